@@ -0,0 +1,94 @@
+package amp
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// Share is a 32-byte share of the root seed of an AMP payment. Each part of
+// the payment carries one share, and the root seed is only recoverable by
+// XORing the shares of all parts together. As a result, the receiver can't
+// derive the preimage of any part until it has received the whole payment.
+type Share [32]byte
+
+// Xor stores the byte-wise XOR of x and y in s. It's safe for s to alias
+// either x or y.
+func (s *Share) Xor(x, y *Share) {
+	for i := range s {
+		s[i] = x[i] ^ y[i]
+	}
+}
+
+// ChildDesc is the information carried by a single part of an AMP payment
+// that's required to derive its preimage once the root seed is known.
+type ChildDesc struct {
+	// Share is the share of the root seed carried by the part.
+	Share Share
+
+	// Index is the index of the part within the payment. It ensures that
+	// each part is locked to a distinct payment hash.
+	Index uint32
+}
+
+// Child is a part of an AMP payment whose preimage has been derived from the
+// root seed.
+type Child struct {
+	ChildDesc
+
+	// Preimage is the preimage that settles the part.
+	Preimage [32]byte
+
+	// Hash is the payment hash of the part.
+	Hash [32]byte
+}
+
+// String returns a human readable description of the child.
+func (c *Child) String() string {
+	return fmt.Sprintf("share=%x, index=%d -> preimage=%x, hash=%x",
+		c.Share[:], c.Index, c.Preimage[:], c.Hash[:])
+}
+
+// DeriveChild derives the preimage and payment hash of a part from the root
+// seed of the payment:
+//
+//	preimage = SHA256(root || index)
+//	hash     = SHA256(preimage)
+//
+// where index is encoded as a big-endian uint32.
+func DeriveChild(root Share, desc ChildDesc) *Child {
+	var index [4]byte
+	binary.BigEndian.PutUint32(index[:], desc.Index)
+
+	h := sha256.New()
+	h.Write(root[:])
+	h.Write(index[:])
+
+	var preimage [32]byte
+	copy(preimage[:], h.Sum(nil))
+
+	return &Child{
+		ChildDesc: desc,
+		Preimage:  preimage,
+		Hash:      sha256.Sum256(preimage[:]),
+	}
+}
+
+// ReconstructChildren recovers the root seed of an AMP payment from the
+// descriptors of all of its parts, and derives the preimage of each part.
+// If any part is missing, the root seed, and hence all preimages, will be
+// incorrect, which the receiver detects by comparing the derived hashes
+// against the payment hashes of the HTLCs it's holding.
+func ReconstructChildren(descs ...ChildDesc) []*Child {
+	var root Share
+	for i := range descs {
+		root.Xor(&root, &descs[i].Share)
+	}
+
+	children := make([]*Child, 0, len(descs))
+	for _, desc := range descs {
+		children = append(children, DeriveChild(root, desc))
+	}
+
+	return children
+}
@@ -0,0 +1,66 @@
+package amp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+)
+
+// TestReconstructChildren asserts that the receiver derives the same
+// preimages as the sender once it holds the shares of all parts, and that it
+// derives different ones if any part is missing.
+func TestReconstructChildren(t *testing.T) {
+	t.Parallel()
+
+	var root Share
+	if _, err := rand.Read(root[:]); err != nil {
+		t.Fatalf("unable to generate root: %v", err)
+	}
+
+	// Split the root into random shares, with the last share chosen such
+	// that all of them XOR to the root.
+	const numParts = 4
+	descs := make([]ChildDesc, numParts)
+	last := root
+	for i := 0; i < numParts-1; i++ {
+		if _, err := rand.Read(descs[i].Share[:]); err != nil {
+			t.Fatalf("unable to generate share: %v", err)
+		}
+		descs[i].Index = uint32(i)
+		last.Xor(&last, &descs[i].Share)
+	}
+	descs[numParts-1] = ChildDesc{Share: last, Index: numParts - 1}
+
+	children := ReconstructChildren(descs...)
+	if len(children) != numParts {
+		t.Fatalf("expected %d children, got %d", numParts,
+			len(children))
+	}
+
+	seen := make(map[[32]byte]struct{})
+	for i, child := range children {
+		expected := DeriveChild(root, descs[i])
+		if child.Preimage != expected.Preimage {
+			t.Fatalf("child %d: preimage mismatch: %v vs %v", i,
+				child, expected)
+		}
+		if child.Hash != sha256.Sum256(child.Preimage[:]) {
+			t.Fatalf("child %d: hash doesn't commit to preimage", i)
+		}
+
+		// Every part must be locked to a distinct hash.
+		if _, ok := seen[child.Hash]; ok {
+			t.Fatalf("child %d: duplicate hash", i)
+		}
+		seen[child.Hash] = struct{}{}
+	}
+
+	// Without the last part, the root can't be recovered.
+	partial := ReconstructChildren(descs[:numParts-1]...)
+	for i, child := range partial {
+		if child.Preimage == children[i].Preimage {
+			t.Fatalf("child %d: derived preimage without all "+
+				"shares", i)
+		}
+	}
+}
@@ -59,6 +59,11 @@ type Config struct {
 	// when opening channels.
 	Constraints *HeuristicConstraints
 
+	// NodeFilter is an optional set of restrictions applied to the nodes
+	// of the graph before they're scored by the Heuristic. If nil, all
+	// nodes are considered.
+	NodeFilter *NodeFilter
+
 	// TODO(roasbeef): add additional signals from fee rates and revenue of
 	// currently opened channels
 }
@@ -532,6 +537,12 @@ func (a *Agent) openChans(availableFunds btcutil.Amount, numChans uint32,
 			return nil
 		}
 
+		// Finally, we'll skip any node that doesn't satisfy our
+		// static node filter.
+		if !a.cfg.NodeFilter.Allows(node) {
+			return nil
+		}
+
 		nodes[nID] = struct{}{}
 		return nil
 	}); err != nil {
@@ -75,6 +75,14 @@ func (d dbNode) Addrs() []net.Addr {
 	return d.node.Addresses
 }
 
+// Features returns the set of features the node advertised in its latest
+// node announcement.
+//
+// NOTE: Part of the autopilot.Node interface.
+func (d dbNode) Features() *lnwire.FeatureVector {
+	return d.node.Features
+}
+
 // ForEachChannel is a higher-order function that will be used to iterate
 // through all edges emanating from/to the target node. For each active
 // channel, this function should be called with the populated ChannelEdge that
@@ -457,6 +465,8 @@ type memNode struct {
 	chans []ChannelEdge
 
 	addrs []net.Addr
+
+	features *lnwire.FeatureVector
 }
 
 // A compile time assertion to ensure memNode meets the autopilot.Node
@@ -482,6 +492,13 @@ func (m memNode) Addrs() []net.Addr {
 	return m.addrs
 }
 
+// Features returns the set of features the node advertises.
+//
+// NOTE: Part of the autopilot.Node interface.
+func (m memNode) Features() *lnwire.FeatureVector {
+	return m.features
+}
+
 // ForEachChannel is a higher-order function that will be used to iterate
 // through all edges emanating from/to the target node. For each active
 // channel, this function should be called with the populated ChannelEdge that
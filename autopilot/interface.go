@@ -24,6 +24,10 @@ type Node interface {
 	// that the peer is known to be listening on.
 	Addrs() []net.Addr
 
+	// Features returns the set of features the node advertises, or nil if
+	// they aren't known.
+	Features() *lnwire.FeatureVector
+
 	// ForEachChannel is a higher-order function that will be used to
 	// iterate through all edges emanating from/to the target node. For
 	// each active channel, this function should be called with the
@@ -0,0 +1,57 @@
+package autopilot
+
+import (
+	"net"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// NodeFilter is a set of static restrictions applied to every node in the
+// graph before it is handed to the attachment heuristic for scoring. Nodes
+// that don't pass the filter will never be considered as channel candidates.
+type NodeFilter struct {
+	// RequiredFeatures is the set of feature bits a node must advertise
+	// in order to be considered as a channel candidate.
+	RequiredFeatures []lnwire.FeatureBit
+
+	// ExcludedNets is a set of IP networks we don't want to open channels
+	// into. Any node advertising an address within one of these networks
+	// will be skipped.
+	ExcludedNets []*net.IPNet
+}
+
+// Allows returns true if the given node satisfies all the restrictions of the
+// filter. A nil filter allows all nodes.
+func (f *NodeFilter) Allows(node Node) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.RequiredFeatures) != 0 {
+		features := node.Features()
+		if features == nil {
+			return false
+		}
+
+		for _, bit := range f.RequiredFeatures {
+			if !features.HasFeature(bit) {
+				return false
+			}
+		}
+	}
+
+	for _, addr := range node.Addrs() {
+		tcpAddr, ok := addr.(*net.TCPAddr)
+		if !ok {
+			continue
+		}
+
+		for _, ipNet := range f.ExcludedNets {
+			if ipNet.Contains(tcpAddr.IP) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
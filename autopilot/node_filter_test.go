@@ -0,0 +1,81 @@
+package autopilot
+
+import (
+	"net"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestNodeFilter ensures that the node filter properly rejects nodes lacking
+// required features or advertising addresses within excluded networks.
+func TestNodeFilter(t *testing.T) {
+	t.Parallel()
+
+	_, excluded, err := net.ParseCIDR("10.0.0.0/8")
+	if err != nil {
+		t.Fatalf("unable to parse cidr: %v", err)
+	}
+
+	features := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.DataLossProtectOptional),
+		lnwire.LocalFeatures,
+	)
+
+	filter := &NodeFilter{
+		RequiredFeatures: []lnwire.FeatureBit{
+			lnwire.DataLossProtectOptional,
+		},
+		ExcludedNets: []*net.IPNet{excluded},
+	}
+
+	testCases := []struct {
+		name    string
+		node    memNode
+		allowed bool
+	}{
+		{
+			name: "allowed",
+			node: memNode{
+				addrs: []net.Addr{&net.TCPAddr{
+					IP: net.ParseIP("192.168.1.1"),
+				}},
+				features: features,
+			},
+			allowed: true,
+		},
+		{
+			name: "missing features",
+			node: memNode{
+				addrs: []net.Addr{&net.TCPAddr{
+					IP: net.ParseIP("192.168.1.1"),
+				}},
+			},
+			allowed: false,
+		},
+		{
+			name: "excluded network",
+			node: memNode{
+				addrs: []net.Addr{
+					&net.TCPAddr{IP: net.ParseIP("192.168.1.1")},
+					&net.TCPAddr{IP: net.ParseIP("10.1.2.3")},
+				},
+				features: features,
+			},
+			allowed: false,
+		},
+	}
+
+	for _, test := range testCases {
+		if filter.Allows(test.node) != test.allowed {
+			t.Fatalf("%s: expected allowed=%v", test.name,
+				test.allowed)
+		}
+	}
+
+	// A nil filter should allow every node.
+	var nilFilter *NodeFilter
+	if !nilFilter.Allows(testCases[1].node) {
+		t.Fatalf("nil filter should allow all nodes")
+	}
+}
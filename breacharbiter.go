@@ -106,6 +106,11 @@ type BreachConfig struct {
 	// breached channels. This is used in conjunction with DB to recover
 	// from crashes, restarts, or other failures.
 	Store RetributionStore
+
+	// NotifyFullyResolvedChannel is used to notify the ChannelNotifier
+	// that the funds of a breached channel have all been swept back to
+	// our wallet.
+	NotifyFullyResolvedChannel func(wire.OutPoint)
 }
 
 // breachArbiter is a special subsystem which is responsible for watching and
@@ -609,6 +614,8 @@ justiceTxBroadcast:
 			return
 		}
 
+		b.cfg.NotifyFullyResolvedChannel(breachInfo.chanPoint)
+
 		// Justice has been carried out; we can safely delete the
 		// retribution info from the database.
 		err = b.cfg.Store.Remove(&breachInfo.chanPoint)
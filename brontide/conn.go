@@ -30,14 +30,17 @@ var _ net.Conn = (*Conn)(nil)
 
 // Dial attempts to establish an encrypted+authenticated connection with the
 // remote peer located at address which has remotePub as its long-term static
-// public key. In the case of a handshake failure, the connection is closed and
-// a non-nil error is returned.
+// public key. The connection attempt is abandoned if the connection can't be
+// established within the passed timeout. In the case of a handshake failure,
+// the connection is closed and a non-nil error is returned.
 func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
-	dialer func(string, string) (net.Conn, error)) (*Conn, error) {
+	timeout time.Duration, dialer func(string, string,
+		time.Duration) (net.Conn, error)) (*Conn, error) {
+
 	ipAddr := netAddr.Address.String()
 	var conn net.Conn
 	var err error
-	conn, err = dialer("tcp", ipAddr)
+	conn, err = dialer("tcp", ipAddr, timeout)
 	if err != nil {
 		return nil, err
 	}
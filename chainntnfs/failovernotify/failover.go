@@ -0,0 +1,790 @@
+package failovernotify
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultMaxFailures is the default number of consecutive failed
+	// health checks after which the active backend is abandoned.
+	DefaultMaxFailures = 3
+
+	// DefaultReorgSafetyLimit is the default depth after which satisfied
+	// notifications are no longer replayed onto a new backend, as they
+	// can no longer be reorged out of the chain.
+	DefaultReorgSafetyLimit = 100
+)
+
+// ErrNoHealthyBackend is returned when none of the configured backends could
+// be started.
+var ErrNoHealthyBackend = errors.New("no healthy chain backend available")
+
+// Backend is one of the chain backends the FailoverNotifier can rely on.
+type Backend struct {
+	// Name identifies the backend within the logs.
+	Name string
+
+	// NewNotifier creates a new chain notifier driven by the backend. It's
+	// called each time the backend becomes active, as a notifier can't be
+	// restarted once stopped.
+	NewNotifier func() (chainntnfs.ChainNotifier, error)
+
+	// HealthCheck returns a non-nil error if the backend is currently
+	// unable to serve requests.
+	HealthCheck func() error
+}
+
+// Config houses the backends and parameters of the FailoverNotifier.
+type Config struct {
+	// Backends is the list of backends, in order of preference.
+	Backends []*Backend
+
+	// HealthTicker signals when the health of the active backend should
+	// be checked.
+	HealthTicker ticker.Ticker
+
+	// MaxFailures is the number of consecutive failed health checks after
+	// which we fail over to the next healthy backend. If zero,
+	// DefaultMaxFailures is used.
+	MaxFailures int
+
+	// ReorgSafetyLimit is the depth after which satisfied notifications
+	// are forgotten. If zero, DefaultReorgSafetyLimit is used.
+	ReorgSafetyLimit uint32
+}
+
+// generation is a single activation of a backend. Once the backend is
+// abandoned, its quit channel is closed, which stops all the goroutines
+// forwarding its notifications.
+type generation struct {
+	backend  *Backend
+	notifier chainntnfs.ChainNotifier
+	quit     chan struct{}
+}
+
+// confRegistration is a confirmation notification requested by a client.
+type confRegistration struct {
+	txid       chainhash.Hash
+	pkScript   []byte
+	numConfs   uint32
+	heightHint uint32
+
+	event     *chainntnfs.ConfirmationEvent
+	confirmed *chainntnfs.TxConfirmation
+
+	quit chan struct{}
+}
+
+// spendRegistration is a spend notification requested by a client.
+type spendRegistration struct {
+	outpoint   wire.OutPoint
+	pkScript   []byte
+	heightHint uint32
+
+	event *chainntnfs.SpendEvent
+	spent *chainntnfs.SpendDetail
+	inner *chainntnfs.SpendEvent
+
+	quit chan struct{}
+}
+
+// epochRegistration is a block epoch notification requested by a client.
+type epochRegistration struct {
+	epochs chan *chainntnfs.BlockEpoch
+	last   *chainntnfs.BlockEpoch
+	inner  *chainntnfs.BlockEpochEvent
+
+	quit chan struct{}
+}
+
+// FailoverNotifier is a ChainNotifier that relies on one of several chain
+// backends at a time. The health of the active backend is checked
+// periodically, and once it's been failing for long enough, the next healthy
+// backend takes over. All outstanding notifications are then registered anew
+// with the new backend, so that clients keep receiving them through the same
+// events, without noticing the switch.
+type FailoverNotifier struct {
+	started int32
+	stopped int32
+
+	cfg Config
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	active   int
+	gen      *generation
+	failures int
+
+	bestHeight uint32
+
+	nextID uint64
+	confs  map[uint64]*confRegistration
+	spends map[uint64]*spendRegistration
+	epochs map[uint64]*epochRegistration
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// Compile-time check to ensure FailoverNotifier implements the ChainNotifier
+// interface.
+var _ chainntnfs.ChainNotifier = (*FailoverNotifier)(nil)
+
+// New creates a new FailoverNotifier from the given config.
+func New(cfg Config) *FailoverNotifier {
+	if cfg.MaxFailures == 0 {
+		cfg.MaxFailures = DefaultMaxFailures
+	}
+	if cfg.ReorgSafetyLimit == 0 {
+		cfg.ReorgSafetyLimit = DefaultReorgSafetyLimit
+	}
+
+	return &FailoverNotifier{
+		cfg:    cfg,
+		confs:  make(map[uint64]*confRegistration),
+		spends: make(map[uint64]*spendRegistration),
+		epochs: make(map[uint64]*epochRegistration),
+		quit:   make(chan struct{}),
+	}
+}
+
+// Start activates the first backend that can be started, and launches the
+// goroutine monitoring its health.
+//
+// NOTE: Part of the chainntnfs.ChainNotifier interface.
+func (n *FailoverNotifier) Start() error {
+	if !atomic.CompareAndSwapInt32(&n.started, 0, 1) {
+		return nil
+	}
+
+	n.mu.Lock()
+	err := n.activateNext(0)
+	n.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	n.cfg.HealthTicker.Resume()
+
+	n.wg.Add(1)
+	go n.healthLoop()
+
+	return nil
+}
+
+// Stop stops the active backend along with all the goroutines forwarding its
+// notifications.
+//
+// NOTE: Part of the chainntnfs.ChainNotifier interface.
+func (n *FailoverNotifier) Stop() error {
+	if !atomic.CompareAndSwapInt32(&n.stopped, 0, 1) {
+		return nil
+	}
+
+	close(n.quit)
+
+	n.mu.Lock()
+	gen := n.gen
+	if gen != nil {
+		close(gen.quit)
+	}
+	n.mu.Unlock()
+
+	n.wg.Wait()
+
+	n.cfg.HealthTicker.Stop()
+
+	if gen == nil {
+		return nil
+	}
+	return gen.notifier.Stop()
+}
+
+// healthLoop periodically checks the health of the active backend.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *FailoverNotifier) healthLoop() {
+	defer n.wg.Done()
+
+	for {
+		select {
+		case <-n.cfg.HealthTicker.Ticks():
+			n.checkHealth()
+
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// checkHealth checks the health of the active backend, and fails over to the
+// next healthy backend if it's been failing for too long.
+func (n *FailoverNotifier) checkHealth() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	backend := n.cfg.Backends[n.active]
+	err := backend.HealthCheck()
+	if err == nil {
+		n.failures = 0
+		return
+	}
+
+	n.failures++
+	chainntnfs.Log.Warnf("Health check of chain backend %v failed "+
+		"(%d/%d): %v", backend.Name, n.failures, n.cfg.MaxFailures,
+		err)
+
+	if n.failures < n.cfg.MaxFailures || len(n.cfg.Backends) < 2 {
+		return
+	}
+
+	// We'll look for a healthy backend amongst the others, in order of
+	// preference.
+	for i := 1; i < len(n.cfg.Backends); i++ {
+		next := (n.active + i) % len(n.cfg.Backends)
+		candidate := n.cfg.Backends[next]
+		if err := candidate.HealthCheck(); err != nil {
+			chainntnfs.Log.Warnf("Unable to fail over to chain "+
+				"backend %v: %v", candidate.Name, err)
+			continue
+		}
+
+		if err := n.failover(next); err != nil {
+			chainntnfs.Log.Errorf("Unable to fail over to chain "+
+				"backend %v: %v", candidate.Name, err)
+			continue
+		}
+
+		return
+	}
+}
+
+// failover abandons the active backend in favor of the one at the given
+// index, replaying all outstanding notifications onto it.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (n *FailoverNotifier) failover(next int) error {
+	prev := n.gen
+	chainntnfs.Log.Infof("Failing over from chain backend %v to %v",
+		prev.backend.Name, n.cfg.Backends[next].Name)
+
+	if err := n.activate(next); err != nil {
+		return err
+	}
+
+	close(prev.quit)
+	if err := prev.notifier.Stop(); err != nil {
+		chainntnfs.Log.Errorf("Unable to stop chain backend %v: %v",
+			prev.backend.Name, err)
+	}
+
+	// Now that the new backend is active, we'll register all outstanding
+	// notifications with it.
+	for id, reg := range n.confs {
+		if err := n.registerConf(reg); err != nil {
+			chainntnfs.Log.Errorf("Unable to replay confirmation "+
+				"notification for %v: %v", reg.txid, err)
+			delete(n.confs, id)
+		}
+	}
+	for id, reg := range n.spends {
+		if err := n.registerSpend(reg); err != nil {
+			chainntnfs.Log.Errorf("Unable to replay spend "+
+				"notification for %v: %v", reg.outpoint, err)
+			delete(n.spends, id)
+		}
+	}
+	for id, reg := range n.epochs {
+		if err := n.registerEpoch(reg); err != nil {
+			chainntnfs.Log.Errorf("Unable to replay block epoch "+
+				"notification: %v", err)
+			delete(n.epochs, id)
+		}
+	}
+
+	return nil
+}
+
+// activateNext activates the first backend that can be started, starting from
+// the given index.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (n *FailoverNotifier) activateNext(start int) error {
+	for i := start; i < len(n.cfg.Backends); i++ {
+		err := n.activate(i)
+		if err == nil {
+			return nil
+		}
+
+		chainntnfs.Log.Errorf("Unable to start chain backend %v: %v",
+			n.cfg.Backends[i].Name, err)
+	}
+
+	return ErrNoHealthyBackend
+}
+
+// activate starts the backend at the given index, and makes it the active
+// one.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (n *FailoverNotifier) activate(i int) error {
+	backend := n.cfg.Backends[i]
+	notifier, err := backend.NewNotifier()
+	if err != nil {
+		return err
+	}
+	if err := notifier.Start(); err != nil {
+		return err
+	}
+
+	// We'll track the best height of the new backend, in order to know
+	// when satisfied notifications are deep enough to be forgotten.
+	blocks, err := notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		notifier.Stop()
+		return err
+	}
+
+	chainntnfs.Log.Infof("Using chain backend %v", backend.Name)
+
+	n.active = i
+	n.failures = 0
+	n.gen = &generation{
+		backend:  backend,
+		notifier: notifier,
+		quit:     make(chan struct{}),
+	}
+
+	n.wg.Add(1)
+	go n.trackBlocks(blocks, n.gen)
+
+	return nil
+}
+
+// trackBlocks keeps track of the best height of the given generation's
+// backend, forgetting satisfied notifications once they're deep enough.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *FailoverNotifier) trackBlocks(blocks *chainntnfs.BlockEpochEvent,
+	gen *generation) {
+
+	defer n.wg.Done()
+	defer blocks.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-blocks.Epochs:
+			if !ok {
+				return
+			}
+
+			n.mu.Lock()
+			n.bestHeight = uint32(epoch.Height)
+			n.pruneRegistrations()
+			n.mu.Unlock()
+
+		case <-gen.quit:
+			return
+		}
+	}
+}
+
+// pruneRegistrations forgets the confirmation and spend notifications that
+// were satisfied deeper than the reorg safety limit.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (n *FailoverNotifier) pruneRegistrations() {
+	limit := n.cfg.ReorgSafetyLimit
+	for id, reg := range n.confs {
+		if reg.confirmed == nil ||
+			reg.confirmed.BlockHeight+limit > n.bestHeight {
+			continue
+		}
+
+		close(reg.quit)
+		delete(n.confs, id)
+	}
+	for id, reg := range n.spends {
+		if reg.spent == nil ||
+			uint32(reg.spent.SpendingHeight)+limit > n.bestHeight {
+			continue
+		}
+
+		close(reg.quit)
+		reg.inner.Cancel()
+		delete(n.spends, id)
+	}
+}
+
+// RegisterConfirmationsNtfn registers an intent to be notified once the
+// target txid reaches the given number of confirmations.
+//
+// NOTE: Part of the chainntnfs.ChainNotifier interface.
+func (n *FailoverNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	pkScript []byte, numConfs,
+	heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	reg := &confRegistration{
+		txid:       *txid,
+		pkScript:   pkScript,
+		numConfs:   numConfs,
+		heightHint: heightHint,
+		event:      chainntnfs.NewConfirmationEvent(numConfs),
+		quit:       make(chan struct{}),
+	}
+	if err := n.registerConf(reg); err != nil {
+		return nil, err
+	}
+
+	n.confs[n.nextID] = reg
+	n.nextID++
+
+	return reg.event, nil
+}
+
+// registerConf registers the confirmation notification with the active
+// backend, and forwards its notifications to the client.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (n *FailoverNotifier) registerConf(reg *confRegistration) error {
+	gen := n.gen
+	inner, err := gen.notifier.RegisterConfirmationsNtfn(
+		&reg.txid, reg.pkScript, reg.numConfs, reg.heightHint,
+	)
+	if err != nil {
+		return err
+	}
+
+	n.wg.Add(1)
+	go n.forwardConf(reg, inner, gen)
+
+	return nil
+}
+
+// forwardConf forwards the notifications of a backend's confirmation event to
+// the client's. As the notification is replayed after a failover, duplicate
+// confirmations are skipped.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *FailoverNotifier) forwardConf(reg *confRegistration,
+	inner *chainntnfs.ConfirmationEvent, gen *generation) {
+
+	defer n.wg.Done()
+
+	for {
+		select {
+		case conf, ok := <-inner.Confirmed:
+			if !ok {
+				return
+			}
+
+			n.mu.Lock()
+			dup := reg.confirmed != nil &&
+				*reg.confirmed.BlockHash == *conf.BlockHash
+			n.mu.Unlock()
+
+			if dup {
+				continue
+			}
+
+			select {
+			case reg.event.Confirmed <- conf:
+			case <-reg.quit:
+				return
+			case <-gen.quit:
+				return
+			}
+
+			n.mu.Lock()
+			reg.confirmed = conf
+			n.mu.Unlock()
+
+		case numConfsLeft, ok := <-inner.Updates:
+			if !ok {
+				return
+			}
+
+			// Updates are replayed after a failover, so we won't
+			// block on clients that don't consume them.
+			select {
+			case reg.event.Updates <- numConfsLeft:
+			default:
+			}
+
+		case depth, ok := <-inner.NegativeConf:
+			if !ok {
+				return
+			}
+
+			n.mu.Lock()
+			reg.confirmed = nil
+			n.mu.Unlock()
+
+			select {
+			case reg.event.NegativeConf <- depth:
+			case <-reg.quit:
+				return
+			case <-gen.quit:
+				return
+			}
+
+		case <-reg.quit:
+			return
+
+		case <-gen.quit:
+			return
+		}
+	}
+}
+
+// RegisterSpendNtfn registers an intent to be notified once the target
+// outpoint is spent.
+//
+// NOTE: Part of the chainntnfs.ChainNotifier interface.
+func (n *FailoverNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	id := n.nextID
+	reg := &spendRegistration{
+		outpoint:   *outpoint,
+		pkScript:   pkScript,
+		heightHint: heightHint,
+		event: chainntnfs.NewSpendEvent(func() {
+			n.cancelSpend(id)
+		}),
+		quit: make(chan struct{}),
+	}
+	if err := n.registerSpend(reg); err != nil {
+		return nil, err
+	}
+
+	n.spends[id] = reg
+	n.nextID++
+
+	return reg.event, nil
+}
+
+// registerSpend registers the spend notification with the active backend, and
+// forwards its notifications to the client.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (n *FailoverNotifier) registerSpend(reg *spendRegistration) error {
+	gen := n.gen
+	inner, err := gen.notifier.RegisterSpendNtfn(
+		&reg.outpoint, reg.pkScript, reg.heightHint,
+	)
+	if err != nil {
+		return err
+	}
+	reg.inner = inner
+
+	n.wg.Add(1)
+	go n.forwardSpend(reg, inner, gen)
+
+	return nil
+}
+
+// forwardSpend forwards the notifications of a backend's spend event to the
+// client's. As the notification is replayed after a failover, duplicate
+// spends are skipped.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *FailoverNotifier) forwardSpend(reg *spendRegistration,
+	inner *chainntnfs.SpendEvent, gen *generation) {
+
+	defer n.wg.Done()
+
+	for {
+		select {
+		case spend, ok := <-inner.Spend:
+			if !ok {
+				return
+			}
+
+			n.mu.Lock()
+			dup := reg.spent != nil &&
+				*reg.spent.SpenderTxHash == *spend.SpenderTxHash
+			n.mu.Unlock()
+
+			if dup {
+				continue
+			}
+
+			select {
+			case reg.event.Spend <- spend:
+			case <-reg.quit:
+				return
+			case <-gen.quit:
+				return
+			}
+
+			n.mu.Lock()
+			reg.spent = spend
+			n.mu.Unlock()
+
+		case _, ok := <-inner.Reorg:
+			if !ok {
+				return
+			}
+
+			n.mu.Lock()
+			reg.spent = nil
+			n.mu.Unlock()
+
+			select {
+			case reg.event.Reorg <- struct{}{}:
+			case <-reg.quit:
+				return
+			case <-gen.quit:
+				return
+			}
+
+		case <-reg.quit:
+			return
+
+		case <-gen.quit:
+			return
+		}
+	}
+}
+
+// cancelSpend cancels the spend notification with the given ID.
+func (n *FailoverNotifier) cancelSpend(id uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	reg, ok := n.spends[id]
+	if !ok {
+		return
+	}
+
+	close(reg.quit)
+	reg.inner.Cancel()
+	delete(n.spends, id)
+}
+
+// RegisterBlockEpochNtfn registers an intent to be notified of each new block
+// connected to the tip of the main chain. If bestBlock is set, the client is
+// first notified of all the blocks it missed since.
+//
+// NOTE: Part of the chainntnfs.ChainNotifier interface.
+func (n *FailoverNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	id := n.nextID
+	reg := &epochRegistration{
+		epochs: make(chan *chainntnfs.BlockEpoch),
+		last:   bestBlock,
+		quit:   make(chan struct{}),
+	}
+	if err := n.registerEpoch(reg); err != nil {
+		return nil, err
+	}
+
+	n.epochs[id] = reg
+	n.nextID++
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: reg.epochs,
+		Cancel: func() {
+			n.cancelEpoch(id)
+		},
+	}, nil
+}
+
+// registerEpoch registers the block epoch notification with the active
+// backend, starting from the last block delivered to the client, and forwards
+// its notifications to the client.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (n *FailoverNotifier) registerEpoch(reg *epochRegistration) error {
+	gen := n.gen
+	inner, err := gen.notifier.RegisterBlockEpochNtfn(reg.last)
+	if err != nil {
+		return err
+	}
+	reg.inner = inner
+
+	n.wg.Add(1)
+	go n.forwardEpochs(reg, inner, gen)
+
+	return nil
+}
+
+// forwardEpochs forwards the blocks notified by a backend to the client,
+// keeping track of the last one delivered.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *FailoverNotifier) forwardEpochs(reg *epochRegistration,
+	inner *chainntnfs.BlockEpochEvent, gen *generation) {
+
+	defer n.wg.Done()
+
+	for {
+		select {
+		case epoch, ok := <-inner.Epochs:
+			if !ok {
+				return
+			}
+
+			select {
+			case reg.epochs <- epoch:
+			case <-reg.quit:
+				return
+			case <-gen.quit:
+				return
+			}
+
+			n.mu.Lock()
+			reg.last = epoch
+			n.mu.Unlock()
+
+		case <-reg.quit:
+			return
+
+		case <-gen.quit:
+			return
+		}
+	}
+}
+
+// cancelEpoch cancels the block epoch notification with the given ID.
+func (n *FailoverNotifier) cancelEpoch(id uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	reg, ok := n.epochs[id]
+	if !ok {
+		return
+	}
+
+	close(reg.quit)
+	reg.inner.Cancel()
+	delete(n.epochs, id)
+}
+
+// ActiveBackend returns the name of the backend currently in use.
+func (n *FailoverNotifier) ActiveBackend() string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.cfg.Backends[n.active].Name
+}
@@ -0,0 +1,184 @@
+package failovernotify
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// mockNotifier is a ChainNotifier that hands out events that can be
+// dispatched by the test.
+type mockNotifier struct {
+	sync.Mutex
+
+	confs  []*chainntnfs.ConfirmationEvent
+	epochs []chan *chainntnfs.BlockEpoch
+
+	// bestBlocks is the best block passed along each block epoch
+	// registration.
+	bestBlocks []*chainntnfs.BlockEpoch
+}
+
+func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	pkScript []byte, numConfs,
+	heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	event := chainntnfs.NewConfirmationEvent(numConfs)
+	m.confs = append(m.confs, event)
+
+	return event, nil
+}
+
+func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	return chainntnfs.NewSpendEvent(func() {}), nil
+}
+
+func (m *mockNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	epochs := make(chan *chainntnfs.BlockEpoch, 10)
+	m.epochs = append(m.epochs, epochs)
+	m.bestBlocks = append(m.bestBlocks, bestBlock)
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: epochs,
+		Cancel: func() {},
+	}, nil
+}
+
+func (m *mockNotifier) Start() error {
+	return nil
+}
+
+func (m *mockNotifier) Stop() error {
+	return nil
+}
+
+// TestFailover asserts that once the active backend fails enough health
+// checks, outstanding notifications are replayed onto the next backend, and
+// delivered to clients through their original events.
+func TestFailover(t *testing.T) {
+	t.Parallel()
+
+	primary := &mockNotifier{}
+	backup := &mockNotifier{}
+
+	var primaryErr error
+	n := New(Config{
+		Backends: []*Backend{
+			{
+				Name: "primary",
+				NewNotifier: func() (chainntnfs.ChainNotifier,
+					error) {
+
+					return primary, nil
+				},
+				HealthCheck: func() error {
+					return primaryErr
+				},
+			},
+			{
+				Name: "backup",
+				NewNotifier: func() (chainntnfs.ChainNotifier,
+					error) {
+
+					return backup, nil
+				},
+				HealthCheck: func() error {
+					return nil
+				},
+			},
+		},
+		HealthTicker: ticker.New(time.Hour),
+		MaxFailures:  2,
+	})
+	if err := n.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer n.Stop()
+
+	confEvent, err := n.RegisterConfirmationsNtfn(
+		&chainhash.Hash{1}, nil, 1, 100,
+	)
+	if err != nil {
+		t.Fatalf("unable to register conf: %v", err)
+	}
+	epochEvent, err := n.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		t.Fatalf("unable to register epoch: %v", err)
+	}
+
+	// The client should receive the blocks notified by the primary
+	// backend. The first epoch registration is the notifier's own.
+	epoch := &chainntnfs.BlockEpoch{Hash: &chainhash.Hash{2}, Height: 101}
+	primary.epochs[1] <- epoch
+	select {
+	case <-epochEvent.Epochs:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("epoch not received")
+	}
+
+	// A single failed health check shouldn't trigger a failover.
+	primaryErr = errors.New("unreachable")
+	n.checkHealth()
+	if n.ActiveBackend() != "primary" {
+		t.Fatalf("expected primary backend to remain active")
+	}
+
+	n.checkHealth()
+	if n.ActiveBackend() != "backup" {
+		t.Fatalf("expected backup backend to be active")
+	}
+
+	// Both notifications should have been registered with the backup,
+	// with the block epochs resuming from the last block delivered.
+	backup.Lock()
+	if len(backup.confs) != 1 {
+		t.Fatalf("expected 1 conf registration, got %v",
+			len(backup.confs))
+	}
+	if len(backup.epochs) != 2 || backup.bestBlocks[1] != epoch {
+		t.Fatalf("expected epochs to resume from %v", epoch)
+	}
+	backupConf := backup.confs[0]
+	backup.Unlock()
+
+	// The confirmation dispatched by the backup should reach the client.
+	conf := &chainntnfs.TxConfirmation{
+		BlockHash:   &chainhash.Hash{3},
+		BlockHeight: 102,
+	}
+	backupConf.Confirmed <- conf
+	select {
+	case c := <-confEvent.Confirmed:
+		if c != conf {
+			t.Fatalf("unexpected confirmation: %v", c)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("confirmation not received")
+	}
+
+	// Notifications from the abandoned primary should be ignored.
+	primary.Lock()
+	primary.confs[0].Confirmed <- conf
+	primary.Unlock()
+	select {
+	case <-confEvent.Confirmed:
+		t.Fatalf("unexpected confirmation from abandoned backend")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
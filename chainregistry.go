@@ -12,8 +12,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/btcwallet/chain"
 	"github.com/btcsuite/btcwallet/wallet"
@@ -22,6 +24,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/chainntnfs/bitcoindnotify"
 	"github.com/lightningnetwork/lnd/chainntnfs/btcdnotify"
+	"github.com/lightningnetwork/lnd/chainntnfs/failovernotify"
 	"github.com/lightningnetwork/lnd/chainntnfs/neutrinonotify"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
@@ -30,6 +33,8 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/chainview"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/lightningnetwork/lnd/tor"
 )
 
 const (
@@ -224,7 +229,10 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			AddPeers:     cfg.NeutrinoMode.AddPeers,
 			ConnectPeers: cfg.NeutrinoMode.ConnectPeers,
 			Dialer: func(addr net.Addr) (net.Conn, error) {
-				return cfg.net.Dial(addr.Network(), addr.String())
+				return cfg.net.Dial(
+					addr.Network(), addr.String(),
+					tor.DefaultConnTimeout,
+				)
 			},
 			NameResolver: func(host string) ([]net.IP, error) {
 				addrs, err := cfg.net.LookupHost(host)
@@ -286,36 +294,10 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			bitcoindMode = cfg.LitecoindMode
 		}
 		// Otherwise, we'll be speaking directly via RPC and ZMQ to a
-		// bitcoind node. If the specified host for the btcd/ltcd RPC
-		// server already has a port specified, then we use that
-		// directly. Otherwise, we assume the default port according to
-		// the selected chain parameters.
-		var bitcoindHost string
-		if strings.Contains(bitcoindMode.RPCHost, ":") {
-			bitcoindHost = bitcoindMode.RPCHost
-		} else {
-			// The RPC ports specified in chainparams.go assume
-			// btcd, which picks a different port so that btcwallet
-			// can use the same RPC port as bitcoind. We convert
-			// this back to the btcwallet/bitcoind port.
-			rpcPort, err := strconv.Atoi(activeNetParams.rpcPort)
-			if err != nil {
-				return nil, nil, err
-			}
-			rpcPort -= 2
-			bitcoindHost = fmt.Sprintf("%v:%d",
-				bitcoindMode.RPCHost, rpcPort)
-			if cfg.Bitcoin.Active && cfg.Bitcoin.RegTest {
-				conn, err := net.Dial("tcp", bitcoindHost)
-				if err != nil || conn == nil {
-					rpcPort = 18443
-					bitcoindHost = fmt.Sprintf("%v:%d",
-						bitcoindMode.RPCHost,
-						rpcPort)
-				} else {
-					conn.Close()
-				}
-			}
+		// bitcoind node.
+		bitcoindHost, err := bitcoindRPCHost(cfg, bitcoindMode.RPCHost)
+		if err != nil {
+			return nil, nil, err
 		}
 
 		// Establish the connection to bitcoind and create the clients
@@ -387,6 +369,18 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 				return nil, nil, err
 			}
 		}
+
+		// If a backup node was configured, then we'll fail over to it
+		// whenever the primary one becomes unreachable.
+		if bitcoindMode.BackupRPCHost != "" {
+			err := enableBitcoindFailover(
+				cfg, cc, walletConfig, bitcoindMode,
+				bitcoindConn, rpcConfig, hintCache,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
 	case "btcd", "ltcd":
 		// Otherwise, we'll be speaking directly via RPC to a node.
 		//
@@ -549,6 +543,189 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 	return cc, cleanUp, nil
 }
 
+// bitcoindRPCHost returns the address of the RPC server of a bitcoind node. If
+// the specified host already has a port specified, then we use that directly.
+// Otherwise, we assume the default port according to the selected chain
+// parameters.
+func bitcoindRPCHost(cfg *config, host string) (string, error) {
+	if strings.Contains(host, ":") {
+		return host, nil
+	}
+
+	// The RPC ports specified in chainparams.go assume btcd, which picks
+	// a different port so that btcwallet can use the same RPC port as
+	// bitcoind. We convert this back to the btcwallet/bitcoind port.
+	rpcPort, err := strconv.Atoi(activeNetParams.rpcPort)
+	if err != nil {
+		return "", err
+	}
+	rpcPort -= 2
+	bitcoindHost := fmt.Sprintf("%v:%d", host, rpcPort)
+	if cfg.Bitcoin.Active && cfg.Bitcoin.RegTest {
+		conn, err := net.Dial("tcp", bitcoindHost)
+		if err != nil || conn == nil {
+			rpcPort = 18443
+			bitcoindHost = fmt.Sprintf("%v:%d", host, rpcPort)
+		} else {
+			conn.Close()
+		}
+	}
+
+	return bitcoindHost, nil
+}
+
+// enableBitcoindFailover sets up the backup bitcoind node of the passed
+// config, such that the chain notifier, the fee estimator and the broadcast of
+// transactions fail over to it whenever the primary node becomes unhealthy.
+//
+// NOTE: The wallet keeps syncing from, and the router keeps watching the
+// chain through, the primary node.
+func enableBitcoindFailover(cfg *config, cc *chainControl,
+	walletConfig *btcwallet.Config, bitcoindMode *bitcoindConfig,
+	primaryConn *chain.BitcoindConn, primaryRPC *rpcclient.ConnConfig,
+	hintCache *chainntnfs.HeightHintCache) error {
+
+	if bitcoindMode.HealthCheckInterval <= 0 {
+		return fmt.Errorf("healthcheckinterval must be positive")
+	}
+
+	backupHost, err := bitcoindRPCHost(cfg, bitcoindMode.BackupRPCHost)
+	if err != nil {
+		return err
+	}
+
+	// Unless specified otherwise, the backup node shares the credentials
+	// of the primary one.
+	backupRPC := *primaryRPC
+	backupRPC.Host = backupHost
+	if bitcoindMode.BackupRPCUser != "" {
+		backupRPC.User = bitcoindMode.BackupRPCUser
+	}
+	if bitcoindMode.BackupRPCPass != "" {
+		backupRPC.Pass = bitcoindMode.BackupRPCPass
+	}
+
+	backupConn, err := chain.NewBitcoindConn(
+		activeNetParams.Params, backupRPC.Host, backupRPC.User,
+		backupRPC.Pass, bitcoindMode.BackupZMQPubRawBlock,
+		bitcoindMode.BackupZMQPubRawTx, 100*time.Millisecond,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The health of each node is checked by querying its block count
+	// through a dedicated RPC client.
+	primaryClient, err := rpcclient.New(primaryRPC, nil)
+	if err != nil {
+		return err
+	}
+	backupClient, err := rpcclient.New(&backupRPC, nil)
+	if err != nil {
+		return err
+	}
+
+	newBackend := func(name string, conn *chain.BitcoindConn,
+		client *rpcclient.Client) *failovernotify.Backend {
+
+		return &failovernotify.Backend{
+			Name: name,
+			NewNotifier: func() (chainntnfs.ChainNotifier, error) {
+				if err := conn.Start(); err != nil {
+					return nil, err
+				}
+				return bitcoindnotify.New(
+					conn, hintCache, hintCache,
+				), nil
+			},
+			HealthCheck: func() error {
+				_, err := client.GetBlockCount()
+				return err
+			},
+		}
+	}
+
+	ltndLog.Infof("Enabling failover to backup bitcoind node %v",
+		backupRPC.Host)
+
+	cc.chainNotifier = failovernotify.New(failovernotify.Config{
+		Backends: []*failovernotify.Backend{
+			newBackend(primaryRPC.Host, primaryConn, primaryClient),
+			newBackend(backupRPC.Host, backupConn, backupClient),
+		},
+		HealthTicker: ticker.New(bitcoindMode.HealthCheckInterval),
+	})
+
+	// If we're using live fee estimates, then we'll fall back to the ones
+	// of the backup node whenever the primary one can't provide them.
+	primaryEstimator, ok := cc.feeEstimator.(*lnwallet.BitcoindFeeEstimator)
+	if ok {
+		fallBackFeeRate := lnwallet.SatPerKVByte(25 * 1000)
+		backupEstimator, err := lnwallet.NewBitcoindFeeEstimator(
+			backupRPC, fallBackFeeRate.FeePerKWeight(),
+		)
+		if err != nil {
+			return err
+		}
+
+		// The backup node may well be down at this point, so we'll
+		// only make use of its estimates if it could be started.
+		if err := backupEstimator.Start(); err != nil {
+			ltndLog.Warnf("Unable to start backup fee estimator: "+
+				"%v", err)
+		} else {
+			cc.feeEstimator = lnwallet.NewFailoverFeeEstimator(
+				primaryEstimator, backupEstimator,
+			)
+		}
+	}
+
+	walletConfig.ChainSource = &failoverChainSource{
+		Interface: walletConfig.ChainSource,
+		backups:   []*rpcclient.Client{backupClient},
+	}
+
+	return nil
+}
+
+// failoverChainSource is a chain.Interface that broadcasts transactions
+// through a set of backup nodes whenever the primary chain source can't be
+// reached.
+type failoverChainSource struct {
+	chain.Interface
+
+	backups []*rpcclient.Client
+}
+
+// SendRawTransaction broadcasts the transaction through the primary chain
+// source, falling back to the backup nodes if it can't be reached. If the
+// transaction is rejected by the primary node, then the error is returned
+// right away.
+//
+// NOTE: This is part of the chain.Interface interface.
+func (f *failoverChainSource) SendRawTransaction(tx *wire.MsgTx,
+	allowHighFees bool) (*chainhash.Hash, error) {
+
+	txid, err := f.Interface.SendRawTransaction(tx, allowHighFees)
+	if err == nil {
+		return txid, nil
+	}
+	if _, ok := err.(*btcjson.RPCError); ok {
+		return nil, err
+	}
+
+	for _, backup := range f.backups {
+		txid, backupErr := backup.SendRawTransaction(tx, allowHighFees)
+		if backupErr == nil {
+			ltndLog.Infof("Broadcast tx %v through backup node "+
+				"after error: %v", txid, err)
+			return txid, nil
+		}
+	}
+
+	return nil, err
+}
+
 var (
 	// bitcoinTestnetGenesis is the genesis hash of Bitcoin's testnet
 	// chain.
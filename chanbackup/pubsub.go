@@ -0,0 +1,249 @@
+package chanbackup
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// Swapper is an interface that allows the chanbackup.SubSwapper to update the
+// main multi backup location once it learns of new channels or that prior
+// channels have been closed.
+type Swapper interface {
+	// UpdateAndSwap attempts to atomically update the main multi back up
+	// file location with the new fully packed multi-channel backup.
+	UpdateAndSwap(newBackup PackedMulti) error
+}
+
+// ChannelNotifier is an interface that allows the chanbackup.SubSwapper to be
+// notified of the channels that are opened and closed, so that the backup
+// can be kept up to date.
+type ChannelNotifier interface {
+	// SubscribeChannelEvents returns a subscription to all the events
+	// that happen to any of our channels.
+	SubscribeChannelEvents() (*channelnotifier.Subscription, error)
+}
+
+// SubSwapper subscribes to new updates to the open channel state, and then
+// swaps out the on-disk channel backup state in response. This sub-system
+// that will ensure that the multi chan backup file on disk will always be
+// updated with the latest channel back up state. We'll receive new opened
+// channel and closed channel notifications from the channel notifier, and
+// re-pack the entire set of channels each time, as the multi-chan backup is
+// a single ciphertext.
+type SubSwapper struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	// backupState are the set of SCBs for all open channels we know of.
+	backupState map[wire.OutPoint]Single
+
+	chanSource   LiveChannelSource
+	addrSource   AddressSource
+	chanNotifier ChannelNotifier
+	keyRing      keychain.KeyRing
+	swapper      Swapper
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewSubSwapper creates a new instance of the SubSwapper given the sources of
+// our live channels and their peers' addresses, the channel notifier that
+// will tell us about channel changes, the key ring used to encrypt the
+// backups, and the Swapper that will persist them.
+func NewSubSwapper(chanSource LiveChannelSource, addrSource AddressSource,
+	chanNotifier ChannelNotifier, keyRing keychain.KeyRing,
+	backupSwapper Swapper) *SubSwapper {
+
+	return &SubSwapper{
+		backupState:  make(map[wire.OutPoint]Single),
+		chanSource:   chanSource,
+		addrSource:   addrSource,
+		chanNotifier: chanNotifier,
+		keyRing:      keyRing,
+		swapper:      backupSwapper,
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start starts the chanbackup.SubSwapper. Before returning, the on-disk
+// backup is brought up to date with the set of channels we currently know
+// of.
+func (s *SubSwapper) Start() error {
+	if !atomic.CompareAndSwapUint32(&s.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Starting chanbackup.SubSwapper")
+
+	// We'll subscribe to channel events before reading the initial set of
+	// channels, so that we won't miss any change happening in between.
+	// Re-applying an event for a channel already in the initial set is
+	// harmless.
+	chanEvents, err := s.chanNotifier.SubscribeChannelEvents()
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to channel events: %v",
+			err)
+	}
+
+	startingChans, err := FetchStaticChanBackups(
+		s.chanSource, s.addrSource,
+	)
+	if err != nil {
+		chanEvents.Cancel()
+		return fmt.Errorf("unable to obtain current channel "+
+			"backups: %v", err)
+	}
+	for _, chanBackup := range startingChans {
+		s.backupState[chanBackup.FundingOutpoint] = chanBackup
+	}
+
+	// Before we enter our main loop, we'll update the on-disk state with
+	// the latest Single state, as nodes may have new advertised addresses.
+	if err := s.updateBackupFile(); err != nil {
+		chanEvents.Cancel()
+		return fmt.Errorf("unable to update backup file: %v", err)
+	}
+
+	s.wg.Add(1)
+	go s.backupUpdater(chanEvents)
+
+	return nil
+}
+
+// Stop signals the SubSwapper to begin a graceful shutdown.
+func (s *SubSwapper) Stop() error {
+	if !atomic.CompareAndSwapUint32(&s.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Stopping chanbackup.SubSwapper")
+
+	close(s.quit)
+	s.wg.Wait()
+
+	return nil
+}
+
+// updateBackupFile updates the backup file in place given the current state
+// of the SubSwapper.
+func (s *SubSwapper) updateBackupFile() error {
+	// With our updated channel state obtained, we'll create a new multi
+	// from our series of singles.
+	var newMulti Multi
+	for _, backup := range s.backupState {
+		newMulti.StaticBackups = append(
+			newMulti.StaticBackups, backup,
+		)
+	}
+
+	// Now that our multi has been assembled, we'll attempt to pack
+	// (encrypt+encode) the new channel state to our target reader.
+	var b bytes.Buffer
+	err := newMulti.PackToWriter(&b, s.keyRing)
+	if err != nil {
+		return fmt.Errorf("unable to pack multi backup: %v", err)
+	}
+
+	// Finally, we'll swap out the old backup for this new one in a single
+	// atomic step.
+	err = s.swapper.UpdateAndSwap(PackedMulti(b.Bytes()))
+	if err != nil {
+		return fmt.Errorf("unable to update multi backup: %v", err)
+	}
+
+	return nil
+}
+
+// backupUpdater is the primary goroutine of the SubSwapper which is
+// responsible for listening for changes to the channel, and updating the
+// persistent multi backup state with a new packed multi of the latest channel
+// state.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *SubSwapper) backupUpdater(chanEvents *channelnotifier.Subscription) {
+	defer s.wg.Done()
+	defer chanEvents.Cancel()
+
+	log.Debugf("SubSwapper's backupUpdater is active!")
+
+	for {
+		select {
+		// The channel state has been modified! We'll evaluate all
+		// changes, and swap out the old packed multi with a new one
+		// with the latest channel state.
+		case e := <-chanEvents.Updates():
+			switch event := e.(type) {
+
+			// A new channel has been funded, or one of our pending
+			// channels has been confirmed. In both cases we'll
+			// (re-)assemble its backup, as its short channel ID
+			// might have changed.
+			case channelnotifier.PendingOpenChannelEvent:
+				s.addChannel(event.PendingChannel)
+
+			case channelnotifier.OpenChannelEvent:
+				s.addChannel(event.Channel)
+
+			// A channel has been closed, so its funds no longer
+			// need to be recovered from the backup.
+			case channelnotifier.ClosedChannelEvent:
+				chanPoint := event.CloseSummary.ChanPoint
+				log.Debugf("Removing channel backup for "+
+					"ChannelPoint(%v)", chanPoint)
+
+				delete(s.backupState, chanPoint)
+
+			// The remaining events don't affect the set of
+			// channels that we need to back up.
+			default:
+				continue
+			}
+
+			log.Infof("Updating on-disk multi SCB backup: "+
+				"num_chans=%v", len(s.backupState))
+
+			// With our new state updated, we'll now attempt to
+			// write it out to disk. If this fails, the previous
+			// backup remains in place, and we'll try again on the
+			// next channel update.
+			err := s.updateBackupFile()
+			if err != nil {
+				log.Errorf("unable to update backup file: %v",
+					err)
+			}
+
+		// The subscription was canceled as the channel notifier is
+		// shutting down.
+		case <-chanEvents.Quit():
+			return
+
+		// We've been signalled to exit, so we'll exit our loop.
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// addChannel assembles a static channel backup for the passed channel and
+// adds it to the backup state, replacing any prior backup of the channel.
+func (s *SubSwapper) addChannel(channel *channeldb.OpenChannel) {
+	chanBackup, err := assembleChanBackup(s.addrSource, channel)
+	if err != nil {
+		log.Errorf("Unable to assemble backup for ChannelPoint(%v): "+
+			"%v", channel.FundingOutpoint, err)
+		return
+	}
+
+	log.Debugf("Adding channel backup for ChannelPoint(%v)",
+		channel.FundingOutpoint)
+
+	s.backupState[channel.FundingOutpoint] = *chanBackup
+}
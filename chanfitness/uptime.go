@@ -0,0 +1,326 @@
+package chanfitness
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// DefaultFlushInterval is the default interval at which the uptime
+// accumulated in memory is persisted, bounding the amount of uptime data
+// lost if we shut down uncleanly.
+const DefaultFlushInterval = 10 * time.Minute
+
+// Config houses the dependencies of the UptimeTracker.
+type Config struct {
+	// AddUptime adds the given monitored lifetime and uptime to the totals
+	// persisted for a channel.
+	AddUptime func(chanPoint *wire.OutPoint, lifetime,
+		uptime time.Duration) error
+
+	// FetchUptime returns the totals persisted for a channel.
+	FetchUptime func(chanPoint *wire.OutPoint) (*channeldb.ChannelUptime,
+		error)
+
+	// FlushTicker signals when the uptime accumulated in memory should be
+	// persisted.
+	FlushTicker ticker.Ticker
+
+	// Now returns the current time. It's used to allow tests to control
+	// the passage of time.
+	Now func() time.Time
+}
+
+// PeerFlaps counts the number of times a peer went online or offline since
+// we started.
+type PeerFlaps struct {
+	// Count is the number of times the peer changed its online state.
+	Count int
+
+	// LastFlap is the time of the most recent change of the peer's online
+	// state.
+	LastFlap time.Time
+}
+
+// channelState is the in-memory state of a tracked channel, covering the
+// period since its uptime was last persisted.
+type channelState struct {
+	// peer is the public key of the remote peer of the channel.
+	peer [33]byte
+
+	// since is the start of the period that hasn't yet been persisted.
+	since time.Time
+
+	// onlineSince is the start of the period during which the peer has
+	// been online that hasn't yet been persisted. It's zero if the peer is
+	// currently offline.
+	onlineSince time.Time
+}
+
+// UptimeTracker tracks for how long each of our channels has been monitored,
+// and for how much of that time the remote peer of the channel was
+// connected. The totals are persisted, such that they accumulate across
+// restarts, and can be used to identify unreliable peers.
+type UptimeTracker struct {
+	started int32
+	stopped int32
+
+	cfg Config
+
+	mtx      sync.Mutex
+	channels map[wire.OutPoint]*channelState
+	online   map[[33]byte]struct{}
+	flaps    map[[33]byte]*PeerFlaps
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewUptimeTracker creates a new uptime tracker from the given config.
+func NewUptimeTracker(cfg Config) *UptimeTracker {
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	return &UptimeTracker{
+		cfg:      cfg,
+		channels: make(map[wire.OutPoint]*channelState),
+		online:   make(map[[33]byte]struct{}),
+		flaps:    make(map[[33]byte]*PeerFlaps),
+		quit:     make(chan struct{}),
+	}
+}
+
+// Start launches the goroutine that periodically persists the uptime
+// accumulated in memory.
+func (u *UptimeTracker) Start() error {
+	if !atomic.CompareAndSwapInt32(&u.started, 0, 1) {
+		return nil
+	}
+
+	log.Info("Uptime tracker starting")
+
+	u.cfg.FlushTicker.Resume()
+
+	u.wg.Add(1)
+	go u.flushLoop()
+
+	return nil
+}
+
+// Stop persists all uptime accumulated in memory, and stops the tracker.
+func (u *UptimeTracker) Stop() error {
+	if !atomic.CompareAndSwapInt32(&u.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Info("Uptime tracker shutting down")
+
+	close(u.quit)
+	u.wg.Wait()
+
+	u.cfg.FlushTicker.Stop()
+	u.flushAll()
+
+	return nil
+}
+
+// flushLoop periodically persists the uptime accumulated in memory.
+//
+// NOTE: This MUST be run as a goroutine.
+func (u *UptimeTracker) flushLoop() {
+	defer u.wg.Done()
+
+	for {
+		select {
+		case <-u.cfg.FlushTicker.Ticks():
+			u.flushAll()
+
+		case <-u.quit:
+			return
+		}
+	}
+}
+
+// AddChannel starts tracking the uptime of a channel with the given peer. If
+// the channel is already tracked, then this is a noop.
+func (u *UptimeTracker) AddChannel(chanPoint wire.OutPoint, peer [33]byte) {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	if _, ok := u.channels[chanPoint]; ok {
+		return
+	}
+
+	now := u.cfg.Now()
+	state := &channelState{
+		peer:  peer,
+		since: now,
+	}
+	if _, ok := u.online[peer]; ok {
+		state.onlineSince = now
+	}
+	u.channels[chanPoint] = state
+}
+
+// RemoveChannel persists the uptime accumulated for a channel, and stops
+// tracking it.
+func (u *UptimeTracker) RemoveChannel(chanPoint wire.OutPoint) {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	state, ok := u.channels[chanPoint]
+	if !ok {
+		return
+	}
+
+	u.flushChannel(chanPoint, state, u.cfg.Now())
+	delete(u.channels, chanPoint)
+}
+
+// PeerOnline marks the peer as connected, accruing uptime for all of its
+// channels.
+func (u *UptimeTracker) PeerOnline(peer [33]byte) {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	if _, ok := u.online[peer]; ok {
+		return
+	}
+	u.online[peer] = struct{}{}
+
+	now := u.cfg.Now()
+	u.recordFlap(peer, now)
+	for _, state := range u.channels {
+		if state.peer == peer {
+			state.onlineSince = now
+		}
+	}
+}
+
+// PeerOffline marks the peer as disconnected, persisting the uptime
+// accumulated for all of its channels.
+func (u *UptimeTracker) PeerOffline(peer [33]byte) {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	if _, ok := u.online[peer]; !ok {
+		return
+	}
+	delete(u.online, peer)
+
+	now := u.cfg.Now()
+	u.recordFlap(peer, now)
+	for chanPoint, state := range u.channels {
+		if state.peer != peer {
+			continue
+		}
+
+		u.flushChannel(chanPoint, state, now)
+		state.onlineSince = time.Time{}
+	}
+}
+
+// Uptime returns the total lifetime and uptime of a channel, including both
+// the persisted totals and the uptime accumulated in memory.
+func (u *UptimeTracker) Uptime(
+	chanPoint wire.OutPoint) (*channeldb.ChannelUptime, error) {
+
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	uptime, err := u.cfg.FetchUptime(&chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if state, ok := u.channels[chanPoint]; ok {
+		lifetime, online := state.pending(u.cfg.Now())
+		uptime.Lifetime += lifetime
+		uptime.Uptime += online
+	}
+
+	return uptime, nil
+}
+
+// Flaps returns the number of times the peer went online or offline since we
+// started, along with the time of the most recent change.
+func (u *UptimeTracker) Flaps(peer [33]byte) PeerFlaps {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	if flaps, ok := u.flaps[peer]; ok {
+		return *flaps
+	}
+
+	return PeerFlaps{}
+}
+
+// recordFlap notes that the peer changed its online state at the given time.
+//
+// NOTE: This MUST be called with the mutex held.
+func (u *UptimeTracker) recordFlap(peer [33]byte, now time.Time) {
+	flaps, ok := u.flaps[peer]
+	if !ok {
+		flaps = &PeerFlaps{}
+		u.flaps[peer] = flaps
+	}
+
+	flaps.Count++
+	flaps.LastFlap = now
+}
+
+// flushAll persists the uptime accumulated in memory for all channels.
+func (u *UptimeTracker) flushAll() {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	now := u.cfg.Now()
+	for chanPoint, state := range u.channels {
+		u.flushChannel(chanPoint, state, now)
+	}
+}
+
+// flushChannel persists the uptime accumulated in memory for a channel. If
+// we're unable to do so, then the uptime remains in memory, and will be
+// persisted on the next attempt.
+//
+// NOTE: This MUST be called with the mutex held.
+func (u *UptimeTracker) flushChannel(chanPoint wire.OutPoint,
+	state *channelState, now time.Time) {
+
+	lifetime, online := state.pending(now)
+	if lifetime == 0 {
+		return
+	}
+
+	if err := u.cfg.AddUptime(&chanPoint, lifetime, online); err != nil {
+		log.Errorf("Unable to persist uptime for ChannelPoint(%v): %v",
+			chanPoint, err)
+		return
+	}
+
+	state.since = now
+	if !state.onlineSince.IsZero() {
+		state.onlineSince = now
+	}
+}
+
+// pending returns the lifetime and uptime of the channel that haven't yet
+// been persisted.
+func (s *channelState) pending(now time.Time) (time.Duration,
+	time.Duration) {
+
+	lifetime := now.Sub(s.since)
+
+	var online time.Duration
+	if !s.onlineSince.IsZero() {
+		online = now.Sub(s.onlineSince)
+	}
+
+	return lifetime, online
+}
@@ -0,0 +1,151 @@
+package chanfitness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// mockUptimeStore is an in-memory store of channel uptime.
+type mockUptimeStore struct {
+	uptimes map[wire.OutPoint]channeldb.ChannelUptime
+}
+
+func (m *mockUptimeStore) add(chanPoint *wire.OutPoint, lifetime,
+	uptime time.Duration) error {
+
+	current := m.uptimes[*chanPoint]
+	current.Lifetime += lifetime
+	current.Uptime += uptime
+	m.uptimes[*chanPoint] = current
+
+	return nil
+}
+
+func (m *mockUptimeStore) fetch(
+	chanPoint *wire.OutPoint) (*channeldb.ChannelUptime, error) {
+
+	uptime := m.uptimes[*chanPoint]
+	return &uptime, nil
+}
+
+// TestUptimeTracker asserts that the tracker accrues uptime only while the
+// peer of a channel is online, and that the totals survive a restart.
+func TestUptimeTracker(t *testing.T) {
+	t.Parallel()
+
+	store := &mockUptimeStore{
+		uptimes: make(map[wire.OutPoint]channeldb.ChannelUptime),
+	}
+	now := time.Unix(1000, 0)
+	newTracker := func() *UptimeTracker {
+		return NewUptimeTracker(Config{
+			AddUptime:   store.add,
+			FetchUptime: store.fetch,
+			FlushTicker: ticker.New(time.Hour),
+			Now: func() time.Time {
+				return now
+			},
+		})
+	}
+
+	var peer [33]byte
+	peer[0] = 0x02
+	chanPoint := wire.OutPoint{Index: 1}
+
+	assertUptime := func(tracker *UptimeTracker, lifetime,
+		uptime time.Duration) {
+
+		t.Helper()
+
+		u, err := tracker.Uptime(chanPoint)
+		if err != nil {
+			t.Fatalf("unable to fetch uptime: %v", err)
+		}
+		if u.Lifetime != lifetime || u.Uptime != uptime {
+			t.Fatalf("expected lifetime=%v uptime=%v, got "+
+				"lifetime=%v uptime=%v", lifetime, uptime,
+				u.Lifetime, u.Uptime)
+		}
+	}
+
+	tracker := newTracker()
+	if err := tracker.Start(); err != nil {
+		t.Fatalf("unable to start tracker: %v", err)
+	}
+
+	// The channel is tracked while its peer is offline, so only its
+	// lifetime should increase.
+	tracker.AddChannel(chanPoint, peer)
+	now = now.Add(time.Minute)
+	assertUptime(tracker, time.Minute, 0)
+
+	// Once the peer comes online, uptime should accrue as well.
+	tracker.PeerOnline(peer)
+	now = now.Add(2 * time.Minute)
+	assertUptime(tracker, 3*time.Minute, 2*time.Minute)
+
+	tracker.PeerOffline(peer)
+	now = now.Add(time.Minute)
+	assertUptime(tracker, 4*time.Minute, 2*time.Minute)
+
+	// After a restart, the totals should be restored from the store, and
+	// continue to accumulate once the channel is tracked again.
+	if err := tracker.Stop(); err != nil {
+		t.Fatalf("unable to stop tracker: %v", err)
+	}
+	now = now.Add(time.Hour)
+
+	tracker = newTracker()
+	if err := tracker.Start(); err != nil {
+		t.Fatalf("unable to start tracker: %v", err)
+	}
+	defer tracker.Stop()
+
+	assertUptime(tracker, 4*time.Minute, 2*time.Minute)
+
+	tracker.PeerOnline(peer)
+	tracker.AddChannel(chanPoint, peer)
+	now = now.Add(time.Minute)
+	assertUptime(tracker, 5*time.Minute, 3*time.Minute)
+}
+
+// TestUptimeTrackerFlaps asserts that the tracker counts each change of a
+// peer's online state, ignoring repeated notifications of the same state.
+func TestUptimeTrackerFlaps(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	tracker := NewUptimeTracker(Config{
+		FlushTicker: ticker.New(time.Hour),
+		Now: func() time.Time {
+			return now
+		},
+	})
+
+	var peer [33]byte
+	peer[0] = 0x03
+
+	if flaps := tracker.Flaps(peer); flaps.Count != 0 {
+		t.Fatalf("expected no flaps, got %v", flaps.Count)
+	}
+
+	tracker.PeerOnline(peer)
+	now = now.Add(time.Minute)
+	tracker.PeerOnline(peer)
+	tracker.PeerOffline(peer)
+	now = now.Add(time.Minute)
+	tracker.PeerOffline(peer)
+
+	flaps := tracker.Flaps(peer)
+	if flaps.Count != 2 {
+		t.Fatalf("expected 2 flaps, got %v", flaps.Count)
+	}
+	if !flaps.LastFlap.Equal(time.Unix(1060, 0)) {
+		t.Fatalf("expected last flap at %v, got %v",
+			time.Unix(1060, 0), flaps.LastFlap)
+	}
+}
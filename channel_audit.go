@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/tv42/zbase32"
+)
+
+// channelAuditVersion is the version of the serialization of channel audit
+// snapshots.
+const channelAuditVersion = 0
+
+// channelAuditEntry describes the state of a single channel within a channel
+// audit snapshot. It only carries public information, such that the snapshot
+// can be freely handed to third parties.
+type channelAuditEntry struct {
+	ChannelPoint    string `json:"channel_point"`
+	ChanID          uint64 `json:"chan_id"`
+	RemotePubkey    string `json:"remote_pubkey"`
+	Capacity        int64  `json:"capacity"`
+	LocalBalance    int64  `json:"local_balance"`
+	RemoteBalance   int64  `json:"remote_balance"`
+	CommitHeight    uint64 `json:"commit_height"`
+	CommitTxid      string `json:"commit_txid"`
+	CommitFee       int64  `json:"commit_fee"`
+	NumPendingHTLCs uint32 `json:"num_pending_htlcs"`
+	PendingHTLCAmt  int64  `json:"pending_htlc_amt"`
+	Pending         bool   `json:"pending"`
+}
+
+// channelAudit is a snapshot of the balances and commitments of the open
+// channels of a node, at a given block. Once serialized, it's signed by the
+// identity key of the node, which allows auditors to attest the off-chain
+// funds of the node.
+type channelAudit struct {
+	Version           uint32              `json:"version"`
+	IdentityPubkey    string              `json:"identity_pubkey"`
+	BlockHeight       uint32              `json:"block_height"`
+	BlockHash         string              `json:"block_hash"`
+	Timestamp         int64               `json:"timestamp"`
+	TotalLocalBalance int64               `json:"total_local_balance"`
+	Channels          []channelAuditEntry `json:"channels"`
+}
+
+// newChannelAudit creates a snapshot of the passed channels, taken at the
+// given best block. The balances are those of the latest local commitment of
+// each channel.
+func newChannelAudit(identity *btcec.PublicKey,
+	channels []*channeldb.OpenChannel, bestHash *chainhash.Hash,
+	bestHeight int32, now time.Time) *channelAudit {
+
+	audit := &channelAudit{
+		Version: channelAuditVersion,
+		IdentityPubkey: hex.EncodeToString(
+			identity.SerializeCompressed(),
+		),
+		BlockHeight: uint32(bestHeight),
+		BlockHash:   bestHash.String(),
+		Timestamp:   now.Unix(),
+		Channels:    make([]channelAuditEntry, 0, len(channels)),
+	}
+
+	for _, channel := range channels {
+		localCommit := channel.LocalCommitment
+
+		var pendingHTLCAmt lnwire.MilliSatoshi
+		for _, htlc := range localCommit.Htlcs {
+			pendingHTLCAmt += htlc.Amt
+		}
+
+		entry := channelAuditEntry{
+			ChannelPoint: channel.FundingOutpoint.String(),
+			ChanID:       channel.ShortChanID().ToUint64(),
+			RemotePubkey: hex.EncodeToString(
+				channel.IdentityPub.SerializeCompressed(),
+			),
+			Capacity: int64(channel.Capacity),
+			LocalBalance: int64(
+				localCommit.LocalBalance.ToSatoshis(),
+			),
+			RemoteBalance: int64(
+				localCommit.RemoteBalance.ToSatoshis(),
+			),
+			CommitHeight:    localCommit.CommitHeight,
+			CommitFee:       int64(localCommit.CommitFee),
+			NumPendingHTLCs: uint32(len(localCommit.Htlcs)),
+			PendingHTLCAmt:  int64(pendingHTLCAmt.ToSatoshis()),
+			Pending:         channel.IsPending,
+		}
+		if localCommit.CommitTx != nil {
+			entry.CommitTxid = localCommit.CommitTx.TxHash().String()
+		}
+
+		audit.TotalLocalBalance += entry.LocalBalance
+		audit.Channels = append(audit.Channels, entry)
+	}
+
+	return audit
+}
+
+// sign serializes the snapshot, and signs it with the identity key of the
+// node. The signature is made in the same way as SignMessage does, so it can
+// be verified by any node through VerifyMessage. The serialized snapshot is
+// returned along with its zbase32 encoded signature.
+func (a *channelAudit) sign(signer *nodeSigner) ([]byte, string, error) {
+	snapshot, err := json.Marshal(a)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sig, err := signer.SignCompact(prefixSignedMsg(snapshot))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return snapshot, zbase32.EncodeToString(sig), nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/tv42/zbase32"
+)
+
+// TestChannelAuditSign asserts that a channel audit snapshot accounts for the
+// local commitment of each channel, and that its signature can be verified
+// against the identity key of the node like any signed message.
+func TestChannelAuditSign(t *testing.T) {
+	t.Parallel()
+
+	identityPriv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	remotePriv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	commitTx := wire.NewMsgTx(2)
+	commitTx.AddTxOut(&wire.TxOut{Value: 100000})
+
+	channels := []*channeldb.OpenChannel{
+		{
+			FundingOutpoint: wire.OutPoint{
+				Hash: chainhash.Hash{1},
+			},
+			ShortChannelID: lnwire.NewShortChanIDFromInt(1),
+			IdentityPub:    remotePriv.PubKey(),
+			Capacity:       200000,
+			LocalCommitment: channeldb.ChannelCommitment{
+				CommitHeight:  5,
+				LocalBalance:  100000 * 1000,
+				RemoteBalance: 90000 * 1000,
+				CommitFee:     9000,
+				CommitTx:      commitTx,
+				Htlcs: []channeldb.HTLC{
+					{Amt: 500 * 1000},
+					{Amt: 500 * 1000},
+				},
+			},
+		},
+		{
+			FundingOutpoint: wire.OutPoint{
+				Hash: chainhash.Hash{2},
+			},
+			IdentityPub: remotePriv.PubKey(),
+			Capacity:    50000,
+			IsPending:   true,
+			LocalCommitment: channeldb.ChannelCommitment{
+				LocalBalance: 41000 * 1000,
+				CommitFee:    9000,
+			},
+		},
+	}
+
+	audit := newChannelAudit(
+		identityPriv.PubKey(), channels, &chainhash.Hash{3}, 500,
+		time.Unix(1000, 0),
+	)
+	if audit.TotalLocalBalance != 141000 {
+		t.Fatalf("expected total local balance of 141000, got %v",
+			audit.TotalLocalBalance)
+	}
+	if len(audit.Channels) != 2 {
+		t.Fatalf("expected 2 channels, got %v", len(audit.Channels))
+	}
+	entry := audit.Channels[0]
+	if entry.NumPendingHTLCs != 2 || entry.PendingHTLCAmt != 1000 {
+		t.Fatalf("unexpected pending htlcs: %v, amount %v",
+			entry.NumPendingHTLCs, entry.PendingHTLCAmt)
+	}
+	if entry.CommitTxid != commitTx.TxHash().String() {
+		t.Fatalf("unexpected commit txid: %v", entry.CommitTxid)
+	}
+	if !audit.Channels[1].Pending {
+		t.Fatalf("expected second channel to be pending")
+	}
+
+	snapshot, sig, err := audit.sign(newNodeSigner(identityPriv))
+	if err != nil {
+		t.Fatalf("unable to sign audit: %v", err)
+	}
+
+	// The snapshot should decode to the audit that was signed.
+	var decoded channelAudit
+	if err := json.Unmarshal(snapshot, &decoded); err != nil {
+		t.Fatalf("unable to decode snapshot: %v", err)
+	}
+	if !reflect.DeepEqual(&decoded, audit) {
+		t.Fatalf("decoded snapshot mismatch: expected %v, got %v",
+			audit, decoded)
+	}
+
+	// Finally, the signature should be valid for the identity key of the
+	// node, in the same way VerifyMessage checks it.
+	sigBytes, err := zbase32.DecodeString(sig)
+	if err != nil {
+		t.Fatalf("unable to decode signature: %v", err)
+	}
+	msg := append([]byte(nil), signedMsgPrefix...)
+	digest := chainhash.DoubleHashB(append(msg, snapshot...))
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), sigBytes, digest)
+	if err != nil {
+		t.Fatalf("unable to recover pubkey: %v", err)
+	}
+	if !pubKey.IsEqual(identityPriv.PubKey()) {
+		t.Fatalf("signature not made by identity key")
+	}
+	if decoded.IdentityPubkey != hex.EncodeToString(
+		identityPriv.PubKey().SerializeCompressed()) {
+
+		t.Fatalf("unexpected identity pubkey: %v",
+			decoded.IdentityPubkey)
+	}
+}
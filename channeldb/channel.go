@@ -56,6 +56,11 @@ var (
 	// remote peer during a channel sync in case we have lost channel state.
 	dataLossCommitPointKey = []byte("data-loss-commit-point-key")
 
+	// pendingConstraintsKey stores the constraints bounding our HTLCs
+	// that we've agreed to upon the request of the remote peer, but that
+	// it has yet to commit to. They're only applied once it has.
+	pendingConstraintsKey = []byte("pending-constraints-key")
+
 	// commitDiffKey stores the current pending commitment state we've
 	// extended to the remote party (if any). Each time we propose a new
 	// state, we store the information necessary to reconstruct this state
@@ -100,6 +105,10 @@ var (
 	// ErrNoCommitPoint is returned when no data loss commit point is found
 	// in the database.
 	ErrNoCommitPoint = fmt.Errorf("no commit point found")
+
+	// ErrNoPendingConstraints is returned when no pending constraints are
+	// found in the database.
+	ErrNoPendingConstraints = fmt.Errorf("no pending constraints found")
 )
 
 // ChannelType is an enum-like type that describes one of several possible
@@ -308,9 +317,14 @@ var (
 	// LocalDataLoss indicates that we have lost channel state for this
 	// channel, and broadcasting our latest commitment might be considered
 	// a breach.
-	// TODO(halseh): actually enforce that we are not force closing such a
-	// channel.
 	LocalDataLoss ChannelStatus = 1 << 2
+
+	// Restored is a status flag that signals that the channel has been
+	// restored from a static channel backup, and doesn't have any of its
+	// commitment state. Such a channel can't be used for any off-chain
+	// updates, and only exists so that we're able to recover our settled
+	// funds once the remote party force closes it.
+	Restored ChannelStatus = 1 << 3
 )
 
 // String returns a human-readable representation of the ChannelStatus.
@@ -324,6 +338,8 @@ func (c ChannelStatus) String() string {
 		return "CommitmentBroadcasted"
 	case LocalDataLoss:
 		return "LocalDataLoss"
+	case Restored:
+		return "Restored"
 	default:
 		return fmt.Sprintf("Unknown(%08b)", c)
 	}
@@ -488,6 +504,19 @@ func (c *OpenChannel) ChanStatus() ChannelStatus {
 	return c.chanStatus
 }
 
+// HasChanStatus returns true if the channel has the given status flag set.
+func (c *OpenChannel) HasChanStatus(status ChannelStatus) bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.hasChanStatus(status)
+}
+
+// hasChanStatus is the unlocked version of HasChanStatus.
+func (c *OpenChannel) hasChanStatus(status ChannelStatus) bool {
+	return c.chanStatus&status == status
+}
+
 // RefreshShortChanID updates the in-memory short channel ID using the latest
 // value observed on disk.
 func (c *OpenChannel) RefreshShortChanID() error {
@@ -772,6 +801,139 @@ func (c *OpenChannel) putChanStatus(status ChannelStatus) error {
 	return nil
 }
 
+// UpdateConstraints replaces the constraints of both parties with the passed
+// ones, persisting them to disk, and clears any pending local constraints.
+// This is used when the constraints are renegotiated on a live channel,
+// rather than upon its creation.
+func (c *OpenChannel) UpdateConstraints(local,
+	remote ChannelConstraints) error {
+
+	c.Lock()
+	defer c.Unlock()
+
+	if err := c.Db.Update(func(tx *bbolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		channel.LocalChanCfg.ChannelConstraints = local
+		channel.RemoteChanCfg.ChannelConstraints = remote
+
+		if err := putChanInfo(chanBucket, channel); err != nil {
+			return err
+		}
+
+		if chanBucket.Get(pendingConstraintsKey) == nil {
+			return nil
+		}
+		return chanBucket.Delete(pendingConstraintsKey)
+	}); err != nil {
+		return err
+	}
+
+	// Update the in-memory representation to keep it in sync with the DB.
+	c.LocalChanCfg.ChannelConstraints = local
+	c.RemoteChanCfg.ChannelConstraints = remote
+
+	return nil
+}
+
+// PutPendingConstraints persists the constraints bounding our HTLCs that
+// we've agreed to upon the request of the remote peer, to be applied once it
+// has committed to them as well. Any previously pending constraints are
+// replaced.
+func (c *OpenChannel) PutPendingConstraints(local ChannelConstraints) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var b bytes.Buffer
+	err := WriteElements(&b,
+		local.DustLimit, local.MaxPendingAmount, local.ChanReserve,
+		local.MinHTLC, local.MaxAcceptedHtlcs,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Db.Update(func(tx *bbolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Put(pendingConstraintsKey, b.Bytes())
+	})
+}
+
+// PendingConstraints returns the local constraints stored by
+// PutPendingConstraints. If none are found, ErrNoPendingConstraints is
+// returned.
+func (c *OpenChannel) PendingConstraints() (*ChannelConstraints, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	var local ChannelConstraints
+	err := c.Db.View(func(tx *bbolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch err {
+		case nil:
+		case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+			return ErrNoPendingConstraints
+		default:
+			return err
+		}
+
+		bs := chanBucket.Get(pendingConstraintsKey)
+		if bs == nil {
+			return ErrNoPendingConstraints
+		}
+
+		return ReadElements(bytes.NewReader(bs),
+			&local.DustLimit, &local.MaxPendingAmount,
+			&local.ChanReserve, &local.MinHTLC,
+			&local.MaxAcceptedHtlcs,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &local, nil
+}
+
+// ClearPendingConstraints removes the local constraints stored by
+// PutPendingConstraints, as the remote peer didn't commit to them.
+func (c *OpenChannel) ClearPendingConstraints() error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.Db.Update(func(tx *bbolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if chanBucket.Get(pendingConstraintsKey) == nil {
+			return nil
+		}
+		return chanBucket.Delete(pendingConstraintsKey)
+	})
+}
+
 // putChannel serializes, and stores the current state of the channel in its
 // entirety.
 func putOpenChannel(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
@@ -2217,6 +2379,15 @@ func writeChanConfig(b io.Writer, c *ChannelConfig) error {
 	)
 }
 
+// fundingTxPresent returns true if the funding transaction of the channel is
+// stored along with its static information. This is only the case for single
+// funder channels that we initiated, unless they have been restored from a
+// backup, which doesn't carry the funding transaction.
+func fundingTxPresent(channel *OpenChannel) bool {
+	return channel.ChanType == SingleFunder && channel.IsInitiator &&
+		!channel.hasChanStatus(Restored)
+}
+
 func putChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 	var w bytes.Buffer
 	if err := WriteElements(&w,
@@ -2231,7 +2402,7 @@ func putChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 	}
 
 	// For single funder channels that we initiated, write the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	if fundingTxPresent(channel) {
 		if err := WriteElement(&w, channel.FundingTxn); err != nil {
 			return err
 		}
@@ -2279,6 +2450,12 @@ func putChanCommitment(chanBucket *bbolt.Bucket, c *ChannelCommitment,
 }
 
 func putChanCommitments(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
+	// If this is a restored channel, then we don't have any commitments to
+	// write.
+	if channel.hasChanStatus(Restored) {
+		return nil
+	}
+
 	err := putChanCommitment(chanBucket, &channel.LocalCommitment, true)
 	if err != nil {
 		return err
@@ -2341,7 +2518,7 @@ func fetchChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 	}
 
 	// For single funder channels that we initiated, read the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	if fundingTxPresent(channel) {
 		if err := ReadElement(r, &channel.FundingTxn); err != nil {
 			return err
 		}
@@ -2399,6 +2576,12 @@ func fetchChanCommitment(chanBucket *bbolt.Bucket, local bool) (ChannelCommitmen
 func fetchChanCommitments(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 	var err error
 
+	// If this is a restored channel, then we don't have any commitments to
+	// read.
+	if channel.hasChanStatus(Restored) {
+		return nil
+	}
+
 	channel.LocalCommitment, err = fetchChanCommitment(chanBucket, true)
 	if err != nil {
 		return err
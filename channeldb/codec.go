@@ -158,7 +158,7 @@ func WriteElement(w io.Writer, element interface{}) error {
 		}
 
 	case lnwire.Message:
-		if _, err := lnwire.WriteMessage(w, e, 0); err != nil {
+		if _, err := lnwire.WriteEmbeddedMessage(w, e, 0); err != nil {
 			return err
 		}
 
@@ -350,7 +350,7 @@ func ReadElement(r io.Reader, element interface{}) error {
 		*e = bytes
 
 	case *lnwire.Message:
-		msg, err := lnwire.ReadMessage(r, 0)
+		msg, err := lnwire.ReadEmbeddedMessage(r, 0)
 		if err != nil {
 			return err
 		}
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
@@ -137,6 +139,48 @@ func Open(dbPath string) (*DB, error) {
 	return chanDB, nil
 }
 
+// OpenReadOnly opens an existing channeldb in read-only mode, which allows a
+// replica of the database to be inspected without risking any modification to
+// it. As migrations can't be applied to a read-only database, an error is
+// returned if the database isn't already at the latest version.
+func OpenReadOnly(dbPath string) (*DB, error) {
+	path := filepath.Join(dbPath, dbName)
+	if !fileExists(path) {
+		return nil, ErrNoChanDBExists
+	}
+
+	bdb, err := bbolt.Open(path, dbFilePermission, &bbolt.Options{
+		ReadOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chanDB := &DB{
+		DB:     bdb,
+		dbPath: dbPath,
+	}
+
+	meta, err := chanDB.FetchMeta(nil)
+	if err != nil {
+		bdb.Close()
+		return nil, err
+	}
+
+	latestVersion := getLatestDBVersion(dbVersions)
+	switch {
+	case meta.DbVersionNumber > latestVersion:
+		bdb.Close()
+		return nil, ErrDBReversion
+
+	case meta.DbVersionNumber < latestVersion:
+		bdb.Close()
+		return nil, ErrDBNotMigrated
+	}
+
+	return chanDB, nil
+}
+
 // Path returns the file path to the channel database.
 func (d *DB) Path() string {
 	return d.dbPath
@@ -721,6 +765,110 @@ func (d *DB) MarkChanFullyClosed(chanPoint *wire.OutPoint) error {
 	})
 }
 
+// ArchiveClosedChannels compacts the close summaries of all channels that have
+// been fully closed and resolved. The state kept around to allow the remote
+// party to recover from data loss (their revocation points, our channel config
+// and our last channel reestablish message) is no longer needed once a channel
+// has been resolved, so it's dropped from the summary. If retention is
+// non-zero, the summaries of resolved channels that were closed at least
+// retention blocks before bestHeight are deleted altogether. The number of
+// summaries that were archived and deleted respectively is returned.
+func (d *DB) ArchiveClosedChannels(bestHeight, retention uint32) (int, int,
+	error) {
+
+	var numArchived, numDeleted int
+	err := d.Update(func(tx *bbolt.Tx) error {
+		numArchived, numDeleted = 0, 0
+
+		closedChanBucket := tx.Bucket(closedChannelBucket)
+		if closedChanBucket == nil {
+			return nil
+		}
+
+		// As the bucket can't be modified while we iterate over it,
+		// we'll first gather the summaries that need to be archived or
+		// deleted.
+		var (
+			toArchive []*ChannelCloseSummary
+			toDelete  [][]byte
+		)
+		err := closedChanBucket.ForEach(func(k, v []byte) error {
+			summary, err := deserializeCloseChannelSummary(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			// Channels that are still pending resolution need all
+			// of their state, so we'll leave them untouched.
+			if summary.IsPending {
+				return nil
+			}
+
+			if retention != 0 &&
+				summary.CloseHeight+retention <= bestHeight {
+
+				toDelete = append(toDelete, k)
+				return nil
+			}
+
+			// Summaries without the data loss recovery fields have
+			// already been archived.
+			if summary.RemoteCurrentRevocation == nil &&
+				summary.LastChanSyncMsg == nil {
+
+				return nil
+			}
+
+			toArchive = append(toArchive, summary)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range toDelete {
+			if err := closedChanBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		numDeleted = len(toDelete)
+
+		for _, summary := range toArchive {
+			summary.RemoteCurrentRevocation = nil
+			summary.RemoteNextRevocation = nil
+			summary.LocalChanConfig = ChannelConfig{}
+			summary.LastChanSyncMsg = nil
+
+			var chanPointBuf, summaryBuf bytes.Buffer
+			err := writeOutpoint(&chanPointBuf, &summary.ChanPoint)
+			if err != nil {
+				return err
+			}
+			err = serializeChannelCloseSummary(&summaryBuf, summary)
+			if err != nil {
+				return err
+			}
+
+			err = closedChanBucket.Put(
+				chanPointBuf.Bytes(), summaryBuf.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		numArchived = len(toArchive)
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return numArchived, numDeleted, nil
+}
+
 // pruneLinkNode determines whether we should garbage collect a link node from
 // the database due to no longer having any open channels with it. If there are
 // any left, then this acts as a no-op.
@@ -761,6 +909,130 @@ func (d *DB) PruneLinkNodes() error {
 	})
 }
 
+// AddrsForNode consults the graph and channel database for all addresses known
+// to the passed node public key. The addresses from both sources are merged,
+// with any duplicates removed.
+func (d *DB) AddrsForNode(nodePub *btcec.PublicKey) ([]net.Addr, error) {
+	var addrs []net.Addr
+
+	// We'll start with the addresses we've stored for the link node, which
+	// include those that we've used to connect to the peer in the past.
+	linkNode, err := d.FetchLinkNode(nodePub)
+	switch {
+	case err == ErrNodeNotFound || err == ErrLinkNodesNotFound:
+	case err != nil:
+		return nil, err
+	default:
+		addrs = append(addrs, linkNode.Addresses...)
+	}
+
+	// Next, we'll add any addresses that the node has advertised on the
+	// network, skipping those that we already know of.
+	graphNode, err := d.ChannelGraph().FetchLightningNode(nodePub)
+	switch {
+	case err == ErrGraphNodeNotFound || err == ErrGraphNodesNotFound:
+		return addrs, nil
+	case err != nil:
+		return nil, err
+	}
+
+	known := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		known[addr.String()] = struct{}{}
+	}
+	for _, addr := range graphNode.Addresses {
+		if _, ok := known[addr.String()]; ok {
+			continue
+		}
+		known[addr.String()] = struct{}{}
+
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
+// ChannelShell is a shell of a channel that is meant to be used for channel
+// recovery purposes. It contains a minimal OpenChannel instance along with
+// addresses for that target node.
+type ChannelShell struct {
+	// NodeAddrs the set of addresses that this node has known to be
+	// reachable at in the past.
+	NodeAddrs []net.Addr
+
+	// Chan is a shell of an OpenChannel, it contains only the items
+	// required to restore the channel on disk.
+	Chan *OpenChannel
+}
+
+// RestoreChannelShells is a method that allows the caller to reconstruct the
+// state of an OpenChannel from the ChannelShell. We'll attempt to write the
+// new channel to disk, and create a LinkNode instance with the passed node
+// addresses. The restored channels are marked with the Restored status, so
+// other sub-systems won't attempt to use them as regular channels. This
+// method is idempotent, so repeated calls with the same set of channel shells
+// won't modify the database after the initial call.
+func (d *DB) RestoreChannelShells(channelShells ...*ChannelShell) error {
+	return d.Update(func(tx *bbolt.Tx) error {
+		nodeInfoBucket, err := tx.CreateBucketIfNotExists(
+			nodeInfoBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, channelShell := range channelShells {
+			channel := channelShell.Chan
+
+			// If we already know of this channel, then we'll leave
+			// it untouched, as we may have more up to date state
+			// for it than the backup.
+			_, err := fetchChanBucket(
+				tx, channel.IdentityPub,
+				&channel.FundingOutpoint, channel.ChainHash,
+			)
+			switch err {
+			case nil:
+				continue
+			case ErrNoChanDBExists, ErrNoActiveChannels,
+				ErrChannelNotFound:
+			default:
+				return err
+			}
+
+			// Otherwise, we'll mark the channel as restored, and
+			// write it to disk.
+			channel.Db = d
+			channel.chanStatus |= Restored
+			if err := channel.fullSync(tx); err != nil {
+				return err
+			}
+
+			// Next, we'll create a LinkNode for the remote party,
+			// so we'll attempt to connect to it using the
+			// addresses from the backup. If one already exists,
+			// then we'll move on to the next channel.
+			nodePub := channel.IdentityPub.SerializeCompressed()
+			if nodeInfoBucket.Get(nodePub) != nil {
+				continue
+			}
+
+			linkNode := &LinkNode{
+				Network:     wire.MainNet,
+				IdentityPub: channel.IdentityPub,
+				LastSeen:    time.Now(),
+				Addresses:   channelShell.NodeAddrs,
+				db:          d,
+			}
+			if err := putLinkNode(nodeInfoBucket, linkNode); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 // syncVersions function is used for safe db version synchronization. It
 // applies migration functions to the current database and recovers the
 // previous state of db if at least one error/panic appeared during migration.
@@ -2,12 +2,18 @@ package channeldb
 
 import (
 	"io/ioutil"
+	"math/rand"
+	"net"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/shachain"
 )
 
 func TestOpenWithCreate(t *testing.T) {
@@ -37,6 +43,47 @@ func TestOpenWithCreate(t *testing.T) {
 	}
 }
 
+// TestOpenReadOnly asserts that an existing channeldb can be opened in
+// read-only mode, rejecting any attempt to modify it.
+func TestOpenReadOnly(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	// A database that doesn't exist yet can't be opened read-only, as it
+	// would need to be created.
+	dbPath := filepath.Join(tempDirName, "cdb")
+	if _, err := OpenReadOnly(dbPath); err != ErrNoChanDBExists {
+		t.Fatalf("expected ErrNoChanDBExists, got %v", err)
+	}
+
+	cdb, err := Open(dbPath)
+	if err != nil {
+		t.Fatalf("unable to create channeldb: %v", err)
+	}
+	if err := cdb.Close(); err != nil {
+		t.Fatalf("unable to close channeldb: %v", err)
+	}
+
+	cdb, err = OpenReadOnly(dbPath)
+	if err != nil {
+		t.Fatalf("unable to open channeldb read-only: %v", err)
+	}
+	defer cdb.Close()
+
+	// Reads should succeed, while writes should be rejected.
+	if _, err := cdb.FetchAllChannels(); err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if err := cdb.PutMeta(&Meta{}); err == nil {
+		t.Fatalf("expected write to read-only channeldb to fail")
+	}
+}
+
 // TestWipe tests that the database wipe operation completes successfully
 // and that the buckets are deleted. It also checks that attempts to fetch
 // information while the buckets are not set return the correct errors.
@@ -147,3 +194,269 @@ func TestFetchClosedChannelForID(t *testing.T) {
 		t.Fatalf("expected ErrClosedChannelNotFound, instead got: %v", err)
 	}
 }
+
+// TestArchiveClosedChannels asserts that the summaries of channels that have
+// been fully closed and resolved are compacted, and deleted after the
+// retention window, while channels pending close are left untouched.
+func TestArchiveClosedChannels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	// We'll create three closed channels: the first is still pending
+	// close, while the others have been resolved at different heights.
+	closeHeights := []uint32{100, 100, 200}
+	chanPoints := make([]wire.OutPoint, len(closeHeights))
+	for i, closeHeight := range closeHeights {
+		state.FundingOutpoint.Index = uint32(i)
+		chanPoints[i] = state.FundingOutpoint
+
+		if err := state.FullSync(); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+
+		closeSummary := &ChannelCloseSummary{
+			ChanPoint:   state.FundingOutpoint,
+			RemotePub:   state.IdentityPub,
+			CloseHeight: closeHeight,
+			IsPending:   true,
+		}
+		if err := state.CloseChannel(closeSummary); err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		err := cdb.MarkChanFullyClosed(&state.FundingOutpoint)
+		if err != nil {
+			t.Fatalf("unable to fully close channel: %v", err)
+		}
+	}
+
+	assertArchive := func(bestHeight, retention uint32, expArchived,
+		expDeleted int) {
+
+		t.Helper()
+
+		numArchived, numDeleted, err := cdb.ArchiveClosedChannels(
+			bestHeight, retention,
+		)
+		if err != nil {
+			t.Fatalf("unable to archive closed channels: %v", err)
+		}
+		if numArchived != expArchived {
+			t.Fatalf("expected %v archived channels, got %v",
+				expArchived, numArchived)
+		}
+		if numDeleted != expDeleted {
+			t.Fatalf("expected %v deleted channels, got %v",
+				expDeleted, numDeleted)
+		}
+	}
+
+	// Without a retention window, both resolved channels should be
+	// archived, and none should be deleted.
+	assertArchive(250, 0, 2, 0)
+
+	// The pending channel should still have its recovery state, while the
+	// resolved ones should have been compacted.
+	for i, chanPoint := range chanPoints {
+		summary, err := cdb.FetchClosedChannel(&chanPoint)
+		if err != nil {
+			t.Fatalf("unable to fetch closed channel: %v", err)
+		}
+
+		archived := summary.RemoteCurrentRevocation == nil
+		if archived != (i != 0) {
+			t.Fatalf("channel %v: expected archived=%v", i, i != 0)
+		}
+	}
+
+	// Archiving again should be a no-op.
+	assertArchive(250, 0, 0, 0)
+
+	// With a retention window of 100 blocks, only the resolved channel
+	// closed at height 100 should be deleted.
+	assertArchive(250, 100, 0, 1)
+
+	_, err = cdb.FetchClosedChannel(&chanPoints[1])
+	if err != ErrClosedChannelNotFound {
+		t.Fatalf("expected ErrClosedChannelNotFound, got %v", err)
+	}
+	for _, i := range []int{0, 2} {
+		if _, err := cdb.FetchClosedChannel(&chanPoints[i]); err != nil {
+			t.Fatalf("unable to fetch closed channel: %v", err)
+		}
+	}
+}
+
+// TestRestoreChannelShells tests that we're able to insert a partially backed
+// up channel into the database, and that the channel is properly
+// marked as restored, without any commitment state.
+func TestRestoreChannelShells(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// First, we'll make our channel shell, it will only have the minimal
+	// amount of information required for us to initiate the data loss
+	// protection feature.
+	channelShell := &ChannelShell{
+		NodeAddrs: []net.Addr{&net.TCPAddr{
+			IP:   net.ParseIP("10.0.0.1"),
+			Port: 9735,
+		}},
+		Chan: &OpenChannel{
+			ChanType:        SingleFunder,
+			ChainHash:       key,
+			FundingOutpoint: *testOutpoint,
+			ShortChannelID: lnwire.NewShortChanIDFromInt(
+				uint64(rand.Int63()),
+			),
+			IsInitiator: true,
+			IdentityPub: pubKey,
+			Capacity:    btcutil.Amount(10000),
+			LocalChanCfg: ChannelConfig{
+				MultiSigKey: keychain.KeyDescriptor{
+					PubKey: privKey.PubKey(),
+				},
+				RevocationBasePoint: keychain.KeyDescriptor{
+					PubKey: privKey.PubKey(),
+				},
+				PaymentBasePoint: keychain.KeyDescriptor{
+					PubKey: privKey.PubKey(),
+				},
+				DelayBasePoint: keychain.KeyDescriptor{
+					PubKey: privKey.PubKey(),
+				},
+				HtlcBasePoint: keychain.KeyDescriptor{
+					PubKey: privKey.PubKey(),
+				},
+			},
+			RemoteChanCfg: ChannelConfig{
+				MultiSigKey: keychain.KeyDescriptor{
+					PubKey: privKey.PubKey(),
+				},
+				RevocationBasePoint: keychain.KeyDescriptor{
+					PubKey: privKey.PubKey(),
+				},
+				PaymentBasePoint: keychain.KeyDescriptor{
+					PubKey: privKey.PubKey(),
+				},
+				DelayBasePoint: keychain.KeyDescriptor{
+					PubKey: privKey.PubKey(),
+				},
+				HtlcBasePoint: keychain.KeyDescriptor{
+					PubKey: privKey.PubKey(),
+				},
+			},
+			RemoteCurrentRevocation: pubKey,
+			RevocationProducer: shachain.NewRevocationProducer(
+				chainhash.Hash(key),
+			),
+			RevocationStore: shachain.NewRevocationStore(),
+		},
+	}
+
+	// With the channel shell constructed, we'll now insert it into the
+	// database with the restoration method. Doing so a second time should
+	// be a noop.
+	for i := 0; i < 2; i++ {
+		err := cdb.RestoreChannelShells(channelShell)
+		if err != nil {
+			t.Fatalf("unable to restore channel shell: %v", err)
+		}
+	}
+
+	// Now that the channel has been inserted, we'll attempt to query for
+	// it to ensure we can properly locate it via various means.
+	nodeChans, err := cdb.FetchOpenChannels(pubKey)
+	if err != nil {
+		t.Fatalf("unable find channel: %v", err)
+	}
+	if len(nodeChans) != 1 {
+		t.Fatalf("expected 1 channel, instead got %v", len(nodeChans))
+	}
+
+	// The channel should be marked as restored, and carry no commitment
+	// state or funding transaction.
+	restoredChan := nodeChans[0]
+	if !restoredChan.HasChanStatus(Restored) {
+		t.Fatalf("restored channel has wrong status: %v",
+			restoredChan.ChanStatus())
+	}
+	if restoredChan.LocalCommitment.CommitTx != nil ||
+		restoredChan.RemoteCommitment.CommitTx != nil {
+
+		t.Fatalf("restored channel shouldn't have commitments")
+	}
+	if restoredChan.FundingTxn != nil {
+		t.Fatalf("restored channel shouldn't have a funding txn")
+	}
+
+	// As the channel can't be used, it shouldn't be returned among the
+	// regular open channels, but rather as waiting to be closed.
+	openChans, err := cdb.FetchAllOpenChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	if len(openChans) != 0 {
+		t.Fatalf("expected no open channels, instead got %v",
+			len(openChans))
+	}
+	waitingCloseChans, err := cdb.FetchWaitingCloseChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch waiting close channels: %v", err)
+	}
+	if len(waitingCloseChans) != 1 {
+		t.Fatalf("expected 1 waiting close channel, instead got %v",
+			len(waitingCloseChans))
+	}
+
+	// We should be able to mark the channel with data loss, storing the
+	// commitment point of the remote party, without losing its restored
+	// status.
+	if err := restoredChan.MarkDataLoss(pubKey); err != nil {
+		t.Fatalf("unable to mark data loss: %v", err)
+	}
+	if !restoredChan.HasChanStatus(Restored | LocalDataLoss) {
+		t.Fatalf("restored channel has wrong status: %v",
+			restoredChan.ChanStatus())
+	}
+	commitPoint, err := restoredChan.DataLossCommitPoint()
+	if err != nil {
+		t.Fatalf("unable to fetch commit point: %v", err)
+	}
+	if !commitPoint.IsEqual(pubKey) {
+		t.Fatalf("wrong commit point returned")
+	}
+
+	// Finally, we'll ensure that a link node has been created for the
+	// remote party, with the addresses of the channel shell.
+	linkNode, err := cdb.FetchLinkNode(pubKey)
+	if err != nil {
+		t.Fatalf("unable to fetch link node: %v", err)
+	}
+	if len(linkNode.Addresses) != 1 ||
+		linkNode.Addresses[0].String() != channelShell.NodeAddrs[0].String() {
+
+		t.Fatalf("link node has wrong addresses: %v",
+			linkNode.Addresses)
+	}
+}
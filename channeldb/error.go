@@ -11,6 +11,11 @@ var (
 	// prior database version.
 	ErrDBReversion = fmt.Errorf("channel db cannot revert to prior version")
 
+	// ErrDBNotMigrated is returned when opening a database read-only that
+	// still requires migrations to be applied.
+	ErrDBNotMigrated = fmt.Errorf("channel db requires migrations, " +
+		"which can't be applied to a read-only db")
+
 	// ErrLinkNodesNotFound is returned when node info bucket hasn't been
 	// created.
 	ErrLinkNodesNotFound = fmt.Errorf("no link nodes exist")
@@ -39,6 +44,14 @@ var (
 	// created.
 	ErrNoPaymentsCreated = fmt.Errorf("there are no existing payments")
 
+	// ErrPaymentNotFound is returned when a payment with the target
+	// payment hash can't be found.
+	ErrPaymentNotFound = fmt.Errorf("payment with payment hash not found")
+
+	// ErrPaymentInFlight is returned when attempting to delete a payment
+	// that is still in flight.
+	ErrPaymentInFlight = fmt.Errorf("payment is still in flight")
+
 	// ErrNodeNotFound is returned when node bucket exists, but node with
 	// specific identity can't be found.
 	ErrNodeNotFound = fmt.Errorf("link node with target identity not found")
@@ -0,0 +1,139 @@
+package channeldb
+
+import (
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// forwardFailuresBucket is the name of the bucket that stores the
+	// number of forwards that failed over each outgoing channel, broken
+	// down by cause, keyed by the short channel ID of the channel.
+	forwardFailuresBucket = []byte("forward-failures")
+)
+
+// ForwardFailureCounts tallies the reasons for which HTLCs we were asked to
+// forward over a channel failed. Operators can use these to decide whether a
+// channel should be rebalanced, have its fees adjusted, or be closed.
+type ForwardFailureCounts struct {
+	// Policy is the number of forwards that violated our forwarding
+	// policy for the channel, such as its fees or time lock delta.
+	Policy uint64
+
+	// Downstream is the number of forwards that we added to the channel,
+	// but that were failed by the remote peer or a node further along the
+	// route.
+	Downstream uint64
+
+	// InsufficientBalance is the number of forwards that failed as our
+	// local balance in the channel couldn't carry them.
+	InsufficientBalance uint64
+
+	// LinkOffline is the number of forwards that failed as the channel
+	// wasn't online.
+	LinkOffline uint64
+}
+
+// Add adds the given counts to the receiver.
+func (c *ForwardFailureCounts) Add(other *ForwardFailureCounts) {
+	c.Policy += other.Policy
+	c.Downstream += other.Downstream
+	c.InsufficientBalance += other.InsufficientBalance
+	c.LinkOffline += other.LinkOffline
+}
+
+// AddForwardFailures adds the given failure counts to the totals accumulated
+// for each of the target channels.
+func (d *DB) AddForwardFailures(
+	counts map[lnwire.ShortChannelID]*ForwardFailureCounts) error {
+
+	if len(counts) == 0 {
+		return nil
+	}
+
+	return d.Batch(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(forwardFailuresBucket)
+		if err != nil {
+			return err
+		}
+
+		for chanID, count := range counts {
+			var key [8]byte
+			byteOrder.PutUint64(key[:], chanID.ToUint64())
+
+			var current ForwardFailureCounts
+			if v := bucket.Get(key[:]); v != nil {
+				current = deserializeForwardFailures(v)
+			}
+			current.Add(count)
+
+			err := bucket.Put(
+				key[:], serializeForwardFailures(&current),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchForwardFailures returns the failure counts accumulated for all
+// channels, keyed by their short channel ID. Channels that haven't seen any
+// failed forwards are omitted.
+func (d *DB) FetchForwardFailures() (
+	map[lnwire.ShortChannelID]ForwardFailureCounts, error) {
+
+	counts := make(map[lnwire.ShortChannelID]ForwardFailureCounts)
+	err := d.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(forwardFailuresBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(k) != 8 {
+				return nil
+			}
+
+			chanID := lnwire.NewShortChanIDFromInt(
+				byteOrder.Uint64(k),
+			)
+			counts[chanID] = deserializeForwardFailures(v)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// serializeForwardFailures encodes each of the counters as a uint64.
+func serializeForwardFailures(c *ForwardFailureCounts) []byte {
+	var b [32]byte
+	byteOrder.PutUint64(b[:8], c.Policy)
+	byteOrder.PutUint64(b[8:16], c.Downstream)
+	byteOrder.PutUint64(b[16:24], c.InsufficientBalance)
+	byteOrder.PutUint64(b[24:], c.LinkOffline)
+
+	return b[:]
+}
+
+// deserializeForwardFailures decodes the counters previously encoded by
+// serializeForwardFailures.
+func deserializeForwardFailures(b []byte) ForwardFailureCounts {
+	if len(b) < 32 {
+		return ForwardFailureCounts{}
+	}
+
+	return ForwardFailureCounts{
+		Policy:              byteOrder.Uint64(b[:8]),
+		Downstream:          byteOrder.Uint64(b[8:16]),
+		InsufficientBalance: byteOrder.Uint64(b[16:24]),
+		LinkOffline:         byteOrder.Uint64(b[24:32]),
+	}
+}
@@ -0,0 +1,63 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestForwardFailures asserts that the failure counts added for each channel
+// accumulate across calls.
+func TestForwardFailures(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	counts, err := cdb.FetchForwardFailures()
+	if err != nil {
+		t.Fatalf("unable to fetch failures: %v", err)
+	}
+	if len(counts) != 0 {
+		t.Fatalf("expected no failures, got %v", counts)
+	}
+
+	chan1 := lnwire.NewShortChanIDFromInt(1)
+	chan2 := lnwire.NewShortChanIDFromInt(2)
+
+	err = cdb.AddForwardFailures(
+		map[lnwire.ShortChannelID]*ForwardFailureCounts{
+			chan1: {Policy: 1, Downstream: 2},
+			chan2: {LinkOffline: 3},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to add failures: %v", err)
+	}
+	err = cdb.AddForwardFailures(
+		map[lnwire.ShortChannelID]*ForwardFailureCounts{
+			chan1: {Policy: 1, InsufficientBalance: 4},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to add failures: %v", err)
+	}
+
+	counts, err = cdb.FetchForwardFailures()
+	if err != nil {
+		t.Fatalf("unable to fetch failures: %v", err)
+	}
+
+	expected := map[lnwire.ShortChannelID]ForwardFailureCounts{
+		chan1: {Policy: 2, Downstream: 2, InsufficientBalance: 4},
+		chan2: {LinkOffline: 3},
+	}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Fatalf("unexpected failures: expected %v, got %v", expected,
+			counts)
+	}
+}
@@ -2426,6 +2426,11 @@ type ChannelEdgePolicy struct {
 	// in millisatoshi.
 	MinHTLC lnwire.MilliSatoshi
 
+	// MaxHTLC is the largest value HTLC this node will forward, expressed
+	// in millisatoshi. It's only set if the ChanUpdateOptionMaxHtlc bit is
+	// set within Flags.
+	MaxHTLC lnwire.MilliSatoshi
+
 	// FeeBaseMSat is the base HTLC fee that will be charged for forwarding
 	// ANY HTLC, expressed in mSAT's.
 	FeeBaseMSat lnwire.MilliSatoshi
@@ -3197,10 +3202,23 @@ func putChanEdgePolicy(edges, nodes *bbolt.Bucket, edge *ChannelEdgePolicy,
 		return err
 	}
 
+	// If the max HTLC field is present, it's stored ahead of the extra
+	// opaque data, such that policies serialized before the field existed
+	// remain readable.
+	var opaqueBuf bytes.Buffer
+	if edge.Flags&lnwire.ChanUpdateOptionMaxHtlc != 0 {
+		err := binary.Write(&opaqueBuf, byteOrder, uint64(edge.MaxHTLC))
+		if err != nil {
+			return err
+		}
+	}
 	if len(edge.ExtraOpaqueData) > MaxAllowedExtraOpaqueBytes {
 		return ErrTooManyExtraOpaqueBytes(len(edge.ExtraOpaqueData))
 	}
-	if err := wire.WriteVarBytes(&b, 0, edge.ExtraOpaqueData); err != nil {
+	if _, err := opaqueBuf.Write(edge.ExtraOpaqueData); err != nil {
+		return err
+	}
+	if err := wire.WriteVarBytes(&b, 0, opaqueBuf.Bytes()); err != nil {
 		return err
 	}
 
@@ -3391,7 +3409,7 @@ func deserializeChanEdgePolicy(r io.Reader,
 	// We'll try and see if there are any opaque bytes left, if not, then
 	// we'll ignore the EOF error and return the edge as is.
 	edge.ExtraOpaqueData, err = wire.ReadVarBytes(
-		r, 0, MaxAllowedExtraOpaqueBytes, "blob",
+		r, 0, MaxAllowedExtraOpaqueBytes+8, "blob",
 	)
 	switch {
 	case err == io.ErrUnexpectedEOF:
@@ -3400,6 +3418,19 @@ func deserializeChanEdgePolicy(r io.Reader,
 		return nil, err
 	}
 
+	// If the max HTLC field is present, it's stored ahead of the extra
+	// opaque data.
+	if edge.Flags&lnwire.ChanUpdateOptionMaxHtlc != 0 {
+		if len(edge.ExtraOpaqueData) < 8 {
+			return nil, fmt.Errorf("edge policy of channel %v "+
+				"is missing its max htlc", edge.ChannelID)
+		}
+		edge.MaxHTLC = lnwire.MilliSatoshi(
+			byteOrder.Uint64(edge.ExtraOpaqueData[:8]),
+		)
+		edge.ExtraOpaqueData = edge.ExtraOpaqueData[8:]
+	}
+
 	edge.Node = &node
 	return edge, nil
 }
@@ -0,0 +1,183 @@
+package channeldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// htlcResolutionBucket is the name of the bucket that stores the final
+	// on-chain resolutions of HTLCs, keyed by short channel ID, direction
+	// and HTLC index.
+	htlcResolutionBucket = []byte("htlc-resolutions")
+
+	// ErrHtlcResolutionNotFound is returned when no resolution has been
+	// recorded for the target HTLC.
+	ErrHtlcResolutionNotFound = errors.New("htlc resolution not found")
+)
+
+// htlcResolutionKeyLen is the length of the key of a stored HTLC resolution:
+// the short channel ID, a byte for the direction of the HTLC, and the HTLC
+// index.
+const htlcResolutionKeyLen = 8 + 1 + 8
+
+// HtlcOutcome describes how an HTLC that went to chain was finally resolved.
+type HtlcOutcome uint8
+
+const (
+	// HtlcOutcomeSettled denotes an HTLC that was claimed on-chain with
+	// its preimage, either by us or by the remote party.
+	HtlcOutcomeSettled HtlcOutcome = 0
+
+	// HtlcOutcomeTimedOut denotes an HTLC that expired, and was claimed
+	// back on-chain by its sender.
+	HtlcOutcomeTimedOut HtlcOutcome = 1
+)
+
+// String returns a human readable version of the outcome.
+func (o HtlcOutcome) String() string {
+	switch o {
+	case HtlcOutcomeSettled:
+		return "Settled"
+
+	case HtlcOutcomeTimedOut:
+		return "TimedOut"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// HtlcResolution is a report of the final on-chain resolution of an HTLC.
+type HtlcResolution struct {
+	// ShortChanID is the short channel ID of the channel the HTLC was
+	// part of.
+	ShortChanID lnwire.ShortChannelID
+
+	// HtlcIndex is the index of the HTLC within the update log of its
+	// direction.
+	HtlcIndex uint64
+
+	// Incoming is true if the HTLC was extended to us by the remote
+	// party.
+	Incoming bool
+
+	// Outcome is how the HTLC was finally resolved.
+	Outcome HtlcOutcome
+
+	// Outpoint is the outpoint of the output that resolved the HTLC on
+	// our side: the HTLC output on the commitment transaction, or the
+	// output of the second-level transaction if the HTLC was on our
+	// commitment.
+	Outpoint wire.OutPoint
+
+	// AmountRecovered is the amount that was swept back to our wallet
+	// while resolving the HTLC. It is zero if the HTLC was claimed by the
+	// remote party.
+	AmountRecovered btcutil.Amount
+}
+
+// htlcResolutionKey returns the key under which the resolution of the target
+// HTLC is stored.
+func htlcResolutionKey(chanID lnwire.ShortChannelID, htlcIndex uint64,
+	incoming bool) []byte {
+
+	var key [htlcResolutionKeyLen]byte
+	byteOrder.PutUint64(key[:8], chanID.ToUint64())
+	if incoming {
+		key[8] = 1
+	}
+	byteOrder.PutUint64(key[9:], htlcIndex)
+
+	return key[:]
+}
+
+// PutHtlcResolution records the final on-chain resolution of an HTLC,
+// replacing any resolution previously recorded for it.
+func (d *DB) PutHtlcResolution(res *HtlcResolution) error {
+	var b bytes.Buffer
+	if err := serializeHtlcResolution(&b, res); err != nil {
+		return err
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(htlcResolutionBucket)
+		if err != nil {
+			return err
+		}
+
+		key := htlcResolutionKey(
+			res.ShortChanID, res.HtlcIndex, res.Incoming,
+		)
+		return bucket.Put(key, b.Bytes())
+	})
+}
+
+// LookupHtlcResolution returns the final on-chain resolution of the target
+// HTLC. If none has been recorded, ErrHtlcResolutionNotFound is returned.
+func (d *DB) LookupHtlcResolution(chanID lnwire.ShortChannelID,
+	htlcIndex uint64, incoming bool) (*HtlcResolution, error) {
+
+	var res *HtlcResolution
+	err := d.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(htlcResolutionBucket)
+		if bucket == nil {
+			return ErrHtlcResolutionNotFound
+		}
+
+		key := htlcResolutionKey(chanID, htlcIndex, incoming)
+		resBytes := bucket.Get(key)
+		if resBytes == nil {
+			return ErrHtlcResolutionNotFound
+		}
+
+		var err error
+		res, err = deserializeHtlcResolution(bytes.NewReader(resBytes))
+		if err != nil {
+			return err
+		}
+
+		res.ShortChanID = chanID
+		res.HtlcIndex = htlcIndex
+		res.Incoming = incoming
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// serializeHtlcResolution writes the value of an HTLC resolution to w. The
+// fields that are part of the key aren't repeated.
+func serializeHtlcResolution(w io.Writer, res *HtlcResolution) error {
+	if err := binary.Write(w, byteOrder, res.Outcome); err != nil {
+		return err
+	}
+
+	return WriteElements(w, res.Outpoint, res.AmountRecovered)
+}
+
+// deserializeHtlcResolution reads the value of an HTLC resolution from r.
+func deserializeHtlcResolution(r io.Reader) (*HtlcResolution, error) {
+	res := &HtlcResolution{}
+	if err := binary.Read(r, byteOrder, &res.Outcome); err != nil {
+		return nil, err
+	}
+
+	err := ReadElements(r, &res.Outpoint, &res.AmountRecovered)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
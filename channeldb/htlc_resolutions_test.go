@@ -0,0 +1,76 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestHtlcResolutions asserts that recorded HTLC resolutions can be looked up
+// by channel, direction and HTLC index.
+func TestHtlcResolutions(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	chanID := lnwire.NewShortChanIDFromInt(1234)
+
+	_, err = cdb.LookupHtlcResolution(chanID, 1, false)
+	if err != ErrHtlcResolutionNotFound {
+		t.Fatalf("expected ErrHtlcResolutionNotFound, got %v", err)
+	}
+
+	outgoing := &HtlcResolution{
+		ShortChanID: chanID,
+		HtlcIndex:   1,
+		Outcome:     HtlcOutcomeTimedOut,
+		Outpoint: wire.OutPoint{
+			Hash:  chainhash.Hash{1},
+			Index: 2,
+		},
+		AmountRecovered: 50000,
+	}
+	incoming := &HtlcResolution{
+		ShortChanID: chanID,
+		HtlcIndex:   1,
+		Incoming:    true,
+		Outcome:     HtlcOutcomeSettled,
+		Outpoint: wire.OutPoint{
+			Hash:  chainhash.Hash{3},
+			Index: 4,
+		},
+	}
+	if err := cdb.PutHtlcResolution(outgoing); err != nil {
+		t.Fatalf("unable to put resolution: %v", err)
+	}
+	if err := cdb.PutHtlcResolution(incoming); err != nil {
+		t.Fatalf("unable to put resolution: %v", err)
+	}
+
+	// The incoming and outgoing HTLCs share the same index, but must be
+	// stored separately.
+	for _, expected := range []*HtlcResolution{outgoing, incoming} {
+		res, err := cdb.LookupHtlcResolution(
+			chanID, expected.HtlcIndex, expected.Incoming,
+		)
+		if err != nil {
+			t.Fatalf("unable to lookup resolution: %v", err)
+		}
+		if !reflect.DeepEqual(res, expected) {
+			t.Fatalf("expected resolution %v, got %v", expected,
+				res)
+		}
+	}
+
+	_, err = cdb.LookupHtlcResolution(chanID, 2, false)
+	if err != ErrHtlcResolutionNotFound {
+		t.Fatalf("expected ErrHtlcResolutionNotFound, got %v", err)
+	}
+}
@@ -28,6 +28,7 @@ func randInvoice(value lnwire.MilliSatoshi) (*Invoice, error) {
 	}
 	i.Memo = []byte("memo")
 	i.Receipt = []byte("receipt")
+	i.Metadata = []byte("metadata")
 
 	// Create a random byte slice of MaxPaymentRequestSize bytes to be used
 	// as a dummy paymentrequest, and  determine if it should be set based
@@ -535,6 +536,16 @@ func TestQueryInvoices(t *testing.T) {
 			},
 			expected: invoices[numInvoices-1:],
 		},
+		// Same as above, but with an offset well beyond the last
+		// invoice. We still expect the last invoice to be returned.
+		{
+			query: InvoiceQuery{
+				IndexOffset:    numInvoices * 2,
+				Reversed:       true,
+				NumMaxInvoices: 1,
+			},
+			expected: invoices[numInvoices-1:],
+		},
 		// Same as above, at offset numInvoices.
 		{
 			query: InvoiceQuery{
@@ -72,6 +72,10 @@ const (
 	// TODO(halseth): determine the max length payment request when field
 	// lengths are final.
 	MaxPaymentRequestSize = 4096
+
+	// MaxMetadataSize is the maximum size of the opaque metadata stored
+	// along side incoming/outgoing invoices.
+	MaxMetadataSize = 1024
 )
 
 // ContractTerm is a companion struct to the Invoice struct. This struct houses
@@ -155,6 +159,11 @@ type Invoice struct {
 	// that the invoice originally didn't specify an amount, or the sender
 	// overpaid.
 	AmtPaid lnwire.MilliSatoshi
+
+	// Metadata is optional opaque data attached by the creator of the
+	// invoice or payment, e.g. to correlate it with an order within
+	// merchant software.
+	Metadata []byte
 }
 
 func validateInvoice(i *Invoice) error {
@@ -172,6 +181,10 @@ func validateInvoice(i *Invoice) error {
 			"provided was %v", MaxPaymentRequestSize,
 			len(i.PaymentRequest))
 	}
+	if len(i.Metadata) > MaxMetadataSize {
+		return fmt.Errorf("max length of metadata is %v, length "+
+			"provided was %v", MaxMetadataSize, len(i.Metadata))
+	}
 	return nil
 }
 
@@ -375,7 +388,7 @@ func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 			}
 
 			invoiceReader := bytes.NewReader(v)
-			invoice, err := deserializeInvoice(invoiceReader)
+			invoice, err := deserializeInvoiceRecord(invoiceReader)
 			if err != nil {
 				return err
 			}
@@ -504,9 +517,18 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 				return nil
 
 			// Otherwise we start iteration at the invoice prior to
-			// the offset.
+			// the offset. If the offset lies beyond the last
+			// invoice, there's nothing to seek past, so we start
+			// from the last invoice instead.
 			default:
-				invoiceKey = keyForIndex(c, q.IndexOffset-1)
+				var keyIndex [8]byte
+				byteOrder.PutUint64(keyIndex[:], q.IndexOffset)
+				indexKey, _ := c.Seek(keyIndex[:])
+				if indexKey == nil {
+					_, invoiceKey = c.Last()
+				} else {
+					_, invoiceKey = c.Prev()
+				}
 			}
 		}
 
@@ -723,7 +745,7 @@ func putInvoice(invoices, invoiceIndex, addIndex *bbolt.Bucket,
 
 	// Finally, serialize the invoice itself to be written to the disk.
 	var buf bytes.Buffer
-	if err := serializeInvoice(&buf, i); err != nil {
+	if err := serializeInvoiceRecord(&buf, i); err != nil {
 		return 0, nil
 	}
 
@@ -790,6 +812,40 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 	return nil
 }
 
+// serializeInvoiceRecord serializes an invoice as it's stored within the
+// invoice bucket. The metadata is appended to the end of the record, as
+// invoices written by older versions don't carry it.
+func serializeInvoiceRecord(w io.Writer, i *Invoice) error {
+	if err := serializeInvoice(w, i); err != nil {
+		return err
+	}
+
+	return serializeMetadata(w, i.Metadata)
+}
+
+// serializeMetadata writes out the opaque metadata of an invoice or payment.
+func serializeMetadata(w io.Writer, metadata []byte) error {
+	return wire.WriteVarBytes(w, 0, metadata)
+}
+
+// deserializeMetadata reads the opaque metadata of an invoice or payment. As
+// the metadata is always at the end of a record, records written before it
+// was introduced are detected by reaching the end of the reader, in which case
+// no metadata is returned.
+func deserializeMetadata(r io.Reader) ([]byte, error) {
+	metadata, err := wire.ReadVarBytes(r, 0, MaxMetadataSize, "metadata")
+	switch {
+	case err == io.EOF:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case len(metadata) == 0:
+		return nil, nil
+	}
+
+	return metadata, nil
+}
+
 func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
 	invoiceBytes := invoices.Get(invoiceNum)
 	if invoiceBytes == nil {
@@ -798,7 +854,7 @@ func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
 
 	invoiceReader := bytes.NewReader(invoiceBytes)
 
-	return deserializeInvoice(invoiceReader)
+	return deserializeInvoiceRecord(invoiceReader)
 }
 
 func deserializeInvoice(r io.Reader) (Invoice, error) {
@@ -862,6 +918,22 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 	return invoice, nil
 }
 
+// deserializeInvoiceRecord deserializes an invoice as it's stored within the
+// invoice bucket, along with its metadata.
+func deserializeInvoiceRecord(r io.Reader) (Invoice, error) {
+	invoice, err := deserializeInvoice(r)
+	if err != nil {
+		return invoice, err
+	}
+
+	invoice.Metadata, err = deserializeMetadata(r)
+	if err != nil {
+		return invoice, err
+	}
+
+	return invoice, nil
+}
+
 func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
 	amtPaid lnwire.MilliSatoshi) (*Invoice, error) {
 
@@ -896,7 +968,7 @@ func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
 	invoice.SettleIndex = nextSettleSeqNo
 
 	var buf bytes.Buffer
-	if err := serializeInvoice(&buf, &invoice); err != nil {
+	if err := serializeInvoiceRecord(&buf, &invoice); err != nil {
 		return nil, err
 	}
 
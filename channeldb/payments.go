@@ -2,6 +2,7 @@ package channeldb
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"io"
@@ -22,6 +23,14 @@ var (
 	// paymentStatusBucket is the name of the bucket within the database that
 	// stores the status of a payment indexed by the payment's preimage.
 	paymentStatusBucket = []byte("payment-status")
+
+	// paymentIndexBucket is the name of the bucket within the database
+	// that maps the payment hash of each payment to its key within the
+	// payments bucket. It allows a payment that was recorded before being
+	// dispatched to be updated once it completes. Payments written before
+	// this index was introduced aren't indexed, which is fine as they're
+	// all completed and never updated.
+	paymentIndexBucket = []byte("payment-index")
 )
 
 // PaymentStatus represent current status of payment
@@ -77,12 +86,16 @@ func (ps PaymentStatus) String() string {
 	}
 }
 
-// OutgoingPayment represents a successful payment between the daemon and a
-// remote node. Details such as the total fee paid, and the time of the payment
-// are stored.
+// OutgoingPayment represents a payment between the daemon and a remote node.
+// Details such as the total fee paid, and the time of the payment are stored.
+// A payment is first recorded as it's dispatched, and updated with its route
+// and preimage once it succeeds.
 type OutgoingPayment struct {
 	Invoice
 
+	// PaymentHash is the hash the payment is locked to.
+	PaymentHash [32]byte
+
 	// Fee is the total fee paid for the payment in milli-satoshis.
 	Fee lnwire.MilliSatoshi
 
@@ -98,32 +111,91 @@ type OutgoingPayment struct {
 	// PaymentPreimage is the preImage of a successful payment. This is used
 	// to calculate the PaymentHash as well as serve as a proof of payment.
 	PaymentPreimage [32]byte
+
+	// Status is the current status of the payment, as tracked by the
+	// control tower of the switch. It isn't serialized along with the
+	// payment, but populated when the payment is fetched.
+	Status PaymentStatus
+
+	// SequenceNum is the key of the payment within the payments bucket,
+	// which increases monotonically as payments are recorded. It isn't
+	// serialized along with the payment, but populated when the payment is
+	// fetched.
+	//
+	// NOTE: This index starts at 1.
+	SequenceNum uint64
+}
+
+// InitPayment records a payment that is about to be dispatched, so that it's
+// kept track of even if it never succeeds. If a payment with the same payment
+// hash already succeeded, this is a no-op, as it won't be paid again.
+func (db *DB) InitPayment(payment *OutgoingPayment) error {
+	paymentBytes, err := serializePaymentRecord(payment)
+	if err != nil {
+		return err
+	}
+
+	return db.Batch(func(tx *bbolt.Tx) error {
+		status, err := FetchPaymentStatusTx(tx, payment.PaymentHash)
+		if err != nil {
+			return err
+		}
+		if status == StatusCompleted {
+			return nil
+		}
+
+		return putPayment(tx, payment.PaymentHash, paymentBytes)
+	})
 }
 
 // AddPayment saves a successful payment to the database. It is assumed that
-// all payment are sent using unique payment hashes.
+// all payment are sent using unique payment hashes. If the payment was
+// recorded by InitPayment as it was dispatched, then that record is replaced,
+// keeping its position within the payments bucket.
 func (db *DB) AddPayment(payment *OutgoingPayment) error {
+	paymentBytes, err := serializePaymentRecord(payment)
+	if err != nil {
+		return err
+	}
+
+	return db.Batch(func(tx *bbolt.Tx) error {
+		return putPayment(tx, payment.PaymentHash, paymentBytes)
+	})
+}
+
+// serializePaymentRecord validates and serializes the payment to be stored.
+// We serialize the payment before starting the database transaction so we
+// can avoid creating a DB payment in the case of a serialization error.
+func serializePaymentRecord(payment *OutgoingPayment) ([]byte, error) {
 	// Validate the field of the inner voice within the outgoing payment,
 	// these must also adhere to the same constraints as regular invoices.
 	if err := validateInvoice(&payment.Invoice); err != nil {
-		return err
+		return nil, err
 	}
 
-	// We first serialize the payment before starting the database
-	// transaction so we can avoid creating a DB payment in the case of a
-	// serialization error.
 	var b bytes.Buffer
 	if err := serializeOutgoingPayment(&b, payment); err != nil {
-		return err
+		return nil, err
 	}
-	paymentBytes := b.Bytes()
 
-	return db.Batch(func(tx *bbolt.Tx) error {
-		payments, err := tx.CreateBucketIfNotExists(paymentBucket)
-		if err != nil {
-			return err
-		}
+	return b.Bytes(), nil
+}
 
+// putPayment stores the serialized payment under the key of any existing
+// payment with the same payment hash, or under a new sequence number
+// otherwise.
+func putPayment(tx *bbolt.Tx, paymentHash [32]byte, paymentBytes []byte) error {
+	payments, err := tx.CreateBucketIfNotExists(paymentBucket)
+	if err != nil {
+		return err
+	}
+	paymentIndex, err := tx.CreateBucketIfNotExists(paymentIndexBucket)
+	if err != nil {
+		return err
+	}
+
+	paymentIDBytes := paymentIndex.Get(paymentHash[:])
+	if paymentIDBytes == nil {
 		// Obtain the new unique sequence number for this payment.
 		paymentID, err := payments.NextSequence()
 		if err != nil {
@@ -133,11 +205,16 @@ func (db *DB) AddPayment(payment *OutgoingPayment) error {
 		// We use BigEndian for keys as it orders keys in
 		// ascending order. This allows bucket scans to order payments
 		// in the order in which they were created.
-		paymentIDBytes := make([]byte, 8)
+		paymentIDBytes = make([]byte, 8)
 		binary.BigEndian.PutUint64(paymentIDBytes, paymentID)
 
-		return payments.Put(paymentIDBytes, paymentBytes)
-	})
+		err = paymentIndex.Put(paymentHash[:], paymentIDBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return payments.Put(paymentIDBytes, paymentBytes)
 }
 
 // FetchAllPayments returns all outgoing payments in DB.
@@ -157,8 +234,7 @@ func (db *DB) FetchAllPayments() ([]*OutgoingPayment, error) {
 				return nil
 			}
 
-			r := bytes.NewReader(v)
-			payment, err := deserializeOutgoingPayment(r)
+			payment, err := fetchPayment(tx, k, v)
 			if err != nil {
 				return err
 			}
@@ -174,6 +250,314 @@ func (db *DB) FetchAllPayments() ([]*OutgoingPayment, error) {
 	return payments, nil
 }
 
+// fetchPayment deserializes the payment stored under the given key, and
+// populates its status and sequence number.
+func fetchPayment(tx *bbolt.Tx, k, v []byte) (*OutgoingPayment, error) {
+	payment, err := deserializeOutgoingPayment(bytes.NewReader(v))
+	if err != nil {
+		return nil, err
+	}
+
+	payment.Status, err = FetchPaymentStatusTx(tx, payment.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+	payment.SequenceNum = byteOrder.Uint64(k)
+
+	return payment, nil
+}
+
+// PaymentsQuery represents a query to the payments database. The query allows
+// a caller to retrieve all payments starting from a particular sequence
+// number and limit the number of results returned.
+type PaymentsQuery struct {
+	// IndexOffset is the sequence number to start at. This can be used to
+	// start the response at a particular payment.
+	IndexOffset uint64
+
+	// MaxPayments is the maximum number of payments that should be
+	// returned starting from the index offset.
+	MaxPayments uint64
+
+	// IncludeIncomplete, if set, also returns payments that are in flight
+	// or that failed, rather than only the completed ones.
+	IncludeIncomplete bool
+
+	// Reversed, if set, indicates that the payments returned should start
+	// from the IndexOffset and go backwards.
+	Reversed bool
+}
+
+// PaymentsSlice is the response to a payments query. It includes the original
+// query, the set of payments that match the query, and the sequence numbers of
+// the first and last payments returned, which allow callers to resume their
+// query in the event that the response exceeds the maximum number of
+// returnable payments.
+type PaymentsSlice struct {
+	PaymentsQuery
+
+	// Payments is the set of payments that matched the query above.
+	Payments []*OutgoingPayment
+
+	// FirstIndexOffset is the sequence number of the first element in the
+	// set of returned payments above.
+	FirstIndexOffset uint64
+
+	// LastIndexOffset is the sequence number of the last element in the
+	// set of returned payments above.
+	LastIndexOffset uint64
+}
+
+// QueryPayments allows a caller to query the payments database for payments
+// within the specified sequence number range.
+func (db *DB) QueryPayments(q PaymentsQuery) (PaymentsSlice, error) {
+	resp := PaymentsSlice{
+		PaymentsQuery: q,
+	}
+
+	err := db.View(func(tx *bbolt.Tx) error {
+		// If the bucket wasn't found, then there aren't any payments
+		// within the database yet, so we can simply exit.
+		payments := tx.Bucket(paymentBucket)
+		if payments == nil {
+			return ErrNoPaymentsCreated
+		}
+
+		// nextKey is a helper closure to determine what the next
+		// payment is when iterating over the payments bucket.
+		nextKey := func(c *bbolt.Cursor) ([]byte, []byte) {
+			if q.Reversed {
+				return c.Prev()
+			}
+			return c.Next()
+		}
+
+		// We'll be using a cursor to seek into the database and return
+		// a slice of payments. We'll need to determine where to start
+		// our cursor depending on the parameters set within the query.
+		c := payments.Cursor()
+		k, v := seekPayment(c, q.IndexOffset+1)
+
+		// If the query is specifying reverse iteration, then we must
+		// handle a few offset cases.
+		if q.Reversed {
+			switch q.IndexOffset {
+
+			// This indicates the default case, where no offset was
+			// specified. In that case we just start from the last
+			// payment.
+			case 0:
+				k, v = c.Last()
+
+			// This indicates the offset being set to the very
+			// first payment. Since there are no payments before
+			// this offset, and the direction is reversed, we can
+			// return without adding any payments to the response.
+			case 1:
+				return nil
+
+			// Otherwise we start iteration at the payment prior to
+			// the offset.
+			default:
+				k, v = seekPayment(c, q.IndexOffset-1)
+			}
+		}
+
+		for ; k != nil; k, v = nextKey(c) {
+			// If our current return payload exceeds the max number
+			// of payments, then we'll exit now.
+			if uint64(len(resp.Payments)) >= q.MaxPayments {
+				break
+			}
+
+			// If the value is nil, then we ignore it as it may be
+			// a sub-bucket.
+			if v == nil {
+				continue
+			}
+
+			payment, err := fetchPayment(tx, k, v)
+			if err != nil {
+				return err
+			}
+
+			// Skip any payments that didn't complete if the caller
+			// is only interested in completed ones.
+			if !q.IncludeIncomplete &&
+				payment.Status != StatusCompleted {
+
+				continue
+			}
+
+			resp.Payments = append(resp.Payments, payment)
+		}
+
+		// If we iterated through the payments in reverse order, then
+		// we'll need to reverse the slice of payments to return them
+		// in forward order.
+		if q.Reversed {
+			numPayments := len(resp.Payments)
+			for i := 0; i < numPayments/2; i++ {
+				opposite := numPayments - i - 1
+				resp.Payments[i], resp.Payments[opposite] =
+					resp.Payments[opposite], resp.Payments[i]
+			}
+		}
+
+		return nil
+	})
+	if err != nil && err != ErrNoPaymentsCreated {
+		return resp, err
+	}
+
+	// Finally, record the sequence numbers of the first and last payments
+	// returned so that the caller can resume from this point later on.
+	if len(resp.Payments) > 0 {
+		resp.FirstIndexOffset = resp.Payments[0].SequenceNum
+		resp.LastIndexOffset =
+			resp.Payments[len(resp.Payments)-1].SequenceNum
+	}
+
+	return resp, nil
+}
+
+// seekPayment positions the cursor at the payment with the given sequence
+// number, or the one following it if it doesn't exist.
+func seekPayment(c *bbolt.Cursor, sequenceNum uint64) ([]byte, []byte) {
+	var seekKey [8]byte
+	byteOrder.PutUint64(seekKey[:], sequenceNum)
+	return c.Seek(seekKey[:])
+}
+
+// DeletePayment deletes the payment with the given payment hash from the
+// database. Its status is kept, so that the control tower still refuses to
+// pay it again if it succeeded. Payments that are still in flight can't be
+// deleted, as their record is updated once they complete.
+func (db *DB) DeletePayment(paymentHash [32]byte) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		status, err := FetchPaymentStatusTx(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+		if status == StatusInFlight {
+			return ErrPaymentInFlight
+		}
+
+		payments := tx.Bucket(paymentBucket)
+		if payments == nil {
+			return ErrPaymentNotFound
+		}
+
+		// Payments written before the payment index was introduced
+		// aren't indexed, so we'll fall back to scanning the payments
+		// for them.
+		var paymentKey []byte
+		paymentIndex := tx.Bucket(paymentIndexBucket)
+		if paymentIndex != nil {
+			paymentKey = paymentIndex.Get(paymentHash[:])
+		}
+		if paymentKey == nil {
+			err := payments.ForEach(func(k, v []byte) error {
+				if v == nil || paymentKey != nil {
+					return nil
+				}
+
+				r := bytes.NewReader(v)
+				payment, err := deserializeOutgoingPayment(r)
+				if err != nil {
+					return err
+				}
+				if payment.PaymentHash == paymentHash {
+					paymentKey = make([]byte, len(k))
+					copy(paymentKey, k)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if paymentKey == nil {
+			return ErrPaymentNotFound
+		}
+
+		if err := payments.Delete(paymentKey); err != nil {
+			return err
+		}
+		if paymentIndex == nil {
+			return nil
+		}
+
+		return paymentIndex.Delete(paymentHash[:])
+	})
+}
+
+// DeleteFailedPayments deletes all the payments that failed from the
+// database, keeping the ones that succeeded or are still in flight.
+func (db *DB) DeleteFailedPayments() error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		payments := tx.Bucket(paymentBucket)
+		if payments == nil {
+			return nil
+		}
+
+		// We'll first collect the payments to delete, as the bucket
+		// can't be modified while iterating over it.
+		var (
+			paymentKeys   [][]byte
+			paymentHashes [][32]byte
+		)
+		err := payments.ForEach(func(k, v []byte) error {
+			if v == nil {
+				return nil
+			}
+
+			payment, err := fetchPayment(tx, k, v)
+			if err != nil {
+				return err
+			}
+			if payment.Status != StatusGrounded {
+				return nil
+			}
+
+			// The key is copied, as it's only valid until the
+			// bucket is modified.
+			paymentKey := make([]byte, len(k))
+			copy(paymentKey, k)
+
+			paymentKeys = append(paymentKeys, paymentKey)
+			paymentHashes = append(
+				paymentHashes, payment.PaymentHash,
+			)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range paymentKeys {
+			if err := payments.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		paymentIndex := tx.Bucket(paymentIndexBucket)
+		if paymentIndex == nil {
+			return nil
+		}
+		for _, paymentHash := range paymentHashes {
+			err := paymentIndex.Delete(paymentHash[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 // DeleteAllPayments deletes all payments from DB.
 func (db *DB) DeleteAllPayments() error {
 	return db.Update(func(tx *bbolt.Tx) error {
@@ -182,6 +566,11 @@ func (db *DB) DeleteAllPayments() error {
 			return err
 		}
 
+		err = tx.DeleteBucket(paymentIndexBucket)
+		if err != nil && err != bbolt.ErrBucketNotFound {
+			return err
+		}
+
 		_, err = tx.CreateBucket(paymentBucket)
 		return err
 	})
@@ -285,7 +674,15 @@ func serializeOutgoingPayment(w io.Writer, p *OutgoingPayment) error {
 		return err
 	}
 
-	return nil
+	// The metadata of the payment is written after all the fields that
+	// payments written by older versions carry, followed by the payment
+	// hash, which older versions derived from the preimage.
+	if err := serializeMetadata(w, p.Metadata); err != nil {
+		return err
+	}
+
+	_, err := w.Write(p.PaymentHash[:])
+	return err
 }
 
 func deserializeOutgoingPayment(r io.Reader) (*OutgoingPayment, error) {
@@ -326,5 +723,20 @@ func deserializeOutgoingPayment(r io.Reader) (*OutgoingPayment, error) {
 		return nil, err
 	}
 
+	p.Metadata, err = deserializeMetadata(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Payments written by older versions were only stored once completed,
+	// so their payment hash can be derived from their preimage.
+	_, err = io.ReadFull(r, p.PaymentHash[:])
+	switch {
+	case err == io.EOF:
+		p.PaymentHash = sha256.Sum256(p.PaymentPreimage[:])
+	case err != nil:
+		return nil, err
+	}
+
 	return p, nil
 }
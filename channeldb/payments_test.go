@@ -2,6 +2,7 @@ package channeldb
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"math/rand"
 	"reflect"
@@ -20,6 +21,7 @@ func makeFakePayment() *OutgoingPayment {
 		Memo:           []byte("fake memo"),
 		Receipt:        []byte("fake receipt"),
 		PaymentRequest: []byte(""),
+		Metadata:       []byte("fake metadata"),
 	}
 
 	copy(fakeInvoice.Terms.PaymentPreimage[:], rev[:])
@@ -37,6 +39,7 @@ func makeFakePayment() *OutgoingPayment {
 		TimeLockLength: 1000,
 	}
 	copy(fakePayment.PaymentPreimage[:], rev[:])
+	fakePayment.PaymentHash = sha256.Sum256(rev[:])
 	return fakePayment
 }
 
@@ -105,6 +108,7 @@ func makeRandomFakePayment() (*OutgoingPayment, error) {
 		TimeLockLength: uint32(rand.Intn(10000)),
 	}
 	copy(fakePayment.PaymentPreimage[:], fakeInvoice.Terms.PaymentPreimage[:])
+	fakePayment.PaymentHash = sha256.Sum256(fakePayment.PaymentPreimage[:])
 
 	return fakePayment, nil
 }
@@ -133,6 +137,38 @@ func TestOutgoingPaymentSerialization(t *testing.T) {
 	}
 }
 
+// TestOutgoingPaymentLegacySerialization asserts that payments written before
+// metadata and the payment hash were introduced can still be deserialized.
+func TestOutgoingPaymentLegacySerialization(t *testing.T) {
+	t.Parallel()
+
+	fakePayment := makeFakePayment()
+	fakePayment.Metadata = nil
+
+	var b bytes.Buffer
+	if err := serializeOutgoingPayment(&b, fakePayment); err != nil {
+		t.Fatalf("unable to serialize outgoing payment: %v", err)
+	}
+
+	// Strip the empty metadata and the payment hash from the end of the
+	// record, leaving us with a payment as written by older versions. Its
+	// payment hash should then be derived from its preimage.
+	legacy := bytes.NewReader(b.Bytes()[:b.Len()-1-32])
+
+	newPayment, err := deserializeOutgoingPayment(legacy)
+	if err != nil {
+		t.Fatalf("unable to deserialize outgoing payment: %v", err)
+	}
+
+	if !reflect.DeepEqual(fakePayment, newPayment) {
+		t.Fatalf("Payments do not match after "+
+			"serialization/deserialization %v vs %v",
+			spew.Sdump(fakePayment),
+			spew.Sdump(newPayment),
+		)
+	}
+}
+
 func TestOutgoingPaymentWorkflow(t *testing.T) {
 	t.Parallel()
 
@@ -146,6 +182,7 @@ func TestOutgoingPaymentWorkflow(t *testing.T) {
 	if err = db.AddPayment(fakePayment); err != nil {
 		t.Fatalf("unable to put payment in DB: %v", err)
 	}
+	fakePayment.SequenceNum = 1
 
 	payments, err := db.FetchAllPayments()
 	if err != nil {
@@ -171,6 +208,7 @@ func TestOutgoingPaymentWorkflow(t *testing.T) {
 		if err = db.AddPayment(randomPayment); err != nil {
 			t.Fatalf("unable to put payment in DB: %v", err)
 		}
+		randomPayment.SequenceNum = uint64(i + 2)
 
 		expectedPayments = append(expectedPayments, randomPayment)
 	}
@@ -251,3 +289,270 @@ func TestPaymentStatusWorkflow(t *testing.T) {
 		}
 	}
 }
+
+// TestInitPayment asserts that a payment recorded as it's dispatched is
+// replaced by its completed version, and that recording a payment that
+// already completed doesn't overwrite it.
+func TestInitPayment(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Record a payment before it's dispatched, which only carries its
+	// payment hash and value.
+	payment := makeFakePayment()
+	pendingPayment := &OutgoingPayment{
+		Invoice:     payment.Invoice,
+		PaymentHash: payment.PaymentHash,
+		Path:        [][33]byte{},
+	}
+	if err := db.InitPayment(pendingPayment); err != nil {
+		t.Fatalf("unable to init payment: %v", err)
+	}
+	err = db.UpdatePaymentStatus(payment.PaymentHash, StatusInFlight)
+	if err != nil {
+		t.Fatalf("unable to update payment status: %v", err)
+	}
+
+	pendingPayment.SequenceNum = 1
+	pendingPayment.Status = StatusInFlight
+	assertPayments(t, db, pendingPayment)
+
+	// Once completed, the payment should replace the pending one.
+	if err := db.AddPayment(payment); err != nil {
+		t.Fatalf("unable to add payment: %v", err)
+	}
+	err = db.UpdatePaymentStatus(payment.PaymentHash, StatusCompleted)
+	if err != nil {
+		t.Fatalf("unable to update payment status: %v", err)
+	}
+
+	payment.SequenceNum = 1
+	payment.Status = StatusCompleted
+	assertPayments(t, db, payment)
+
+	// Attempting to pay it again shouldn't overwrite the completed
+	// payment.
+	if err := db.InitPayment(pendingPayment); err != nil {
+		t.Fatalf("unable to init payment: %v", err)
+	}
+	assertPayments(t, db, payment)
+}
+
+// TestQueryPayments asserts that payments can be paginated in both directions,
+// and that incomplete payments are only returned if requested.
+func TestQueryPayments(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// With no payments, an empty response should be returned.
+	resp, err := db.QueryPayments(PaymentsQuery{MaxPayments: 10})
+	if err != nil {
+		t.Fatalf("unable to query payments: %v", err)
+	}
+	if len(resp.Payments) != 0 {
+		t.Fatalf("expected no payments, got %v", len(resp.Payments))
+	}
+
+	// Add 10 payments, of which the odd ones completed.
+	const numPayments = 10
+	for i := 1; i <= numPayments; i++ {
+		payment, err := makeRandomFakePayment()
+		if err != nil {
+			t.Fatalf("unable to create payment: %v", err)
+		}
+		if err := db.InitPayment(payment); err != nil {
+			t.Fatalf("unable to init payment: %v", err)
+		}
+
+		if i%2 == 0 {
+			continue
+		}
+		err = db.UpdatePaymentStatus(
+			payment.PaymentHash, StatusCompleted,
+		)
+		if err != nil {
+			t.Fatalf("unable to update payment status: %v", err)
+		}
+	}
+
+	testCases := []struct {
+		query       PaymentsQuery
+		expectedSeq []uint64
+	}{
+		{
+			query: PaymentsQuery{
+				MaxPayments: numPayments,
+			},
+			expectedSeq: []uint64{1, 3, 5, 7, 9},
+		},
+		{
+			query: PaymentsQuery{
+				MaxPayments:       numPayments,
+				IncludeIncomplete: true,
+			},
+			expectedSeq: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+		{
+			query: PaymentsQuery{
+				IndexOffset:       3,
+				MaxPayments:       4,
+				IncludeIncomplete: true,
+			},
+			expectedSeq: []uint64{4, 5, 6, 7},
+		},
+		{
+			query: PaymentsQuery{
+				MaxPayments:       3,
+				IncludeIncomplete: true,
+				Reversed:          true,
+			},
+			expectedSeq: []uint64{8, 9, 10},
+		},
+		{
+			query: PaymentsQuery{
+				IndexOffset: 8,
+				MaxPayments: 2,
+				Reversed:    true,
+			},
+			expectedSeq: []uint64{5, 7},
+		},
+		{
+			query: PaymentsQuery{
+				IndexOffset:       1,
+				MaxPayments:       numPayments,
+				IncludeIncomplete: true,
+				Reversed:          true,
+			},
+			expectedSeq: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		resp, err := db.QueryPayments(testCase.query)
+		if err != nil {
+			t.Fatalf("test #%d: unable to query payments: %v", i,
+				err)
+		}
+
+		var seqs []uint64
+		for _, payment := range resp.Payments {
+			seqs = append(seqs, payment.SequenceNum)
+		}
+		if !reflect.DeepEqual(seqs, testCase.expectedSeq) {
+			t.Fatalf("test #%d: expected payments %v, got %v", i,
+				testCase.expectedSeq, seqs)
+		}
+
+		if len(seqs) == 0 {
+			continue
+		}
+		if resp.FirstIndexOffset != seqs[0] {
+			t.Fatalf("test #%d: expected first index offset %v, "+
+				"got %v", i, seqs[0], resp.FirstIndexOffset)
+		}
+		if resp.LastIndexOffset != seqs[len(seqs)-1] {
+			t.Fatalf("test #%d: expected last index offset %v, "+
+				"got %v", i, seqs[len(seqs)-1],
+				resp.LastIndexOffset)
+		}
+	}
+}
+
+// TestDeletePayment asserts that single payments can be deleted unless
+// they're in flight, and that deleting the failed payments keeps the ones that
+// succeeded or are still in flight.
+func TestDeletePayment(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Add a payment for each status, in the order they're listed.
+	statuses := []PaymentStatus{
+		StatusGrounded, StatusInFlight, StatusCompleted,
+		StatusGrounded, StatusCompleted,
+	}
+	payments := make([]*OutgoingPayment, len(statuses))
+	for i, status := range statuses {
+		payment, err := makeRandomFakePayment()
+		if err != nil {
+			t.Fatalf("unable to create payment: %v", err)
+		}
+		if err := db.InitPayment(payment); err != nil {
+			t.Fatalf("unable to init payment: %v", err)
+		}
+		err = db.UpdatePaymentStatus(payment.PaymentHash, status)
+		if err != nil {
+			t.Fatalf("unable to update payment status: %v", err)
+		}
+
+		payment.SequenceNum = uint64(i + 1)
+		payment.Status = status
+		payments[i] = payment
+	}
+
+	// Unknown and in flight payments can't be deleted.
+	err = db.DeletePayment(makeFakePaymentHash())
+	if err != ErrPaymentNotFound {
+		t.Fatalf("expected ErrPaymentNotFound, got %v", err)
+	}
+	err = db.DeletePayment(payments[1].PaymentHash)
+	if err != ErrPaymentInFlight {
+		t.Fatalf("expected ErrPaymentInFlight, got %v", err)
+	}
+
+	// Deleting a completed payment should keep its status, so that it
+	// can't be paid again.
+	if err := db.DeletePayment(payments[2].PaymentHash); err != nil {
+		t.Fatalf("unable to delete payment: %v", err)
+	}
+	assertPayments(t, db, payments[0], payments[1], payments[3], payments[4])
+
+	status, err := db.FetchPaymentStatus(payments[2].PaymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment status: %v", err)
+	}
+	if status != StatusCompleted {
+		t.Fatalf("expected status %v, got %v", StatusCompleted, status)
+	}
+
+	err = db.DeletePayment(payments[2].PaymentHash)
+	if err != ErrPaymentNotFound {
+		t.Fatalf("expected ErrPaymentNotFound, got %v", err)
+	}
+
+	// Finally, only the failed payments should be deleted.
+	if err := db.DeleteFailedPayments(); err != nil {
+		t.Fatalf("unable to delete failed payments: %v", err)
+	}
+	assertPayments(t, db, payments[1], payments[4])
+}
+
+// assertPayments asserts that the given payments are the only ones stored
+// within the database.
+func assertPayments(t *testing.T, db *DB, expected ...*OutgoingPayment) {
+	t.Helper()
+
+	payments, err := db.FetchAllPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch payments: %v", err)
+	}
+
+	if !reflect.DeepEqual(payments, expected) {
+		t.Fatalf("wrong payments, got %v, want %v",
+			spew.Sdump(payments), spew.Sdump(expected))
+	}
+}
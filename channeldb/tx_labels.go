@@ -0,0 +1,86 @@
+package channeldb
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/coreos/bbolt"
+)
+
+// MaxTxLabelLength is the maximum length, in bytes, of a label that can be
+// attached to an on-chain transaction.
+const MaxTxLabelLength = 500
+
+var (
+	// txLabelBucket is the name of the bucket that stores the labels the
+	// user attached to on-chain transactions of the wallet, keyed by
+	// txid.
+	txLabelBucket = []byte("tx-labels")
+
+	// ErrEmptyTxLabel is returned when an empty label is attached to a
+	// transaction.
+	ErrEmptyTxLabel = errors.New("transaction label must not be empty")
+
+	// ErrTxLabelTooLong is returned when a label exceeds the maximum
+	// length of MaxTxLabelLength.
+	ErrTxLabelTooLong = errors.New("transaction label exceeds maximum " +
+		"length")
+)
+
+// ValidateTxLabel checks that the passed label can be attached to a
+// transaction.
+func ValidateTxLabel(label string) error {
+	switch {
+	case len(label) == 0:
+		return ErrEmptyTxLabel
+
+	case len(label) > MaxTxLabelLength:
+		return ErrTxLabelTooLong
+	}
+
+	return nil
+}
+
+// PutTxLabel attaches the passed label to the target transaction, replacing
+// any label it had before.
+func (d *DB) PutTxLabel(txid *chainhash.Hash, label string) error {
+	if err := ValidateTxLabel(label); err != nil {
+		return err
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(txLabelBucket)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(txid[:], []byte(label))
+	})
+}
+
+// FetchTxLabels returns the labels of all labeled transactions, keyed by
+// txid.
+func (d *DB) FetchTxLabels() (map[chainhash.Hash]string, error) {
+	labels := make(map[chainhash.Hash]string)
+	err := d.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(txLabelBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			txid, err := chainhash.NewHash(k)
+			if err != nil {
+				return err
+			}
+			labels[*txid] = string(v)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
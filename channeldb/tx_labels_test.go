@@ -0,0 +1,60 @@
+package channeldb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// TestTxLabels asserts that labels attached to transactions can be fetched,
+// that relabeling replaces the previous label, and that invalid labels are
+// rejected.
+func TestTxLabels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	labels, err := cdb.FetchTxLabels()
+	if err != nil {
+		t.Fatalf("unable to fetch labels: %v", err)
+	}
+	if len(labels) != 0 {
+		t.Fatalf("expected no labels, got %v", labels)
+	}
+
+	txid1 := chainhash.Hash{1}
+	txid2 := chainhash.Hash{2}
+	if err := cdb.PutTxLabel(&txid1, "rent"); err != nil {
+		t.Fatalf("unable to put label: %v", err)
+	}
+	if err := cdb.PutTxLabel(&txid2, "savings"); err != nil {
+		t.Fatalf("unable to put label: %v", err)
+	}
+	if err := cdb.PutTxLabel(&txid1, "groceries"); err != nil {
+		t.Fatalf("unable to put label: %v", err)
+	}
+
+	err = cdb.PutTxLabel(&txid2, "")
+	if err != ErrEmptyTxLabel {
+		t.Fatalf("expected ErrEmptyTxLabel, got %v", err)
+	}
+	err = cdb.PutTxLabel(&txid2, strings.Repeat("a", MaxTxLabelLength+1))
+	if err != ErrTxLabelTooLong {
+		t.Fatalf("expected ErrTxLabelTooLong, got %v", err)
+	}
+
+	labels, err = cdb.FetchTxLabels()
+	if err != nil {
+		t.Fatalf("unable to fetch labels: %v", err)
+	}
+	if len(labels) != 2 || labels[txid1] != "groceries" ||
+		labels[txid2] != "savings" {
+
+		t.Fatalf("unexpected labels: %v", labels)
+	}
+}
@@ -0,0 +1,109 @@
+package channeldb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// channelUptimeBucket is the name of the bucket that stores the
+	// accumulated uptime of each channel, keyed by its channel point.
+	channelUptimeBucket = []byte("channel-uptime")
+)
+
+// ChannelUptime tracks how long a channel has been monitored, and for how
+// much of that time its remote peer was connected to us.
+type ChannelUptime struct {
+	// Lifetime is the total duration over which the channel has been
+	// monitored. Time during which our own node was offline isn't
+	// included.
+	Lifetime time.Duration
+
+	// Uptime is the portion of the lifetime during which the remote peer
+	// of the channel was connected to us.
+	Uptime time.Duration
+}
+
+// AddChannelUptime adds the given monitored lifetime and uptime to the
+// totals accumulated for the target channel.
+func (d *DB) AddChannelUptime(chanPoint *wire.OutPoint, lifetime,
+	uptime time.Duration) error {
+
+	var key bytes.Buffer
+	if err := writeOutpoint(&key, chanPoint); err != nil {
+		return err
+	}
+
+	return d.Batch(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(channelUptimeBucket)
+		if err != nil {
+			return err
+		}
+
+		var current ChannelUptime
+		if v := bucket.Get(key.Bytes()); v != nil {
+			current = deserializeChannelUptime(v)
+		}
+
+		current.Lifetime += lifetime
+		current.Uptime += uptime
+
+		return bucket.Put(key.Bytes(), serializeChannelUptime(&current))
+	})
+}
+
+// FetchChannelUptime returns the totals accumulated for the target channel.
+// If no uptime has been recorded for the channel yet, then zero durations
+// are returned.
+func (d *DB) FetchChannelUptime(chanPoint *wire.OutPoint) (*ChannelUptime,
+	error) {
+
+	var key bytes.Buffer
+	if err := writeOutpoint(&key, chanPoint); err != nil {
+		return nil, err
+	}
+
+	uptime := &ChannelUptime{}
+	err := d.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(channelUptimeBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		if v := bucket.Get(key.Bytes()); v != nil {
+			*uptime = deserializeChannelUptime(v)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uptime, nil
+}
+
+// serializeChannelUptime encodes both durations as nanoseconds.
+func serializeChannelUptime(u *ChannelUptime) []byte {
+	var b [16]byte
+	byteOrder.PutUint64(b[:8], uint64(u.Lifetime))
+	byteOrder.PutUint64(b[8:], uint64(u.Uptime))
+
+	return b[:]
+}
+
+// deserializeChannelUptime decodes an uptime previously encoded by
+// serializeChannelUptime.
+func deserializeChannelUptime(b []byte) ChannelUptime {
+	if len(b) < 16 {
+		return ChannelUptime{}
+	}
+
+	return ChannelUptime{
+		Lifetime: time.Duration(byteOrder.Uint64(b[:8])),
+		Uptime:   time.Duration(byteOrder.Uint64(b[8:16])),
+	}
+}
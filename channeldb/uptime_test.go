@@ -0,0 +1,47 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestChannelUptime asserts that uptime added for a channel accumulates
+// across calls, and that unknown channels report no uptime.
+func TestChannelUptime(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	chanPoint := &wire.OutPoint{Index: 1}
+
+	uptime, err := cdb.FetchChannelUptime(chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch uptime: %v", err)
+	}
+	if uptime.Lifetime != 0 || uptime.Uptime != 0 {
+		t.Fatalf("expected no uptime, got %v", uptime)
+	}
+
+	err = cdb.AddChannelUptime(chanPoint, time.Hour, time.Minute)
+	if err != nil {
+		t.Fatalf("unable to add uptime: %v", err)
+	}
+	err = cdb.AddChannelUptime(chanPoint, time.Hour, 2*time.Minute)
+	if err != nil {
+		t.Fatalf("unable to add uptime: %v", err)
+	}
+
+	uptime, err = cdb.FetchChannelUptime(chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch uptime: %v", err)
+	}
+	if uptime.Lifetime != 2*time.Hour || uptime.Uptime != 3*time.Minute {
+		t.Fatalf("unexpected uptime: %v", uptime)
+	}
+}
@@ -0,0 +1,270 @@
+package channelnotifier
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// ErrNotifierShuttingDown is returned when attempting to subscribe to a
+// ChannelNotifier that is shutting down.
+var ErrNotifierShuttingDown = errors.New("channel notifier shutting down")
+
+// PendingOpenChannelEvent represents a new event where a new channel has
+// entered a pending open state.
+type PendingOpenChannelEvent struct {
+	// ChannelPoint is the channel outpoint for the new channel.
+	ChannelPoint *wire.OutPoint
+
+	// PendingChannel is the channel configuration for the newly created
+	// channel. This might not have been persisted to the channel DB yet
+	// because we are still waiting for the final message from the remote
+	// peer.
+	PendingChannel *channeldb.OpenChannel
+}
+
+// OpenChannelEvent represents a new event where a channel goes from pending
+// open to open.
+type OpenChannelEvent struct {
+	// Channel is the channel that has become open.
+	Channel *channeldb.OpenChannel
+}
+
+// ActiveChannelEvent represents a new event where a channel becomes active,
+// as its link was added to the switch.
+type ActiveChannelEvent struct {
+	// ChannelPoint is the channel outpoint for the channel that became
+	// active.
+	ChannelPoint *wire.OutPoint
+}
+
+// InactiveChannelEvent represents a new event where a channel becomes
+// inactive, as its link was removed from the switch.
+type InactiveChannelEvent struct {
+	// ChannelPoint is the channel outpoint for the channel that became
+	// inactive.
+	ChannelPoint *wire.OutPoint
+}
+
+// ClosedChannelEvent represents a new event where a channel close is
+// confirmed on-chain.
+type ClosedChannelEvent struct {
+	// CloseSummary is the summary of the closed channel. Its outputs may
+	// not be fully resolved yet.
+	CloseSummary *channeldb.ChannelCloseSummary
+}
+
+// FullyResolvedChannelEvent represents a new event where a closed channel
+// has had all of its contracts resolved on-chain.
+type FullyResolvedChannelEvent struct {
+	// ChannelPoint is the channel outpoint for the channel that was fully
+	// resolved.
+	ChannelPoint *wire.OutPoint
+}
+
+// ChannelNotifier dispatches events about the lifecycle of our channels to
+// all of its subscribers. Subsystems notify it as channels are funded,
+// opened, become active or inactive, closed and finally resolved, so that
+// callers can react to those changes instead of polling the channel state.
+type ChannelNotifier struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	chanDB *channeldb.DB
+
+	clientMtx    sync.Mutex
+	nextClientID uint64
+	clients      map[uint64]*Subscription
+
+	quit chan struct{}
+}
+
+// New creates a new channel notifier. The ChannelNotifier gets channel
+// events from peers and from the chain arbitrator, and dispatches them to
+// its clients.
+func New(chanDB *channeldb.DB) *ChannelNotifier {
+	return &ChannelNotifier{
+		chanDB:  chanDB,
+		clients: make(map[uint64]*Subscription),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start starts the ChannelNotifier.
+func (c *ChannelNotifier) Start() error {
+	if !atomic.CompareAndSwapUint32(&c.started, 0, 1) {
+		return nil
+	}
+
+	log.Info("ChannelNotifier starting")
+
+	return nil
+}
+
+// Stop signals the notifier for a graceful shutdown, canceling all active
+// subscriptions.
+func (c *ChannelNotifier) Stop() error {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Info("ChannelNotifier shutting down")
+
+	close(c.quit)
+
+	c.clientMtx.Lock()
+	clients := c.clients
+	c.clients = make(map[uint64]*Subscription)
+	c.clientMtx.Unlock()
+
+	for _, client := range clients {
+		client.stop()
+	}
+
+	return nil
+}
+
+// SubscribeChannelEvents returns a subscription to all the events that
+// happen to any of our channels, delivered in the order they occur.
+func (c *ChannelNotifier) SubscribeChannelEvents() (*Subscription, error) {
+	select {
+	case <-c.quit:
+		return nil, ErrNotifierShuttingDown
+	default:
+	}
+
+	client := &Subscription{
+		ntfnQueue: queue.NewConcurrentQueue(20),
+		notifier:  c,
+		quit:      make(chan struct{}),
+	}
+	client.ntfnQueue.Start()
+
+	c.clientMtx.Lock()
+	client.id = c.nextClientID
+	c.nextClientID++
+	c.clients[client.id] = client
+	c.clientMtx.Unlock()
+
+	return client, nil
+}
+
+// NotifyPendingOpenChannelEvent notifies the channel notifier that a new
+// channel is pending.
+func (c *ChannelNotifier) NotifyPendingOpenChannelEvent(
+	chanPoint wire.OutPoint, pendingChan *channeldb.OpenChannel) {
+
+	c.notify(PendingOpenChannelEvent{
+		ChannelPoint:   &chanPoint,
+		PendingChannel: pendingChan,
+	})
+}
+
+// NotifyOpenChannelEvent notifies the channel notifier that a channel has
+// gone from pending open to open.
+func (c *ChannelNotifier) NotifyOpenChannelEvent(
+	channel *channeldb.OpenChannel) {
+
+	c.notify(OpenChannelEvent{Channel: channel})
+}
+
+// NotifyActiveChannelEvent notifies the channel notifier that a channel has
+// become active.
+func (c *ChannelNotifier) NotifyActiveChannelEvent(chanPoint wire.OutPoint) {
+	c.notify(ActiveChannelEvent{ChannelPoint: &chanPoint})
+}
+
+// NotifyInactiveChannelEvent notifies the channel notifier that a channel has
+// become inactive.
+func (c *ChannelNotifier) NotifyInactiveChannelEvent(chanPoint wire.OutPoint) {
+	c.notify(InactiveChannelEvent{ChannelPoint: &chanPoint})
+}
+
+// NotifyClosedChannelEvent notifies the channel notifier that a channel has
+// been closed. The close summary of the channel is fetched from the database,
+// so it must already have been marked as closed.
+func (c *ChannelNotifier) NotifyClosedChannelEvent(chanPoint wire.OutPoint) {
+	closeSummary, err := c.chanDB.FetchClosedChannel(&chanPoint)
+	if err != nil {
+		log.Errorf("Unable to fetch closed channel summary for "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return
+	}
+
+	c.notify(ClosedChannelEvent{CloseSummary: closeSummary})
+}
+
+// NotifyFullyResolvedChannelEvent notifies the channel notifier that all the
+// contracts of a closed channel have been resolved on-chain.
+func (c *ChannelNotifier) NotifyFullyResolvedChannelEvent(
+	chanPoint wire.OutPoint) {
+
+	c.notify(FullyResolvedChannelEvent{ChannelPoint: &chanPoint})
+}
+
+// notify dispatches the given event to all active subscriptions.
+func (c *ChannelNotifier) notify(event interface{}) {
+	c.clientMtx.Lock()
+	defer c.clientMtx.Unlock()
+
+	for _, client := range c.clients {
+		select {
+		case client.ntfnQueue.ChanIn() <- event:
+		case <-client.quit:
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// Subscription is a subscription to the events of a ChannelNotifier. Each
+// event is one of PendingOpenChannelEvent, OpenChannelEvent,
+// ActiveChannelEvent, InactiveChannelEvent, ClosedChannelEvent or
+// FullyResolvedChannelEvent.
+type Subscription struct {
+	cancelled uint32 // To be used atomically.
+
+	id uint64
+
+	ntfnQueue *queue.ConcurrentQueue
+
+	notifier *ChannelNotifier
+
+	quit chan struct{}
+}
+
+// Updates returns the channel over which the events of the subscription are
+// delivered. It's never closed, so callers should also select on Quit.
+func (s *Subscription) Updates() <-chan interface{} {
+	return s.ntfnQueue.ChanOut()
+}
+
+// Quit returns a channel that is closed once the subscription is canceled,
+// either by the caller or as the notifier shuts down.
+func (s *Subscription) Quit() <-chan struct{} {
+	return s.quit
+}
+
+// Cancel unregisters the subscription, freeing any previously allocated
+// resources.
+func (s *Subscription) Cancel() {
+	s.notifier.clientMtx.Lock()
+	delete(s.notifier.clients, s.id)
+	s.notifier.clientMtx.Unlock()
+
+	s.stop()
+}
+
+// stop closes the quit channel of the subscription and stops its queue.
+func (s *Subscription) stop() {
+	if !atomic.CompareAndSwapUint32(&s.cancelled, 0, 1) {
+		return
+	}
+
+	close(s.quit)
+	s.ntfnQueue.Stop()
+}
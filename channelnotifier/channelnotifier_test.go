@@ -0,0 +1,108 @@
+package channelnotifier
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// TestChannelNotifier asserts that events are delivered in order to all
+// active subscriptions, and that canceled subscriptions no longer receive
+// them.
+func TestChannelNotifier(t *testing.T) {
+	t.Parallel()
+
+	notifier := New(nil)
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	sub1, err := notifier.SubscribeChannelEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	sub2, err := notifier.SubscribeChannelEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	chanPoint := wire.OutPoint{Index: 1}
+	channel := &channeldb.OpenChannel{FundingOutpoint: chanPoint}
+
+	notifier.NotifyPendingOpenChannelEvent(chanPoint, channel)
+	notifier.NotifyOpenChannelEvent(channel)
+	notifier.NotifyActiveChannelEvent(chanPoint)
+	notifier.NotifyInactiveChannelEvent(chanPoint)
+	notifier.NotifyFullyResolvedChannelEvent(chanPoint)
+
+	expectedEvents := []interface{}{
+		PendingOpenChannelEvent{
+			ChannelPoint:   &chanPoint,
+			PendingChannel: channel,
+		},
+		OpenChannelEvent{Channel: channel},
+		ActiveChannelEvent{ChannelPoint: &chanPoint},
+		InactiveChannelEvent{ChannelPoint: &chanPoint},
+		FullyResolvedChannelEvent{ChannelPoint: &chanPoint},
+	}
+
+	for _, sub := range []*Subscription{sub1, sub2} {
+		for _, expected := range expectedEvents {
+			select {
+			case event := <-sub.Updates():
+				if !reflect.DeepEqual(event, expected) {
+					t.Fatalf("expected event %#v, got %#v",
+						expected, event)
+				}
+
+			case <-time.After(time.Second):
+				t.Fatalf("event %T not received", expected)
+			}
+		}
+	}
+
+	// Once canceled, the first subscription shouldn't receive any more
+	// events, while the second one still should.
+	sub1.Cancel()
+	select {
+	case <-sub1.Quit():
+	default:
+		t.Fatalf("subscription quit channel not closed")
+	}
+
+	notifier.NotifyActiveChannelEvent(chanPoint)
+
+	select {
+	case event := <-sub2.Updates():
+		if _, ok := event.(ActiveChannelEvent); !ok {
+			t.Fatalf("unexpected event %#v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("event not received")
+	}
+
+	select {
+	case event := <-sub1.Updates():
+		t.Fatalf("canceled subscription received event %#v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// After the notifier is stopped, the remaining subscription should be
+	// canceled, and no new ones allowed.
+	if err := notifier.Stop(); err != nil {
+		t.Fatalf("unable to stop notifier: %v", err)
+	}
+	select {
+	case <-sub2.Quit():
+	case <-time.After(time.Second):
+		t.Fatalf("subscription not canceled on shutdown")
+	}
+	_, err = notifier.SubscribeChannelEvents()
+	if err != ErrNotifierShuttingDown {
+		t.Fatalf("expected ErrNotifierShuttingDown, got %v", err)
+	}
+}
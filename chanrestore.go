@@ -0,0 +1,177 @@
+package main
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/contractcourt"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/shachain"
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+// chanDBRestorer is an implementation of the chanbackup.ChannelRestorer
+// interface that is able to properly map a Single backup, into a
+// channeldb.ChannelShell which is required to fully restore a channel. We also
+// need the secret key chain in order obtain the prior shachain root so we can
+// verify the DLP protocol as initiated by the remote node.
+type chanDBRestorer struct {
+	db *channeldb.DB
+
+	secretKeys keychain.SecretKeyRing
+
+	chainArb *contractcourt.ChainArbitrator
+}
+
+// openChannelShell maps the static channel back up into an open channel
+// "shell". We say shell as this doesn't include all the information required
+// to continue to use the channel, only the minimal amount of information to
+// insert this shell channel back into the database.
+func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
+	*channeldb.ChannelShell, error) {
+
+	// First, we'll also need to obtain the private key for the shachain
+	// root from the encoded public key.
+	privKey, err := c.secretKeys.DerivePrivKey(backup.ShaChainRootDesc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive shachain root "+
+			"key: %v", err)
+	}
+	revRoot, err := chainhash.NewHash(privKey.Serialize())
+	if err != nil {
+		return nil, err
+	}
+	shaChainProducer := shachain.NewRevocationProducer(*revRoot)
+
+	// The backup only carries the key locators for our side of the
+	// channel, so we'll re-derive the public keys of each base point to
+	// ensure the restored channel config is complete.
+	localKeys := []*keychain.KeyDescriptor{
+		&backup.LocalChanCfg.MultiSigKey,
+		&backup.LocalChanCfg.RevocationBasePoint,
+		&backup.LocalChanCfg.PaymentBasePoint,
+		&backup.LocalChanCfg.DelayBasePoint,
+		&backup.LocalChanCfg.HtlcBasePoint,
+	}
+	for _, keyDesc := range localKeys {
+		derivedKey, err := c.secretKeys.DeriveKey(keyDesc.KeyLocator)
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive key: %v", err)
+		}
+		keyDesc.PubKey = derivedKey.PubKey
+	}
+
+	// With the backup information parsed, we'll now map the Single
+	// backup into a channel shell. We set the remote commitment point to
+	// the remote node's identity key as a placeholder, it will be
+	// replaced by the point the remote node sends us during the data loss
+	// recovery protocol.
+	chanShell := channeldb.ChannelShell{
+		NodeAddrs: backup.Addresses,
+		Chan: &channeldb.OpenChannel{
+			ChainHash:               backup.ChainHash,
+			ChanType:                channeldb.SingleFunder,
+			IsInitiator:             backup.IsInitiator,
+			Capacity:                backup.Capacity,
+			FundingOutpoint:         backup.FundingOutpoint,
+			ShortChannelID:          backup.ShortChannelID,
+			IdentityPub:             backup.RemoteNodePub,
+			IsPending:               false,
+			LocalChanCfg:            backup.LocalChanCfg,
+			RemoteChanCfg:           backup.RemoteChanCfg,
+			RemoteCurrentRevocation: backup.RemoteNodePub,
+			RevocationStore:         shachain.NewRevocationStore(),
+			RevocationProducer:      shaChainProducer,
+		},
+	}
+
+	return &chanShell, nil
+}
+
+// RestoreChansFromSingles attempts to map the set of single channel backups to
+// channel shells that will be stored persistently. Once these shells have been
+// stored on disk, we'll be able to connect to the channel peer and execute the
+// data loss recovery protocol.
+//
+// NOTE: Part of the chanbackup.ChannelRestorer interface.
+func (c *chanDBRestorer) RestoreChansFromSingles(backups ...chanbackup.Single) error {
+	channelShells := make([]*channeldb.ChannelShell, 0, len(backups))
+	for _, backup := range backups {
+		chanShell, err := c.openChannelShell(backup)
+		if err != nil {
+			return err
+		}
+
+		channelShells = append(channelShells, chanShell)
+	}
+
+	ltndLog.Infof("Inserting %v SCB channel shells into DB",
+		len(channelShells))
+
+	// Now that we have all the backups mapped into a series of Singles,
+	// we'll insert them all into the database.
+	if err := c.db.RestoreChannelShells(channelShells...); err != nil {
+		return err
+	}
+
+	ltndLog.Infof("Informing chain watchers of new restored channels")
+
+	// Finally, we'll need to inform the chain arbitrator of these new
+	// channels so we'll properly watch for their ultimate closure on chain
+	// and sweep them via the DLP.
+	for _, restoredChannel := range channelShells {
+		err := c.chainArb.WatchNewChannel(restoredChannel.Chan)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A compile-time constraint to ensure chanDBRestorer implements
+// chanbackup.ChannelRestorer.
+var _ chanbackup.ChannelRestorer = (*chanDBRestorer)(nil)
+
+// ConnectPeer attempts to connect to the target node at the set of available
+// addresses. Each attempt is made as a persistent connection request, so even
+// if this method returns with a non-nil error, the connection manager will
+// keep trying to reach the target peer in the background.
+//
+// NOTE: Part of the chanbackup.PeerConnector interface.
+func (s *server) ConnectPeer(nodePub *btcec.PublicKey, addrs []net.Addr) error {
+	// For each of the known addresses, we'll attempt to launch a
+	// persistent connection to the (pub, addr) pair until one of the
+	// requests is accepted.
+	for _, addr := range addrs {
+		netAddr := &lnwire.NetAddress{
+			IdentityKey: nodePub,
+			Address:     addr,
+		}
+
+		ltndLog.Infof("Attempting to connect to %v for SCB restore "+
+			"DLP", netAddr)
+
+		// Attempt to connect to the peer using this full address. If
+		// we're unable to connect to them, then we'll try the next
+		// address in place of it.
+		err := s.ConnectToPeer(netAddr, true, tor.DefaultConnTimeout)
+		if err != nil {
+			ltndLog.Errorf("unable to connect to %v to "+
+				"complete SCB restore: %v", netAddr, err)
+			continue
+		}
+
+		// If we connected no problem, then we can exit early as our
+		// job here is done.
+		return nil
+	}
+
+	return fmt.Errorf("unable to connect to peer %x for SCB restore",
+		nodePub.SerializeCompressed())
+}
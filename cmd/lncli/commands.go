@@ -3,6 +3,8 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -10,6 +12,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math"
+	"net"
 	"os"
 	"strconv"
 	"strings"
@@ -20,11 +23,13 @@ import (
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/urfave/cli"
 	"golang.org/x/crypto/ssh/terminal"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	macaroon "gopkg.in/macaroon.v2"
 )
 
 // TODO(roasbeef): cli logic for supporting both positional and unix style
@@ -143,6 +148,32 @@ func newAddress(ctx *cli.Context) error {
 	return nil
 }
 
+var listAddressesCommand = cli.Command{
+	Name:     "listaddresses",
+	Category: "Wallet",
+	Usage:    "List all addresses derived by the wallet.",
+	Description: `
+	List all addresses the wallet has derived so far, both for receiving
+	and for change, along with their derivation paths, address types and
+	balances.
+	`,
+	Action: actionDecorator(listAddresses),
+}
+
+func listAddresses(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListAddresses(ctxb, &lnrpc.ListAddressesRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var sendCoinsCommand = cli.Command{
 	Name:      "sendcoins",
 	Category:  "On-chain",
@@ -152,7 +183,10 @@ var sendCoinsCommand = cli.Command{
 	Send amt coins in satoshis to the BASE58 encoded bitcoin address addr.
 
 	Fees used when sending the transaction can be specified via the --conf_target, or
-	--sat_per_byte optional flags.
+	--sat_per_vbyte optional flags.
+
+	The whole balance of the wallet can be sent with the --sweepall flag, in
+	which case no amount must be specified.
 
 	Positional arguments and flags can be used interchangeably but not at the same time!
 	`,
@@ -173,11 +207,31 @@ var sendCoinsCommand = cli.Command{
 				"used for fee estimation",
 		},
 		cli.Int64Flag{
-			Name: "sat_per_byte",
+			Name:  "sat_per_byte",
+			Usage: "Deprecated, use sat_per_vbyte instead.",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
 			Usage: "(optional) a manual fee expressed in " +
-				"sat/byte that should be used when crafting " +
+				"sat/vbyte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.BoolFlag{
+			Name: "sweepall",
+			Usage: "if set, then the amount field will be ignored, " +
+				"and the wallet will attempt to sweep all " +
+				"outputs within the wallet to the target " +
+				"address",
+		},
+		cli.BoolFlag{
+			Name: "spend_unconfirmed",
+			Usage: "(optional) whether unconfirmed outputs should " +
+				"be used as inputs for the transaction",
+		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "(optional) a label for the transaction",
+		},
 	},
 	Action: actionDecorator(sendCoins),
 }
@@ -195,9 +249,11 @@ func sendCoins(ctx *cli.Context) error {
 		return nil
 	}
 
-	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
-		return fmt.Errorf("either conf_target or sat_per_byte should be " +
-			"set, but not both")
+	if ctx.IsSet("conf_target") && (ctx.IsSet("sat_per_byte") ||
+		ctx.IsSet("sat_per_vbyte")) {
+
+		return fmt.Errorf("either conf_target or sat_per_vbyte should " +
+			"be set, but not both")
 	}
 
 	switch {
@@ -215,7 +271,7 @@ func sendCoins(ctx *cli.Context) error {
 		amt = ctx.Int64("amt")
 	case args.Present():
 		amt, err = strconv.ParseInt(args.First(), 10, 64)
-	default:
+	case !ctx.Bool("sweepall"):
 		return fmt.Errorf("Amount argument missing")
 	}
 
@@ -223,15 +279,24 @@ func sendCoins(ctx *cli.Context) error {
 		return fmt.Errorf("unable to decode amount: %v", err)
 	}
 
+	if amt != 0 && ctx.Bool("sweepall") {
+		return fmt.Errorf("amount cannot be set if attempting to " +
+			"sweep all coins out of the wallet")
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
 	req := &lnrpc.SendCoinsRequest{
-		Addr:       addr,
-		Amount:     amt,
-		TargetConf: int32(ctx.Int64("conf_target")),
-		SatPerByte: ctx.Int64("sat_per_byte"),
+		Addr:             addr,
+		Amount:           amt,
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		SatPerByte:       ctx.Int64("sat_per_byte"),
+		SatPerVbyte:      ctx.Uint64("sat_per_vbyte"),
+		SendAll:          ctx.Bool("sweepall"),
+		SpendUnconfirmed: ctx.Bool("spend_unconfirmed"),
+		Label:            ctx.String("label"),
 	}
 	txid, err := client.SendCoins(ctxb, req)
 	if err != nil {
@@ -395,11 +460,74 @@ func sendMany(ctx *cli.Context) error {
 	return nil
 }
 
+var estimateFeeCommand = cli.Command{
+	Name:      "estimatefee",
+	Category:  "On-chain",
+	Usage:     "Get fee estimates for sending bitcoin on-chain to multiple addresses.",
+	ArgsUsage: "send-json-string [--conf_target=N]",
+	Description: `
+	Get fee estimates for sending a transaction paying the specified amount(s)
+	to the passed address(es). No transaction is created or broadcast.
+
+	The send-json-string' param decodes addresses and the amount to send
+	respectively in the following format:
+
+	    '{"ExampleAddr": NumCoinsInSatoshis, "SecondAddr": NumCoins}'
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the transaction *should* " +
+				"confirm in",
+		},
+	},
+	Action: actionDecorator(estimateFee),
+}
+
+func estimateFee(ctx *cli.Context) error {
+	// Show command help if no arguments were provided.
+	if ctx.NArg() == 0 {
+		cli.ShowCommandHelp(ctx, "estimatefee")
+		return nil
+	}
+
+	var amountToAddr map[string]int64
+
+	jsonMap := ctx.Args().First()
+	if err := json.Unmarshal([]byte(jsonMap), &amountToAddr); err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.EstimateFee(ctxb, &lnrpc.EstimateFeeRequest{
+		AddrToAmount: amountToAddr,
+		TargetConf:   int32(ctx.Int64("conf_target")),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var connectCommand = cli.Command{
 	Name:      "connect",
 	Category:  "Peers",
 	Usage:     "Connect to a remote lnd peer.",
-	ArgsUsage: "<pubkey>@host",
+	ArgsUsage: "<pubkey>@host | <alias>",
+	Description: `
+	Connect to a remote lnd peer, identified either by its public key and
+	host, or by an alias that resolves to both.
+
+	Aliases are looked up in the alias file set via the global --aliasfile
+	option, in which each line has the format "alias pubkey@host:port".
+	Identifiers of the form user@domain or domain are also looked up in
+	the TXT records of user._lightning.domain or _lightning.domain, unless
+	--no-dns-resolve is set.`,
 	Flags: []cli.Flag{
 		cli.BoolFlag{
 			Name: "perm",
@@ -407,6 +535,13 @@ var connectCommand = cli.Command{
 				"connect to the target peer.\n" +
 				"           If not, the call will be synchronous.",
 		},
+		cli.DurationFlag{
+			Name: "timeout",
+			Usage: "The connection timeout for the request, e.g. " +
+				"30s or 2m.\n" +
+				"           If not set, a default timeout " +
+				"of 120s is used.",
+		},
 	},
 	Action: actionDecorator(connectPeer),
 }
@@ -417,19 +552,23 @@ func connectPeer(ctx *cli.Context) error {
 	defer cleanUp()
 
 	targetAddress := ctx.Args().First()
-	splitAddr := strings.Split(targetAddress, "@")
-	if len(splitAddr) != 2 {
+	node, err := resolveNode(ctx, targetAddress)
+	if err != nil {
+		return err
+	}
+	if node.host == "" {
 		return fmt.Errorf("target address expected in format: " +
 			"pubkey@host:port")
 	}
 
 	addr := &lnrpc.LightningAddress{
-		Pubkey: splitAddr[0],
-		Host:   splitAddr[1],
+		Pubkey: node.pubKey,
+		Host:   node.host,
 	}
 	req := &lnrpc.ConnectPeerRequest{
-		Addr: addr,
-		Perm: ctx.Bool("perm"),
+		Addr:    addr,
+		Perm:    ctx.Bool("perm"),
+		Timeout: uint64(ctx.Duration("timeout").Seconds()),
 	}
 
 	lnid, err := client.ConnectPeer(ctxb, req)
@@ -497,19 +636,29 @@ var openChannelCommand = cli.Command{
 	setting its host:port via the --connect argument. For this to work,
 	the node_key must be provided, rather than the peer_id. This is optional.
 
+	Instead of its public key, the node can also be identified by an alias,
+	as accepted by the connect command. If the alias resolves to a host,
+	we'll connect to it unless --connect is set.
+
 	The channel will be initialized with local-amt satoshis local and push-amt
 	satoshis for the remote node. Note that specifying push-amt means you give that
 	amount to the remote node as part of the channel opening. Once the channel is open,
 	a channelPoint (txid:vout) of the funding output is returned.
 
 	One can manually set the fee to be used for the funding transaction via either
-	the --conf_target or --sat_per_byte arguments. This is optional.`,
+	the --conf_target or --sat_per_byte arguments. This is optional.
+
+	With the --psbt flag, the funding transaction isn't created by lnd's wallet.
+	Instead, once the remote node accepted the channel, a PSBT paying to the
+	funding output is printed along with the pending channel ID. The PSBT can
+	then be funded and signed by an external wallet, and the funding flow is
+	continued with the fundingstatestep command.`,
 	ArgsUsage: "node-key local-amt push-amt",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: "node_key",
 			Usage: "the identity public key of the target node/peer " +
-				"serialized in compressed format",
+				"serialized in compressed format, or its alias",
 		},
 		cli.StringFlag{
 			Name:  "connect",
@@ -571,6 +720,21 @@ var openChannelCommand = cli.Command{
 				"transaction must satisfy",
 			Value: 1,
 		},
+		cli.BoolFlag{
+			Name: "psbt",
+			Usage: "(optional) fund the channel with an external " +
+				"wallet through a PSBT, see fundingstatestep",
+		},
+		cli.StringFlag{
+			Name: "base_psbt",
+			Usage: "(optional) a base64 encoded PSBT the funding " +
+				"output should be added to, requires --psbt",
+		},
+		cli.BoolFlag{
+			Name: "no_publish",
+			Usage: "(optional) don't broadcast the funding " +
+				"transaction, requires --psbt",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -598,32 +762,38 @@ func openChannel(ctx *cli.Context) error {
 		MinConfs:       int32(ctx.Uint64("min_confs")),
 	}
 
+	var nodeID string
 	switch {
 	case ctx.IsSet("node_key"):
-		nodePubHex, err := hex.DecodeString(ctx.String("node_key"))
-		if err != nil {
-			return fmt.Errorf("unable to decode node public key: %v", err)
-		}
-		req.NodePubkey = nodePubHex
+		nodeID = ctx.String("node_key")
 
 	case args.Present():
-		nodePubHex, err := hex.DecodeString(args.First())
-		if err != nil {
-			return fmt.Errorf("unable to decode node public key: %v", err)
-		}
+		nodeID = args.First()
 		args = args.Tail()
-		req.NodePubkey = nodePubHex
 	default:
 		return fmt.Errorf("node id argument missing")
 	}
 
-	// As soon as we can confirm that the node's node_key was set, rather
-	// than the peer_id, we can check if the host:port was also set to
-	// connect to it before opening the channel.
-	if req.NodePubkey != nil && ctx.IsSet("connect") {
+	node, err := resolveNode(ctx, nodeID)
+	if err != nil {
+		return err
+	}
+	nodePubHex, err := hex.DecodeString(node.pubKey)
+	if err != nil {
+		return fmt.Errorf("unable to decode node public key: %v", err)
+	}
+	req.NodePubkey = nodePubHex
+
+	// If the host:port of the node was set, either explicitly or through
+	// its alias, we'll connect to it before opening the channel.
+	host := node.host
+	if ctx.IsSet("connect") {
+		host = ctx.String("connect")
+	}
+	if host != "" {
 		addr := &lnrpc.LightningAddress{
-			Pubkey: hex.EncodeToString(req.NodePubkey),
-			Host:   ctx.String("connect"),
+			Pubkey: node.pubKey,
+			Host:   host,
 		}
 
 		req := &lnrpc.ConnectPeerRequest{
@@ -664,6 +834,31 @@ func openChannel(ctx *cli.Context) error {
 
 	req.Private = ctx.Bool("private")
 
+	if ctx.Bool("psbt") {
+		shim := &lnrpc.PsbtShim{
+			PendingChanId: make([]byte, 32),
+			NoPublish:     ctx.Bool("no_publish"),
+		}
+		if _, err := rand.Read(shim.PendingChanId); err != nil {
+			return fmt.Errorf("unable to generate pending chan "+
+				"id: %v", err)
+		}
+
+		if ctx.IsSet("base_psbt") {
+			shim.BasePsbt, err = base64.StdEncoding.DecodeString(
+				ctx.String("base_psbt"),
+			)
+			if err != nil {
+				return fmt.Errorf("unable to decode base "+
+					"psbt: %v", err)
+			}
+		}
+
+		req.PsbtShim = shim
+	} else if ctx.IsSet("base_psbt") || ctx.IsSet("no_publish") {
+		return fmt.Errorf("--base_psbt and --no_publish require --psbt")
+	}
+
 	stream, err := client.OpenChannel(ctxb, req)
 	if err != nil {
 		return err
@@ -678,6 +873,25 @@ func openChannel(ctx *cli.Context) error {
 		}
 
 		switch update := resp.Update.(type) {
+		case *lnrpc.OpenStatusUpdate_PsbtFund:
+			// The funding flow only continues once the PSBT has
+			// been funded and signed through fundingstatestep, so
+			// we'll keep waiting for the channel to be pending.
+			psbtFund := update.PsbtFund
+			printJSON(struct {
+				PendingChanID  string `json:"pending_chan_id"`
+				FundingAddress string `json:"funding_address"`
+				FundingAmount  int64  `json:"funding_amount"`
+				Psbt           string `json:"psbt"`
+			}{
+				PendingChanID:  hex.EncodeToString(resp.PendingChanId),
+				FundingAddress: psbtFund.FundingAddress,
+				FundingAmount:  psbtFund.FundingAmount,
+				Psbt: base64.StdEncoding.EncodeToString(
+					psbtFund.Psbt,
+				),
+			})
+
 		case *lnrpc.OpenStatusUpdate_ChanPending:
 			txid, err := chainhash.NewHash(update.ChanPending.Txid)
 			if err != nil {
@@ -731,6 +945,264 @@ func openChannel(ctx *cli.Context) error {
 	}
 }
 
+var fundingStateStepCommand = cli.Command{
+	Name:     "fundingstatestep",
+	Category: "Channels",
+	Usage:    "Continue the PSBT funding flow of a pending channel.",
+	Description: `
+	Continue the funding flow of a channel opened with openchannel --psbt,
+	identified by the pending channel ID printed by openchannel. Exactly
+	one of the following steps must be specified:
+
+	--verify: verify that the funded, but not yet signed base64 encoded
+	PSBT pays to the funding output of the channel. All of its inputs must
+	spend segwit outputs.
+
+	--finalize: hand over the signed base64 encoded PSBT, whose inputs must
+	all be finalized. Alternatively, the fully signed funding transaction
+	can be passed in its raw hex encoding with --final_tx. The funding
+	transaction is broadcast once the remote node has signed the channel.
+
+	--cancel: abandon the funding flow, which is only possible as long as
+	the signed funding transaction hasn't been handed over.`,
+	ArgsUsage: "pending-chan-id",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "verify",
+			Usage: "the funded base64 encoded PSBT to verify",
+		},
+		cli.StringFlag{
+			Name:  "finalize",
+			Usage: "the signed base64 encoded PSBT",
+		},
+		cli.StringFlag{
+			Name:  "final_tx",
+			Usage: "the signed funding transaction in raw hex",
+		},
+		cli.BoolFlag{
+			Name:  "cancel",
+			Usage: "cancel the funding flow",
+		},
+	},
+	Action: actionDecorator(fundingStateStep),
+}
+
+func fundingStateStep(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		cli.ShowCommandHelp(ctx, "fundingstatestep")
+		return nil
+	}
+
+	pendingChanID, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to decode pending chan id: %v", err)
+	}
+
+	req := &lnrpc.FundingTransitionMsg{}
+	switch {
+	case ctx.IsSet("verify"):
+		fundedPsbt, err := base64.StdEncoding.DecodeString(
+			ctx.String("verify"),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode psbt: %v", err)
+		}
+
+		req.Trigger = &lnrpc.FundingTransitionMsg_PsbtVerify{
+			PsbtVerify: &lnrpc.FundingPsbtVerify{
+				FundedPsbt:    fundedPsbt,
+				PendingChanId: pendingChanID,
+			},
+		}
+
+	case ctx.IsSet("finalize"):
+		signedPsbt, err := base64.StdEncoding.DecodeString(
+			ctx.String("finalize"),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode psbt: %v", err)
+		}
+
+		req.Trigger = &lnrpc.FundingTransitionMsg_PsbtFinalize{
+			PsbtFinalize: &lnrpc.FundingPsbtFinalize{
+				SignedPsbt:    signedPsbt,
+				PendingChanId: pendingChanID,
+			},
+		}
+
+	case ctx.IsSet("final_tx"):
+		finalTx, err := hex.DecodeString(ctx.String("final_tx"))
+		if err != nil {
+			return fmt.Errorf("unable to decode final tx: %v", err)
+		}
+
+		req.Trigger = &lnrpc.FundingTransitionMsg_PsbtFinalize{
+			PsbtFinalize: &lnrpc.FundingPsbtFinalize{
+				FinalRawTx:    finalTx,
+				PendingChanId: pendingChanID,
+			},
+		}
+
+	case ctx.Bool("cancel"):
+		req.Trigger = &lnrpc.FundingTransitionMsg_ShimCancel{
+			ShimCancel: &lnrpc.FundingShimCancel{
+				PendingChanId: pendingChanID,
+			},
+		}
+
+	default:
+		return fmt.Errorf("one of --verify, --finalize, --final_tx " +
+			"or --cancel must be set")
+	}
+
+	resp, err := client.FundingStateStep(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var batchOpenChannelCommand = cli.Command{
+	Name:     "batchopenchannel",
+	Category: "Channels",
+	Usage: "Open multiple channels to existing peers in a single " +
+		"transaction.",
+	Description: `
+	Attempt to open multiple new channels to existing peers, all funded by
+	a single on-chain transaction with at most one change output. Either
+	all channels are opened, or none of them are: the funding transaction
+	is only broadcast once every peer has signed, and the whole batch is
+	aborted if any of the channels fails.
+
+	The channels-json param decodes the list of channels to open in the
+	following format:
+
+	    '[{"node_pubkey": "03...", "local_funding_amount": 500000,
+	       "push_sat": 0, "private": false}, {...}]'
+
+	Each channel additionally accepts the optional min_htlc_msat and
+	remote_csv_delay fields, with the same meaning as the flags of the
+	openchannel command.
+
+	One can manually set the fee to be used for the funding transaction via either
+	the --conf_target or --sat_per_byte arguments. This is optional.`,
+	ArgsUsage: "channels-json",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the transaction",
+		},
+		cli.Uint64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of confirmations " +
+				"each one of your outputs used for the funding " +
+				"transaction must satisfy",
+			Value: 1,
+		},
+	},
+	Action: actionDecorator(batchOpenChannel),
+}
+
+// batchChannelJSON is the format of a single channel within the JSON list
+// passed to the batchopenchannel command.
+type batchChannelJSON struct {
+	NodePubkey         string `json:"node_pubkey"`
+	LocalFundingAmount int64  `json:"local_funding_amount"`
+	PushSat            int64  `json:"push_sat"`
+	Private            bool   `json:"private"`
+	MinHtlcMsat        int64  `json:"min_htlc_msat"`
+	RemoteCsvDelay     uint32 `json:"remote_csv_delay"`
+}
+
+func batchOpenChannel(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments provided
+	if ctx.NArg() == 0 {
+		cli.ShowCommandHelp(ctx, "batchopenchannel")
+		return nil
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should be " +
+			"set, but not both")
+	}
+
+	var jsonChannels []batchChannelJSON
+	err := json.Unmarshal([]byte(ctx.Args().First()), &jsonChannels)
+	if err != nil {
+		return fmt.Errorf("unable to decode channels: %v", err)
+	}
+
+	minConfs := int32(ctx.Uint64("min_confs"))
+	req := &lnrpc.BatchOpenChannelRequest{
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		SatPerByte:       ctx.Int64("sat_per_byte"),
+		MinConfs:         minConfs,
+		SpendUnconfirmed: minConfs == 0,
+	}
+	for _, channel := range jsonChannels {
+		nodePubHex, err := hex.DecodeString(channel.NodePubkey)
+		if err != nil {
+			return fmt.Errorf("unable to decode node public key: %v",
+				err)
+		}
+
+		req.Channels = append(req.Channels, &lnrpc.BatchOpenChannel{
+			NodePubkey:         nodePubHex,
+			LocalFundingAmount: channel.LocalFundingAmount,
+			PushSat:            channel.PushSat,
+			Private:            channel.Private,
+			MinHtlcMsat:        channel.MinHtlcMsat,
+			RemoteCsvDelay:     channel.RemoteCsvDelay,
+		})
+	}
+
+	resp, err := client.BatchOpenChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	type pendingChannel struct {
+		ChannelPoint string `json:"channel_point"`
+	}
+	var pendingChannels []pendingChannel
+	for _, pending := range resp.PendingChannels {
+		txid, err := chainhash.NewHash(pending.Txid)
+		if err != nil {
+			return err
+		}
+
+		pendingChannels = append(pendingChannels, pendingChannel{
+			ChannelPoint: fmt.Sprintf("%v:%v", txid,
+				pending.OutputIndex),
+		})
+	}
+
+	printJSON(struct {
+		PendingChannels []pendingChannel `json:"pending_channels"`
+	}{
+		PendingChannels: pendingChannels,
+	})
+	return nil
+}
+
 // TODO(roasbeef): also allow short relative channel ID.
 
 var closeChannelCommand = cli.Command{
@@ -747,8 +1219,9 @@ var closeChannelCommand = cli.Command{
 
 	In the case of a cooperative closure, One can manually set the fee to
 	be used for the closing transaction via either the --conf_target or
-	--sat_per_byte arguments. This will be the starting value used during
-	fee negotiation. This is optional.
+	--sat_per_vbyte arguments. This will be the starting value used during
+	fee negotiation. This is optional. Our funds are paid to a fresh wallet
+	address, unless an address is specified via --delivery_addr.
 
 	To view which funding_txids/output_indexes can be used for a channel close,
 	see the channel_point values within the listchannels command output.
@@ -785,11 +1258,20 @@ var closeChannelCommand = cli.Command{
 				"used for fee estimation",
 		},
 		cli.Int64Flag{
-			Name: "sat_per_byte",
+			Name:  "sat_per_byte",
+			Usage: "Deprecated, use sat_per_vbyte instead.",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
 			Usage: "(optional) a manual fee expressed in " +
-				"sat/byte that should be used when crafting " +
+				"sat/vbyte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.StringFlag{
+			Name: "delivery_addr",
+			Usage: "(optional) an address to deliver our funds " +
+				"to in case of a cooperative close",
+		},
 	},
 	Action: actionDecorator(closeChannel),
 }
@@ -811,10 +1293,12 @@ func closeChannel(ctx *cli.Context) error {
 
 	// TODO(roasbeef): implement time deadline within server
 	req := &lnrpc.CloseChannelRequest{
-		ChannelPoint: channelPoint,
-		Force:        ctx.Bool("force"),
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		SatPerByte:   ctx.Int64("sat_per_byte"),
+		ChannelPoint:    channelPoint,
+		Force:           ctx.Bool("force"),
+		TargetConf:      int32(ctx.Int64("conf_target")),
+		SatPerByte:      ctx.Int64("sat_per_byte"),
+		SatPerVbyte:     ctx.Uint64("sat_per_vbyte"),
+		DeliveryAddress: ctx.String("delivery_addr"),
 	}
 
 	// After parsing the request, we'll spin up a goroutine that will
@@ -1159,6 +1643,152 @@ func abandonChannel(ctx *cli.Context) error {
 	return nil
 }
 
+var freezeChannelCommand = cli.Command{
+	Name:     "freezechannel",
+	Category: "Channels",
+	Usage:    "Stop a channel from carrying any new HTLCs.",
+	Description: `
+	Put a channel into drain mode, in which it neither accepts nor offers
+	any new HTLCs, while those already in flight are allowed to resolve.
+	This is useful before closing a channel, or carrying out any
+	maintenance on it. The number of HTLCs still in flight is returned,
+	so the command can be repeated until the channel has been drained.
+
+	The channel remains frozen until thawed through the --thaw flag, or
+	until lnd is restarted.
+
+	To view which funding_txids/output_indexes can be used for this command,
+	see the channel_point values within the listchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+		cli.BoolFlag{
+			Name: "thaw",
+			Usage: "take the channel out of drain mode, allowing " +
+				"it to carry new HTLCs once again",
+		},
+	},
+	Action: actionDecorator(freezeChannel),
+}
+
+func freezeChannel(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "freezechannel")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.FreezeChannelRequest{
+		ChannelPoint: channelPoint,
+		Thaw:         ctx.Bool("thaw"),
+	}
+
+	resp, err := client.FreezeChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var updateChanConstraintsCommand = cli.Command{
+	Name:     "updatechanconstraints",
+	Category: "Channels",
+	Usage:    "Renegotiate the constraints of an open channel.",
+	Description: `
+	Renegotiate the flow constraints which bound the HTLCs that the remote
+	party may offer over an open channel, without closing it. The channel
+	is quiesced for the duration of the update, and the remote peer must
+	support renegotiating them.
+
+	Any constraint that isn't specified is left unchanged.
+
+	To view which funding_txids/output_indexes can be used for this command,
+	see the channel_point values within the listchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+		cli.Uint64Flag{
+			Name: "max_pending_amt_msat",
+			Usage: "the maximum total value in millisatoshis of " +
+				"the HTLCs the remote party may have in flight",
+		},
+		cli.Uint64Flag{
+			Name: "min_htlc_msat",
+			Usage: "the smallest HTLC in millisatoshis the remote " +
+				"party may offer",
+		},
+		cli.Uint64Flag{
+			Name: "max_accepted_htlcs",
+			Usage: "the maximum number of HTLCs the remote party " +
+				"may have in flight",
+		},
+	},
+	Action: actionDecorator(updateChanConstraints),
+}
+
+func updateChanConstraints(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "updatechanconstraints")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.UpdateChannelConstraintsRequest{
+		ChannelPoint:      channelPoint,
+		MaxPendingAmtMsat: ctx.Uint64("max_pending_amt_msat"),
+		MinHtlcMsat:       ctx.Uint64("min_htlc_msat"),
+		MaxAcceptedHtlcs:  uint32(ctx.Uint64("max_accepted_htlcs")),
+	}
+
+	resp, err := client.UpdateChannelConstraints(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // parseChannelPoint parses a funding txid and output index from the command
 // line. Both named options as well as unnamed parameters are supported.
 func parseChannelPoint(ctx *cli.Context) (*lnrpc.ChannelPoint, error) {
@@ -1218,6 +1848,174 @@ func listPeers(ctx *cli.Context) error {
 	return nil
 }
 
+var htlcRateLimitsCommand = cli.Command{
+	Name:     "htlcratelimits",
+	Category: "Peers",
+	Usage: "Show the number of incoming HTLC adds accepted and " +
+		"rejected by the rate limiter for each connected peer.",
+	Action: actionDecorator(htlcRateLimits),
+}
+
+func htlcRateLimits(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListPeersRequest{}
+	resp, err := client.ListPeers(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	type peerRateLimit struct {
+		PubKey   string `json:"pub_key"`
+		Accepted uint64 `json:"accepted"`
+		Rejected uint64 `json:"rejected"`
+	}
+
+	peers := make([]peerRateLimit, 0, len(resp.Peers))
+	for _, peer := range resp.Peers {
+		stats := peer.GetHtlcRateLimit()
+		peers = append(peers, peerRateLimit{
+			PubKey:   peer.PubKey,
+			Accepted: stats.GetAccepted(),
+			Rejected: stats.GetRejected(),
+		})
+	}
+
+	printJSON(struct {
+		Peers []peerRateLimit `json:"peers"`
+	}{
+		Peers: peers,
+	})
+	return nil
+}
+
+var sendCustomCommand = cli.Command{
+	Name:      "sendcustom",
+	Category:  "Peers",
+	Usage:     "Send a custom message to a connected peer.",
+	ArgsUsage: "peer type data",
+	Description: `
+	Send a custom message to a connected peer. The message type must be odd
+	and at least 32768, and the data must be hex encoded. For example:
+
+	    lncli sendcustom <pubkey> 32769 0102
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "peer",
+			Usage: "the hex encoded pubkey of the peer",
+		},
+		cli.Uint64Flag{
+			Name:  "type",
+			Usage: "the message type",
+		},
+		cli.StringFlag{
+			Name:  "data",
+			Usage: "the hex encoded payload of the message",
+		},
+	},
+	Action: actionDecorator(sendCustom),
+}
+
+func sendCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		peerStr, dataStr string
+		msgType          uint64
+		err              error
+	)
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("peer"):
+		peerStr = ctx.String("peer")
+	case args.Present():
+		peerStr = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("peer argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("type"):
+		msgType = ctx.Uint64("type")
+	case args.Present():
+		msgType, err = strconv.ParseUint(args.First(), 10, 16)
+		if err != nil {
+			return fmt.Errorf("unable to decode type: %v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("type argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("data"):
+		dataStr = ctx.String("data")
+	case args.Present():
+		dataStr = args.First()
+	}
+
+	peer, err := hex.DecodeString(peerStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode peer pubkey: %v", err)
+	}
+	data, err := hex.DecodeString(dataStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode data: %v", err)
+	}
+
+	req := &lnrpc.SendCustomMessageRequest{
+		Peer: peer,
+		Type: uint32(msgType),
+		Data: data,
+	}
+	resp, err := client.SendCustomMessage(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var subscribeCustomCommand = cli.Command{
+	Name:     "subscribecustom",
+	Category: "Peers",
+	Usage:    "Subscribe to the custom messages sent by our peers.",
+	Action:   actionDecorator(subscribeCustom),
+}
+
+func subscribeCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeCustomMessages(
+		ctxb, &lnrpc.SubscribeCustomMessagesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+	}
+}
+
 var createCommand = cli.Command{
 	Name:     "create",
 	Category: "Startup",
@@ -1618,6 +2416,63 @@ func changePassword(ctx *cli.Context) error {
 	return nil
 }
 
+var stateCommand = cli.Command{
+	Name:     "state",
+	Category: "Startup",
+	Usage:    "Get the current state of the wallet and RPC.",
+	Description: `
+	Get the current state of the wallet and RPC. The state is one of
+	NON_EXISTING, LOCKED, UNLOCKED, RPC_ACTIVE or SERVER_ACTIVE. This
+	command doesn't require a macaroon and can be used both before and
+	after the wallet has been unlocked.
+
+	If the --subscribe flag is set, every state transition is printed until
+	the daemon shuts down.
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "subscribe",
+			Usage: "keep printing every state transition",
+		},
+	},
+	Action: actionDecorator(getState),
+}
+
+func getState(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getStateServiceClient(ctx)
+	defer cleanUp()
+
+	if !ctx.Bool("subscribe") {
+		resp, err := client.GetState(ctxb, &lnrpc.GetStateRequest{})
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+		return nil
+	}
+
+	stream, err := client.SubscribeState(
+		ctxb, &lnrpc.SubscribeStateRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+	}
+}
+
 var walletBalanceCommand = cli.Command{
 	Name:     "walletbalance",
 	Category: "Wallet",
@@ -1645,6 +2500,12 @@ var channelBalanceCommand = cli.Command{
 	Category: "Channels",
 	Usage: "Returns the sum of the total available channel balance across " +
 		"all open channels.",
+	Description: `
+	Returns the local and remote balances of all open channels, the
+	balances of the channels that are still pending open, and the amounts
+	of the in-flight HTLCs that aren't yet part of either balance, in both
+	satoshis and milli-satoshis.
+	`,
 	Action: actionDecorator(channelBalance),
 }
 
@@ -1694,9 +2555,12 @@ func getInfo(ctx *cli.Context) error {
 		BlockHash           string   `json:"block_hash"`
 		BestHeaderTimestamp int64    `json:"best_header_timestamp"`
 		SyncedToChain       bool     `json:"synced_to_chain"`
+		SyncedToGraph       bool     `json:"synced_to_graph"`
 		Testnet             bool     `json:"testnet"`
 		Chains              []string `json:"chains"`
+		Network             string   `json:"network"`
 		Uris                []string `json:"uris"`
+		Features            []uint32 `json:"features"`
 	}{
 		Version:             resp.Version,
 		IdentityPubkey:      resp.IdentityPubkey,
@@ -1709,27 +2573,132 @@ func getInfo(ctx *cli.Context) error {
 		BlockHash:           resp.BlockHash,
 		BestHeaderTimestamp: resp.BestHeaderTimestamp,
 		SyncedToChain:       resp.SyncedToChain,
+		SyncedToGraph:       resp.SyncedToGraph,
 		Testnet:             resp.Testnet,
 		Chains:              resp.Chains,
+		Network:             resp.Network,
 		Uris:                resp.Uris,
+		Features:            resp.Features,
 	})
 	return nil
 }
 
-var pendingChannelsCommand = cli.Command{
-	Name:     "pendingchannels",
-	Category: "Channels",
-	Usage:    "Display information pertaining to pending channels.",
-	Action:   actionDecorator(pendingChannels),
+var getRecoveryInfoCommand = cli.Command{
+	Name:  "getrecoveryinfo",
+	Usage: "Display information about an ongoing recovery attempt.",
+	Description: `
+	Display whether the wallet is in recovery mode, whether the recovery is
+	finished, and the progress made so far as a percentage. Once the
+	recovery is finished, the full balance of the restored wallet is
+	visible.
+	`,
+	Action: actionDecorator(getRecoveryInfo),
 }
 
-func pendingChannels(ctx *cli.Context) error {
+func getRecoveryInfo(ctx *cli.Context) error {
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &lnrpc.PendingChannelsRequest{}
-	resp, err := client.PendingChannels(ctxb, req)
+	req := &lnrpc.GetRecoveryInfoRequest{}
+	resp, err := client.GetRecoveryInfo(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var updateNodeAnnouncementCommand = cli.Command{
+	Name:  "updatenodeannouncement",
+	Usage: "Update the node announcement at runtime.",
+	Description: `
+	Change the alias, color, advertised addresses and feature bits of the
+	node announcement. The announcement is re-signed and broadcast to the
+	network, so no restart is needed for the changes to take effect.
+
+	The address and feature flags can be repeated, for example:
+
+	    lncli updatenodeannouncement --alias=bob --add_address=1.2.3.4:9735 --set_feature=23
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "alias",
+			Usage: "(optional) the new alias of the node",
+		},
+		cli.StringFlag{
+			Name: "color",
+			Usage: "(optional) the new color of the node, in the " +
+				"form #RRGGBB",
+		},
+		cli.StringSliceFlag{
+			Name:  "add_address",
+			Usage: "(optional) an address to start advertising",
+		},
+		cli.StringSliceFlag{
+			Name:  "remove_address",
+			Usage: "(optional) an address to stop advertising",
+		},
+		cli.IntSliceFlag{
+			Name:  "set_feature",
+			Usage: "(optional) a feature bit to set",
+		},
+		cli.IntSliceFlag{
+			Name:  "unset_feature",
+			Usage: "(optional) a feature bit to unset",
+		},
+	},
+	Action: actionDecorator(updateNodeAnnouncement),
+}
+
+func updateNodeAnnouncement(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	toFeatureBits := func(bits []int) []uint32 {
+		featureBits := make([]uint32, 0, len(bits))
+		for _, bit := range bits {
+			featureBits = append(featureBits, uint32(bit))
+		}
+		return featureBits
+	}
+
+	req := &lnrpc.UpdateNodeAnnouncementRequest{
+		Alias:           ctx.String("alias"),
+		Color:           ctx.String("color"),
+		AddAddresses:    ctx.StringSlice("add_address"),
+		RemoveAddresses: ctx.StringSlice("remove_address"),
+		SetFeatures:     toFeatureBits(ctx.IntSlice("set_feature")),
+		UnsetFeatures:   toFeatureBits(ctx.IntSlice("unset_feature")),
+	}
+
+	resp, err := client.UpdateNodeAnnouncement(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var pendingChannelsCommand = cli.Command{
+	Name:     "pendingchannels",
+	Category: "Channels",
+	Usage:    "Display information pertaining to pending channels.",
+	Action:   actionDecorator(pendingChannels),
+}
+
+func pendingChannels(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.PendingChannelsRequest{}
+	resp, err := client.PendingChannels(ctxb, req)
 	if err != nil {
 		return err
 	}
@@ -1760,6 +2729,16 @@ var listChannelsCommand = cli.Command{
 			Name:  "private_only",
 			Usage: "only list channels which are currently private",
 		},
+		cli.StringFlag{
+			Name: "peer",
+			Usage: "(optional) only list channels with the peer " +
+				"of this hex encoded pubkey",
+		},
+		cli.BoolFlag{
+			Name: "peer_alias_lookup",
+			Usage: "look up and include the alias of the peer of " +
+				"each channel",
+		},
 	},
 	Action: actionDecorator(listChannels),
 }
@@ -1769,11 +2748,18 @@ func listChannels(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
+	peer, err := hex.DecodeString(ctx.String("peer"))
+	if err != nil {
+		return fmt.Errorf("unable to decode peer pubkey: %v", err)
+	}
+
 	req := &lnrpc.ListChannelsRequest{
-		ActiveOnly:   ctx.Bool("active_only"),
-		InactiveOnly: ctx.Bool("inactive_only"),
-		PublicOnly:   ctx.Bool("public_only"),
-		PrivateOnly:  ctx.Bool("private_only"),
+		ActiveOnly:      ctx.Bool("active_only"),
+		InactiveOnly:    ctx.Bool("inactive_only"),
+		PublicOnly:      ctx.Bool("public_only"),
+		PrivateOnly:     ctx.Bool("private_only"),
+		Peer:            peer,
+		PeerAliasLookup: ctx.Bool("peer_alias_lookup"),
 	}
 
 	resp, err := client.ListChannels(ctxb, req)
@@ -1788,59 +2774,638 @@ func listChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var exportChanAuditCommand = cli.Command{
+	Name:     "exportchanaudit",
+	Category: "Channels",
+	Usage:    "Export a signed snapshot of the state of all open channels.",
+	Description: `
+	Export a snapshot of the balances and commitments of all open channels,
+	signed by the identity key of the node. The snapshot holds no secrets,
+	and can be handed to an auditor in order to attest the off-chain funds
+	of the node.
+
+	The signature is made over the serialized snapshot, in the same way as
+	signmessage does, so it can be verified through verifymessage:
+
+	    lncli exportchanaudit --snapshot_file=audit.json
+	    lncli verifymessage --msg="$(cat audit.json)" --sig=<signature>
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "snapshot_file",
+			Usage: "(optional) the file to write the serialized " +
+				"snapshot to",
+		},
+	},
+	Action: actionDecorator(exportChanAudit),
+}
+
+func exportChanAudit(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ExportChannelAuditRequest{}
+	resp, err := client.ExportChannelAudit(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	if ctx.IsSet("snapshot_file") {
+		err := ioutil.WriteFile(
+			ctx.String("snapshot_file"), resp.Snapshot, 0644,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to write snapshot: %v", err)
+		}
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var exportChanBackupCommand = cli.Command{
+	Name:     "exportchanbackup",
+	Category: "Channels",
+	Usage: "Obtain a static channel back up for a selected " +
+		"channel, or all known channels",
+	ArgsUsage: "[chan_point] [--all] [--output_file]",
+	Description: `
+	This command allows a user to export a Static Channel Backup (SCB) for
+	a selected channel. SCB's are encrypted backups of a channel's initial
+	state that are encrypted with a key derived from the seed of a user. In
+	the case of partial or complete data loss, the SCB will allow the user
+	to reclaim settled funds in the channel at its final state. The
+	exported channel backups can be restored at a later time using the
+	restorechanbackup command.
+
+	This command will return one of two types of channel backups depending
+	on the set of passed arguments:
+
+	   * If a target channel point is specified, then a single channel
+	     backup containing only the information for that channel will be
+	     returned.
+
+	   * If the --all flag is passed, then a multi-channel backup will be
+	     returned. A multi backup is a single encrypted blob (displayed in
+	     hex encoding) that contains several channels in a single cipher
+	     text.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "chan_point",
+			Usage: "the target channel to obtain an SCB for",
+		},
+		cli.BoolFlag{
+			Name: "all",
+			Usage: "if specified, then a multi backup of all " +
+				"active channels will be returned",
+		},
+		cli.StringFlag{
+			Name: "output_file",
+			Usage: `
+			if specified, then rather than printing a JSON output
+			of the static channel backup, a serialized version of
+			the backup (either Single or Multi) will be written to
+			the target file, this is the same format used by lnd in
+			its channel.backup file`,
+		},
+	},
+	Action: actionDecorator(exportChanBackup),
+}
+
+func exportChanBackup(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments provided
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "exportchanbackup")
+		return nil
+	}
+
+	var chanPointStr string
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("chan_point"):
+		chanPointStr = ctx.String("chan_point")
+
+	case args.Present():
+		chanPointStr = args.First()
+
+	case !ctx.IsSet("all"):
+		return fmt.Errorf("must specify chan_point if --all isn't set")
+	}
+
+	if chanPointStr != "" {
+		split := strings.Split(chanPointStr, ":")
+		if len(split) != 2 {
+			return fmt.Errorf("expecting chan_point to be in format of: " +
+				"txid:index")
+		}
+
+		index, err := strconv.ParseInt(split[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("unable to decode output index: %v", err)
+		}
+
+		chanPointRPC := &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+				FundingTxidStr: split[0],
+			},
+			OutputIndex: uint32(index),
+		}
+
+		chanBackup, err := client.ExportChannelBackup(
+			ctxb, &lnrpc.ExportChannelBackupRequest{
+				ChanPoint: chanPointRPC,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		if ctx.IsSet("output_file") {
+			return ioutil.WriteFile(
+				ctx.String("output_file"),
+				chanBackup.ChanBackup,
+				0666,
+			)
+		}
+
+		printJSON(struct {
+			ChanPoint  string `json:"chan_point"`
+			ChanBackup string `json:"chan_backup"`
+		}{
+			ChanPoint:  chanPointStr,
+			ChanBackup: hex.EncodeToString(chanBackup.ChanBackup),
+		})
+		return nil
+	}
+
+	if !ctx.IsSet("all") {
+		return fmt.Errorf("if a channel isn't specified, --all must be")
+	}
+
+	chanBackup, err := client.ExportAllChannelBackups(
+		ctxb, &lnrpc.ChanBackupExportRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	if ctx.IsSet("output_file") {
+		return ioutil.WriteFile(
+			ctx.String("output_file"),
+			chanBackup.MultiChanBackup.MultiChanBackup,
+			0666,
+		)
+	}
+
+	var chanPoints []string
+	for _, chanPoint := range chanBackup.MultiChanBackup.ChanPoints {
+		txid, err := chainhash.NewHash(chanPoint.GetFundingTxidBytes())
+		if err != nil {
+			return err
+		}
+
+		chanPoints = append(chanPoints, fmt.Sprintf("%v:%v", txid,
+			chanPoint.OutputIndex))
+	}
+
+	printJSON(struct {
+		ChanPoints      []string `json:"chan_points"`
+		MultiChanBackup string   `json:"multi_chan_backup"`
+	}{
+		ChanPoints: chanPoints,
+		MultiChanBackup: hex.EncodeToString(
+			chanBackup.MultiChanBackup.MultiChanBackup,
+		),
+	})
+	return nil
+}
+
+// errMissingChanBackup is returned when restorechanbackup is invoked without
+// any of the supported backup sources.
+var errMissingChanBackup = errors.New("missing channel backup, one of " +
+	"--single_backup, --multi_backup, --single_file or --multi_file " +
+	"must be set")
+
+var restoreChanBackupCommand = cli.Command{
+	Name:     "restorechanbackup",
+	Category: "Channels",
+	Usage: "Restore an existing single or multi-channel static channel " +
+		"backup",
+	ArgsUsage: "[--single_backup] [--multi_backup] [--single_file] " +
+		"[--multi_file]",
+	Description: `
+	Allows a user to restore a Static Channel Backup (SCB) that was
+	obtained either via the exportchanbackup command, or from lnd's
+	automatically managed channel.backup file. This command should be used
+	if a user is attempting to restore a channel due to data loss on a
+	running node restored with the same seed as the node that created the
+	channel. If successful, this command will allow the user to recover
+	the settled funds stored in the recovered channels.
+
+	The command will accept backups in one of four forms:
+
+	   * A single channel packed SCB, which can be obtained from
+	     exportchanbackup. This should be passed in hex encoded format.
+
+	   * A packed multi-channel SCB, which couples several individual
+	     static channel backups in single blob.
+
+	   * A file path which points to a packed single-channel backup within a
+	     file, using the same format that lnd does in its channel.backup
+	     file.
+
+	   * A file path which points to a packed multi-channel backup within a
+	     file, using the same format that lnd does in its channel.backup
+	     file.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "single_backup",
+			Usage: "a hex encoded single channel backup obtained " +
+				"from exportchanbackup",
+		},
+		cli.StringFlag{
+			Name: "multi_backup",
+			Usage: "a hex encoded multi-channel backup obtained " +
+				"from exportchanbackup",
+		},
+		cli.StringFlag{
+			Name:  "single_file",
+			Usage: "the path to a single-channel backup file",
+		},
+		cli.StringFlag{
+			Name:  "multi_file",
+			Usage: "the path to a multi-channel back up file",
+		},
+	},
+	Action: actionDecorator(restoreChanBackup),
+}
+
+func restoreChanBackup(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments provided
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "restorechanbackup")
+		return nil
+	}
+
+	req, err := parseChanBackups(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.RestoreChannelBackups(ctxb, req)
+	if err != nil {
+		return fmt.Errorf("unable to restore chan backups: %v", err)
+	}
+
+	return nil
+}
+
+// parseChanBackups parses the channel backup passed in through one of the
+// single_backup, multi_backup, single_file or multi_file flags.
+func parseChanBackups(ctx *cli.Context) (*lnrpc.RestoreChanBackupRequest, error) {
+	var req lnrpc.RestoreChanBackupRequest
+
+	switch {
+	case ctx.IsSet("single_backup"):
+		packedBackup, err := hex.DecodeString(
+			ctx.String("single_backup"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode single packed "+
+				"backup: %v", err)
+		}
+
+		req.Backup = &lnrpc.RestoreChanBackupRequest_ChanBackups{
+			ChanBackups: &lnrpc.ChannelBackups{
+				ChanBackups: []*lnrpc.ChannelBackup{{
+					ChanBackup: packedBackup,
+				}},
+			},
+		}
+
+	case ctx.IsSet("multi_backup"):
+		packedMulti, err := hex.DecodeString(
+			ctx.String("multi_backup"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode multi packed "+
+				"backup: %v", err)
+		}
+
+		req.Backup = &lnrpc.RestoreChanBackupRequest_MultiChanBackup{
+			MultiChanBackup: packedMulti,
+		}
+
+	case ctx.IsSet("single_file"):
+		packedSingle, err := ioutil.ReadFile(ctx.String("single_file"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode single packed "+
+				"backup: %v", err)
+		}
+
+		req.Backup = &lnrpc.RestoreChanBackupRequest_ChanBackups{
+			ChanBackups: &lnrpc.ChannelBackups{
+				ChanBackups: []*lnrpc.ChannelBackup{{
+					ChanBackup: packedSingle,
+				}},
+			},
+		}
+
+	case ctx.IsSet("multi_file"):
+		packedMulti, err := ioutil.ReadFile(ctx.String("multi_file"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode multi packed "+
+				"backup: %v", err)
+		}
+
+		req.Backup = &lnrpc.RestoreChanBackupRequest_MultiChanBackup{
+			MultiChanBackup: packedMulti,
+		}
+
+	default:
+		return nil, errMissingChanBackup
+	}
+
+	return &req, nil
+}
+
+var verifyChanBackupCommand = cli.Command{
+	Name:     "verifychanbackup",
+	Category: "Channels",
+	Usage:    "Verify an existing channel backup",
+	ArgsUsage: "[--single_backup] [--multi_backup] [--single_file] " +
+		"[--multi_file]",
+	Description: `
+	This command allows a user to verify an existing Single or Multi channel
+	backup for integrity. This is useful when a user has a backup, but is
+	unsure as to if it's valid or for the target node.
+
+	The command will accept backups in one of four forms:
+
+	   * A single channel packed SCB, which can be obtained from
+	     exportchanbackup. This should be passed in hex encoded format.
+
+	   * A packed multi-channel SCB, which couples several individual
+	     static channel backups in single blob.
+
+	   * A file path which points to a packed single-channel backup within a
+	     file, using the same format that lnd does in its channel.backup
+	     file.
+
+	   * A file path which points to a packed multi-channel backup within a
+	     file, using the same format that lnd does in its channel.backup
+	     file.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "single_backup",
+			Usage: "a hex encoded single channel backup obtained " +
+				"from exportchanbackup",
+		},
+		cli.StringFlag{
+			Name: "multi_backup",
+			Usage: "a hex encoded multi-channel backup obtained " +
+				"from exportchanbackup",
+		},
+		cli.StringFlag{
+			Name:  "single_file",
+			Usage: "the path to a single-channel backup file",
+		},
+		cli.StringFlag{
+			Name:  "multi_file",
+			Usage: "the path to a multi-channel back up file",
+		},
+	},
+	Action: actionDecorator(verifyChanBackup),
+}
+
+func verifyChanBackup(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments provided
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "verifychanbackup")
+		return nil
+	}
+
+	backups, err := parseChanBackups(ctx)
+	if err != nil {
+		return err
+	}
+
+	verifyReq := lnrpc.ChanBackupSnapshot{}
+
+	if backups.GetChanBackups() != nil {
+		verifyReq.SingleChanBackups = backups.GetChanBackups()
+	}
+	if backups.GetMultiChanBackup() != nil {
+		verifyReq.MultiChanBackup = &lnrpc.MultiChanBackup{
+			MultiChanBackup: backups.GetMultiChanBackup(),
+		}
+	}
+
+	resp, err := client.VerifyChanBackup(ctxb, &verifyReq)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var closedChannelsCommand = cli.Command{
 	Name:     "closedchannels",
 	Category: "Channels",
 	Usage:    "List all closed channels.",
 	Flags: []cli.Flag{
-		cli.BoolFlag{
-			Name:  "cooperative",
-			Usage: "list channels that were closed cooperatively",
-		},
-		cli.BoolFlag{
-			Name: "local_force",
-			Usage: "list channels that were force-closed " +
-				"by the local node",
-		},
-		cli.BoolFlag{
-			Name: "remote_force",
-			Usage: "list channels that were force-closed " +
-				"by the remote node",
-		},
-		cli.BoolFlag{
-			Name: "breach",
-			Usage: "list channels for which the remote node " +
-				"attempted to broadcast a prior " +
-				"revoked channel state",
+		cli.BoolFlag{
+			Name:  "cooperative",
+			Usage: "list channels that were closed cooperatively",
+		},
+		cli.BoolFlag{
+			Name: "local_force",
+			Usage: "list channels that were force-closed " +
+				"by the local node",
+		},
+		cli.BoolFlag{
+			Name: "remote_force",
+			Usage: "list channels that were force-closed " +
+				"by the remote node",
+		},
+		cli.BoolFlag{
+			Name: "breach",
+			Usage: "list channels for which the remote node " +
+				"attempted to broadcast a prior " +
+				"revoked channel state",
+		},
+		cli.BoolFlag{
+			Name:  "funding_canceled",
+			Usage: "list channels that were never fully opened",
+		},
+		cli.BoolFlag{
+			Name: "abandoned",
+			Usage: "list channels that were abandoned by " +
+				"the local node",
+		},
+	},
+	Action: actionDecorator(closedChannels),
+}
+
+func closedChannels(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ClosedChannelsRequest{
+		Cooperative:     ctx.Bool("cooperative"),
+		LocalForce:      ctx.Bool("local_force"),
+		RemoteForce:     ctx.Bool("remote_force"),
+		Breach:          ctx.Bool("breach"),
+		FundingCanceled: ctx.Bool("funding_canceled"),
+		Abandoned:       ctx.Bool("abandoned"),
+	}
+
+	resp, err := client.ClosedChannels(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var archiveClosedChannelsCommand = cli.Command{
+	Name:     "archiveclosedchannels",
+	Category: "Channels",
+	Usage:    "Compact the records of fully closed channels.",
+	Description: `
+	Compact the records of all channels that have been fully closed and
+	resolved, dropping the state that is only needed to help the remote
+	party recover from data loss.
+
+	If --retention_blocks is set, the records of resolved channels that
+	were closed at least that many blocks ago are deleted altogether.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "retention_blocks",
+			Usage: "(optional) the number of blocks after a " +
+				"channel was closed at which its record is " +
+				"deleted altogether",
+		},
+	},
+	Action: actionDecorator(archiveClosedChannels),
+}
+
+func archiveClosedChannels(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ArchiveClosedChannelsRequest{
+		RetentionBlocks: uint32(ctx.Uint64("retention_blocks")),
+	}
+
+	resp, err := client.ArchiveClosedChannels(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var lookupHtlcResolutionCommand = cli.Command{
+	Name:      "lookuphtlcresolution",
+	Category:  "Channels",
+	Usage:     "Look up how an HTLC that went to chain was resolved.",
+	ArgsUsage: "chan_id htlc_index",
+	Description: `
+	Look up how an HTLC that went to chain was finally resolved: whether it
+	was settled or timed out, the outpoint that resolved it and the amount
+	that was recovered.
+
+	The HTLC is identified by the short channel ID of its channel and its
+	index. As incoming and outgoing HTLCs are indexed separately,
+	--incoming must be set to look up an HTLC extended to us.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "chan_id",
+			Usage: "the short channel ID of the channel of the HTLC",
 		},
-		cli.BoolFlag{
-			Name:  "funding_canceled",
-			Usage: "list channels that were never fully opened",
+		cli.Uint64Flag{
+			Name:  "htlc_index",
+			Usage: "the index of the HTLC",
 		},
 		cli.BoolFlag{
-			Name: "abandoned",
-			Usage: "list channels that were abandoned by " +
-				"the local node",
+			Name:  "incoming",
+			Usage: "whether the HTLC was extended to us",
 		},
 	},
-	Action: actionDecorator(closedChannels),
+	Action: actionDecorator(lookupHtlcResolution),
 }
 
-func closedChannels(ctx *cli.Context) error {
+func lookupHtlcResolution(ctx *cli.Context) error {
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &lnrpc.ClosedChannelsRequest{
-		Cooperative:     ctx.Bool("cooperative"),
-		LocalForce:      ctx.Bool("local_force"),
-		RemoteForce:     ctx.Bool("remote_force"),
-		Breach:          ctx.Bool("breach"),
-		FundingCanceled: ctx.Bool("funding_cancelled"),
-		Abandoned:       ctx.Bool("abandoned"),
+	var (
+		chanID, htlcIndex uint64
+		err               error
+	)
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("chan_id"):
+		chanID = ctx.Uint64("chan_id")
+	case args.Present():
+		chanID, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode chan_id: %v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("chan_id argument missing")
 	}
 
-	resp, err := client.ClosedChannels(ctxb, req)
+	switch {
+	case ctx.IsSet("htlc_index"):
+		htlcIndex = ctx.Uint64("htlc_index")
+	case args.Present():
+		htlcIndex, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode htlc_index: %v", err)
+		}
+	default:
+		return fmt.Errorf("htlc_index argument missing")
+	}
+
+	req := &lnrpc.LookupHtlcResolutionRequest{
+		ChanId:    chanID,
+		HtlcIndex: htlcIndex,
+		Incoming:  ctx.Bool("incoming"),
+	}
+
+	resp, err := client.LookupHtlcResolution(ctxb, req)
 	if err != nil {
 		return err
 	}
@@ -1879,8 +3444,8 @@ var sendPaymentCommand = cli.Command{
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: "dest, d",
-			Usage: "the compressed identity pubkey of the " +
-				"payment recipient",
+			Usage: "the compressed identity pubkey, or the " +
+				"alias, of the payment recipient",
 		},
 		cli.Int64Flag{
 			Name:  "amt, a",
@@ -1916,6 +3481,11 @@ var sendPaymentCommand = cli.Command{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
 		},
+		cli.StringFlag{
+			Name: "metadata",
+			Usage: "optional hex-encoded metadata to store along " +
+				"with the payment",
+		},
 	},
 	Action: sendPayment,
 }
@@ -1995,6 +3565,11 @@ func sendPayment(ctx *cli.Context) error {
 		return err
 	}
 
+	metadata, err := hex.DecodeString(ctx.String("metadata"))
+	if err != nil {
+		return fmt.Errorf("unable to parse metadata: %v", err)
+	}
+
 	// If a payment request was provided, we can exit early since all of the
 	// details of the payment are encoded within the request.
 	if ctx.IsSet("pay_req") {
@@ -2008,6 +3583,7 @@ func sendPayment(ctx *cli.Context) error {
 			PaymentRequest: ctx.String("pay_req"),
 			Amt:            ctx.Int64("amt"),
 			FeeLimit:       feeLimit,
+			Metadata:       metadata,
 		}
 
 		return sendPaymentRequest(client, req)
@@ -2020,15 +3596,22 @@ func sendPayment(ctx *cli.Context) error {
 
 	args := ctx.Args()
 
+	var destID string
 	switch {
 	case ctx.IsSet("dest"):
-		destNode, err = hex.DecodeString(ctx.String("dest"))
+		destID = ctx.String("dest")
 	case args.Present():
-		destNode, err = hex.DecodeString(args.First())
+		destID = args.First()
 		args = args.Tail()
 	default:
 		return fmt.Errorf("destination txid argument missing")
 	}
+
+	dest, err := resolveNode(ctx, destID)
+	if err != nil {
+		return err
+	}
+	destNode, err = hex.DecodeString(dest.pubKey)
 	if err != nil {
 		return err
 	}
@@ -2052,6 +3635,7 @@ func sendPayment(ctx *cli.Context) error {
 		Dest:     destNode,
 		Amt:      amount,
 		FeeLimit: feeLimit,
+		Metadata: metadata,
 	}
 
 	if ctx.Bool("debug_send") && (ctx.IsSet("payment_hash") || args.Present()) {
@@ -2334,6 +3918,142 @@ func sendToRouteRequest(ctx *cli.Context, req *lnrpc.SendToRouteRequest) error {
 	return nil
 }
 
+var cancelPaymentCommand = cli.Command{
+	Name:      "cancelpayment",
+	Category:  "Payments",
+	Usage:     "Cancel a payment that's currently being sent.",
+	ArgsUsage: "payment_hash",
+	Description: `
+	Cancel a payment that's currently being sent, identified by its
+	payment hash. No further routes will be attempted for the payment,
+	although an HTLC that's already in flight can only be failed by the
+	remote party, so the pending sendpayment call only returns once that
+	HTLC has been resolved.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "payment_hash",
+			Usage: "the hash of the payment to cancel",
+		},
+	},
+	Action: actionDecorator(cancelPayment),
+}
+
+func cancelPayment(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var payHash string
+	switch {
+	case ctx.IsSet("payment_hash"):
+		payHash = ctx.String("payment_hash")
+	case ctx.Args().Present():
+		payHash = ctx.Args().First()
+	default:
+		return fmt.Errorf("payment hash argument missing")
+	}
+
+	req := &lnrpc.CancelPaymentRequest{
+		PaymentHashStr: payHash,
+	}
+
+	resp, err := client.CancelPayment(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var deletePaymentCommand = cli.Command{
+	Name:      "deletepayment",
+	Category:  "Payments",
+	Usage:     "Delete an outgoing payment from the database.",
+	ArgsUsage: "payment_hash",
+	Description: `
+	Delete the outgoing payment with the given payment hash from the
+	database. Payments that are still in flight can't be deleted. The
+	status of a deleted payment is kept, so that a payment that succeeded
+	still can't be paid again.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "payment_hash",
+			Usage: "the hash of the payment to delete",
+		},
+	},
+	Action: actionDecorator(deletePayment),
+}
+
+func deletePayment(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var payHash string
+	switch {
+	case ctx.IsSet("payment_hash"):
+		payHash = ctx.String("payment_hash")
+	case ctx.Args().Present():
+		payHash = ctx.Args().First()
+	default:
+		return fmt.Errorf("payment hash argument missing")
+	}
+
+	req := &lnrpc.DeletePaymentRequest{
+		PaymentHashStr: payHash,
+	}
+
+	resp, err := client.DeletePayment(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var deleteAllPaymentsCommand = cli.Command{
+	Name:     "deleteallpayments",
+	Category: "Payments",
+	Usage:    "Delete all outgoing payments from the database.",
+	Description: `
+	Delete all the outgoing payments from the database, or only the ones
+	that failed if --failed_only is set. Payments that are still in
+	flight are always kept when only deleting failed payments.
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "failed_only",
+			Usage: "only delete the payments that failed",
+		},
+	},
+	Action: actionDecorator(deleteAllPayments),
+}
+
+func deleteAllPayments(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.DeleteAllPaymentsRequest{
+		FailedPaymentsOnly: ctx.Bool("failed_only"),
+	}
+
+	resp, err := client.DeleteAllPayments(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var addInvoiceCommand = cli.Command{
 	Name:     "addinvoice",
 	Category: "Payments",
@@ -2355,6 +4075,11 @@ var addInvoiceCommand = cli.Command{
 			Name:  "receipt",
 			Usage: "an optional cryptographic receipt of payment",
 		},
+		cli.StringFlag{
+			Name: "metadata",
+			Usage: "optional hex-encoded metadata to store along " +
+				"with the invoice, e.g. an order identifier",
+		},
 		cli.StringFlag{
 			Name: "preimage",
 			Usage: "the hex-encoded preimage (32 byte) which will " +
@@ -2400,6 +4125,7 @@ func addInvoice(ctx *cli.Context) error {
 		preimage []byte
 		descHash []byte
 		receipt  []byte
+		metadata []byte
 		amt      int64
 		err      error
 	)
@@ -2441,9 +4167,15 @@ func addInvoice(ctx *cli.Context) error {
 		return fmt.Errorf("unable to parse receipt: %v", err)
 	}
 
+	metadata, err = hex.DecodeString(ctx.String("metadata"))
+	if err != nil {
+		return fmt.Errorf("unable to parse metadata: %v", err)
+	}
+
 	invoice := &lnrpc.Invoice{
 		Memo:            ctx.String("memo"),
 		Receipt:         receipt,
+		Metadata:        metadata,
 		RPreimage:       preimage,
 		Value:           amt,
 		DescriptionHash: descHash,
@@ -2452,19 +4184,185 @@ func addInvoice(ctx *cli.Context) error {
 		Private:         ctx.Bool("private"),
 	}
 
-	resp, err := client.AddInvoice(context.Background(), invoice)
+	resp, err := client.AddInvoice(context.Background(), invoice)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		RHash    string `json:"r_hash"`
+		PayReq   string `json:"pay_req"`
+		AddIndex uint64 `json:"add_index"`
+	}{
+		RHash:    hex.EncodeToString(resp.RHash),
+		PayReq:   resp.PaymentRequest,
+		AddIndex: resp.AddIndex,
+	})
+
+	return nil
+}
+
+var createOfferCommand = cli.Command{
+	Name:     "createoffer",
+	Category: "Payments",
+	Usage:    "Create a new BOLT-12 offer.",
+	Description: `
+	Create a new offer, which can be paid any number of times. Payers
+	request a fresh invoice for the offer over onion messages, so lnd must
+	be started with --offers.
+
+	Offers without an amount can be created by not supplying one or
+	providing an amount of 0. These offers allow the payer to specify the
+	amount they wish to send.`,
+	ArgsUsage: "description",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "description",
+			Usage: "a description of the purpose of the payment",
+		},
+		cli.Int64Flag{
+			Name:  "amt_msat",
+			Usage: "the amount of millisatoshis to request per item",
+		},
+		cli.StringFlag{
+			Name:  "issuer",
+			Usage: "(optional) a human readable name of the issuer",
+		},
+		cli.Uint64Flag{
+			Name: "quantity_max",
+			Usage: "(optional) the maximum number of items that can " +
+				"be paid for at once",
+		},
+		cli.Int64Flag{
+			Name: "expiry",
+			Usage: "(optional) the offer's expiry time in seconds. " +
+				"If not specified the offer never expires.",
+		},
+	},
+	Action: actionDecorator(createOffer),
+}
+
+func createOffer(ctx *cli.Context) error {
+	args := ctx.Args()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var description string
+	switch {
+	case ctx.IsSet("description"):
+		description = ctx.String("description")
+	case args.Present():
+		description = args.First()
+	default:
+		return fmt.Errorf("description argument missing")
+	}
+
+	req := &lnrpc.CreateOfferRequest{
+		AmtMsat:     ctx.Int64("amt_msat"),
+		Description: description,
+		Issuer:      ctx.String("issuer"),
+		QuantityMax: ctx.Uint64("quantity_max"),
+		Expiry:      ctx.Int64("expiry"),
+	}
+
+	resp, err := client.CreateOffer(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		Offer   string `json:"offer"`
+		OfferID string `json:"offer_id"`
+	}{
+		Offer:   resp.Offer,
+		OfferID: hex.EncodeToString(resp.OfferId),
+	})
+
+	return nil
+}
+
+var payOfferCommand = cli.Command{
+	Name:     "payoffer",
+	Category: "Payments",
+	Usage:    "Pay a BOLT-12 offer over lightning.",
+	Description: `
+	Request an invoice for the offer from its issuer over onion messages,
+	and pay it. This requires lnd to be started with --offers.`,
+	ArgsUsage: "offer",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "offer",
+			Usage: "the bech32 encoded offer to pay",
+		},
+		cli.Int64Flag{
+			Name: "amt_msat",
+			Usage: "the total amount of millisatoshis to pay, " +
+				"required if the offer doesn't specify an amount",
+		},
+		cli.Uint64Flag{
+			Name: "quantity",
+			Usage: "(optional) the number of items to pay for, if the " +
+				"offer permits multiple items",
+		},
+		cli.StringFlag{
+			Name:  "payer_note",
+			Usage: "(optional) a note to send along with the request",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "maximum fee allowed in satoshis when sending " +
+				"the payment",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit_percent",
+			Usage: "percentage of the payment's amount used as the " +
+				"maximum fee allowed when sending the payment",
+		},
+	},
+	Action: actionDecorator(payOffer),
+}
+
+func payOffer(ctx *cli.Context) error {
+	args := ctx.Args()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var offer string
+	switch {
+	case ctx.IsSet("offer"):
+		offer = ctx.String("offer")
+	case args.Present():
+		offer = args.First()
+	default:
+		return fmt.Errorf("offer argument missing")
+	}
+
+	feeLimit, err := retrieveFeeLimit(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.PayOfferRequest{
+		Offer:     offer,
+		AmtMsat:   ctx.Int64("amt_msat"),
+		Quantity:  ctx.Uint64("quantity"),
+		PayerNote: ctx.String("payer_note"),
+		FeeLimit:  feeLimit,
+	}
+
+	resp, err := client.PayOffer(context.Background(), req)
 	if err != nil {
 		return err
 	}
 
 	printJSON(struct {
-		RHash    string `json:"r_hash"`
-		PayReq   string `json:"pay_req"`
-		AddIndex uint64 `json:"add_index"`
+		E string       `json:"payment_error"`
+		P string       `json:"payment_preimage"`
+		R *lnrpc.Route `json:"payment_route"`
 	}{
-		RHash:    hex.EncodeToString(resp.RHash),
-		PayReq:   resp.PaymentRequest,
-		AddIndex: resp.AddIndex,
+		E: resp.PaymentError,
+		P: hex.EncodeToString(resp.PaymentPreimage),
+		R: resp.PaymentRoute,
 	})
 
 	return nil
@@ -2658,14 +4556,49 @@ var listPaymentsCommand = cli.Command{
 	Name:     "listpayments",
 	Category: "Payments",
 	Usage:    "List all outgoing payments.",
-	Action:   actionDecorator(listPayments),
+	Description: `
+	This command enables the retrieval of the outgoing payments stored
+	within the database. By default, all the payments that succeeded are
+	returned. Payments can also be paginated through their payment_index,
+	by using either the first_index_offset or last_index_offset fields
+	included in the response as the index_offset of the next request.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "include_incomplete",
+			Usage: "if set, payments that are in flight or that " +
+				"failed are also returned",
+		},
+		cli.Uint64Flag{
+			Name: "index_offset",
+			Usage: "the index of a payment that will be used as " +
+				"either the start or end of a query to " +
+				"determine which payments should be returned " +
+				"in the response",
+		},
+		cli.Uint64Flag{
+			Name:  "max_payments",
+			Usage: "the max number of payments to return",
+		},
+		cli.BoolFlag{
+			Name: "reversed",
+			Usage: "if set, the payments returned precede the " +
+				"given index_offset, allowing backwards " +
+				"pagination",
+		},
+	},
+	Action: actionDecorator(listPayments),
 }
 
 func listPayments(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &lnrpc.ListPaymentsRequest{}
+	req := &lnrpc.ListPaymentsRequest{
+		IncludeIncomplete: ctx.Bool("include_incomplete"),
+		IndexOffset:       ctx.Uint64("index_offset"),
+		MaxPayments:       ctx.Uint64("max_payments"),
+		Reversed:          ctx.Bool("reversed"),
+	}
 
 	payments, err := client.ListPayments(context.Background(), req)
 	if err != nil {
@@ -2736,6 +4669,11 @@ var getNodeInfoCommand = cli.Command{
 			Usage: "the 33-byte hex-encoded compressed public of the target " +
 				"node",
 		},
+		cli.BoolFlag{
+			Name: "include_channels",
+			Usage: "if true, will return all known channels " +
+				"associated with the node",
+		},
 	},
 	Action: actionDecorator(getNodeInfo),
 }
@@ -2758,7 +4696,8 @@ func getNodeInfo(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.NodeInfoRequest{
-		PubKey: pubKey,
+		PubKey:          pubKey,
+		IncludeChannels: ctx.Bool("include_channels"),
 	}
 
 	nodeInfo, err := client.GetNodeInfo(ctxb, req)
@@ -2898,6 +4837,7 @@ var debugLevelCommand = cli.Command{
 	Usage: "Set the debug level.",
 	Description: `Logging level for all subsystems {trace, debug, info, warn, error, critical, off}
 	You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems
+	Both can be combined, e.g. info,HSWC=debug, in which case they are applied in order
 
 	Use show to list available subsystems`,
 	Flags: []cli.Flag{
@@ -3132,7 +5072,9 @@ var feeReportCommand = cli.Command{
 	Category: "Channels",
 	Usage:    "Display the current fee policies of all active channels.",
 	Description: `
-	Returns the current fee policies of all active channels.
+	Returns the current fee policies of all active channels, along with
+	the routing fees earned by forwarding over each of them, and in
+	total, over the past day, week and month.
 	Fee policies can be updated using the updatechanpolicy command.`,
 	Action: actionDecorator(feeReport),
 }
@@ -3187,6 +5129,34 @@ var updateChannelPolicyCommand = cli.Command{
 				"updated, if nil the policies for all channels " +
 				"will be updated. Takes the form of: txid:output_index",
 		},
+		cli.Int64Flag{
+			Name: "inbound_base_fee_msat",
+			Usage: "the base fee in milli-satoshis that will " +
+				"be charged for each HTLC entering through " +
+				"the channel, on top of the fee of the " +
+				"outgoing channel, a negative value grants a " +
+				"discount",
+		},
+		cli.Int64Flag{
+			Name: "inbound_fee_rate_ppm",
+			Usage: "the fee rate in parts per million that " +
+				"will be charged for each HTLC entering " +
+				"through the channel, on top of the fee of " +
+				"the outgoing channel, a negative value " +
+				"grants a discount",
+		},
+		cli.Uint64Flag{
+			Name: "min_htlc_msat",
+			Usage: "if set, the minimum HTLC size in " +
+				"milli-satoshis that will be forwarded over " +
+				"the channel",
+		},
+		cli.Uint64Flag{
+			Name: "max_htlc_msat",
+			Usage: "if set, the maximum HTLC size in " +
+				"milli-satoshis that will be forwarded over " +
+				"the channel",
+		},
 	},
 	Action: actionDecorator(updateChannelPolicy),
 }
@@ -3282,6 +5252,19 @@ func updateChannelPolicy(ctx *cli.Context) error {
 		BaseFeeMsat:   baseFee,
 		FeeRate:       feeRate,
 		TimeLockDelta: uint32(timeLockDelta),
+		MinHtlcMsat:   ctx.Uint64("min_htlc_msat"),
+		MaxHtlcMsat:   ctx.Uint64("max_htlc_msat"),
+	}
+
+	// The inbound fee is only updated if either of its components was
+	// specified.
+	if ctx.IsSet("inbound_base_fee_msat") ||
+		ctx.IsSet("inbound_fee_rate_ppm") {
+
+		req.InboundFee = &lnrpc.InboundFee{
+			BaseFeeMsat: int32(ctx.Int64("inbound_base_fee_msat")),
+			FeeRatePpm:  int32(ctx.Int64("inbound_fee_rate_ppm")),
+		}
 	}
 
 	if chanPoint != nil {
@@ -3417,3 +5400,441 @@ func forwardingHistory(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var listSweepableCommand = cli.Command{
+	Name:     "listsweepable",
+	Category: "On-chain",
+	Usage: "List the outputs of force closed channels being swept " +
+		"into the wallet.",
+	Description: `
+	For each time-locked output of a force closed channel that is being swept
+	back into the wallet, lists its outpoint, channel point, amount, the
+	height at which it is swept, and the txid of the sweep transaction if
+	one has been broadcast.
+
+	The fee required to sweep each output on its own is estimated at the fee
+	rate specified via the --conf_target, or --sat_per_byte optional flags.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that a sweep " +
+				"*should* confirm in, will be used for fee " +
+				"estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used for fee " +
+				"estimation",
+		},
+	},
+	Action: actionDecorator(listSweepable),
+}
+
+func listSweepable(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte " +
+			"should be set, but not both")
+	}
+
+	req := &lnrpc.ListSweepableOutputsRequest{
+		TargetConf: int32(ctx.Int64("conf_target")),
+		SatPerByte: ctx.Int64("sat_per_byte"),
+	}
+	resp, err := client.ListSweepableOutputs(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var sweepOutputsCommand = cli.Command{
+	Name:      "sweepoutputs",
+	Category:  "On-chain",
+	Usage:     "Immediately sweep outputs of force closed channels.",
+	ArgsUsage: "outpoint [outpoint...]",
+	Description: `
+	Immediately sweep the given outputs, in format txid:index, back into the
+	wallet, replacing the sweep transaction that was broadcast for them. This
+	can be used to speed up the confirmation of a sweep by paying a higher
+	fee. All outputs swept together by the original sweep transaction must be
+	specified, see listsweepable.
+
+	The fee rate of the sweep is specified via the --conf_target, or
+	--sat_per_byte optional flags.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"sweep transaction *should* confirm in, will " +
+				"be used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the sweep transaction",
+		},
+	},
+	Action: actionDecorator(sweepOutputs),
+}
+
+func sweepOutputs(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() == 0 {
+		cli.ShowCommandHelp(ctx, "sweepoutputs")
+		return nil
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte " +
+			"should be set, but not both")
+	}
+
+	req := &lnrpc.SweepOutputsRequest{
+		Outpoints:  ctx.Args(),
+		TargetConf: int32(ctx.Int64("conf_target")),
+		SatPerByte: ctx.Int64("sat_per_byte"),
+	}
+	resp, err := client.SweepOutputs(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var bakeMacaroonCommand = cli.Command{
+	Name:     "bakemacaroon",
+	Category: "Macaroons",
+	Usage: "Bakes a new macaroon with the provided list of permissions " +
+		"and restrictions",
+	ArgsUsage: "[--save_to=] [--timeout=] [--ip_address=] " +
+		"[--root_key_id=] [--custom_caveat_name= " +
+		"[--custom_caveat_condition=]] permissions...",
+	Description: `
+	Bake a new macaroon that grants the provided permissions and
+	optionally adds restrictions (timeout, IP address) to it.
+
+	The new macaroon can either be shown on command line in hex serialized
+	format or it can be saved directly to a file using the --save_to
+	argument.
+
+	A permission is a tuple of an entity and an action, separated by a
+	colon. Multiple operations can be added as arguments, for example:
+
+	lncli bakemacaroon info:read invoices:write
+
+	To create a macaroon that can later be revoked on its own, bake it
+	with a non-default root key ID using the --root_key_id argument.
+
+	A custom caveat can be added with the --custom_caveat_name and
+	--custom_caveat_condition arguments. Such a macaroon is only accepted
+	by lnd while an RPC middleware that registered for the custom caveat
+	name is connected, and all calls made with it are sent to that
+	middleware.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "save the created macaroon to this file " +
+				"using the default binary format",
+		},
+		cli.Int64Flag{
+			Name: "timeout",
+			Usage: "the number of seconds the macaroon will be " +
+				"valid before it times out",
+		},
+		cli.StringFlag{
+			Name:  "ip_address",
+			Usage: "the IP address the macaroon will be bound to",
+		},
+		cli.Uint64Flag{
+			Name:  "root_key_id",
+			Usage: "the numerical root key ID used to create the macaroon",
+		},
+		cli.StringFlag{
+			Name: "custom_caveat_name",
+			Usage: "the name of the custom caveat to add, handled " +
+				"by the RPC middleware registered for it",
+		},
+		cli.StringFlag{
+			Name: "custom_caveat_condition",
+			Usage: "the condition of the custom caveat to add, " +
+				"can be empty",
+		},
+	},
+	Action: actionDecorator(bakeMacaroon),
+}
+
+func bakeMacaroon(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments.
+	if ctx.NArg() == 0 {
+		return cli.ShowCommandHelp(ctx, "bakemacaroon")
+	}
+	args := ctx.Args()
+
+	var (
+		savePath          string
+		timeout           int64
+		ipAddress         net.IP
+		rootKeyID         uint64
+		customCaveatName  string
+		parsedPermissions []*lnrpc.MacaroonPermission
+		err               error
+	)
+
+	if ctx.String("save_to") != "" {
+		savePath = cleanAndExpandPath(ctx.String("save_to"))
+	}
+
+	if ctx.IsSet("timeout") {
+		timeout = ctx.Int64("timeout")
+		if timeout <= 0 {
+			return fmt.Errorf("timeout must be greater than 0")
+		}
+	}
+
+	if ctx.IsSet("ip_address") {
+		ipAddress = net.ParseIP(ctx.String("ip_address"))
+		if ipAddress == nil {
+			return fmt.Errorf("unable to parse ip_address: %s",
+				ctx.String("ip_address"))
+		}
+	}
+
+	if ctx.IsSet("root_key_id") {
+		rootKeyID = ctx.Uint64("root_key_id")
+	}
+
+	if ctx.IsSet("custom_caveat_name") {
+		customCaveatName = ctx.String("custom_caveat_name")
+		if strings.Contains(customCaveatName, " ") {
+			return fmt.Errorf("custom_caveat_name cannot contain " +
+				"spaces")
+		}
+	} else if ctx.IsSet("custom_caveat_condition") {
+		return fmt.Errorf("custom_caveat_condition requires " +
+			"custom_caveat_name to be set")
+	}
+
+	// A command line argument can't be an empty string. So we'll check
+	// each entry if it's a valid entity:action tuple. The content itself
+	// is validated server side. We just make sure we can parse it
+	// correctly.
+	for _, permission := range args {
+		tuple := strings.Split(permission, ":")
+		if len(tuple) != 2 {
+			return fmt.Errorf("unable to parse permission tuple: %s",
+				permission)
+		}
+		entity, action := tuple[0], tuple[1]
+		if entity == "" {
+			return fmt.Errorf("invalid permission [%s]. entity "+
+				"cannot be empty", permission)
+		}
+		if action == "" {
+			return fmt.Errorf("invalid permission [%s]. action "+
+				"cannot be empty", permission)
+		}
+
+		// Now we can assume that we have a formally valid
+		// entity:action tuple. The rest of the validation happens
+		// server side.
+		parsedPermissions = append(
+			parsedPermissions, &lnrpc.MacaroonPermission{
+				Entity: entity,
+				Action: action,
+			},
+		)
+	}
+
+	// Now we have gathered all the input we need and can do the actual
+	// RPC call.
+	req := &lnrpc.BakeMacaroonRequest{
+		Permissions: parsedPermissions,
+		RootKeyId:   rootKeyID,
+	}
+	resp, err := client.BakeMacaroon(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	// Now we should have gotten a valid macaroon. Unmarshal it so we can
+	// add first-party caveats (if necessary) to it.
+	macBytes, err := hex.DecodeString(resp.Macaroon)
+	if err != nil {
+		return err
+	}
+	unmarshalMac := &macaroon.Macaroon{}
+	if err = unmarshalMac.UnmarshalBinary(macBytes); err != nil {
+		return err
+	}
+
+	// Now apply the desired constraints to the macaroon. This will always
+	// create a new macaroon object, even if no constraints are added.
+	var macConstraints []macaroons.Constraint
+	if timeout > 0 {
+		macConstraints = append(
+			macConstraints, macaroons.TimeoutConstraint(timeout),
+		)
+	}
+	if ipAddress != nil {
+		macConstraints = append(
+			macConstraints,
+			macaroons.IPLockConstraint(ipAddress.String()),
+		)
+	}
+	if customCaveatName != "" {
+		macConstraints = append(
+			macConstraints,
+			macaroons.CustomConstraint(
+				customCaveatName,
+				ctx.String("custom_caveat_condition"),
+			),
+		)
+	}
+	constrainedMac, err := macaroons.AddConstraints(
+		unmarshalMac, macConstraints...,
+	)
+	if err != nil {
+		return err
+	}
+	macBytes, err = constrainedMac.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	// Now we can output the result. We either write it binary serialized
+	// to a file or write to the standard output using hex encoding.
+	if savePath != "" {
+		err = ioutil.WriteFile(savePath, macBytes, 0644)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Macaroon saved to %s\n", savePath)
+	} else {
+		fmt.Printf("%s\n", hex.EncodeToString(macBytes))
+	}
+
+	return nil
+}
+
+var listMacaroonIDsCommand = cli.Command{
+	Name:     "listmacaroonids",
+	Category: "Macaroons",
+	Usage:    "List all macaroons root key IDs in use.",
+	Action:   actionDecorator(listMacaroonIDs),
+}
+
+func listMacaroonIDs(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListMacaroonIDsRequest{}
+	resp, err := client.ListMacaroonIDs(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var deleteMacaroonIDCommand = cli.Command{
+	Name:      "deletemacaroonid",
+	Category:  "Macaroons",
+	Usage:     "Delete a specific macaroon ID.",
+	ArgsUsage: "root_key_id",
+	Description: `
+	Remove a macaroon ID using the specified root key ID. For example:
+
+	lncli deletemacaroonid 1
+
+	WARNING
+	When the ID is deleted, all macaroons created from that root key will
+	be invalidated.
+
+	Note that the default root key ID 0 cannot be deleted.
+	`,
+	Action: actionDecorator(deleteMacaroonID),
+}
+
+func deleteMacaroonID(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Validate args length. Only one argument is allowed.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "deletemacaroonid")
+	}
+
+	rootKeyIDString := ctx.Args().First()
+
+	// Convert string into uint64.
+	rootKeyID, err := strconv.ParseUint(rootKeyIDString, 10, 64)
+	if err != nil {
+		return fmt.Errorf("root key ID must be a positive integer")
+	}
+
+	// Check that the value is not the default root key ID. Note that the
+	// server also validates the root key ID when removing it. However, we
+	// check it here too so that we can give users a nice warning.
+	if rootKeyID == 0 {
+		return fmt.Errorf("deleting the default root key ID 0 is not " +
+			"allowed")
+	}
+
+	// Make the actual RPC call.
+	req := &lnrpc.DeleteMacaroonIDRequest{
+		RootKeyId: rootKeyID,
+	}
+	resp, err := client.DeleteMacaroonID(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listPermissionsCommand = cli.Command{
+	Name:     "listpermissions",
+	Category: "Macaroons",
+	Usage: "List all RPC method URIs and the macaroon permissions they " +
+		"require to be invoked.",
+	Action: actionDecorator(listPermissions),
+}
+
+func listPermissions(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListPermissionsRequest{}
+	resp, err := client.ListPermissions(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
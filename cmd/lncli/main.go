@@ -54,6 +54,16 @@ func getWalletUnlockerClient(ctx *cli.Context) (lnrpc.WalletUnlockerClient, func
 	return lnrpc.NewWalletUnlockerClient(conn), cleanUp
 }
 
+func getStateServiceClient(ctx *cli.Context) (lnrpc.StateClient, func()) {
+	conn := getClientConn(ctx, true)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return lnrpc.NewStateClient(conn), cleanUp
+}
+
 func getClient(ctx *cli.Context) (lnrpc.LightningClient, func()) {
 	conn := getClientConn(ctx, false)
 
@@ -248,34 +258,70 @@ func main() {
 			Name:  "macaroonip",
 			Usage: "if set, lock macaroon to specific IP address",
 		},
+		cli.StringFlag{
+			Name: "aliasfile",
+			Usage: "path to the file mapping node aliases to " +
+				"pubkey@host:port, defaults to " +
+				"<lnddir>/" + defaultAliasFilename,
+		},
+		cli.BoolFlag{
+			Name: "no-dns-resolve",
+			Usage: "disable resolving node identifiers of the " +
+				"form user@domain through DNS",
+		},
 	}
 	app.Commands = []cli.Command{
 		createCommand,
 		unlockCommand,
 		changePasswordCommand,
+		stateCommand,
 		newAddressCommand,
+		listAddressesCommand,
 		sendManyCommand,
+		estimateFeeCommand,
 		sendCoinsCommand,
 		listUnspentCommand,
+		listSweepableCommand,
+		sweepOutputsCommand,
 		connectCommand,
 		disconnectCommand,
 		openChannelCommand,
+		batchOpenChannelCommand,
+		fundingStateStepCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
+		freezeChannelCommand,
+		updateChanConstraintsCommand,
 		listPeersCommand,
+		htlcRateLimitsCommand,
+		sendCustomCommand,
+		subscribeCustomCommand,
 		walletBalanceCommand,
 		channelBalanceCommand,
 		getInfoCommand,
+		getRecoveryInfoCommand,
+		updateNodeAnnouncementCommand,
 		pendingChannelsCommand,
 		sendPaymentCommand,
 		payInvoiceCommand,
 		sendToRouteCommand,
+		cancelPaymentCommand,
+		deletePaymentCommand,
+		deleteAllPaymentsCommand,
 		addInvoiceCommand,
+		createOfferCommand,
+		payOfferCommand,
 		lookupInvoiceCommand,
 		listInvoicesCommand,
 		listChannelsCommand,
+		exportChanAuditCommand,
+		exportChanBackupCommand,
+		verifyChanBackupCommand,
+		restoreChanBackupCommand,
 		closedChannelsCommand,
+		archiveClosedChannelsCommand,
+		lookupHtlcResolutionCommand,
 		listPaymentsCommand,
 		describeGraphCommand,
 		getChanInfoCommand,
@@ -291,6 +337,10 @@ func main() {
 		feeReportCommand,
 		updateChannelPolicyCommand,
 		forwardingHistoryCommand,
+		bakeMacaroonCommand,
+		listMacaroonIDsCommand,
+		deleteMacaroonIDCommand,
+		listPermissionsCommand,
 	}
 
 	// Add any extra autopilot commands determined by build flags.
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli"
+)
+
+const (
+	// defaultAliasFilename is the name of the file within lnd's base
+	// directory that maps node aliases to their identities.
+	defaultAliasFilename = "node_aliases"
+
+	// dnsNodeLabel is the label that's prepended to a domain in order to
+	// find the TXT records describing the Lightning nodes of the domain.
+	dnsNodeLabel = "_lightning"
+)
+
+// errUnknownNode is returned by a NodeResolver that doesn't know about the
+// identifier it was asked to resolve.
+var errUnknownNode = errors.New("unknown node")
+
+// resolvedNode is the identity of a Lightning node, as returned by a
+// NodeResolver.
+type resolvedNode struct {
+	// pubKey is the hex-encoded compressed public key of the node.
+	pubKey string
+
+	// host is the host:port at which the node can be reached. It may be
+	// empty if the resolver only knows the node's public key.
+	host string
+}
+
+// NodeResolver maps human-readable identifiers, such as alice@example.com, to
+// the identity of a Lightning node, so users aren't forced to paste raw public
+// keys into the connect, openchannel and sendpayment commands.
+type NodeResolver interface {
+	// ResolveNode returns the identity of the node known under the given
+	// identifier. If the resolver doesn't know about the identifier,
+	// errUnknownNode is returned.
+	ResolveNode(id string) (*resolvedNode, error)
+}
+
+// parseNodeIdentity parses a node identity in the format pubkey[@host:port].
+func parseNodeIdentity(s string) (*resolvedNode, error) {
+	split := strings.SplitN(s, "@", 2)
+	if !isPubKeyHex(split[0]) {
+		return nil, fmt.Errorf("invalid node public key: %v", split[0])
+	}
+
+	node := &resolvedNode{
+		pubKey: split[0],
+	}
+	if len(split) == 2 {
+		node.host = split[1]
+	}
+
+	return node, nil
+}
+
+// isPubKeyHex returns true if the passed string is a hex-encoded compressed
+// public key.
+func isPubKeyHex(s string) bool {
+	pubKey, err := hex.DecodeString(s)
+	return err == nil && len(pubKey) == 33
+}
+
+// aliasFileResolver is a NodeResolver that looks identifiers up within a local
+// file. Each line of the file maps an alias to a node identity, in the format
+// "alias pubkey[@host:port]". Empty lines and lines starting with # are
+// ignored.
+type aliasFileResolver struct {
+	path string
+}
+
+// ResolveNode returns the identity of the node known under the given alias.
+//
+// NOTE: Part of the NodeResolver interface.
+func (r *aliasFileResolver) ResolveNode(id string) (*resolvedNode, error) {
+	f, err := os.Open(r.path)
+	if os.IsNotExist(err) {
+		return nil, errUnknownNode
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid line in %v: %v",
+				r.path, line)
+		}
+		if fields[0] != id {
+			continue
+		}
+
+		return parseNodeIdentity(fields[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, errUnknownNode
+}
+
+// dnsResolver is a NodeResolver that looks up identifiers of the form
+// user@domain or domain within the DNS. The identity of the node is expected
+// in a TXT record of user._lightning.domain or _lightning.domain
+// respectively, in the format pubkey[@host:port].
+type dnsResolver struct {
+	// lookupTXT returns the TXT records of the given name.
+	lookupTXT func(name string) ([]string, error)
+}
+
+// ResolveNode returns the identity of the node published under the given
+// identifier within the DNS.
+//
+// NOTE: Part of the NodeResolver interface.
+func (r *dnsResolver) ResolveNode(id string) (*resolvedNode, error) {
+	var user, domain string
+	split := strings.SplitN(id, "@", 2)
+	if len(split) == 2 {
+		user, domain = split[0], split[1]
+	} else {
+		domain = split[0]
+	}
+
+	// Only fully qualified domains can be resolved.
+	if !strings.Contains(domain, ".") {
+		return nil, errUnknownNode
+	}
+
+	name := dnsNodeLabel + "." + domain
+	if user != "" {
+		name = user + "." + name
+	}
+
+	records, err := r.lookupTXT(name)
+	if dnsErr, ok := err.(*net.DNSError); ok && dnsErr.IsNotFound {
+		return nil, errUnknownNode
+	} else if err != nil {
+		return nil, err
+	}
+
+	// We'll use the first record that describes a node, ignoring any
+	// unrelated records published under the same name.
+	for _, record := range records {
+		node, err := parseNodeIdentity(strings.TrimSpace(record))
+		if err != nil {
+			continue
+		}
+
+		return node, nil
+	}
+
+	return nil, errUnknownNode
+}
+
+// multiResolver is a NodeResolver that consults a list of resolvers in order,
+// returning the first identity found.
+type multiResolver []NodeResolver
+
+// ResolveNode returns the identity of the node known under the given
+// identifier by the first resolver that knows about it.
+//
+// NOTE: Part of the NodeResolver interface.
+func (r multiResolver) ResolveNode(id string) (*resolvedNode, error) {
+	for _, resolver := range r {
+		node, err := resolver.ResolveNode(id)
+		if err == errUnknownNode {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return node, nil
+	}
+
+	return nil, errUnknownNode
+}
+
+// getNodeResolver returns the NodeResolver configured by the global command
+// line flags.
+func getNodeResolver(ctx *cli.Context) NodeResolver {
+	aliasFile := ctx.GlobalString("aliasfile")
+	if aliasFile == "" {
+		lndDir := cleanAndExpandPath(ctx.GlobalString("lnddir"))
+		aliasFile = filepath.Join(lndDir, defaultAliasFilename)
+	}
+
+	resolvers := multiResolver{
+		&aliasFileResolver{path: cleanAndExpandPath(aliasFile)},
+	}
+	if !ctx.GlobalBool("no-dns-resolve") {
+		resolvers = append(resolvers, &dnsResolver{
+			lookupTXT: net.LookupTXT,
+		})
+	}
+
+	return resolvers
+}
+
+// resolveNode returns the identity of the node referred to by the given
+// identifier. Identities in the format pubkey[@host:port] are returned as is,
+// while any other identifier is resolved using the configured NodeResolver.
+func resolveNode(ctx *cli.Context, id string) (*resolvedNode, error) {
+	if isPubKeyHex(strings.SplitN(id, "@", 2)[0]) {
+		return parseNodeIdentity(id)
+	}
+
+	node, err := getNodeResolver(ctx).ResolveNode(id)
+	if err == errUnknownNode {
+		return nil, fmt.Errorf("unable to resolve node %v: expected "+
+			"a public key, an alias or a DNS identifier", id)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to resolve node %v: %v", id, err)
+	}
+
+	return node, nil
+}
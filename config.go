@@ -22,7 +22,10 @@ import (
 	"github.com/btcsuite/btcutil"
 	flags "github.com/jessevdk/go-flags"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/feepolicy"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
+	"github.com/lightningnetwork/lnd/liquidity"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -64,6 +67,27 @@ const (
 
 	defaultBroadcastDelta = 10
 
+	// defaultChainHealthCheckInterval is the default interval at which the
+	// health of the active chain backend is checked, when a backup one is
+	// configured.
+	defaultChainHealthCheckInterval = 30 * time.Second
+
+	// defaultAutoFeeMinFeeRate and defaultAutoFeeMaxFeeRate are the
+	// default bounds, in parts per million, of the fee rates set by the
+	// automatic fee policy manager.
+	defaultAutoFeeMinFeeRate = 1
+	defaultAutoFeeMaxFeeRate = 1000
+
+	// defaultLiquidityMinLocalRatio and defaultLiquidityMaxLocalRatio are
+	// the default bounds of the fraction of each channel's capacity that
+	// the liquidity manager keeps on our side.
+	defaultLiquidityMinLocalRatio = 0.2
+	defaultLiquidityMaxLocalRatio = 0.8
+
+	// defaultLiquidityMaxFeeRate is the default maximum fee rate, in parts
+	// per million, paid for a single rebalance.
+	defaultLiquidityMaxFeeRate = 500
+
 	// minTimeLockDelta is the minimum timelock we require for incoming
 	// HTLCs on our channels.
 	minTimeLockDelta = 4
@@ -138,6 +162,13 @@ type bitcoindConfig struct {
 	RPCPass        string `long:"rpcpass" default-mask:"-" description:"Password for RPC connections"`
 	ZMQPubRawBlock string `long:"zmqpubrawblock" description:"The address listening for ZMQ connections to deliver raw block notifications"`
 	ZMQPubRawTx    string `long:"zmqpubrawtx" description:"The address listening for ZMQ connections to deliver raw transaction notifications"`
+
+	BackupRPCHost        string        `long:"backuprpchost" description:"The rpc listening address of a backup node, which takes over whenever the primary node becomes unreachable. If a port is omitted, then the default port for the selected chain parameters will be used."`
+	BackupRPCUser        string        `long:"backuprpcuser" description:"Username for RPC connections to the backup node, defaults to the one of the primary node"`
+	BackupRPCPass        string        `long:"backuprpcpass" default-mask:"-" description:"Password for RPC connections to the backup node, defaults to the one of the primary node"`
+	BackupZMQPubRawBlock string        `long:"backupzmqpubrawblock" description:"The address listening for ZMQ connections to deliver raw block notifications from the backup node"`
+	BackupZMQPubRawTx    string        `long:"backupzmqpubrawtx" description:"The address listening for ZMQ connections to deliver raw transaction notifications from the backup node"`
+	HealthCheckInterval  time.Duration `long:"healthcheckinterval" description:"The interval at which the health of the active node is checked when a backup node is configured"`
 }
 
 type autoPilotConfig struct {
@@ -148,6 +179,32 @@ type autoPilotConfig struct {
 	MaxChannelSize int64   `long:"maxchansize" description:"The largest channel that the autopilot agent should create"`
 	Private        bool    `long:"private" description:"Whether the channels created by the autopilot agent should be private or not. Private channels won't be announced to the network."`
 	MinConfs       int32   `long:"minconfs" description:"The minimum number of confirmations each of your inputs in funding transactions created by the autopilot agent must have."`
+
+	RequiredFeatures []uint16 `long:"requiredfeature" description:"A feature bit that nodes must advertise in order to be considered by the autopilot agent. Can be specified multiple times."`
+	ExcludeNets      []string `long:"excludenet" description:"A network in CIDR notation (e.g. 10.0.0.0/8) the autopilot agent should never open channels into. Nodes advertising an address within the network are skipped. Can be specified multiple times."`
+
+	// excludedNets is the parsed form of ExcludeNets.
+	excludedNets []*net.IPNet
+}
+
+type autoFeeConfig struct {
+	Active          bool          `long:"active" description:"If true, the forwarding fee rate of each channel is periodically adjusted according to its liquidity. The scarcer our balance within a channel, the higher the fee rate. Channels that haven't forwarded anything recently have their fee rate lowered."`
+	MinFeeRate      uint32        `long:"minfeerate" description:"The fee rate, in parts per million, charged for channels whose balance is entirely on our side"`
+	MaxFeeRate      uint32        `long:"maxfeerate" description:"The fee rate, in parts per million, charged for channels whose balance is entirely on the remote side"`
+	Interval        time.Duration `long:"interval" description:"The interval at which the fee rates of our channels are re-evaluated"`
+	UpdateThreshold float64       `long:"updatethreshold" description:"The fraction (0-1) by which the fee rate of a channel must change before a new policy is broadcast for it"`
+	IdleDiscount    float64       `long:"idlediscount" description:"The fraction (0-1) of the fee rate dropped for channels that haven't forwarded anything since the last evaluation"`
+}
+
+type liquidityConfig struct {
+	Active        bool          `long:"active" description:"If true, the balance of each channel is kept within the configured bounds by paying ourselves over circular routes, moving liquidity from channels in which our balance is too high to channels in which it's too low"`
+	MinLocalRatio float64       `long:"minlocalratio" description:"The fraction (0-1) of the capacity of a channel below which our balance is considered too low"`
+	MaxLocalRatio float64       `long:"maxlocalratio" description:"The fraction (0-1) of the capacity of a channel above which our balance is considered too high"`
+	MaxFeeRate    uint32        `long:"maxfeerate" description:"The maximum fee, in parts per million of the amount moved, paid for a single rebalance"`
+	FeeBudget     int64         `long:"feebudget" description:"The total amount of fees, in satoshis, that may be spent on rebalances within each budget period"`
+	BudgetPeriod  time.Duration `long:"budgetperiod" description:"The period over which the fee budget applies"`
+	Interval      time.Duration `long:"interval" description:"The interval at which the balances of our channels are evaluated"`
+	DryRun        bool          `long:"dryrun" description:"If true, the rebalances that would be performed are only logged, without being executed"`
 }
 
 type torConfig struct {
@@ -168,20 +225,22 @@ type torConfig struct {
 type config struct {
 	ShowVersion bool `short:"V" long:"version" description:"Display version information and exit"`
 
-	LndDir         string `long:"lnddir" description:"The base directory that contains lnd's data, logs, configuration file, etc."`
-	ConfigFile     string `long:"C" long:"configfile" description:"Path to configuration file"`
-	DataDir        string `short:"b" long:"datadir" description:"The directory to store lnd's data within"`
-	TLSCertPath    string `long:"tlscertpath" description:"Path to write the TLS certificate for lnd's RPC and REST services"`
-	TLSKeyPath     string `long:"tlskeypath" description:"Path to write the TLS private key for lnd's RPC and REST services"`
-	TLSExtraIP     string `long:"tlsextraip" description:"Adds an extra ip to the generated certificate"`
-	TLSExtraDomain string `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate"`
-	NoMacaroons    bool   `long:"no-macaroons" description:"Disable macaroon authentication"`
-	AdminMacPath   string `long:"adminmacaroonpath" description:"Path to write the admin macaroon for lnd's RPC and REST services if it doesn't exist"`
-	ReadMacPath    string `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for lnd's RPC and REST services if it doesn't exist"`
-	InvoiceMacPath string `long:"invoicemacaroonpath" description:"Path to the invoice-only macaroon for lnd's RPC and REST services if it doesn't exist"`
-	LogDir         string `long:"logdir" description:"Directory to log output."`
-	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
-	MaxLogFileSize int    `long:"maxlogfilesize" description:"Maximum logfile size in MB"`
+	LndDir          string   `long:"lnddir" description:"The base directory that contains lnd's data, logs, configuration file, etc."`
+	ConfigFile      string   `long:"C" long:"configfile" description:"Path to configuration file"`
+	DataDir         string   `short:"b" long:"datadir" description:"The directory to store lnd's data within"`
+	TLSCertPath     string   `long:"tlscertpath" description:"Path to write the TLS certificate for lnd's RPC and REST services"`
+	TLSKeyPath      string   `long:"tlskeypath" description:"Path to write the TLS private key for lnd's RPC and REST services"`
+	TLSExtraIPs     []string `long:"tlsextraip" description:"Adds an extra ip to the generated certificate; can be specified multiple times"`
+	TLSExtraDomains []string `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate; can be specified multiple times"`
+	TLSAutoRefresh  bool     `long:"tlsautorefresh" description:"Regenerate the auto-generated certificate if the IP addresses or hostname of the host change"`
+	NoMacaroons     bool     `long:"no-macaroons" description:"Disable macaroon authentication"`
+	AdminMacPath    string   `long:"adminmacaroonpath" description:"Path to write the admin macaroon for lnd's RPC and REST services if it doesn't exist"`
+	ReadMacPath     string   `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for lnd's RPC and REST services if it doesn't exist"`
+	InvoiceMacPath  string   `long:"invoicemacaroonpath" description:"Path to the invoice-only macaroon for lnd's RPC and REST services if it doesn't exist"`
+	BackupFilePath  string   `long:"backupfilepath" description:"The target location of the channel backup file"`
+	LogDir          string   `long:"logdir" description:"Directory to log output."`
+	MaxLogFiles     int      `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
+	MaxLogFileSize  int      `long:"maxlogfilesize" description:"Maximum logfile size in MB"`
 
 	// We'll parse these 'raw' string arguments into real net.Addrs in the
 	// loadConfig function. We need to expose the 'raw' strings so the
@@ -189,6 +248,7 @@ type config struct {
 	// Only the parsed net.Addrs should be used!
 	RawRPCListeners  []string `long:"rpclisten" description:"Add an interface/port/socket to listen for RPC connections"`
 	RawRESTListeners []string `long:"restlisten" description:"Add an interface/port/socket to listen for REST connections"`
+	WSAllowedOrigins []string `long:"wsallowedorigin" description:"Add an origin (e.g. https://example.com) from which browsers may open WebSocket connections to the REST proxy, or * to allow any origin. Connections without an origin are only accepted if * is given"`
 	RawListeners     []string `long:"listen" description:"Add an interface/port to listen for peer connections"`
 	RawExternalIPs   []string `long:"externalip" description:"Add an ip:port to the list of local addresses we claim to listen on to peers. If a port is not specified, the default (9735) will be used regardless of other parameters"`
 	RPCListeners     []net.Addr
@@ -223,6 +283,10 @@ type config struct {
 
 	Tor *torConfig `group:"Tor" namespace:"tor"`
 
+	AutoFee *autoFeeConfig `group:"AutoFee" namespace:"autofee"`
+
+	Liquidity *liquidityConfig `group:"Liquidity" namespace:"liquidity"`
+
 	SubRPCServers *subRPCServerConfigs `group:"subrpc"`
 
 	Hodl *hodl.Config `group:"hodl" namespace:"hodl"`
@@ -242,8 +306,50 @@ type config struct {
 
 	RejectPush bool `long:"rejectpush" description:"If true, lnd will not accept channel opening requests with non-zero push amounts. This should prevent accidental pushes to merchant nodes."`
 
+	RejectHTLC bool `long:"rejecthtlc" description:"If true, lnd will not forward any HTLCs that are meant as onward payments. This option will still allow lnd to send HTLCs and receive HTLCs but lnd won't be used as a hop."`
+
+	HtlcAddRate  float64 `long:"htlcaddrate" description:"The maximum number of incoming HTLCs per second each peer may forward through this node. HTLCs in excess of this rate are failed back with a temporary failure. Set to 0 to disable."`
+	HtlcAddBurst uint32  `long:"htlcaddburst" description:"The number of incoming HTLCs a peer may forward in quick succession before being subject to htlcaddrate. Defaults to one second worth of HTLCs if unset."`
+
+	ProbeFailureThreshold float64       `long:"probefailurethreshold" description:"The number of recent failed attempts to pay our invoices, such as those paying unknown hashes or incorrect amounts, after which further attempts from the same peer are failed back immediately. Failed attempts are forgotten over time according to probedecayhalflife. Limits the effectiveness of balance probing. Set to 0 to disable."`
+	ProbeDecayHalfLife    time.Duration `long:"probedecayhalflife" description:"The duration after which the weight of a failed attempt to pay our invoices is halved. Defaults to 10m if unset."`
+
+	MaxHeldInvoiceHtlcs int `long:"maxheldinvoicehtlcs" description:"The maximum number of parts of multi-part payments paying our invoices that are held at once while waiting for the rest of their payment. Parts in excess of this bound are failed back with a temporary failure. Set to 0 to disable."`
+
+	EndorsedSlotReserve      float64 `long:"endorsedslotreserve" description:"The fraction (0-1) of each channel's HTLC slots reserved for endorsed HTLCs from high-reputation peers. Unendorsed HTLCs that would dip into the reserve are failed back with a temporary failure."`
+	EndorsedLiquidityReserve float64 `long:"endorsedliquidityreserve" description:"The fraction (0-1) of each channel's available liquidity reserved for endorsed HTLCs from high-reputation peers."`
+
+	AsyncPayments       bool   `long:"asyncpayments" description:"EXPERIMENTAL: If true, HTLCs to be forwarded over a private channel whose peer is offline are held, and forwarded once the peer reconnects, rather than being failed. Useful for nodes serving mostly offline wallets."`
+	AsyncHoldCltvMargin uint32 `long:"asyncholdcltvmargin" description:"The number of blocks before the expiry of a held HTLC at which we'll give up waiting for the offline peer, and fail it back. Defaults to 40 if unset."`
+	AsyncHoldMaxHtlcs   int    `long:"asyncholdmaxhtlcs" description:"The maximum number of HTLCs held for each offline peer. Defaults to 20 if unset."`
+
+	MaxLinkStartups   int           `long:"maxlinkstartups" description:"The maximum number of channels that may be re-establishing their state with their peer at once, which bounds the load on the database and the peer connections when starting up with many channels. Setting this too low may cause peers to give up on the re-establishment of channels. Set to 0 to disable."`
+	LinkStartupJitter time.Duration `long:"linkstartupjitter" description:"The maximum random delay applied before each channel re-establishes its state with its peer, which spreads out their startup. Set to 0 to disable."`
+
+	FeeUpdateBand       float64 `long:"feeupdateband" description:"The fraction by which the network fee rate must deviate from the commitment fee rate of a channel we opened, in either direction, before we propose to update it. Defaults to 0.1 if unset."`
+	FeeUpdateConfTarget uint32  `long:"feeupdateconftarget" description:"The confirmation target, in blocks, used when sampling the network fee rate for commitment fee updates. Defaults to 3 if unset."`
+
+	ArchiveClosedChans  bool   `long:"archiveclosedchans" description:"If true, the records of channels that have been fully closed and resolved are compacted on startup, dropping the state that is only needed to help the remote party recover from data loss."`
+	ClosedChanRetention uint32 `long:"closedchanretention" description:"If archiveclosedchans is set, the number of blocks after a channel was closed at which its record is deleted altogether. Set to 0 to keep the records forever."`
+
+	OnionMessages     bool    `long:"onionmessages" description:"EXPERIMENTAL: If true, lnd will forward onion messages on behalf of its peers, and signal support for doing so. Implied by --offers."`
+	OnionMessageRate  float64 `long:"onionmessagerate" description:"The maximum number of onion messages per second each peer may send us. Messages in excess of this rate are dropped. Defaults to 10 if unset."`
+	OnionMessageBurst int     `long:"onionmessageburst" description:"The number of onion messages a peer may send in quick succession before being subject to onionmessagerate. Defaults to 50 if unset."`
+
+	Offers            bool    `long:"offers" description:"EXPERIMENTAL: If true, lnd will answer invoice requests for the BOLT-12 offers it creates and allow offers to be paid, exchanging invoice requests and invoices over onion messages. Implies --onionmessages. Invoices don't yet carry blinded payment paths, so offers are not yet compatible with other implementations."`
+	OfferInvoiceRate  float64 `long:"offerinvoicerate" description:"The maximum number of invoice requests per second we answer for our offers, across all senders, as each answered request stores a new invoice. Requests in excess of this rate are dropped. Defaults to 1 if unset."`
+	OfferInvoiceBurst int     `long:"offerinvoiceburst" description:"The number of invoice requests we answer in quick succession before being subject to offerinvoicerate. Defaults to 10 if unset."`
+
+	PeerFeatures []string `long:"peerfeature" description:"Force-enable or disable an optional feature for a specific peer, in the format <pubkey>:<+|-><feature>, e.g. <pubkey>:-gossip-queries. Disabled features are neither advertised to nor used with the peer, even if it supports them. Can be specified multiple times."`
+
+	Observer bool `long:"observer" description:"If true, lnd runs as a read-only observer of a replica of the channel database of another node, serving only the read RPCs that can be answered from the database. No wallet is unlocked, no chain backend or peer connections are used, and nothing is ever written to the channel database, allowing dashboards to be run without exposing the signing node. The replica must not be opened by any other lnd instance at the same time."`
+
 	net tor.Net
 
+	// peerFeatureOverrides is the parsed form of PeerFeatures, keyed by
+	// the compressed public key of each peer.
+	peerFeatureOverrides map[[33]byte]*featureOverrides
+
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
 }
 
@@ -251,10 +357,10 @@ type config struct {
 // line options.
 //
 // The configuration proceeds as follows:
-// 	1) Start with a default config with sane settings
-// 	2) Pre-parse the command line to check for an alternative config file
-// 	3) Load configuration file overwriting defaults with any specified options
-// 	4) Parse CLI options and overwrite/add any specified options
+//  1. Start with a default config with sane settings
+//  2. Pre-parse the command line to check for an alternative config file
+//  3. Load configuration file overwriting defaults with any specified options
+//  4. Parse CLI options and overwrite/add any specified options
 func loadConfig() (*config, error) {
 	defaultCfg := config{
 		LndDir:         defaultLndDir,
@@ -279,8 +385,9 @@ func loadConfig() (*config, error) {
 			RPCCert: defaultBtcdRPCCertFile,
 		},
 		BitcoindMode: &bitcoindConfig{
-			Dir:     defaultBitcoindDir,
-			RPCHost: defaultRPCHost,
+			Dir:                 defaultBitcoindDir,
+			RPCHost:             defaultRPCHost,
+			HealthCheckInterval: defaultChainHealthCheckInterval,
 		},
 		Litecoin: &chainConfig{
 			MinHTLC:       defaultLitecoinMinHTLCMSat,
@@ -295,8 +402,9 @@ func loadConfig() (*config, error) {
 			RPCCert: defaultLtcdRPCCertFile,
 		},
 		LitecoindMode: &bitcoindConfig{
-			Dir:     defaultLitecoindDir,
-			RPCHost: defaultRPCHost,
+			Dir:                 defaultLitecoindDir,
+			RPCHost:             defaultRPCHost,
+			HealthCheckInterval: defaultChainHealthCheckInterval,
 		},
 		MaxPendingChannels: defaultMaxPendingChannels,
 		NoSeedBackup:       defaultNoSeedBackup,
@@ -310,6 +418,20 @@ func loadConfig() (*config, error) {
 			MinChannelSize: int64(minChanFundingSize),
 			MaxChannelSize: int64(maxFundingAmount),
 		},
+		AutoFee: &autoFeeConfig{
+			MinFeeRate:      defaultAutoFeeMinFeeRate,
+			MaxFeeRate:      defaultAutoFeeMaxFeeRate,
+			Interval:        feepolicy.DefaultUpdateInterval,
+			UpdateThreshold: feepolicy.DefaultUpdateThreshold,
+			IdleDiscount:    feepolicy.DefaultIdleDiscount,
+		},
+		Liquidity: &liquidityConfig{
+			MinLocalRatio: defaultLiquidityMinLocalRatio,
+			MaxLocalRatio: defaultLiquidityMaxLocalRatio,
+			MaxFeeRate:    defaultLiquidityMaxFeeRate,
+			BudgetPeriod:  liquidity.DefaultBudgetPeriod,
+			Interval:      liquidity.DefaultUpdateInterval,
+		},
 		TrickleDelay:        defaultTrickleDelay,
 		InactiveChanTimeout: defaultInactiveChanTimeout,
 		Alias:               defaultAlias,
@@ -411,6 +533,7 @@ func loadConfig() (*config, error) {
 	cfg.AdminMacPath = cleanAndExpandPath(cfg.AdminMacPath)
 	cfg.ReadMacPath = cleanAndExpandPath(cfg.ReadMacPath)
 	cfg.InvoiceMacPath = cleanAndExpandPath(cfg.InvoiceMacPath)
+	cfg.BackupFilePath = cleanAndExpandPath(cfg.BackupFilePath)
 	cfg.LogDir = cleanAndExpandPath(cfg.LogDir)
 	cfg.BtcdMode.Dir = cleanAndExpandPath(cfg.BtcdMode.Dir)
 	cfg.LtcdMode.Dir = cleanAndExpandPath(cfg.LtcdMode.Dir)
@@ -418,6 +541,17 @@ func loadConfig() (*config, error) {
 	cfg.LitecoindMode.Dir = cleanAndExpandPath(cfg.LitecoindMode.Dir)
 	cfg.Tor.PrivateKeyPath = cleanAndExpandPath(cfg.Tor.PrivateKeyPath)
 
+	// Ensure that the extra IPs to add to the TLS certificate are valid,
+	// rather than silently leaving them out.
+	for _, ip := range cfg.TLSExtraIPs {
+		if net.ParseIP(ip) == nil {
+			str := "%s: invalid tlsextraip: %v"
+			err := fmt.Errorf(str, funcName, ip)
+			fmt.Fprintln(os.Stderr, err)
+			return nil, err
+		}
+	}
+
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
 	if cfg.Autopilot.MaxChannels < 0 {
@@ -450,6 +584,141 @@ func loadConfig() (*config, error) {
 		fmt.Fprintln(os.Stderr, err)
 		return nil, err
 	}
+	for _, excludeNet := range cfg.Autopilot.ExcludeNets {
+		_, ipNet, err := net.ParseCIDR(excludeNet)
+		if err != nil {
+			str := "%s: invalid autopilot.excludenet %v: %v"
+			err := fmt.Errorf(str, funcName, excludeNet, err)
+			fmt.Fprintln(os.Stderr, err)
+			return nil, err
+		}
+		cfg.Autopilot.excludedNets = append(
+			cfg.Autopilot.excludedNets, ipNet,
+		)
+	}
+	if cfg.HtlcAddRate < 0 {
+		str := "%s: htlcaddrate must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.MaxLinkStartups < 0 || cfg.LinkStartupJitter < 0 {
+		str := "%s: maxlinkstartups and linkstartupjitter must be " +
+			"non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.ProbeFailureThreshold < 0 || cfg.ProbeDecayHalfLife < 0 {
+		str := "%s: probefailurethreshold and probedecayhalflife " +
+			"must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.EndorsedSlotReserve < 0 || cfg.EndorsedSlotReserve > 1 {
+		str := "%s: endorsedslotreserve must be between 0 and 1"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.EndorsedLiquidityReserve < 0 || cfg.EndorsedLiquidityReserve > 1 {
+		str := "%s: endorsedliquidityreserve must be between 0 and 1"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.AutoFee.MinFeeRate > cfg.AutoFee.MaxFeeRate {
+		str := "%s: autofee.minfeerate must not exceed " +
+			"autofee.maxfeerate"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.AutoFee.Interval <= 0 {
+		str := "%s: autofee.interval must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.AutoFee.UpdateThreshold < 0 || cfg.AutoFee.UpdateThreshold > 1 ||
+		cfg.AutoFee.IdleDiscount < 0 || cfg.AutoFee.IdleDiscount > 1 {
+
+		str := "%s: autofee.updatethreshold and autofee.idlediscount " +
+			"must be between 0 and 1"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.Liquidity.MinLocalRatio < 0 ||
+		cfg.Liquidity.MinLocalRatio > cfg.Liquidity.MaxLocalRatio ||
+		cfg.Liquidity.MaxLocalRatio > 1 {
+
+		str := "%s: liquidity.minlocalratio and " +
+			"liquidity.maxlocalratio must be between 0 and 1, " +
+			"with minlocalratio not exceeding maxlocalratio"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.Liquidity.FeeBudget < 0 || cfg.Liquidity.BudgetPeriod <= 0 ||
+		cfg.Liquidity.Interval <= 0 {
+
+		str := "%s: liquidity.feebudget must not be negative, and " +
+			"liquidity.budgetperiod and liquidity.interval must " +
+			"be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.MaxHeldInvoiceHtlcs < 0 {
+		str := "%s: maxheldinvoicehtlcs must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.AsyncHoldMaxHtlcs < 0 {
+		str := "%s: asyncholdmaxhtlcs must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.FeeUpdateBand < 0 {
+		str := "%s: feeupdateband must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.OnionMessageRate < 0 || cfg.OnionMessageBurst < 0 {
+		str := "%s: onionmessagerate and onionmessageburst must be " +
+			"non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.OfferInvoiceRate < 0 || cfg.OfferInvoiceBurst < 0 {
+		str := "%s: offerinvoicerate and offerinvoiceburst must be " +
+			"non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// Offers exchange invoice requests and invoices over onion messages,
+	// so they can't be used without them.
+	if cfg.Offers {
+		cfg.OnionMessages = true
+	}
+
+	peerFeatureOverrides, err := parsePeerFeatureOverrides(
+		cfg.PeerFeatures,
+	)
+	if err != nil {
+		err = fmt.Errorf("%s: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	cfg.peerFeatureOverrides = peerFeatureOverrides
 
 	// Ensure that the specified values for the min and max channel size
 	// don't are within the bounds of the normal chan size constraints.
@@ -822,6 +1091,14 @@ func loadConfig() (*config, error) {
 		)
 	}
 
+	// Similarly, if a custom back up file path wasn't specified, then
+	// we'll update the file location to match our set network directory.
+	if cfg.BackupFilePath == "" {
+		cfg.BackupFilePath = filepath.Join(
+			networkDir, chanbackup.DefaultBackupFileName,
+		)
+	}
+
 	// Append the network type to the log directory so it is "namespaced"
 	// per network in the same fashion as the data directory.
 	cfg.LogDir = filepath.Join(cfg.LogDir,
@@ -1004,35 +1281,43 @@ func cleanAndExpandPath(path string) string {
 }
 
 // parseAndSetDebugLevels attempts to parse the specified debug level and set
-// the levels accordingly. An appropriate error is returned if anything is
-// invalid.
+// the levels accordingly. The level spec is a comma separated list of either
+// a global level, applied to all subsystems, or subsystem=level pairs, which
+// are applied in order. An appropriate error is returned if anything is
+// invalid, in which case none of the levels are changed.
 func parseAndSetDebugLevels(debugLevel string) error {
-	// When the specified string doesn't have any delimiters, treat it as
-	// the log level for all subsystems.
-	if !strings.Contains(debugLevel, ",") && !strings.Contains(debugLevel, "=") {
-		// Validate debug log level.
-		if !validLogLevel(debugLevel) {
-			str := "The specified debug level [%v] is invalid"
-			return fmt.Errorf(str, debugLevel)
-		}
-
-		// Change the logging level for all subsystems.
-		setLogLevels(debugLevel)
-
-		return nil
+	type levelPair struct {
+		subsysID string
+		logLevel string
 	}
 
-	// Split the specified string into subsystem/level pairs while detecting
-	// issues and update the log levels accordingly.
+	// Split the specified string into global levels and subsystem/level
+	// pairs, validating all of them before updating any log level so
+	// that an invalid spec doesn't leave it half applied.
+	var levelPairs []levelPair
 	for _, logLevelPair := range strings.Split(debugLevel, ",") {
+		// An entry without a delimiter is treated as the log level
+		// for all subsystems.
 		if !strings.Contains(logLevelPair, "=") {
-			str := "The specified debug level contains an invalid " +
-				"subsystem/level pair [%v]"
-			return fmt.Errorf(str, logLevelPair)
+			if !validLogLevel(logLevelPair) {
+				str := "The specified debug level [%v] is " +
+					"invalid"
+				return fmt.Errorf(str, logLevelPair)
+			}
+
+			levelPairs = append(levelPairs, levelPair{
+				logLevel: logLevelPair,
+			})
+			continue
 		}
 
 		// Extract the specified subsystem and log level.
 		fields := strings.Split(logLevelPair, "=")
+		if len(fields) != 2 {
+			str := "The specified debug level contains an invalid " +
+				"subsystem/level pair [%v]"
+			return fmt.Errorf(str, logLevelPair)
+		}
 		subsysID, logLevel := fields[0], fields[1]
 
 		// Validate subsystem.
@@ -1048,7 +1333,19 @@ func parseAndSetDebugLevels(debugLevel string) error {
 			return fmt.Errorf(str, logLevel)
 		}
 
-		setLogLevel(subsysID, logLevel)
+		levelPairs = append(levelPairs, levelPair{
+			subsysID: subsysID,
+			logLevel: logLevel,
+		})
+	}
+
+	for _, pair := range levelPairs {
+		if pair.subsysID == "" {
+			setLogLevels(pair.logLevel)
+			continue
+		}
+
+		setLogLevel(pair.subsysID, pair.logLevel)
 	}
 
 	return nil
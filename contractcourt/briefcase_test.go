@@ -279,6 +279,7 @@ func TestContractInsertionRetrieval(t *testing.T) {
 		resolved:         true,
 		broadcastHeight:  109,
 		payHash:          testPreimage,
+		htlcIndex:        13,
 		sweepTx:          nil,
 	}
 	resolvers := []ContractResolver{
@@ -134,6 +134,20 @@ type ChainArbitratorConfig struct {
 
 	// Sweeper allows resolvers to sweep their final outputs.
 	Sweeper *sweep.UtxoSweeper
+
+	// NotifyClosedChannel is a function closure that the ChainArbitrator
+	// will use to notify the ChannelNotifier about a newly closed channel,
+	// once it has been marked as closed in the database.
+	NotifyClosedChannel func(wire.OutPoint)
+
+	// NotifyFullyResolvedChannel is a function closure that the
+	// ChainArbitrator will use to notify the ChannelNotifier that all the
+	// contracts of a closed channel have been resolved.
+	NotifyFullyResolvedChannel func(wire.OutPoint)
+
+	// PutHtlcResolution is a function closure that resolvers will use to
+	// persist how an HTLC that went to chain was finally resolved.
+	PutHtlcResolution func(*channeldb.HtlcResolution) error
 }
 
 // ChainArbitrator is a sub-system that oversees the on-chain resolution of all
@@ -251,10 +265,19 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 			return chanMachine.ForceClose()
 		},
 		MarkCommitmentBroadcasted: channel.MarkCommitmentBroadcasted,
-		MarkChannelClosed:         channel.CloseChannel,
-		IsPendingClose:            false,
-		ChainArbitratorConfig:     c.cfg,
-		ChainEvents:               chanEvents,
+		MarkChannelClosed: func(
+			summary *channeldb.ChannelCloseSummary) error {
+
+			if err := channel.CloseChannel(summary); err != nil {
+				return err
+			}
+
+			c.cfg.NotifyClosedChannel(summary.ChanPoint)
+			return nil
+		},
+		IsPendingClose:        false,
+		ChainArbitratorConfig: c.cfg,
+		ChainEvents:           chanEvents,
 	}
 
 	// The final component needed is an arbitrator log that the arbitrator
@@ -297,6 +320,8 @@ func (c *ChainArbitrator) resolveContract(chanPoint wire.OutPoint,
 		return err
 	}
 
+	c.cfg.NotifyFullyResolvedChannel(chanPoint)
+
 	if arbLog != nil {
 		// Once this has been marked as resolved, we'll wipe the log
 		// that the channel arbitrator was using to store its
@@ -359,6 +384,7 @@ func (c *ChainArbitrator) Start() error {
 				contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 					return c.cfg.ContractBreach(chanPoint, retInfo)
 				},
+				notifyClosedChannel: c.cfg.NotifyClosedChannel,
 			},
 		)
 		if err != nil {
@@ -679,6 +705,7 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 			contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 				return c.cfg.ContractBreach(chanPoint, retInfo)
 			},
+			notifyClosedChannel: c.cfg.NotifyClosedChannel,
 		},
 	)
 	if err != nil {
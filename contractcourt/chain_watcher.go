@@ -104,6 +104,10 @@ type chainWatcherConfig struct {
 	// isOurAddr is a function that returns true if the passed address is
 	// known to us.
 	isOurAddr func(btcutil.Address) bool
+
+	// notifyClosedChannel is a method that will be called by the watcher
+	// once it has marked a breached channel as closed in the database.
+	notifyClosedChannel func(wire.OutPoint)
 }
 
 // chainWatcher is a system that's assigned to every active channel. The duty
@@ -314,22 +318,33 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 			return
 		}
 
+		// If this channel has been restored from a backup, then we
+		// don't have any commitment state for it. As we can't close
+		// the channel off-chain ourselves, this can only be the
+		// remote party force closing, or a cooperative close we
+		// signed off on before losing our data.
+		isRestoredChan := c.cfg.chanState.HasChanStatus(
+			channeldb.Restored,
+		)
+
 		// If this is our commitment transaction, then we can
 		// exit here as we don't have any further processing we
 		// need to do (we can't cheat ourselves :p).
-		commitmentHash := localCommit.CommitTx.TxHash()
-		isOurCommitment := commitSpend.SpenderTxHash.IsEqual(
-			&commitmentHash,
-		)
-		if isOurCommitment {
-			if err := c.dispatchLocalForceClose(
-				commitSpend, *localCommit,
-			); err != nil {
-				log.Errorf("unable to handle local"+
-					"close for chan_point=%v: %v",
-					c.cfg.chanState.FundingOutpoint, err)
+		if !isRestoredChan {
+			commitmentHash := localCommit.CommitTx.TxHash()
+			isOurCommitment := commitSpend.SpenderTxHash.IsEqual(
+				&commitmentHash,
+			)
+			if isOurCommitment {
+				if err := c.dispatchLocalForceClose(
+					commitSpend, *localCommit,
+				); err != nil {
+					log.Errorf("unable to handle local"+
+						"close for chan_point=%v: %v",
+						c.cfg.chanState.FundingOutpoint, err)
+				}
+				return
 			}
-			return
 		}
 
 		// Next, we'll check to see if this is a cooperative
@@ -371,7 +386,7 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 		// unilateral close. So we'll trigger the unilateral
 		// close signal so subscribers can clean up the state
 		// as necessary.
-		case broadcastStateNum == remoteStateNum:
+		case broadcastStateNum == remoteStateNum && !isRestoredChan:
 			err := c.dispatchRemoteForceClose(
 				commitSpend, *remoteCommit,
 				c.cfg.chanState.RemoteCurrentRevocation,
@@ -389,7 +404,7 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 		// has a fail crash _after_ accepting the new state,
 		// but _before_ sending their signature to us.
 		case broadcastStateNum == remoteStateNum+1 &&
-			remoteChainTip != nil:
+			remoteChainTip != nil && !isRestoredChan:
 
 			err := c.dispatchRemoteForceClose(
 				commitSpend, remoteChainTip.Commitment,
@@ -404,8 +419,10 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 		// This is the case that somehow the commitment broadcast is
 		// actually greater than even one beyond our best known state
 		// number. This should ONLY happen in case we experienced some
-		// sort of data loss.
-		case broadcastStateNum > remoteStateNum+1:
+		// sort of data loss. A restored channel has lost all of its
+		// state, so any commitment broadcast by the remote party must
+		// be handled this way.
+		case broadcastStateNum > remoteStateNum+1, isRestoredChan:
 			log.Warnf("Remote node broadcast state #%v, "+
 				"which is more than 1 beyond best known "+
 				"state #%v!!! Attempting recovery...",
@@ -816,5 +833,7 @@ func (c *chainWatcher) dispatchContractBreach(spendEvent *chainntnfs.SpendDetail
 	log.Infof("Breached channel=%v marked pending-closed",
 		c.cfg.chanState.FundingOutpoint)
 
+	c.cfg.notifyClosedChannel(c.cfg.chanState.FundingOutpoint)
+
 	return nil
 }
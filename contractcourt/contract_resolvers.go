@@ -11,8 +11,10 @@ import (
 	"github.com/lightningnetwork/lnd/sweep"
 
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -277,6 +279,21 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		}
 	}
 
+	// Before marking the contract resolved, we'll record that the HTLC
+	// timed out, along with the output we swept back to our wallet.
+	resolution := &channeldb.HtlcResolution{
+		ShortChanID: h.ShortChanID,
+		HtlcIndex:   h.htlcIndex,
+		Outcome:     channeldb.HtlcOutcomeTimedOut,
+		Outpoint:    h.htlcResolution.ClaimOutpoint,
+		AmountRecovered: btcutil.Amount(
+			h.htlcResolution.SweepSignDesc.Output.Value,
+		),
+	}
+	if err := h.PutHtlcResolution(resolution); err != nil {
+		return nil, err
+	}
+
 	// With the clean up message sent, we'll now mark the contract
 	// resolved, and wait.
 	h.resolved = true
@@ -400,6 +417,10 @@ type htlcSuccessResolver struct {
 	// payHash is the payment hash of the original HTLC extended to us.
 	payHash [32]byte
 
+	// htlcIndex is the index of this HTLC within the trace of the
+	// additional commitment state machine.
+	htlcIndex uint64
+
 	// sweepTx will be non-nil if we've already crafted a transaction to
 	// sweep a direct HTLC output. This is only a concern if we're sweeping
 	// from the commitment transaction of the remote party.
@@ -525,7 +546,15 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		}
 
 		// Once the transaction has received a sufficient number of
-		// confirmations, we'll mark ourselves as fully resolved and exit.
+		// confirmations, we'll record the settled HTLC, then mark
+		// ourselves as fully resolved and exit.
+		err = h.reportSettle(
+			btcutil.Amount(h.sweepTx.TxOut[0].Value),
+		)
+		if err != nil {
+			return nil, err
+		}
+
 		h.resolved = true
 		return nil, h.Checkpoint(h)
 	}
@@ -589,10 +618,30 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		return nil, fmt.Errorf("quitting")
 	}
 
+	err = h.reportSettle(
+		btcutil.Amount(h.htlcResolution.SweepSignDesc.Output.Value),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	h.resolved = true
 	return nil, h.Checkpoint(h)
 }
 
+// reportSettle records that the incoming HTLC was settled on-chain, along
+// with the amount we swept back to our wallet.
+func (h *htlcSuccessResolver) reportSettle(amt btcutil.Amount) error {
+	return h.PutHtlcResolution(&channeldb.HtlcResolution{
+		ShortChanID:     h.ShortChanID,
+		HtlcIndex:       h.htlcIndex,
+		Incoming:        true,
+		Outcome:         channeldb.HtlcOutcomeSettled,
+		Outpoint:        h.htlcResolution.ClaimOutpoint,
+		AmountRecovered: amt,
+	})
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
@@ -633,6 +682,9 @@ func (h *htlcSuccessResolver) Encode(w io.Writer) error {
 	if _, err := w.Write(h.payHash[:]); err != nil {
 		return err
 	}
+	if err := binary.Write(w, endian, h.htlcIndex); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -662,6 +714,13 @@ func (h *htlcSuccessResolver) Decode(r io.Reader) error {
 		return err
 	}
 
+	// The HTLC index was added after the initial version of this
+	// resolver, so it may be absent from resolvers stored before.
+	err := binary.Read(r, endian, &h.htlcIndex)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
 	return nil
 }
 
@@ -751,8 +810,8 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 				h, h.htlcResolution.ClaimOutpoint)
 		}
 
-		// Finally, we'll send the clean up message, mark ourselves as
-		// resolved, then exit.
+		// Finally, we'll send the clean up message, record the settled
+		// HTLC, mark ourselves as resolved, then exit.
 		if err := h.DeliverResolutionMsg(ResolutionMsg{
 			SourceChan: h.ShortChanID,
 			HtlcIndex:  h.htlcIndex,
@@ -760,6 +819,17 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 		}); err != nil {
 			return nil, err
 		}
+
+		resolution := &channeldb.HtlcResolution{
+			ShortChanID: h.ShortChanID,
+			HtlcIndex:   h.htlcIndex,
+			Outcome:     channeldb.HtlcOutcomeSettled,
+			Outpoint:    *commitSpend.SpentOutPoint,
+		}
+		if err := h.PutHtlcResolution(resolution); err != nil {
+			return nil, err
+		}
+
 		h.resolved = true
 		return nil, h.Checkpoint(h)
 	}
@@ -1004,6 +1074,10 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 		log.Infof("%T(%v): HTLC has timed out (expiry=%v, height=%v), "+
 			"abandoning", h, h.htlcResolution.ClaimOutpoint,
 			h.htlcExpiry, currentHeight)
+		if err := h.reportTimeout(); err != nil {
+			return nil, err
+		}
+
 		h.resolved = true
 		return nil, h.Checkpoint(h)
 	}
@@ -1096,6 +1170,10 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 					"(expiry=%v, height=%v), abandoning", h,
 					h.htlcResolution.ClaimOutpoint,
 					h.htlcExpiry, currentHeight)
+				if err := h.reportTimeout(); err != nil {
+					return nil, err
+				}
+
 				h.resolved = true
 				return nil, h.Checkpoint(h)
 			}
@@ -1106,6 +1184,23 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 	}
 }
 
+// reportTimeout records that the incoming HTLC timed out, leaving its output
+// on the commitment transaction to be swept by the remote party.
+func (h *htlcIncomingContestResolver) reportTimeout() error {
+	op := h.htlcResolution.ClaimOutpoint
+	if h.htlcResolution.SignedSuccessTx != nil {
+		op = h.htlcResolution.SignedSuccessTx.TxIn[0].PreviousOutPoint
+	}
+
+	return h.PutHtlcResolution(&channeldb.HtlcResolution{
+		ShortChanID: h.ShortChanID,
+		HtlcIndex:   h.htlcIndex,
+		Incoming:    true,
+		Outcome:     channeldb.HtlcOutcomeTimedOut,
+		Outpoint:    op,
+	})
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
@@ -165,6 +165,10 @@ type AuthenticatedGossiper struct {
 	// as we know it. To be used atomically.
 	bestHeight uint32
 
+	// graphSynced is set to 1 once one of our gossip syncers completed its
+	// initial sync of the channel graph. To be used atomically.
+	graphSynced uint32
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 
@@ -1218,9 +1222,10 @@ func (d *AuthenticatedGossiper) networkHandler() {
 // queries. We'll allocate a new gossip syncer for it, and start any goroutines
 // needed to handle new queries. The recvUpdates bool indicates if we should
 // continue to receive real-time updates from the remote peer once we've synced
-// channel state.
+// channel state. The encoding is used to encode the short channel IDs of our
+// queries and replies, and must be understood by the remote peer.
 func (d *AuthenticatedGossiper) InitSyncState(syncPeer lnpeer.Peer,
-	recvUpdates bool) {
+	recvUpdates bool, encoding lnwire.ShortChanIDEncoding) {
 
 	d.syncerMtx.Lock()
 	defer d.syncerMtx.Unlock()
@@ -1234,7 +1239,6 @@ func (d *AuthenticatedGossiper) InitSyncState(syncPeer lnpeer.Peer,
 
 	log.Infof("Creating new gossipSyncer for peer=%x", nodeID[:])
 
-	encoding := lnwire.EncodingSortedPlain
 	syncer := newGossiperSyncer(gossipSyncerCfg{
 		chainHash:       d.cfg.ChainHash,
 		syncChanUpdates: recvUpdates,
@@ -1274,6 +1278,28 @@ func (d *AuthenticatedGossiper) PruneSyncState(peer *btcec.PublicKey) {
 	return
 }
 
+// IsGraphSynced returns true if one of our gossip syncers has completed its
+// initial sync of the channel graph. Once the graph has been synced, it's
+// considered synced for the lifetime of the gossiper, even if the syncer is
+// pruned later on.
+func (d *AuthenticatedGossiper) IsGraphSynced() bool {
+	if atomic.LoadUint32(&d.graphSynced) == 1 {
+		return true
+	}
+
+	d.syncerMtx.RLock()
+	defer d.syncerMtx.RUnlock()
+
+	for _, syncer := range d.peerSyncers {
+		if syncer.SyncState() == chansSynced {
+			atomic.StoreUint32(&d.graphSynced, 1)
+			return true
+		}
+	}
+
+	return false
+}
+
 // isRecentlyRejectedMsg returns true if we recently rejected a message, and
 // false otherwise, This avoids expensive reprocessing of the message.
 func (d *AuthenticatedGossiper) isRecentlyRejectedMsg(msg lnwire.Message) bool {
@@ -1429,6 +1455,46 @@ func (d *AuthenticatedGossiper) processChanPolicyUpdate(
 			policyUpdate.newSchema.FeeRate,
 		)
 		edge.TimeLockDelta = uint16(policyUpdate.newSchema.TimeLockDelta)
+		if minHTLC := policyUpdate.newSchema.MinHTLC; minHTLC != 0 {
+			edge.MinHTLC = minHTLC
+		}
+
+		// The maximum HTLC is optional within the channel update, so
+		// we'll only signal it once it's been set.
+		if maxHTLC := policyUpdate.newSchema.MaxHTLC; maxHTLC != 0 {
+			capacity := lnwire.NewMSatFromSatoshis(info.Capacity)
+			if maxHTLC > capacity {
+				return fmt.Errorf("max htlc of %v exceeds "+
+					"capacity of ChannelPoint(%v)",
+					maxHTLC, info.ChannelPoint)
+			}
+
+			edge.MaxHTLC = maxHTLC
+			edge.Flags |= lnwire.ChanUpdateOptionMaxHtlc
+		}
+
+		// Either bound may have changed, so we'll make sure we won't be
+		// advertising a range no HTLC can fall within.
+		if edge.Flags&lnwire.ChanUpdateOptionMaxHtlc != 0 &&
+			edge.MinHTLC > edge.MaxHTLC {
+
+			return fmt.Errorf("min htlc of %v exceeds max htlc of "+
+				"%v for ChannelPoint(%v)", edge.MinHTLC,
+				edge.MaxHTLC, info.ChannelPoint)
+		}
+
+		// The inbound fee is carried within the extra opaque data of
+		// the channel update, so we'll only touch it if requested.
+		if policyUpdate.newSchema.InboundFee != nil {
+			extraData, err := lnwire.SetInboundFee(
+				edge.ExtraOpaqueData,
+				policyUpdate.newSchema.InboundFee,
+			)
+			if err != nil {
+				return err
+			}
+			edge.ExtraOpaqueData = extraData
+		}
 
 		edgesToUpdate = append(edgesToUpdate, edgeWithInfo{
 			info: info,
@@ -1995,7 +2061,11 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// Validate the channel announcement with the expected public
 		// key, In the case of an invalid channel , we'll return an
 		// error to the caller and exit early.
-		if err := routing.ValidateChannelUpdateAnn(pubKey, msg); err != nil {
+		err = routing.ValidateChannelUpdateFields(chanInfo.Capacity, msg)
+		if err == nil {
+			err = routing.ValidateChannelUpdateAnn(pubKey, msg)
+		}
+		if err != nil {
 			rErr := fmt.Errorf("unable to validate channel "+
 				"update announcement for short_chan_id=%v: %v",
 				spew.Sdump(msg.ShortChannelID), err)
@@ -2012,6 +2082,7 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			Flags:                     msg.Flags,
 			TimeLockDelta:             msg.TimeLockDelta,
 			MinHTLC:                   msg.HtlcMinimumMsat,
+			MaxHTLC:                   msg.HtlcMaximumMsat,
 			FeeBaseMSat:               lnwire.MilliSatoshi(msg.BaseFee),
 			FeeProportionalMillionths: lnwire.MilliSatoshi(msg.FeeRate),
 			ExtraOpaqueData:           msg.ExtraOpaqueData,
@@ -2520,6 +2591,7 @@ func (d *AuthenticatedGossiper) updateChannel(info *channeldb.ChannelEdgeInfo,
 		Flags:           edge.Flags,
 		TimeLockDelta:   edge.TimeLockDelta,
 		HtlcMinimumMsat: edge.MinHTLC,
+		HtlcMaximumMsat: edge.MaxHTLC,
 		BaseFee:         uint32(edge.FeeBaseMSat),
 		FeeRate:         uint32(edge.FeeProportionalMillionths),
 		ExtraOpaqueData: edge.ExtraOpaqueData,
@@ -2571,7 +2643,7 @@ func (d *AuthenticatedGossiper) updateChannel(info *channeldb.ChannelEdgeInfo,
 			BitcoinKey1:     info.BitcoinKey1Bytes,
 			Features:        lnwire.NewRawFeatureVector(),
 			BitcoinKey2:     info.BitcoinKey2Bytes,
-			ExtraOpaqueData: edge.ExtraOpaqueData,
+			ExtraOpaqueData: info.ExtraOpaqueData,
 		}
 		chanAnn.NodeSig1, err = lnwire.NewSigFromRawSignature(
 			info.AuthProof.NodeSig1Bytes,
@@ -11,6 +11,7 @@ import (
 	"os"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -2752,6 +2753,44 @@ func TestNodeAnnouncementNoChannels(t *testing.T) {
 	}
 }
 
+// TestIsGraphSynced asserts that the graph is considered synced once one of
+// the gossip syncers completed its initial sync, and that it remains synced
+// after the syncer is pruned.
+func TestIsGraphSynced(t *testing.T) {
+	t.Parallel()
+
+	ctx, cleanup, err := createTestCtx(0)
+	if err != nil {
+		t.Fatalf("can't create context: %v", err)
+	}
+	defer cleanup()
+
+	syncer := &gossipSyncer{
+		state: uint32(syncingChans),
+	}
+	vertex := routing.NewVertex(nodeKeyPub1)
+	ctx.gossiper.syncerMtx.Lock()
+	ctx.gossiper.peerSyncers[vertex] = syncer
+	ctx.gossiper.syncerMtx.Unlock()
+
+	if ctx.gossiper.IsGraphSynced() {
+		t.Fatal("graph shouldn't be synced while syncing channels")
+	}
+
+	atomic.StoreUint32(&syncer.state, uint32(chansSynced))
+	if !ctx.gossiper.IsGraphSynced() {
+		t.Fatal("graph should be synced")
+	}
+
+	ctx.gossiper.syncerMtx.Lock()
+	delete(ctx.gossiper.peerSyncers, vertex)
+	ctx.gossiper.syncerMtx.Unlock()
+
+	if !ctx.gossiper.IsGraphSynced() {
+		t.Fatal("graph should remain synced")
+	}
+}
+
 // mockPeer implements the lnpeer.Peer interface and is used to test the
 // gossiper's interaction with peers.
 type mockPeer struct {
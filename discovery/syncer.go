@@ -94,6 +94,7 @@ var (
 	// single message safely.
 	encodingTypeToChunkSize = map[lnwire.ShortChanIDEncoding]int32{
 		lnwire.EncodingSortedPlain: 8000,
+		lnwire.EncodingSortedZlib:  8000,
 	}
 
 	// ErrGossipSyncerExiting signals that the syncer has been killed.
@@ -486,7 +487,7 @@ func (g *gossipSyncer) synchronizeChanIDs() (bool, error) {
 	// false indicating that we're net yet fully synced.
 	err := g.cfg.sendToPeer(&lnwire.QueryShortChanIDs{
 		ChainHash:    g.cfg.chainHash,
-		EncodingType: lnwire.EncodingSortedPlain,
+		EncodingType: g.cfg.encodingType,
 		ShortChanIDs: queryChunk,
 	})
 
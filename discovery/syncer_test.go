@@ -866,6 +866,43 @@ func TestGossipSyncerProcessChanRangeReply(t *testing.T) {
 	}
 }
 
+// TestGossipSyncerQueryEncoding asserts that the syncer encodes its short
+// channel ID queries using the encoding negotiated with the remote peer.
+func TestGossipSyncerQueryEncoding(t *testing.T) {
+	t.Parallel()
+
+	msgChan, syncer, _ := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), lnwire.EncodingSortedZlib,
+		encodingTypeToChunkSize[lnwire.EncodingSortedZlib],
+	)
+
+	syncer.newChansToQuery = []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1),
+		lnwire.NewShortChanIDFromInt(2),
+	}
+
+	if _, err := syncer.synchronizeChanIDs(); err != nil {
+		t.Fatalf("unable to sync chan IDs: %v", err)
+	}
+
+	select {
+	case <-time.After(time.Second * 15):
+		t.Fatalf("no msgs received")
+
+	case msg := <-msgChan:
+		queryMsg, ok := msg[0].(*lnwire.QueryShortChanIDs)
+		if !ok {
+			t.Fatalf("expected QueryShortChanIDs instead "+
+				"got %T", msg)
+		}
+
+		if queryMsg.EncodingType != lnwire.EncodingSortedZlib {
+			t.Fatalf("expected zlib encoding, got %v",
+				queryMsg.EncodingType)
+		}
+	}
+}
+
 // TestGossipSyncerSynchronizeChanIDs tests that we properly request chunks of
 // the short chan ID's which were unknown to us. We'll ensure that we request
 // chunk by chunk, and after the last chunk, we return true indicating that we
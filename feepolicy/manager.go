@@ -0,0 +1,268 @@
+package feepolicy
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultUpdateInterval is the default interval at which the fee
+	// rates of our channels are re-evaluated.
+	DefaultUpdateInterval = time.Hour
+
+	// DefaultUpdateThreshold is the default fraction by which the fee
+	// rate of a channel must change before we broadcast a new policy for
+	// it, which prevents us from spamming the network with updates.
+	DefaultUpdateThreshold = 0.1
+
+	// DefaultIdleDiscount is the default fraction of the fee rate that's
+	// dropped for channels that haven't forwarded anything since the
+	// last evaluation.
+	DefaultIdleDiscount = 0.5
+)
+
+// Channel is a snapshot of one of our channels, as evaluated by the Manager.
+type Channel struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// Capacity is the total capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our current balance within the channel.
+	LocalBalance lnwire.MilliSatoshi
+
+	// TotalForwarded is the total amount we've ever sent or received over
+	// the channel. It's used to detect idle channels.
+	TotalForwarded lnwire.MilliSatoshi
+
+	// Policy is the forwarding policy currently advertised for the
+	// channel.
+	Policy routing.ChannelPolicy
+}
+
+// Config houses the dependencies and parameters of the Manager.
+type Config struct {
+	// FetchChannels returns a snapshot of all the channels whose fees
+	// should be managed.
+	FetchChannels func() ([]*Channel, error)
+
+	// UpdatePolicy applies the given policy to the channel's link, and
+	// broadcasts it to the network.
+	UpdatePolicy func(chanPoint wire.OutPoint,
+		policy routing.ChannelPolicy) error
+
+	// MinFeeRate is the fee rate, in parts per million, charged for
+	// channels whose balance is entirely on our side.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the fee rate, in parts per million, charged for
+	// channels whose balance is entirely on the remote side.
+	MaxFeeRate uint32
+
+	// UpdateThreshold is the fraction by which the fee rate of a channel
+	// must change before a new policy is broadcast. If zero,
+	// DefaultUpdateThreshold is used.
+	UpdateThreshold float64
+
+	// IdleDiscount is the fraction of the fee rate dropped for channels
+	// that haven't forwarded anything since the last evaluation. If
+	// zero, DefaultIdleDiscount is used.
+	IdleDiscount float64
+
+	// UpdateTicker signals when the fee rates should be re-evaluated.
+	UpdateTicker ticker.Ticker
+}
+
+// Manager periodically adjusts the forwarding fee rate of each of our
+// channels according to its liquidity. The scarcer our balance within a
+// channel, the more we charge for forwarding over it, which discourages
+// draining it any further. Channels that sit idle have their fees lowered,
+// in order to attract traffic. All fee rates are kept within the bounds
+// configured by the operator.
+type Manager struct {
+	started int32
+	stopped int32
+
+	cfg Config
+
+	// forwarded is the total amount forwarded over each channel as of the
+	// last evaluation, used to detect idle channels.
+	forwarded map[wire.OutPoint]lnwire.MilliSatoshi
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewManager creates a new fee policy manager from the given config.
+func NewManager(cfg Config) *Manager {
+	if cfg.UpdateThreshold == 0 {
+		cfg.UpdateThreshold = DefaultUpdateThreshold
+	}
+	if cfg.IdleDiscount == 0 {
+		cfg.IdleDiscount = DefaultIdleDiscount
+	}
+
+	return &Manager{
+		cfg:       cfg,
+		forwarded: make(map[wire.OutPoint]lnwire.MilliSatoshi),
+		quit:      make(chan struct{}),
+	}
+}
+
+// Start launches the goroutine that periodically re-evaluates the fee rates
+// of our channels.
+func (m *Manager) Start() error {
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Fee policy manager starting, fee rate bounds: [%v, %v] "+
+		"ppm", m.cfg.MinFeeRate, m.cfg.MaxFeeRate)
+
+	m.cfg.UpdateTicker.Resume()
+
+	m.wg.Add(1)
+	go m.updateLoop()
+
+	return nil
+}
+
+// Stop stops the manager.
+func (m *Manager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&m.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Info("Fee policy manager shutting down")
+
+	close(m.quit)
+	m.wg.Wait()
+
+	m.cfg.UpdateTicker.Stop()
+
+	return nil
+}
+
+// updateLoop periodically re-evaluates the fee rates of our channels.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) updateLoop() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-m.cfg.UpdateTicker.Ticks():
+			if err := m.updateFees(); err != nil {
+				log.Errorf("Unable to update fees: %v", err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// updateFees re-evaluates the fee rate of each channel, broadcasting a new
+// policy for those whose fee rate changed substantially.
+func (m *Manager) updateFees() error {
+	channels, err := m.cfg.FetchChannels()
+	if err != nil {
+		return err
+	}
+
+	active := make(map[wire.OutPoint]struct{}, len(channels))
+	for _, channel := range channels {
+		active[channel.ChanPoint] = struct{}{}
+
+		// A channel is idle if nothing has been forwarded over it since
+		// the last evaluation. We can't tell for channels we haven't
+		// evaluated before, so we'll treat them as active.
+		lastForwarded, ok := m.forwarded[channel.ChanPoint]
+		idle := ok && lastForwarded == channel.TotalForwarded
+		m.forwarded[channel.ChanPoint] = channel.TotalForwarded
+
+		feeRate := m.targetFeeRate(channel, idle)
+		if !m.shouldUpdate(channel.Policy.FeeRate, feeRate) {
+			continue
+		}
+
+		log.Infof("Updating fee rate of ChannelPoint(%v) from %v to "+
+			"%v ppm, local_balance=%v, capacity=%v, idle=%v",
+			channel.ChanPoint, channel.Policy.FeeRate, feeRate,
+			channel.LocalBalance, channel.Capacity, idle)
+
+		policy := channel.Policy
+		policy.FeeRate = feeRate
+		err := m.cfg.UpdatePolicy(channel.ChanPoint, policy)
+		if err != nil {
+			log.Errorf("Unable to update policy of "+
+				"ChannelPoint(%v): %v", channel.ChanPoint, err)
+		}
+	}
+
+	// Forget about any channels that have since been closed.
+	for chanPoint := range m.forwarded {
+		if _, ok := active[chanPoint]; !ok {
+			delete(m.forwarded, chanPoint)
+		}
+	}
+
+	return nil
+}
+
+// targetFeeRate returns the fee rate, in parts per million, that should be
+// charged for forwarding over the channel. The fee rate scales linearly with
+// the fraction of the capacity that's on the remote side, from MinFeeRate for
+// a channel whose balance is entirely ours, to MaxFeeRate for a channel in
+// which we have no balance left. Idle channels are discounted, but never
+// below MinFeeRate.
+func (m *Manager) targetFeeRate(channel *Channel, idle bool) uint32 {
+	capacity := lnwire.NewMSatFromSatoshis(channel.Capacity)
+	if capacity == 0 {
+		return channel.Policy.FeeRate
+	}
+
+	localRatio := float64(channel.LocalBalance) / float64(capacity)
+	if localRatio > 1 {
+		localRatio = 1
+	}
+
+	minRate := float64(m.cfg.MinFeeRate)
+	maxRate := float64(m.cfg.MaxFeeRate)
+	feeRate := minRate + (maxRate-minRate)*(1-localRatio)
+
+	if idle {
+		feeRate *= 1 - m.cfg.IdleDiscount
+		if feeRate < minRate {
+			feeRate = minRate
+		}
+	}
+
+	return uint32(feeRate)
+}
+
+// shouldUpdate returns true if the new fee rate deviates enough from the
+// current one to warrant broadcasting a new policy.
+func (m *Manager) shouldUpdate(current, target uint32) bool {
+	if current == target {
+		return false
+	}
+	if current == 0 {
+		return true
+	}
+
+	delta := float64(target) - float64(current)
+	if delta < 0 {
+		delta = -delta
+	}
+
+	return delta/float64(current) >= m.cfg.UpdateThreshold
+}
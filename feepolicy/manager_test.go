@@ -0,0 +1,109 @@
+package feepolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// TestManagerUpdateFees asserts that the fee rate of each channel is set
+// according to its liquidity and activity, and that only substantial changes
+// are broadcast.
+func TestManagerUpdateFees(t *testing.T) {
+	t.Parallel()
+
+	depleted := wire.OutPoint{Index: 1}
+	balanced := wire.OutPoint{Index: 2}
+	full := wire.OutPoint{Index: 3}
+
+	newChannel := func(chanPoint wire.OutPoint, localSat btcutil.Amount,
+		feeRate uint32) *Channel {
+
+		return &Channel{
+			ChanPoint:    chanPoint,
+			Capacity:     1000000,
+			LocalBalance: lnwire.NewMSatFromSatoshis(localSat),
+			Policy: routing.ChannelPolicy{
+				FeeSchema: routing.FeeSchema{
+					BaseFee: 1000,
+					FeeRate: feeRate,
+				},
+				TimeLockDelta: 40,
+			},
+		}
+	}
+
+	channels := []*Channel{
+		newChannel(depleted, 0, 100),
+		newChannel(balanced, 500000, 520),
+		newChannel(full, 1000000, 100),
+	}
+	updates := make(map[wire.OutPoint]routing.ChannelPolicy)
+
+	m := NewManager(Config{
+		FetchChannels: func() ([]*Channel, error) {
+			return channels, nil
+		},
+		UpdatePolicy: func(chanPoint wire.OutPoint,
+			policy routing.ChannelPolicy) error {
+
+			updates[chanPoint] = policy
+			return nil
+		},
+		MinFeeRate:   10,
+		MaxFeeRate:   1000,
+		UpdateTicker: ticker.New(time.Hour),
+	})
+
+	if err := m.updateFees(); err != nil {
+		t.Fatalf("unable to update fees: %v", err)
+	}
+
+	// The depleted channel should be charging the maximum fee rate, and
+	// the full channel the minimum one. The balanced channel is already
+	// within the update threshold of its target, so it shouldn't have
+	// been updated.
+	if len(updates) != 2 {
+		t.Fatalf("expected 2 updates, got %v", len(updates))
+	}
+	if updates[depleted].FeeRate != 1000 {
+		t.Fatalf("expected fee rate of 1000 for depleted channel, "+
+			"got %v", updates[depleted].FeeRate)
+	}
+	if updates[full].FeeRate != 10 {
+		t.Fatalf("expected fee rate of 10 for full channel, got %v",
+			updates[full].FeeRate)
+	}
+
+	// The rest of the policy should be left untouched.
+	policy := updates[depleted]
+	if policy.BaseFee != 1000 || policy.TimeLockDelta != 40 {
+		t.Fatalf("unexpected policy: %v", policy)
+	}
+
+	// Now, we'll have the depleted channel forward a payment, while the
+	// balanced channel sits idle. The balanced channel's fee rate should
+	// be discounted, while the depleted channel keeps charging the
+	// maximum fee rate.
+	channels[0].Policy.FeeRate = 1000
+	channels[0].TotalForwarded = 5000
+	channels[2].Policy.FeeRate = 10
+	updates = make(map[wire.OutPoint]routing.ChannelPolicy)
+
+	if err := m.updateFees(); err != nil {
+		t.Fatalf("unable to update fees: %v", err)
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("expected 1 update, got %v", len(updates))
+	}
+	if updates[balanced].FeeRate != 252 {
+		t.Fatalf("expected fee rate of 252 for idle channel, got %v",
+			updates[balanced].FeeRate)
+	}
+}
@@ -0,0 +1,378 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+var (
+	// errFundingBatchFailed is returned for the channels of a funding batch
+	// once any other channel of the same batch has failed.
+	errFundingBatchFailed = errors.New("funding batch failed")
+)
+
+// batchChannel tracks the funding flow of a single channel within a funding
+// batch.
+type batchChannel struct {
+	pendingChanID [32]byte
+	resCtx        *reservationWithCtx
+
+	// theirContribution is the contribution of the remote party, which is
+	// known once they've accepted the channel.
+	theirContribution *lnwallet.ChannelContribution
+
+	// commitSig is the remote party's already verified signature for our
+	// version of the commitment transaction.
+	commitSig []byte
+}
+
+// fundingBatch coordinates the funding flows of several channels that are all
+// funded by a single transaction. As the funding transaction has to pay to the
+// multi-sig outputs of all channels, it can only be created once every peer
+// has accepted its channel. Likewise, it is only broadcast once every peer has
+// signed our version of its commitment transaction. If the funding flow of any
+// channel fails, the whole batch fails.
+type fundingBatch struct {
+	numChannels int
+
+	// feeRate is the fee rate of the shared funding transaction.
+	feeRate lnwallet.SatPerKWeight
+
+	// minConfs is the minimum number of confirmations that each output
+	// spent by the shared funding transaction should satisfy.
+	minConfs int32
+
+	mtx       sync.Mutex
+	channels  []*batchChannel
+	fundingTx *wire.MsgTx
+	failed    bool
+	completed bool
+}
+
+// newFundingBatch creates a new funding batch for the given number of
+// channels.
+func newFundingBatch(numChannels int, feeRate lnwallet.SatPerKWeight,
+	minConfs int32) *fundingBatch {
+
+	return &fundingBatch{
+		numChannels: numChannels,
+		feeRate:     feeRate,
+		minConfs:    minConfs,
+	}
+}
+
+// addChannel registers the reservation of a channel of the batch. False is
+// returned if the batch has already failed.
+func (b *fundingBatch) addChannel(pendingChanID [32]byte,
+	resCtx *reservationWithCtx) bool {
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.failed {
+		return false
+	}
+
+	b.channels = append(b.channels, &batchChannel{
+		pendingChanID: pendingChanID,
+		resCtx:        resCtx,
+	})
+
+	return true
+}
+
+// channel returns the channel of the batch with the given pending channel ID.
+//
+// NOTE: The batch mutex MUST be held when calling this method.
+func (b *fundingBatch) channel(pendingChanID [32]byte) *batchChannel {
+	for _, c := range b.channels {
+		if c.pendingChanID == pendingChanID {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// acceptChannel records the contribution of the remote party of a channel. It
+// returns true once the peers of all channels of the batch have accepted.
+func (b *fundingBatch) acceptChannel(pendingChanID [32]byte,
+	contribution *lnwallet.ChannelContribution) bool {
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if c := b.channel(pendingChanID); c != nil {
+		c.theirContribution = contribution
+	}
+
+	if b.failed || len(b.channels) != b.numChannels {
+		return false
+	}
+	for _, c := range b.channels {
+		if c.theirContribution == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// signChannel records the verified commitment signature of the remote party
+// of a channel. It returns true once the peers of all channels of the batch
+// have signed.
+func (b *fundingBatch) signChannel(pendingChanID [32]byte,
+	commitSig []byte) bool {
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if c := b.channel(pendingChanID); c != nil {
+		c.commitSig = commitSig
+	}
+
+	if b.failed || b.completed {
+		return false
+	}
+	for _, c := range b.channels {
+		if c.commitSig == nil {
+			return false
+		}
+	}
+
+	// Mark the batch as completed, so it can no longer fail while its
+	// channels are committed to disk.
+	b.completed = true
+
+	return true
+}
+
+// setFundingTx records the shared funding transaction of the batch. False is
+// returned if the batch has failed in the meantime, in which case the caller
+// is responsible for releasing the transaction's inputs.
+func (b *fundingBatch) setFundingTx(fundingTx *wire.MsgTx) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.failed {
+		return false
+	}
+
+	b.fundingTx = fundingTx
+
+	return true
+}
+
+// snapshot returns a copy of the set of channels of the batch.
+func (b *fundingBatch) snapshot() []*batchChannel {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	channels := make([]*batchChannel, len(b.channels))
+	copy(channels, b.channels)
+
+	return channels
+}
+
+// hasFailed returns true if the batch has failed.
+func (b *fundingBatch) hasFailed() bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.failed
+}
+
+// fail marks the batch as failed, and returns its channels along with its
+// funding transaction, if already created. False is returned if the batch has
+// already failed or completed.
+func (b *fundingBatch) fail() ([]*batchChannel, *wire.MsgTx, bool) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.failed || b.completed {
+		return nil, nil, false
+	}
+	b.failed = true
+
+	channels := make([]*batchChannel, len(b.channels))
+	copy(channels, b.channels)
+
+	return channels, b.fundingTx, true
+}
+
+// handleBatchFundingAccept records the contribution of the remote party of a
+// batched channel. Once all peers of the batch have accepted their channels,
+// the shared funding transaction is created and the funding flow of every
+// channel is continued by sending the FundingCreated message.
+func (f *fundingManager) handleBatchFundingAccept(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, contribution *lnwallet.ChannelContribution) {
+
+	batch := resCtx.batch
+	if !batch.acceptChannel(pendingChanID, contribution) {
+		fndgLog.Debugf("Waiting for the remaining peers of the batch "+
+			"of pendingID(%x) to accept", pendingChanID[:])
+		return
+	}
+
+	// Every peer has accepted, so we know the multi-sig keys of all
+	// channels and can create their funding outputs.
+	channels := batch.snapshot()
+	outputs := make([]*wire.TxOut, 0, len(channels))
+	for _, c := range channels {
+		ourKey := c.resCtx.reservation.OurContribution().MultiSigKey
+		theirKey := c.theirContribution.MultiSigKey
+
+		_, output, err := lnwallet.GenFundingPkScript(
+			ourKey.PubKey.SerializeCompressed(),
+			theirKey.PubKey.SerializeCompressed(),
+			int64(c.resCtx.chanAmt),
+		)
+		if err != nil {
+			f.failBatch(batch, err)
+			return
+		}
+		outputs = append(outputs, output)
+	}
+
+	fundingTx, err := f.cfg.Wallet.CreateBatchFundingTx(
+		outputs, batch.feeRate, batch.minConfs,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to create batch funding tx: %v", err)
+		f.failBatch(batch, err)
+		return
+	}
+	if !batch.setFundingTx(fundingTx) {
+		f.cfg.Wallet.ReleaseBatchFundingTx(fundingTx)
+		return
+	}
+
+	fndgLog.Infof("Created batch funding tx %v for %v channels",
+		fundingTx.TxHash(), len(channels))
+
+	for _, c := range channels {
+		err := c.resCtx.reservation.ProcessExternalContribution(
+			c.theirContribution, fundingTx,
+		)
+		if err != nil {
+			fndgLog.Errorf("Unable to process contribution for "+
+				"pendingID(%x): %v", c.pendingChanID[:], err)
+			f.failFundingFlow(c.resCtx.peer, c.pendingChanID, err)
+			return
+		}
+
+		err = f.sendFundingCreated(c.resCtx, c.pendingChanID)
+		if err != nil {
+			f.failFundingFlow(c.resCtx.peer, c.pendingChanID, err)
+			return
+		}
+	}
+}
+
+// handleBatchFundingSigned verifies and records the commitment signature of
+// the remote party of a batched channel. Once all peers of the batch have
+// signed, every channel is committed to disk and the shared funding
+// transaction is broadcast.
+func (f *fundingManager) handleBatchFundingSigned(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, commitSig []byte) {
+
+	// We verify the signature right away, so that none of the channels is
+	// committed to disk before we know that all of them can be.
+	if err := resCtx.reservation.VerifyCommitSig(commitSig); err != nil {
+		fndgLog.Errorf("Invalid commitment signature for "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
+	batch := resCtx.batch
+	if !batch.signChannel(pendingChanID, commitSig) {
+		fndgLog.Debugf("Waiting for the remaining peers of the batch "+
+			"of pendingID(%x) to sign", pendingChanID[:])
+		return
+	}
+
+	channels := batch.snapshot()
+	completeChans := make([]*channeldb.OpenChannel, 0, len(channels))
+	for i, c := range channels {
+		completeChan, err := c.resCtx.reservation.CompleteReservation(
+			nil, c.commitSig,
+		)
+		if err != nil {
+			fndgLog.Errorf("Unable to complete reservation of "+
+				"batched pendingID(%x): %v", c.pendingChanID[:],
+				err)
+
+			// The funding transaction can't be broadcast if any of
+			// its channels wasn't committed to disk, so we'll fail
+			// the remaining ones.
+			for _, r := range channels[i:] {
+				f.failFundingFlow(
+					r.resCtx.peer, r.pendingChanID, err,
+				)
+			}
+			return
+		}
+
+		f.deleteReservationCtx(c.resCtx.peer.IdentityKey(),
+			c.pendingChanID)
+		completeChans = append(completeChans, completeChan)
+	}
+
+	// Broadcast the finalized funding transaction to the network. As
+	// with regular channels, a failed broadcast is retried at startup.
+	fundingTx := completeChans[0].FundingTxn
+	fndgLog.Infof("Broadcasting batch funding tx %v: %v",
+		fundingTx.TxHash(), spew.Sdump(fundingTx))
+
+	if err := f.cfg.PublishTransaction(fundingTx); err != nil {
+		fndgLog.Errorf("Unable to broadcast batch funding tx %v: %v",
+			fundingTx.TxHash(), err)
+	}
+
+	for i, c := range channels {
+		f.watchPendingChannel(
+			c.resCtx, c.pendingChanID, completeChans[i],
+		)
+	}
+}
+
+// failBatch fails the funding flows of all channels of the passed batch that
+// are still pending, and releases the coins of its funding transaction. It is
+// a no-op if batch is nil, or the batch has already failed.
+func (f *fundingManager) failBatch(batch *fundingBatch, batchErr error) {
+	if batch == nil {
+		return
+	}
+
+	channels, fundingTx, ok := batch.fail()
+	if !ok {
+		return
+	}
+
+	fndgLog.Errorf("Funding batch of %v channels failed: %v",
+		batch.numChannels, batchErr)
+
+	if fundingTx != nil {
+		f.cfg.Wallet.ReleaseBatchFundingTx(fundingTx)
+	}
+
+	batchErr = fmt.Errorf("%v: %v", errFundingBatchFailed, batchErr)
+	for _, c := range channels {
+		// Skip the channels whose reservation has already been
+		// cancelled, including the one that caused the batch to fail.
+		peerKey := c.resCtx.peer.IdentityKey()
+		_, err := f.getReservationCtx(peerKey, c.pendingChanID)
+		if err != nil {
+			continue
+		}
+
+		f.failFundingFlow(c.resCtx.peer, c.pendingChanID, batchErr)
+	}
+}
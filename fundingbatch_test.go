@@ -0,0 +1,84 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestFundingBatchProgress ensures that a funding batch only reports all of
+// its channels as accepted and signed once every channel of the batch has
+// progressed that far, and that a failed batch can't progress any further.
+func TestFundingBatchProgress(t *testing.T) {
+	t.Parallel()
+
+	chanIDs := [][32]byte{{1}, {2}}
+	contribution := &lnwallet.ChannelContribution{}
+
+	batch := newFundingBatch(len(chanIDs), 253, 1)
+	if !batch.addChannel(chanIDs[0], &reservationWithCtx{}) {
+		t.Fatalf("unable to add channel to batch")
+	}
+
+	// With only one of the two channels added, the batch can't be fully
+	// accepted.
+	if batch.acceptChannel(chanIDs[0], contribution) {
+		t.Fatalf("batch accepted with missing channel")
+	}
+
+	if !batch.addChannel(chanIDs[1], &reservationWithCtx{}) {
+		t.Fatalf("unable to add channel to batch")
+	}
+	if !batch.acceptChannel(chanIDs[1], contribution) {
+		t.Fatalf("batch not accepted after all channels accepted")
+	}
+
+	if batch.signChannel(chanIDs[0], []byte{1}) {
+		t.Fatalf("batch signed with missing signature")
+	}
+	if !batch.signChannel(chanIDs[1], []byte{2}) {
+		t.Fatalf("batch not signed after all channels signed")
+	}
+
+	// Once completed, the batch can no longer fail.
+	if _, _, ok := batch.fail(); ok {
+		t.Fatalf("completed batch failed")
+	}
+
+	// A batch that failed before completion returns its funding
+	// transaction so its inputs can be released, and can neither add
+	// channels nor record a funding transaction afterwards.
+	batch = newFundingBatch(len(chanIDs), 253, 1)
+	fundingTx := wire.NewMsgTx(1)
+	if !batch.addChannel(chanIDs[0], &reservationWithCtx{}) {
+		t.Fatalf("unable to add channel to batch")
+	}
+	if !batch.setFundingTx(fundingTx) {
+		t.Fatalf("unable to set funding tx")
+	}
+
+	channels, tx, ok := batch.fail()
+	if !ok {
+		t.Fatalf("unable to fail batch")
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected 1 channel, got %v", len(channels))
+	}
+	if tx != fundingTx {
+		t.Fatalf("funding tx not returned for failed batch")
+	}
+
+	if !batch.hasFailed() {
+		t.Fatalf("batch not marked as failed")
+	}
+	if _, _, ok := batch.fail(); ok {
+		t.Fatalf("batch failed twice")
+	}
+	if batch.addChannel(chanIDs[1], &reservationWithCtx{}) {
+		t.Fatalf("channel added to failed batch")
+	}
+	if batch.setFundingTx(fundingTx) {
+		t.Fatalf("funding tx set for failed batch")
+	}
+}
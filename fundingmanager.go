@@ -109,6 +109,14 @@ type reservationWithCtx struct {
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
+	// batch is the funding batch the reservation is part of, or nil if the
+	// channel is funded by a transaction of its own.
+	batch *fundingBatch
+
+	// psbt tracks the funding flow of a channel whose funding transaction
+	// is assembled by an external wallet, or is nil otherwise.
+	psbt *psbtFunding
+
 	updates chan *lnrpc.OpenStatusUpdate
 	err     chan error
 }
@@ -332,6 +340,17 @@ type fundingConfig struct {
 	// flood us with very small channels that would never really be usable
 	// due to fees.
 	MinChanSize btcutil.Amount
+
+	// NotifyPendingOpenChannelEvent informs the ChannelNotifier when
+	// channels enter a pending state, once their funding transaction is
+	// fully signed.
+	NotifyPendingOpenChannelEvent func(wire.OutPoint,
+		*channeldb.OpenChannel)
+
+	// NotifyOpenChannelEvent informs the ChannelNotifier when channels
+	// transition from pending open to open, once their funding
+	// transaction has confirmed.
+	NotifyOpenChannelEvent func(*channeldb.OpenChannel)
 }
 
 // fundingManager acts as an orchestrator/bridge between the wallet's
@@ -783,13 +802,13 @@ func (f *fundingManager) CancelPeerReservations(nodePub [33]byte) {
 	fndgLog.Debugf("Cancelling all reservations for peer %x", nodePub[:])
 
 	f.resMtx.Lock()
-	defer f.resMtx.Unlock()
 
 	// We'll attempt to look up this node in the set of active
 	// reservations.  If they don't have any, then there's no further work
 	// to be done.
 	nodeReservations, ok := f.activeReservations[nodePub]
 	if !ok {
+		f.resMtx.Unlock()
 		fndgLog.Debugf("No active reservations for node: %x", nodePub[:])
 		return
 	}
@@ -797,6 +816,7 @@ func (f *fundingManager) CancelPeerReservations(nodePub [33]byte) {
 	// If they do have any active reservations, then we'll cancel all of
 	// them (which releases any locked UTXO's), and also delete it from the
 	// reservation map.
+	var failedBatches []*fundingBatch
 	for pendingID, resCtx := range nodeReservations {
 		if err := resCtx.reservation.Cancel(); err != nil {
 			fndgLog.Errorf("unable to cancel reservation for "+
@@ -805,10 +825,24 @@ func (f *fundingManager) CancelPeerReservations(nodePub [33]byte) {
 
 		resCtx.err <- fmt.Errorf("peer disconnected")
 		delete(nodeReservations, pendingID)
+
+		if resCtx.batch != nil {
+			failedBatches = append(failedBatches, resCtx.batch)
+		}
 	}
 
 	// Finally, we'll delete the node itself from the set of reservations.
 	delete(f.activeReservations, nodePub)
+	f.resMtx.Unlock()
+
+	// The funding batches of any cancelled reservations can't complete
+	// anymore, so we'll fail the remaining channels of those as well.
+	// This must happen without holding the reservation mutex, as failing
+	// a batch cancels the reservations of other peers.
+	for _, batch := range failedBatches {
+		f.failBatch(batch, fmt.Errorf("peer %x disconnected",
+			nodePub[:]))
+	}
 }
 
 // failFundingFlow will fail the active funding flow with the target peer,
@@ -863,6 +897,12 @@ func (f *fundingManager) failFundingFlow(peer lnpeer.Peer, tempChanID [32]byte,
 	if err := peer.SendMessage(false, errMsg); err != nil {
 		fndgLog.Errorf("unable to send error message to peer %v", err)
 	}
+
+	// If the channel was part of a funding batch, the whole batch has
+	// failed, as its funding transaction can no longer be broadcast.
+	if ctx != nil {
+		f.failBatch(ctx.batch, fundingErr)
+	}
 }
 
 // reservationCoordinator is the primary goroutine tasked with progressing the
@@ -904,6 +944,8 @@ func (f *fundingManager) reservationCoordinator() {
 			switch msg := req.(type) {
 			case *pendingChansReq:
 				f.handlePendingChannels(msg)
+			case *psbtStepReq:
+				f.handlePsbtStep(msg)
 			}
 		case <-f.quit:
 			return
@@ -1278,6 +1320,23 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 			},
 		},
 	}
+
+	// The channels of a funding batch share a single funding transaction,
+	// which can only be created once every peer of the batch has accepted
+	// its channel.
+	if resCtx.batch != nil {
+		f.handleBatchFundingAccept(resCtx, pendingChanID, remoteContribution)
+		return
+	}
+
+	// The funding output of a channel funded through a PSBT is handed to
+	// the caller, who'll continue the funding flow once the external
+	// wallet has funded it.
+	if resCtx.psbt != nil {
+		f.handlePsbtFundingAccept(resCtx, pendingChanID, remoteContribution)
+		return
+	}
+
 	err = resCtx.reservation.ProcessContribution(remoteContribution)
 	if err != nil {
 		fndgLog.Errorf("Unable to process contribution from %v: %v",
@@ -1291,6 +1350,18 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
 		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
 
+	if err := f.sendFundingCreated(resCtx, pendingChanID); err != nil {
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+	}
+}
+
+// sendFundingCreated sends the funding outpoint of the reservation along with
+// our signature for the remote party's version of the commitment transaction
+// to the peer. The contribution of the remote party MUST already have been
+// processed.
+func (f *fundingManager) sendFundingCreated(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) error {
+
 	// Now that we have their contribution, we can extract, then send over
 	// both the funding out point and our signature for their version of
 	// the commitment transaction to the remote peer.
@@ -1318,21 +1389,22 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	fndgLog.Infof("Generated ChannelPoint(%v) for pendingID(%x)", outPoint,
 		pendingChanID[:])
 
+	commitSig, err := lnwire.NewSigFromRawSignature(sig)
+	if err != nil {
+		fndgLog.Errorf("Unable to parse signature: %v", err)
+		return err
+	}
 	fundingCreated := &lnwire.FundingCreated{
 		PendingChannelID: pendingChanID,
 		FundingPoint:     *outPoint,
+		CommitSig:        commitSig,
 	}
-	fundingCreated.CommitSig, err = lnwire.NewSigFromRawSignature(sig)
-	if err != nil {
-		fndgLog.Errorf("Unable to parse signature: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
-		return
-	}
-	if err := fmsg.peer.SendMessage(false, fundingCreated); err != nil {
+	if err := resCtx.peer.SendMessage(false, fundingCreated); err != nil {
 		fndgLog.Errorf("Unable to send funding complete message: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
-		return
+		return err
 	}
+
+	return nil
 }
 
 // processFundingCreated queues a funding complete message coupled with the
@@ -1456,6 +1528,10 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 			"arbitration: %v", fundingOut, err)
 	}
 
+	// Inform the ChannelNotifier that the channel has entered pending
+	// open state.
+	f.cfg.NotifyPendingOpenChannelEvent(fundingOut, completeChan)
+
 	// Create an entry in the local discovery map so we can ensure that we
 	// process the channel confirmation fully before we receive a funding
 	// locked message.
@@ -1580,6 +1656,22 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	// transaction. We'll verify the signature for validity, then commit
 	// the state to disk as we can now open the channel.
 	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
+
+	// The channels of a funding batch are only committed to disk once all
+	// peers of the batch have signed, as the shared funding transaction
+	// can't be broadcast before that.
+	if resCtx.batch != nil {
+		f.handleBatchFundingSigned(resCtx, pendingChanID, commitSig)
+		return
+	}
+
+	// Likewise, a channel funded through a PSBT can only be committed to
+	// disk once the external wallet has signed the funding transaction.
+	if resCtx.psbt != nil {
+		f.handlePsbtFundingSigned(resCtx, pendingChanID, commitSig)
+		return
+	}
+
 	completeChan, err := resCtx.reservation.CompleteReservation(
 		nil, commitSig,
 	)
@@ -1611,6 +1703,19 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		// delete from the DB?
 	}
 
+	f.watchPendingChannel(resCtx, pendingChanID, completeChan)
+}
+
+// watchPendingChannel hands a channel whose funding transaction has just been
+// broadcast over to the ChainArbitrator, notifies the caller that the channel
+// is pending, and then waits for the funding transaction to confirm in order
+// to finish the funding flow.
+func (f *fundingManager) watchPendingChannel(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, completeChan *channeldb.OpenChannel) {
+
+	peerKey := resCtx.peer.IdentityKey()
+	fundingPoint := &completeChan.FundingOutpoint
+
 	// Now that we have a finalized reservation for this funding flow,
 	// we'll send the to be active channel to the ChainArbitrator so it can
 	// watch for any on-chin actions before the channel has fully
@@ -1620,6 +1725,10 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 			"arbitration: %v", fundingPoint, err)
 	}
 
+	// Inform the ChannelNotifier that the channel has entered pending
+	// open state.
+	f.cfg.NotifyPendingOpenChannelEvent(*fundingPoint, completeChan)
+
 	fndgLog.Infof("Finalizing pendingID(%x) over ChannelPoint(%v), "+
 		"waiting for channel open on-chain", pendingChanID[:],
 		fundingPoint)
@@ -1690,7 +1799,7 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		defer lnChannel.Stop()
 
 		err = f.sendFundingLocked(
-			fmsg.peer, completeChan, lnChannel, shortChanID,
+			resCtx.peer, completeChan, lnChannel, shortChanID,
 		)
 		if err != nil {
 			fndgLog.Errorf("failed sending fundingLocked: %v", err)
@@ -1917,6 +2026,10 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 		return
 	}
 
+	// Inform the ChannelNotifier that the channel has transitioned from
+	// pending open to open.
+	f.cfg.NotifyOpenChannelEvent(completeChan)
+
 	// TODO(roasbeef): ideally persistent state update for chan above
 	// should be abstracted
 
@@ -2660,6 +2773,25 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		remoteCsvDelay = msg.remoteCsvDelay
 	)
 
+	// If this channel is part of a funding batch that has already failed,
+	// there's no point in starting its funding flow.
+	if msg.batch != nil && msg.batch.hasFailed() {
+		msg.err <- errFundingBatchFailed
+		return
+	}
+
+	// A channel funded through a PSBT is tracked by the pending channel
+	// ID chosen by the caller, which must not collide with the one of any
+	// other pending channel.
+	if msg.psbtShim != nil {
+		_, err := f.findReservationCtx(msg.psbtShim.pendingChanID)
+		if err == nil {
+			msg.err <- fmt.Errorf("pending channel ID %x already "+
+				"in use", msg.psbtShim.pendingChanID[:])
+			return
+		}
+	}
+
 	// We'll determine our dust limit depending on which chain is active.
 	var ourDustLimit btcutil.Amount
 	switch registeredChains.PrimaryChain() {
@@ -2681,6 +2813,7 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	commitFeePerKw, err := f.cfg.FeeEstimator.EstimateFeePerKW(3)
 	if err != nil {
 		msg.err <- err
+		f.failBatch(msg.batch, err)
 		return
 	}
 
@@ -2706,17 +2839,23 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		PushMSat:        msg.pushAmt,
 		Flags:           channelFlags,
 		MinConfs:        msg.minConfs,
+		ExternalFunding: msg.batch != nil || msg.psbtShim != nil,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
 	if err != nil {
 		msg.err <- err
+		f.failBatch(msg.batch, err)
 		return
 	}
 
 	// Obtain a new pending channel ID which is used to track this
-	// reservation throughout its lifetime.
+	// reservation throughout its lifetime, unless the caller has chosen
+	// one to continue the funding flow of a PSBT funded channel with.
 	chanID := f.nextPendingChanID()
+	if msg.psbtShim != nil {
+		chanID = msg.psbtShim.pendingChanID
+	}
 
 	fndgLog.Infof("Target commit tx sat/kw for pendingID(%x): %v", chanID,
 		int64(commitFeePerKw))
@@ -2748,12 +2887,28 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		remoteMinHtlc:  minHtlc,
 		reservation:    reservation,
 		peer:           msg.peer,
+		batch:          msg.batch,
 		updates:        msg.updates,
 		err:            msg.err,
 	}
+	if msg.psbtShim != nil {
+		resCtx.psbt = &psbtFunding{shim: msg.psbtShim}
+	}
 	f.activeReservations[peerIDKey][chanID] = resCtx
 	f.resMtx.Unlock()
 
+	// Register the reservation with its funding batch, so the funding
+	// flow can be continued once all channels of the batch have
+	// progressed far enough.
+	if msg.batch != nil && !msg.batch.addChannel(chanID, resCtx) {
+		if _, err := f.cancelReservationCtx(peerKey, chanID); err != nil {
+			fndgLog.Errorf("unable to cancel reservation: %v", err)
+		}
+
+		msg.err <- errFundingBatchFailed
+		return
+	}
+
 	// Update the timestamp once the initFundingMsg has been handled.
 	defer resCtx.updateTimestamp()
 
@@ -2803,6 +2958,7 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		}
 
 		msg.err <- e
+		f.failBatch(msg.batch, e)
 		return
 	}
 }
@@ -2886,6 +3042,8 @@ func (f *fundingManager) handleErrorMsg(fmsg *fundingErrorMsg) {
 		)
 	}
 	resCtx.err <- err
+
+	f.failBatch(resCtx.batch, err)
 }
 
 // pruneZombieReservations loops through all pending reservations and fails the
@@ -3000,6 +3158,24 @@ func (f *fundingManager) getReservationCtx(peerKey *btcec.PublicKey,
 	return resCtx, nil
 }
 
+// findReservationCtx returns the reservation context of the pending channel
+// with the given ID, regardless of the peer it is opened with.
+func (f *fundingManager) findReservationCtx(
+	pendingChanID [32]byte) (*reservationWithCtx, error) {
+
+	f.resMtx.RLock()
+	defer f.resMtx.RUnlock()
+
+	for _, pendingChans := range f.activeReservations {
+		if resCtx, ok := pendingChans[pendingChanID]; ok {
+			return resCtx, nil
+		}
+	}
+
+	return nil, errors.Errorf("unknown pending channel (id: %x)",
+		pendingChanID[:])
+}
+
 // IsPendingChannel returns a boolean indicating whether the channel identified
 // by the pendingChanID and given peer is pending, meaning it is in the process
 // of being funded. After the funding transaction has been confirmed, the
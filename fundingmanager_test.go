@@ -351,6 +351,10 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		},
 		ZombieSweeperInterval: 1 * time.Hour,
 		ReservationTimeout:    1 * time.Nanosecond,
+		NotifyPendingOpenChannelEvent: func(wire.OutPoint,
+			*channeldb.OpenChannel) {
+		},
+		NotifyOpenChannelEvent: func(*channeldb.OpenChannel) {},
 	})
 	if err != nil {
 		t.Fatalf("failed creating fundingManager: %v", err)
@@ -434,8 +438,10 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 			publishChan <- txn
 			return nil
 		},
-		ZombieSweeperInterval: oldCfg.ZombieSweeperInterval,
-		ReservationTimeout:    oldCfg.ReservationTimeout,
+		ZombieSweeperInterval:         oldCfg.ZombieSweeperInterval,
+		ReservationTimeout:            oldCfg.ReservationTimeout,
+		NotifyPendingOpenChannelEvent: oldCfg.NotifyPendingOpenChannelEvent,
+		NotifyOpenChannelEvent:        oldCfg.NotifyOpenChannelEvent,
 	})
 	if err != nil {
 		t.Fatalf("failed recreating aliceFundingManager: %v", err)
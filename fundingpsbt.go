@@ -0,0 +1,462 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/psbt"
+)
+
+var (
+	// errPsbtFundingCancelled is returned for a channel whose PSBT funding
+	// flow has been cancelled by the caller.
+	errPsbtFundingCancelled = errors.New("PSBT funding cancelled")
+)
+
+// psbtShim holds the parameters of a channel whose funding transaction is
+// assembled and signed by an external wallet through a PSBT.
+type psbtShim struct {
+	// pendingChanID is the pending channel ID chosen by the caller, which
+	// is used to continue the funding flow.
+	pendingChanID [32]byte
+
+	// basePsbt is an optional PSBT the funding output is added to.
+	basePsbt *psbt.Packet
+
+	// noPublish indicates that the final funding transaction shouldn't be
+	// broadcast by us, as the caller takes care of it.
+	noPublish bool
+}
+
+// psbtFunding tracks the funding flow of a channel funded through a PSBT. The
+// flow advances through the following steps, which can happen in any order
+// as long as each step's prerequisites are met:
+//  1. The remote party accepts the channel, which tells us the funding output
+//     the external wallet needs to fund.
+//  2. The caller hands over the funded but unsigned PSBT, which we verify and
+//     use to send FundingCreated to the remote party.
+//  3. The remote party signs our commitment transaction in FundingSigned.
+//  4. The caller hands over the signed funding transaction.
+//
+// Only once both 3. and 4. have happened is the channel committed to disk and
+// its funding transaction broadcast.
+//
+// NOTE: All fields are only accessed by the reservation coordinator.
+type psbtFunding struct {
+	shim *psbtShim
+
+	// theirContribution is the contribution of the remote party, which is
+	// known once they've accepted the channel.
+	theirContribution *lnwallet.ChannelContribution
+
+	// fundingOutput is the multi-sig output the external wallet needs to
+	// fund.
+	fundingOutput *wire.TxOut
+
+	// unsignedTx is the verified, yet unsigned funding transaction.
+	unsignedTx *wire.MsgTx
+
+	// prevOuts are the outputs spent by the inputs of the funding
+	// transaction, which allow us to verify its signatures.
+	prevOuts []*wire.TxOut
+
+	// commitSig is the remote party's already verified signature for our
+	// version of the commitment transaction.
+	commitSig []byte
+
+	// signedTx is the final, fully signed funding transaction.
+	signedTx *wire.MsgTx
+}
+
+// psbtStepReq is a request to advance the PSBT funding flow of a pending
+// channel. Exactly one of cancel, fundedPsbt and signedTx is set.
+type psbtStepReq struct {
+	pendingChanID [32]byte
+
+	cancel     bool
+	fundedPsbt *psbt.Packet
+	signedTx   *wire.MsgTx
+
+	err chan error
+}
+
+// CancelPsbtFunding cancels the PSBT funding flow of the pending channel with
+// the given ID. This is only possible as long as the signed funding
+// transaction hasn't been handed over yet.
+func (f *fundingManager) CancelPsbtFunding(pendingChanID [32]byte) error {
+	return f.psbtStep(&psbtStepReq{
+		pendingChanID: pendingChanID,
+		cancel:        true,
+	})
+}
+
+// VerifyPsbtFunding verifies that the passed funded PSBT pays to the funding
+// output of the pending channel with the given ID, and continues the funding
+// flow with the remote party using its unsigned transaction.
+func (f *fundingManager) VerifyPsbtFunding(pendingChanID [32]byte,
+	fundedPsbt *psbt.Packet) error {
+
+	return f.psbtStep(&psbtStepReq{
+		pendingChanID: pendingChanID,
+		fundedPsbt:    fundedPsbt,
+	})
+}
+
+// FinalizePsbtFunding hands over the signed funding transaction of the
+// pending channel with the given ID, whose txid must match the one of the
+// previously verified PSBT.
+func (f *fundingManager) FinalizePsbtFunding(pendingChanID [32]byte,
+	signedTx *wire.MsgTx) error {
+
+	return f.psbtStep(&psbtStepReq{
+		pendingChanID: pendingChanID,
+		signedTx:      signedTx,
+	})
+}
+
+// psbtStep hands the passed request to the reservation coordinator, and waits
+// for it to be processed.
+func (f *fundingManager) psbtStep(req *psbtStepReq) error {
+	req.err = make(chan error, 1)
+
+	select {
+	case f.queries <- req:
+	case <-f.quit:
+		return fmt.Errorf("funding manager shutting down")
+	}
+
+	select {
+	case err := <-req.err:
+		return err
+	case <-f.quit:
+		return fmt.Errorf("funding manager shutting down")
+	}
+}
+
+// handlePsbtStep advances the PSBT funding flow of a pending channel as
+// requested by the caller.
+func (f *fundingManager) handlePsbtStep(req *psbtStepReq) {
+	resCtx, err := f.findReservationCtx(req.pendingChanID)
+	if err != nil {
+		req.err <- err
+		return
+	}
+	if resCtx.psbt == nil {
+		req.err <- fmt.Errorf("pending channel %x isn't funded "+
+			"through a PSBT", req.pendingChanID[:])
+		return
+	}
+
+	// Update the timestamp once the step has been handled, as the
+	// external wallet is making progress.
+	defer resCtx.updateTimestamp()
+
+	switch {
+	case req.cancel:
+		req.err <- f.cancelPsbtFunding(resCtx, req.pendingChanID)
+
+	case req.fundedPsbt != nil:
+		req.err <- f.verifyPsbtFunding(
+			resCtx, req.pendingChanID, req.fundedPsbt,
+		)
+
+	case req.signedTx != nil:
+		req.err <- f.finalizePsbtFunding(
+			resCtx, req.pendingChanID, req.signedTx,
+		)
+
+	default:
+		req.err <- fmt.Errorf("no PSBT funding step specified")
+	}
+}
+
+// handlePsbtFundingAccept records the contribution of the remote party of a
+// channel funded through a PSBT, and hands the funding output the external
+// wallet needs to fund to the caller.
+func (f *fundingManager) handlePsbtFundingAccept(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, contribution *lnwallet.ChannelContribution) {
+
+	ourKey := resCtx.reservation.OurContribution().MultiSigKey
+	_, fundingOutput, err := lnwallet.GenFundingPkScript(
+		ourKey.PubKey.SerializeCompressed(),
+		contribution.MultiSigKey.PubKey.SerializeCompressed(),
+		int64(resCtx.chanAmt),
+	)
+	if err != nil {
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		fundingOutput.PkScript, &f.cfg.Wallet.Cfg.NetParams,
+	)
+	if err != nil || len(addrs) != 1 {
+		err = fmt.Errorf("unable to derive funding address: %v", err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
+	// The funding output is added to the base PSBT of the shim if one was
+	// provided, so the external wallet can fund it along with any other
+	// outputs of the caller.
+	packet := resCtx.psbt.shim.basePsbt
+	if packet == nil {
+		packet, err = psbt.New(wire.NewMsgTx(2))
+		if err != nil {
+			f.failFundingFlow(resCtx.peer, pendingChanID, err)
+			return
+		}
+	}
+	packet.AddOutput(fundingOutput)
+
+	var b bytes.Buffer
+	if err := packet.Serialize(&b); err != nil {
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
+	resCtx.psbt.theirContribution = contribution
+	resCtx.psbt.fundingOutput = fundingOutput
+
+	fndgLog.Infof("Waiting for PSBT funding of pendingID(%x) to address "+
+		"%v", pendingChanID[:], addrs[0])
+
+	upd := &lnrpc.OpenStatusUpdate{
+		Update: &lnrpc.OpenStatusUpdate_PsbtFund{
+			PsbtFund: &lnrpc.ReadyForPsbtFunding{
+				FundingAddress: addrs[0].String(),
+				FundingAmount:  fundingOutput.Value,
+				Psbt:           b.Bytes(),
+			},
+		},
+		PendingChanId: pendingChanID[:],
+	}
+
+	select {
+	case resCtx.updates <- upd:
+	case <-f.quit:
+		return
+	}
+}
+
+// verifyPsbtFunding checks that the funded PSBT pays the exact value of the
+// funding output of the channel, and that the txid of its transaction can't
+// change once it is signed. If so, the funding flow is continued by sending
+// the FundingCreated message to the remote party.
+func (f *fundingManager) verifyPsbtFunding(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, packet *psbt.Packet) error {
+
+	p := resCtx.psbt
+	switch {
+	case p.fundingOutput == nil:
+		return fmt.Errorf("remote party hasn't accepted the channel yet")
+
+	case p.unsignedTx != nil:
+		return fmt.Errorf("funding PSBT has already been verified")
+	}
+
+	tx := packet.UnsignedTx
+	found, outputIndex := lnwallet.FindScriptOutputIndex(
+		tx, p.fundingOutput.PkScript,
+	)
+	if !found {
+		return fmt.Errorf("PSBT doesn't pay to the funding output")
+	}
+	if tx.TxOut[outputIndex].Value != p.fundingOutput.Value {
+		return fmt.Errorf("funding output of PSBT has value %v, "+
+			"expected %v", tx.TxOut[outputIndex].Value,
+			p.fundingOutput.Value)
+	}
+
+	// The commitment transactions are built upon the txid of the unsigned
+	// transaction, so we require all inputs to spend segwit outputs, which
+	// are the only ones whose signatures don't alter the txid.
+	if len(tx.TxIn) == 0 {
+		return fmt.Errorf("PSBT has no inputs")
+	}
+	var totalIn, totalOut int64
+	prevOuts := make([]*wire.TxOut, len(tx.TxIn))
+	for i, pIn := range packet.Inputs {
+		if pIn.WitnessUtxo == nil {
+			return fmt.Errorf("input %d of PSBT has no witness "+
+				"UTXO, only segwit inputs are supported", i)
+		}
+
+		prevOuts[i] = pIn.WitnessUtxo
+		totalIn += pIn.WitnessUtxo.Value
+	}
+	for _, txOut := range tx.TxOut {
+		totalOut += txOut.Value
+	}
+	if totalIn < totalOut {
+		return fmt.Errorf("PSBT inputs of %v sat don't cover its "+
+			"outputs of %v sat", totalIn, totalOut)
+	}
+
+	err := resCtx.reservation.ProcessExternalContribution(
+		p.theirContribution, tx.Copy(),
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to process contribution for "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return err
+	}
+
+	p.unsignedTx = tx
+	p.prevOuts = prevOuts
+
+	fndgLog.Infof("Verified funding PSBT with txid %v for pendingID(%x)",
+		tx.TxHash(), pendingChanID[:])
+
+	if err := f.sendFundingCreated(resCtx, pendingChanID); err != nil {
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return err
+	}
+
+	return nil
+}
+
+// handlePsbtFundingSigned verifies and records the commitment signature of
+// the remote party of a channel funded through a PSBT. If the signed funding
+// transaction has already been handed over, the channel is committed to disk
+// and its funding transaction broadcast.
+func (f *fundingManager) handlePsbtFundingSigned(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, commitSig []byte) {
+
+	if err := resCtx.reservation.VerifyCommitSig(commitSig); err != nil {
+		fndgLog.Errorf("Invalid commitment signature for "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+	resCtx.psbt.commitSig = commitSig
+
+	if resCtx.psbt.signedTx == nil {
+		fndgLog.Debugf("Waiting for signed funding tx of "+
+			"pendingID(%x)", pendingChanID[:])
+		return
+	}
+
+	// Any error is already reported to the caller of OpenChannel.
+	_ = f.completePsbtFunding(resCtx, pendingChanID)
+}
+
+// finalizePsbtFunding verifies the signed funding transaction of a channel
+// funded through a PSBT. If the remote party has already signed our
+// commitment transaction, the channel is committed to disk and its funding
+// transaction broadcast.
+func (f *fundingManager) finalizePsbtFunding(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, signedTx *wire.MsgTx) error {
+
+	p := resCtx.psbt
+	switch {
+	case p.unsignedTx == nil:
+		return fmt.Errorf("funding PSBT hasn't been verified yet")
+
+	case p.signedTx != nil:
+		return fmt.Errorf("funding transaction has already been " +
+			"finalized")
+	}
+
+	if signedTx.TxHash() != p.unsignedTx.TxHash() {
+		return fmt.Errorf("txid of signed funding tx %v doesn't match "+
+			"the verified PSBT with txid %v", signedTx.TxHash(),
+			p.unsignedTx.TxHash())
+	}
+
+	// Make sure the funding transaction is fully signed before we commit
+	// to it, as the channel would never confirm otherwise.
+	hashCache := txscript.NewTxSigHashes(signedTx)
+	for i, prevOut := range p.prevOuts {
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, signedTx, i,
+			txscript.StandardVerifyFlags, nil, hashCache,
+			prevOut.Value,
+		)
+		if err != nil {
+			return err
+		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("invalid signature for input %d of "+
+				"funding tx: %v", i, err)
+		}
+	}
+
+	if err := resCtx.reservation.SetFinalFundingTx(signedTx); err != nil {
+		return err
+	}
+	p.signedTx = signedTx
+
+	if p.commitSig == nil {
+		fndgLog.Debugf("Waiting for remote commitment signature of "+
+			"pendingID(%x)", pendingChanID[:])
+		return nil
+	}
+
+	return f.completePsbtFunding(resCtx, pendingChanID)
+}
+
+// cancelPsbtFunding cancels the funding flow of a channel funded through a
+// PSBT, as long as its signed funding transaction hasn't been handed over.
+func (f *fundingManager) cancelPsbtFunding(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) error {
+
+	if resCtx.psbt.signedTx != nil {
+		return fmt.Errorf("funding transaction has already been " +
+			"finalized")
+	}
+
+	fndgLog.Infof("Cancelling PSBT funding of pendingID(%x)",
+		pendingChanID[:])
+
+	f.failFundingFlow(resCtx.peer, pendingChanID, errPsbtFundingCancelled)
+
+	return nil
+}
+
+// completePsbtFunding commits a channel funded through a PSBT to disk, and
+// broadcasts its funding transaction unless the caller takes care of it.
+func (f *fundingManager) completePsbtFunding(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) error {
+
+	completeChan, err := resCtx.reservation.CompleteReservation(
+		nil, resCtx.psbt.commitSig,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to complete reservation of "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return err
+	}
+
+	f.deleteReservationCtx(resCtx.peer.IdentityKey(), pendingChanID)
+
+	fundingTx := completeChan.FundingTxn
+	if resCtx.psbt.shim.noPublish {
+		fndgLog.Infof("Not broadcasting funding tx for "+
+			"ChannelPoint(%v) as requested",
+			completeChan.FundingOutpoint)
+	} else {
+		fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): "+
+			"%v", completeChan.FundingOutpoint,
+			spew.Sdump(fundingTx))
+
+		err := f.cfg.PublishTransaction(fundingTx)
+		if err != nil {
+			fndgLog.Errorf("Unable to broadcast funding tx for "+
+				"ChannelPoint(%v): %v",
+				completeChan.FundingOutpoint, err)
+		}
+	}
+
+	f.watchPendingChannel(resCtx, pendingChanID, completeChan)
+
+	return nil
+}
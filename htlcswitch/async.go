@@ -0,0 +1,133 @@
+package htlcswitch
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultAsyncHoldCltvMargin is the default number of blocks before
+	// the expiry of the incoming HTLC at which we'll stop holding an HTLC
+	// for an offline peer, and fail it back instead.
+	DefaultAsyncHoldCltvMargin = 40
+
+	// DefaultAsyncHoldMaxHtlcs is the default number of HTLCs we'll hold
+	// on behalf of each offline peer.
+	DefaultAsyncHoldMaxHtlcs = 20
+
+	// DefaultAsyncReleaseInterval is the default interval at which the
+	// switch checks whether peers we're holding HTLCs for have come back
+	// online.
+	DefaultAsyncReleaseInterval = 5 * time.Second
+)
+
+// asyncHoldQueue holds HTLCs destined to peers that are currently offline,
+// so that they can be forwarded once the peer comes back online. This
+// allows a node acting as the LSP of a mostly offline recipient, such as a
+// mobile wallet, to complete payments sent while the recipient wasn't
+// connected.
+//
+// NOTE: Held HTLCs are only kept in memory. If we restart while holding an
+// HTLC, its circuit will be failed back once the incoming link reforwards
+// it, as is done for any other packet lost to a restart.
+type asyncHoldQueue struct {
+	// cltvMargin is the number of blocks before the expiry of the
+	// incoming HTLC at which it must be failed back rather than held, so
+	// we never risk having to go on chain to resolve it.
+	cltvMargin uint32
+
+	// maxHtlcs is the maximum number of HTLCs held per peer.
+	maxHtlcs int
+
+	mtx  sync.Mutex
+	held map[[33]byte][]*htlcPacket
+}
+
+// newAsyncHoldQueue creates a new queue of held HTLCs.
+func newAsyncHoldQueue(cltvMargin uint32, maxHtlcs int) *asyncHoldQueue {
+	if cltvMargin == 0 {
+		cltvMargin = DefaultAsyncHoldCltvMargin
+	}
+	if maxHtlcs == 0 {
+		maxHtlcs = DefaultAsyncHoldMaxHtlcs
+	}
+
+	return &asyncHoldQueue{
+		cltvMargin: cltvMargin,
+		maxHtlcs:   maxHtlcs,
+		held:       make(map[[33]byte][]*htlcPacket),
+	}
+}
+
+// hold attempts to hold the packet until the peer comes online. False is
+// returned if the packet is too close to expiry, or the peer already has too
+// many HTLCs held on its behalf.
+func (q *asyncHoldQueue) hold(peer [33]byte, packet *htlcPacket,
+	height uint32) bool {
+
+	if packet.incomingTimeout <= height+q.cltvMargin {
+		return false
+	}
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if len(q.held[peer]) >= q.maxHtlcs {
+		return false
+	}
+	q.held[peer] = append(q.held[peer], packet)
+
+	return true
+}
+
+// peers returns the set of peers that currently have HTLCs held on their
+// behalf.
+func (q *asyncHoldQueue) peers() [][33]byte {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	peers := make([][33]byte, 0, len(q.held))
+	for peer := range q.held {
+		peers = append(peers, peer)
+	}
+
+	return peers
+}
+
+// release removes and returns all HTLCs held for the peer.
+func (q *asyncHoldQueue) release(peer [33]byte) []*htlcPacket {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	packets := q.held[peer]
+	delete(q.held, peer)
+
+	return packets
+}
+
+// expire removes and returns all held HTLCs whose incoming HTLC is within
+// the CLTV margin of expiring at the given height.
+func (q *asyncHoldQueue) expire(height uint32) []*htlcPacket {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	var expired []*htlcPacket
+	for peer, packets := range q.held {
+		var remaining []*htlcPacket
+		for _, packet := range packets {
+			if packet.incomingTimeout <= height+q.cltvMargin {
+				expired = append(expired, packet)
+				continue
+			}
+			remaining = append(remaining, packet)
+		}
+
+		if len(remaining) == 0 {
+			delete(q.held, peer)
+		} else {
+			q.held[peer] = remaining
+		}
+	}
+
+	return expired
+}
@@ -0,0 +1,75 @@
+package htlcswitch
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestAsyncHoldQueue asserts that HTLCs for offline peers are only held
+// while they're outside of the CLTV safety margin, are bounded per peer, and
+// are handed back once released or expired.
+func TestAsyncHoldQueue(t *testing.T) {
+	t.Parallel()
+
+	const (
+		height = 100
+		margin = 10
+	)
+	q := newAsyncHoldQueue(margin, 2)
+
+	var alice, bob [33]byte
+	alice[0] = 0x02
+	bob[0] = 0x03
+
+	newPacket := func(id uint64, timeout uint32) *htlcPacket {
+		return &htlcPacket{
+			incomingChanID:  lnwire.NewShortChanIDFromInt(1),
+			incomingHTLCID:  id,
+			incomingTimeout: timeout,
+		}
+	}
+
+	// An HTLC already within the safety margin shouldn't be held.
+	if q.hold(alice, newPacket(0, height+margin), height) {
+		t.Fatalf("htlc within cltv margin held")
+	}
+
+	// We should be able to hold up to the maximum number of HTLCs per
+	// peer.
+	if !q.hold(alice, newPacket(1, height+margin+1), height) {
+		t.Fatalf("unable to hold htlc")
+	}
+	if !q.hold(alice, newPacket(2, height+margin+50), height) {
+		t.Fatalf("unable to hold htlc")
+	}
+	if q.hold(alice, newPacket(3, height+margin+50), height) {
+		t.Fatalf("htlc held beyond per peer limit")
+	}
+	if !q.hold(bob, newPacket(4, height+margin+50), height) {
+		t.Fatalf("unable to hold htlc")
+	}
+	if len(q.peers()) != 2 {
+		t.Fatalf("expected 2 peers, got %d", len(q.peers()))
+	}
+
+	// Once the next block arrives, the first of Alice's HTLCs should
+	// enter the margin and be expired.
+	expired := q.expire(height + 1)
+	if len(expired) != 1 || expired[0].incomingHTLCID != 1 {
+		t.Fatalf("expected htlc 1 to expire, got %v", expired)
+	}
+
+	// Releasing Alice's HTLCs should only return those still held for
+	// her.
+	released := q.release(alice)
+	if len(released) != 1 || released[0].incomingHTLCID != 2 {
+		t.Fatalf("expected htlc 2 to be released, got %v", released)
+	}
+	if len(q.release(alice)) != 0 {
+		t.Fatalf("htlcs released twice")
+	}
+	if len(q.peers()) != 1 {
+		t.Fatalf("expected 1 peer, got %d", len(q.peers()))
+	}
+}
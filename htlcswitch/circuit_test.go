@@ -538,6 +538,99 @@ func TestCircuitMapPersistence(t *testing.T) {
 	assertNumCircuitsWithHash(t, circuitMap, hash3, 0)
 }
 
+// TestCircuitMapSharedPaymentHash checks that circuits forwarding HTLCs with
+// the same payment hash are tracked independently, such that removing one of
+// them leaves the other intact.
+func TestCircuitMapSharedPaymentHash(t *testing.T) {
+	t.Parallel()
+
+	var (
+		chan1 = lnwire.NewShortChanIDFromInt(1)
+		chan2 = lnwire.NewShortChanIDFromInt(2)
+		chan3 = lnwire.NewShortChanIDFromInt(3)
+	)
+
+	cfg, circuitMap := newCircuitMap(t)
+
+	// Two HTLCs paying the same hash arrive over different channels, and
+	// are forwarded over the same outgoing channel.
+	circuit1 := &htlcswitch.PaymentCircuit{
+		Incoming: htlcswitch.CircuitKey{
+			ChanID: chan1,
+			HtlcID: 0,
+		},
+		PaymentHash:    hash1,
+		ErrorEncrypter: testExtracter,
+	}
+	circuit2 := &htlcswitch.PaymentCircuit{
+		Incoming: htlcswitch.CircuitKey{
+			ChanID: chan2,
+			HtlcID: 0,
+		},
+		PaymentHash:    hash1,
+		ErrorEncrypter: testExtracter,
+	}
+
+	actions, err := circuitMap.CommitCircuits(circuit1, circuit2)
+	if err != nil {
+		t.Fatalf("failed to commit circuits: %v", err)
+	}
+	if len(actions.Adds) != 2 {
+		t.Fatalf("expected both circuits to be added, got %d",
+			len(actions.Adds))
+	}
+
+	keystone1 := htlcswitch.Keystone{
+		InKey: circuit1.Incoming,
+		OutKey: htlcswitch.CircuitKey{
+			ChanID: chan3,
+			HtlcID: 0,
+		},
+	}
+	keystone2 := htlcswitch.Keystone{
+		InKey: circuit2.Incoming,
+		OutKey: htlcswitch.CircuitKey{
+			ChanID: chan3,
+			HtlcID: 1,
+		},
+	}
+	circuit1.Outgoing = &keystone1.OutKey
+	circuit2.Outgoing = &keystone2.OutKey
+
+	if err := circuitMap.OpenCircuits(keystone1, keystone2); err != nil {
+		t.Fatalf("failed to open circuits: %v", err)
+	}
+
+	assertNumCircuitsWithHash(t, circuitMap, hash1, 2)
+	assertHasKeystone(t, circuitMap, keystone1.OutKey, circuit1)
+	assertHasKeystone(t, circuitMap, keystone2.OutKey, circuit2)
+
+	// Settling the first HTLC should only close and remove its own
+	// circuit.
+	if _, err := circuitMap.CloseCircuit(keystone1.OutKey); err != nil {
+		t.Fatalf("unable to close circuit: %v", err)
+	}
+	if err := circuitMap.DeleteCircuits(circuit1.Incoming); err != nil {
+		t.Fatalf("unable to delete circuit: %v", err)
+	}
+
+	assertDoesNotHaveCircuit(t, circuitMap, circuit1)
+	assertDoesNotHaveKeystone(t, circuitMap, keystone1.OutKey)
+
+	assertNumCircuitsWithHash(t, circuitMap, hash1, 1)
+	assertHasCircuitForHash(t, circuitMap, hash1, circuit2)
+	assertHasCircuit(t, circuitMap, circuit2)
+	assertHasKeystone(t, circuitMap, keystone2.OutKey, circuit2)
+
+	// The remaining circuit should survive a restart.
+	_, circuitMap = restartCircuitMap(t, cfg)
+
+	assertDoesNotHaveCircuit(t, circuitMap, circuit1)
+	assertNumCircuitsWithHash(t, circuitMap, hash1, 1)
+	assertHasCircuit(t, circuitMap, circuit2)
+	assertHasKeystone(t, circuitMap, keystone2.OutKey, circuit2)
+}
+
 // assertHasKeystone tests that the circuit map contains the provided payment
 // circuit.
 func assertHasKeystone(t *testing.T, cm htlcswitch.CircuitMap,
@@ -0,0 +1,121 @@
+package htlcswitch
+
+import (
+	"runtime"
+	"sync"
+)
+
+// defaultNumDecodeWorkers is the default number of goroutines the onion
+// processor will use to decode onion packets in parallel.
+var defaultNumDecodeWorkers = runtime.NumCPU()
+
+// decodePool is a bounded pool of worker goroutines used to spread the CPU
+// heavy parts of onion processing across multiple cores. Jobs submitted while
+// the pool isn't running are executed inline by the caller, so that users of
+// the onion processor that never start it keep working as before.
+type decodePool struct {
+	numWorkers int
+
+	jobs chan func()
+
+	mu      sync.RWMutex
+	running bool
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// newDecodePool creates a new decode pool backed by numWorkers goroutines.
+func newDecodePool(numWorkers int) *decodePool {
+	if numWorkers < 1 {
+		numWorkers = 1
+	}
+
+	return &decodePool{
+		numWorkers: numWorkers,
+		jobs:       make(chan func()),
+		quit:       make(chan struct{}),
+	}
+}
+
+// start spins up the pool's worker goroutines.
+func (p *decodePool) start() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.running {
+		return
+	}
+	p.running = true
+
+	for i := 0; i < p.numWorkers; i++ {
+		p.wg.Add(1)
+		go p.worker()
+	}
+}
+
+// stop signals all workers to exit and waits for them to do so.
+func (p *decodePool) stop() {
+	p.mu.Lock()
+	if !p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = false
+	close(p.quit)
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
+
+// worker executes jobs until the pool is stopped.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *decodePool) worker() {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case job := <-p.jobs:
+			job()
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// run executes f for every index in [0, n) and blocks until all invocations
+// have returned. Invocations are spread across the pool's workers, with any
+// work that can't be handed off executed by the calling goroutine.
+func (p *decodePool) run(n int, f func(int)) {
+	p.mu.RLock()
+	running := p.running
+	p.mu.RUnlock()
+
+	// There is nothing to gain from dispatching a single job, nor can we
+	// dispatch anything if the workers aren't running.
+	if !running || n < 2 {
+		for i := 0; i < n; i++ {
+			f(i)
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		i := i
+		job := func() {
+			defer wg.Done()
+			f(i)
+		}
+
+		select {
+		case p.jobs <- job:
+		case <-p.quit:
+			job()
+		}
+	}
+
+	wg.Wait()
+}
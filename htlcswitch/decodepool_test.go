@@ -0,0 +1,74 @@
+package htlcswitch
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestDecodePoolRun asserts that the decode pool executes every job exactly
+// once, both when running and when executing inline.
+func TestDecodePoolRun(t *testing.T) {
+	t.Parallel()
+
+	const numJobs = 100
+
+	pool := newDecodePool(4)
+
+	runJobs := func() {
+		var counts [numJobs]int32
+		pool.run(numJobs, func(i int) {
+			atomic.AddInt32(&counts[i], 1)
+		})
+
+		for i, count := range counts {
+			if count != 1 {
+				t.Fatalf("job %d executed %d times", i, count)
+			}
+		}
+	}
+
+	// Before the pool is started, all jobs should be executed inline.
+	runJobs()
+
+	pool.start()
+	runJobs()
+	pool.stop()
+
+	// Once stopped, the pool should fall back to executing inline again.
+	runJobs()
+}
+
+// TestDecodePoolBounded asserts that no more than the configured number of
+// workers execute jobs at the same time.
+func TestDecodePoolBounded(t *testing.T) {
+	t.Parallel()
+
+	const numWorkers = 2
+
+	pool := newDecodePool(numWorkers)
+	pool.start()
+	defer pool.stop()
+
+	var active, maxActive int32
+	pool.run(20, func(int) {
+		n := atomic.AddInt32(&active, 1)
+		for {
+			max := atomic.LoadInt32(&maxActive)
+			if n <= max {
+				break
+			}
+			if atomic.CompareAndSwapInt32(&maxActive, max, n) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+	})
+
+	if maxActive > numWorkers {
+		t.Fatalf("expected at most %d concurrent jobs, got %d",
+			numWorkers, maxActive)
+	}
+}
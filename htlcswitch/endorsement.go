@@ -0,0 +1,415 @@
+package htlcswitch
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// reputationHalfLife is the period over which the weight of a past
+	// forwarding outcome is halved when computing a peer's reputation.
+	reputationHalfLife = 7 * 24 * time.Hour
+
+	// minReputationSettles is the (decayed) number of successfully
+	// settled forwards a peer must have before it's considered to have a
+	// high reputation.
+	minReputationSettles = 10
+
+	// maxReputationFailRatio is the maximum ratio of failed to settled
+	// forwards a peer may have while still being considered to have a
+	// high reputation.
+	maxReputationFailRatio = 0.25
+
+	// peerReputationSize is the size of a serialized peerReputation: the
+	// settled and failed counters, followed by the unix nano timestamp of
+	// the last update.
+	peerReputationSize = 24
+)
+
+var (
+	// peerReputationBucket is the top-level bucket that stores the
+	// reputation of each peer, keyed by its compressed public key.
+	peerReputationBucket = []byte("peer-reputation")
+
+	// ErrCorruptedReputation signals that a stored peer reputation
+	// couldn't be decoded.
+	ErrCorruptedReputation = errors.New("peer reputation has been " +
+		"corrupted")
+)
+
+// peerReputation tracks the forwarding outcomes of HTLCs that a peer has
+// sent us. Both counters decay exponentially over time, so that the
+// reputation reflects the peer's recent behavior.
+type peerReputation struct {
+	settled    float64
+	failed     float64
+	lastUpdate time.Time
+}
+
+// decay applies the exponential decay accrued since the last update.
+func (p *peerReputation) decay(now time.Time) {
+	elapsed := now.Sub(p.lastUpdate)
+	if elapsed <= 0 {
+		return
+	}
+
+	factor := math.Pow(0.5, float64(elapsed)/float64(reputationHalfLife))
+	p.settled *= factor
+	p.failed *= factor
+	p.lastUpdate = now
+}
+
+// isHigh returns true if the peer has accrued a high reputation.
+func (p *peerReputation) isHigh() bool {
+	return p.settled >= minReputationSettles &&
+		p.failed <= p.settled*maxReputationFailRatio
+}
+
+// encode serializes the reputation into its on-disk format.
+func (p *peerReputation) encode() []byte {
+	var b [peerReputationSize]byte
+	binary.BigEndian.PutUint64(b[:8], math.Float64bits(p.settled))
+	binary.BigEndian.PutUint64(b[8:16], math.Float64bits(p.failed))
+	binary.BigEndian.PutUint64(b[16:], uint64(p.lastUpdate.UnixNano()))
+
+	return b[:]
+}
+
+// decodePeerReputation deserializes a reputation from its on-disk format.
+func decodePeerReputation(b []byte) (*peerReputation, error) {
+	if len(b) != peerReputationSize {
+		return nil, ErrCorruptedReputation
+	}
+
+	return &peerReputation{
+		settled: math.Float64frombits(binary.BigEndian.Uint64(b[:8])),
+		failed:  math.Float64frombits(binary.BigEndian.Uint64(b[8:16])),
+		lastUpdate: time.Unix(
+			0, int64(binary.BigEndian.Uint64(b[16:])),
+		),
+	}, nil
+}
+
+// htlcOutcome describes how a forwarded HTLC was resolved, as far as the
+// reputation of the peer that sent it is concerned.
+type htlcOutcome uint8
+
+const (
+	// htlcSettled indicates that the HTLC was settled, which is credited
+	// to the peer that sent it.
+	htlcSettled htlcOutcome = iota
+
+	// htlcFailed indicates that the HTLC was failed back from downstream.
+	// The peer that sent it chose to route it through us, so the failure
+	// counts against its reputation.
+	htlcFailed
+
+	// htlcFailedLocally indicates that we failed the HTLC ourselves after
+	// it was handed to the outgoing link, e.g. because the link could no
+	// longer carry it, or because it was resolved on-chain. These
+	// failures aren't the fault of the peer that sent it, so they leave
+	// its reputation untouched.
+	htlcFailedLocally
+)
+
+// linkUsage tracks the resources of an outgoing link occupied by unendorsed
+// HTLCs.
+type linkUsage struct {
+	numHtlcs int
+	amount   lnwire.MilliSatoshi
+}
+
+// inflightHtlc is an HTLC that has been forwarded through the switch, but
+// not yet resolved.
+type inflightHtlc struct {
+	incomingPeer [33]byte
+	outgoing     lnwire.ShortChannelID
+	amount       lnwire.MilliSatoshi
+	endorsed     bool
+}
+
+// endorsementManager implements HTLC endorsement as a jamming mitigation.
+// It tracks the reputation of each peer based on the outcomes of the HTLCs
+// they forward to us, only propagates their endorsement downstream if
+// they've earned a high reputation, and reserves a portion of each outgoing
+// link's slots and liquidity for endorsed HTLCs. The size of the reserve is
+// derived from the flow constraints the remote party of the link imposes on
+// our HTLCs, as well as the link's available balance.
+//
+// The reputation of each peer is persisted, so that it survives restarts.
+// Updates are buffered in memory and written out by flush, which the switch
+// calls alongside its flush of the forwarding log.
+//
+// NOTE: The set of in-flight HTLCs is only tracked in memory, so any HTLCs
+// still in flight across a restart won't count towards a link's usage.
+type endorsementManager struct {
+	db *channeldb.DB
+
+	// slotReserve is the fraction of each link's HTLC slots, as bounded
+	// by the max_accepted_htlcs of its remote party, that may only be
+	// used by endorsed HTLCs.
+	slotReserve float64
+
+	// liquidityReserve is the fraction of each link's available
+	// liquidity and in-flight allowance, as bounded by the
+	// max_htlc_value_in_flight of its remote party, that may only be used
+	// by endorsed HTLCs.
+	liquidityReserve float64
+
+	// now returns the current time, and is overridden within tests.
+	now func() time.Time
+
+	mtx        sync.Mutex
+	reputation map[[33]byte]*peerReputation
+	dirty      map[[33]byte]struct{}
+	usage      map[lnwire.ShortChannelID]*linkUsage
+	inflight   map[CircuitKey]inflightHtlc
+}
+
+// newEndorsementManager creates a new endorsement manager that reserves the
+// given fractions of each link's slots and liquidity for endorsed HTLCs. The
+// reputation of each peer is loaded from, and persisted to, the passed
+// database.
+func newEndorsementManager(db *channeldb.DB, slotReserve,
+	liquidityReserve float64) (*endorsementManager, error) {
+
+	e := &endorsementManager{
+		db:               db,
+		slotReserve:      clampFraction(slotReserve),
+		liquidityReserve: clampFraction(liquidityReserve),
+		now:              time.Now,
+		reputation:       make(map[[33]byte]*peerReputation),
+		dirty:            make(map[[33]byte]struct{}),
+		usage:            make(map[lnwire.ShortChannelID]*linkUsage),
+		inflight:         make(map[CircuitKey]inflightHtlc),
+	}
+
+	if err := e.initDB(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// initDB creates the reputation bucket if it doesn't exist yet, and loads the
+// reputation of all peers stored within it.
+func (e *endorsementManager) initDB() error {
+	return e.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(peerReputationBucket)
+		if err != nil {
+			return err
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(k) != 33 {
+				return ErrCorruptedReputation
+			}
+
+			rep, err := decodePeerReputation(v)
+			if err != nil {
+				return err
+			}
+
+			var peer [33]byte
+			copy(peer[:], k)
+			e.reputation[peer] = rep
+
+			return nil
+		})
+	})
+}
+
+// clampFraction bounds the passed value to the range [0, 1].
+func clampFraction(f float64) float64 {
+	switch {
+	case f < 0:
+		return 0
+	case f > 1:
+		return 1
+	default:
+		return f
+	}
+}
+
+// outgoingEndorsement returns whether an HTLC received from the given peer
+// with the given endorsement signal should be endorsed when forwarded. We
+// only vouch for the HTLCs of peers that have earned a high reputation.
+func (e *endorsementManager) outgoingEndorsement(peer [33]byte,
+	incomingEndorsed bool) bool {
+
+	if !incomingEndorsed {
+		return false
+	}
+
+	return e.isHighReputation(peer)
+}
+
+// isHighReputation returns true if the given peer has accrued a high
+// reputation.
+func (e *endorsementManager) isHighReputation(peer [33]byte) bool {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	rep, ok := e.reputation[peer]
+	if !ok {
+		return false
+	}
+	rep.decay(e.now())
+
+	return rep.isHigh()
+}
+
+// admit returns whether an HTLC of the given amount and endorsement may be
+// forwarded over the outgoing link with the given flow constraints and
+// available bandwidth. Endorsed HTLCs may use all of the link's resources,
+// while unendorsed HTLCs are confined to the unreserved portion.
+func (e *endorsementManager) admit(outgoing lnwire.ShortChannelID,
+	constraints lnwallet.FlowConstraints, bandwidth,
+	amt lnwire.MilliSatoshi, endorsed bool) bool {
+
+	if endorsed {
+		return true
+	}
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	var used linkUsage
+	if usage, ok := e.usage[outgoing]; ok {
+		used = *usage
+	}
+
+	// The remote party limits the number of HTLCs we may have in flight,
+	// a portion of which is reserved for endorsed HTLCs.
+	maxSlots := int(
+		float64(constraints.MaxAcceptedHtlcs) * (1 - e.slotReserve),
+	)
+	if used.numHtlcs+1 > maxSlots {
+		return false
+	}
+
+	// It also limits the total value of the HTLCs we may have in flight,
+	// which is reserved in the same manner.
+	maxInFlight := lnwire.MilliSatoshi(
+		float64(constraints.MaxPendingAmount) * (1 - e.liquidityReserve),
+	)
+	if used.amount+amt > maxInFlight {
+		return false
+	}
+
+	// The bandwidth reported by the link already excludes the liquidity
+	// occupied by in-flight HTLCs, so we add back our own usage to arrive
+	// at the total liquidity the unendorsed bucket is drawn from.
+	total := float64(bandwidth + used.amount)
+	maxAmt := lnwire.MilliSatoshi(total * (1 - e.liquidityReserve))
+
+	return used.amount+amt <= maxAmt
+}
+
+// forwarded records that an HTLC identified by its incoming circuit key has
+// been forwarded over the outgoing link.
+func (e *endorsementManager) forwarded(inKey CircuitKey, htlc inflightHtlc) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if _, ok := e.inflight[inKey]; ok {
+		return
+	}
+	e.inflight[inKey] = htlc
+
+	if htlc.endorsed {
+		return
+	}
+
+	usage, ok := e.usage[htlc.outgoing]
+	if !ok {
+		usage = &linkUsage{}
+		e.usage[htlc.outgoing] = usage
+	}
+	usage.numHtlcs++
+	usage.amount += htlc.amount
+}
+
+// resolved records the outcome of a previously forwarded HTLC, releasing the
+// resources it occupied and updating the reputation of the peer that sent
+// it.
+func (e *endorsementManager) resolved(inKey CircuitKey, outcome htlcOutcome) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	htlc, ok := e.inflight[inKey]
+	if !ok {
+		return
+	}
+	delete(e.inflight, inKey)
+
+	if !htlc.endorsed {
+		usage := e.usage[htlc.outgoing]
+		usage.numHtlcs--
+		usage.amount -= htlc.amount
+		if usage.numHtlcs == 0 {
+			delete(e.usage, htlc.outgoing)
+		}
+	}
+
+	if outcome == htlcFailedLocally {
+		return
+	}
+
+	now := e.now()
+	rep, ok := e.reputation[htlc.incomingPeer]
+	if !ok {
+		rep = &peerReputation{lastUpdate: now}
+		e.reputation[htlc.incomingPeer] = rep
+	}
+	rep.decay(now)
+
+	if outcome == htlcSettled {
+		rep.settled++
+	} else {
+		rep.failed++
+	}
+	e.dirty[htlc.incomingPeer] = struct{}{}
+}
+
+// flush persists the reputation of every peer that has changed since the
+// last flush.
+func (e *endorsementManager) flush() error {
+	e.mtx.Lock()
+	if len(e.dirty) == 0 {
+		e.mtx.Unlock()
+		return nil
+	}
+
+	// Encode the reputations while we still hold the mutex, so that we
+	// don't block forwarding on the disk write.
+	updates := make(map[[33]byte][]byte, len(e.dirty))
+	for peer := range e.dirty {
+		updates[peer] = e.reputation[peer].encode()
+	}
+	e.dirty = make(map[[33]byte]struct{})
+	e.mtx.Unlock()
+
+	return e.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(peerReputationBucket)
+		if bucket == nil {
+			return ErrCorruptedReputation
+		}
+
+		for peer, encoded := range updates {
+			if err := bucket.Put(peer[:], encoded); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,220 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestEndorsementReputation asserts that a peer's endorsement is only
+// propagated once it has built a high reputation, and that the reputation
+// is lost after too many failures.
+func TestEndorsementReputation(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Unix(1000, 0)
+	mgr, err := newEndorsementManager(db, 0, 0)
+	if err != nil {
+		t.Fatalf("unable to create endorsement manager: %v", err)
+	}
+	mgr.now = func() time.Time {
+		return now
+	}
+
+	var peer [33]byte
+	peer[0] = 0x02
+	outgoing := lnwire.NewShortChanIDFromInt(1)
+
+	// A peer we have no history with shouldn't have its endorsement
+	// propagated.
+	if mgr.outgoingEndorsement(peer, true) {
+		t.Fatalf("endorsement propagated for unknown peer")
+	}
+
+	// After enough successful forwards, the peer's endorsement should be
+	// honored, but we should still never endorse an HTLC the peer didn't.
+	for i := 0; i < minReputationSettles; i++ {
+		key := CircuitKey{ChanID: outgoing, HtlcID: uint64(i)}
+		mgr.forwarded(key, inflightHtlc{
+			incomingPeer: peer,
+			outgoing:     outgoing,
+			amount:       1000,
+		})
+		mgr.resolved(key, htlcSettled)
+	}
+	if !mgr.outgoingEndorsement(peer, true) {
+		t.Fatalf("endorsement not propagated for reputable peer")
+	}
+	if mgr.outgoingEndorsement(peer, false) {
+		t.Fatalf("unendorsed htlc was endorsed")
+	}
+
+	// Failures that we caused ourselves shouldn't be held against the
+	// peer.
+	for i := 0; i < minReputationSettles; i++ {
+		key := CircuitKey{ChanID: outgoing, HtlcID: uint64(100 + i)}
+		mgr.forwarded(key, inflightHtlc{
+			incomingPeer: peer,
+			outgoing:     outgoing,
+			amount:       1000,
+		})
+		mgr.resolved(key, htlcFailedLocally)
+	}
+	if !mgr.outgoingEndorsement(peer, true) {
+		t.Fatalf("reputation lost due to local failures")
+	}
+
+	// A burst of failures from downstream should cost the peer its
+	// reputation.
+	for i := 0; i < minReputationSettles; i++ {
+		key := CircuitKey{ChanID: outgoing, HtlcID: uint64(200 + i)}
+		mgr.forwarded(key, inflightHtlc{
+			incomingPeer: peer,
+			outgoing:     outgoing,
+			amount:       1000,
+		})
+		mgr.resolved(key, htlcFailed)
+	}
+	if mgr.outgoingEndorsement(peer, true) {
+		t.Fatalf("endorsement propagated after failures")
+	}
+
+	// Finally, the reputation should decay away over time.
+	now = now.Add(10 * reputationHalfLife)
+	if mgr.isHighReputation(peer) {
+		t.Fatalf("reputation didn't decay")
+	}
+}
+
+// TestEndorsementReputationPersistence asserts that the reputation of a peer
+// survives a restart once it has been flushed.
+func TestEndorsementReputationPersistence(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+	defer db.Close()
+
+	mgr, err := newEndorsementManager(db, 0, 0)
+	if err != nil {
+		t.Fatalf("unable to create endorsement manager: %v", err)
+	}
+
+	var peer [33]byte
+	peer[0] = 0x02
+	outgoing := lnwire.NewShortChanIDFromInt(1)
+
+	for i := 0; i < minReputationSettles; i++ {
+		key := CircuitKey{ChanID: outgoing, HtlcID: uint64(i)}
+		mgr.forwarded(key, inflightHtlc{
+			incomingPeer: peer,
+			outgoing:     outgoing,
+			amount:       1000,
+		})
+		mgr.resolved(key, htlcSettled)
+	}
+	if err := mgr.flush(); err != nil {
+		t.Fatalf("unable to flush reputations: %v", err)
+	}
+
+	// A manager backed by the same database should pick up where the
+	// previous one left off.
+	restarted, err := newEndorsementManager(db, 0, 0)
+	if err != nil {
+		t.Fatalf("unable to create endorsement manager: %v", err)
+	}
+	if !restarted.isHighReputation(peer) {
+		t.Fatalf("reputation not restored")
+	}
+}
+
+// TestEndorsementResourceBuckets asserts that unendorsed HTLCs are confined
+// to the unreserved portion of a link's slots and liquidity, while endorsed
+// HTLCs may use all of it.
+func TestEndorsementResourceBuckets(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+	defer db.Close()
+
+	mgr, err := newEndorsementManager(db, 0.5, 0.5)
+	if err != nil {
+		t.Fatalf("unable to create endorsement manager: %v", err)
+	}
+	outgoing := lnwire.NewShortChanIDFromInt(1)
+	constraints := lnwallet.FlowConstraints{
+		MaxPendingAmount: 1000000,
+		MaxAcceptedHtlcs: 30,
+	}
+
+	// With 10,000 msat of bandwidth, only half may be used by unendorsed
+	// HTLCs.
+	if mgr.admit(outgoing, constraints, 10000, 6000, false) {
+		t.Fatalf("unendorsed htlc admitted into reserve")
+	}
+	if !mgr.admit(outgoing, constraints, 10000, 6000, true) {
+		t.Fatalf("endorsed htlc rejected")
+	}
+	if !mgr.admit(outgoing, constraints, 10000, 4000, false) {
+		t.Fatalf("unendorsed htlc rejected from free bucket")
+	}
+
+	// Once forwarded, the link's bandwidth drops, but our accounting of
+	// the unendorsed usage should still only leave 1,000 msat.
+	key := CircuitKey{ChanID: outgoing, HtlcID: 1}
+	mgr.forwarded(key, inflightHtlc{outgoing: outgoing, amount: 4000})
+
+	if mgr.admit(outgoing, constraints, 6000, 2000, false) {
+		t.Fatalf("unendorsed htlc admitted into reserve")
+	}
+	if !mgr.admit(outgoing, constraints, 6000, 1000, false) {
+		t.Fatalf("unendorsed htlc rejected from free bucket")
+	}
+
+	// Resolving the HTLC should release its resources.
+	mgr.resolved(key, htlcSettled)
+	if !mgr.admit(outgoing, constraints, 10000, 5000, false) {
+		t.Fatalf("resources not released")
+	}
+
+	// If the remote party allows less value in flight than we have
+	// available, the bucket should be sized from its limit instead.
+	limited := constraints
+	limited.MaxPendingAmount = 4000
+	if mgr.admit(outgoing, limited, 10000, 2001, false) {
+		t.Fatalf("unendorsed htlc admitted beyond in-flight limit")
+	}
+	if !mgr.admit(outgoing, limited, 10000, 2001, true) {
+		t.Fatalf("endorsed htlc rejected")
+	}
+	if !mgr.admit(outgoing, limited, 10000, 2000, false) {
+		t.Fatalf("unendorsed htlc rejected from free bucket")
+	}
+
+	// Slots should be bucketed in the same manner, according to the
+	// number of HTLCs the remote party accepts.
+	maxSlots := int(constraints.MaxAcceptedHtlcs / 2)
+	for i := 0; i < maxSlots; i++ {
+		key := CircuitKey{ChanID: outgoing, HtlcID: uint64(i)}
+		mgr.forwarded(key, inflightHtlc{outgoing: outgoing, amount: 1})
+	}
+	if mgr.admit(outgoing, constraints, 1000000, 1, false) {
+		t.Fatalf("unendorsed htlc admitted into reserved slot")
+	}
+	if !mgr.admit(outgoing, constraints, 1000000, 1, true) {
+		t.Fatalf("endorsed htlc rejected")
+	}
+}
@@ -25,6 +25,10 @@ type Config struct {
 	Commit bool `long:"commit" description:"Instructs the node to add HTLCs to its local commitment state and to open circuits for any ADDs, but abort before committing the changes"`
 
 	BogusSettle bool `long:"bogus-settle" description:"Instructs the node to settle back any incoming HTLC with a bogus preimage"`
+
+	CommitSig bool `long:"commit-sig" description:"Instructs the node to sign new commitments for the remote party, but to not send the signatures until the channel is reestablished"`
+
+	Revoke bool `long:"revoke" description:"Instructs the node to revoke its prior commitments, but to not send the revocations until the channel is reestablished"`
 }
 
 // Mask extracts the flags specified in the configuration, composing a Mask from
@@ -59,6 +63,12 @@ func (c *Config) Mask() Mask {
 	if c.BogusSettle {
 		flags = append(flags, BogusSettle)
 	}
+	if c.CommitSig {
+		flags = append(flags, CommitSig)
+	}
+	if c.Revoke {
+		flags = append(flags, Revoke)
+	}
 
 	// NOTE: The value returned here will only honor the configuration if
 	// the dev build flag is present. In production, this method always
@@ -55,6 +55,16 @@ const (
 	// BogusSettle attempts to settle back any incoming HTLC for which we
 	// are the exit node with a bogus preimage.
 	BogusSettle
+
+	// CommitSig drops an outgoing COMMIT_SIG after the new remote
+	// commitment has been persisted, leaving it to be retransmitted once
+	// the channel is reestablished.
+	CommitSig
+
+	// Revoke drops an outgoing REVOKE_AND_ACK after our prior commitment
+	// has been revoked, leaving it to be retransmitted once the channel is
+	// reestablished.
+	Revoke
 )
 
 // String returns a human-readable identifier for a given Flag.
@@ -78,6 +88,10 @@ func (f Flag) String() string {
 		return "Commit"
 	case BogusSettle:
 		return "BogusSettle"
+	case CommitSig:
+		return "CommitSig"
+	case Revoke:
+		return "Revoke"
 	default:
 		return "UnknownHodlFlag"
 	}
@@ -106,6 +120,10 @@ func (f Flag) Warning() string {
 		msg = "will not commit pending channel updates"
 	case BogusSettle:
 		msg = "will settle HTLC with bogus preimage"
+	case CommitSig:
+		msg = "will not send COMMIT_SIG to peer"
+	case Revoke:
+		msg = "will not send REVOKE_AND_ACK to peer"
 	default:
 		msg = "incorrect hodl flag usage"
 	}
@@ -69,6 +69,8 @@ var hodlMaskTests = []struct {
 			hodl.FailOutgoing,
 			hodl.Commit,
 			hodl.BogusSettle,
+			hodl.CommitSig,
+			hodl.Revoke,
 		),
 		flags: map[hodl.Flag]struct{}{
 			hodl.ExitSettle:     {},
@@ -80,6 +82,8 @@ var hodlMaskTests = []struct {
 			hodl.FailOutgoing:   {},
 			hodl.Commit:         {},
 			hodl.BogusSettle:    {},
+			hodl.CommitSig:      {},
+			hodl.Revoke:         {},
 		},
 	},
 }
@@ -0,0 +1,172 @@
+package htlcswitch
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// maxHoldTimeSamples is the number of most recent hold times retained for
+// each outgoing channel and peer when computing percentiles.
+const maxHoldTimeSamples = 1000
+
+// HoldTimeStats summarizes how long the forwards over an outgoing channel, or
+// to an outgoing peer, were held at this node between the switch forwarding
+// the incoming add and receiving the outgoing settle or fail.
+type HoldTimeStats struct {
+	// NumForwards is the total number of resolved forwards.
+	NumForwards uint64
+
+	// P50, P90 and P99 are the percentiles of the hold times of the most
+	// recently resolved forwards.
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+}
+
+// holdTimeSamples is a ring buffer of the most recent hold times, along with
+// the total number of hold times ever recorded.
+type holdTimeSamples struct {
+	samples []time.Duration
+	next    int
+	total   uint64
+}
+
+// add records a new hold time, evicting the oldest one if the buffer is full.
+func (h *holdTimeSamples) add(d time.Duration) {
+	h.total++
+
+	if len(h.samples) < maxHoldTimeSamples {
+		h.samples = append(h.samples, d)
+		return
+	}
+
+	h.samples[h.next] = d
+	h.next = (h.next + 1) % maxHoldTimeSamples
+}
+
+// stats computes the percentiles of the currently retained hold times.
+func (h *holdTimeSamples) stats() HoldTimeStats {
+	sorted := make([]time.Duration, len(h.samples))
+	copy(sorted, h.samples)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	percentile := func(p float64) time.Duration {
+		if len(sorted) == 0 {
+			return 0
+		}
+		return sorted[int(p*float64(len(sorted)-1))]
+	}
+
+	return HoldTimeStats{
+		NumForwards: h.total,
+		P50:         percentile(0.50),
+		P90:         percentile(0.90),
+		P99:         percentile(0.99),
+	}
+}
+
+// pendingHold is a forward that has yet to be resolved by the outgoing peer.
+type pendingHold struct {
+	start        time.Time
+	outgoing     lnwire.ShortChannelID
+	outgoingPeer [33]byte
+}
+
+// holdTimeTracker measures the time each forwarded HTLC is held at this node,
+// and aggregates the results per outgoing channel and per outgoing peer so
+// that slow peers can be identified.
+type holdTimeTracker struct {
+	// now returns the current time, and is overridden within tests.
+	now func() time.Time
+
+	mtx      sync.Mutex
+	pending  map[CircuitKey]pendingHold
+	channels map[lnwire.ShortChannelID]*holdTimeSamples
+	peers    map[[33]byte]*holdTimeSamples
+}
+
+// newHoldTimeTracker creates a new, empty hold time tracker.
+func newHoldTimeTracker() *holdTimeTracker {
+	return &holdTimeTracker{
+		now:      time.Now,
+		pending:  make(map[CircuitKey]pendingHold),
+		channels: make(map[lnwire.ShortChannelID]*holdTimeSamples),
+		peers:    make(map[[33]byte]*holdTimeSamples),
+	}
+}
+
+// forwarded starts the clock for the HTLC identified by its incoming circuit
+// key, which has just been handed to the outgoing link.
+func (h *holdTimeTracker) forwarded(inKey CircuitKey,
+	outgoing lnwire.ShortChannelID, outgoingPeer [33]byte) {
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.pending[inKey] = pendingHold{
+		start:        h.now(),
+		outgoing:     outgoing,
+		outgoingPeer: outgoingPeer,
+	}
+}
+
+// resolved stops the clock for the HTLC identified by its incoming circuit
+// key, recording its hold time. Forwards that were started before a restart
+// are unknown to the tracker and are ignored.
+func (h *holdTimeTracker) resolved(inKey CircuitKey) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	hold, ok := h.pending[inKey]
+	if !ok {
+		return
+	}
+	delete(h.pending, inKey)
+
+	elapsed := h.now().Sub(hold.start)
+
+	chanSamples, ok := h.channels[hold.outgoing]
+	if !ok {
+		chanSamples = &holdTimeSamples{}
+		h.channels[hold.outgoing] = chanSamples
+	}
+	chanSamples.add(elapsed)
+
+	peerSamples, ok := h.peers[hold.outgoingPeer]
+	if !ok {
+		peerSamples = &holdTimeSamples{}
+		h.peers[hold.outgoingPeer] = peerSamples
+	}
+	peerSamples.add(elapsed)
+}
+
+// channelStats returns the hold time statistics of every outgoing channel.
+func (h *holdTimeTracker) channelStats() map[lnwire.ShortChannelID]HoldTimeStats {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	stats := make(map[lnwire.ShortChannelID]HoldTimeStats, len(h.channels))
+	for chanID, samples := range h.channels {
+		stats[chanID] = samples.stats()
+	}
+
+	return stats
+}
+
+// peerStats returns the hold time statistics of every outgoing peer.
+func (h *holdTimeTracker) peerStats() map[[33]byte]HoldTimeStats {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	stats := make(map[[33]byte]HoldTimeStats, len(h.peers))
+	for peer, samples := range h.peers {
+		stats[peer] = samples.stats()
+	}
+
+	return stats
+}
@@ -0,0 +1,72 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestHoldTimeTracker asserts that the hold time tracker measures the time
+// between a forward and its resolution, and aggregates the results per
+// outgoing channel and peer.
+func TestHoldTimeTracker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	tracker := newHoldTimeTracker()
+	tracker.now = func() time.Time {
+		return now
+	}
+
+	chanA := lnwire.NewShortChanIDFromInt(1)
+	chanB := lnwire.NewShortChanIDFromInt(2)
+	peer := [33]byte{1}
+
+	// Forward a hundred HTLCs over channel A, holding the i-th one for i
+	// milliseconds, and a single one over channel B for a second. Both
+	// channels are with the same peer.
+	for i := 1; i <= 100; i++ {
+		inKey := CircuitKey{HtlcID: uint64(i)}
+		tracker.forwarded(inKey, chanA, peer)
+		now = now.Add(time.Duration(i) * time.Millisecond)
+		tracker.resolved(inKey)
+	}
+
+	inKey := CircuitKey{HtlcID: 1000}
+	tracker.forwarded(inKey, chanB, peer)
+	now = now.Add(time.Second)
+	tracker.resolved(inKey)
+
+	// Resolving an unknown HTLC, e.g. one forwarded before a restart,
+	// shouldn't be recorded.
+	tracker.resolved(CircuitKey{HtlcID: 2000})
+
+	chanStats := tracker.channelStats()
+	statsA := chanStats[chanA]
+	if statsA.NumForwards != 100 {
+		t.Fatalf("expected 100 forwards, got %v", statsA.NumForwards)
+	}
+	if statsA.P50 != 50*time.Millisecond {
+		t.Fatalf("unexpected p50: %v", statsA.P50)
+	}
+	if statsA.P90 != 90*time.Millisecond {
+		t.Fatalf("unexpected p90: %v", statsA.P90)
+	}
+	if statsA.P99 != 99*time.Millisecond {
+		t.Fatalf("unexpected p99: %v", statsA.P99)
+	}
+
+	statsB := chanStats[chanB]
+	if statsB.NumForwards != 1 || statsB.P50 != time.Second {
+		t.Fatalf("unexpected stats for channel B: %v", statsB)
+	}
+
+	peerStats := tracker.peerStats()[peer]
+	if peerStats.NumForwards != 101 {
+		t.Fatalf("expected 101 forwards, got %v", peerStats.NumForwards)
+	}
+	if peerStats.P99 != 100*time.Millisecond {
+		t.Fatalf("unexpected peer p99: %v", peerStats.P99)
+	}
+}
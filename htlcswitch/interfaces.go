@@ -4,6 +4,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -82,9 +83,10 @@ type ChannelLink interface {
 	// details satisfy the current forwarding policy fo the target link.
 	// Otherwise, a valid protocol failure message should be returned in
 	// order to signal to the source of the HTLC, the policy consistency
-	// issue.
+	// issue. The inbound fee charged by the incoming link is added to
+	// the fee expected by the target link.
 	HtlcSatifiesPolicy(payHash [32]byte, incomingAmt lnwire.MilliSatoshi,
-		amtToForward lnwire.MilliSatoshi,
+		amtToForward lnwire.MilliSatoshi, inboundFee int64,
 		incomingTimeout, outgoingTimeout uint32,
 		heightNow uint32) lnwire.FailureMessage
 
@@ -95,10 +97,19 @@ type ChannelLink interface {
 	// HTLC's which have been set to the over flow queue.
 	Bandwidth() lnwire.MilliSatoshi
 
+	// OutgoingFlowConstraints returns the flow constraints imposed by the
+	// remote party on the HTLCs we may offer over the link.
+	OutgoingFlowConstraints() lnwallet.FlowConstraints
+
 	// Stats return the statistics of channel link. Number of updates,
 	// total sent/received milli-satoshis.
 	Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSatoshi)
 
+	// PendingHTLCs returns a snapshot of the HTLCs pending within the
+	// current commitment of the channel, indicating for each of them
+	// whether it's being forwarded through us or is a local payment.
+	PendingHTLCs() []HTLCSnapshot
+
 	// Peer returns the representation of remote peer with which we have
 	// the channel link opened.
 	Peer() lnpeer.Peer
@@ -110,6 +121,22 @@ type ChannelLink interface {
 	// will use this function in forwarding decisions accordingly.
 	EligibleToForward() bool
 
+	// Quiesce requests that the link enter quiescence, such that neither
+	// party may propose new updates to the channel. The returned channel
+	// receives nil once the link is quiescent, or an error if quiescence
+	// couldn't be reached.
+	Quiesce() <-chan error
+
+	// ResumeQuiescence terminates quiescence of the link, allowing
+	// updates to be proposed once again.
+	ResumeQuiescence()
+
+	// ProposeFlowConstraints proposes new flow constraints to the remote
+	// party of a quiescent link, bounding the HTLCs it may offer. The
+	// returned channel receives nil once the constraints have been
+	// applied by both parties, or an error if they weren't.
+	ProposeFlowConstraints(lnwallet.FlowConstraints) <-chan error
+
 	// AttachMailBox delivers an active MailBox to the link. The MailBox may
 	// have buffered messages.
 	AttachMailBox(MailBox)
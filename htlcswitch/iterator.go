@@ -70,6 +70,11 @@ type ForwardingInfo struct {
 	// in the outgoing HTLC.
 	OutgoingCTLV uint32
 
+	// TotalAmount is the total amount of the multi-part payment that the
+	// HTLC is a part of. It's only set for the exit hop of multi-part
+	// payments, and is zero otherwise.
+	TotalAmount lnwire.MilliSatoshi
+
 	// TODO(roasbeef): modify sphinx logic to not just discard the
 	// remaining bytes, instead should include the rest as excess
 }
@@ -142,10 +147,20 @@ func (r *sphinxHopIterator) EncodeNextHop(w io.Writer) error {
 func (r *sphinxHopIterator) ForwardingInstructions() ForwardingInfo {
 	fwdInst := r.processedPacket.ForwardingInstructions
 
-	var nextHop lnwire.ShortChannelID
+	var (
+		nextHop     lnwire.ShortChannelID
+		totalAmount lnwire.MilliSatoshi
+	)
 	switch r.processedPacket.Action {
 	case sphinx.ExitNode:
 		nextHop = exitHop
+
+		// As the fixed size hop payload has no room for any other
+		// fields, the total amount of a multi-part payment is encoded
+		// within the first bytes of the padding of the exit hop.
+		totalAmount = lnwire.MilliSatoshi(
+			binary.BigEndian.Uint64(fwdInst.ExtraBytes[:8]),
+		)
 	case sphinx.MoreHops:
 		s := binary.BigEndian.Uint64(fwdInst.NextAddress[:])
 		nextHop = lnwire.NewShortChanIDFromInt(s)
@@ -156,6 +171,7 @@ func (r *sphinxHopIterator) ForwardingInstructions() ForwardingInfo {
 		NextHop:         nextHop,
 		AmountToForward: lnwire.MilliSatoshi(fwdInst.ForwardAmount),
 		OutgoingCTLV:    fwdInst.OutgoingCltv,
+		TotalAmount:     totalAmount,
 	}
 }
 
@@ -182,20 +198,33 @@ func (r *sphinxHopIterator) ExtractErrorEncrypter(
 // tests dependent from the sphinx internal parts.
 type OnionProcessor struct {
 	router *sphinx.Router
+
+	// pool is used to decode the onion packets of a batch in parallel.
+	pool *decodePool
 }
 
 // NewOnionProcessor creates new instance of decoder.
 func NewOnionProcessor(router *sphinx.Router) *OnionProcessor {
-	return &OnionProcessor{router}
+	return &OnionProcessor{
+		router: router,
+		pool:   newDecodePool(defaultNumDecodeWorkers),
+	}
 }
 
-// Start spins up the onion processor's sphinx router.
+// Start spins up the onion processor's sphinx router and decode workers.
 func (p *OnionProcessor) Start() error {
-	return p.router.Start()
+	if err := p.router.Start(); err != nil {
+		return err
+	}
+
+	p.pool.start()
+
+	return nil
 }
 
-// Stop shutsdown the onion processor's sphinx router.
+// Stop shutsdown the onion processor's sphinx router and decode workers.
 func (p *OnionProcessor) Stop() error {
+	p.pool.stop()
 	p.router.Stop()
 	return nil
 }
@@ -285,32 +314,41 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 		resps     = make([]DecodeHopIteratorResponse, batchSize)
 	)
 
-	tx := p.router.BeginTxn(id, batchSize)
-
-	for i, req := range reqs {
-		onionPkt := &onionPkts[i]
-		resp := &resps[i]
-
-		err := onionPkt.Decode(req.OnionReader)
+	// Parsing the onion packets, which includes decompressing their
+	// ephemeral keys, is independent across the batch, so we spread it
+	// across our decode workers.
+	p.pool.run(batchSize, func(i int) {
+		err := onionPkts[i].Decode(reqs[i].OnionReader)
 		switch err {
 		case nil:
 			// success
 
 		case sphinx.ErrInvalidOnionVersion:
-			resp.FailCode = lnwire.CodeInvalidOnionVersion
-			continue
+			resps[i].FailCode = lnwire.CodeInvalidOnionVersion
 
 		case sphinx.ErrInvalidOnionKey:
-			resp.FailCode = lnwire.CodeInvalidOnionKey
-			continue
+			resps[i].FailCode = lnwire.CodeInvalidOnionKey
 
 		default:
 			log.Errorf("unable to decode onion packet: %v", err)
-			resp.FailCode = lnwire.CodeInvalidOnionKey
+			resps[i].FailCode = lnwire.CodeInvalidOnionKey
+		}
+	})
+
+	// The sphinx batch isn't safe for concurrent use, so the packets that
+	// were parsed successfully are added to it one at a time.
+	tx := p.router.BeginTxn(id, batchSize)
+
+	for i, req := range reqs {
+		onionPkt := &onionPkts[i]
+		resp := &resps[i]
+
+		// Skip any indexes that already failed onion decoding.
+		if resp.FailCode != lnwire.CodeNone {
 			continue
 		}
 
-		err = tx.ProcessOnionPacket(
+		err := tx.ProcessOnionPacket(
 			uint16(i), onionPkt, req.RHash, req.IncomingCltv,
 		)
 		switch err {
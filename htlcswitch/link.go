@@ -54,6 +54,19 @@ const (
 	// DefaultMaxLinkFeeUpdateTimeout represents the maximum interval in
 	// which a link should propose to update its commitment fee rate.
 	DefaultMaxLinkFeeUpdateTimeout = 60 * time.Minute
+
+	// DefaultLinkFeeUpdateBand is the default fraction by which the
+	// network fee rate must deviate from the commitment fee rate before a
+	// link proposes to update it.
+	DefaultLinkFeeUpdateBand = 0.1
+
+	// DefaultLinkFeeUpdateConfTarget is the default confirmation target
+	// used when sampling the network fee rate for commitment fee updates.
+	DefaultLinkFeeUpdateConfTarget = 3
+
+	// DefaultQuiescenceTimeout is the default duration for which the
+	// remote party may hold a channel quiescent before we disconnect it.
+	DefaultQuiescenceTimeout = time.Minute
 )
 
 // ForwardingPolicy describes the set of constraints that a given ChannelLink
@@ -69,6 +82,11 @@ type ForwardingPolicy struct {
 	// lifetime of the channel.
 	MinHTLC lnwire.MilliSatoshi
 
+	// MaxHTLC is the largest HTLC that is to be forwarded. If zero, the
+	// size of forwarded HTLCs isn't capped, other than by the bandwidth
+	// of the link.
+	MaxHTLC lnwire.MilliSatoshi
+
 	// BaseFee is the base fee, expressed in milli-satoshi that must be
 	// paid for each incoming HTLC. This field, combined with FeeRate is
 	// used to compute the required fee for a given HTLC.
@@ -90,6 +108,12 @@ type ForwardingPolicy struct {
 	//    per-hop payload of the incoming HTLC's onion packet.
 	TimeLockDelta uint32
 
+	// InboundFee is the fee charged for HTLCs entering through this
+	// link, on top of the fee of the outgoing link. It's a discount if
+	// negative. A nil value means that no inbound fee is charged, or
+	// when updating a policy, that the inbound fee is left unchanged.
+	InboundFee *lnwire.InboundFee
+
 	// TODO(roasbeef): add fee module inside of switch
 }
 
@@ -106,6 +130,31 @@ func ExpectedFee(f ForwardingPolicy,
 	return f.BaseFee + (htlcAmt*f.FeeRate)/1000000
 }
 
+// HTLCSnapshot describes an HTLC that's pending within the current commitment
+// of a link.
+type HTLCSnapshot struct {
+	// HtlcIndex is the index of the HTLC within the update log of the
+	// party that offered it.
+	HtlcIndex uint64
+
+	// Incoming is true if the HTLC was offered to us by the remote party.
+	Incoming bool
+
+	// Amount is the amount of the HTLC.
+	Amount lnwire.MilliSatoshi
+
+	// PaymentHash is the payment hash locking the HTLC.
+	PaymentHash [32]byte
+
+	// Expiry is the absolute height at which the HTLC times out.
+	Expiry uint32
+
+	// Forwarded is true if the HTLC is part of a payment being forwarded
+	// through us. Otherwise, it's either a payment we sent, or one paying
+	// one of our invoices, depending on its direction.
+	Forwarded bool
+}
+
 // ChannelLinkConfig defines the configuration for the channel link. ALL
 // elements within the configuration MUST be non-nil for channel link to carry
 // out its duties.
@@ -237,6 +286,42 @@ type ChannelLinkConfig struct {
 	// fee rate. A random timeout will be selected between these values.
 	MinFeeUpdateTimeout time.Duration
 	MaxFeeUpdateTimeout time.Duration
+
+	// FeeUpdateBand is the fraction by which the network fee rate must
+	// deviate from the current commitment fee rate, in either direction,
+	// before the link proposes to update it. If zero,
+	// DefaultLinkFeeUpdateBand is used.
+	FeeUpdateBand float64
+
+	// FeeUpdateConfTarget is the confirmation target, in blocks, used
+	// when sampling the network fee rate. If zero,
+	// DefaultLinkFeeUpdateConfTarget is used.
+	FeeUpdateConfTarget uint32
+
+	// QuiescenceSupported indicates whether the remote peer has signalled
+	// support for the quiescence protocol. If false, requests to quiesce
+	// the link will fail with ErrQuiescenceUnsupported.
+	QuiescenceSupported bool
+
+	// DynamicCommitmentsSupported indicates whether the remote peer has
+	// signalled support for renegotiating the flow constraints of the
+	// channel once quiescent. If false, proposals of new constraints will
+	// fail with ErrDynCommitmentsUnsupported.
+	DynamicCommitmentsSupported bool
+
+	// QuiescenceTimeout is the duration for which the remote party may
+	// hold the channel quiescent once it has sent us its Stfu, unless we
+	// initiated the quiescence session. Once it elapses, the link fails
+	// and the peer is disconnected, which terminates the session on both
+	// ends. If zero, DefaultQuiescenceTimeout is used.
+	QuiescenceTimeout time.Duration
+
+	// AcquireStartup, if set, blocks until the link is allowed to start
+	// up, i.e. re-establish the channel state with the remote peer and
+	// replay its forwarding packages. The returned closure is called once
+	// the startup has completed. This is used to stagger the startup of
+	// many links at once.
+	AcquireStartup func(quit <-chan struct{}) (func(), error)
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -331,6 +416,59 @@ type channelLink struct {
 	// commitment fee every time it fires.
 	updateFeeTimer *time.Timer
 
+	// quiescer tracks the state of the quiescence protocol for the
+	// channel.
+	quiescer *quiescer
+
+	// quiescing is set to 1 while quiescence is either pending or active,
+	// signalling to the switch that the link can't accept new HTLCs.
+	quiescing int32
+
+	// quiescenceReqs is a channel over which other subsystems request
+	// that the link enter quiescence. The included channel is notified
+	// once the link is quiescent.
+	quiescenceReqs chan chan error
+
+	// resumeReqs is a channel over which other subsystems request that
+	// the link terminate quiescence.
+	resumeReqs chan struct{}
+
+	// quiescenceWaiters is the set of pending quiescence requests that
+	// will be notified once the link becomes quiescent.
+	quiescenceWaiters []chan error
+
+	// quiescenceTimer, if set, fires once the remote party has held the
+	// channel quiescent for longer than the quiescence timeout.
+	quiescenceTimer *time.Timer
+
+	// dynUpdateReqs is a channel over which other subsystems propose new
+	// flow constraints for the channel.
+	dynUpdateReqs chan *dynUpdateReq
+
+	// pendingDynUpdate is the proposal of new flow constraints that we've
+	// sent to the remote party, and which is awaiting its response.
+	pendingDynUpdate *dynUpdateReq
+
+	// pendingDynAck holds the flow constraints proposed by the remote
+	// party that we've accepted, but that it has yet to commit to. They're
+	// persisted, and settled with the remote party upon reconnection if
+	// the connection drops in the meantime.
+	pendingDynAck *lnwallet.FlowConstraints
+
+	// dynAckInSession is true while the quiescence session within which
+	// we accepted pendingDynAck is still active, in which case the
+	// session ends once the remote party has committed to them.
+	dynAckInSession bool
+
+	// awaitingDynCommit is set to 1 while pendingDynAck is set. We don't
+	// offer any HTLCs in the meantime, as we don't know which constraints
+	// bound them yet.
+	awaitingDynCommit int32
+
+	// mppResolutions delivers the resolutions of the multi-part payments
+	// whose parts the link is holding as the exit hop.
+	mppResolutions chan *mppResolution
+
 	sync.RWMutex
 
 	wg   sync.WaitGroup
@@ -350,6 +488,14 @@ func NewChannelLink(cfg ChannelLinkConfig,
 		logCommitTimer: time.NewTimer(300 * time.Millisecond),
 		overflowQueue:  newPacketQueue(lnwallet.MaxHTLCNumber / 2),
 		htlcUpdates:    make(chan []channeldb.HTLC),
+		quiescer: newQuiescer(
+			lnwire.NewChanIDFromOutPoint(channel.ChannelPoint()),
+			channel.IsInitiator(),
+		),
+		quiescenceReqs: make(chan chan error),
+		resumeReqs:     make(chan struct{}),
+		dynUpdateReqs:  make(chan *dynUpdateReq),
+		mppResolutions: make(chan *mppResolution),
 		quit:           make(chan struct{}),
 	}
 }
@@ -461,9 +607,456 @@ func (l *channelLink) WaitForShutdown() {
 // we know the remote party's next revocation point. Otherwise, we can't
 // initiate new channel state. We also require that the short channel ID not be
 // the all-zero source ID, meaning that the channel has had its ID finalized.
+// Finally, the link must not be quiescent, or in the process of becoming so,
+// nor awaiting the remote party's commitment to new flow constraints.
 func (l *channelLink) EligibleToForward() bool {
 	return l.channel.RemoteNextRevocation() != nil &&
-		l.ShortChanID() != sourceHop
+		l.ShortChanID() != sourceHop &&
+		atomic.LoadInt32(&l.quiescing) == 0 &&
+		atomic.LoadInt32(&l.awaitingDynCommit) == 0
+}
+
+// Quiesce requests that the link enter quiescence. The link will stop
+// proposing new updates, and once all pending updates have been irrevocably
+// committed, exchange Stfu messages with the remote party. The returned
+// channel receives nil once the link is quiescent, or an error if quiescence
+// couldn't be reached.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) Quiesce() <-chan error {
+	resp := make(chan error, 1)
+
+	if !l.cfg.QuiescenceSupported {
+		resp <- ErrQuiescenceUnsupported
+		return resp
+	}
+
+	select {
+	case l.quiescenceReqs <- resp:
+	case <-l.quit:
+		resp <- ErrLinkShuttingDown
+	}
+
+	return resp
+}
+
+// ResumeQuiescence terminates quiescence of the link, allowing updates to be
+// proposed once again. Any pending quiescence requests will be failed with
+// ErrQuiescenceResumed.
+//
+// NOTE: The remote party is only able to propose updates once it has
+// terminated quiescence on its end as well, or upon reconnection.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) ResumeQuiescence() {
+	select {
+	case l.resumeReqs <- struct{}{}:
+	case <-l.quit:
+	}
+}
+
+// dynUpdateReq is a request to propose new flow constraints to the remote
+// party.
+type dynUpdateReq struct {
+	// constraints are the proposed constraints, which bound the HTLCs
+	// offered by the remote party.
+	constraints lnwallet.FlowConstraints
+
+	// resp receives nil once the remote party has applied the
+	// constraints, or an error if they weren't applied.
+	resp chan error
+}
+
+// ProposeFlowConstraints proposes new flow constraints to the remote party,
+// bounding the HTLCs it may offer to us. The link must be quiescent, with us
+// as the initiator of the quiescence session. If the remote party accepts
+// them, we apply the new constraints and then commit to them, at which point
+// the remote party applies them as well. In either case, quiescence is
+// terminated on both sides. The returned channel receives nil once the
+// constraints have been applied, or an error if they weren't.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) ProposeFlowConstraints(
+	constraints lnwallet.FlowConstraints) <-chan error {
+
+	req := &dynUpdateReq{
+		constraints: constraints,
+		resp:        make(chan error, 1),
+	}
+
+	if !l.cfg.DynamicCommitmentsSupported {
+		req.resp <- ErrDynCommitmentsUnsupported
+		return req.resp
+	}
+
+	select {
+	case l.dynUpdateReqs <- req:
+	case <-l.quit:
+		req.resp <- ErrLinkShuttingDown
+	}
+
+	return req.resp
+}
+
+// handleDynUpdateReq validates a proposal of new flow constraints, and sends
+// it to the remote party if it's valid.
+func (l *channelLink) handleDynUpdateReq(req *dynUpdateReq) {
+	switch {
+	case !l.quiescer.isQuiescent() || !l.quiescer.isInitiator():
+		req.resp <- ErrNotQuiescenceInitiator
+		return
+
+	case l.pendingDynUpdate != nil || l.pendingDynAck != nil:
+		req.resp <- ErrDynUpdatePending
+		return
+	}
+
+	// The proposed constraints bound the HTLCs offered by the remote
+	// party, which must be able to apply them to its own commitment.
+	err := l.channel.ValidateFlowConstraints(req.constraints, false)
+	if err != nil {
+		req.resp <- err
+		return
+	}
+
+	propose := &lnwire.DynPropose{
+		ChanID:           l.ChanID(),
+		MaxValueInFlight: req.constraints.MaxPendingAmount,
+		HtlcMinimum:      req.constraints.MinHTLC,
+		MaxAcceptedHTLCs: req.constraints.MaxAcceptedHtlcs,
+	}
+	if err := l.cfg.Peer.SendMessage(false, propose); err != nil {
+		req.resp <- err
+		return
+	}
+	l.pendingDynUpdate = req
+
+	l.infof("Proposed flow constraints: max_value_in_flight=%v, "+
+		"htlc_minimum=%v, max_accepted_htlcs=%v",
+		propose.MaxValueInFlight, propose.HtlcMinimum,
+		propose.MaxAcceptedHTLCs)
+}
+
+// handleDynPropose accepts the flow constraints proposed by the remote party
+// if they're valid, and responds with either a DynAck or a DynReject. Accepted
+// constraints are persisted as pending, and only applied once the remote
+// party has committed to them, which ends the quiescence session. A rejection
+// ends it right away.
+func (l *channelLink) handleDynPropose(msg *lnwire.DynPropose) {
+	// Only the initiator of the current quiescence session may propose
+	// new constraints, once any previous proposal has been settled.
+	if !l.quiescer.isQuiescent() || l.quiescer.isInitiator() {
+		l.fail(LinkFailureError{code: ErrInvalidUpdate},
+			"received dyn_propose while not quiescent, or as the "+
+				"initiator")
+		return
+	}
+	if l.pendingDynAck != nil {
+		l.fail(LinkFailureError{code: ErrInvalidUpdate},
+			"received dyn_propose before the previous proposal "+
+				"was settled")
+		return
+	}
+
+	// The proposed constraints bound the HTLCs that we offer to the
+	// remote party.
+	constraints := lnwallet.FlowConstraints{
+		MaxPendingAmount: msg.MaxValueInFlight,
+		MinHTLC:          msg.HtlcMinimum,
+		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
+	}
+
+	err := l.channel.AcceptFlowConstraints(constraints)
+	if err != nil {
+		l.warnf("Rejecting proposed flow constraints: %v", err)
+
+		reject := &lnwire.DynReject{
+			ChanID: msg.ChanID,
+			Reason: lnwire.ErrorData(err.Error()),
+		}
+		if err := l.cfg.Peer.SendMessage(false, reject); err != nil {
+			l.fail(LinkFailureError{code: ErrInternalError},
+				"unable to send dyn_reject: %v", err)
+			return
+		}
+
+		l.resumeQuiescence()
+		return
+	}
+
+	l.setPendingDynAck(&constraints)
+	l.dynAckInSession = true
+
+	if err := l.sendDynAck(constraints); err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to send dyn_ack: %v", err)
+		return
+	}
+
+	l.infof("Accepted flow constraints proposed by remote party, "+
+		"awaiting its commitment: max_value_in_flight=%v, "+
+		"htlc_minimum=%v, max_accepted_htlcs=%v", msg.MaxValueInFlight,
+		msg.HtlcMinimum, msg.MaxAcceptedHTLCs)
+}
+
+// handleDynAck processes a DynAck from the remote party. It's either:
+//   - the commitment of the remote party to the constraints it proposed and
+//     that we accepted, in which case we apply them,
+//   - the acceptance of our pending proposal, in which case we apply the
+//     constraints and commit to them,
+//   - or an acceptance re-sent upon reconnection for a past proposal of ours,
+//     in which case we tell the remote party whether we applied it.
+func (l *channelLink) handleDynAck(msg *lnwire.DynAck) {
+	constraints := lnwallet.FlowConstraints{
+		MaxPendingAmount: msg.MaxValueInFlight,
+		MinHTLC:          msg.HtlcMinimum,
+		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
+	}
+
+	switch {
+	case l.pendingDynAck != nil:
+		if *l.pendingDynAck != constraints {
+			l.fail(LinkFailureError{code: ErrInvalidUpdate},
+				"received dyn_ack for constraints that "+
+					"weren't proposed")
+			return
+		}
+
+		if err := l.channel.CommitFlowConstraints(); err != nil {
+			l.fail(LinkFailureError{code: ErrInternalError},
+				"unable to apply flow constraints: %v", err)
+			return
+		}
+
+		l.infof("Applied flow constraints committed to by remote "+
+			"party: max_value_in_flight=%v, htlc_minimum=%v, "+
+			"max_accepted_htlcs=%v", msg.MaxValueInFlight,
+			msg.HtlcMinimum, msg.MaxAcceptedHTLCs)
+
+		inSession := l.dynAckInSession
+		l.setPendingDynAck(nil)
+		if inSession {
+			l.resumeQuiescence()
+		}
+
+	case l.pendingDynUpdate != nil &&
+		l.pendingDynUpdate.constraints == constraints:
+
+		req := l.pendingDynUpdate
+		l.pendingDynUpdate = nil
+
+		// The remote party has accepted the constraints, so we'll
+		// apply them and commit to them, after which the remote party
+		// applies them as well. If we can't apply them, we'll reject
+		// them instead, so that the remote party discards them.
+		var resp lnwire.Message = &lnwire.DynAck{
+			ChanID:           msg.ChanID,
+			MaxValueInFlight: msg.MaxValueInFlight,
+			HtlcMinimum:      msg.HtlcMinimum,
+			MaxAcceptedHTLCs: msg.MaxAcceptedHTLCs,
+		}
+		err := l.channel.UpdateFlowConstraints(constraints, false)
+		if err != nil {
+			resp = &lnwire.DynReject{
+				ChanID: msg.ChanID,
+				Reason: lnwire.ErrorData(err.Error()),
+			}
+		}
+		req.resp <- err
+
+		if err := l.cfg.Peer.SendMessage(false, resp); err != nil {
+			l.fail(LinkFailureError{code: ErrInternalError},
+				"unable to send %v: %v", resp.MsgType(), err)
+			return
+		}
+
+		l.resumeQuiescence()
+
+	default:
+		// The remote party accepted a proposal of ours before the
+		// connection dropped, and wants to know whether we committed
+		// to it. We did if and only if the constraints we apply to the
+		// remote party are the accepted ones.
+		var resp lnwire.Message = &lnwire.DynAck{
+			ChanID:           msg.ChanID,
+			MaxValueInFlight: msg.MaxValueInFlight,
+			HtlcMinimum:      msg.HtlcMinimum,
+			MaxAcceptedHTLCs: msg.MaxAcceptedHTLCs,
+		}
+		if l.channel.CurrentFlowConstraints(false) != constraints {
+			resp = &lnwire.DynReject{
+				ChanID: msg.ChanID,
+				Reason: lnwire.ErrorData("constraints weren't " +
+					"applied"),
+			}
+		}
+
+		if err := l.cfg.Peer.SendMessage(false, resp); err != nil {
+			l.fail(LinkFailureError{code: ErrInternalError},
+				"unable to send %v: %v", resp.MsgType(), err)
+		}
+	}
+}
+
+// handleDynReject processes a DynReject from the remote party, which either
+// refuses our pending proposal, or refuses to commit to the constraints it
+// proposed and that we accepted. In either case, the constraints are
+// discarded.
+func (l *channelLink) handleDynReject(msg *lnwire.DynReject) {
+	switch {
+	case l.pendingDynAck != nil:
+		if err := l.channel.CancelFlowConstraints(); err != nil {
+			l.fail(LinkFailureError{code: ErrInternalError},
+				"unable to discard flow constraints: %v", err)
+			return
+		}
+
+		l.infof("Discarded flow constraints that remote party didn't "+
+			"commit to: %v", string(msg.Reason))
+
+		inSession := l.dynAckInSession
+		l.setPendingDynAck(nil)
+		if inSession {
+			l.resumeQuiescence()
+		}
+
+	case l.pendingDynUpdate != nil:
+		l.pendingDynUpdate.resp <- fmt.Errorf("remote party rejected "+
+			"flow constraints: %v", string(msg.Reason))
+		l.pendingDynUpdate = nil
+
+		l.resumeQuiescence()
+
+	default:
+		l.fail(LinkFailureError{code: ErrInvalidUpdate},
+			"received dyn_reject without a pending proposal")
+	}
+}
+
+// sendDynAck sends a DynAck for the passed constraints to the remote party.
+func (l *channelLink) sendDynAck(constraints lnwallet.FlowConstraints) error {
+	return l.cfg.Peer.SendMessage(false, &lnwire.DynAck{
+		ChanID:           l.ChanID(),
+		MaxValueInFlight: constraints.MaxPendingAmount,
+		HtlcMinimum:      constraints.MinHTLC,
+		MaxAcceptedHTLCs: constraints.MaxAcceptedHtlcs,
+	})
+}
+
+// setPendingDynAck sets the flow constraints that we've accepted, and that
+// the remote party has yet to commit to. If nil, they've been settled.
+func (l *channelLink) setPendingDynAck(constraints *lnwallet.FlowConstraints) {
+	l.pendingDynAck = constraints
+
+	if constraints == nil {
+		l.dynAckInSession = false
+		atomic.StoreInt32(&l.awaitingDynCommit, 0)
+		return
+	}
+	atomic.StoreInt32(&l.awaitingDynCommit, 1)
+}
+
+// resendPendingDynAck re-sends our acceptance of the flow constraints that
+// the remote party proposed before the connection dropped, if it has yet to
+// commit to them. The remote party responds with a DynAck if it applied them,
+// or a DynReject otherwise, settling them on both ends.
+func (l *channelLink) resendPendingDynAck() error {
+	pending, err := l.channel.PendingFlowConstraints()
+	if err != nil || pending == nil {
+		return err
+	}
+
+	l.setPendingDynAck(pending)
+
+	l.infof("Re-sending acceptance of flow constraints pending the "+
+		"commitment of remote party: max_value_in_flight=%v, "+
+		"htlc_minimum=%v, max_accepted_htlcs=%v",
+		pending.MaxPendingAmount, pending.MinHTLC,
+		pending.MaxAcceptedHtlcs)
+
+	return l.sendDynAck(*pending)
+}
+
+// maybeSendStfu sends our Stfu to the remote party if we owe one, and there
+// are no longer any updates pending on the channel. Once the link becomes
+// quiescent, all pending quiescence requests are notified.
+func (l *channelLink) maybeSendStfu() {
+	if l.failed {
+		return
+	}
+
+	if l.quiescer.owesStfu() && l.channel.NoDanglingUpdates() {
+		stfu := l.quiescer.makeStfu()
+		if err := l.cfg.Peer.SendMessage(false, stfu); err != nil {
+			l.fail(LinkFailureError{code: ErrInternalError},
+				"unable to send stfu: %v", err)
+			return
+		}
+
+		l.debugf("Sent stfu, initiator=%v", stfu.Initiator)
+	}
+
+	if !l.quiescer.isQuiescent() {
+		return
+	}
+
+	for _, waiter := range l.quiescenceWaiters {
+		waiter <- nil
+	}
+	l.quiescenceWaiters = nil
+}
+
+// startQuiescenceTimer starts the timer bounding how long the remote party
+// may hold the channel quiescent.
+func (l *channelLink) startQuiescenceTimer() {
+	timeout := l.cfg.QuiescenceTimeout
+	if timeout == 0 {
+		timeout = DefaultQuiescenceTimeout
+	}
+
+	l.stopQuiescenceTimer()
+	l.quiescenceTimer = time.NewTimer(timeout)
+}
+
+// stopQuiescenceTimer stops the timer bounding how long the remote party may
+// hold the channel quiescent, if it's running.
+func (l *channelLink) stopQuiescenceTimer() {
+	if l.quiescenceTimer == nil {
+		return
+	}
+
+	l.quiescenceTimer.Stop()
+	l.quiescenceTimer = nil
+}
+
+// quiescenceTimeout returns a channel that receives once the remote party has
+// held the channel quiescent for too long. If the timer isn't running, the
+// returned channel is nil, and never receives.
+func (l *channelLink) quiescenceTimeout() <-chan time.Time {
+	if l.quiescenceTimer == nil {
+		return nil
+	}
+
+	return l.quiescenceTimer.C
+}
+
+// resumeQuiescence terminates quiescence of the link, failing any pending
+// quiescence requests.
+func (l *channelLink) resumeQuiescence() {
+	l.quiescer.resume()
+	l.stopQuiescenceTimer()
+	l.dynAckInSession = false
+	atomic.StoreInt32(&l.quiescing, 0)
+
+	for _, waiter := range l.quiescenceWaiters {
+		waiter <- ErrQuiescenceResumed
+	}
+	l.quiescenceWaiters = nil
+
+	if l.pendingDynUpdate != nil {
+		l.pendingDynUpdate.resp <- ErrQuiescenceResumed
+		l.pendingDynUpdate = nil
+	}
 }
 
 // sampleNetworkFee samples the current fee rate on the network to get into the
@@ -471,32 +1064,42 @@ func (l *channelLink) EligibleToForward() bool {
 // this is the native rate used when computing the fee for commitment
 // transactions, and the second-level HTLC transactions.
 func (l *channelLink) sampleNetworkFee() (lnwallet.SatPerKWeight, error) {
-	// We'll first query for the sat/kw recommended to be confirmed within 3
-	// blocks.
-	feePerKw, err := l.cfg.FeeEstimator.EstimateFeePerKW(3)
+	confTarget := l.cfg.FeeUpdateConfTarget
+	if confTarget == 0 {
+		confTarget = DefaultLinkFeeUpdateConfTarget
+	}
+
+	// We'll first query for the sat/kw recommended to be confirmed within
+	// our target number of blocks.
+	feePerKw, err := l.cfg.FeeEstimator.EstimateFeePerKW(confTarget)
 	if err != nil {
 		return 0, err
 	}
 
-	log.Debugf("ChannelLink(%v): sampled fee rate for 3 block conf: %v "+
-		"sat/kw", l, int64(feePerKw))
+	log.Debugf("ChannelLink(%v): sampled fee rate for %v block conf: %v "+
+		"sat/kw", l, confTarget, int64(feePerKw))
 
 	return feePerKw, nil
 }
 
 // shouldAdjustCommitFee returns true if we should update our commitment fee to
 // match that of the network fee. We'll only update our commitment fee if the
-// network fee is +/- 10% to our network fee.
-func shouldAdjustCommitFee(netFee, chanFee lnwallet.SatPerKWeight) bool {
+// network fee deviates from it by at least the given band, e.g. +/- 10% for a
+// band of 0.1.
+func shouldAdjustCommitFee(netFee, chanFee lnwallet.SatPerKWeight,
+	band float64) bool {
+
+	delta := lnwallet.SatPerKWeight(float64(chanFee) * band)
+
 	switch {
 	// If the network fee is greater than the commitment fee, then we'll
-	// switch to it if it's at least 10% greater than the commit fee.
-	case netFee > chanFee && netFee >= (chanFee+(chanFee*10)/100):
+	// switch to it if it's outside of our band above the commit fee.
+	case netFee > chanFee && netFee >= chanFee+delta:
 		return true
 
 	// If the network fee is less than our commitment fee, then we'll
-	// switch to it if it's at least 10% less than the commitment fee.
-	case netFee < chanFee && netFee <= (chanFee-(chanFee*10)/100):
+	// switch to it if it's outside of our band below the commit fee.
+	case netFee < chanFee && netFee <= chanFee-delta:
 		return true
 
 	// Otherwise, we won't modify our fee.
@@ -773,6 +1376,23 @@ func (l *channelLink) htlcManager() {
 
 	// TODO(roasbeef): need to call wipe chan whenever D/C?
 
+	// Before doing any work, we'll wait for our turn to start up, so that
+	// we don't hammer the database and the peer connections along with
+	// all the other links when the daemon boots.
+	releaseStartup := func() {}
+	if l.cfg.AcquireStartup != nil {
+		release, err := l.cfg.AcquireStartup(l.quit)
+		if err != nil {
+			log.Debugf("ChannelLink(%v) exiting before startup: %v",
+				l, err)
+			return
+		}
+
+		var releaseOnce sync.Once
+		releaseStartup = func() { releaseOnce.Do(release) }
+		defer releaseStartup()
+	}
+
 	// If this isn't the first time that this channel link has been
 	// created, then we'll need to check to see if we need to
 	// re-synchronize state with the remote peer. settledHtlcs is a map of
@@ -817,10 +1437,9 @@ func (l *channelLink) htlcManager() {
 			// hopefully force close it. The remote has sent us its
 			// latest unrevoked commitment point, that we stored in
 			// the database, that we can use to retrieve the funds
-			// when the remote closes the channel.
-			// TODO(halseth): mark this, such that we prevent
-			// channel from being force closed by the user or
-			// contractcourt etc.
+			// when the remote closes the channel. The channel has
+			// been marked with LocalDataLoss, which prevents it
+			// from being force closed by the user or contractcourt.
 			case err == lnwallet.ErrCommitSyncLocalDataLoss:
 
 			// We determined the commit chains were not possible to
@@ -846,6 +1465,15 @@ func (l *channelLink) htlcManager() {
 		}
 	}
 
+	// If the remote party proposed new flow constraints that we accepted
+	// before the connection dropped, we'll find out whether it committed
+	// to them before we offer any HTLCs.
+	if err := l.resendPendingDynAck(); err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to settle pending flow constraints: %v", err)
+		return
+	}
+
 	// With the channel states synced, we now reset the mailbox to ensure
 	// we start processing all unacked packets in order. This is done here
 	// to ensure that all acknowledgments that occur during channel
@@ -873,6 +1501,10 @@ func (l *channelLink) htlcManager() {
 		go l.fwdPkgGarbager()
 	}
 
+	// Our startup is now complete, so we'll let the next link proceed
+	// with its own.
+	releaseStartup()
+
 out:
 	for {
 		// We must always check if we failed at some point processing
@@ -882,6 +1514,19 @@ out:
 			break out
 		}
 
+		// While quiescence is pending or active, we're unable to
+		// propose any new updates, so we'll stop pulling packets from
+		// the switch. They'll remain within the mailbox until
+		// quiescence is terminated.
+		downstream := l.downstream
+		overflow := l.overflowQueue.outgoingPkts
+		mppResolutions := l.mppResolutions
+		if !l.quiescer.canSendUpdates() {
+			downstream = nil
+			overflow = nil
+			mppResolutions = nil
+		}
+
 		select {
 		// Our update fee timer has fired, so we'll check the network
 		// fee to see if we should adjust our commitment fee.
@@ -894,9 +1539,15 @@ out:
 				continue
 			}
 
+			// We're also unable to update the fee while the
+			// channel is being quiesced.
+			if !l.quiescer.canSendUpdates() {
+				continue
+			}
+
 			// If we are the initiator, then we'll sample the
-			// current fee rate to get into the chain within 3
-			// blocks.
+			// current fee rate to get into the chain within our
+			// confirmation target.
 			feePerKw, err := l.sampleNetworkFee()
 			if err != nil {
 				log.Errorf("unable to sample network fee: %v", err)
@@ -906,7 +1557,11 @@ out:
 			// We'll check to see if we should update the fee rate
 			// based on our current set fee rate.
 			commitFee := l.channel.CommitFeeRate()
-			if !shouldAdjustCommitFee(feePerKw, commitFee) {
+			band := l.cfg.FeeUpdateBand
+			if band == 0 {
+				band = DefaultLinkFeeUpdateBand
+			}
+			if !shouldAdjustCommitFee(feePerKw, commitFee, band) {
 				continue
 			}
 
@@ -977,7 +1632,7 @@ out:
 		// transaction is now eligible for processing once again. So
 		// we'll attempt to re-process the packet in order to allow it
 		// to continue propagating within the network.
-		case packet := <-l.overflowQueue.outgoingPkts:
+		case packet := <-overflow:
 			msg := packet.htlc.(*lnwire.UpdateAddHTLC)
 			log.Tracef("Reprocessing downstream add update "+
 				"with payment hash(%x)", msg.PaymentHash[:])
@@ -994,7 +1649,7 @@ out:
 		// A message from the switch was just received. This indicates
 		// that the link is an intermediate hop in a multi-hop HTLC
 		// circuit.
-		case pkt := <-l.downstream:
+		case pkt := <-downstream:
 			// If we have non empty processing queue then we'll add
 			// this to the overflow rather than processing it
 			// directly. Once an active HTLC is either settled or
@@ -1026,6 +1681,56 @@ out:
 		case msg := <-l.upstream:
 			l.handleUpstreamMsg(msg)
 
+			// The message may have locked in the last of the
+			// pending updates, or been an Stfu from the remote
+			// party, so we'll check whether we now owe an Stfu.
+			l.maybeSendStfu()
+
+		// Another subsystem has requested that we quiesce the channel,
+		// so we'll stop proposing updates and send our Stfu as soon as
+		// the channel has drained.
+		case resp := <-l.quiescenceReqs:
+			l.quiescer.initStfu()
+			l.quiescenceWaiters = append(l.quiescenceWaiters, resp)
+			atomic.StoreInt32(&l.quiescing, 1)
+
+			l.maybeSendStfu()
+
+		case <-l.resumeReqs:
+			l.resumeQuiescence()
+
+		// The remote party has held the channel quiescent for longer
+		// than we allow, so we'll disconnect it, which terminates the
+		// quiescence session on both ends.
+		case <-l.quiescenceTimeout():
+			l.fail(LinkFailureError{
+				code:       ErrQuiescenceTimeout,
+				Disconnect: true,
+			}, "remote party held the channel quiescent for too "+
+				"long")
+
+		// Another subsystem has proposed new flow constraints for the
+		// channel, which we'll send to the remote party if the
+		// channel is quiescent.
+		case req := <-l.dynUpdateReqs:
+			l.handleDynUpdateReq(req)
+
+		// A multi-part payment that we're holding parts of has either
+		// completed or been cancelled, so we'll settle or fail the
+		// part we're holding accordingly.
+		case res := <-mppResolutions:
+			if err := l.resolveMPPHTLC(res); err != nil {
+				l.fail(LinkFailureError{code: ErrInternalError},
+					"unable to resolve mpp htlc: %v", err)
+				break out
+			}
+
+			if err := l.updateCommitTx(); err != nil {
+				l.fail(LinkFailureError{code: ErrInternalError},
+					"unable to update commitment: %v", err)
+				break out
+			}
+
 		case <-l.quit:
 			break out
 		}
@@ -1369,6 +2074,20 @@ func (l *channelLink) cleanupSpuriousResponse(pkt *htlcPacket) {
 // updates from the upstream peer. The upstream peer is the peer whom we have a
 // direct channel with, updating our respective commitment chains.
 func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
+	// Once the remote party has sent us their Stfu, they may not propose
+	// any further updates until quiescence is terminated.
+	if !l.quiescer.canRecvUpdates() {
+		switch msg.(type) {
+		case *lnwire.UpdateAddHTLC, *lnwire.UpdateFulfillHTLC,
+			*lnwire.UpdateFailHTLC, *lnwire.UpdateFailMalformedHTLC,
+			*lnwire.UpdateFee:
+
+			l.fail(LinkFailureError{code: ErrInvalidUpdate},
+				"received %v after stfu", msg.MsgType())
+			return
+		}
+	}
+
 	switch msg := msg.(type) {
 
 	case *lnwire.UpdateAddHTLC:
@@ -1501,7 +2220,15 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			log.Errorf("unable to revoke commitment: %v", err)
 			return
 		}
-		l.cfg.Peer.SendMessage(false, nextRevocation)
+
+		// If hodl.Revoke mode is active, we won't deliver the
+		// revocation, which will instead be retransmitted once the
+		// channel is reestablished.
+		if l.cfg.DebugHTLC && l.cfg.HodlMask.Active(hodl.Revoke) {
+			l.warnf(hodl.Revoke.Warning())
+		} else {
+			l.cfg.Peer.SendMessage(false, nextRevocation)
+		}
 
 		// Since we just revoked our commitment, we may have a new set
 		// of HTLC's on our commitment, so we'll send them over our
@@ -1565,7 +2292,13 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			return
 		}
 
-		if needUpdate {
+		// The revocation has opened up our window, so any updates that
+		// accumulated while our previous commitment was in flight can
+		// now be coalesced into a single new commitment. Rather than
+		// waiting for the next batch tick, we sign for everything that
+		// is owed right away. As our window only holds a single
+		// unrevoked commitment, this is as soon as we may sign again.
+		if needUpdate || l.channel.OweCommitment() {
 			if err := l.updateCommitTx(); err != nil {
 				l.fail(LinkFailureError{code: ErrInternalError},
 					"unable to update commitment: %v", err)
@@ -1582,6 +2315,48 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 				"error receiving fee update: %v", err)
 			return
 		}
+
+	case *lnwire.Stfu:
+		// The remote party wishes to quiesce the channel, or is
+		// responding to our own request to do so. In either case,
+		// we'll stop forwarding new HTLCs over the link, and send our
+		// own Stfu once all pending updates have been committed.
+		if !l.cfg.QuiescenceSupported {
+			l.fail(LinkFailureError{code: ErrInvalidUpdate},
+				"received stfu, but quiescence wasn't "+
+					"negotiated")
+			return
+		}
+		if err := l.quiescer.recvStfu(msg); err != nil {
+			l.fail(LinkFailureError{code: ErrInvalidUpdate},
+				"unable to handle stfu: %v", err)
+			return
+		}
+		atomic.StoreInt32(&l.quiescing, 1)
+
+		// Only we are able to end a quiescence session that we
+		// initiated. Otherwise, the session is left to the remote
+		// party, so we'll bound how long it may hold the channel
+		// quiescent.
+		if !l.quiescer.isInitiator() {
+			l.startQuiescenceTimer()
+		}
+
+	case *lnwire.DynPropose:
+		if !l.cfg.DynamicCommitmentsSupported {
+			l.fail(LinkFailureError{code: ErrInvalidUpdate},
+				"received dyn_propose, but dynamic "+
+					"commitments weren't negotiated")
+			return
+		}
+		l.handleDynPropose(msg)
+
+	case *lnwire.DynAck:
+		l.handleDynAck(msg)
+
+	case *lnwire.DynReject:
+		l.handleDynReject(msg)
+
 	case *lnwire.Error:
 		// Error received from remote, MUST fail channel, but should
 		// only print the contents of the error message if all
@@ -1705,7 +2480,15 @@ func (l *channelLink) updateCommitTx() error {
 		CommitSig: theirCommitSig,
 		HtlcSigs:  htlcSigs,
 	}
-	l.cfg.Peer.SendMessage(false, commitSig)
+
+	// If hodl.CommitSig mode is active, we won't deliver the signature,
+	// which will instead be retransmitted once the channel is
+	// reestablished.
+	if l.cfg.DebugHTLC && l.cfg.HodlMask.Active(hodl.CommitSig) {
+		l.warnf(hodl.CommitSig.Warning())
+	} else {
+		l.cfg.Peer.SendMessage(false, commitSig)
+	}
 
 	// We've just initiated a state transition, attempt to stop the
 	// logCommitTimer. If the timer already ticked, then we'll consume the
@@ -1825,7 +2608,30 @@ func (l *channelLink) Bandwidth() lnwire.MilliSatoshi {
 	// Else the amount that is available to flow through the link at this
 	// point is the available balance minus the reserve amount we are
 	// required to keep as collateral.
-	return linkBandwidth - reserve
+	linkBandwidth -= reserve
+
+	// Finally, the remote party limits the total value of the HTLCs we
+	// can have in flight, so we can't offer more than what remains of
+	// that limit once the overflow queue has been drained.
+	inFlightBandwidth, _ := l.channel.AvailableInFlight()
+	if inFlightBandwidth < overflowBandwidth {
+		return 0
+	}
+	inFlightBandwidth -= overflowBandwidth
+
+	if inFlightBandwidth < linkBandwidth {
+		return inFlightBandwidth
+	}
+
+	return linkBandwidth
+}
+
+// OutgoingFlowConstraints returns the flow constraints imposed by the remote
+// party on the HTLCs we may offer over the link.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) OutgoingFlowConstraints() lnwallet.FlowConstraints {
+	return l.channel.CurrentFlowConstraints(true)
 }
 
 // AttachMailBox updates the current mailbox used by this link, and hooks up
@@ -1866,6 +2672,25 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 	if newPolicy.MinHTLC != 0 {
 		l.cfg.FwrdingPolicy.MinHTLC = newPolicy.MinHTLC
 	}
+	if newPolicy.MaxHTLC != 0 {
+		l.cfg.FwrdingPolicy.MaxHTLC = newPolicy.MaxHTLC
+	}
+	if newPolicy.InboundFee != nil {
+		l.cfg.FwrdingPolicy.InboundFee = newPolicy.InboundFee
+	}
+}
+
+// inboundFee returns the inbound fee charged for an HTLC of the given amount
+// entering through this link.
+func (l *channelLink) inboundFee(amt lnwire.MilliSatoshi) int64 {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.cfg.FwrdingPolicy.InboundFee == nil {
+		return 0
+	}
+
+	return l.cfg.FwrdingPolicy.InboundFee.CalcFee(amt)
 }
 
 // HtlcSatifiesPolicy should return a nil error if the passed HTLC details
@@ -1875,7 +2700,7 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 //
 // NOTE: Part of the ChannelLink interface.
 func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
-	incomingHtlcAmt, amtToForward lnwire.MilliSatoshi,
+	incomingHtlcAmt, amtToForward lnwire.MilliSatoshi, inboundFee int64,
 	incomingTimeout, outgoingTimeout uint32,
 	heightNow uint32) lnwire.FailureMessage {
 
@@ -1906,20 +2731,44 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 		return failure
 	}
 
+	// Similarly, we'll ensure that the HTLC doesn't exceed the maximum
+	// HTLC of the link, if any, such that a single payment can't consume
+	// all of its liquidity.
+	if policy.MaxHTLC != 0 && amtToForward > policy.MaxHTLC {
+		l.errorf("outgoing htlc(%x) is too large: max_htlc=%v, "+
+			"htlc_value=%v", payHash[:], policy.MaxHTLC,
+			amtToForward)
+
+		var failure lnwire.FailureMessage
+		update, err := l.cfg.FetchLastChannelUpdate(l.ShortChanID())
+		if err != nil {
+			failure = &lnwire.FailTemporaryNodeFailure{}
+		} else {
+			failure = lnwire.NewTemporaryChannelFailure(update)
+		}
+
+		return failure
+	}
+
 	// Next, using the amount of the incoming HTLC, we'll calculate the
 	// expected fee this incoming HTLC must carry in order to satisfy the
-	// constraints of the outgoing link.
-	expectedFee := ExpectedFee(policy, amtToForward)
+	// constraints of the outgoing link. The inbound fee of the incoming
+	// link is added on top, which may lower the expected fee, though
+	// never below zero.
+	expectedFee := int64(ExpectedFee(policy, amtToForward)) + inboundFee
+	if expectedFee < 0 {
+		expectedFee = 0
+	}
 
 	// If the actual fee is less than our expected fee, then we'll reject
 	// this HTLC as it didn't provide a sufficient amount of fees, or the
 	// values have been tampered with, or the send used incorrect/dated
 	// information to construct the forwarding information for this hop. In
 	// any case, we'll cancel this HTLC.
-	actualFee := incomingHtlcAmt - amtToForward
-	if incomingHtlcAmt < amtToForward || actualFee < expectedFee {
+	actualFee := int64(incomingHtlcAmt) - int64(amtToForward)
+	if actualFee < expectedFee {
 		l.errorf("outgoing htlc(%x) has insufficient fee: expected %v, "+
-			"got %v", payHash[:], int64(expectedFee), int64(actualFee))
+			"got %v", payHash[:], expectedFee, actualFee)
 
 		// As part of the returned error, we'll send our latest routing
 		// policy so the sending node obtains the most up to date data.
@@ -2006,6 +2855,31 @@ func (l *channelLink) Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSatoshi)
 		snapshot.TotalMSatReceived
 }
 
+// PendingHTLCs returns a snapshot of the HTLCs pending within the current
+// local commitment of the channel.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) PendingHTLCs() []HTLCSnapshot {
+	snapshot := l.channel.StateSnapshot()
+	shortChanID := l.ShortChanID()
+
+	htlcs := make([]HTLCSnapshot, 0, len(snapshot.Htlcs))
+	for _, htlc := range snapshot.Htlcs {
+		htlcs = append(htlcs, HTLCSnapshot{
+			HtlcIndex:   htlc.HtlcIndex,
+			Incoming:    htlc.Incoming,
+			Amount:      htlc.Amt,
+			PaymentHash: htlc.RHash,
+			Expiry:      htlc.RefundTimeout,
+			Forwarded: l.cfg.Switch.IsForwardedHTLC(
+				shortChanID, htlc.HtlcIndex, htlc.Incoming,
+			),
+		})
+	}
+
+	return htlcs
+}
+
 // String returns the string representation of channel link.
 //
 // NOTE: Part of the ChannelLink interface.
@@ -2269,6 +3143,35 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			continue
 		}
 
+		// If the channel is being drained, then we'll fail back any
+		// new HTLC, whether it pays us or is to be forwarded. HTLCs
+		// that were already processed before the channel was frozen
+		// are replayed as usual.
+		if fwdPkg.State == channeldb.FwdStateLockedIn &&
+			l.cfg.Switch.IsLinkFrozen(l.ChanID()) {
+
+			log.Debugf("Failing htlc(%x) received over frozen "+
+				"ChannelLink(%v)", pd.RHash[:], l)
+
+			var failure lnwire.FailureMessage
+			update, err := l.cfg.FetchLastChannelUpdate(
+				l.ShortChanID(),
+			)
+			if err != nil {
+				failure = &lnwire.FailTemporaryNodeFailure{}
+			} else {
+				failure = lnwire.NewTemporaryChannelFailure(
+					update,
+				)
+			}
+
+			l.sendHTLCError(
+				pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+			)
+			needUpdate = true
+			continue
+		}
+
 		heightNow := l.cfg.Switch.BestHeight()
 
 		fwdInfo := chanIterator.ForwardingInstructions()
@@ -2299,16 +3202,39 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				continue
 			}
 
+			// If this peer's recent attempts to pay us keep
+			// failing, then it may be probing the balances of the
+			// channels leading to us, so we'll fail the htlc back
+			// without consulting the registry.
+			invoiceHash := chainhash.Hash(pd.RHash)
+			peerKey := l.cfg.Peer.PubKey()
+			probeGuard := l.cfg.Switch.probeGuard
+			probeFailure := probeGuard.check(peerKey, invoiceHash)
+			if probeFailure != nil {
+				log.Debugf("Failing htlc(%x) from throttled "+
+					"peer %x: %v", pd.RHash[:], peerKey,
+					probeFailure)
+
+				l.sendHTLCError(
+					pd.HtlcIndex, probeFailure, obfuscator,
+					pd.SourceRef,
+				)
+
+				needUpdate = true
+				continue
+			}
+
 			// We're the designated payment destination.  Therefore
 			// we attempt to see if we have an invoice locally
 			// which'll allow us to settle this htlc.
-			invoiceHash := chainhash.Hash(pd.RHash)
 			invoice, minCltvDelta, err := l.cfg.Registry.LookupInvoice(
 				invoiceHash,
 			)
 			if err != nil {
 				log.Errorf("unable to query invoice registry: "+
 					" %v", err)
+				probeGuard.recordFailure(peerKey, invoiceHash)
+
 				failure := lnwire.FailUnknownPaymentHash{}
 				l.sendHTLCError(
 					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
@@ -2340,6 +3266,40 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					"hash=%x", pd.RHash[:])
 			}
 
+			// If the htlc is part of a multi-part payment, then
+			// the invoice is paid by the total amount of the set,
+			// rather than by this htlc alone. We'll still ensure
+			// that the htlc carries the amount of its part.
+			isMPP := fwdInfo.TotalAmount != 0
+			paidAmt := pd.Amount
+			onionAmt := fwdInfo.AmountToForward
+			if isMPP {
+				paidAmt = fwdInfo.TotalAmount
+				onionAmt = fwdInfo.TotalAmount
+			}
+
+			if isMPP && !l.cfg.DebugHTLC &&
+				pd.Amount < fwdInfo.AmountToForward {
+
+				log.Errorf("Incoming htlc(%x) has incorrect "+
+					"amount for its part: expected %v, "+
+					"got %v", pd.RHash[:],
+					fwdInfo.AmountToForward, pd.Amount)
+
+				probeGuard.recordFailure(peerKey, invoiceHash)
+
+				failure := lnwire.NewFinalIncorrectHtlcAmount(
+					pd.Amount,
+				)
+				l.sendHTLCError(
+					pd.HtlcIndex, failure, obfuscator,
+					pd.SourceRef,
+				)
+
+				needUpdate = true
+				continue
+			}
+
 			// If we're not currently in debug mode, and the
 			// extended htlc doesn't meet the value requested, then
 			// we'll fail the htlc.  Otherwise, we settle this htlc
@@ -2352,11 +3312,13 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// they wish to send.  So since we expect the htlc to
 			// have a different amount, we should not fail.
 			if !l.cfg.DebugHTLC && invoice.Terms.Value > 0 &&
-				pd.Amount < invoice.Terms.Value {
+				paidAmt < invoice.Terms.Value {
 
 				log.Errorf("rejecting htlc due to incorrect "+
 					"amount: expected %v, received %v",
-					invoice.Terms.Value, pd.Amount)
+					invoice.Terms.Value, paidAmt)
+
+				probeGuard.recordFailure(peerKey, invoiceHash)
 
 				failure := lnwire.FailIncorrectPaymentAmount{}
 				l.sendHTLCError(
@@ -2378,12 +3340,14 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// they wish to send.  So since we expect the htlc to
 			// have a different amount, we should not fail.
 			if !l.cfg.DebugHTLC && invoice.Terms.Value > 0 &&
-				fwdInfo.AmountToForward < invoice.Terms.Value {
+				onionAmt < invoice.Terms.Value {
 
 				log.Errorf("Onion payload of incoming htlc(%x) "+
 					"has incorrect value: expected %v, "+
 					"got %v", pd.RHash, invoice.Terms.Value,
-					fwdInfo.AmountToForward)
+					onionAmt)
+
+				probeGuard.recordFailure(peerKey, invoiceHash)
 
 				failure := lnwire.FailIncorrectPaymentAmount{}
 				l.sendHTLCError(
@@ -2433,6 +3397,36 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				continue
 			}
 
+			// Unless the invoice has already been settled, we'll
+			// hold the parts of a multi-part payment until the
+			// whole set has arrived, so that they're either all
+			// settled or all failed back together.
+			if isMPP && !invoice.Terms.Settled {
+				key := CircuitKey{
+					ChanID: l.ShortChanID(),
+					HtlcID: pd.HtlcIndex,
+				}
+				part := &mppHTLC{
+					amt:         pd.Amount,
+					resolutions: l.mppResolutions,
+					linkQuit:    l.quit,
+				}
+				part.resolution = mppResolution{
+					htlcIndex:   pd.HtlcIndex,
+					sourceRef:   pd.SourceRef,
+					obfuscator:  obfuscator,
+					paymentHash: invoiceHash,
+				}
+				l.cfg.Switch.mppSets.addHTLC(
+					key, fwdInfo.TotalAmount,
+					invoice.Terms.PaymentPreimage, part,
+				)
+
+				l.debugf("holding htlc(%x) as part of "+
+					"multi-part payment", pd.RHash[:])
+				continue
+			}
+
 			preimage := invoice.Terms.PaymentPreimage
 			err = l.channel.SettleHTLC(
 				preimage, pd.HtlcIndex, pd.SourceRef, nil, nil,
@@ -2506,6 +3500,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					Expiry:      fwdInfo.OutgoingCTLV,
 					Amount:      fwdInfo.AmountToForward,
 					PaymentHash: pd.RHash,
+					Endorsed:    pd.Endorsed,
 				}
 
 				// Finally, we'll encode the onion packet for
@@ -2524,6 +3519,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					outgoingChanID:  fwdInfo.NextHop,
 					sourceRef:       pd.SourceRef,
 					incomingAmount:  pd.Amount,
+					inboundFee:      l.inboundFee(pd.Amount),
 					amount:          addMsg.Amount,
 					htlc:            addMsg,
 					obfuscator:      obfuscator,
@@ -2547,6 +3543,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				Expiry:      fwdInfo.OutgoingCTLV,
 				Amount:      fwdInfo.AmountToForward,
 				PaymentHash: pd.RHash,
+				Endorsed:    pd.Endorsed,
 			}
 
 			// Finally, we'll encode the onion packet for the
@@ -2592,6 +3589,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					outgoingChanID:  fwdInfo.NextHop,
 					sourceRef:       pd.SourceRef,
 					incomingAmount:  pd.Amount,
+					inboundFee:      l.inboundFee(pd.Amount),
 					amount:          addMsg.Amount,
 					htlc:            addMsg,
 					obfuscator:      obfuscator,
@@ -2673,6 +3671,47 @@ func (l *channelLink) handleBatchFwdErrs(errChan chan error) {
 	}
 }
 
+// resolveMPPHTLC settles or fails an htlc that the link has been holding as
+// part of a multi-part payment, according to the resolution of its set.
+func (l *channelLink) resolveMPPHTLC(res *mppResolution) error {
+	if res.failure != nil {
+		l.infof("failing %x as part of cancelled multi-part payment: %v",
+			res.paymentHash[:], res.failure)
+
+		l.sendHTLCError(
+			res.htlcIndex, res.failure, res.obfuscator,
+			res.sourceRef,
+		)
+		return nil
+	}
+
+	preimage := *res.preimage
+	err := l.channel.SettleHTLC(
+		preimage, res.htlcIndex, res.sourceRef, nil, nil,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to settle htlc: %v", err)
+	}
+
+	// Settling an invoice is idempotent, so each link holding a part of
+	// the payment settles it with the total amount received by the set.
+	err = l.cfg.Registry.SettleInvoice(res.paymentHash, res.setTotal)
+	if err != nil {
+		return fmt.Errorf("unable to settle invoice: %v", err)
+	}
+
+	l.infof("settling %x as exit hop of multi-part payment",
+		res.paymentHash[:])
+
+	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFulfillHTLC{
+		ChanID:          l.ChanID(),
+		ID:              res.htlcIndex,
+		PaymentPreimage: preimage,
+	})
+
+	return nil
+}
+
 // sendHTLCError functions cancels HTLC and send cancel message back to the
 // peer from which HTLC was received.
 func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMessage,
@@ -12,6 +12,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -387,6 +388,91 @@ func TestChannelLinkBidirectionalOneHopPayments(t *testing.T) {
 	t.Logf("Average waiting: %v", time.Duration(int(averageDelay)/count))
 }
 
+// BenchmarkChannelLinkOneHopPayments measures the throughput of a single
+// channel link when many payments are in flight concurrently. As the link
+// coalesces pending updates into a single commitment whenever its revocation
+// window opens, the number of commit_sig/revoke_and_ack exchanges should grow
+// much slower than the number of payments, so we report the number of
+// exchanges per payment alongside the timing.
+func BenchmarkChannelLinkOneHopPayments(b *testing.B) {
+	channels, cleanUp, _, err := createClusterChannels(
+		btcutil.SatoshiPerBitcoin*3,
+		btcutil.SatoshiPerBitcoin*5)
+	if err != nil {
+		b.Fatalf("unable to create channel: %v", err)
+	}
+	defer cleanUp()
+
+	n := newThreeHopNetwork(b, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	if err := n.start(); err != nil {
+		b.Fatal(err)
+	}
+	defer n.stop()
+
+	// Count the commitment signatures and revocations exchanged over the
+	// channel between Alice and Bob in either direction.
+	var numCommitSigs, numRevocations uint64
+	countExchanges := func(chanID lnwire.ChannelID) messageInterceptor {
+		return func(m lnwire.Message) (bool, error) {
+			switch msg := m.(type) {
+			case *lnwire.CommitSig:
+				if msg.ChanID == chanID {
+					atomic.AddUint64(&numCommitSigs, 1)
+				}
+			case *lnwire.RevokeAndAck:
+				if msg.ChanID == chanID {
+					atomic.AddUint64(&numRevocations, 1)
+				}
+			}
+
+			return false, nil
+		}
+	}
+	chanID := n.aliceChannelLink.ChanID()
+	n.aliceServer.intersect(countExchanges(chanID))
+	n.bobServer.intersect(countExchanges(chanID))
+
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	htlcAmt, totalTimelock, hops := generateHops(amt, testStartingHeight,
+		n.firstBobChannelLink)
+	firstHop := n.firstBobChannelLink.ShortChanID()
+
+	// Bound the number of concurrent payments so that we never exceed the
+	// maximum number of HTLCs the commitment can hold.
+	inFlight := make(chan struct{}, lnwallet.MaxHTLCNumber/2)
+	errChan := make(chan error, b.N)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		inFlight <- struct{}{}
+		go func() {
+			defer func() { <-inFlight }()
+
+			_, err := n.makePayment(
+				n.aliceServer, n.bobServer, firstHop, hops,
+				amt, htlcAmt, totalTimelock,
+			).Wait(time.Minute)
+			errChan <- err
+		}()
+	}
+
+	for i := 0; i < b.N; i++ {
+		if err := <-errChan; err != nil {
+			b.Fatalf("unable to make payment: %v", err)
+		}
+	}
+	b.StopTimer()
+
+	// Without coalescing, each payment would take two exchanges: one to
+	// lock in the add and one to lock in the settle.
+	commitSigs := atomic.LoadUint64(&numCommitSigs)
+	revocations := atomic.LoadUint64(&numRevocations)
+	b.Logf("%d payments: %d commit_sig, %d revoke_and_ack "+
+		"(%.2f exchanges per payment)", b.N, commitSigs, revocations,
+		float64(revocations)/float64(b.N))
+}
+
 // TestChannelLinkMultiHopPayment checks the ability to send payment over two
 // hops. In this test we send the payment from Carol to Alice over Bob peer.
 // (Carol -> Bob -> Alice) and checking that HTLC was settled properly and
@@ -3459,7 +3545,7 @@ func TestShouldAdjustCommitFee(t *testing.T) {
 
 	for i, test := range tests {
 		adjustedFee := shouldAdjustCommitFee(
-			test.netFee, test.chanFee,
+			test.netFee, test.chanFee, DefaultLinkFeeUpdateBand,
 		)
 
 		if adjustedFee && !test.shouldAdjust {
@@ -3471,6 +3557,39 @@ func TestShouldAdjustCommitFee(t *testing.T) {
 	}
 }
 
+// TestShouldAdjustCommitFeeBand asserts that shouldAdjustCommitFee respects
+// the configured band in both directions.
+func TestShouldAdjustCommitFeeBand(t *testing.T) {
+	t.Parallel()
+
+	const (
+		chanFee = lnwallet.SatPerKWeight(1000)
+		band    = 0.5
+	)
+
+	tests := []struct {
+		netFee       lnwallet.SatPerKWeight
+		shouldAdjust bool
+	}{
+		{netFee: 1200, shouldAdjust: false},
+		{netFee: 1499, shouldAdjust: false},
+		{netFee: 1500, shouldAdjust: true},
+		{netFee: 800, shouldAdjust: false},
+		{netFee: 501, shouldAdjust: false},
+		{netFee: 500, shouldAdjust: true},
+	}
+
+	for i, test := range tests {
+		adjust := shouldAdjustCommitFee(test.netFee, chanFee, band)
+		if adjust != test.shouldAdjust {
+			t.Fatalf("test #%v failed: net_fee=%v, chan_fee=%v, "+
+				"band=%v, adjust_expect=%v, adjust_returned=%v",
+				i, test.netFee, chanFee, band,
+				test.shouldAdjust, adjust)
+		}
+	}
+}
+
 // TestChannelLinkShutdownDuringForward asserts that a link can be fully
 // stopped when it is trying to send synchronously through the switch. The
 // specific case this can occur is when a link forwards incoming Adds. We test
@@ -4500,6 +4619,146 @@ func TestChannelLinkWaitForRevocation(t *testing.T) {
 	}
 }
 
+// TestChannelLinkCoalesceOnRevocation tests that the updates which accumulate
+// while our latest commitment is still awaiting a revocation are all covered
+// by a single new commitment, which is sent as soon as the remote party's
+// revocation opens up our window again.
+func TestChannelLinkCoalesceOnRevocation(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = btcutil.SatoshiPerBitcoin * 5
+	aliceLink, bobChannel, batchTick, start, cleanUp, _, err :=
+		newSingleLinkTestHarness(chanAmt, 0)
+	if err != nil {
+		t.Fatalf("unable to create link: %v", err)
+	}
+	defer cleanUp()
+
+	if err := start(); err != nil {
+		t.Fatalf("unable to start test harness: %v", err)
+	}
+
+	var (
+		mockBlob  [lnwire.OnionPacketSize]byte
+		coreLink  = aliceLink.(*channelLink)
+		aliceMsgs = coreLink.cfg.Peer.(*mockPeer).sentMsgs
+		htlcAmt   = lnwire.NewMSatFromSatoshis(100000)
+	)
+
+	// newAddPkt creates a switch initiated payment, and commits its
+	// circuit such that Alice's link will forward it to Bob.
+	newAddPkt := func(id uint64) *htlcPacket {
+		_, htlc, err := generatePayment(htlcAmt, htlcAmt, 5, mockBlob)
+		if err != nil {
+			t.Fatalf("unable to create payment: %v", err)
+		}
+		addPkt := &htlcPacket{
+			htlc:           htlc,
+			incomingChanID: sourceHop,
+			incomingHTLCID: id,
+			obfuscator:     NewMockObfuscator(),
+		}
+
+		circuit := makePaymentCircuit(&htlc.PaymentHash, addPkt)
+		_, err = coreLink.cfg.Switch.commitCircuits(&circuit)
+		if err != nil {
+			t.Fatalf("unable to commit circuit: %v", err)
+		}
+		addPkt.circuit = &circuit
+
+		return addPkt
+	}
+
+	// receiveAdd waits for Alice to send an HTLC to Bob, then hands it to
+	// Bob.
+	receiveAdd := func() {
+		var msg lnwire.Message
+		select {
+		case msg = <-aliceMsgs:
+		case <-time.After(15 * time.Second):
+			t.Fatalf("did not receive message")
+		}
+
+		addHtlc, ok := msg.(*lnwire.UpdateAddHTLC)
+		if !ok {
+			t.Fatalf("expected UpdateAddHTLC, got %T", msg)
+		}
+		if _, err := bobChannel.ReceiveHTLC(addHtlc); err != nil {
+			t.Fatalf("bob failed receiving htlc: %v", err)
+		}
+	}
+
+	// First, Alice adds a single HTLC and signs for it on the next batch
+	// tick. We don't let Bob revoke yet, which leaves Alice's commitment
+	// in flight.
+	if err := aliceLink.HandleSwitchPacket(newAddPkt(0)); err != nil {
+		t.Fatalf("unable to handle switch packet: %v", err)
+	}
+	receiveAdd()
+
+	select {
+	case batchTick <- time.Now():
+	case <-time.After(15 * time.Second):
+		t.Fatalf("unable to tick batch ticker")
+	}
+	receiveCommitSigAliceToBob(t, aliceMsgs, aliceLink, bobChannel, 1)
+
+	// Now we'll concurrently hand Alice's link a number of additional
+	// payments. All of them should be forwarded to Bob straight away.
+	const numHtlcs = 5
+	pkts := make([]*htlcPacket, numHtlcs)
+	for i := range pkts {
+		pkts[i] = newAddPkt(uint64(i + 1))
+	}
+
+	var wg sync.WaitGroup
+	for _, pkt := range pkts {
+		wg.Add(1)
+		go func(pkt *htlcPacket) {
+			defer wg.Done()
+
+			if err := aliceLink.HandleSwitchPacket(pkt); err != nil {
+				t.Errorf("unable to handle switch packet: %v",
+					err)
+			}
+		}(pkt)
+	}
+	wg.Wait()
+
+	for i := 0; i < numHtlcs; i++ {
+		receiveAdd()
+	}
+
+	// Since her revocation window is exhausted, Alice shouldn't be able
+	// to sign for the new HTLCs, even if the batch ticker fires.
+	select {
+	case batchTick <- time.Now():
+	case <-time.After(15 * time.Second):
+		t.Fatalf("unable to tick batch ticker")
+	}
+	select {
+	case msg := <-aliceMsgs:
+		t.Fatalf("did not expect message %T", msg)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once Bob revokes his prior commitment, Alice should immediately
+	// send a single commitment covering all the HTLCs, without waiting
+	// for the batch ticker to fire.
+	sendRevAndAckBobToAlice(t, aliceLink, bobChannel)
+	receiveCommitSigAliceToBob(
+		t, aliceMsgs, aliceLink, bobChannel, numHtlcs+1,
+	)
+
+	// All updates are now covered, so no further signatures should be
+	// sent.
+	select {
+	case msg := <-aliceMsgs:
+		t.Fatalf("did not expect message %T", msg)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 // TestChannelLinkCleanupSpuriousResponses tests that we properly cleanup
 // references in the event that internal retransmission continues as a result of
 // not properly cleaning up Add/SettleFailRefs.
@@ -5143,6 +5402,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 			FwrdingPolicy: ForwardingPolicy{
 				TimeLockDelta: 20,
 				MinHTLC:       500,
+				MaxHTLC:       5000,
 				BaseFee:       10,
 			},
 			FetchLastChannelUpdate: fetchLastChannelUpdate,
@@ -5152,7 +5412,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 	var hash [32]byte
 
 	t.Run("satisfied", func(t *testing.T) {
-		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
+		result := link.HtlcSatifiesPolicy(hash, 1500, 1000, 0,
 			200, 150, 0)
 		if result != nil {
 			t.Fatalf("expected policy to be satisfied")
@@ -5160,15 +5420,42 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 	})
 
 	t.Run("below minhtlc", func(t *testing.T) {
-		result := link.HtlcSatifiesPolicy(hash, 100, 50,
+		result := link.HtlcSatifiesPolicy(hash, 100, 50, 0,
 			200, 150, 0)
 		if _, ok := result.(*lnwire.FailAmountBelowMinimum); !ok {
 			t.Fatalf("expected FailAmountBelowMinimum failure code")
 		}
 	})
 
+	t.Run("above maxhtlc", func(t *testing.T) {
+		result := link.HtlcSatifiesPolicy(hash, 6500, 6000, 0,
+			200, 150, 0)
+		if _, ok := result.(*lnwire.FailTemporaryChannelFailure); !ok {
+			t.Fatalf("expected FailTemporaryChannelFailure " +
+				"failure code")
+		}
+	})
+
 	t.Run("insufficient fee", func(t *testing.T) {
-		result := link.HtlcSatifiesPolicy(hash, 1005, 1000,
+		result := link.HtlcSatifiesPolicy(hash, 1005, 1000, 0,
+			200, 150, 0)
+		if _, ok := result.(*lnwire.FailFeeInsufficient); !ok {
+			t.Fatalf("expected FailFeeInsufficient failure code")
+		}
+	})
+
+	t.Run("inbound fee discount", func(t *testing.T) {
+		// A discount of 5 msat on the incoming link lowers the expected
+		// fee below the 5 msat carried by the HTLC.
+		result := link.HtlcSatifiesPolicy(hash, 1005, 1000, -5,
+			200, 150, 0)
+		if result != nil {
+			t.Fatalf("expected policy to be satisfied")
+		}
+	})
+
+	t.Run("inbound fee surcharge", func(t *testing.T) {
+		result := link.HtlcSatifiesPolicy(hash, 1010, 1000, 5,
 			200, 150, 0)
 		if _, ok := result.(*lnwire.FailFeeInsufficient); !ok {
 			t.Fatalf("expected FailFeeInsufficient failure code")
@@ -5176,7 +5463,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 	})
 
 	t.Run("expiry too soon", func(t *testing.T) {
-		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
+		result := link.HtlcSatifiesPolicy(hash, 1500, 1000, 0,
 			200, 150, 190)
 		if _, ok := result.(*lnwire.FailExpiryTooSoon); !ok {
 			t.Fatalf("expected FailExpiryTooSoon failure code")
@@ -5184,7 +5471,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 	})
 
 	t.Run("incorrect cltv expiry", func(t *testing.T) {
-		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
+		result := link.HtlcSatifiesPolicy(hash, 1500, 1000, 0,
 			200, 190, 0)
 		if _, ok := result.(*lnwire.FailIncorrectCltvExpiry); !ok {
 			t.Fatalf("expected FailIncorrectCltvExpiry failure code")
@@ -5194,7 +5481,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("cltv expiry too far in the future", func(t *testing.T) {
 		// Check that expiry isn't too far in the future.
-		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
+		result := link.HtlcSatifiesPolicy(hash, 1500, 1000, 0,
 			10200, 10100, 0)
 		if _, ok := result.(*lnwire.FailExpiryTooFar); !ok {
 			t.Fatalf("expected FailExpiryTooFar failure code")
@@ -34,6 +34,10 @@ const (
 	// ErrInvalidRevocation indicates that the remote peer send us an
 	// invalid revocation message.
 	ErrInvalidRevocation
+
+	// ErrQuiescenceTimeout indicates that the remote peer held the
+	// channel quiescent for longer than we allow.
+	ErrQuiescenceTimeout
 )
 
 // LinkFailureError encapsulates an error that will make us fail the current
@@ -51,6 +55,11 @@ type LinkFailureError struct {
 	// SendData is a byte slice that will be sent to the peer. If nil a
 	// generic error will be sent.
 	SendData []byte
+
+	// Disconnect indicates whether we should disconnect from the peer
+	// because of this error, resetting any state that only lasts for the
+	// duration of the connection.
+	Disconnect bool
 }
 
 // A compile time check to ensure LinkFailureError implements the error
@@ -74,6 +83,8 @@ func (e LinkFailureError) Error() string {
 		return "invalid commitment"
 	case ErrInvalidRevocation:
 		return "invalid revocation"
+	case ErrQuiescenceTimeout:
+		return "quiescence timeout"
 	default:
 		return "unknown error"
 	}
@@ -88,6 +99,11 @@ func (e LinkFailureError) ShouldSendToPeer() bool {
 	case ErrRemoteError:
 		return false
 
+	// If the peer held the channel quiescent for too long, we'll merely
+	// disconnect it, as an error would prompt it to fail the channel.
+	case ErrQuiescenceTimeout:
+		return false
+
 	// In all other cases we will attempt to send our peer an error message.
 	default:
 		return true
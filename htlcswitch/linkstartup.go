@@ -0,0 +1,69 @@
+package htlcswitch
+
+import (
+	"math/rand"
+	"time"
+)
+
+// linkStartupGate staggers the startup of the links, during which they
+// re-establish their channel state with the remote peer and replay their
+// forwarding packages. Without it, a node with many channels would have all
+// of its links hammering the database and the peer connections at once when
+// booting.
+type linkStartupGate struct {
+	// slots bounds the number of links starting up concurrently. It's nil
+	// if the number of concurrent startups is unbounded.
+	slots chan struct{}
+
+	// jitter is the maximum random delay applied before each link starts
+	// up. A value of zero disables the delay.
+	jitter time.Duration
+}
+
+// newLinkStartupGate creates a new gate which lets at most maxConcurrent links
+// start up at once, each of them after a random delay of up to jitter. If
+// maxConcurrent is zero, the number of concurrent startups is unbounded.
+func newLinkStartupGate(maxConcurrent int,
+	jitter time.Duration) *linkStartupGate {
+
+	g := &linkStartupGate{
+		jitter: jitter,
+	}
+	if maxConcurrent > 0 {
+		g.slots = make(chan struct{}, maxConcurrent)
+	}
+
+	return g
+}
+
+// acquire blocks until the link is allowed to start up, and returns a closure
+// that must be called once its startup has completed. If either quit channel
+// is closed while waiting, then ErrLinkShuttingDown is returned.
+func (g *linkStartupGate) acquire(linkQuit,
+	switchQuit <-chan struct{}) (func(), error) {
+
+	if g.jitter > 0 {
+		delay := time.Duration(rand.Int63n(int64(g.jitter)))
+		select {
+		case <-time.After(delay):
+		case <-linkQuit:
+			return nil, ErrLinkShuttingDown
+		case <-switchQuit:
+			return nil, ErrLinkShuttingDown
+		}
+	}
+
+	if g.slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case g.slots <- struct{}{}:
+	case <-linkQuit:
+		return nil, ErrLinkShuttingDown
+	case <-switchQuit:
+		return nil, ErrLinkShuttingDown
+	}
+
+	return func() { <-g.slots }, nil
+}
@@ -0,0 +1,74 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+)
+
+// TestLinkStartupGate asserts that the link startup gate bounds the number of
+// links starting up concurrently, and that links waiting for their turn
+// can bail out once shut down.
+func TestLinkStartupGate(t *testing.T) {
+	t.Parallel()
+
+	gate := newLinkStartupGate(2, 0)
+	switchQuit := make(chan struct{})
+
+	// The first two links should be able to start up right away.
+	release1, err := gate.acquire(nil, switchQuit)
+	if err != nil {
+		t.Fatalf("unable to acquire startup: %v", err)
+	}
+	if _, err := gate.acquire(nil, switchQuit); err != nil {
+		t.Fatalf("unable to acquire startup: %v", err)
+	}
+
+	// The third one should have to wait for one of them to complete.
+	acquired := make(chan struct{})
+	go func() {
+		if _, err := gate.acquire(nil, switchQuit); err != nil {
+			t.Errorf("unable to acquire startup: %v", err)
+			return
+		}
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatalf("startup acquired beyond the concurrency limit")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release1()
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("startup not acquired after release")
+	}
+
+	// A link that is shut down while waiting should give up.
+	linkQuit := make(chan struct{})
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := gate.acquire(linkQuit, switchQuit)
+		errChan <- err
+	}()
+	close(linkQuit)
+
+	select {
+	case err := <-errChan:
+		if err != ErrLinkShuttingDown {
+			t.Fatalf("expected ErrLinkShuttingDown, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("waiting link didn't give up")
+	}
+
+	// An unbounded gate should never block.
+	gate = newLinkStartupGate(0, time.Millisecond)
+	for i := 0; i < 10; i++ {
+		if _, err := gate.acquire(nil, switchQuit); err != nil {
+			t.Fatalf("unable to acquire startup: %v", err)
+		}
+	}
+}
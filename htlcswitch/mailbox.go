@@ -35,6 +35,12 @@ type MailBox interface {
 	// restarts if the switch has remained online.
 	AckPacket(CircuitKey) error
 
+	// PruneExpiredAdds removes the Add packets that have yet to be
+	// delivered to the link, and whose outgoing expiry is at or below the
+	// given height. The removed packets are returned, so that they can be
+	// failed back.
+	PruneExpiredAdds(height uint32) []*htlcPacket
+
 	// MessageOutBox returns a channel that any new messages ready for
 	// delivery will be sent on.
 	MessageOutBox() chan lnwire.Message
@@ -200,6 +206,39 @@ func (m *memoryMailBox) AckPacket(inKey CircuitKey) error {
 	return nil
 }
 
+// PruneExpiredAdds removes the Add packets that have yet to be delivered to
+// the link, and whose outgoing expiry is at or below the given height. Only
+// packets at or after the head of the queue are considered, as those behind
+// it have already been handed to the link, which may have added them to the
+// channel.
+//
+// NOTE: This method is part of the MailBox interface.
+func (m *memoryMailBox) PruneExpiredAdds(height uint32) []*htlcPacket {
+	m.pktCond.L.Lock()
+	defer m.pktCond.L.Unlock()
+
+	var expired []*htlcPacket
+	for entry := m.pktHead; entry != nil; {
+		next := entry.Next()
+
+		pkt := entry.Value.(*htlcPacket)
+		htlc, ok := pkt.htlc.(*lnwire.UpdateAddHTLC)
+		if ok && htlc.Expiry <= height {
+			if entry == m.pktHead {
+				m.pktHead = next
+			}
+			m.htlcPkts.Remove(entry)
+			delete(m.pktIndex, pkt.inKey())
+
+			expired = append(expired, pkt)
+		}
+
+		entry = next
+	}
+
+	return expired
+}
+
 // HasPacket queries the packets for a circuit key, this is used to drop packets
 // bound for the switch that already have a queued response.
 func (m *memoryMailBox) HasPacket(inKey CircuitKey) bool {
@@ -486,6 +525,40 @@ func (mo *mailOrchestrator) exclusiveGetOrCreateMailBox(
 	return mailbox
 }
 
+// PruneExpiredAdds removes the Add packets whose outgoing expiry is at or
+// below the given height from all mailboxes, along with any such packets
+// that have yet to be claimed by a mailbox.
+func (mo *mailOrchestrator) PruneExpiredAdds(height uint32) []*htlcPacket {
+	mo.mu.Lock()
+	defer mo.mu.Unlock()
+
+	var expired []*htlcPacket
+	for _, mailbox := range mo.mailboxes {
+		expired = append(expired, mailbox.PruneExpiredAdds(height)...)
+	}
+
+	for sid, pkts := range mo.unclaimedPackets {
+		remaining := pkts[:0]
+		for _, pkt := range pkts {
+			htlc, ok := pkt.htlc.(*lnwire.UpdateAddHTLC)
+			if ok && htlc.Expiry <= height {
+				expired = append(expired, pkt)
+				continue
+			}
+
+			remaining = append(remaining, pkt)
+		}
+
+		if len(remaining) == 0 {
+			delete(mo.unclaimedPackets, sid)
+		} else {
+			mo.unclaimedPackets[sid] = remaining
+		}
+	}
+
+	return expired
+}
+
 // BindLiveShortChanID registers that messages bound for a particular short
 // channel id should be forwarded to the mailbox corresponding to the given
 // channel id. This method also checks to see if there are any unclaimed
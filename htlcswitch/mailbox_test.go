@@ -265,3 +265,78 @@ func TestMailOrchestrator(t *testing.T) {
 			spew.Sdump(sentPackets), spew.Sdump(recvdPackets))
 	}
 }
+
+// TestMailBoxPruneExpiredAdds asserts that pruning a mailbox removes the
+// undelivered Add packets whose outgoing expiry has been reached, while the
+// remaining packets are still delivered in order.
+func TestMailBoxPruneExpiredAdds(t *testing.T) {
+	t.Parallel()
+
+	mailBox := newMemoryMailBox()
+	defer mailBox.Stop()
+
+	_, _, aliceChanID, bobChanID := genIDs()
+	newPkt := func(id uint64, htlc lnwire.Message) *htlcPacket {
+		return &htlcPacket{
+			outgoingChanID: aliceChanID,
+			incomingChanID: bobChanID,
+			incomingHTLCID: id,
+			htlc:           htlc,
+		}
+	}
+
+	// We'll add a mix of Add packets, some of which expire at the pruned
+	// height, and a Settle packet, which must never be pruned.
+	sentPackets := []*htlcPacket{
+		newPkt(0, &lnwire.UpdateAddHTLC{Expiry: 100}),
+		newPkt(1, &lnwire.UpdateAddHTLC{Expiry: 200}),
+		newPkt(2, &lnwire.UpdateFulfillHTLC{}),
+		newPkt(3, &lnwire.UpdateAddHTLC{Expiry: 150}),
+		newPkt(4, &lnwire.UpdateAddHTLC{Expiry: 300}),
+	}
+	for _, pkt := range sentPackets {
+		mailBox.AddPacket(pkt)
+	}
+
+	expired := mailBox.PruneExpiredAdds(150)
+	expectedExpired := []*htlcPacket{sentPackets[0], sentPackets[3]}
+	if !reflect.DeepEqual(expired, expectedExpired) {
+		t.Fatalf("expired packets mismatched: expected %v, got %v",
+			spew.Sdump(expectedExpired), spew.Sdump(expired))
+	}
+
+	// The pruned packets should no longer be indexed, so that they can be
+	// added again.
+	for _, pkt := range expired {
+		if mailBox.HasPacket(pkt.inKey()) {
+			t.Fatalf("pruned packet %v still indexed", pkt.inKey())
+		}
+	}
+
+	// Once started, the mailbox should deliver only the remaining
+	// packets.
+	mailBox.Start()
+
+	expectedPackets := []*htlcPacket{
+		sentPackets[1], sentPackets[2], sentPackets[4],
+	}
+	recvdPackets := make([]*htlcPacket, 0, len(expectedPackets))
+	for i := 0; i < len(expectedPackets); i++ {
+		select {
+		case pkt := <-mailBox.PacketOutBox():
+			recvdPackets = append(recvdPackets, pkt)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("didn't recv pkt %d after timeout", i)
+		}
+	}
+	if !reflect.DeepEqual(recvdPackets, expectedPackets) {
+		t.Fatalf("recvd packets mismatched: expected %v, got %v",
+			spew.Sdump(expectedPackets), spew.Sdump(recvdPackets))
+	}
+
+	select {
+	case pkt := <-mailBox.PacketOutBox():
+		t.Fatalf("unexpected pkt delivered: %v", pkt)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -32,6 +32,10 @@ import (
 type mockPreimageCache struct {
 	sync.Mutex
 	preimageMap map[[32]byte][]byte
+
+	// updates, if non-nil, receives each preimage added to the cache, and
+	// is handed out to subscribers.
+	updates chan []byte
 }
 
 func (m *mockPreimageCache) LookupPreimage(hash []byte) ([]byte, bool) {
@@ -51,11 +55,22 @@ func (m *mockPreimageCache) AddPreimage(preimage []byte) error {
 
 	m.preimageMap[sha256.Sum256(preimage[:])] = preimage
 
+	if m.updates != nil {
+		m.updates <- preimage
+	}
+
 	return nil
 }
 
 func (m *mockPreimageCache) SubscribeUpdates() *contractcourt.WitnessSubscription {
-	return nil
+	if m.updates == nil {
+		return nil
+	}
+
+	return &contractcourt.WitnessSubscription{
+		WitnessUpdates:     m.updates,
+		CancelSubscription: func() {},
+	}
 }
 
 type mockFeeEstimator struct {
@@ -298,6 +313,10 @@ func (f *ForwardingInfo) encode(w io.Writer) error {
 		return err
 	}
 
+	if err := binary.Write(w, binary.BigEndian, f.TotalAmount); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -462,6 +481,10 @@ func (f *ForwardingInfo) decode(r io.Reader) error {
 		return err
 	}
 
+	if err := binary.Read(r, binary.BigEndian, &f.TotalAmount); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -645,7 +668,8 @@ func (f *mockChannelLink) HandleChannelUpdate(lnwire.Message) {
 func (f *mockChannelLink) UpdateForwardingPolicy(_ ForwardingPolicy) {
 }
 func (f *mockChannelLink) HtlcSatifiesPolicy([32]byte, lnwire.MilliSatoshi,
-	lnwire.MilliSatoshi, uint32, uint32, uint32) lnwire.FailureMessage {
+	lnwire.MilliSatoshi, int64, uint32, uint32,
+	uint32) lnwire.FailureMessage {
 	return nil
 }
 
@@ -653,6 +677,10 @@ func (f *mockChannelLink) Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSato
 	return 0, 0, 0
 }
 
+func (f *mockChannelLink) PendingHTLCs() []HTLCSnapshot {
+	return nil
+}
+
 func (f *mockChannelLink) AttachMailBox(mailBox MailBox) {
 	f.mailBox = mailBox
 	f.packets = mailBox.PacketOutBox()
@@ -670,12 +698,34 @@ func (f *mockChannelLink) Bandwidth() lnwire.MilliSatoshi               { return
 func (f *mockChannelLink) Peer() lnpeer.Peer                            { return f.peer }
 func (f *mockChannelLink) Stop()                                        {}
 func (f *mockChannelLink) EligibleToForward() bool                      { return f.eligible }
+func (f *mockChannelLink) ResumeQuiescence()                            {}
 func (f *mockChannelLink) setLiveShortChanID(sid lnwire.ShortChannelID) { f.shortChanID = sid }
 func (f *mockChannelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	f.eligible = true
 	return f.shortChanID, nil
 }
 
+func (f *mockChannelLink) OutgoingFlowConstraints() lnwallet.FlowConstraints {
+	return lnwallet.FlowConstraints{
+		MaxPendingAmount: 99999999,
+		MaxAcceptedHtlcs: lnwallet.MaxHTLCNumber / 2,
+	}
+}
+
+func (f *mockChannelLink) Quiesce() <-chan error {
+	resp := make(chan error, 1)
+	resp <- nil
+	return resp
+}
+
+func (f *mockChannelLink) ProposeFlowConstraints(
+	lnwallet.FlowConstraints) <-chan error {
+
+	resp := make(chan error, 1)
+	resp <- nil
+	return resp
+}
+
 var _ ChannelLink = (*mockChannelLink)(nil)
 
 type mockInvoiceRegistry struct {
@@ -0,0 +1,302 @@
+package htlcswitch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// DefaultMPPTimeout is the default duration for which we'll hold the parts of
+// a multi-part payment while waiting for the rest of the set to arrive.
+const DefaultMPPTimeout = time.Minute
+
+// MPPStats houses the counters tracked by the switch for the parts of
+// multi-part payments paying our invoices.
+type MPPStats struct {
+	// NumHeld is the number of parts currently held while waiting for the
+	// rest of their payment to arrive.
+	NumHeld int
+
+	// NumRejected is the number of parts that were failed back because
+	// the maximum number of held parts had been reached.
+	NumRejected uint64
+}
+
+// mppResolution instructs a link to either settle or fail an HTLC that it's
+// been holding as part of a multi-part payment.
+type mppResolution struct {
+	// htlcIndex is the index of the HTLC within the incoming link.
+	htlcIndex uint64
+
+	// sourceRef is the forwarding package reference of the HTLC.
+	sourceRef *channeldb.AddRef
+
+	// obfuscator is used to encrypt the failure back to the sender.
+	obfuscator ErrorEncrypter
+
+	// paymentHash is the payment hash of the multi-part payment.
+	paymentHash chainhash.Hash
+
+	// preimage is set if the set is complete, and the HTLC should be
+	// settled.
+	preimage *[32]byte
+
+	// setTotal is the total amount received by the set, which is the
+	// amount the invoice is settled with.
+	setTotal lnwire.MilliSatoshi
+
+	// failure is set if the set has been cancelled, and the HTLC should be
+	// failed back.
+	failure lnwire.FailureMessage
+}
+
+// mppHTLC is a single HTLC held as part of a multi-part payment.
+type mppHTLC struct {
+	// amt is the amount of the HTLC.
+	amt lnwire.MilliSatoshi
+
+	// resolution is the template of the resolution delivered to the link
+	// once the set is either settled or cancelled.
+	resolution mppResolution
+
+	// resolutions is the channel of the link holding the HTLC.
+	resolutions chan<- *mppResolution
+
+	// linkQuit is closed once the link holding the HTLC exits.
+	linkQuit <-chan struct{}
+}
+
+// mppSet is the set of HTLCs paying the same invoice.
+type mppSet struct {
+	// total is the total amount of the payment, as specified by the
+	// sender.
+	total lnwire.MilliSatoshi
+
+	// preimage is the preimage that settles the set.
+	preimage [32]byte
+
+	// htlcs are the parts of the payment received so far. If a link
+	// restarts, it'll reprocess the HTLCs it was holding, so keying them
+	// by circuit key ensures they're only counted once.
+	htlcs map[CircuitKey]*mppHTLC
+
+	// timer cancels the set if it doesn't complete in time.
+	timer *time.Timer
+}
+
+// received returns the total amount of the HTLCs within the set.
+func (s *mppSet) received() lnwire.MilliSatoshi {
+	var amt lnwire.MilliSatoshi
+	for _, htlc := range s.htlcs {
+		amt += htlc.amt
+	}
+
+	return amt
+}
+
+// mppCollector aggregates the parts of the multi-part payments for which we
+// are the final hop. The parts are held until their total amount reaches the
+// amount the sender indicated, at which point the whole set is settled. If
+// the set doesn't complete within the timeout, then all of its parts are
+// failed back together.
+//
+// NOTE: The collector only keeps sets in memory. If we restart while holding
+// parts of a payment, each link reprocesses the HTLCs it was holding when
+// replaying its forwarding packages, which reassembles the set.
+type mppCollector struct {
+	timeout time.Duration
+
+	// maxHeld is the maximum number of parts held across all sets. Parts
+	// received beyond this bound are failed back with a temporary
+	// failure, rather than letting the collector grow unboundedly. A
+	// value of zero disables the bound.
+	maxHeld int
+
+	mtx  sync.Mutex
+	sets map[chainhash.Hash]*mppSet
+
+	// numHeld is the number of parts currently held across all sets.
+	numHeld int
+
+	// numRejected is the number of parts rejected for exceeding maxHeld.
+	numRejected uint64
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// newMPPCollector creates a new collector of multi-part payments, holding at
+// most maxHeld parts at once.
+func newMPPCollector(timeout time.Duration, maxHeld int) *mppCollector {
+	if timeout == 0 {
+		timeout = DefaultMPPTimeout
+	}
+
+	return &mppCollector{
+		timeout: timeout,
+		maxHeld: maxHeld,
+		sets:    make(map[chainhash.Hash]*mppSet),
+		quit:    make(chan struct{}),
+	}
+}
+
+// addHTLC adds an HTLC paying the given hash to its set, which is created if
+// this is the first part received. If the HTLC completes the set, then all of
+// its parts are settled. If we're already holding the maximum number of parts,
+// then the HTLC is failed back with a temporary failure, unless it completes
+// its set.
+func (c *mppCollector) addHTLC(key CircuitKey, total lnwire.MilliSatoshi,
+	preimage [32]byte, htlc *mppHTLC) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	hash := htlc.resolution.paymentHash
+	set, ok := c.sets[hash]
+
+	// Parts reprocessed after a link restart are already accounted for,
+	// and a part that completes its set frees up slots rather than taking
+	// one, so neither is subject to the bound.
+	if c.maxHeld > 0 && c.numHeld >= c.maxHeld {
+		var known, completes bool
+		if ok {
+			_, known = set.htlcs[key]
+			completes = total == set.total &&
+				set.received()+htlc.amt >= set.total
+		}
+		if !known && !completes {
+			c.numRejected++
+			log.Warnf("Rejecting part of payment %x: already "+
+				"holding %d parts", hash[:], c.numHeld)
+
+			res := htlc.resolution
+			res.failure = &lnwire.FailTemporaryNodeFailure{}
+			c.resolve(htlc, &res)
+			return
+		}
+	}
+
+	if !ok {
+		set = &mppSet{
+			total:    total,
+			preimage: preimage,
+			htlcs:    make(map[CircuitKey]*mppHTLC),
+		}
+		set.timer = time.AfterFunc(c.timeout, func() {
+			c.cancelSet(hash, &lnwire.FailMPPTimeout{})
+		})
+		c.sets[hash] = set
+	}
+
+	// All parts of the payment must agree on its total amount, otherwise
+	// we'll reject the part that doesn't.
+	if total != set.total {
+		log.Errorf("Rejecting part of payment %x with total %v, "+
+			"expected total %v", hash[:], total, set.total)
+
+		res := htlc.resolution
+		res.failure = &lnwire.FailIncorrectPaymentAmount{}
+		c.resolve(htlc, &res)
+		return
+	}
+
+	if _, ok := set.htlcs[key]; !ok {
+		c.numHeld++
+	}
+	set.htlcs[key] = htlc
+
+	received := set.received()
+	if received < set.total {
+		log.Debugf("Holding part of payment %x: received %v of %v "+
+			"in %d parts", hash[:], received, set.total,
+			len(set.htlcs))
+		return
+	}
+
+	log.Infof("Settling payment %x: received %v in %d parts", hash[:],
+		received, len(set.htlcs))
+
+	set.timer.Stop()
+	delete(c.sets, hash)
+	c.numHeld -= len(set.htlcs)
+
+	for _, part := range set.htlcs {
+		res := part.resolution
+		res.preimage = &set.preimage
+		res.setTotal = received
+		c.resolve(part, &res)
+	}
+}
+
+// cancelSet fails back all parts of the set paying the given hash.
+func (c *mppCollector) cancelSet(hash chainhash.Hash,
+	failure lnwire.FailureMessage) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	set, ok := c.sets[hash]
+	if !ok {
+		return
+	}
+
+	log.Infof("Cancelling payment %x: received %v of %v in %d parts: %v",
+		hash[:], set.received(), set.total, len(set.htlcs), failure)
+
+	set.timer.Stop()
+	delete(c.sets, hash)
+	c.numHeld -= len(set.htlcs)
+
+	for _, part := range set.htlcs {
+		res := part.resolution
+		res.failure = failure
+		c.resolve(part, &res)
+	}
+}
+
+// stats returns the current counters of the collector.
+func (c *mppCollector) stats() MPPStats {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return MPPStats{
+		NumHeld:     c.numHeld,
+		NumRejected: c.numRejected,
+	}
+}
+
+// resolve delivers a resolution to the link holding the HTLC. If the link
+// exits before it can receive the resolution, then the link will reprocess
+// the HTLC once it's restarted.
+//
+// NOTE: This MUST be called with the mutex held.
+func (c *mppCollector) resolve(htlc *mppHTLC, res *mppResolution) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		select {
+		case htlc.resolutions <- res:
+		case <-htlc.linkQuit:
+		case <-c.quit:
+		}
+	}()
+}
+
+// stop cancels the timers of all pending sets, and waits for all pending
+// resolutions to be delivered or abandoned.
+func (c *mppCollector) stop() {
+	c.mtx.Lock()
+	for hash, set := range c.sets {
+		set.timer.Stop()
+		delete(c.sets, hash)
+	}
+	c.numHeld = 0
+	c.mtx.Unlock()
+
+	close(c.quit)
+	c.wg.Wait()
+}
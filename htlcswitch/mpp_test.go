@@ -0,0 +1,199 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// newTestMPPHTLC creates a part of a multi-part payment, along with the
+// channel its resolution is delivered over.
+func newTestMPPHTLC(hash chainhash.Hash, htlcIndex uint64,
+	amt lnwire.MilliSatoshi) (*mppHTLC, chan *mppResolution) {
+
+	resolutions := make(chan *mppResolution, 1)
+	return &mppHTLC{
+		amt: amt,
+		resolution: mppResolution{
+			htlcIndex:   htlcIndex,
+			paymentHash: hash,
+		},
+		resolutions: resolutions,
+		linkQuit:    make(chan struct{}),
+	}, resolutions
+}
+
+// assertNoMPPResolution asserts that a part hasn't been resolved.
+func assertNoMPPResolution(t *testing.T, resolutions chan *mppResolution) {
+	t.Helper()
+
+	select {
+	case res := <-resolutions:
+		t.Fatalf("unexpected resolution: %v", res)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// receiveMPPResolution waits for the resolution of a part.
+func receiveMPPResolution(t *testing.T,
+	resolutions chan *mppResolution) *mppResolution {
+
+	t.Helper()
+
+	select {
+	case res := <-resolutions:
+		return res
+	case <-time.After(5 * time.Second):
+		t.Fatalf("part wasn't resolved")
+	}
+
+	return nil
+}
+
+// TestMPPCollectorSettle asserts that the parts of a multi-part payment are
+// held until the total amount has been received, at which point they're all
+// settled, and that parts reprocessed after a restart are only counted once.
+func TestMPPCollectorSettle(t *testing.T) {
+	t.Parallel()
+
+	c := newMPPCollector(time.Hour, 0)
+	defer c.stop()
+
+	hash := chainhash.Hash{1}
+	preimage := [32]byte{2}
+	chanID := lnwire.NewShortChanIDFromInt(1)
+
+	first, firstRes := newTestMPPHTLC(hash, 0, 600)
+	c.addHTLC(
+		CircuitKey{ChanID: chanID, HtlcID: 0}, 1000, preimage, first,
+	)
+	assertNoMPPResolution(t, firstRes)
+
+	// Adding the same part again, as happens when its link restarts,
+	// shouldn't complete the set.
+	first, firstRes = newTestMPPHTLC(hash, 0, 600)
+	c.addHTLC(
+		CircuitKey{ChanID: chanID, HtlcID: 0}, 1000, preimage, first,
+	)
+	assertNoMPPResolution(t, firstRes)
+
+	// A part that disagrees on the total amount should be rejected.
+	bogus, bogusRes := newTestMPPHTLC(hash, 1, 400)
+	c.addHTLC(
+		CircuitKey{ChanID: chanID, HtlcID: 1}, 2000, preimage, bogus,
+	)
+	res := receiveMPPResolution(t, bogusRes)
+	if _, ok := res.failure.(*lnwire.FailIncorrectPaymentAmount); !ok {
+		t.Fatalf("expected incorrect payment amount, got %v",
+			res.failure)
+	}
+
+	// Once the remainder arrives, both parts should be settled.
+	second, secondRes := newTestMPPHTLC(hash, 2, 400)
+	c.addHTLC(
+		CircuitKey{ChanID: chanID, HtlcID: 2}, 1000, preimage, second,
+	)
+
+	for _, resolutions := range []chan *mppResolution{firstRes, secondRes} {
+		res := receiveMPPResolution(t, resolutions)
+		if res.preimage == nil || *res.preimage != preimage {
+			t.Fatalf("expected part to be settled")
+		}
+		if res.setTotal != 1000 {
+			t.Fatalf("expected set total of 1000, got %v",
+				res.setTotal)
+		}
+	}
+}
+
+// TestMPPCollectorTimeout asserts that all parts of an incomplete multi-part
+// payment are failed back once the timeout expires.
+func TestMPPCollectorTimeout(t *testing.T) {
+	t.Parallel()
+
+	c := newMPPCollector(100*time.Millisecond, 0)
+	defer c.stop()
+
+	hash := chainhash.Hash{1}
+	chanID := lnwire.NewShortChanIDFromInt(1)
+
+	part, resolutions := newTestMPPHTLC(hash, 0, 600)
+	c.addHTLC(
+		CircuitKey{ChanID: chanID, HtlcID: 0}, 1000, [32]byte{}, part,
+	)
+
+	res := receiveMPPResolution(t, resolutions)
+	if _, ok := res.failure.(*lnwire.FailMPPTimeout); !ok {
+		t.Fatalf("expected mpp timeout, got %v", res.failure)
+	}
+}
+
+// TestMPPCollectorMaxHeld asserts that parts received once the maximum number
+// of held parts has been reached are failed back with a temporary failure,
+// unless they complete their set.
+func TestMPPCollectorMaxHeld(t *testing.T) {
+	t.Parallel()
+
+	c := newMPPCollector(time.Hour, 2)
+	defer c.stop()
+
+	chanID := lnwire.NewShortChanIDFromInt(1)
+	hash1 := chainhash.Hash{1}
+	hash2 := chainhash.Hash{2}
+	preimage := [32]byte{3}
+
+	first, firstRes := newTestMPPHTLC(hash1, 0, 300)
+	c.addHTLC(
+		CircuitKey{ChanID: chanID, HtlcID: 0}, 1000, preimage, first,
+	)
+	second, secondRes := newTestMPPHTLC(hash2, 1, 300)
+	c.addHTLC(
+		CircuitKey{ChanID: chanID, HtlcID: 1}, 1000, preimage, second,
+	)
+	assertNoMPPResolution(t, firstRes)
+	assertNoMPPResolution(t, secondRes)
+
+	// Reprocessing a part we're already holding shouldn't count against
+	// the bound.
+	first, firstRes = newTestMPPHTLC(hash1, 0, 300)
+	c.addHTLC(
+		CircuitKey{ChanID: chanID, HtlcID: 0}, 1000, preimage, first,
+	)
+	assertNoMPPResolution(t, firstRes)
+
+	// A new part that doesn't complete its set should be rejected.
+	third, thirdRes := newTestMPPHTLC(hash1, 2, 300)
+	c.addHTLC(
+		CircuitKey{ChanID: chanID, HtlcID: 2}, 1000, preimage, third,
+	)
+	res := receiveMPPResolution(t, thirdRes)
+	if _, ok := res.failure.(*lnwire.FailTemporaryNodeFailure); !ok {
+		t.Fatalf("expected temporary node failure, got %v",
+			res.failure)
+	}
+
+	stats := c.stats()
+	if stats.NumHeld != 2 || stats.NumRejected != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	// A part that completes its set should still be accepted, which
+	// releases the slot of the first part.
+	fourth, fourthRes := newTestMPPHTLC(hash1, 3, 700)
+	c.addHTLC(
+		CircuitKey{ChanID: chanID, HtlcID: 3}, 1000, preimage, fourth,
+	)
+	for _, resolutions := range []chan *mppResolution{firstRes, fourthRes} {
+		res := receiveMPPResolution(t, resolutions)
+		if res.preimage == nil || *res.preimage != preimage {
+			t.Fatalf("expected part to be settled")
+		}
+	}
+
+	stats = c.stats()
+	if stats.NumHeld != 1 || stats.NumRejected != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
@@ -46,6 +46,10 @@ type htlcPacket struct {
 	// incoming link.
 	incomingAmount lnwire.MilliSatoshi
 
+	// inboundFee is the inbound fee charged by the incoming link for the
+	// HTLC, which is negative if the incoming link grants a discount.
+	inboundFee int64
+
 	// amount is the value of the HTLC that is being created or modified.
 	amount lnwire.MilliSatoshi
 
@@ -85,6 +89,10 @@ type htlcPacket struct {
 	// will be extraced from the hop payload recevived by the incoming
 	// link.
 	outgoingTimeout uint32
+
+	// wasHeld is set once an HTLC that was held for an offline peer is
+	// released, so it isn't subject to the incoming rate limit again.
+	wasHeld bool
 }
 
 // inKey returns the circuit key used to identify the incoming htlc.
@@ -0,0 +1,175 @@
+package htlcswitch
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultProbeDecayHalfLife is the default duration after which the
+	// weight of a failed payment attempt recorded by the probe guard is
+	// halved.
+	DefaultProbeDecayHalfLife = 10 * time.Minute
+
+	// probeHashRepeats is the decayed number of times a payment hash must
+	// have failed for a peer before further attempts for it are failed
+	// fast.
+	probeHashRepeats = 2
+
+	// probeMinScore is the score below which a decayed entry is considered
+	// forgotten, and is pruned from the log.
+	probeMinScore = 0.05
+)
+
+// decayingScore is a score that decays exponentially over time, halving once
+// per half-life.
+type decayingScore struct {
+	value   float64
+	updated time.Time
+}
+
+// decay brings the score up to date with the current time.
+func (d *decayingScore) decay(now time.Time, halfLife time.Duration) {
+	elapsed := now.Sub(d.updated)
+	if elapsed > 0 {
+		d.value *= math.Exp2(-float64(elapsed) / float64(halfLife))
+		d.updated = now
+	}
+}
+
+// increment decays the score, and then adds a single unit to it.
+func (d *decayingScore) increment(now time.Time, halfLife time.Duration) {
+	d.decay(now, halfLife)
+	d.value++
+}
+
+// probeKey identifies a payment hash attempted by a particular peer.
+type probeKey struct {
+	peer [33]byte
+	hash chainhash.Hash
+}
+
+// probeGuard limits the effectiveness of balance probing through our node. A
+// prober sends HTLCs paying hashes we don't know, or amounts we don't expect,
+// and learns from our failure that the HTLC made it to us. The guard keeps a
+// decaying log of the payment hashes that failed at our node as the exit hop,
+// along with a decaying failure score for each incoming peer. Further
+// attempts for a hash that recently failed are failed fast, and once a
+// peer's score exceeds the threshold, all of its attempts to pay us are
+// throttled until the score decays.
+type probeGuard struct {
+	// threshold is the failure score at which we stop accepting payment
+	// attempts from a peer. A threshold of zero disables the guard.
+	threshold float64
+
+	// halfLife is the duration after which the weight of a failure is
+	// halved.
+	halfLife time.Duration
+
+	// now returns the current time, and is overridden within tests.
+	now func() time.Time
+
+	mtx       sync.Mutex
+	hashes    map[probeKey]*decayingScore
+	peers     map[[33]byte]*decayingScore
+	lastPrune time.Time
+}
+
+// newProbeGuard creates a new probe guard which throttles peers once their
+// failure score reaches threshold. If halfLife is zero,
+// DefaultProbeDecayHalfLife is used.
+func newProbeGuard(threshold float64, halfLife time.Duration) *probeGuard {
+	if halfLife == 0 {
+		halfLife = DefaultProbeDecayHalfLife
+	}
+
+	return &probeGuard{
+		threshold: threshold,
+		halfLife:  halfLife,
+		now:       time.Now,
+		hashes:    make(map[probeKey]*decayingScore),
+		peers:     make(map[[33]byte]*decayingScore),
+	}
+}
+
+// check returns the failure an incoming HTLC paying the given hash from the
+// given peer should be failed back with, without consulting the invoice
+// registry, or nil if the HTLC should be processed as usual.
+func (g *probeGuard) check(peer [33]byte,
+	hash chainhash.Hash) lnwire.FailureMessage {
+
+	if g.threshold <= 0 {
+		return nil
+	}
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	now := g.now()
+
+	// A hash that has repeatedly failed recently will fail again, so
+	// there's no need to look it up.
+	if score, ok := g.hashes[probeKey{peer, hash}]; ok {
+		score.decay(now, g.halfLife)
+		if score.value >= probeHashRepeats {
+			return &lnwire.FailUnknownPaymentHash{}
+		}
+	}
+
+	if score, ok := g.peers[peer]; ok {
+		score.decay(now, g.halfLife)
+		if score.value >= g.threshold {
+			return &lnwire.FailTemporaryNodeFailure{}
+		}
+	}
+
+	return nil
+}
+
+// recordFailure records that an HTLC paying the given hash from the given
+// peer failed at our node as the exit hop.
+func (g *probeGuard) recordFailure(peer [33]byte, hash chainhash.Hash) {
+	if g.threshold <= 0 {
+		return
+	}
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	now := g.now()
+
+	key := probeKey{peer, hash}
+	if _, ok := g.hashes[key]; !ok {
+		g.hashes[key] = &decayingScore{updated: now}
+	}
+	g.hashes[key].increment(now, g.halfLife)
+
+	if _, ok := g.peers[peer]; !ok {
+		g.peers[peer] = &decayingScore{updated: now}
+	}
+	g.peers[peer].increment(now, g.halfLife)
+
+	// Periodically forget the entries that have decayed away, so that the
+	// log doesn't grow unbounded.
+	if now.Sub(g.lastPrune) < g.halfLife {
+		return
+	}
+	g.lastPrune = now
+
+	for key, score := range g.hashes {
+		score.decay(now, g.halfLife)
+		if score.value < probeMinScore {
+			delete(g.hashes, key)
+		}
+	}
+	for key, score := range g.peers {
+		score.decay(now, g.halfLife)
+		if score.value < probeMinScore {
+			delete(g.peers, key)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestProbeGuard asserts that the probe guard fails fast hashes that
+// repeatedly failed, throttles peers whose failures exceed the threshold,
+// tracks each peer independently, and forgets failures as they decay.
+func TestProbeGuard(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	guard := newProbeGuard(3, time.Minute)
+	guard.now = func() time.Time {
+		return now
+	}
+
+	var alice, bob [33]byte
+	alice[0] = 0x02
+	bob[0] = 0x03
+
+	hash1 := chainhash.Hash{1}
+	hash2 := chainhash.Hash{2}
+	hash3 := chainhash.Hash{3}
+
+	// A single failure shouldn't cause the hash to be failed fast, as an
+	// honest sender may have made a mistake.
+	guard.recordFailure(alice, hash1)
+	if failure := guard.check(alice, hash1); failure != nil {
+		t.Fatalf("hash failed fast after a single failure: %v",
+			failure)
+	}
+
+	// Once it fails again, further attempts should be failed fast, but
+	// only for Alice.
+	guard.recordFailure(alice, hash1)
+	failure := guard.check(alice, hash1)
+	if _, ok := failure.(*lnwire.FailUnknownPaymentHash); !ok {
+		t.Fatalf("expected unknown payment hash, got %v", failure)
+	}
+	if failure := guard.check(bob, hash1); failure != nil {
+		t.Fatalf("other peer failed fast: %v", failure)
+	}
+
+	// A third failure takes Alice to the threshold, so all of her
+	// attempts should be throttled, while Bob's go through.
+	guard.recordFailure(alice, hash2)
+	failure = guard.check(alice, hash3)
+	if _, ok := failure.(*lnwire.FailTemporaryNodeFailure); !ok {
+		t.Fatalf("expected temporary node failure, got %v", failure)
+	}
+	if failure := guard.check(bob, hash3); failure != nil {
+		t.Fatalf("other peer throttled: %v", failure)
+	}
+
+	// After two half-lives, Alice's score has decayed below the threshold,
+	// and the repeated hash is no longer failed fast.
+	now = now.Add(2 * time.Minute)
+	if failure := guard.check(alice, hash3); failure != nil {
+		t.Fatalf("peer still throttled after decay: %v", failure)
+	}
+	if failure := guard.check(alice, hash1); failure != nil {
+		t.Fatalf("hash still failed fast after decay: %v", failure)
+	}
+
+	// Much later, recording a failure should prune the entries that have
+	// decayed away.
+	now = now.Add(time.Hour)
+	guard.recordFailure(bob, hash1)
+	if len(guard.hashes) != 1 || len(guard.peers) != 1 {
+		t.Fatalf("expected decayed entries to be pruned, got %d "+
+			"hashes and %d peers", len(guard.hashes),
+			len(guard.peers))
+	}
+}
+
+// TestProbeGuardDisabled asserts that a threshold of zero disables the probe
+// guard.
+func TestProbeGuardDisabled(t *testing.T) {
+	t.Parallel()
+
+	guard := newProbeGuard(0, 0)
+
+	var alice [33]byte
+	hash := chainhash.Hash{1}
+	for i := 0; i < 10; i++ {
+		guard.recordFailure(alice, hash)
+	}
+
+	if failure := guard.check(alice, hash); failure != nil {
+		t.Fatalf("disabled guard failed htlc: %v", failure)
+	}
+}
@@ -0,0 +1,173 @@
+package htlcswitch
+
+import (
+	"fmt"
+
+	"github.com/go-errors/errors"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrQuiescenceUnsupported is returned when quiescence is requested
+	// for a link whose remote peer hasn't signalled support for the
+	// quiescence protocol.
+	ErrQuiescenceUnsupported = errors.New("peer doesn't support " +
+		"quiescence")
+
+	// ErrQuiescenceResumed is sent to any pending quiescence requests if
+	// the link is resumed before it managed to become quiescent.
+	ErrQuiescenceResumed = errors.New("quiescence resumed before " +
+		"completion")
+
+	// ErrDynCommitmentsUnsupported is returned when new flow constraints
+	// are proposed for a link whose remote peer hasn't signalled support
+	// for renegotiating them.
+	ErrDynCommitmentsUnsupported = errors.New("peer doesn't support " +
+		"dynamic commitments")
+
+	// ErrNotQuiescenceInitiator is returned when new flow constraints are
+	// proposed for a link that either isn't quiescent, or whose
+	// quiescence session wasn't initiated by us.
+	ErrNotQuiescenceInitiator = errors.New("link isn't quiescent, or " +
+		"not the initiator of quiescence")
+
+	// ErrDynUpdatePending is returned when new flow constraints are
+	// proposed while a previous proposal is still awaiting a response.
+	ErrDynUpdatePending = errors.New("flow constraints proposal " +
+		"already pending")
+
+	// errStfuReceivedTwice is returned if the remote party sends us more
+	// than one Stfu message for the same quiescence session.
+	errStfuReceivedTwice = errors.New("stfu received twice")
+)
+
+// quiescer tracks the state of the quiescence (stfu) protocol for a single
+// channel. Quiescence is entered in two phases: once either party wishes to
+// quiesce the channel, it stops proposing new updates and waits until all
+// of its pending updates have been irrevocably committed, at which point it
+// sends an Stfu message. The channel is quiescent once both parties have
+// sent and received Stfu.
+//
+// NOTE: The quiescer isn't safe for concurrent use, and is only accessed
+// from within the link's htlcManager goroutine.
+type quiescer struct {
+	// chanID is the channel that's being quiesced.
+	chanID lnwire.ChannelID
+
+	// channelInitiator is true if we funded the channel. It's used to
+	// break the tie if both parties initiate quiescence at the same time.
+	channelInitiator bool
+
+	// localInit is true if we've been asked to initiate quiescence.
+	localInit bool
+
+	// remoteInit is true if the remote party sent us an Stfu with the
+	// initiator flag set.
+	remoteInit bool
+
+	// sent is true once we've sent our Stfu to the remote party.
+	sent bool
+
+	// received is true once we've received an Stfu from the remote party.
+	received bool
+}
+
+// newQuiescer creates a new quiescer for the target channel.
+func newQuiescer(chanID lnwire.ChannelID, channelInitiator bool) *quiescer {
+	return &quiescer{
+		chanID:           chanID,
+		channelInitiator: channelInitiator,
+	}
+}
+
+// initStfu registers our intent to quiesce the channel. We'll send our Stfu
+// once all of our pending updates have been committed.
+func (q *quiescer) initStfu() {
+	// If we've already sent our Stfu, or the remote party already
+	// initiated quiescence, then there's nothing left for us to initiate.
+	if q.sent || q.received {
+		return
+	}
+
+	q.localInit = true
+}
+
+// recvStfu processes an Stfu received from the remote party.
+func (q *quiescer) recvStfu(msg *lnwire.Stfu) error {
+	if msg.ChanID != q.chanID {
+		return fmt.Errorf("stfu for wrong channel: expected %v, got %v",
+			q.chanID, msg.ChanID)
+	}
+	if q.received {
+		return errStfuReceivedTwice
+	}
+
+	q.received = true
+	q.remoteInit = msg.Initiator
+
+	// If the remote party initiated quiescence before we managed to send
+	// our own Stfu, then we'll merely respond to theirs.
+	if q.remoteInit && !q.sent {
+		q.localInit = false
+	}
+
+	return nil
+}
+
+// owesStfu returns true if we have yet to send an Stfu, either because we
+// initiated quiescence, or because the remote party did.
+func (q *quiescer) owesStfu() bool {
+	return !q.sent && (q.localInit || q.received)
+}
+
+// makeStfu marks our Stfu as sent, returning the message that should be
+// delivered to the remote party. This should only be called once owesStfu
+// returns true, and the channel has no dangling updates.
+func (q *quiescer) makeStfu() *lnwire.Stfu {
+	q.sent = true
+
+	// We'll only claim to be the initiator if we requested quiescence
+	// before the remote party did, otherwise we're merely responding.
+	return lnwire.NewStfu(q.chanID, q.localInit && !q.received)
+}
+
+// canSendUpdates returns true if we're still allowed to propose new updates
+// to the channel. Once quiescence is pending on either side, we'll refrain
+// from adding any new updates so that the channel is able to drain.
+func (q *quiescer) canSendUpdates() bool {
+	return !q.sent && !q.owesStfu()
+}
+
+// canRecvUpdates returns true if the remote party is still allowed to
+// propose updates, which is the case up until they send us their Stfu.
+func (q *quiescer) canRecvUpdates() bool {
+	return !q.received
+}
+
+// isQuiescent returns true if both parties have sent their Stfu, meaning
+// that neither party may propose any further updates.
+func (q *quiescer) isQuiescent() bool {
+	return q.sent && q.received
+}
+
+// isInitiator returns true if we're considered the initiator of the current
+// quiescence session. If both parties claim to be the initiator, the funder
+// of the channel wins.
+func (q *quiescer) isInitiator() bool {
+	switch {
+	case q.localInit && q.remoteInit:
+		return q.channelInitiator
+
+	default:
+		return q.localInit && !q.remoteInit
+	}
+}
+
+// resume terminates the current quiescence session, allowing updates to be
+// proposed once again.
+func (q *quiescer) resume() {
+	q.localInit = false
+	q.remoteInit = false
+	q.sent = false
+	q.received = false
+}
@@ -0,0 +1,116 @@
+package htlcswitch
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestQuiescerInitiate asserts that a quiescer that initiates quiescence
+// stops sending updates, sends an Stfu flagged as the initiator, and only
+// becomes quiescent once the remote party responds.
+func TestQuiescerInitiate(t *testing.T) {
+	t.Parallel()
+
+	var chanID lnwire.ChannelID
+	q := newQuiescer(chanID, false)
+
+	if !q.canSendUpdates() || q.owesStfu() {
+		t.Fatalf("fresh quiescer shouldn't restrict updates")
+	}
+
+	q.initStfu()
+	if q.canSendUpdates() {
+		t.Fatalf("able to send updates after initiating quiescence")
+	}
+	if !q.owesStfu() {
+		t.Fatalf("expected to owe stfu")
+	}
+
+	stfu := q.makeStfu()
+	if !stfu.Initiator {
+		t.Fatalf("expected stfu to be flagged as initiator")
+	}
+	if q.owesStfu() || q.isQuiescent() {
+		t.Fatalf("quiescent before receiving remote stfu")
+	}
+	if !q.canRecvUpdates() {
+		t.Fatalf("remote updates rejected before remote stfu")
+	}
+
+	if err := q.recvStfu(lnwire.NewStfu(chanID, false)); err != nil {
+		t.Fatalf("unable to receive stfu: %v", err)
+	}
+	if !q.isQuiescent() || q.canRecvUpdates() {
+		t.Fatalf("expected quiescence after exchanging stfu")
+	}
+	if !q.isInitiator() {
+		t.Fatalf("expected to be the initiator")
+	}
+
+	// A second Stfu within the same session is a protocol violation.
+	err := q.recvStfu(lnwire.NewStfu(chanID, false))
+	if err != errStfuReceivedTwice {
+		t.Fatalf("expected errStfuReceivedTwice, got %v", err)
+	}
+
+	// Once resumed, we should be able to send updates again.
+	q.resume()
+	if !q.canSendUpdates() || !q.canRecvUpdates() || q.isQuiescent() {
+		t.Fatalf("quiescer not reset after resume")
+	}
+}
+
+// TestQuiescerRespond asserts that a quiescer responds to an Stfu from the
+// remote party without claiming to be the initiator, even if it was asked to
+// initiate quiescence before sending its own Stfu.
+func TestQuiescerRespond(t *testing.T) {
+	t.Parallel()
+
+	var chanID lnwire.ChannelID
+	q := newQuiescer(chanID, true)
+
+	q.initStfu()
+	if err := q.recvStfu(lnwire.NewStfu(chanID, true)); err != nil {
+		t.Fatalf("unable to receive stfu: %v", err)
+	}
+	if q.canSendUpdates() || !q.owesStfu() {
+		t.Fatalf("expected to owe stfu after receiving one")
+	}
+
+	stfu := q.makeStfu()
+	if stfu.Initiator {
+		t.Fatalf("response stfu flagged as initiator")
+	}
+	if !q.isQuiescent() {
+		t.Fatalf("expected quiescence after exchanging stfu")
+	}
+	if q.isInitiator() {
+		t.Fatalf("responder considered initiator")
+	}
+}
+
+// TestQuiescerTieBreak asserts that if both parties initiate quiescence
+// concurrently, the funder of the channel is considered the initiator.
+func TestQuiescerTieBreak(t *testing.T) {
+	t.Parallel()
+
+	var chanID lnwire.ChannelID
+	for _, funder := range []bool{true, false} {
+		q := newQuiescer(chanID, funder)
+
+		q.initStfu()
+		if !q.makeStfu().Initiator {
+			t.Fatalf("expected stfu to be flagged as initiator")
+		}
+		err := q.recvStfu(lnwire.NewStfu(chanID, true))
+		if err != nil {
+			t.Fatalf("unable to receive stfu: %v", err)
+		}
+
+		if q.isInitiator() != funder {
+			t.Fatalf("expected initiator=%v, got %v", funder,
+				q.isInitiator())
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package htlcswitch
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitStats houses the counters tracked for a single peer by the
+// switch's incoming HTLC rate limiter.
+type RateLimitStats struct {
+	// Accepted is the number of incoming HTLC adds from the peer that
+	// were within its rate limit.
+	Accepted uint64
+
+	// Rejected is the number of incoming HTLC adds from the peer that
+	// were failed back for exceeding its rate limit.
+	Rejected uint64
+}
+
+// htlcRateLimiter enforces a per-peer rate limit on the HTLC adds forwarded
+// through the switch, so that a single peer can't flood the switch and
+// starve the traffic of all other peers.
+type htlcRateLimiter struct {
+	// limit is the number of adds each peer may forward per second. A
+	// limit of zero disables the limiter entirely.
+	limit rate.Limit
+
+	// burst is the number of adds a peer may send in quick succession.
+	burst int
+
+	// now returns the current time, and is overridden within tests.
+	now func() time.Time
+
+	mtx      sync.Mutex
+	limiters map[[33]byte]*rate.Limiter
+	stats    map[[33]byte]*RateLimitStats
+}
+
+// newHtlcRateLimiter creates a new rate limiter which permits each peer to
+// forward addRate adds per second, with bursts of up to burst adds. If burst
+// is zero, it defaults to a single second worth of adds.
+func newHtlcRateLimiter(addRate float64, burst uint32) *htlcRateLimiter {
+	b := int(burst)
+	if b == 0 {
+		b = int(addRate)
+	}
+	if b < 1 {
+		b = 1
+	}
+
+	return &htlcRateLimiter{
+		limit:    rate.Limit(addRate),
+		burst:    b,
+		now:      time.Now,
+		limiters: make(map[[33]byte]*rate.Limiter),
+		stats:    make(map[[33]byte]*RateLimitStats),
+	}
+}
+
+// allow consumes a token from the limiter of the target peer, returning
+// false if the peer has exhausted its allowance.
+func (r *htlcRateLimiter) allow(peer [33]byte) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	stats, ok := r.stats[peer]
+	if !ok {
+		stats = &RateLimitStats{}
+		r.stats[peer] = stats
+	}
+
+	if r.limit <= 0 {
+		stats.Accepted++
+		return true
+	}
+
+	limiter, ok := r.limiters[peer]
+	if !ok {
+		limiter = rate.NewLimiter(r.limit, r.burst)
+		r.limiters[peer] = limiter
+	}
+
+	if !limiter.AllowN(r.now(), 1) {
+		stats.Rejected++
+		return false
+	}
+
+	stats.Accepted++
+
+	return true
+}
+
+// forget drops the limiter and counters of the target peer. This is called
+// once the switch no longer has any links with the peer, so that the state
+// of peers we've stopped forwarding for doesn't accumulate.
+func (r *htlcRateLimiter) forget(peer [33]byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	delete(r.limiters, peer)
+	delete(r.stats, peer)
+}
+
+// snapshot returns a copy of the counters of all peers.
+func (r *htlcRateLimiter) snapshot() map[[33]byte]RateLimitStats {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	stats := make(map[[33]byte]RateLimitStats, len(r.stats))
+	for peer, s := range r.stats {
+		stats[peer] = *s
+	}
+
+	return stats
+}
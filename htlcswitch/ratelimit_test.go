@@ -0,0 +1,128 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+)
+
+// TestHtlcRateLimiter asserts that the rate limiter permits bursts up to its
+// configured size, replenishes tokens over time, and tracks each peer
+// independently.
+func TestHtlcRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	limiter := newHtlcRateLimiter(2, 3)
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	var alice, bob [33]byte
+	alice[0] = 0x02
+	bob[0] = 0x03
+
+	// Alice should be able to send a full burst, but no more.
+	for i := 0; i < 3; i++ {
+		if !limiter.allow(alice) {
+			t.Fatalf("add #%d within burst rejected", i)
+		}
+	}
+	if limiter.allow(alice) {
+		t.Fatalf("add beyond burst accepted")
+	}
+
+	// Bob has his own bucket, so he shouldn't be affected by Alice.
+	if !limiter.allow(bob) {
+		t.Fatalf("add from other peer rejected")
+	}
+
+	// After half a second, a single token should be replenished.
+	now = now.Add(500 * time.Millisecond)
+	if !limiter.allow(alice) {
+		t.Fatalf("add after refill rejected")
+	}
+	if limiter.allow(alice) {
+		t.Fatalf("add beyond refill accepted")
+	}
+
+	// Waiting a long time should never refill beyond the burst size.
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		if !limiter.allow(alice) {
+			t.Fatalf("add #%d within burst rejected", i)
+		}
+	}
+	if limiter.allow(alice) {
+		t.Fatalf("add beyond burst accepted")
+	}
+
+	stats := limiter.snapshot()
+	if stats[alice].Accepted != 7 || stats[alice].Rejected != 3 {
+		t.Fatalf("unexpected stats for alice: %v", stats[alice])
+	}
+	if stats[bob].Accepted != 1 || stats[bob].Rejected != 0 {
+		t.Fatalf("unexpected stats for bob: %v", stats[bob])
+	}
+}
+
+// TestHtlcRateLimiterDisabled asserts that a zero rate disables the limiter
+// while still counting accepted adds.
+func TestHtlcRateLimiterDisabled(t *testing.T) {
+	t.Parallel()
+
+	limiter := newHtlcRateLimiter(0, 0)
+
+	var peer [33]byte
+	for i := 0; i < 1000; i++ {
+		if !limiter.allow(peer) {
+			t.Fatalf("add rejected with limiter disabled")
+		}
+	}
+
+	if limiter.snapshot()[peer].Accepted != 1000 {
+		t.Fatalf("expected 1000 accepted adds")
+	}
+}
+
+// TestSwitchForgetsRateLimitStats asserts that the switch drops the rate
+// limiting state of a peer once its last link is removed.
+func TestSwitchForgetsRateLimitStats(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, _, aliceChanID, _ := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+
+	// Record an add from Alice, as the switch would when she forwards
+	// an HTLC through us.
+	s.rateLimiter.allow(alicePeer.PubKey())
+	if _, ok := s.HtlcRateLimitStats()[alicePeer.PubKey()]; !ok {
+		t.Fatalf("expected rate limit stats for alice")
+	}
+
+	// Once the link is removed, Alice has no links left, so her stats
+	// should be gone.
+	s.RemoveLink(chanID1)
+	if _, ok := s.HtlcRateLimitStats()[alicePeer.PubKey()]; ok {
+		t.Fatalf("rate limit stats for alice not pruned")
+	}
+}
@@ -117,6 +117,11 @@ type ChanClose struct {
 	// process for the cooperative closure transaction kicks off.
 	TargetFeePerKw lnwallet.SatPerKWeight
 
+	// DeliveryScript is an optional script to pay our funds to in case of
+	// a cooperative closure. If nil, a fresh script of the wallet is used.
+	// This value is only utilized if the closure type is CloseRegular.
+	DeliveryScript lnwire.DeliveryAddress
+
 	// Updates is used by request creator to receive the notifications about
 	// execution of the close channel request.
 	Updates chan *lnrpc.CloseStatusUpdate
@@ -176,6 +181,93 @@ type Config struct {
 	// LogEventTicker is a signal instructing the htlcswitch to log
 	// aggregate stats about it's forwarding during the last interval.
 	LogEventTicker ticker.Ticker
+
+	// HtlcAddRate is the number of incoming HTLC adds per second that
+	// each peer may forward through the switch. Adds in excess of this
+	// rate are failed back with a temporary failure. A value of zero
+	// disables rate limiting.
+	HtlcAddRate float64
+
+	// HtlcAddBurst is the number of incoming HTLC adds a peer may forward
+	// in quick succession before being subject to HtlcAddRate. If zero,
+	// one second worth of adds is permitted.
+	HtlcAddBurst uint32
+
+	// EndorsedSlotReserve is the fraction of each outgoing link's HTLC
+	// slots that is reserved for endorsed HTLCs.
+	EndorsedSlotReserve float64
+
+	// EndorsedLiquidityReserve is the fraction of each outgoing link's
+	// available liquidity that is reserved for endorsed HTLCs.
+	EndorsedLiquidityReserve float64
+
+	// RejectHTLC indicates that the switch should fail every HTLC that
+	// would be forwarded through us, only permitting locally initiated
+	// payments and HTLCs paying our own invoices.
+	RejectHTLC bool
+
+	// ProbeFailureThreshold is the decaying number of failed attempts to
+	// pay our invoices, such as those paying unknown hashes or incorrect
+	// amounts, after which further attempts from the same peer are failed
+	// back without consulting the invoice registry. A value of zero
+	// disables the probing defense.
+	ProbeFailureThreshold float64
+
+	// ProbeDecayHalfLife is the duration after which the weight of a
+	// failed attempt to pay our invoices is halved. If zero,
+	// DefaultProbeDecayHalfLife is used.
+	ProbeDecayHalfLife time.Duration
+
+	// MPPTimeout is the duration for which we'll hold the parts of a
+	// multi-part payment paying one of our invoices, before failing them
+	// back if the rest of the payment hasn't arrived. If zero,
+	// DefaultMPPTimeout is used.
+	MPPTimeout time.Duration
+
+	// MaxHeldInvoiceHTLCs is the maximum number of parts of multi-part
+	// payments paying our invoices that we'll hold at once. Parts received
+	// beyond this bound are failed back with a temporary failure. A value
+	// of zero disables the bound.
+	MaxHeldInvoiceHTLCs int
+
+	// AsyncPaymentPeer returns the public key of the peer on the other
+	// end of the given outgoing channel, if HTLCs destined to that peer
+	// may be held while it's offline. If nil, HTLCs destined to offline
+	// peers are failed back immediately.
+	AsyncPaymentPeer func(lnwire.ShortChannelID) ([33]byte, bool)
+
+	// AsyncHoldCltvMargin is the number of blocks before the expiry of
+	// the incoming HTLC at which we stop holding an HTLC for an offline
+	// peer and fail it back. If zero, DefaultAsyncHoldCltvMargin is used.
+	AsyncHoldCltvMargin uint32
+
+	// AsyncHoldMaxHtlcs is the maximum number of HTLCs held for each
+	// offline peer. If zero, DefaultAsyncHoldMaxHtlcs is used.
+	AsyncHoldMaxHtlcs int
+
+	// AsyncReleaseTicker signals the switch to check whether any of the
+	// peers we're holding HTLCs for can receive them again. It's only
+	// used if AsyncPaymentPeer is set.
+	AsyncReleaseTicker ticker.Ticker
+
+	// PreimageCache is the global witness beacon shared with the links
+	// and the on-chain contract resolvers. The switch subscribes to it so
+	// that forwarded HTLCs can be settled backwards as soon as their
+	// preimage is learned elsewhere, even if the outgoing link is no
+	// longer online to deliver the settle itself. If nil, forwarded HTLCs
+	// are only settled by their outgoing link or its contract resolution.
+	PreimageCache contractcourt.WitnessBeacon
+
+	// MaxLinkStartups is the maximum number of links that may be starting
+	// up concurrently, i.e. re-establishing their channel state and
+	// replaying their forwarding packages. A value of zero lets all links
+	// start up at once.
+	MaxLinkStartups int
+
+	// LinkStartupJitter is the maximum random delay applied before each
+	// link starts up, which spreads out their startup when many of them
+	// are added at once. A value of zero disables the delay.
+	LinkStartupJitter time.Duration
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -273,10 +365,50 @@ type Switch struct {
 	fwdEventMtx         sync.Mutex
 	pendingFwdingEvents []channeldb.ForwardingEvent
 
+	// pendingFwdFailures tallies the causes of the forwards that failed
+	// over each outgoing channel during the current interval. It's
+	// flushed to disk along with the forwarding events, and is guarded
+	// by fwdEventMtx.
+	pendingFwdFailures map[lnwire.ShortChannelID]*channeldb.ForwardFailureCounts
+
 	// blockEpochStream is an active block epoch event stream backed by an
 	// active ChainNotifier instance. This will be used to retrieve the
 	// lastest height of the chain.
 	blockEpochStream *chainntnfs.BlockEpochEvent
+
+	// rateLimiter bounds the rate at which each peer may forward HTLC
+	// adds through the switch.
+	rateLimiter *htlcRateLimiter
+
+	// linkStartups staggers the startup of the links added to the switch.
+	linkStartups *linkStartupGate
+
+	// frozenLinks is the set of channels that are being drained, which
+	// neither accept nor offer new HTLCs. It's guarded by frozenMtx, and
+	// persists across reconnections of the links.
+	frozenMtx   sync.RWMutex
+	frozenLinks map[lnwire.ChannelID]struct{}
+
+	// endorsement tracks peer reputation, decides which HTLCs we endorse
+	// downstream, and confines unendorsed HTLCs to the unreserved portion
+	// of each link's resources.
+	endorsement *endorsementManager
+
+	// asyncHold holds HTLCs destined to offline peers until they come
+	// back online.
+	asyncHold *asyncHoldQueue
+
+	// holdTimes measures how long forwarded HTLCs are held before being
+	// resolved by the outgoing peer.
+	holdTimes *holdTimeTracker
+
+	// mppSets aggregates the parts of multi-part payments paying our
+	// invoices until they're complete.
+	mppSets *mppCollector
+
+	// probeGuard throttles peers whose attempts to pay our invoices keep
+	// failing, limiting the effectiveness of balance probing.
+	probeGuard *probeGuard
 }
 
 // New creates the new instance of htlc switch.
@@ -294,6 +426,13 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		return nil, err
 	}
 
+	endorsement, err := newEndorsementManager(
+		cfg.DB, cfg.EndorsedSlotReserve, cfg.EndorsedLiquidityReserve,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Switch{
 		bestHeight:        currentHeight,
 		cfg:               &cfg,
@@ -309,7 +448,25 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		htlcPlex:          make(chan *plexPacket),
 		chanCloseRequests: make(chan *ChanClose),
 		resolutionMsgs:    make(chan *resolutionMsg),
-		quit:              make(chan struct{}),
+		rateLimiter: newHtlcRateLimiter(
+			cfg.HtlcAddRate, cfg.HtlcAddBurst,
+		),
+		linkStartups: newLinkStartupGate(
+			cfg.MaxLinkStartups, cfg.LinkStartupJitter,
+		),
+		frozenLinks: make(map[lnwire.ChannelID]struct{}),
+		endorsement: endorsement,
+		asyncHold: newAsyncHoldQueue(
+			cfg.AsyncHoldCltvMargin, cfg.AsyncHoldMaxHtlcs,
+		),
+		holdTimes: newHoldTimeTracker(),
+		mppSets: newMPPCollector(
+			cfg.MPPTimeout, cfg.MaxHeldInvoiceHTLCs,
+		),
+		probeGuard: newProbeGuard(
+			cfg.ProbeFailureThreshold, cfg.ProbeDecayHalfLife,
+		),
+		quit: make(chan struct{}),
 	}, nil
 }
 
@@ -381,6 +538,9 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 	s.pendingPayments[paymentID] = payment
 	s.pendingMutex.Unlock()
 
+	// We always vouch for the payments we originate ourselves.
+	htlc.Endorsed = true
+
 	// Generate and send new update packet, if error will be received on
 	// this stage it means that packet haven't left boundaries of our
 	// system and something wrong happened.
@@ -780,7 +940,7 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 			}
 		}
 
-		if !link.EligibleToForward() {
+		if !link.EligibleToForward() || s.IsLinkFrozen(link.ChanID()) {
 			err := fmt.Errorf("Link %v is not available to forward",
 				pkt.outgoingChanID)
 			log.Error(err)
@@ -1020,11 +1180,104 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return s.handleLocalDispatch(packet)
 		}
 
+		// If we're configured to reject all forwards, then we'll fail
+		// the HTLC back right away, signalling that the outgoing
+		// channel is disabled so the sender routes around us.
+		if s.cfg.RejectHTLC {
+			var failure lnwire.FailureMessage
+			update, err := s.cfg.FetchLastChannelUpdate(
+				packet.outgoingChanID,
+			)
+			if err != nil {
+				failure = &lnwire.FailUnknownNextPeer{}
+			} else {
+				failure = lnwire.NewChannelDisabled(
+					uint16(update.Flags), *update,
+				)
+			}
+
+			addErr := fmt.Errorf("rejecting forward of HTLC(%x), "+
+				"node configured to reject all forwards",
+				htlc.PaymentHash[:])
+
+			return s.failAddPacket(packet, failure, addErr)
+		}
+
+		// If the outgoing HTLC would expire before the next hop has a
+		// chance to resolve it, then there's no point in forwarding
+		// it, as we'd only have to go on chain to time it out.
+		currentHeight := atomic.LoadUint32(&s.bestHeight)
+		if packet.outgoingTimeout <= currentHeight+expiryGraceDelta {
+			s.recordForwardFailure(
+				packet.outgoingChanID, fwdFailurePolicy,
+			)
+
+			failure := s.expiryTooSoonFailure(packet.outgoingChanID)
+			addErr := fmt.Errorf("outgoing HTLC(%x) expiry too "+
+				"soon: expiry=%v, best_height=%v",
+				htlc.PaymentHash[:], packet.outgoingTimeout,
+				currentHeight)
+
+			return s.failAddPacket(packet, failure, addErr)
+		}
+
 		s.indexMtx.RLock()
+
+		// Before doing any further work, ensure the peer that sent us
+		// this HTLC hasn't exceeded its allowance. If it has, we'll
+		// fail the HTLC back with a temporary failure, as it may
+		// succeed once the peer slows down. HTLCs released after being
+		// held for an offline peer were already admitted when they
+		// first arrived.
+		sourceLink, err := s.getLinkByShortID(packet.incomingChanID)
+		if err == nil && !packet.wasHeld &&
+			!s.rateLimiter.allow(sourceLink.Peer().PubKey()) {
+
+			s.indexMtx.RUnlock()
+
+			var failure lnwire.FailureMessage
+			update, err := s.cfg.FetchLastChannelUpdate(
+				packet.outgoingChanID,
+			)
+			if err != nil {
+				failure = &lnwire.FailTemporaryNodeFailure{}
+			} else {
+				failure = lnwire.NewTemporaryChannelFailure(update)
+			}
+
+			addErr := fmt.Errorf("incoming HTLC(%x) from peer %x "+
+				"exceeded rate limit", htlc.PaymentHash[:],
+				sourceLink.Peer().PubKey())
+
+			return s.failAddPacket(packet, failure, addErr)
+		}
+
+		// We'll only vouch for the HTLC downstream if the peer that
+		// sent it both endorsed it and has earned a high reputation
+		// with us.
+		var incomingPeer [33]byte
+		if sourceLink != nil {
+			incomingPeer = sourceLink.Peer().PubKey()
+		}
+		htlc.Endorsed = s.endorsement.outgoingEndorsement(
+			incomingPeer, htlc.Endorsed,
+		)
+
 		targetLink, err := s.getLinkByShortID(packet.outgoingChanID)
 		if err != nil {
 			s.indexMtx.RUnlock()
 
+			// If the next peer is offline, but we're willing to
+			// hold HTLCs on its behalf, we'll hold on to the HTLC
+			// until it comes back online.
+			if s.holdForOfflinePeer(packet) {
+				return nil
+			}
+
+			s.recordForwardFailure(
+				packet.outgoingChanID, fwdFailureLinkOffline,
+			)
+
 			// If packet was forwarded from another channel link
 			// than we should notify this link that some error
 			// occurred.
@@ -1053,6 +1306,11 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			case !link.EligibleToForward():
 				continue
 
+			// Frozen links are being drained, so they won't accept
+			// any new HTLCs.
+			case s.IsLinkFrozen(link.ChanID()):
+				continue
+
 			// If the link doesn't yet have a source chan ID, then
 			// we'll skip it as well.
 			case link.ShortChanID() == sourceHop:
@@ -1065,15 +1323,28 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			currentHeight := atomic.LoadUint32(&s.bestHeight)
 			err := link.HtlcSatifiesPolicy(
 				htlc.PaymentHash, packet.incomingAmount,
-				packet.amount, packet.incomingTimeout,
-				packet.outgoingTimeout, currentHeight,
+				packet.amount, packet.inboundFee,
+				packet.incomingTimeout, packet.outgoingTimeout,
+				currentHeight,
 			)
 			if err != nil {
 				linkErrs[link.ShortChanID()] = err
 				continue
 			}
 
-			if link.Bandwidth() >= htlc.Amount {
+			// Unendorsed HTLCs may not dip into the resources
+			// reserved for endorsed HTLCs. If the unreserved
+			// portion is exhausted, we'll treat the link as if it
+			// has insufficient capacity.
+			bandwidth := link.Bandwidth()
+			if !s.endorsement.admit(link.ShortChanID(),
+				link.OutgoingFlowConstraints(), bandwidth,
+				htlc.Amount, htlc.Endorsed) {
+
+				continue
+			}
+
+			if bandwidth >= htlc.Amount {
 				destination = link
 
 				break
@@ -1086,6 +1357,11 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 		// forwarding policies, then we'll cancel the htlc as the
 		// payment cannot succeed.
 		case destination == nil && len(linkErrs) == 0:
+			s.recordForwardFailure(
+				packet.outgoingChanID,
+				fwdFailureInsufficientBalance,
+			)
+
 			// If packet was forwarded from another channel link
 			// than we should notify this link that some error
 			// occurred.
@@ -1110,6 +1386,10 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 		// error, but ensure we send back the error sourced at the
 		// *target* link.
 		case destination == nil && len(linkErrs) != 0:
+			s.recordForwardFailure(
+				packet.outgoingChanID, fwdFailurePolicy,
+			)
+
 			// At this point, some or all of the links rejected the
 			// HTLC so we couldn't forward it. So we'll try to look
 			// up the error that came from the source.
@@ -1137,7 +1417,23 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 		// Send the packet to the destination channel link which
 		// manages the channel.
 		packet.outgoingChanID = destination.ShortChanID()
-		return destination.HandleSwitchPacket(packet)
+		err = destination.HandleSwitchPacket(packet)
+		if err != nil {
+			return err
+		}
+
+		s.endorsement.forwarded(packet.inKey(), inflightHtlc{
+			incomingPeer: incomingPeer,
+			outgoing:     packet.outgoingChanID,
+			amount:       htlc.Amount,
+			endorsed:     htlc.Endorsed,
+		})
+		s.holdTimes.forwarded(
+			packet.inKey(), packet.outgoingChanID,
+			destination.Peer().PubKey(),
+		)
+
+		return nil
 
 	case *lnwire.UpdateFailHTLC, *lnwire.UpdateFulfillHTLC:
 		// If the source of this packet has not been set, use the
@@ -1148,6 +1444,33 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 		}
 
 		fail, isFail := htlc.(*lnwire.UpdateFailHTLC)
+
+		// Now that the HTLC has been resolved, release the resources
+		// it occupied and credit the outcome to the peer that sent
+		// it. Failures that originate from our outgoing link or from
+		// an on-chain resolution aren't the fault of that peer, so
+		// they don't count against its reputation.
+		outcome := htlcSettled
+		switch {
+		case isFail && (packet.hasSource || packet.isResolution):
+			outcome = htlcFailedLocally
+		case isFail:
+			outcome = htlcFailed
+		}
+		s.endorsement.resolved(circuit.Incoming, outcome)
+		s.holdTimes.resolved(circuit.Incoming)
+
+		// If a forward was failed by the remote peer of the outgoing
+		// channel, or a node further along the route, then we'll
+		// account the failure to the outgoing channel.
+		if isFail && !packet.hasSource && circuit.Outgoing != nil &&
+			circuit.Incoming.ChanID != sourceHop {
+
+			s.recordForwardFailure(
+				circuit.Outgoing.ChanID, fwdFailureDownstream,
+			)
+		}
+
 		if isFail && !packet.hasSource {
 			switch {
 			case circuit.ErrorEncrypter == nil:
@@ -1412,10 +1735,12 @@ func (s *Switch) teardownCircuit(pkt *htlcPacket) error {
 
 // CloseLink creates and sends the close channel command to the target link
 // directing the specified closure type. If the closure type if CloseRegular,
-// then the last parameter should be the ideal fee-per-kw that will be used as
-// a starting point for close negotiation.
+// then the targetFeePerKw parameter should be the ideal fee-per-kw that will
+// be used as a starting point for close negotiation, and deliveryScript may
+// optionally be set to the script our funds should be paid to.
 func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
-	targetFeePerKw lnwallet.SatPerKWeight) (chan *lnrpc.CloseStatusUpdate,
+	targetFeePerKw lnwallet.SatPerKWeight,
+	deliveryScript lnwire.DeliveryAddress) (chan *lnrpc.CloseStatusUpdate,
 	chan error) {
 
 	// TODO(roasbeef) abstract out the close updates.
@@ -1427,6 +1752,7 @@ func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
 		ChanPoint:      chanPoint,
 		Updates:        updateChan,
 		TargetFeePerKw: targetFeePerKw,
+		DeliveryScript: deliveryScript,
 		Err:            errChan,
 	}
 
@@ -1516,6 +1842,16 @@ func (s *Switch) htlcForwarder() {
 	s.cfg.FwdEventTicker.Resume()
 	defer s.cfg.FwdEventTicker.Stop()
 
+	// If we hold HTLCs for offline peers, we'll periodically check
+	// whether they've come back online.
+	var asyncReleaseTicks <-chan time.Time
+	if s.cfg.AsyncPaymentPeer != nil {
+		s.cfg.AsyncReleaseTicker.Resume()
+		defer s.cfg.AsyncReleaseTicker.Stop()
+
+		asyncReleaseTicks = s.cfg.AsyncReleaseTicker.Ticks()
+	}
+
 out:
 	for {
 		select {
@@ -1526,6 +1862,19 @@ out:
 
 			atomic.StoreUint32(&s.bestHeight, uint32(blockEpoch.Height))
 
+			// With the new height, some of the HTLCs we're holding
+			// for offline peers may now be too close to expiry.
+			s.failExpiredHeldHtlcs(uint32(blockEpoch.Height))
+
+			// The same goes for HTLCs that are still waiting to
+			// be added to their outgoing channel.
+			s.failExpiredForwards(uint32(blockEpoch.Height))
+
+		// When this ticks, we'll forward any held HTLCs whose
+		// destination peer has come back online.
+		case <-asyncReleaseTicks:
+			s.releaseHeldHtlcs()
+
 		// A local close request has arrived, we'll forward this to the
 		// relevant link (if it exists) so the channel can be
 		// cooperatively closed (if possible).
@@ -1601,6 +1950,11 @@ out:
 					log.Errorf("unable to flush "+
 						"forwarding events: %v", err)
 				}
+
+				if err := s.endorsement.flush(); err != nil {
+					log.Errorf("unable to flush peer "+
+						"reputations: %v", err)
+				}
 			}()
 
 		// The log ticker has fired, so we'll calculate some forwarding
@@ -1704,6 +2058,13 @@ func (s *Switch) Start() error {
 	s.wg.Add(1)
 	go s.htlcForwarder()
 
+	if s.cfg.PreimageCache != nil {
+		if sub := s.cfg.PreimageCache.SubscribeUpdates(); sub != nil {
+			s.wg.Add(1)
+			go s.preimageWatcher(sub)
+		}
+	}
+
 	if err := s.reforwardResponses(); err != nil {
 		s.Stop()
 		log.Errorf("unable to reforward responses: %v", err)
@@ -1713,6 +2074,77 @@ func (s *Switch) Start() error {
 	return nil
 }
 
+// preimageWatcher consumes the preimages added to the global witness beacon,
+// and settles backwards any forwarded HTLCs paying their hash whose outgoing
+// link isn't active. Such HTLCs would otherwise have to wait for the outgoing
+// channel to come back online, or for its contract to be resolved on-chain,
+// even though the preimage is already known, e.g. because another HTLC paying
+// the same hash was settled.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *Switch) preimageWatcher(sub *contractcourt.WitnessSubscription) {
+	defer s.wg.Done()
+	defer sub.CancelSubscription()
+
+	for {
+		select {
+		case pre, ok := <-sub.WitnessUpdates:
+			if !ok {
+				return
+			}
+
+			s.settleForwardsWithPreimage(pre)
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// settleForwardsWithPreimage settles backwards the open forwarded circuits
+// paying the hash of the given preimage whose outgoing link isn't active.
+// Circuits with an active outgoing link are left alone, as the link will
+// deliver the settle once received from the remote peer.
+func (s *Switch) settleForwardsWithPreimage(pre []byte) {
+	var preimage [32]byte
+	if len(pre) != len(preimage) {
+		return
+	}
+	copy(preimage[:], pre)
+
+	hash := sha256.Sum256(preimage[:])
+	for _, circuit := range s.circuits.LookupByPaymentHash(hash) {
+		if circuit.Incoming.ChanID == sourceHop ||
+			circuit.Outgoing == nil {
+
+			continue
+		}
+
+		s.indexMtx.RLock()
+		_, err := s.getLinkByShortID(circuit.Outgoing.ChanID)
+		s.indexMtx.RUnlock()
+		if err == nil {
+			continue
+		}
+
+		log.Infof("Settling forwarded htlc (%s, %d) with preimage "+
+			"learned elsewhere for hash=%x", circuit.Incoming.ChanID,
+			circuit.Incoming.HtlcID, hash[:])
+
+		// The settle is processed just like one learned on-chain, so
+		// that a later resolution of the outgoing HTLC is ignored as
+		// the circuit will already have been closed.
+		err = s.ProcessContractResolution(contractcourt.ResolutionMsg{
+			SourceChan: circuit.Outgoing.ChanID,
+			HtlcIndex:  circuit.Outgoing.HtlcID,
+			PreImage:   &preimage,
+		})
+		if err != nil {
+			return
+		}
+	}
+}
+
 // reforwardResponses for every known, non-pending channel, loads all associated
 // forwarding packages and reforwards any Settle or Fail HTLCs found. This is
 // used to resurrect the switch's mailboxes after a restart.
@@ -1887,9 +2319,26 @@ func (s *Switch) Stop() error {
 	// accessed and modified.
 	s.mailOrchestrator.Stop()
 
+	// Now that no more HTLCs can be resolved, persist any reputation
+	// updates that haven't been flushed yet.
+	if err := s.endorsement.flush(); err != nil {
+		log.Errorf("unable to flush peer reputations: %v", err)
+	}
+
+	s.mppSets.stop()
+
 	return nil
 }
 
+// AcquireLinkStartup blocks until a link is allowed to start up, which is
+// bounded by MaxLinkStartups and delayed by up to LinkStartupJitter. The
+// returned closure must be called once the startup of the link has completed.
+// If the passed quit channel or the switch is shut down while waiting, then
+// ErrLinkShuttingDown is returned.
+func (s *Switch) AcquireLinkStartup(linkQuit <-chan struct{}) (func(), error) {
+	return s.linkStartups.acquire(linkQuit, s.quit)
+}
+
 // AddLink is used to initiate the handling of the add link command. The
 // request will be propagated and handled in the main goroutine.
 func (s *Switch) AddLink(link ChannelLink) error {
@@ -1954,6 +2403,36 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 	s.interfaceIndex[peerPub][link.ChanID()] = link
 }
 
+// BandwidthSnapshot returns the bandwidth currently available to send over
+// each of our links, keyed by their short channel ID. The bandwidth of each
+// link already accounts for its pending HTLCs, those waiting in its overflow
+// queue, and its channel reserve. Links that aren't yet eligible to forward
+// HTLCs are reported with zero bandwidth, while pending links aren't reported
+// at all. This allows the router to learn the balances of all of our channels
+// at once before building routes.
+func (s *Switch) BandwidthSnapshot() map[lnwire.ShortChannelID]lnwire.MilliSatoshi {
+	// We'll only hold the index lock while collecting the links, as
+	// querying their bandwidth requires acquiring the lock of each
+	// channel.
+	s.indexMtx.RLock()
+	links := make([]ChannelLink, 0, len(s.linkIndex))
+	for _, link := range s.linkIndex {
+		links = append(links, link)
+	}
+	s.indexMtx.RUnlock()
+
+	snapshot := make(map[lnwire.ShortChannelID]lnwire.MilliSatoshi)
+	for _, link := range links {
+		var bandwidth lnwire.MilliSatoshi
+		if link.EligibleToForward() {
+			bandwidth = link.Bandwidth()
+		}
+		snapshot[link.ShortChanID()] = bandwidth
+	}
+
+	return snapshot
+}
+
 // GetLink is used to initiate the handling of the get link command. The
 // request will be propagated/handled to/in the main goroutine.
 func (s *Switch) GetLink(chanID lnwire.ChannelID) (ChannelLink, error) {
@@ -1963,6 +2442,131 @@ func (s *Switch) GetLink(chanID lnwire.ChannelID) (ChannelLink, error) {
 	return s.getLink(chanID)
 }
 
+// IsForwardedHTLC consults the circuit map to determine whether the HTLC
+// with the given index on the target channel is part of a forward through
+// our node, rather than a locally initiated payment or one paying one of our
+// own invoices.
+func (s *Switch) IsForwardedHTLC(chanID lnwire.ShortChannelID,
+	htlcIndex uint64, incoming bool) bool {
+
+	key := CircuitKey{
+		ChanID: chanID,
+		HtlcID: htlcIndex,
+	}
+
+	// Incoming HTLCs only enter the circuit map if we attempted to
+	// forward them, as exit hop HTLCs are settled by the link itself.
+	if incoming {
+		return s.circuits.LookupCircuit(key) != nil
+	}
+
+	// For outgoing HTLCs, we'll need to inspect the open circuit to
+	// distinguish our own payments from those we forwarded.
+	circuit := s.circuits.LookupOpenCircuit(key)
+	if circuit == nil {
+		return false
+	}
+
+	return circuit.Incoming.ChanID != sourceHop
+}
+
+// PendingHTLCs returns a snapshot of the HTLCs pending within the current
+// commitment of the target link.
+func (s *Switch) PendingHTLCs(chanID lnwire.ChannelID) ([]HTLCSnapshot,
+	error) {
+
+	s.indexMtx.RLock()
+	link, err := s.getLink(chanID)
+	s.indexMtx.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return link.PendingHTLCs(), nil
+}
+
+// QuiesceLink requests that the target link enter quiescence, allowing other
+// subsystems to operate on the channel without any updates in flight. The
+// returned channel receives nil once the link is quiescent, or an error if
+// quiescence couldn't be reached. While quiescent, the link won't be
+// considered eligible to forward HTLCs.
+func (s *Switch) QuiesceLink(chanID lnwire.ChannelID) (<-chan error, error) {
+	s.indexMtx.RLock()
+	link, err := s.getLink(chanID)
+	s.indexMtx.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return link.Quiesce(), nil
+}
+
+// ProposeLinkConstraints proposes new flow constraints to the remote party of
+// the target link, bounding the HTLCs it may offer to us. The link must have
+// been quiesced through QuiesceLink beforehand. The returned channel receives
+// nil once the constraints have been applied by both parties, or an error if
+// they weren't. In either case, the link resumes once the remote party has
+// responded.
+func (s *Switch) ProposeLinkConstraints(chanID lnwire.ChannelID,
+	constraints lnwallet.FlowConstraints) (<-chan error, error) {
+
+	s.indexMtx.RLock()
+	link, err := s.getLink(chanID)
+	s.indexMtx.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return link.ProposeFlowConstraints(constraints), nil
+}
+
+// FreezeLink puts the target channel into drain mode, in which it neither
+// accepts nor offers any new HTLCs, while those already in flight are allowed
+// to resolve. This is useful before closing the channel, or carrying out any
+// maintenance on it. The channel remains frozen across reconnections of its
+// link, until ThawLink is called or the switch is restarted.
+func (s *Switch) FreezeLink(chanID lnwire.ChannelID) {
+	s.frozenMtx.Lock()
+	s.frozenLinks[chanID] = struct{}{}
+	s.frozenMtx.Unlock()
+
+	log.Infof("ChannelLink(%v) frozen, draining in-flight HTLCs", chanID)
+}
+
+// ThawLink takes the target channel out of drain mode, allowing it to carry
+// new HTLCs once again.
+func (s *Switch) ThawLink(chanID lnwire.ChannelID) {
+	s.frozenMtx.Lock()
+	delete(s.frozenLinks, chanID)
+	s.frozenMtx.Unlock()
+
+	log.Infof("ChannelLink(%v) thawed", chanID)
+}
+
+// IsLinkFrozen returns true if the target channel is in drain mode.
+func (s *Switch) IsLinkFrozen(chanID lnwire.ChannelID) bool {
+	s.frozenMtx.RLock()
+	defer s.frozenMtx.RUnlock()
+
+	_, ok := s.frozenLinks[chanID]
+	return ok
+}
+
+// ResumeLink terminates quiescence of the target link, allowing it to
+// forward HTLCs once again.
+func (s *Switch) ResumeLink(chanID lnwire.ChannelID) error {
+	s.indexMtx.RLock()
+	link, err := s.getLink(chanID)
+	s.indexMtx.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	link.ResumeQuiescence()
+
+	return nil
+}
+
 // getLink returns the link stored in either the pending index or the live
 // lindex.
 func (s *Switch) getLink(chanID lnwire.ChannelID) (ChannelLink, error) {
@@ -2036,9 +2640,11 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 		delete(peerIndex, link.ChanID())
 
 		// If after deletion, there are no longer any links, then we'll
-		// remove the interface map all together.
+		// remove the interface map all together, along with the
+		// peer's rate limiting state.
 		if len(peerIndex) == 0 {
 			delete(s.interfaceIndex, peerPub)
+			s.rateLimiter.forget(peerPub)
 		}
 	}
 
@@ -2196,13 +2802,17 @@ func (s *Switch) lookupOpenCircuit(outKey CircuitKey) *PaymentCircuit {
 // method to ensure all data is flushed to dis before querying the log.
 func (s *Switch) FlushForwardingEvents() error {
 	// First, we'll obtain a copy of the current set of pending forwarding
-	// events.
+	// events, along with the failures tallied since the last flush.
 	s.fwdEventMtx.Lock()
 
-	// If we won't have any forwarding events, then we can exit early.
+	failures := s.pendingFwdFailures
+	s.pendingFwdFailures = nil
+
+	// If we won't have any forwarding events, then we only need to write
+	// out the failures.
 	if len(s.pendingFwdingEvents) == 0 {
 		s.fwdEventMtx.Unlock()
-		return nil
+		return s.cfg.DB.AddForwardFailures(failures)
 	}
 
 	events := make([]channeldb.ForwardingEvent, len(s.pendingFwdingEvents))
@@ -2214,11 +2824,243 @@ func (s *Switch) FlushForwardingEvents() error {
 	s.pendingFwdingEvents = s.pendingFwdingEvents[:0]
 	s.fwdEventMtx.Unlock()
 
+	if err := s.cfg.DB.AddForwardFailures(failures); err != nil {
+		return err
+	}
+
 	// Finally, we'll write out the copied events to the persistent
 	// forwarding log.
 	return s.cfg.FwdingLog.AddForwardingEvents(events)
 }
 
+// fwdFailureCause is the reason for which a forward over an outgoing channel
+// failed.
+type fwdFailureCause uint8
+
+const (
+	// fwdFailurePolicy indicates that the forward violated our policy for
+	// the outgoing channel.
+	fwdFailurePolicy fwdFailureCause = iota
+
+	// fwdFailureDownstream indicates that the forward was failed by the
+	// remote peer of the outgoing channel, or a node further along the
+	// route.
+	fwdFailureDownstream
+
+	// fwdFailureInsufficientBalance indicates that our local balance in
+	// the outgoing channel couldn't carry the forward.
+	fwdFailureInsufficientBalance
+
+	// fwdFailureLinkOffline indicates that the outgoing channel wasn't
+	// online.
+	fwdFailureLinkOffline
+)
+
+// recordForwardFailure tallies a failed forward over the given outgoing
+// channel, to be written to disk along with the next batch of forwarding
+// events.
+func (s *Switch) recordForwardFailure(chanID lnwire.ShortChannelID,
+	cause fwdFailureCause) {
+
+	s.fwdEventMtx.Lock()
+	defer s.fwdEventMtx.Unlock()
+
+	if s.pendingFwdFailures == nil {
+		s.pendingFwdFailures = make(
+			map[lnwire.ShortChannelID]*channeldb.ForwardFailureCounts,
+		)
+	}
+
+	counts, ok := s.pendingFwdFailures[chanID]
+	if !ok {
+		counts = &channeldb.ForwardFailureCounts{}
+		s.pendingFwdFailures[chanID] = counts
+	}
+
+	switch cause {
+	case fwdFailurePolicy:
+		counts.Policy++
+	case fwdFailureDownstream:
+		counts.Downstream++
+	case fwdFailureInsufficientBalance:
+		counts.InsufficientBalance++
+	case fwdFailureLinkOffline:
+		counts.LinkOffline++
+	}
+}
+
+// HtlcRateLimitStats returns the incoming HTLC rate limiting counters of
+// every peer that has forwarded an HTLC through the switch, keyed by the
+// peer's compressed public key.
+func (s *Switch) HtlcRateLimitStats() map[[33]byte]RateLimitStats {
+	return s.rateLimiter.snapshot()
+}
+
+// MPPStats returns the counters of the parts of multi-part payments held while
+// waiting for the rest of their payment to arrive.
+func (s *Switch) MPPStats() MPPStats {
+	return s.mppSets.stats()
+}
+
+// ChannelHoldTimes returns the hold time statistics of the forwards over each
+// outgoing channel, keyed by the channel's short channel ID.
+func (s *Switch) ChannelHoldTimes() map[lnwire.ShortChannelID]HoldTimeStats {
+	return s.holdTimes.channelStats()
+}
+
+// PeerHoldTimes returns the hold time statistics of the forwards to each
+// outgoing peer, keyed by the peer's compressed public key.
+func (s *Switch) PeerHoldTimes() map[[33]byte]HoldTimeStats {
+	return s.holdTimes.peerStats()
+}
+
+// holdForOfflinePeer attempts to hold an HTLC destined to an offline peer
+// until the peer comes back online. It returns true if the HTLC is now held,
+// and false if it should be failed back.
+func (s *Switch) holdForOfflinePeer(packet *htlcPacket) bool {
+	if s.cfg.AsyncPaymentPeer == nil {
+		return false
+	}
+
+	peer, ok := s.cfg.AsyncPaymentPeer(packet.outgoingChanID)
+	if !ok {
+		return false
+	}
+
+	height := atomic.LoadUint32(&s.bestHeight)
+	if !s.asyncHold.hold(peer, packet, height) {
+		return false
+	}
+
+	log.Infof("Holding HTLC(%v) destined to offline peer %x until it "+
+		"reconnects", packet.inKey(), peer)
+
+	return true
+}
+
+// releaseHeldHtlcs forwards the HTLCs held for each offline peer that now
+// has a link able to forward them.
+func (s *Switch) releaseHeldHtlcs() {
+	for _, peer := range s.asyncHold.peers() {
+		s.indexMtx.RLock()
+		links, _ := s.getLinks(peer)
+		var eligible bool
+		for _, link := range links {
+			if link.EligibleToForward() {
+				eligible = true
+				break
+			}
+		}
+		s.indexMtx.RUnlock()
+
+		if !eligible {
+			continue
+		}
+
+		packets := s.asyncHold.release(peer)
+
+		log.Infof("Peer %x back online, releasing %d held HTLCs",
+			peer, len(packets))
+
+		for _, packet := range packets {
+			packet.wasHeld = true
+			if err := s.handlePacketForward(packet); err != nil {
+				log.Errorf("Unable to forward held HTLC(%v): "+
+					"%v", packet.inKey(), err)
+			}
+		}
+	}
+}
+
+// failExpiredHeldHtlcs fails back all held HTLCs that, at the given height,
+// are too close to expiry to be held any longer.
+func (s *Switch) failExpiredHeldHtlcs(height uint32) {
+	for _, packet := range s.asyncHold.expire(height) {
+		addErr := fmt.Errorf("held HTLC(%v) expiring at height %v, "+
+			"destination %v still offline", packet.inKey(),
+			packet.incomingTimeout, packet.outgoingChanID)
+
+		s.recordForwardFailure(
+			packet.outgoingChanID, fwdFailureLinkOffline,
+		)
+
+		// Any error is already logged when failing the packet.
+		s.failAddPacket(packet, &lnwire.FailUnknownNextPeer{}, addErr)
+	}
+}
+
+// failExpiredForwards fails back the HTLCs waiting within the mailboxes of
+// their outgoing links, such as those of offline peers, that at the given
+// height are too close to expiry to still be forwarded. Their circuits are
+// only half-open, so it's safe to fail them back without waiting for the
+// outgoing link.
+func (s *Switch) failExpiredForwards(height uint32) {
+	expired := s.mailOrchestrator.PruneExpiredAdds(height + expiryGraceDelta)
+	for _, packet := range expired {
+		log.Warnf("Failing HTLC(%v) waiting to be forwarded to %v, "+
+			"outgoing expiry %v too close to height %v",
+			packet.inKey(), packet.outgoingChanID,
+			packet.outgoingTimeout, height)
+
+		failure := s.expiryTooSoonFailure(packet.outgoingChanID)
+
+		// Encrypt the failure back to the source, unless the payment
+		// was initiated by us.
+		var (
+			reason       lnwire.OpaqueReason
+			localFailure bool
+		)
+		if packet.obfuscator == nil {
+			var b bytes.Buffer
+			err := lnwire.EncodeFailure(&b, failure, 0)
+			if err != nil {
+				log.Errorf("Unable to encode failure: %v", err)
+				continue
+			}
+			reason = lnwire.OpaqueReason(b.Bytes())
+			localFailure = true
+		} else {
+			var err error
+			reason, err = packet.obfuscator.EncryptFirstHop(failure)
+			if err != nil {
+				log.Errorf("Unable to obfuscate error: %v", err)
+				continue
+			}
+		}
+
+		failPkt := &htlcPacket{
+			incomingChanID: packet.incomingChanID,
+			incomingHTLCID: packet.incomingHTLCID,
+			circuit:        packet.circuit,
+			sourceRef:      packet.sourceRef,
+			hasSource:      true,
+			localFailure:   localFailure,
+			htlc: &lnwire.UpdateFailHTLC{
+				Reason: reason,
+			},
+		}
+
+		if err := s.handlePacketForward(failPkt); err != nil {
+			log.Errorf("Unable to fail expired HTLC(%v): %v",
+				packet.inKey(), err)
+		}
+	}
+}
+
+// expiryTooSoonFailure returns the failure sent back for HTLCs whose outgoing
+// expiry is too close to the current height to be forwarded over the given
+// channel.
+func (s *Switch) expiryTooSoonFailure(
+	chanID lnwire.ShortChannelID) lnwire.FailureMessage {
+
+	update, err := s.cfg.FetchLastChannelUpdate(chanID)
+	if err != nil || update == nil {
+		return &lnwire.FailTemporaryNodeFailure{}
+	}
+
+	return lnwire.NewExpiryTooSoon(*update)
+}
+
 // BestHeight returns the best height known to the switch.
 func (s *Switch) BestHeight() uint32 {
 	return atomic.LoadUint32(&s.bestHeight)
@@ -17,6 +17,10 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
+// testOutgoingTimeout is the outgoing expiry of the HTLCs forwarded through
+// the switch within the tests, which leaves them enough time to be resolved.
+const testOutgoingTimeout = testStartingHeight + 144
+
 func genPreimage() ([32]byte, error) {
 	var preimage [32]byte
 	if _, err := io.ReadFull(rand.Reader, preimage[:]); err != nil {
@@ -120,10 +124,11 @@ func TestSwitchSendPending(t *testing.T) {
 	}
 	rhash := fastsha256.Sum256(preimage[:])
 	packet := &htlcPacket{
-		incomingChanID: bobChanID,
-		incomingHTLCID: 0,
-		outgoingChanID: aliceChanID,
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  bobChanID,
+		incomingHTLCID:  0,
+		outgoingChanID:  aliceChanID,
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -223,10 +228,11 @@ func TestSwitchForward(t *testing.T) {
 	}
 	rhash := fastsha256.Sum256(preimage[:])
 	packet := &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -251,6 +257,18 @@ func TestSwitchForward(t *testing.T) {
 		t.Fatal("wrong amount of circuits")
 	}
 
+	// Both ends of the circuit should now be reported as part of a
+	// forward.
+	if !s.IsForwardedHTLC(aliceChannelLink.ShortChanID(), 0, true) {
+		t.Fatal("incoming htlc not reported as forwarded")
+	}
+	if !s.IsForwardedHTLC(bobChannelLink.ShortChanID(), 0, false) {
+		t.Fatal("outgoing htlc not reported as forwarded")
+	}
+	if s.IsForwardedHTLC(bobChannelLink.ShortChanID(), 1, false) {
+		t.Fatal("unknown htlc reported as forwarded")
+	}
+
 	// Create settle request pretending that bob link handled the add htlc
 	// request and sent the htlc settle request back. This request should
 	// be forwarder back to Alice link.
@@ -282,6 +300,261 @@ func TestSwitchForward(t *testing.T) {
 	}
 }
 
+// TestSwitchSettleForwardWithBeaconPreimage asserts that a forwarded HTLC
+// whose outgoing link went offline is settled backwards once its preimage is
+// added to the global witness beacon.
+func TestSwitchSettleForwardWithBeaconPreimage(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	pCache := &mockPreimageCache{
+		preimageMap: make(map[[32]byte][]byte),
+		updates:     make(chan []byte, 1),
+	}
+	s.cfg.PreimageCache = pCache
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+	packet := &htlcPacket{
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+
+	if err := s.forward(packet); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+		if err := bobChannelLink.completeCircuit(packet); err != nil {
+			t.Fatalf("unable to complete payment circuit: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+
+	// Bob's link now goes offline, so the settle can't be delivered by
+	// it.
+	s.RemoveLink(chanID2)
+
+	// Once the preimage is learned elsewhere, the HTLC should be settled
+	// back to Alice.
+	if err := pCache.AddPreimage(preimage[:]); err != nil {
+		t.Fatalf("unable to add preimage: %v", err)
+	}
+
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		fulfill, ok := pkt.htlc.(*lnwire.UpdateFulfillHTLC)
+		if !ok {
+			t.Fatalf("expected settle, got %T", pkt.htlc)
+		}
+		if fulfill.PaymentPreimage != preimage {
+			t.Fatalf("wrong preimage: expected %x, got %x",
+				preimage, fulfill.PaymentPreimage)
+		}
+		if err := aliceChannelLink.deleteCircuit(pkt); err != nil {
+			t.Fatalf("unable to remove circuit: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("settle was not propagated to alice")
+	}
+
+	if s.circuits.NumOpen() != 0 {
+		t.Fatal("wrong amount of circuits")
+	}
+}
+
+// TestSwitchRejectHTLC asserts that forwards the switch must refuse are
+// failed back to the incoming link rather than delivered to the outgoing one.
+func TestSwitchRejectHTLC(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		// rejectHTLC is whether the switch is configured to reject all
+		// forwards.
+		rejectHTLC bool
+
+		// outgoingTimeout is the outgoing expiry of the forward.
+		outgoingTimeout uint32
+
+		// policyFailures is the number of policy failures that should
+		// be accounted to the outgoing channel.
+		policyFailures uint64
+	}{
+		{
+			name:            "reject htlc mode",
+			rejectHTLC:      true,
+			outgoingTimeout: testOutgoingTimeout,
+		},
+		{
+			// As the outgoing HTLC would expire within the grace
+			// period, the forward should be rejected.
+			name:            "outgoing expiry too soon",
+			outgoingTimeout: testStartingHeight + expiryGraceDelta,
+			policyFailures:  1,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			testSwitchRejectForward(
+				t, test.rejectHTLC, test.outgoingTimeout,
+				test.policyFailures,
+			)
+		})
+	}
+}
+
+// testSwitchRejectForward forwards an HTLC with the given outgoing expiry
+// through a switch, and asserts that it is failed back to the incoming link,
+// with the expected number of policy failures accounted to the outgoing
+// channel.
+func testSwitchRejectForward(t *testing.T, rejectHTLC bool,
+	outgoingTimeout uint32, policyFailures uint64) {
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	s.cfg.RejectHTLC = rejectHTLC
+	s.cfg.FetchLastChannelUpdate = func(
+		lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error) {
+
+		return &lnwire.ChannelUpdate{}, nil
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+	packet := &htlcPacket{
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: outgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+
+	// The forward should be rejected, and failed back to Alice rather
+	// than being delivered to Bob.
+	if err := s.forward(packet); err == nil {
+		t.Fatalf("forward should have been rejected")
+	}
+
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+			t.Fatalf("expected fail, got %T", pkt.htlc)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("htlc wasn't failed back")
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+		t.Fatal("rejected htlc was forwarded")
+	default:
+	}
+
+	if s.circuits.NumOpen() != 0 {
+		t.Fatal("wrong amount of circuits")
+	}
+
+	// Once flushed to disk, the failure should be accounted to Bob's
+	// channel if it violated our policy.
+	if err := s.FlushForwardingEvents(); err != nil {
+		t.Fatalf("unable to flush forwarding events: %v", err)
+	}
+	failures, err := s.cfg.DB.FetchForwardFailures()
+	if err != nil {
+		t.Fatalf("unable to fetch forward failures: %v", err)
+	}
+	if failures[bobChanID].Policy != policyFailures {
+		t.Fatalf("expected %v policy failures for bob's channel, "+
+			"got %v", policyFailures, failures[bobChanID])
+	}
+}
+
 func TestSwitchForwardFailAfterFullAdd(t *testing.T) {
 	t.Parallel()
 
@@ -337,10 +610,11 @@ func TestSwitchForwardFailAfterFullAdd(t *testing.T) {
 	preimage := [sha256.Size]byte{1}
 	rhash := fastsha256.Sum256(preimage[:])
 	ogPacket := &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -528,10 +802,11 @@ func TestSwitchForwardSettleAfterFullAdd(t *testing.T) {
 	preimage := [sha256.Size]byte{1}
 	rhash := fastsha256.Sum256(preimage[:])
 	ogPacket := &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -722,10 +997,11 @@ func TestSwitchForwardDropAfterFullAdd(t *testing.T) {
 	preimage := [sha256.Size]byte{1}
 	rhash := fastsha256.Sum256(preimage[:])
 	ogPacket := &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -885,10 +1161,11 @@ func TestSwitchForwardFailAfterHalfAdd(t *testing.T) {
 	preimage := [sha256.Size]byte{1}
 	rhash := fastsha256.Sum256(preimage[:])
 	ogPacket := &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -1043,10 +1320,11 @@ func TestSwitchForwardCircuitPersistence(t *testing.T) {
 	preimage := [sha256.Size]byte{1}
 	rhash := fastsha256.Sum256(preimage[:])
 	ogPacket := &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -1266,9 +1544,10 @@ func TestSkipIneligibleLinksMultiHopForward(t *testing.T) {
 	preimage := [sha256.Size]byte{1}
 	rhash := fastsha256.Sum256(preimage[:])
 	packet = &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -1340,6 +1619,98 @@ func TestSkipIneligibleLinksLocalForward(t *testing.T) {
 	}
 }
 
+// TestSkipFrozenLinksForward asserts that the switch neither forwards nor
+// sends any new HTLC over a frozen link, until it's thawed.
+func TestSkipFrozenLinksForward(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	// We'll freeze Bob's link, so that it shouldn't be offered any new
+	// HTLCs, neither forwarded nor sent by us.
+	s.FreezeLink(chanID2)
+	if !s.IsLinkFrozen(chanID2) {
+		t.Fatalf("expected bob link to be frozen")
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+	packet := &htlcPacket{
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+	if err := s.forward(packet); err == nil {
+		t.Fatalf("forwarding should have failed due to frozen link")
+	}
+
+	addMsg := &lnwire.UpdateAddHTLC{
+		PaymentHash: rhash,
+		Amount:      1,
+	}
+	_, err = s.SendHTLC(bobChannelLink.ShortChanID(), addMsg, nil)
+	if err == nil {
+		t.Fatalf("local send should fail due to frozen link")
+	}
+
+	if s.circuits.NumOpen() != 0 {
+		t.Fatal("wrong amount of circuits")
+	}
+
+	// Once thawed, the link should be offered HTLCs once again.
+	s.ThawLink(chanID2)
+	packet.incomingHTLCID = 1
+	if err := s.forward(packet); err != nil {
+		t.Fatalf("unable to forward htlc: %v", err)
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+}
+
 // TestSwitchCancel checks that if htlc was rejected we remove unused
 // circuits.
 func TestSwitchCancel(t *testing.T) {
@@ -1386,10 +1757,11 @@ func TestSwitchCancel(t *testing.T) {
 	}
 	rhash := fastsha256.Sum256(preimage[:])
 	request := &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -1497,10 +1869,11 @@ func TestSwitchAddSamePayment(t *testing.T) {
 	}
 	rhash := fastsha256.Sum256(preimage[:])
 	request := &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -1527,10 +1900,11 @@ func TestSwitchAddSamePayment(t *testing.T) {
 	}
 
 	request = &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 1,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  1,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		outgoingTimeout: testOutgoingTimeout,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -1912,3 +2286,59 @@ func TestMultiHopPaymentForwardingEvents(t *testing.T) {
 		}
 	}
 }
+
+// TestSwitchBandwidthSnapshot checks that the switch reports the bandwidth of
+// each of its links, with links that aren't eligible to forward reported as
+// having no bandwidth.
+func TestSwitchBandwidthSnapshot(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, false,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	snapshot := s.BandwidthSnapshot()
+	if len(snapshot) != 2 {
+		t.Fatalf("expected 2 links in snapshot, got %d", len(snapshot))
+	}
+	if snapshot[aliceChanID] != aliceChannelLink.Bandwidth() {
+		t.Fatalf("expected alice bandwidth %v, got %v",
+			aliceChannelLink.Bandwidth(), snapshot[aliceChanID])
+	}
+
+	// Bob's link isn't eligible to forward, so it shouldn't be considered
+	// to have any bandwidth.
+	if bandwidth, ok := snapshot[bobChanID]; !ok || bandwidth != 0 {
+		t.Fatalf("expected bob link with no bandwidth, got %v",
+			bandwidth)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/offers"
 	"github.com/lightningnetwork/lnd/queue"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
@@ -327,6 +328,12 @@ func (i *invoiceRegistry) LookupInvoice(rHash chainhash.Hash) (channeldb.Invoice
 		return channeldb.Invoice{}, 0, err
 	}
 
+	// Invoices issued in response to an offer are stored using their
+	// BOLT-12 encoding, which carries no final CLTV delta of its own.
+	if offers.IsInvoiceString(string(invoice.PaymentRequest)) {
+		return invoice, offers.DefaultMinFinalCLTVExpiry, nil
+	}
+
 	payReq, err := zpay32.Decode(
 		string(invoice.PaymentRequest), activeNetParams.Params,
 	)
@@ -0,0 +1,356 @@
+package liquidity
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultUpdateInterval is the default interval at which the balances
+	// of our channels are evaluated against their targets.
+	DefaultUpdateInterval = 10 * time.Minute
+
+	// DefaultBudgetPeriod is the default period over which the fee budget
+	// of the manager applies.
+	DefaultBudgetPeriod = 24 * time.Hour
+
+	// DefaultMinRebalanceAmount is the default smallest amount that's
+	// worth rebalancing, below which imbalances are ignored.
+	DefaultMinRebalanceAmount = lnwire.MilliSatoshi(10000000)
+)
+
+// Channel is a snapshot of one of our channels, as evaluated by the Manager.
+type Channel struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Capacity is the total capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our current balance within the channel.
+	LocalBalance lnwire.MilliSatoshi
+}
+
+// localRatio returns the fraction of the capacity of the channel that's on
+// our side.
+func (c *Channel) localRatio() float64 {
+	capacity := lnwire.NewMSatFromSatoshis(c.Capacity)
+	if capacity == 0 {
+		return 0
+	}
+
+	return float64(c.LocalBalance) / float64(capacity)
+}
+
+// Rebalance is a planned circular payment, moving Amount of our balance out of
+// one channel and back into another.
+type Rebalance struct {
+	// From is the channel that the payment leaves through, which has more
+	// outbound liquidity than its target.
+	From *Channel
+
+	// To is the channel that the payment comes back through, which has
+	// less outbound liquidity than its target.
+	To *Channel
+
+	// Amount is the amount to move between the channels.
+	Amount lnwire.MilliSatoshi
+
+	// MaxFee is the maximum fee we're willing to pay for the rebalance.
+	MaxFee lnwire.MilliSatoshi
+}
+
+// Config houses the dependencies and parameters of the Manager.
+type Config struct {
+	// FetchChannels returns a snapshot of all the channels whose balance
+	// should be maintained.
+	FetchChannels func() ([]*Channel, error)
+
+	// Rebalance executes the given rebalance, returning the fee that was
+	// paid for it.
+	Rebalance func(rebalance *Rebalance) (lnwire.MilliSatoshi, error)
+
+	// MinLocalRatio is the fraction of the capacity of a channel below
+	// which our balance is considered too low.
+	MinLocalRatio float64
+
+	// MaxLocalRatio is the fraction of the capacity of a channel above
+	// which our balance is considered too high.
+	MaxLocalRatio float64
+
+	// MaxFeeRate is the maximum fee, in parts per million of the amount
+	// moved, that we're willing to pay for a single rebalance.
+	MaxFeeRate uint32
+
+	// FeeBudget is the total amount of fees that may be spent on
+	// rebalances within each BudgetPeriod.
+	FeeBudget lnwire.MilliSatoshi
+
+	// BudgetPeriod is the period over which FeeBudget applies. If zero,
+	// DefaultBudgetPeriod is used.
+	BudgetPeriod time.Duration
+
+	// MinRebalanceAmount is the smallest amount worth rebalancing. If
+	// zero, DefaultMinRebalanceAmount is used.
+	MinRebalanceAmount lnwire.MilliSatoshi
+
+	// DryRun, if true, only reports the rebalances that would be
+	// performed, without executing them.
+	DryRun bool
+
+	// UpdateTicker signals when the balances of our channels should be
+	// evaluated.
+	UpdateTicker ticker.Ticker
+
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// Manager keeps the balance of each of our channels within the bounds set by
+// the operator. Channels in which our balance is too high are paired with
+// channels in which it's too low, and liquidity is moved between them by
+// paying ourselves over a circular route. The fees spent on rebalancing are
+// bounded by a budget that's replenished periodically.
+type Manager struct {
+	started int32
+	stopped int32
+
+	cfg Config
+
+	// budgetStart is the time at which the current budget period began.
+	budgetStart time.Time
+
+	// spent is the amount of fees spent on rebalances within the current
+	// budget period.
+	spent lnwire.MilliSatoshi
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewManager creates a new liquidity manager from the given config.
+func NewManager(cfg Config) *Manager {
+	if cfg.BudgetPeriod == 0 {
+		cfg.BudgetPeriod = DefaultBudgetPeriod
+	}
+	if cfg.MinRebalanceAmount == 0 {
+		cfg.MinRebalanceAmount = DefaultMinRebalanceAmount
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	return &Manager{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start launches the goroutine that periodically evaluates the balances of
+// our channels.
+func (m *Manager) Start() error {
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Liquidity manager starting, local ratio bounds: "+
+		"[%v, %v], fee budget: %v per %v, dry_run=%v",
+		m.cfg.MinLocalRatio, m.cfg.MaxLocalRatio, m.cfg.FeeBudget,
+		m.cfg.BudgetPeriod, m.cfg.DryRun)
+
+	m.cfg.UpdateTicker.Resume()
+
+	m.wg.Add(1)
+	go m.updateLoop()
+
+	return nil
+}
+
+// Stop stops the manager.
+func (m *Manager) Stop() error {
+	if !atomic.CompareAndSwapInt32(&m.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Info("Liquidity manager shutting down")
+
+	close(m.quit)
+	m.wg.Wait()
+
+	m.cfg.UpdateTicker.Stop()
+
+	return nil
+}
+
+// updateLoop periodically evaluates the balances of our channels.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) updateLoop() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-m.cfg.UpdateTicker.Ticks():
+			if err := m.maintainLiquidity(); err != nil {
+				log.Errorf("Unable to maintain liquidity: %v",
+					err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// maintainLiquidity plans the rebalances needed to bring our channels within
+// their targets, and executes them as long as the fee budget allows. In dry
+// run mode, the planned rebalances are only reported.
+func (m *Manager) maintainLiquidity() error {
+	channels, err := m.cfg.FetchChannels()
+	if err != nil {
+		return err
+	}
+
+	rebalances := m.planRebalances(channels)
+	if len(rebalances) == 0 {
+		log.Debugf("All channels are within their liquidity targets")
+		return nil
+	}
+
+	// Replenish the budget if the current period is over.
+	now := m.cfg.Now()
+	if now.Sub(m.budgetStart) >= m.cfg.BudgetPeriod {
+		m.budgetStart = now
+		m.spent = 0
+	}
+
+	for _, rebalance := range rebalances {
+		// Cap the fee of the rebalance to what's left of our budget,
+		// skipping it if nothing is.
+		if m.spent >= m.cfg.FeeBudget {
+			log.Infof("Fee budget of %v exhausted, deferring "+
+				"remaining rebalances", m.cfg.FeeBudget)
+			return nil
+		}
+		remaining := m.cfg.FeeBudget - m.spent
+		if rebalance.MaxFee > remaining {
+			rebalance.MaxFee = remaining
+		}
+
+		if m.cfg.DryRun {
+			log.Infof("Dry run: would rebalance %v from "+
+				"ChannelPoint(%v) to ChannelPoint(%v), "+
+				"max_fee=%v", rebalance.Amount,
+				rebalance.From.ChanPoint,
+				rebalance.To.ChanPoint, rebalance.MaxFee)
+
+			// Reserve the maximum fee, so that the report
+			// reflects what the budget would allow.
+			m.spent += rebalance.MaxFee
+			continue
+		}
+
+		log.Infof("Rebalancing %v from ChannelPoint(%v) to "+
+			"ChannelPoint(%v), max_fee=%v", rebalance.Amount,
+			rebalance.From.ChanPoint, rebalance.To.ChanPoint,
+			rebalance.MaxFee)
+
+		fee, err := m.cfg.Rebalance(rebalance)
+		if err != nil {
+			log.Errorf("Unable to rebalance from "+
+				"ChannelPoint(%v) to ChannelPoint(%v): %v",
+				rebalance.From.ChanPoint,
+				rebalance.To.ChanPoint, err)
+			continue
+		}
+
+		m.spent += fee
+	}
+
+	return nil
+}
+
+// planRebalances pairs the channels in which our balance is above
+// MaxLocalRatio with those in which it's below MinLocalRatio, and returns the
+// rebalances that bring them closest to the midpoint of the two bounds. The
+// largest imbalances are addressed first.
+func (m *Manager) planRebalances(channels []*Channel) []*Rebalance {
+	type imbalance struct {
+		channel *Channel
+		amt     lnwire.MilliSatoshi
+	}
+
+	target := (m.cfg.MinLocalRatio + m.cfg.MaxLocalRatio) / 2
+
+	var sources, sinks []*imbalance
+	for _, channel := range channels {
+		capacity := float64(lnwire.NewMSatFromSatoshis(
+			channel.Capacity,
+		))
+		targetBalance := lnwire.MilliSatoshi(capacity * target)
+
+		ratio := channel.localRatio()
+		switch {
+		case ratio > m.cfg.MaxLocalRatio:
+			sources = append(sources, &imbalance{
+				channel: channel,
+				amt:     channel.LocalBalance - targetBalance,
+			})
+
+		case ratio < m.cfg.MinLocalRatio:
+			sinks = append(sinks, &imbalance{
+				channel: channel,
+				amt:     targetBalance - channel.LocalBalance,
+			})
+		}
+	}
+
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].amt > sources[j].amt
+	})
+	sort.Slice(sinks, func(i, j int) bool {
+		return sinks[i].amt > sinks[j].amt
+	})
+
+	var rebalances []*Rebalance
+	for len(sources) > 0 && len(sinks) > 0 {
+		source, sink := sources[0], sinks[0]
+
+		amt := source.amt
+		if sink.amt < amt {
+			amt = sink.amt
+		}
+		if amt < m.cfg.MinRebalanceAmount {
+			break
+		}
+
+		rebalances = append(rebalances, &Rebalance{
+			From:   source.channel,
+			To:     sink.channel,
+			Amount: amt,
+			MaxFee: amt * lnwire.MilliSatoshi(m.cfg.MaxFeeRate) /
+				1000000,
+		})
+
+		source.amt -= amt
+		sink.amt -= amt
+		if source.amt < m.cfg.MinRebalanceAmount {
+			sources = sources[1:]
+		}
+		if sink.amt < m.cfg.MinRebalanceAmount {
+			sinks = sinks[1:]
+		}
+	}
+
+	return rebalances
+}
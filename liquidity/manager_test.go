@@ -0,0 +1,147 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// newTestChannel returns a channel with a capacity of 1 BTC, in which we hold
+// the given balance.
+func newTestChannel(index uint32, localSat btcutil.Amount) *Channel {
+	return &Channel{
+		ChanPoint:    wire.OutPoint{Index: index},
+		ChanID:       lnwire.NewShortChanIDFromInt(uint64(index)),
+		Capacity:     btcutil.SatoshiPerBitcoin,
+		LocalBalance: lnwire.NewMSatFromSatoshis(localSat),
+	}
+}
+
+// TestManagerPlanRebalances asserts that channels with too much outbound
+// liquidity are paired with channels with too little, largest imbalances
+// first.
+func TestManagerPlanRebalances(t *testing.T) {
+	t.Parallel()
+
+	full := newTestChannel(1, 90000000)
+	depleted := newTestChannel(2, 10000000)
+	balanced := newTestChannel(3, 50000000)
+	low := newTestChannel(4, 25000000)
+
+	m := NewManager(Config{
+		MinLocalRatio: 0.3,
+		MaxLocalRatio: 0.7,
+		MaxFeeRate:    1000,
+	})
+
+	rebalances := m.planRebalances(
+		[]*Channel{full, depleted, balanced, low},
+	)
+
+	// The full channel holds 0.4 BTC over the target, which should be
+	// split between the depleted channel, short of 0.4 BTC, and the low
+	// one, short of 0.25 BTC. As the depleted channel is addressed first,
+	// nothing is left for the low one.
+	if len(rebalances) != 1 {
+		t.Fatalf("expected 1 rebalance, got %v", len(rebalances))
+	}
+
+	rebalance := rebalances[0]
+	if rebalance.From != full || rebalance.To != depleted {
+		t.Fatalf("expected rebalance from %v to %v, got %v to %v",
+			full.ChanPoint, depleted.ChanPoint,
+			rebalance.From.ChanPoint, rebalance.To.ChanPoint)
+	}
+
+	expectedAmt := lnwire.NewMSatFromSatoshis(40000000)
+	if rebalance.Amount != expectedAmt {
+		t.Fatalf("expected amount of %v, got %v", expectedAmt,
+			rebalance.Amount)
+	}
+	if rebalance.MaxFee != expectedAmt/1000 {
+		t.Fatalf("expected max fee of %v, got %v", expectedAmt/1000,
+			rebalance.MaxFee)
+	}
+}
+
+// TestManagerFeeBudget asserts that rebalances are capped by the fee budget,
+// which is replenished once the budget period is over, and that nothing is
+// executed in dry run mode.
+func TestManagerFeeBudget(t *testing.T) {
+	t.Parallel()
+
+	channels := []*Channel{
+		newTestChannel(1, 90000000),
+		newTestChannel(2, 10000000),
+	}
+
+	var (
+		now        = time.Unix(1000, 0)
+		rebalances []*Rebalance
+	)
+	cfg := Config{
+		FetchChannels: func() ([]*Channel, error) {
+			return channels, nil
+		},
+		Rebalance: func(r *Rebalance) (lnwire.MilliSatoshi, error) {
+			rebalances = append(rebalances, r)
+			return r.MaxFee, nil
+		},
+		MinLocalRatio: 0.3,
+		MaxLocalRatio: 0.7,
+		MaxFeeRate:    1000,
+		FeeBudget:     30000000,
+		BudgetPeriod:  time.Hour,
+		UpdateTicker:  ticker.New(time.Hour),
+		Now: func() time.Time {
+			return now
+		},
+	}
+	m := NewManager(cfg)
+
+	// The rebalance would allow for a fee of 40k sat, so it should be
+	// capped to the 30k sat budget.
+	if err := m.maintainLiquidity(); err != nil {
+		t.Fatalf("unable to maintain liquidity: %v", err)
+	}
+	if len(rebalances) != 1 {
+		t.Fatalf("expected 1 rebalance, got %v", len(rebalances))
+	}
+	if rebalances[0].MaxFee != cfg.FeeBudget {
+		t.Fatalf("expected max fee of %v, got %v", cfg.FeeBudget,
+			rebalances[0].MaxFee)
+	}
+
+	// With the budget exhausted, nothing should be rebalanced until the
+	// budget period is over.
+	if err := m.maintainLiquidity(); err != nil {
+		t.Fatalf("unable to maintain liquidity: %v", err)
+	}
+	if len(rebalances) != 1 {
+		t.Fatalf("expected no new rebalance, got %v",
+			len(rebalances)-1)
+	}
+
+	now = now.Add(time.Hour)
+	if err := m.maintainLiquidity(); err != nil {
+		t.Fatalf("unable to maintain liquidity: %v", err)
+	}
+	if len(rebalances) != 2 {
+		t.Fatalf("expected 2 rebalances, got %v", len(rebalances))
+	}
+
+	// In dry run mode, rebalances should only be reported.
+	cfg.DryRun = true
+	m = NewManager(cfg)
+	if err := m.maintainLiquidity(); err != nil {
+		t.Fatalf("unable to maintain liquidity: %v", err)
+	}
+	if len(rebalances) != 2 {
+		t.Fatalf("expected no rebalance in dry run mode, got %v",
+			len(rebalances)-2)
+	}
+}
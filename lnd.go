@@ -5,17 +5,9 @@
 package main
 
 import (
-	"bytes"
-	"crypto/ecdsa"
-	"crypto/elliptic"
-	"crypto/rand"
 	"crypto/tls"
-	"crypto/x509"
-	"crypto/x509/pkix"
-	"encoding/pem"
 	"fmt"
 	"io/ioutil"
-	"math/big"
 	"net"
 	"net/http"
 	_ "net/http/pprof"
@@ -26,8 +18,6 @@ import (
 	"sync"
 	"time"
 
-	"gopkg.in/macaroon-bakery.v2/bakery"
-
 	"golang.org/x/net/context"
 
 	"google.golang.org/grpc"
@@ -51,11 +41,6 @@ import (
 	"github.com/lightningnetwork/lnd/walletunlocker"
 )
 
-const (
-	// Make certificate valid for 14 months.
-	autogenCertValidity = 14 /*months*/ * 30 /*days*/ * 24 * time.Hour
-)
-
 var (
 	cfg              *config
 	registeredChains = newChainRegistry()
@@ -65,12 +50,6 @@ var (
 	// network.
 	networkDir string
 
-	// End of ASN.1 time.
-	endOfTime = time.Date(2049, 12, 31, 23, 59, 59, 0, time.UTC)
-
-	// Max serial number.
-	serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
-
 	/*
 	 * These cipher suites fit the following criteria:
 	 * - Don't use outdated algorithms like SHA-1 and 3DES
@@ -159,8 +138,14 @@ func lndMain() error {
 		normalizeNetwork(activeNetParams.Name))
 
 	// Open the channeldb, which is dedicated to storing channel, and
-	// network related metadata.
-	chanDB, err := channeldb.Open(graphDir)
+	// network related metadata. In observer mode, we'll only ever read
+	// from it.
+	var chanDB *channeldb.DB
+	if cfg.Observer {
+		chanDB, err = channeldb.OpenReadOnly(graphDir)
+	} else {
+		chanDB, err = channeldb.Open(graphDir)
+	}
 	if err != nil {
 		ltndLog.Errorf("unable to open channeldb: %v", err)
 		return err
@@ -172,14 +157,12 @@ func lndMain() error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	// Ensure we create TLS key and certificate if they don't exist
-	if !fileExists(cfg.TLSCertPath) && !fileExists(cfg.TLSKeyPath) {
-		if err := genCertPair(cfg.TLSCertPath, cfg.TLSKeyPath); err != nil {
-			return err
-		}
-	}
-
-	cert, err := tls.LoadX509KeyPair(cfg.TLSCertPath, cfg.TLSKeyPath)
+	// Ensure we create TLS key and certificate if they don't exist, and
+	// regenerate them if they're no longer fit for use.
+	cert, err := loadCertPair(
+		cfg.TLSCertPath, cfg.TLSKeyPath, cfg.TLSExtraIPs,
+		cfg.TLSExtraDomains, cfg.TLSAutoRefresh,
+	)
 	if err != nil {
 		return err
 	}
@@ -204,13 +187,18 @@ func lndMain() error {
 		unlockedWallet  *wallet.Wallet
 	)
 
+	// The state service reports the lifecycle state of the daemon. It is
+	// served by the wallet unlocker first, and then by the main RPC server.
+	stateService := newStateServer(lnrpc.WalletState_NON_EXISTING)
+
 	// We wait until the user provides a password over RPC. In case lnd is
 	// started with the --noseedbackup flag, we use the default password
-	// for wallet encryption.
-	if !cfg.NoSeedBackup {
+	// for wallet encryption. In observer mode, no wallet is ever
+	// unlocked, so the default password is used as well.
+	if !cfg.NoSeedBackup && !cfg.Observer {
 		walletInitParams, err := waitForWalletPassword(
 			cfg.RPCListeners, cfg.RESTListeners, serverOpts,
-			proxyOpts, tlsConf,
+			proxyOpts, tlsConf, stateService,
 		)
 		if err != nil {
 			return err
@@ -228,12 +216,19 @@ func lndMain() error {
 				recoveryWindow)
 		}
 	}
+	stateService.SetState(lnrpc.WalletState_UNLOCKED)
+
+	// The RPC middleware registry needs to be known to the macaroon
+	// service, as macaroons with custom caveats are only accepted if a
+	// middleware is registered to handle them.
+	rpcMiddleware := newRPCMiddlewareRegistry()
 
 	var macaroonService *macaroons.Service
 	if !cfg.NoMacaroons {
 		// Create the macaroon authentication/authorization service.
 		macaroonService, err = macaroons.NewService(
 			networkDir, macaroons.IPLockChecker,
+			macaroons.CustomChecker(rpcMiddleware),
 		)
 		if err != nil {
 			srvrLog.Errorf("unable to create macaroon service: %v", err)
@@ -264,6 +259,14 @@ func lndMain() error {
 		}
 	}
 
+	// In observer mode, we'll only serve read RPCs from the channel
+	// database, without starting any of the other subsystems.
+	if cfg.Observer {
+		return runObserver(
+			chanDB, macaroonService, serverOpts, proxyOpts, tlsConf,
+		)
+	}
+
 	// With the information parsed from the configuration, create valid
 	// instances of the pertinent interfaces required to operate the
 	// Lightning Network Daemon.
@@ -331,8 +334,8 @@ func lndMain() error {
 	// Initialize, and register our implementation of the gRPC interface
 	// exported by the rpcServer.
 	rpcServer, err := newRPCServer(
-		server, macaroonService, cfg.SubRPCServers, serverOpts,
-		proxyOpts, atplManager, tlsConf,
+		server, macaroonService, rpcMiddleware, stateService,
+		cfg.SubRPCServers, serverOpts, proxyOpts, atplManager, tlsConf,
 	)
 	if err != nil {
 		srvrLog.Errorf("unable to start RPC server: %v", err)
@@ -343,6 +346,8 @@ func lndMain() error {
 	}
 	defer rpcServer.Stop()
 
+	stateService.SetState(lnrpc.WalletState_RPC_ACTIVE)
+
 	// If we're not in simnet mode, We'll wait until we're fully synced to
 	// continue the start up of the remainder of the daemon. This ensures
 	// that we don't accept any possibly invalid state transitions, or
@@ -390,6 +395,8 @@ func lndMain() error {
 	}
 	defer server.Stop()
 
+	stateService.SetState(lnrpc.WalletState_SERVER_ACTIVE)
+
 	// Now that the server has started, if the autopilot mode is currently
 	// active, then we'll start the autopilot agent immediately. It will be
 	// stopped together with the autopilot service.
@@ -430,142 +437,6 @@ func fileExists(name string) bool {
 	return true
 }
 
-// genCertPair generates a key/cert pair to the paths provided. The
-// auto-generated certificates should *not* be used in production for public
-// access as they're self-signed and don't necessarily contain all of the
-// desired hostnames for the service. For production/public use, consider a
-// real PKI.
-//
-// This function is adapted from https://github.com/btcsuite/btcd and
-// https://github.com/btcsuite/btcutil
-func genCertPair(certFile, keyFile string) error {
-	rpcsLog.Infof("Generating TLS certificates...")
-
-	org := "lnd autogenerated cert"
-	now := time.Now()
-	validUntil := now.Add(autogenCertValidity)
-
-	// Check that the certificate validity isn't past the ASN.1 end of time.
-	if validUntil.After(endOfTime) {
-		validUntil = endOfTime
-	}
-
-	// Generate a serial number that's below the serialNumberLimit.
-	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
-	if err != nil {
-		return fmt.Errorf("failed to generate serial number: %s", err)
-	}
-
-	// Collect the host's IP addresses, including loopback, in a slice.
-	ipAddresses := []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")}
-
-	// addIP appends an IP address only if it isn't already in the slice.
-	addIP := func(ipAddr net.IP) {
-		for _, ip := range ipAddresses {
-			if bytes.Equal(ip, ipAddr) {
-				return
-			}
-		}
-		ipAddresses = append(ipAddresses, ipAddr)
-	}
-
-	// Add all the interface IPs that aren't already in the slice.
-	addrs, err := net.InterfaceAddrs()
-	if err != nil {
-		return err
-	}
-	for _, a := range addrs {
-		ipAddr, _, err := net.ParseCIDR(a.String())
-		if err == nil {
-			addIP(ipAddr)
-		}
-	}
-
-	// Add extra IP to the slice.
-	ipAddr := net.ParseIP(cfg.TLSExtraIP)
-	if ipAddr != nil {
-		addIP(ipAddr)
-	}
-
-	// Collect the host's names into a slice.
-	host, err := os.Hostname()
-	if err != nil {
-		return err
-	}
-	dnsNames := []string{host}
-	if host != "localhost" {
-		dnsNames = append(dnsNames, "localhost")
-	}
-	if cfg.TLSExtraDomain != "" {
-		dnsNames = append(dnsNames, cfg.TLSExtraDomain)
-	}
-
-	// Also add fake hostnames for unix sockets, otherwise hostname
-	// verification will fail in the client.
-	dnsNames = append(dnsNames, "unix", "unixpacket")
-
-	// Generate a private key for the certificate.
-	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
-	if err != nil {
-		return err
-	}
-
-	// Construct the certificate template.
-	template := x509.Certificate{
-		SerialNumber: serialNumber,
-		Subject: pkix.Name{
-			Organization: []string{org},
-			CommonName:   host,
-		},
-		NotBefore: now.Add(-time.Hour * 24),
-		NotAfter:  validUntil,
-
-		KeyUsage: x509.KeyUsageKeyEncipherment |
-			x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
-		IsCA:                  true, // so can sign self.
-		BasicConstraintsValid: true,
-
-		DNSNames:    dnsNames,
-		IPAddresses: ipAddresses,
-	}
-
-	derBytes, err := x509.CreateCertificate(rand.Reader, &template,
-		&template, &priv.PublicKey, priv)
-	if err != nil {
-		return fmt.Errorf("failed to create certificate: %v", err)
-	}
-
-	certBuf := &bytes.Buffer{}
-	err = pem.Encode(certBuf, &pem.Block{Type: "CERTIFICATE",
-		Bytes: derBytes})
-	if err != nil {
-		return fmt.Errorf("failed to encode certificate: %v", err)
-	}
-
-	keybytes, err := x509.MarshalECPrivateKey(priv)
-	if err != nil {
-		return fmt.Errorf("unable to encode privkey: %v", err)
-	}
-	keyBuf := &bytes.Buffer{}
-	err = pem.Encode(keyBuf, &pem.Block{Type: "EC PRIVATE KEY",
-		Bytes: keybytes})
-	if err != nil {
-		return fmt.Errorf("failed to encode private key: %v", err)
-	}
-
-	// Write cert and key files.
-	if err = ioutil.WriteFile(certFile, certBuf.Bytes(), 0644); err != nil {
-		return err
-	}
-	if err = ioutil.WriteFile(keyFile, keyBuf.Bytes(), 0600); err != nil {
-		os.Remove(certFile)
-		return err
-	}
-
-	rpcsLog.Infof("Done generating TLS certificates")
-	return nil
-}
-
 // genMacaroons generates three macaroon files; one admin-level, one for
 // invoice access and one read-only. These can also be used to generate more
 // granular macaroons.
@@ -576,8 +447,8 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	// access invoice related calls. This is useful for merchants and other
 	// services to allow an isolated instance that can only query and
 	// modify invoices.
-	invoiceMac, err := svc.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, invoicePermissions...,
+	invoiceMac, err := svc.NewMacaroon(
+		ctx, macaroons.DefaultRootKeyID, invoicePermissions...,
 	)
 	if err != nil {
 		return err
@@ -593,8 +464,8 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	}
 
 	// Generate the read-only macaroon and write it to a file.
-	roMacaroon, err := svc.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, readPermissions...,
+	roMacaroon, err := svc.NewMacaroon(
+		ctx, macaroons.DefaultRootKeyID, readPermissions...,
 	)
 	if err != nil {
 		return err
@@ -610,8 +481,8 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 
 	// Generate the admin macaroon and write it to a file.
 	adminPermissions := append(readPermissions, writePermissions...)
-	admMacaroon, err := svc.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, adminPermissions...,
+	admMacaroon, err := svc.NewMacaroon(
+		ctx, macaroons.DefaultRootKeyID, adminPermissions...,
 	)
 	if err != nil {
 		return err
@@ -654,7 +525,8 @@ type WalletUnlockParams struct {
 // the user to this RPC server.
 func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	serverOpts []grpc.ServerOption, proxyOpts []grpc.DialOption,
-	tlsConf *tls.Config) (*WalletUnlockParams, error) {
+	tlsConf *tls.Config, stateService *stateServer) (*WalletUnlockParams,
+	error) {
 
 	// Set up a new PasswordService, which will listen for passwords
 	// provided over RPC.
@@ -677,6 +549,21 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 		chainConfig.ChainDir, activeNetParams.Params, macaroonFiles,
 	)
 	lnrpc.RegisterWalletUnlockerServer(grpcServer, pwService)
+	lnrpc.RegisterStateServer(grpcServer, stateService)
+
+	// Before we serve any requests, we'll find out whether a wallet
+	// already exists, so the state service reports the correct state.
+	netDir := btcwallet.NetworkDir(
+		chainConfig.ChainDir, activeNetParams.Params,
+	)
+	loader := wallet.NewLoader(activeNetParams.Params, netDir, 0)
+	walletExists, err := loader.WalletExists()
+	if err != nil {
+		return nil, err
+	}
+	if walletExists {
+		stateService.SetState(lnrpc.WalletState_LOCKED)
+	}
 
 	// Use a WaitGroup so we can be sure the instructions on how to input the
 	// password is the last thing to be printed to the console.
@@ -713,7 +600,13 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 
 	mux := proxy.NewServeMux()
 
-	err := lnrpc.RegisterWalletUnlockerHandlerFromEndpoint(
+	err = lnrpc.RegisterWalletUnlockerHandlerFromEndpoint(
+		ctx, mux, grpcEndpoints[0].String(), proxyOpts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = lnrpc.RegisterStateHandlerFromEndpoint(
 		ctx, mux, grpcEndpoints[0].String(), proxyOpts,
 	)
 	if err != nil {
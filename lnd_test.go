@@ -12489,6 +12489,129 @@ func testAbandonChannel(net *lntest.NetworkHarness, t *harnessTest) {
 	cleanupForceClose(t, net, net.Bob, chanPoint)
 }
 
+// testUnreliableNetwork tests that payments complete between two nodes whose
+// connection suffers from latency and jitter, and that the nodes recover once
+// their connection is repeatedly severed.
+func testUnreliableNetwork(net *lntest.NetworkHarness, t *harnessTest) {
+	ctxb := context.Background()
+
+	const (
+		chanAmt     = btcutil.Amount(500000)
+		paymentAmt  = 1000
+		numPayments = 5
+	)
+
+	carol, err := net.NewNode("Carol", nil)
+	if err != nil {
+		t.Fatalf("unable to create carol's node: %v", err)
+	}
+	defer shutdownAndAssert(net, t, carol)
+
+	// Bob will connect to Carol through a proxy that delays every message
+	// by 50 to 150ms, reordering the messages sent in either direction.
+	ctxt, _ := context.WithTimeout(ctxb, defaultTimeout)
+	proxy, err := net.ConnectNodesViaProxy(
+		ctxt, net.Bob, carol, lntest.LinkConditions{
+			Latency: 50 * time.Millisecond,
+			Jitter:  100 * time.Millisecond,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to connect bob to carol: %v", err)
+	}
+	defer proxy.Close()
+
+	ctxt, _ = context.WithTimeout(ctxb, channelOpenTimeout)
+	chanPoint := openChannelAndAssert(
+		ctxt, t, net, net.Bob, carol,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+
+	ctxt, _ = context.WithTimeout(ctxb, defaultTimeout)
+	err = net.Bob.WaitForNetworkChannelOpen(ctxt, chanPoint)
+	if err != nil {
+		t.Fatalf("bob didn't see the channel open: %v", err)
+	}
+
+	// sendPayments pays a batch of invoices from Carol, retrying until
+	// the link between the nodes is back up if the connection has just
+	// been severed.
+	sendPayments := func() {
+		ctxt, _ := context.WithTimeout(ctxb, defaultTimeout)
+		payReqs, _, _, err := createPayReqs(
+			ctxt, carol, paymentAmt, numPayments,
+		)
+		if err != nil {
+			t.Fatalf("unable to create pay reqs: %v", err)
+		}
+
+		for _, payReq := range payReqs {
+			var predErr error
+			err := lntest.WaitPredicate(func() bool {
+				ctxt, _ := context.WithTimeout(
+					ctxb, defaultTimeout,
+				)
+				predErr = completePaymentRequests(
+					ctxt, net.Bob, []string{payReq}, true,
+				)
+
+				// If an earlier attempt succeeded despite
+				// the connection failing, then the invoice
+				// is already paid.
+				return predErr == nil || strings.Contains(
+					predErr.Error(), "already paid",
+				)
+			}, defaultTimeout)
+			if err != nil {
+				t.Fatalf("unable to send payment: %v", predErr)
+			}
+		}
+	}
+
+	sendPayments()
+
+	// Sever the connection a few times. Bob should reconnect to Carol
+	// through the proxy each time, and payments should succeed once the
+	// link has been reestablished.
+	for i := 0; i < 3; i++ {
+		proxy.DisconnectAll()
+		sendPayments()
+	}
+
+	// Finally, make the link lossy, such that the connection is severed
+	// while payments are in flight.
+	proxy.SetConditions(lntest.LinkConditions{
+		Latency:        50 * time.Millisecond,
+		Jitter:         100 * time.Millisecond,
+		DisconnectProb: 0.05,
+	})
+	sendPayments()
+	proxy.SetConditions(lntest.LinkConditions{})
+
+	// All payments should have been received exactly once.
+	const totalPayments = 5 * numPayments
+	err = lntest.WaitPredicate(func() bool {
+		ctxt, _ := context.WithTimeout(ctxb, defaultTimeout)
+		resp, err := carol.ListChannels(
+			ctxt, &lnrpc.ListChannelsRequest{},
+		)
+		if err != nil || len(resp.Channels) != 1 {
+			return false
+		}
+
+		received := resp.Channels[0].TotalSatoshisReceived
+		return received == totalPayments*paymentAmt
+	}, defaultTimeout)
+	if err != nil {
+		t.Fatalf("carol didn't receive all payments: %v", err)
+	}
+
+	ctxt, _ = context.WithTimeout(ctxb, channelCloseTimeout)
+	closeChannelAndAssert(ctxt, t, net, net.Bob, chanPoint, false)
+}
+
 type testCase struct {
 	name string
 	test func(net *lntest.NetworkHarness, t *harnessTest)
@@ -12708,6 +12831,10 @@ var testsCases = []*testCase{
 		name: "send update disable channel",
 		test: testSendUpdateDisableChannel,
 	},
+	{
+		name: "unreliable network",
+		test: testUnreliableNetwork,
+	},
 }
 
 // TestLightningNetworkDaemon performs a series of integration tests amongst a
@@ -16,6 +16,10 @@ It has these top-level messages:
 	UnlockWalletResponse
 	ChangePasswordRequest
 	ChangePasswordResponse
+	SubscribeStateRequest
+	SubscribeStateResponse
+	GetStateRequest
+	GetStateResponse
 	Utxo
 	Transaction
 	GetTransactionsRequest
@@ -28,12 +32,17 @@ It has these top-level messages:
 	LightningAddress
 	SendManyRequest
 	SendManyResponse
+	EstimateFeeRequest
+	EstimateFeeResponse
 	SendCoinsRequest
 	SendCoinsResponse
 	ListUnspentRequest
 	ListUnspentResponse
 	NewAddressRequest
 	NewAddressResponse
+	ListAddressesRequest
+	WalletAddress
+	ListAddressesResponse
 	SignMessageRequest
 	SignMessageResponse
 	VerifyMessageRequest
@@ -49,9 +58,21 @@ It has these top-level messages:
 	ChannelCloseSummary
 	ClosedChannelsRequest
 	ClosedChannelsResponse
+	LookupHtlcResolutionRequest
+	LookupHtlcResolutionResponse
+	HtlcRateLimitStats
+	HoldTimeStats
 	Peer
+	SendCustomMessageRequest
+	SendCustomMessageResponse
+	SubscribeCustomMessagesRequest
+	CustomMessage
 	ListPeersRequest
 	ListPeersResponse
+	UpdateNodeAnnouncementRequest
+	UpdateNodeAnnouncementResponse
+	GetRecoveryInfoRequest
+	GetRecoveryInfoResponse
 	GetInfoRequest
 	GetInfoResponse
 	ConfirmationUpdate
@@ -61,12 +82,24 @@ It has these top-level messages:
 	CloseStatusUpdate
 	PendingUpdate
 	OpenChannelRequest
+	PsbtShim
+	BatchOpenChannelRequest
+	BatchOpenChannel
+	BatchOpenChannelResponse
 	OpenStatusUpdate
+	ReadyForPsbtFunding
+	FundingTransitionMsg
+	FundingShimCancel
+	FundingPsbtVerify
+	FundingPsbtFinalize
+	FundingStateStepResp
 	PendingHTLC
 	PendingChannelsRequest
 	PendingChannelsResponse
 	WalletBalanceRequest
+	WalletAccountBalance
 	WalletBalanceResponse
+	Amount
 	ChannelBalanceRequest
 	ChannelBalanceResponse
 	QueryRoutesRequest
@@ -95,6 +128,9 @@ It has these top-level messages:
 	RouteHint
 	Invoice
 	AddInvoiceResponse
+	CreateOfferRequest
+	CreateOfferResponse
+	PayOfferRequest
 	PaymentHash
 	ListInvoiceRequest
 	ListInvoiceResponse
@@ -118,6 +154,56 @@ It has these top-level messages:
 	ForwardingHistoryRequest
 	ForwardingEvent
 	ForwardingHistoryResponse
+	ListSweepableOutputsRequest
+	SweepableOutput
+	ListSweepableOutputsResponse
+	SweepOutputsRequest
+	SweepOutputsResponse
+	ArchiveClosedChannelsRequest
+	ArchiveClosedChannelsResponse
+	InboundFee
+	CancelPaymentRequest
+	CancelPaymentResponse
+	ExportChannelAuditRequest
+	ChannelAuditEntry
+	ExportChannelAuditResponse
+	FreezeChannelRequest
+	FreezeChannelResponse
+	UpdateChannelConstraintsRequest
+	UpdateChannelConstraintsResponse
+	ChannelEventSubscription
+	ChannelEventUpdate
+	PeerEventSubscription
+	PeerEvent
+	DeletePaymentRequest
+	DeletePaymentResponse
+	ExportChannelBackupRequest
+	ChannelBackup
+	MultiChanBackup
+	ChanBackupExportRequest
+	ChanBackupSnapshot
+	ChannelBackups
+	ChannelBackupSubscription
+	RestoreChanBackupRequest
+	RestoreBackupResponse
+	VerifyChanBackupResponse
+	BakeMacaroonRequest
+	BakeMacaroonResponse
+	MacaroonPermission
+	ListMacaroonIDsRequest
+	ListMacaroonIDsResponse
+	DeleteMacaroonIDRequest
+	DeleteMacaroonIDResponse
+	MacaroonPermissionList
+	ListPermissionsRequest
+	ListPermissionsResponse
+	CheckMacPermRequest
+	CheckMacPermResponse
+	RPCMiddlewareRequest
+	RPCMessage
+	RPCMiddlewareResponse
+	MiddlewareRegistration
+	InterceptFeedback
 */
 package lnrpc
 
@@ -142,6 +228,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type WalletState int32
+
+const (
+	// / The wallet hasn't been created yet.
+	WalletState_NON_EXISTING WalletState = 0
+	// / The wallet exists but is still locked.
+	WalletState_LOCKED WalletState = 1
+	// / The wallet has been unlocked, but the main RPC server isn't up yet.
+	WalletState_UNLOCKED WalletState = 2
+	// / The main RPC server is up, but the daemon is still starting.
+	WalletState_RPC_ACTIVE WalletState = 3
+	// / The daemon has fully started and is ready to accept all RPC calls.
+	WalletState_SERVER_ACTIVE WalletState = 4
+)
+
+var WalletState_name = map[int32]string{
+	0: "NON_EXISTING",
+	1: "LOCKED",
+	2: "UNLOCKED",
+	3: "RPC_ACTIVE",
+	4: "SERVER_ACTIVE",
+}
+var WalletState_value = map[string]int32{
+	"NON_EXISTING":  0,
+	"LOCKED":        1,
+	"UNLOCKED":      2,
+	"RPC_ACTIVE":    3,
+	"SERVER_ACTIVE": 4,
+}
+
+func (x WalletState) String() string {
+	return proto.EnumName(WalletState_name, int32(x))
+}
+func (WalletState) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 // *
 // `AddressType` has to be one of:
 //
@@ -166,7 +287,7 @@ var AddressType_value = map[string]int32{
 func (x AddressType) String() string {
 	return proto.EnumName(AddressType_name, int32(x))
 }
-func (AddressType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (AddressType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
 type ChannelCloseSummary_ClosureType int32
 
@@ -200,8 +321,91 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{38, 0}
+	return fileDescriptor0, []int{47, 0}
+}
+
+type Payment_PaymentStatus int32
+
+const (
+	Payment_UNKNOWN   Payment_PaymentStatus = 0
+	Payment_IN_FLIGHT Payment_PaymentStatus = 1
+	Payment_SUCCEEDED Payment_PaymentStatus = 2
+	Payment_FAILED    Payment_PaymentStatus = 3
+)
+
+var Payment_PaymentStatus_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "IN_FLIGHT",
+	2: "SUCCEEDED",
+	3: "FAILED",
+}
+var Payment_PaymentStatus_value = map[string]int32{
+	"UNKNOWN":   0,
+	"IN_FLIGHT": 1,
+	"SUCCEEDED": 2,
+	"FAILED":    3,
+}
+
+func (x Payment_PaymentStatus) String() string {
+	return proto.EnumName(Payment_PaymentStatus_name, int32(x))
+}
+func (Payment_PaymentStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{127, 0} }
+
+type ChannelEventUpdate_UpdateType int32
+
+const (
+	ChannelEventUpdate_OPEN_CHANNEL           ChannelEventUpdate_UpdateType = 0
+	ChannelEventUpdate_CLOSED_CHANNEL         ChannelEventUpdate_UpdateType = 1
+	ChannelEventUpdate_ACTIVE_CHANNEL         ChannelEventUpdate_UpdateType = 2
+	ChannelEventUpdate_INACTIVE_CHANNEL       ChannelEventUpdate_UpdateType = 3
+	ChannelEventUpdate_PENDING_OPEN_CHANNEL   ChannelEventUpdate_UpdateType = 4
+	ChannelEventUpdate_FULLY_RESOLVED_CHANNEL ChannelEventUpdate_UpdateType = 5
+)
+
+var ChannelEventUpdate_UpdateType_name = map[int32]string{
+	0: "OPEN_CHANNEL",
+	1: "CLOSED_CHANNEL",
+	2: "ACTIVE_CHANNEL",
+	3: "INACTIVE_CHANNEL",
+	4: "PENDING_OPEN_CHANNEL",
+	5: "FULLY_RESOLVED_CHANNEL",
+}
+var ChannelEventUpdate_UpdateType_value = map[string]int32{
+	"OPEN_CHANNEL":           0,
+	"CLOSED_CHANNEL":         1,
+	"ACTIVE_CHANNEL":         2,
+	"INACTIVE_CHANNEL":       3,
+	"PENDING_OPEN_CHANNEL":   4,
+	"FULLY_RESOLVED_CHANNEL": 5,
+}
+
+func (x ChannelEventUpdate_UpdateType) String() string {
+	return proto.EnumName(ChannelEventUpdate_UpdateType_name, int32(x))
+}
+func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{164, 0}
+}
+
+type PeerEvent_EventType int32
+
+const (
+	PeerEvent_PEER_ONLINE  PeerEvent_EventType = 0
+	PeerEvent_PEER_OFFLINE PeerEvent_EventType = 1
+)
+
+var PeerEvent_EventType_name = map[int32]string{
+	0: "PEER_ONLINE",
+	1: "PEER_OFFLINE",
+}
+var PeerEvent_EventType_value = map[string]int32{
+	"PEER_ONLINE":  0,
+	"PEER_OFFLINE": 1,
+}
+
+func (x PeerEvent_EventType) String() string {
+	return proto.EnumName(PeerEvent_EventType_name, int32(x))
 }
+func (PeerEvent_EventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{166, 0} }
 
 type GenSeedRequest struct {
 	// *
@@ -411,6 +615,56 @@ func (m *ChangePasswordResponse) String() string            { return proto.Compa
 func (*ChangePasswordResponse) ProtoMessage()               {}
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
+type SubscribeStateRequest struct {
+}
+
+func (m *SubscribeStateRequest) Reset()                    { *m = SubscribeStateRequest{} }
+func (m *SubscribeStateRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeStateRequest) ProtoMessage()               {}
+func (*SubscribeStateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+type SubscribeStateResponse struct {
+	// / The current state of the wallet.
+	State WalletState `protobuf:"varint,1,opt,name=state,enum=lnrpc.WalletState" json:"state,omitempty"`
+}
+
+func (m *SubscribeStateResponse) Reset()                    { *m = SubscribeStateResponse{} }
+func (m *SubscribeStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeStateResponse) ProtoMessage()               {}
+func (*SubscribeStateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *SubscribeStateResponse) GetState() WalletState {
+	if m != nil {
+		return m.State
+	}
+	return WalletState_NON_EXISTING
+}
+
+type GetStateRequest struct {
+}
+
+func (m *GetStateRequest) Reset()                    { *m = GetStateRequest{} }
+func (m *GetStateRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetStateRequest) ProtoMessage()               {}
+func (*GetStateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+type GetStateResponse struct {
+	// / The current state of the wallet.
+	State WalletState `protobuf:"varint,1,opt,name=state,enum=lnrpc.WalletState" json:"state,omitempty"`
+}
+
+func (m *GetStateResponse) Reset()                    { *m = GetStateResponse{} }
+func (m *GetStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetStateResponse) ProtoMessage()               {}
+func (*GetStateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *GetStateResponse) GetState() WalletState {
+	if m != nil {
+		return m.State
+	}
+	return WalletState_NON_EXISTING
+}
+
 type Utxo struct {
 	// / The type of address
 	Type AddressType `protobuf:"varint,1,opt,name=type,json=address_type,enum=lnrpc.AddressType" json:"type,omitempty"`
@@ -431,7 +685,7 @@ type Utxo struct {
 func (m *Utxo) Reset()                    { *m = Utxo{} }
 func (m *Utxo) String() string            { return proto.CompactTextString(m) }
 func (*Utxo) ProtoMessage()               {}
-func (*Utxo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*Utxo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *Utxo) GetType() AddressType {
 	if m != nil {
@@ -492,12 +746,14 @@ type Transaction struct {
 	TotalFees int64 `protobuf:"varint,7,opt,name=total_fees" json:"total_fees,omitempty"`
 	// / Addresses that received funds for this transaction
 	DestAddresses []string `protobuf:"bytes,8,rep,name=dest_addresses" json:"dest_addresses,omitempty"`
+	// / An optional label that was set on the transaction
+	Label string `protobuf:"bytes,9,opt,name=label" json:"label,omitempty"`
 }
 
 func (m *Transaction) Reset()                    { *m = Transaction{} }
 func (m *Transaction) String() string            { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()               {}
-func (*Transaction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*Transaction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *Transaction) GetTxHash() string {
 	if m != nil {
@@ -555,13 +811,20 @@ func (m *Transaction) GetDestAddresses() []string {
 	return nil
 }
 
+func (m *Transaction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type GetTransactionsRequest struct {
 }
 
 func (m *GetTransactionsRequest) Reset()                    { *m = GetTransactionsRequest{} }
 func (m *GetTransactionsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()               {}
-func (*GetTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 type TransactionDetails struct {
 	// / The list of transactions relevant to the wallet.
@@ -571,7 +834,7 @@ type TransactionDetails struct {
 func (m *TransactionDetails) Reset()                    { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string            { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()               {}
-func (*TransactionDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*TransactionDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *TransactionDetails) GetTransactions() []*Transaction {
 	if m != nil {
@@ -590,7 +853,7 @@ type FeeLimit struct {
 func (m *FeeLimit) Reset()                    { *m = FeeLimit{} }
 func (m *FeeLimit) String() string            { return proto.CompactTextString(m) }
 func (*FeeLimit) ProtoMessage()               {}
-func (*FeeLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*FeeLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 type isFeeLimit_Limit interface{ isFeeLimit_Limit() }
 
@@ -715,12 +978,14 @@ type SendRequest struct {
 	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
 	// send the payment.
 	FeeLimit *FeeLimit `protobuf:"bytes,8,opt,name=fee_limit,json=feeLimit" json:"fee_limit,omitempty"`
+	// / Optional opaque metadata to store along with the payment, e.g. an order identifier.
+	Metadata []byte `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *SendRequest) Reset()                    { *m = SendRequest{} }
 func (m *SendRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendRequest) ProtoMessage()               {}
-func (*SendRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*SendRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *SendRequest) GetDest() []byte {
 	if m != nil {
@@ -778,6 +1043,13 @@ func (m *SendRequest) GetFeeLimit() *FeeLimit {
 	return nil
 }
 
+func (m *SendRequest) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type SendResponse struct {
 	PaymentError    string `protobuf:"bytes,1,opt,name=payment_error" json:"payment_error,omitempty"`
 	PaymentPreimage []byte `protobuf:"bytes,2,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
@@ -788,7 +1060,7 @@ type SendResponse struct {
 func (m *SendResponse) Reset()                    { *m = SendResponse{} }
 func (m *SendResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendResponse) ProtoMessage()               {}
-func (*SendResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*SendResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *SendResponse) GetPaymentError() string {
 	if m != nil {
@@ -825,12 +1097,14 @@ type SendToRouteRequest struct {
 	PaymentHashString string `protobuf:"bytes,2,opt,name=payment_hash_string,json=paymentHashString" json:"payment_hash_string,omitempty"`
 	// / The set of routes that should be used to attempt to complete the payment.
 	Routes []*Route `protobuf:"bytes,3,rep,name=routes" json:"routes,omitempty"`
+	// / Optional opaque metadata to store along with the payment, e.g. an order identifier.
+	Metadata []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *SendToRouteRequest) Reset()                    { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()               {}
-func (*SendToRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*SendToRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *SendToRouteRequest) GetPaymentHash() []byte {
 	if m != nil {
@@ -853,6 +1127,13 @@ func (m *SendToRouteRequest) GetRoutes() []*Route {
 	return nil
 }
 
+func (m *SendToRouteRequest) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type ChannelPoint struct {
 	// Types that are valid to be assigned to FundingTxid:
 	//	*ChannelPoint_FundingTxidBytes
@@ -865,7 +1146,7 @@ type ChannelPoint struct {
 func (m *ChannelPoint) Reset()                    { *m = ChannelPoint{} }
 func (m *ChannelPoint) String() string            { return proto.CompactTextString(m) }
 func (*ChannelPoint) ProtoMessage()               {}
-func (*ChannelPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*ChannelPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 type isChannelPoint_FundingTxid interface{ isChannelPoint_FundingTxid() }
 
@@ -983,7 +1264,7 @@ type LightningAddress struct {
 func (m *LightningAddress) Reset()                    { *m = LightningAddress{} }
 func (m *LightningAddress) String() string            { return proto.CompactTextString(m) }
 func (*LightningAddress) ProtoMessage()               {}
-func (*LightningAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*LightningAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *LightningAddress) GetPubkey() string {
 	if m != nil {
@@ -1011,7 +1292,7 @@ type SendManyRequest struct {
 func (m *SendManyRequest) Reset()                    { *m = SendManyRequest{} }
 func (m *SendManyRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendManyRequest) ProtoMessage()               {}
-func (*SendManyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*SendManyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *SendManyRequest) GetAddrToAmount() map[string]int64 {
 	if m != nil {
@@ -1042,7 +1323,7 @@ type SendManyResponse struct {
 func (m *SendManyResponse) Reset()                    { *m = SendManyResponse{} }
 func (m *SendManyResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendManyResponse) ProtoMessage()               {}
-func (*SendManyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*SendManyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *SendManyResponse) GetTxid() string {
 	if m != nil {
@@ -1051,6 +1332,58 @@ func (m *SendManyResponse) GetTxid() string {
 	return ""
 }
 
+type EstimateFeeRequest struct {
+	// / The map from addresses to amounts for the transaction.
+	AddrToAmount map[string]int64 `protobuf:"bytes,1,rep,name=AddrToAmount" json:"AddrToAmount,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// / The target number of blocks that this transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+}
+
+func (m *EstimateFeeRequest) Reset()                    { *m = EstimateFeeRequest{} }
+func (m *EstimateFeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*EstimateFeeRequest) ProtoMessage()               {}
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *EstimateFeeRequest) GetAddrToAmount() map[string]int64 {
+	if m != nil {
+		return m.AddrToAmount
+	}
+	return nil
+}
+
+func (m *EstimateFeeRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+type EstimateFeeResponse struct {
+	// / The total fee in satoshis.
+	FeeSat int64 `protobuf:"varint,1,opt,name=fee_sat" json:"fee_sat,omitempty"`
+	// / The effective fee rate in sat/vbyte the transaction would pay.
+	SatPerVbyte uint64 `protobuf:"varint,2,opt,name=sat_per_vbyte" json:"sat_per_vbyte,omitempty"`
+}
+
+func (m *EstimateFeeResponse) Reset()                    { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()               {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *EstimateFeeResponse) GetFeeSat() int64 {
+	if m != nil {
+		return m.FeeSat
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetSatPerVbyte() uint64 {
+	if m != nil {
+		return m.SatPerVbyte
+	}
+	return 0
+}
+
 type SendCoinsRequest struct {
 	// / The address to send coins to
 	Addr string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
@@ -1058,14 +1391,25 @@ type SendCoinsRequest struct {
 	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
 	// / The target number of blocks that this transaction should be confirmed by.
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
+	// / Deprecated, use sat_per_vbyte. A manual fee rate set in sat/byte that should be used when crafting the transaction.
 	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// *
+	// If set, then the amount field will be ignored, and lnd will attempt to
+	// send all the coins under control of the internal wallet to the specified
+	// address.
+	SendAll bool `protobuf:"varint,6,opt,name=send_all" json:"send_all,omitempty"`
+	// / An optional label for the transaction, limited to 500 characters.
+	Label string `protobuf:"bytes,7,opt,name=label" json:"label,omitempty"`
+	// / Whether unconfirmed outputs should be used as inputs for the transaction.
+	SpendUnconfirmed bool `protobuf:"varint,8,opt,name=spend_unconfirmed" json:"spend_unconfirmed,omitempty"`
+	// / A manual fee rate set in sat/vbyte that should be used when crafting the transaction.
+	SatPerVbyte uint64 `protobuf:"varint,9,opt,name=sat_per_vbyte" json:"sat_per_vbyte,omitempty"`
 }
 
 func (m *SendCoinsRequest) Reset()                    { *m = SendCoinsRequest{} }
 func (m *SendCoinsRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendCoinsRequest) ProtoMessage()               {}
-func (*SendCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*SendCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *SendCoinsRequest) GetAddr() string {
 	if m != nil {
@@ -1095,6 +1439,34 @@ func (m *SendCoinsRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *SendCoinsRequest) GetSendAll() bool {
+	if m != nil {
+		return m.SendAll
+	}
+	return false
+}
+
+func (m *SendCoinsRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *SendCoinsRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
+func (m *SendCoinsRequest) GetSatPerVbyte() uint64 {
+	if m != nil {
+		return m.SatPerVbyte
+	}
+	return 0
+}
+
 type SendCoinsResponse struct {
 	// / The transaction ID of the transaction
 	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
@@ -1103,7 +1475,7 @@ type SendCoinsResponse struct {
 func (m *SendCoinsResponse) Reset()                    { *m = SendCoinsResponse{} }
 func (m *SendCoinsResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendCoinsResponse) ProtoMessage()               {}
-func (*SendCoinsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*SendCoinsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *SendCoinsResponse) GetTxid() string {
 	if m != nil {
@@ -1122,7 +1494,7 @@ type ListUnspentRequest struct {
 func (m *ListUnspentRequest) Reset()                    { *m = ListUnspentRequest{} }
 func (m *ListUnspentRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListUnspentRequest) ProtoMessage()               {}
-func (*ListUnspentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*ListUnspentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *ListUnspentRequest) GetMinConfs() int32 {
 	if m != nil {
@@ -1146,7 +1518,7 @@ type ListUnspentResponse struct {
 func (m *ListUnspentResponse) Reset()                    { *m = ListUnspentResponse{} }
 func (m *ListUnspentResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListUnspentResponse) ProtoMessage()               {}
-func (*ListUnspentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*ListUnspentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *ListUnspentResponse) GetUtxos() []*Utxo {
 	if m != nil {
@@ -1163,7 +1535,7 @@ type NewAddressRequest struct {
 func (m *NewAddressRequest) Reset()                    { *m = NewAddressRequest{} }
 func (m *NewAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*NewAddressRequest) ProtoMessage()               {}
-func (*NewAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*NewAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *NewAddressRequest) GetType() AddressType {
 	if m != nil {
@@ -1180,7 +1552,7 @@ type NewAddressResponse struct {
 func (m *NewAddressResponse) Reset()                    { *m = NewAddressResponse{} }
 func (m *NewAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*NewAddressResponse) ProtoMessage()               {}
-func (*NewAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*NewAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *NewAddressResponse) GetAddress() string {
 	if m != nil {
@@ -1189,6 +1561,84 @@ func (m *NewAddressResponse) GetAddress() string {
 	return ""
 }
 
+type ListAddressesRequest struct {
+}
+
+func (m *ListAddressesRequest) Reset()                    { *m = ListAddressesRequest{} }
+func (m *ListAddressesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAddressesRequest) ProtoMessage()               {}
+func (*ListAddressesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+type WalletAddress struct {
+	// / The address
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// / The type of the address
+	AddressType AddressType `protobuf:"varint,2,opt,name=address_type,enum=lnrpc.AddressType" json:"address_type,omitempty"`
+	// / Whether the address is a change address
+	IsInternal bool `protobuf:"varint,3,opt,name=is_internal" json:"is_internal,omitempty"`
+	// / The BIP32 derivation path of the key of the address
+	DerivationPath string `protobuf:"bytes,4,opt,name=derivation_path" json:"derivation_path,omitempty"`
+	// / The total value of the unspent outputs paying to the address, including unconfirmed ones
+	Balance int64 `protobuf:"varint,5,opt,name=balance" json:"balance,omitempty"`
+}
+
+func (m *WalletAddress) Reset()                    { *m = WalletAddress{} }
+func (m *WalletAddress) String() string            { return proto.CompactTextString(m) }
+func (*WalletAddress) ProtoMessage()               {}
+func (*WalletAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *WalletAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WalletAddress) GetAddressType() AddressType {
+	if m != nil {
+		return m.AddressType
+	}
+	return AddressType_WITNESS_PUBKEY_HASH
+}
+
+func (m *WalletAddress) GetIsInternal() bool {
+	if m != nil {
+		return m.IsInternal
+	}
+	return false
+}
+
+func (m *WalletAddress) GetDerivationPath() string {
+	if m != nil {
+		return m.DerivationPath
+	}
+	return ""
+}
+
+func (m *WalletAddress) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type ListAddressesResponse struct {
+	// / The list of addresses derived by the wallet
+	Addresses []*WalletAddress `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *ListAddressesResponse) Reset()                    { *m = ListAddressesResponse{} }
+func (m *ListAddressesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListAddressesResponse) ProtoMessage()               {}
+func (*ListAddressesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ListAddressesResponse) GetAddresses() []*WalletAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 type SignMessageRequest struct {
 	// / The message to be signed
 	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -1197,7 +1647,7 @@ type SignMessageRequest struct {
 func (m *SignMessageRequest) Reset()                    { *m = SignMessageRequest{} }
 func (m *SignMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*SignMessageRequest) ProtoMessage()               {}
-func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *SignMessageRequest) GetMsg() []byte {
 	if m != nil {
@@ -1214,7 +1664,7 @@ type SignMessageResponse struct {
 func (m *SignMessageResponse) Reset()                    { *m = SignMessageResponse{} }
 func (m *SignMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignMessageResponse) ProtoMessage()               {}
-func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *SignMessageResponse) GetSignature() string {
 	if m != nil {
@@ -1233,7 +1683,7 @@ type VerifyMessageRequest struct {
 func (m *VerifyMessageRequest) Reset()                    { *m = VerifyMessageRequest{} }
 func (m *VerifyMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*VerifyMessageRequest) ProtoMessage()               {}
-func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *VerifyMessageRequest) GetMsg() []byte {
 	if m != nil {
@@ -1259,7 +1709,7 @@ type VerifyMessageResponse struct {
 func (m *VerifyMessageResponse) Reset()                    { *m = VerifyMessageResponse{} }
 func (m *VerifyMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*VerifyMessageResponse) ProtoMessage()               {}
-func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *VerifyMessageResponse) GetValid() bool {
 	if m != nil {
@@ -1281,12 +1731,16 @@ type ConnectPeerRequest struct {
 	// * If set, the daemon will attempt to persistently connect to the target
 	// peer.  Otherwise, the call will be synchronous.
 	Perm bool `protobuf:"varint,2,opt,name=perm" json:"perm,omitempty"`
+	// *
+	// The connection timeout value (in seconds) for this request. It won't affect
+	// other requests. If not set, a default timeout of 120 seconds is used.
+	Timeout uint64 `protobuf:"varint,3,opt,name=timeout" json:"timeout,omitempty"`
 }
 
 func (m *ConnectPeerRequest) Reset()                    { *m = ConnectPeerRequest{} }
 func (m *ConnectPeerRequest) String() string            { return proto.CompactTextString(m) }
 func (*ConnectPeerRequest) ProtoMessage()               {}
-func (*ConnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*ConnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *ConnectPeerRequest) GetAddr() *LightningAddress {
 	if m != nil {
@@ -1302,13 +1756,20 @@ func (m *ConnectPeerRequest) GetPerm() bool {
 	return false
 }
 
+func (m *ConnectPeerRequest) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 type ConnectPeerResponse struct {
 }
 
 func (m *ConnectPeerResponse) Reset()                    { *m = ConnectPeerResponse{} }
 func (m *ConnectPeerResponse) String() string            { return proto.CompactTextString(m) }
 func (*ConnectPeerResponse) ProtoMessage()               {}
-func (*ConnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*ConnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 type DisconnectPeerRequest struct {
 	// / The pubkey of the node to disconnect from
@@ -1318,7 +1779,7 @@ type DisconnectPeerRequest struct {
 func (m *DisconnectPeerRequest) Reset()                    { *m = DisconnectPeerRequest{} }
 func (m *DisconnectPeerRequest) String() string            { return proto.CompactTextString(m) }
 func (*DisconnectPeerRequest) ProtoMessage()               {}
-func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 func (m *DisconnectPeerRequest) GetPubKey() string {
 	if m != nil {
@@ -1333,19 +1794,25 @@ type DisconnectPeerResponse struct {
 func (m *DisconnectPeerResponse) Reset()                    { *m = DisconnectPeerResponse{} }
 func (m *DisconnectPeerResponse) String() string            { return proto.CompactTextString(m) }
 func (*DisconnectPeerResponse) ProtoMessage()               {}
-func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 type HTLC struct {
 	Incoming         bool   `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
 	Amount           int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
 	HashLock         []byte `protobuf:"bytes,3,opt,name=hash_lock,proto3" json:"hash_lock,omitempty"`
 	ExpirationHeight uint32 `protobuf:"varint,4,opt,name=expiration_height" json:"expiration_height,omitempty"`
+	// / Whether this HTLC is part of a payment being forwarded through us.
+	Forwarding bool `protobuf:"varint,5,opt,name=forwarding" json:"forwarding,omitempty"`
+	// / The index of the HTLC within the channel's update log.
+	HtlcIndex uint64 `protobuf:"varint,6,opt,name=htlc_index" json:"htlc_index,omitempty"`
+	// / The amount of the HTLC in millisatoshis.
+	AmountMsat uint64 `protobuf:"varint,7,opt,name=amount_msat" json:"amount_msat,omitempty"`
 }
 
 func (m *HTLC) Reset()                    { *m = HTLC{} }
 func (m *HTLC) String() string            { return proto.CompactTextString(m) }
 func (*HTLC) ProtoMessage()               {}
-func (*HTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*HTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *HTLC) GetIncoming() bool {
 	if m != nil {
@@ -1375,6 +1842,27 @@ func (m *HTLC) GetExpirationHeight() uint32 {
 	return 0
 }
 
+func (m *HTLC) GetForwarding() bool {
+	if m != nil {
+		return m.Forwarding
+	}
+	return false
+}
+
+func (m *HTLC) GetHtlcIndex() uint64 {
+	if m != nil {
+		return m.HtlcIndex
+	}
+	return 0
+}
+
+func (m *HTLC) GetAmountMsat() uint64 {
+	if m != nil {
+		return m.AmountMsat
+	}
+	return 0
+}
+
 type Channel struct {
 	// / Whether this channel is active or not
 	Active bool `protobuf:"varint,1,opt,name=active" json:"active,omitempty"`
@@ -1430,12 +1918,25 @@ type Channel struct {
 	CsvDelay uint32 `protobuf:"varint,16,opt,name=csv_delay" json:"csv_delay,omitempty"`
 	// / Whether this channel is advertised to the network or not
 	Private bool `protobuf:"varint,17,opt,name=private" json:"private,omitempty"`
+	// *
+	// The total number of seconds the channel has been monitored for. Time
+	// during which our node was offline isn't included.
+	Lifetime int64 `protobuf:"varint,18,opt,name=lifetime" json:"lifetime,omitempty"`
+	// *
+	// The number of seconds of the channel's lifetime during which the remote
+	// peer was connected to us. The ratio of uptime to lifetime can be used to
+	// gauge the reliability of the peer.
+	Uptime int64 `protobuf:"varint,19,opt,name=uptime" json:"uptime,omitempty"`
+	// / Statistics on how long the forwards over this channel were held by the remote peer
+	HoldTimes *HoldTimeStats `protobuf:"bytes,20,opt,name=hold_times" json:"hold_times,omitempty"`
+	// / The alias of the remote peer, only set if requested with peer_alias_lookup
+	PeerAlias string `protobuf:"bytes,21,opt,name=peer_alias" json:"peer_alias,omitempty"`
 }
 
 func (m *Channel) Reset()                    { *m = Channel{} }
 func (m *Channel) String() string            { return proto.CompactTextString(m) }
 func (*Channel) ProtoMessage()               {}
-func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *Channel) GetActive() bool {
 	if m != nil {
@@ -1556,17 +2057,49 @@ func (m *Channel) GetPrivate() bool {
 	return false
 }
 
+func (m *Channel) GetLifetime() int64 {
+	if m != nil {
+		return m.Lifetime
+	}
+	return 0
+}
+
+func (m *Channel) GetUptime() int64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
+}
+
+func (m *Channel) GetHoldTimes() *HoldTimeStats {
+	if m != nil {
+		return m.HoldTimes
+	}
+	return nil
+}
+
+func (m *Channel) GetPeerAlias() string {
+	if m != nil {
+		return m.PeerAlias
+	}
+	return ""
+}
+
 type ListChannelsRequest struct {
 	ActiveOnly   bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly" json:"active_only,omitempty"`
 	InactiveOnly bool `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly" json:"inactive_only,omitempty"`
 	PublicOnly   bool `protobuf:"varint,3,opt,name=public_only,json=publicOnly" json:"public_only,omitempty"`
 	PrivateOnly  bool `protobuf:"varint,4,opt,name=private_only,json=privateOnly" json:"private_only,omitempty"`
+	// / Only return the channels with the peer of the given compressed public key
+	Peer []byte `protobuf:"bytes,5,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / Whether the alias of the peer of each channel should be looked up and returned
+	PeerAliasLookup bool `protobuf:"varint,6,opt,name=peer_alias_lookup,json=peerAliasLookup" json:"peer_alias_lookup,omitempty"`
 }
 
 func (m *ListChannelsRequest) Reset()                    { *m = ListChannelsRequest{} }
 func (m *ListChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListChannelsRequest) ProtoMessage()               {}
-func (*ListChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *ListChannelsRequest) GetActiveOnly() bool {
 	if m != nil {
@@ -1596,6 +2129,20 @@ func (m *ListChannelsRequest) GetPrivateOnly() bool {
 	return false
 }
 
+func (m *ListChannelsRequest) GetPeer() []byte {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+func (m *ListChannelsRequest) GetPeerAliasLookup() bool {
+	if m != nil {
+		return m.PeerAliasLookup
+	}
+	return false
+}
+
 type ListChannelsResponse struct {
 	// / The list of active channels
 	Channels []*Channel `protobuf:"bytes,11,rep,name=channels" json:"channels,omitempty"`
@@ -1604,7 +2151,7 @@ type ListChannelsResponse struct {
 func (m *ListChannelsResponse) Reset()                    { *m = ListChannelsResponse{} }
 func (m *ListChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListChannelsResponse) ProtoMessage()               {}
-func (*ListChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *ListChannelsResponse) GetChannels() []*Channel {
 	if m != nil {
@@ -1639,7 +2186,7 @@ type ChannelCloseSummary struct {
 func (m *ChannelCloseSummary) Reset()                    { *m = ChannelCloseSummary{} }
 func (m *ChannelCloseSummary) String() string            { return proto.CompactTextString(m) }
 func (*ChannelCloseSummary) ProtoMessage()               {}
-func (*ChannelCloseSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*ChannelCloseSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *ChannelCloseSummary) GetChannelPoint() string {
 	if m != nil {
@@ -1723,7 +2270,7 @@ type ClosedChannelsRequest struct {
 func (m *ClosedChannelsRequest) Reset()                    { *m = ClosedChannelsRequest{} }
 func (m *ClosedChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelsRequest) ProtoMessage()               {}
-func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *ClosedChannelsRequest) GetCooperative() bool {
 	if m != nil {
@@ -1774,7 +2321,7 @@ type ClosedChannelsResponse struct {
 func (m *ClosedChannelsResponse) Reset()                    { *m = ClosedChannelsResponse{} }
 func (m *ClosedChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelsResponse) ProtoMessage()               {}
-func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	if m != nil {
@@ -1783,5472 +2330,10097 @@ func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	return nil
 }
 
-type Peer struct {
-	// / The identity pubkey of the peer
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
-	// / Network address of the peer; eg `127.0.0.1:10011`
-	Address string `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
-	// / Bytes of data transmitted to this peer
-	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent" json:"bytes_sent,omitempty"`
-	// / Bytes of data transmitted from this peer
-	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv" json:"bytes_recv,omitempty"`
-	// / Satoshis sent to this peer
-	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent" json:"sat_sent,omitempty"`
-	// / Satoshis received from this peer
-	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv" json:"sat_recv,omitempty"`
-	// / A channel is inbound if the counterparty initiated the channel
-	Inbound bool `protobuf:"varint,8,opt,name=inbound" json:"inbound,omitempty"`
-	// / Ping time to this peer
-	PingTime int64 `protobuf:"varint,9,opt,name=ping_time" json:"ping_time,omitempty"`
+type LookupHtlcResolutionRequest struct {
+	// / The short channel ID of the channel the HTLC was part of
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The index of the HTLC within the update log of its direction
+	HtlcIndex uint64 `protobuf:"varint,2,opt,name=htlc_index" json:"htlc_index,omitempty"`
+	// / Whether the HTLC was extended to us by the remote party
+	Incoming bool `protobuf:"varint,3,opt,name=incoming" json:"incoming,omitempty"`
 }
 
-func (m *Peer) Reset()                    { *m = Peer{} }
-func (m *Peer) String() string            { return proto.CompactTextString(m) }
-func (*Peer) ProtoMessage()               {}
-func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (m *LookupHtlcResolutionRequest) Reset()                    { *m = LookupHtlcResolutionRequest{} }
+func (m *LookupHtlcResolutionRequest) String() string            { return proto.CompactTextString(m) }
+func (*LookupHtlcResolutionRequest) ProtoMessage()               {}
+func (*LookupHtlcResolutionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
-func (m *Peer) GetPubKey() string {
+func (m *LookupHtlcResolutionRequest) GetChanId() uint64 {
 	if m != nil {
-		return m.PubKey
+		return m.ChanId
 	}
-	return ""
+	return 0
 }
 
-func (m *Peer) GetAddress() string {
+func (m *LookupHtlcResolutionRequest) GetHtlcIndex() uint64 {
 	if m != nil {
-		return m.Address
+		return m.HtlcIndex
 	}
-	return ""
+	return 0
 }
 
-func (m *Peer) GetBytesSent() uint64 {
+func (m *LookupHtlcResolutionRequest) GetIncoming() bool {
 	if m != nil {
-		return m.BytesSent
+		return m.Incoming
 	}
-	return 0
+	return false
 }
 
-func (m *Peer) GetBytesRecv() uint64 {
+type LookupHtlcResolutionResponse struct {
+	// / Whether the HTLC was settled with its preimage, rather than timed out
+	Settled bool `protobuf:"varint,1,opt,name=settled" json:"settled,omitempty"`
+	// / The outpoint of the output that resolved the HTLC on our side
+	Outpoint string `protobuf:"bytes,2,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The amount that was swept back to the wallet while resolving the HTLC
+	AmountSat int64 `protobuf:"varint,3,opt,name=amount_sat" json:"amount_sat,omitempty"`
+}
+
+func (m *LookupHtlcResolutionResponse) Reset()                    { *m = LookupHtlcResolutionResponse{} }
+func (m *LookupHtlcResolutionResponse) String() string            { return proto.CompactTextString(m) }
+func (*LookupHtlcResolutionResponse) ProtoMessage()               {}
+func (*LookupHtlcResolutionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *LookupHtlcResolutionResponse) GetSettled() bool {
 	if m != nil {
-		return m.BytesRecv
+		return m.Settled
 	}
-	return 0
+	return false
 }
 
-func (m *Peer) GetSatSent() int64 {
+func (m *LookupHtlcResolutionResponse) GetOutpoint() string {
 	if m != nil {
-		return m.SatSent
+		return m.Outpoint
 	}
-	return 0
+	return ""
 }
 
-func (m *Peer) GetSatRecv() int64 {
+func (m *LookupHtlcResolutionResponse) GetAmountSat() int64 {
 	if m != nil {
-		return m.SatRecv
+		return m.AmountSat
 	}
 	return 0
 }
 
-func (m *Peer) GetInbound() bool {
+type HtlcRateLimitStats struct {
+	// / The number of incoming HTLC adds from the peer that were within its rate limit
+	Accepted uint64 `protobuf:"varint,1,opt,name=accepted" json:"accepted,omitempty"`
+	// / The number of incoming HTLC adds from the peer that were failed back for exceeding its rate limit
+	Rejected uint64 `protobuf:"varint,2,opt,name=rejected" json:"rejected,omitempty"`
+}
+
+func (m *HtlcRateLimitStats) Reset()                    { *m = HtlcRateLimitStats{} }
+func (m *HtlcRateLimitStats) String() string            { return proto.CompactTextString(m) }
+func (*HtlcRateLimitStats) ProtoMessage()               {}
+func (*HtlcRateLimitStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *HtlcRateLimitStats) GetAccepted() uint64 {
 	if m != nil {
-		return m.Inbound
+		return m.Accepted
 	}
-	return false
+	return 0
 }
 
-func (m *Peer) GetPingTime() int64 {
+func (m *HtlcRateLimitStats) GetRejected() uint64 {
 	if m != nil {
-		return m.PingTime
+		return m.Rejected
 	}
 	return 0
 }
 
-type ListPeersRequest struct {
+type HoldTimeStats struct {
+	// / The total number of forwards that were resolved since startup
+	NumForwards uint64 `protobuf:"varint,1,opt,name=num_forwards" json:"num_forwards,omitempty"`
+	// / The median hold time of the most recent forwards, in milliseconds
+	P50Ms int64 `protobuf:"varint,2,opt,name=p50_ms" json:"p50_ms,omitempty"`
+	// / The 90th percentile hold time of the most recent forwards, in milliseconds
+	P90Ms int64 `protobuf:"varint,3,opt,name=p90_ms" json:"p90_ms,omitempty"`
+	// / The 99th percentile hold time of the most recent forwards, in milliseconds
+	P99Ms int64 `protobuf:"varint,4,opt,name=p99_ms" json:"p99_ms,omitempty"`
 }
 
-func (m *ListPeersRequest) Reset()                    { *m = ListPeersRequest{} }
-func (m *ListPeersRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListPeersRequest) ProtoMessage()               {}
-func (*ListPeersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (m *HoldTimeStats) Reset()                    { *m = HoldTimeStats{} }
+func (m *HoldTimeStats) String() string            { return proto.CompactTextString(m) }
+func (*HoldTimeStats) ProtoMessage()               {}
+func (*HoldTimeStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
-type ListPeersResponse struct {
-	// / The list of currently connected peers
-	Peers []*Peer `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+func (m *HoldTimeStats) GetNumForwards() uint64 {
+	if m != nil {
+		return m.NumForwards
+	}
+	return 0
 }
 
-func (m *ListPeersResponse) Reset()                    { *m = ListPeersResponse{} }
-func (m *ListPeersResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListPeersResponse) ProtoMessage()               {}
-func (*ListPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
-
-func (m *ListPeersResponse) GetPeers() []*Peer {
+func (m *HoldTimeStats) GetP50Ms() int64 {
 	if m != nil {
-		return m.Peers
+		return m.P50Ms
 	}
-	return nil
+	return 0
 }
 
-type GetInfoRequest struct {
+func (m *HoldTimeStats) GetP90Ms() int64 {
+	if m != nil {
+		return m.P90Ms
+	}
+	return 0
 }
 
-func (m *GetInfoRequest) Reset()                    { *m = GetInfoRequest{} }
-func (m *GetInfoRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetInfoRequest) ProtoMessage()               {}
-func (*GetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (m *HoldTimeStats) GetP99Ms() int64 {
+	if m != nil {
+		return m.P99Ms
+	}
+	return 0
+}
 
-type GetInfoResponse struct {
-	// / The identity pubkey of the current node.
-	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey" json:"identity_pubkey,omitempty"`
-	// / If applicable, the alias of the current node, e.g. "bob"
-	Alias string `protobuf:"bytes,2,opt,name=alias" json:"alias,omitempty"`
-	// / Number of pending channels
-	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels" json:"num_pending_channels,omitempty"`
-	// / Number of active channels
-	NumActiveChannels uint32 `protobuf:"varint,4,opt,name=num_active_channels" json:"num_active_channels,omitempty"`
-	// / Number of peers
-	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers" json:"num_peers,omitempty"`
-	// / The node's current view of the height of the best block
-	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height" json:"block_height,omitempty"`
-	// / The node's current view of the hash of the best block
-	BlockHash string `protobuf:"bytes,8,opt,name=block_hash" json:"block_hash,omitempty"`
-	// / Whether the wallet's view is synced to the main chain
-	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain" json:"synced_to_chain,omitempty"`
-	// / Whether the current node is connected to testnet
-	Testnet bool `protobuf:"varint,10,opt,name=testnet" json:"testnet,omitempty"`
-	// / A list of active chains the node is connected to
-	Chains []string `protobuf:"bytes,11,rep,name=chains" json:"chains,omitempty"`
-	// / The URIs of the current node.
-	Uris []string `protobuf:"bytes,12,rep,name=uris" json:"uris,omitempty"`
-	// / Timestamp of the block best known to the wallet
-	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp" json:"best_header_timestamp,omitempty"`
-	// / The version of the LND software that the node is running.
-	Version string `protobuf:"bytes,14,opt,name=version" json:"version,omitempty"`
-	// / Number of inactive channels
-	NumInactiveChannels uint32 `protobuf:"varint,15,opt,name=num_inactive_channels" json:"num_inactive_channels,omitempty"`
+type Peer struct {
+	// / The identity pubkey of the peer
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
+	// / Network address of the peer; eg `127.0.0.1:10011`
+	Address string `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
+	// / Bytes of data transmitted to this peer
+	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent" json:"bytes_sent,omitempty"`
+	// / Bytes of data transmitted from this peer
+	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv" json:"bytes_recv,omitempty"`
+	// / Satoshis sent to this peer
+	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent" json:"sat_sent,omitempty"`
+	// / Satoshis received from this peer
+	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv" json:"sat_recv,omitempty"`
+	// / A channel is inbound if the counterparty initiated the channel
+	Inbound bool `protobuf:"varint,8,opt,name=inbound" json:"inbound,omitempty"`
+	// / Ping time to this peer
+	PingTime int64 `protobuf:"varint,9,opt,name=ping_time" json:"ping_time,omitempty"`
+	// / The number of incoming HTLC adds from this peer that were accepted or rejected by the rate limiter
+	HtlcRateLimit *HtlcRateLimitStats `protobuf:"bytes,10,opt,name=htlc_rate_limit" json:"htlc_rate_limit,omitempty"`
+	// / Statistics on how long the forwards to this peer were held before being resolved
+	HoldTimes *HoldTimeStats `protobuf:"bytes,11,opt,name=hold_times" json:"hold_times,omitempty"`
+	// / Whether we request channel updates from this peer to keep our channel graph in sync
+	GossipSyncPeer bool `protobuf:"varint,12,opt,name=gossip_sync_peer" json:"gossip_sync_peer,omitempty"`
+	// / The feature bits the peer advertised when connecting
+	Features []uint32 `protobuf:"varint,13,rep,packed,name=features" json:"features,omitempty"`
+	// / The most recent error the peer sent us, if any
+	LastError string `protobuf:"bytes,14,opt,name=last_error" json:"last_error,omitempty"`
+	// / The unix timestamp in seconds at which the most recent error was received
+	LastErrorTimestamp int64 `protobuf:"varint,15,opt,name=last_error_timestamp" json:"last_error_timestamp,omitempty"`
+	// / The number of times the peer went online or offline since we started
+	FlapCount int32 `protobuf:"varint,16,opt,name=flap_count" json:"flap_count,omitempty"`
+	// / The unix timestamp in nanoseconds of the most recent flap, zero if the peer never flapped
+	LastFlapNs int64 `protobuf:"varint,17,opt,name=last_flap_ns" json:"last_flap_ns,omitempty"`
 }
 
-func (m *GetInfoResponse) Reset()                    { *m = GetInfoResponse{} }
-func (m *GetInfoResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetInfoResponse) ProtoMessage()               {}
-func (*GetInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (m *Peer) Reset()                    { *m = Peer{} }
+func (m *Peer) String() string            { return proto.CompactTextString(m) }
+func (*Peer) ProtoMessage()               {}
+func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
-func (m *GetInfoResponse) GetIdentityPubkey() string {
+func (m *Peer) GetPubKey() string {
 	if m != nil {
-		return m.IdentityPubkey
+		return m.PubKey
 	}
 	return ""
 }
 
-func (m *GetInfoResponse) GetAlias() string {
+func (m *Peer) GetAddress() string {
 	if m != nil {
-		return m.Alias
+		return m.Address
 	}
 	return ""
 }
 
-func (m *GetInfoResponse) GetNumPendingChannels() uint32 {
+func (m *Peer) GetBytesSent() uint64 {
 	if m != nil {
-		return m.NumPendingChannels
+		return m.BytesSent
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetNumActiveChannels() uint32 {
+func (m *Peer) GetBytesRecv() uint64 {
 	if m != nil {
-		return m.NumActiveChannels
+		return m.BytesRecv
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetNumPeers() uint32 {
+func (m *Peer) GetSatSent() int64 {
 	if m != nil {
-		return m.NumPeers
+		return m.SatSent
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetBlockHeight() uint32 {
+func (m *Peer) GetSatRecv() int64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.SatRecv
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetBlockHash() string {
-	if m != nil {
-		return m.BlockHash
-	}
-	return ""
-}
-
-func (m *GetInfoResponse) GetSyncedToChain() bool {
+func (m *Peer) GetInbound() bool {
 	if m != nil {
-		return m.SyncedToChain
+		return m.Inbound
 	}
 	return false
 }
 
-func (m *GetInfoResponse) GetTestnet() bool {
+func (m *Peer) GetPingTime() int64 {
 	if m != nil {
-		return m.Testnet
+		return m.PingTime
 	}
-	return false
+	return 0
 }
 
-func (m *GetInfoResponse) GetChains() []string {
+func (m *Peer) GetHtlcRateLimit() *HtlcRateLimitStats {
 	if m != nil {
-		return m.Chains
+		return m.HtlcRateLimit
 	}
 	return nil
 }
 
-func (m *GetInfoResponse) GetUris() []string {
+func (m *Peer) GetHoldTimes() *HoldTimeStats {
 	if m != nil {
-		return m.Uris
+		return m.HoldTimes
 	}
 	return nil
 }
 
-func (m *GetInfoResponse) GetBestHeaderTimestamp() int64 {
+func (m *Peer) GetGossipSyncPeer() bool {
 	if m != nil {
-		return m.BestHeaderTimestamp
+		return m.GossipSyncPeer
 	}
-	return 0
+	return false
 }
 
-func (m *GetInfoResponse) GetVersion() string {
+func (m *Peer) GetFeatures() []uint32 {
 	if m != nil {
-		return m.Version
+		return m.Features
 	}
-	return ""
+	return nil
 }
 
-func (m *GetInfoResponse) GetNumInactiveChannels() uint32 {
+func (m *Peer) GetLastError() string {
 	if m != nil {
-		return m.NumInactiveChannels
+		return m.LastError
 	}
-	return 0
-}
-
-type ConfirmationUpdate struct {
-	BlockSha     []byte `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
-	BlockHeight  int32  `protobuf:"varint,2,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
-	NumConfsLeft uint32 `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft" json:"num_confs_left,omitempty"`
+	return ""
 }
 
-func (m *ConfirmationUpdate) Reset()                    { *m = ConfirmationUpdate{} }
-func (m *ConfirmationUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ConfirmationUpdate) ProtoMessage()               {}
-func (*ConfirmationUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
-
-func (m *ConfirmationUpdate) GetBlockSha() []byte {
+func (m *Peer) GetLastErrorTimestamp() int64 {
 	if m != nil {
-		return m.BlockSha
+		return m.LastErrorTimestamp
 	}
-	return nil
+	return 0
 }
 
-func (m *ConfirmationUpdate) GetBlockHeight() int32 {
+func (m *Peer) GetFlapCount() int32 {
 	if m != nil {
-		return m.BlockHeight
+		return m.FlapCount
 	}
 	return 0
 }
 
-func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
+func (m *Peer) GetLastFlapNs() int64 {
 	if m != nil {
-		return m.NumConfsLeft
+		return m.LastFlapNs
 	}
 	return 0
 }
 
-type ChannelOpenUpdate struct {
-	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
+type SendCustomMessageRequest struct {
+	// / The compressed public key of the peer to send the message to.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / The message type, which must be odd and at least 32768.
+	Type uint32 `protobuf:"varint,2,opt,name=type" json:"type,omitempty"`
+	// / The raw payload of the message.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *ChannelOpenUpdate) Reset()                    { *m = ChannelOpenUpdate{} }
-func (m *ChannelOpenUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ChannelOpenUpdate) ProtoMessage()               {}
-func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (m *SendCustomMessageRequest) Reset()                    { *m = SendCustomMessageRequest{} }
+func (m *SendCustomMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendCustomMessageRequest) ProtoMessage()               {}
+func (*SendCustomMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
-func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
+func (m *SendCustomMessageRequest) GetPeer() []byte {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Peer
 	}
 	return nil
 }
 
-type ChannelCloseUpdate struct {
-	ClosingTxid []byte `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	Success     bool   `protobuf:"varint,2,opt,name=success" json:"success,omitempty"`
+func (m *SendCustomMessageRequest) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
 }
 
-func (m *ChannelCloseUpdate) Reset()                    { *m = ChannelCloseUpdate{} }
-func (m *ChannelCloseUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ChannelCloseUpdate) ProtoMessage()               {}
-func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
-
-func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
+func (m *SendCustomMessageRequest) GetData() []byte {
 	if m != nil {
-		return m.ClosingTxid
+		return m.Data
 	}
 	return nil
 }
 
-func (m *ChannelCloseUpdate) GetSuccess() bool {
-	if m != nil {
-		return m.Success
-	}
-	return false
+type SendCustomMessageResponse struct {
 }
 
-type CloseChannelRequest struct {
-	// *
-	// The outpoint (txid:index) of the funding transaction. With this value, Bob
-	// will be able to generate a signature for Alice's version of the commitment
-	// transaction.
-	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
-	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
-	Force bool `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
-	// / The target number of blocks that the closure transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
-	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+func (m *SendCustomMessageResponse) Reset()                    { *m = SendCustomMessageResponse{} }
+func (m *SendCustomMessageResponse) String() string            { return proto.CompactTextString(m) }
+func (*SendCustomMessageResponse) ProtoMessage()               {}
+func (*SendCustomMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+type SubscribeCustomMessagesRequest struct {
 }
 
-func (m *CloseChannelRequest) Reset()                    { *m = CloseChannelRequest{} }
-func (m *CloseChannelRequest) String() string            { return proto.CompactTextString(m) }
-func (*CloseChannelRequest) ProtoMessage()               {}
-func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (m *SubscribeCustomMessagesRequest) Reset()         { *m = SubscribeCustomMessagesRequest{} }
+func (m *SubscribeCustomMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeCustomMessagesRequest) ProtoMessage()    {}
+func (*SubscribeCustomMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{57}
+}
 
-func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
-	if m != nil {
-		return m.ChannelPoint
-	}
-	return nil
+type CustomMessage struct {
+	// / The compressed public key of the peer that sent the message.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / The message type.
+	Type uint32 `protobuf:"varint,2,opt,name=type" json:"type,omitempty"`
+	// / The raw payload of the message.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *CloseChannelRequest) GetForce() bool {
+func (m *CustomMessage) Reset()                    { *m = CustomMessage{} }
+func (m *CustomMessage) String() string            { return proto.CompactTextString(m) }
+func (*CustomMessage) ProtoMessage()               {}
+func (*CustomMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *CustomMessage) GetPeer() []byte {
 	if m != nil {
-		return m.Force
+		return m.Peer
 	}
-	return false
+	return nil
 }
 
-func (m *CloseChannelRequest) GetTargetConf() int32 {
+func (m *CustomMessage) GetType() uint32 {
 	if m != nil {
-		return m.TargetConf
+		return m.Type
 	}
 	return 0
 }
 
-func (m *CloseChannelRequest) GetSatPerByte() int64 {
+func (m *CustomMessage) GetData() []byte {
 	if m != nil {
-		return m.SatPerByte
+		return m.Data
 	}
-	return 0
+	return nil
 }
 
-type CloseStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
-	//	*CloseStatusUpdate_ClosePending
-	//	*CloseStatusUpdate_Confirmation
-	//	*CloseStatusUpdate_ChanClose
-	Update isCloseStatusUpdate_Update `protobuf_oneof:"update"`
+type ListPeersRequest struct {
 }
 
-func (m *CloseStatusUpdate) Reset()                    { *m = CloseStatusUpdate{} }
-func (m *CloseStatusUpdate) String() string            { return proto.CompactTextString(m) }
-func (*CloseStatusUpdate) ProtoMessage()               {}
-func (*CloseStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
-
-type isCloseStatusUpdate_Update interface{ isCloseStatusUpdate_Update() }
+func (m *ListPeersRequest) Reset()                    { *m = ListPeersRequest{} }
+func (m *ListPeersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPeersRequest) ProtoMessage()               {}
+func (*ListPeersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
-type CloseStatusUpdate_ClosePending struct {
-	ClosePending *PendingUpdate `protobuf:"bytes,1,opt,name=close_pending,oneof"`
+type ListPeersResponse struct {
+	// / The list of currently connected peers
+	Peers []*Peer `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
 }
-type CloseStatusUpdate_Confirmation struct {
-	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,oneof"`
+
+func (m *ListPeersResponse) Reset()                    { *m = ListPeersResponse{} }
+func (m *ListPeersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListPeersResponse) ProtoMessage()               {}
+func (*ListPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *ListPeersResponse) GetPeers() []*Peer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
 }
-type CloseStatusUpdate_ChanClose struct {
-	ChanClose *ChannelCloseUpdate `protobuf:"bytes,3,opt,name=chan_close,oneof"`
+
+type UpdateNodeAnnouncementRequest struct {
+	// / The new alias of the node, left unchanged if empty
+	Alias string `protobuf:"bytes,1,opt,name=alias" json:"alias,omitempty"`
+	// / The new color of the node in the form #RRGGBB, left unchanged if empty
+	Color string `protobuf:"bytes,2,opt,name=color" json:"color,omitempty"`
+	// / The addresses to start advertising, in the form host:port
+	AddAddresses []string `protobuf:"bytes,3,rep,name=add_addresses" json:"add_addresses,omitempty"`
+	// / The advertised addresses to stop advertising, in the form host:port
+	RemoveAddresses []string `protobuf:"bytes,4,rep,name=remove_addresses" json:"remove_addresses,omitempty"`
+	// / The feature bits to set in the announcement
+	SetFeatures []uint32 `protobuf:"varint,5,rep,packed,name=set_features" json:"set_features,omitempty"`
+	// / The feature bits to unset from the announcement
+	UnsetFeatures []uint32 `protobuf:"varint,6,rep,packed,name=unset_features" json:"unset_features,omitempty"`
 }
 
-func (*CloseStatusUpdate_ClosePending) isCloseStatusUpdate_Update() {}
-func (*CloseStatusUpdate_Confirmation) isCloseStatusUpdate_Update() {}
-func (*CloseStatusUpdate_ChanClose) isCloseStatusUpdate_Update()    {}
+func (m *UpdateNodeAnnouncementRequest) Reset()                    { *m = UpdateNodeAnnouncementRequest{} }
+func (m *UpdateNodeAnnouncementRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateNodeAnnouncementRequest) ProtoMessage()               {}
+func (*UpdateNodeAnnouncementRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
 
-func (m *CloseStatusUpdate) GetUpdate() isCloseStatusUpdate_Update {
+func (m *UpdateNodeAnnouncementRequest) GetAlias() string {
 	if m != nil {
-		return m.Update
+		return m.Alias
 	}
-	return nil
+	return ""
 }
 
-func (m *CloseStatusUpdate) GetClosePending() *PendingUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ClosePending); ok {
-		return x.ClosePending
+func (m *UpdateNodeAnnouncementRequest) GetColor() string {
+	if m != nil {
+		return m.Color
 	}
-	return nil
+	return ""
 }
 
-func (m *CloseStatusUpdate) GetConfirmation() *ConfirmationUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_Confirmation); ok {
-		return x.Confirmation
+func (m *UpdateNodeAnnouncementRequest) GetAddAddresses() []string {
+	if m != nil {
+		return m.AddAddresses
 	}
 	return nil
 }
 
-func (m *CloseStatusUpdate) GetChanClose() *ChannelCloseUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ChanClose); ok {
-		return x.ChanClose
+func (m *UpdateNodeAnnouncementRequest) GetRemoveAddresses() []string {
+	if m != nil {
+		return m.RemoveAddresses
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*CloseStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _CloseStatusUpdate_OneofMarshaler, _CloseStatusUpdate_OneofUnmarshaler, _CloseStatusUpdate_OneofSizer, []interface{}{
-		(*CloseStatusUpdate_ClosePending)(nil),
-		(*CloseStatusUpdate_Confirmation)(nil),
-		(*CloseStatusUpdate_ChanClose)(nil),
+func (m *UpdateNodeAnnouncementRequest) GetSetFeatures() []uint32 {
+	if m != nil {
+		return m.SetFeatures
 	}
+	return nil
 }
 
-func _CloseStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*CloseStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *CloseStatusUpdate_ClosePending:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ClosePending); err != nil {
-			return err
-		}
-	case *CloseStatusUpdate_Confirmation:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Confirmation); err != nil {
-			return err
-		}
-	case *CloseStatusUpdate_ChanClose:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanClose); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("CloseStatusUpdate.Update has unexpected type %T", x)
+func (m *UpdateNodeAnnouncementRequest) GetUnsetFeatures() []uint32 {
+	if m != nil {
+		return m.UnsetFeatures
 	}
 	return nil
 }
 
-func _CloseStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*CloseStatusUpdate)
-	switch tag {
-	case 1: // update.close_pending
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(PendingUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_ClosePending{msg}
-		return true, err
-	case 2: // update.confirmation
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ConfirmationUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_Confirmation{msg}
-		return true, err
-	case 3: // update.chan_close
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelCloseUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_ChanClose{msg}
-		return true, err
-	default:
-		return false, nil
-	}
+type UpdateNodeAnnouncementResponse struct {
+	// / The alias of the node after the update
+	Alias string `protobuf:"bytes,1,opt,name=alias" json:"alias,omitempty"`
+	// / The color of the node after the update
+	Color string `protobuf:"bytes,2,opt,name=color" json:"color,omitempty"`
+	// / The addresses advertised after the update
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses" json:"addresses,omitempty"`
+	// / The feature bits of the announcement after the update
+	Features []uint32 `protobuf:"varint,4,rep,packed,name=features" json:"features,omitempty"`
 }
 
-func _CloseStatusUpdate_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*CloseStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *CloseStatusUpdate_ClosePending:
-		s := proto.Size(x.ClosePending)
-		n += proto.SizeVarint(1<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *CloseStatusUpdate_Confirmation:
-		s := proto.Size(x.Confirmation)
-		n += proto.SizeVarint(2<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *CloseStatusUpdate_ChanClose:
-		s := proto.Size(x.ChanClose)
-		n += proto.SizeVarint(3<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
+func (m *UpdateNodeAnnouncementResponse) Reset()         { *m = UpdateNodeAnnouncementResponse{} }
+func (m *UpdateNodeAnnouncementResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateNodeAnnouncementResponse) ProtoMessage()    {}
+func (*UpdateNodeAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{62}
 }
 
-type PendingUpdate struct {
-	Txid        []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index" json:"output_index,omitempty"`
+func (m *UpdateNodeAnnouncementResponse) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
 }
 
-func (m *PendingUpdate) Reset()                    { *m = PendingUpdate{} }
-func (m *PendingUpdate) String() string            { return proto.CompactTextString(m) }
-func (*PendingUpdate) ProtoMessage()               {}
-func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (m *UpdateNodeAnnouncementResponse) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
 
-func (m *PendingUpdate) GetTxid() []byte {
+func (m *UpdateNodeAnnouncementResponse) GetAddresses() []string {
 	if m != nil {
-		return m.Txid
+		return m.Addresses
 	}
 	return nil
 }
 
-func (m *PendingUpdate) GetOutputIndex() uint32 {
+func (m *UpdateNodeAnnouncementResponse) GetFeatures() []uint32 {
 	if m != nil {
-		return m.OutputIndex
+		return m.Features
 	}
-	return 0
+	return nil
 }
 
-type OpenChannelRequest struct {
-	// / The pubkey of the node to open a channel with
-	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
-	// / The hex encoded pubkey of the node to open a channel with
-	NodePubkeyString string `protobuf:"bytes,3,opt,name=node_pubkey_string" json:"node_pubkey_string,omitempty"`
-	// / The number of satoshis the wallet should commit to the channel
-	LocalFundingAmount int64 `protobuf:"varint,4,opt,name=local_funding_amount" json:"local_funding_amount,omitempty"`
-	// / The number of satoshis to push to the remote side as part of the initial commitment state
-	PushSat int64 `protobuf:"varint,5,opt,name=push_sat" json:"push_sat,omitempty"`
-	// / The target number of blocks that the funding transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,6,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
-	SatPerByte int64 `protobuf:"varint,7,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
-	// / Whether this channel should be private, not announced to the greater network.
-	Private bool `protobuf:"varint,8,opt,name=private" json:"private,omitempty"`
-	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
-	MinHtlcMsat int64 `protobuf:"varint,9,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
-	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
-	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay" json:"remote_csv_delay,omitempty"`
-	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
-	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs" json:"min_confs,omitempty"`
-	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
-	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed" json:"spend_unconfirmed,omitempty"`
+type GetRecoveryInfoRequest struct {
 }
 
-func (m *OpenChannelRequest) Reset()                    { *m = OpenChannelRequest{} }
-func (m *OpenChannelRequest) String() string            { return proto.CompactTextString(m) }
-func (*OpenChannelRequest) ProtoMessage()               {}
-func (*OpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (m *GetRecoveryInfoRequest) Reset()                    { *m = GetRecoveryInfoRequest{} }
+func (m *GetRecoveryInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRecoveryInfoRequest) ProtoMessage()               {}
+func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
 
-func (m *OpenChannelRequest) GetNodePubkey() []byte {
-	if m != nil {
-		return m.NodePubkey
-	}
-	return nil
+type GetRecoveryInfoResponse struct {
+	// / Whether the wallet is in recovery mode
+	RecoveryMode bool `protobuf:"varint,1,opt,name=recovery_mode" json:"recovery_mode,omitempty"`
+	// / Whether the wallet recovery is finished
+	RecoveryFinished bool `protobuf:"varint,2,opt,name=recovery_finished" json:"recovery_finished,omitempty"`
+	// / The recovery progress, as a percentage ranging from 0 to 100
+	Progress float64 `protobuf:"fixed64,3,opt,name=progress" json:"progress,omitempty"`
 }
 
-func (m *OpenChannelRequest) GetNodePubkeyString() string {
+func (m *GetRecoveryInfoResponse) Reset()                    { *m = GetRecoveryInfoResponse{} }
+func (m *GetRecoveryInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetRecoveryInfoResponse) ProtoMessage()               {}
+func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *GetRecoveryInfoResponse) GetRecoveryMode() bool {
 	if m != nil {
-		return m.NodePubkeyString
+		return m.RecoveryMode
 	}
-	return ""
+	return false
 }
 
-func (m *OpenChannelRequest) GetLocalFundingAmount() int64 {
+func (m *GetRecoveryInfoResponse) GetRecoveryFinished() bool {
 	if m != nil {
-		return m.LocalFundingAmount
+		return m.RecoveryFinished
 	}
-	return 0
+	return false
 }
 
-func (m *OpenChannelRequest) GetPushSat() int64 {
+func (m *GetRecoveryInfoResponse) GetProgress() float64 {
 	if m != nil {
-		return m.PushSat
+		return m.Progress
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetTargetConf() int32 {
-	if m != nil {
-		return m.TargetConf
-	}
-	return 0
+type GetInfoRequest struct {
 }
 
-func (m *OpenChannelRequest) GetSatPerByte() int64 {
+func (m *GetInfoRequest) Reset()                    { *m = GetInfoRequest{} }
+func (m *GetInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetInfoRequest) ProtoMessage()               {}
+func (*GetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+type GetInfoResponse struct {
+	// / The identity pubkey of the current node.
+	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey" json:"identity_pubkey,omitempty"`
+	// / If applicable, the alias of the current node, e.g. "bob"
+	Alias string `protobuf:"bytes,2,opt,name=alias" json:"alias,omitempty"`
+	// / Number of pending channels
+	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels" json:"num_pending_channels,omitempty"`
+	// / Number of active channels
+	NumActiveChannels uint32 `protobuf:"varint,4,opt,name=num_active_channels" json:"num_active_channels,omitempty"`
+	// / Number of peers
+	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers" json:"num_peers,omitempty"`
+	// / The node's current view of the height of the best block
+	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height" json:"block_height,omitempty"`
+	// / The node's current view of the hash of the best block
+	BlockHash string `protobuf:"bytes,8,opt,name=block_hash" json:"block_hash,omitempty"`
+	// / Whether the wallet's view is synced to the main chain
+	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain" json:"synced_to_chain,omitempty"`
+	// / Whether the current node is connected to testnet
+	Testnet bool `protobuf:"varint,10,opt,name=testnet" json:"testnet,omitempty"`
+	// / A list of active chains the node is connected to
+	Chains []string `protobuf:"bytes,11,rep,name=chains" json:"chains,omitempty"`
+	// / The URIs of the current node.
+	Uris []string `protobuf:"bytes,12,rep,name=uris" json:"uris,omitempty"`
+	// / Timestamp of the block best known to the wallet
+	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp" json:"best_header_timestamp,omitempty"`
+	// / The version of the LND software that the node is running.
+	Version string `protobuf:"bytes,14,opt,name=version" json:"version,omitempty"`
+	// / Number of inactive channels
+	NumInactiveChannels uint32 `protobuf:"varint,15,opt,name=num_inactive_channels" json:"num_inactive_channels,omitempty"`
+	// / Whether we've completed the initial sync of the channel graph with one of our peers
+	SyncedToGraph bool `protobuf:"varint,16,opt,name=synced_to_graph" json:"synced_to_graph,omitempty"`
+	// / The feature bits the current node advertises to its peers
+	Features []uint32 `protobuf:"varint,17,rep,packed,name=features" json:"features,omitempty"`
+	// / The network the node is connected to, e.g. "mainnet" or "testnet"
+	Network string `protobuf:"bytes,18,opt,name=network" json:"network,omitempty"`
+}
+
+func (m *GetInfoResponse) Reset()                    { *m = GetInfoResponse{} }
+func (m *GetInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetInfoResponse) ProtoMessage()               {}
+func (*GetInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *GetInfoResponse) GetIdentityPubkey() string {
 	if m != nil {
-		return m.SatPerByte
+		return m.IdentityPubkey
 	}
-	return 0
+	return ""
 }
 
-func (m *OpenChannelRequest) GetPrivate() bool {
+func (m *GetInfoResponse) GetAlias() string {
 	if m != nil {
-		return m.Private
+		return m.Alias
 	}
-	return false
+	return ""
 }
 
-func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
+func (m *GetInfoResponse) GetNumPendingChannels() uint32 {
 	if m != nil {
-		return m.MinHtlcMsat
+		return m.NumPendingChannels
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
+func (m *GetInfoResponse) GetNumActiveChannels() uint32 {
 	if m != nil {
-		return m.RemoteCsvDelay
+		return m.NumActiveChannels
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetMinConfs() int32 {
+func (m *GetInfoResponse) GetNumPeers() uint32 {
 	if m != nil {
-		return m.MinConfs
+		return m.NumPeers
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
+func (m *GetInfoResponse) GetBlockHeight() uint32 {
 	if m != nil {
-		return m.SpendUnconfirmed
+		return m.BlockHeight
 	}
-	return false
-}
-
-type OpenStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
-	//	*OpenStatusUpdate_ChanPending
-	//	*OpenStatusUpdate_Confirmation
-	//	*OpenStatusUpdate_ChanOpen
-	Update isOpenStatusUpdate_Update `protobuf_oneof:"update"`
-}
-
-func (m *OpenStatusUpdate) Reset()                    { *m = OpenStatusUpdate{} }
-func (m *OpenStatusUpdate) String() string            { return proto.CompactTextString(m) }
-func (*OpenStatusUpdate) ProtoMessage()               {}
-func (*OpenStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
-
-type isOpenStatusUpdate_Update interface{ isOpenStatusUpdate_Update() }
-
-type OpenStatusUpdate_ChanPending struct {
-	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,oneof"`
-}
-type OpenStatusUpdate_Confirmation struct {
-	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,oneof"`
-}
-type OpenStatusUpdate_ChanOpen struct {
-	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,oneof"`
+	return 0
 }
 
-func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update()  {}
-func (*OpenStatusUpdate_Confirmation) isOpenStatusUpdate_Update() {}
-func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update()     {}
-
-func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
+func (m *GetInfoResponse) GetBlockHash() string {
 	if m != nil {
-		return m.Update
+		return m.BlockHash
 	}
-	return nil
+	return ""
 }
 
-func (m *OpenStatusUpdate) GetChanPending() *PendingUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanPending); ok {
-		return x.ChanPending
+func (m *GetInfoResponse) GetSyncedToChain() bool {
+	if m != nil {
+		return m.SyncedToChain
 	}
-	return nil
+	return false
 }
 
-func (m *OpenStatusUpdate) GetConfirmation() *ConfirmationUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_Confirmation); ok {
-		return x.Confirmation
+func (m *GetInfoResponse) GetTestnet() bool {
+	if m != nil {
+		return m.Testnet
 	}
-	return nil
+	return false
 }
 
-func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanOpen); ok {
-		return x.ChanOpen
+func (m *GetInfoResponse) GetChains() []string {
+	if m != nil {
+		return m.Chains
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*OpenStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _OpenStatusUpdate_OneofMarshaler, _OpenStatusUpdate_OneofUnmarshaler, _OpenStatusUpdate_OneofSizer, []interface{}{
-		(*OpenStatusUpdate_ChanPending)(nil),
-		(*OpenStatusUpdate_Confirmation)(nil),
-		(*OpenStatusUpdate_ChanOpen)(nil),
-	}
-}
-
-func _OpenStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*OpenStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *OpenStatusUpdate_ChanPending:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanPending); err != nil {
-			return err
-		}
-	case *OpenStatusUpdate_Confirmation:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Confirmation); err != nil {
-			return err
-		}
-	case *OpenStatusUpdate_ChanOpen:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanOpen); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("OpenStatusUpdate.Update has unexpected type %T", x)
+func (m *GetInfoResponse) GetUris() []string {
+	if m != nil {
+		return m.Uris
 	}
 	return nil
 }
 
-func _OpenStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*OpenStatusUpdate)
-	switch tag {
-	case 1: // update.chan_pending
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(PendingUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_ChanPending{msg}
-		return true, err
-	case 2: // update.confirmation
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ConfirmationUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_Confirmation{msg}
-		return true, err
-	case 3: // update.chan_open
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelOpenUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_ChanOpen{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *GetInfoResponse) GetBestHeaderTimestamp() int64 {
+	if m != nil {
+		return m.BestHeaderTimestamp
 	}
+	return 0
 }
 
-func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*OpenStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *OpenStatusUpdate_ChanPending:
-		s := proto.Size(x.ChanPending)
-		n += proto.SizeVarint(1<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *OpenStatusUpdate_Confirmation:
-		s := proto.Size(x.Confirmation)
-		n += proto.SizeVarint(2<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *OpenStatusUpdate_ChanOpen:
-		s := proto.Size(x.ChanOpen)
-		n += proto.SizeVarint(3<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *GetInfoResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return n
+	return ""
 }
 
-type PendingHTLC struct {
-	// / The direction within the channel that the htlc was sent
-	Incoming bool `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
-	// / The total value of the htlc
-	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
-	// / The final output to be swept back to the user's wallet
-	Outpoint string `protobuf:"bytes,3,opt,name=outpoint" json:"outpoint,omitempty"`
-	// / The next block height at which we can spend the current stage
-	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height" json:"maturity_height,omitempty"`
-	// *
-	// The number of blocks remaining until the current stage can be swept.
-	// Negative values indicate how many blocks have passed since becoming
-	// mature.
-	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity" json:"blocks_til_maturity,omitempty"`
-	// / Indicates whether the htlc is in its first or second stage of recovery
-	Stage uint32 `protobuf:"varint,6,opt,name=stage" json:"stage,omitempty"`
+func (m *GetInfoResponse) GetNumInactiveChannels() uint32 {
+	if m != nil {
+		return m.NumInactiveChannels
+	}
+	return 0
 }
 
-func (m *PendingHTLC) Reset()                    { *m = PendingHTLC{} }
-func (m *PendingHTLC) String() string            { return proto.CompactTextString(m) }
-func (*PendingHTLC) ProtoMessage()               {}
-func (*PendingHTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
-
-func (m *PendingHTLC) GetIncoming() bool {
+func (m *GetInfoResponse) GetSyncedToGraph() bool {
 	if m != nil {
-		return m.Incoming
+		return m.SyncedToGraph
 	}
 	return false
 }
 
-func (m *PendingHTLC) GetAmount() int64 {
+func (m *GetInfoResponse) GetFeatures() []uint32 {
 	if m != nil {
-		return m.Amount
+		return m.Features
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingHTLC) GetOutpoint() string {
+func (m *GetInfoResponse) GetNetwork() string {
 	if m != nil {
-		return m.Outpoint
+		return m.Network
 	}
 	return ""
 }
 
-func (m *PendingHTLC) GetMaturityHeight() uint32 {
+type ConfirmationUpdate struct {
+	BlockSha     []byte `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
+	BlockHeight  int32  `protobuf:"varint,2,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
+	NumConfsLeft uint32 `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft" json:"num_confs_left,omitempty"`
+}
+
+func (m *ConfirmationUpdate) Reset()                    { *m = ConfirmationUpdate{} }
+func (m *ConfirmationUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ConfirmationUpdate) ProtoMessage()               {}
+func (*ConfirmationUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *ConfirmationUpdate) GetBlockSha() []byte {
 	if m != nil {
-		return m.MaturityHeight
+		return m.BlockSha
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
+func (m *ConfirmationUpdate) GetBlockHeight() int32 {
 	if m != nil {
-		return m.BlocksTilMaturity
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetStage() uint32 {
+func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
 	if m != nil {
-		return m.Stage
+		return m.NumConfsLeft
 	}
 	return 0
 }
 
-type PendingChannelsRequest struct {
-}
-
-func (m *PendingChannelsRequest) Reset()                    { *m = PendingChannelsRequest{} }
-func (m *PendingChannelsRequest) String() string            { return proto.CompactTextString(m) }
-func (*PendingChannelsRequest) ProtoMessage()               {}
-func (*PendingChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
-
-type PendingChannelsResponse struct {
-	// / The balance in satoshis encumbered in pending channels
-	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance" json:"total_limbo_balance,omitempty"`
-	// / Channels pending opening
-	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels" json:"pending_open_channels,omitempty"`
-	// / Channels pending closing
-	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels" json:"pending_closing_channels,omitempty"`
-	// / Channels pending force closing
-	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels" json:"pending_force_closing_channels,omitempty"`
-	// / Channels waiting for closing tx to confirm
-	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels" json:"waiting_close_channels,omitempty"`
+type ChannelOpenUpdate struct {
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
 }
 
-func (m *PendingChannelsResponse) Reset()                    { *m = PendingChannelsResponse{} }
-func (m *PendingChannelsResponse) String() string            { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse) ProtoMessage()               {}
-func (*PendingChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
-
-func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
-	if m != nil {
-		return m.TotalLimboBalance
-	}
-	return 0
-}
+func (m *ChannelOpenUpdate) Reset()                    { *m = ChannelOpenUpdate{} }
+func (m *ChannelOpenUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ChannelOpenUpdate) ProtoMessage()               {}
+func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
-func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
+func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.PendingOpenChannels
+		return m.ChannelPoint
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
-	if m != nil {
-		return m.PendingClosingChannels
-	}
-	return nil
+type ChannelCloseUpdate struct {
+	ClosingTxid []byte `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	Success     bool   `protobuf:"varint,2,opt,name=success" json:"success,omitempty"`
 }
 
-func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
+func (m *ChannelCloseUpdate) Reset()                    { *m = ChannelCloseUpdate{} }
+func (m *ChannelCloseUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ChannelCloseUpdate) ProtoMessage()               {}
+func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
 	if m != nil {
-		return m.PendingForceClosingChannels
+		return m.ClosingTxid
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
+func (m *ChannelCloseUpdate) GetSuccess() bool {
 	if m != nil {
-		return m.WaitingCloseChannels
+		return m.Success
 	}
-	return nil
-}
-
-type PendingChannelsResponse_PendingChannel struct {
-	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub" json:"remote_node_pub,omitempty"`
-	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point" json:"channel_point,omitempty"`
-	Capacity      int64  `protobuf:"varint,3,opt,name=capacity" json:"capacity,omitempty"`
-	LocalBalance  int64  `protobuf:"varint,4,opt,name=local_balance" json:"local_balance,omitempty"`
-	RemoteBalance int64  `protobuf:"varint,5,opt,name=remote_balance" json:"remote_balance,omitempty"`
+	return false
 }
 
-func (m *PendingChannelsResponse_PendingChannel) Reset() {
-	*m = PendingChannelsResponse_PendingChannel{}
-}
-func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 0}
+type CloseChannelRequest struct {
+	// *
+	// The outpoint (txid:index) of the funding transaction. With this value, Bob
+	// will be able to generate a signature for Alice's version of the commitment
+	// transaction.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
+	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
+	Force bool `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
+	// / The target number of blocks that the closure transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / Deprecated, use sat_per_vbyte. A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
+	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / An optional address to send our funds to in case of a cooperative close. If not set, a fresh address of the wallet is used.
+	DeliveryAddress string `protobuf:"bytes,5,opt,name=delivery_address" json:"delivery_address,omitempty"`
+	// / A manual fee rate set in sat/vbyte that should be used when crafting the closure transaction.
+	SatPerVbyte uint64 `protobuf:"varint,6,opt,name=sat_per_vbyte" json:"sat_per_vbyte,omitempty"`
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
-	if m != nil {
-		return m.RemoteNodePub
-	}
-	return ""
-}
+func (m *CloseChannelRequest) Reset()                    { *m = CloseChannelRequest{} }
+func (m *CloseChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*CloseChannelRequest) ProtoMessage()               {}
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
 
-func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
+func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
 		return m.ChannelPoint
 	}
-	return ""
-}
-
-func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
-	if m != nil {
-		return m.Capacity
-	}
-	return 0
+	return nil
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
+func (m *CloseChannelRequest) GetForce() bool {
 	if m != nil {
-		return m.LocalBalance
+		return m.Force
 	}
-	return 0
+	return false
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
+func (m *CloseChannelRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.RemoteBalance
+		return m.TargetConf
 	}
 	return 0
 }
 
-type PendingChannelsResponse_PendingOpenChannel struct {
-	// / The pending channel
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
-	// / The height at which this channel will be confirmed
-	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height" json:"confirmation_height,omitempty"`
-	// *
-	// The amount calculated to be paid in fees for the current set of
-	// commitment transactions. The fee amount is persisted with the channel
-	// in order to allow the fee amount to be removed and recalculated with
-	// each channel state update, including updates that happen after a system
-	// restart.
-	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee" json:"commit_fee,omitempty"`
-	// / The weight of the commitment transaction
-	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight" json:"commit_weight,omitempty"`
-	// *
-	// The required number of satoshis per kilo-weight that the requester will
-	// pay at all times, for both the funding transaction and commitment
-	// transaction. This value can later be updated once the channel is open.
-	FeePerKw int64 `protobuf:"varint,6,opt,name=fee_per_kw" json:"fee_per_kw,omitempty"`
-}
-
-func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
-	*m = PendingChannelsResponse_PendingOpenChannel{}
-}
-func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 1}
-}
-
-func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
-	if m != nil {
-		return m.Channel
-	}
-	return nil
-}
-
-func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
+func (m *CloseChannelRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.ConfirmationHeight
+		return m.SatPerByte
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
+func (m *CloseChannelRequest) GetDeliveryAddress() string {
 	if m != nil {
-		return m.CommitFee
+		return m.DeliveryAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
+func (m *CloseChannelRequest) GetSatPerVbyte() uint64 {
 	if m != nil {
-		return m.CommitWeight
+		return m.SatPerVbyte
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
-	if m != nil {
-		return m.FeePerKw
-	}
-	return 0
+type CloseStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
+	//	*CloseStatusUpdate_ClosePending
+	//	*CloseStatusUpdate_Confirmation
+	//	*CloseStatusUpdate_ChanClose
+	Update isCloseStatusUpdate_Update `protobuf_oneof:"update"`
 }
 
-type PendingChannelsResponse_WaitingCloseChannel struct {
-	// / The pending channel waiting for closing tx to confirm
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
-	// / The balance in satoshis encumbered in this channel
-	LimboBalance int64 `protobuf:"varint,2,opt,name=limbo_balance" json:"limbo_balance,omitempty"`
-}
+func (m *CloseStatusUpdate) Reset()                    { *m = CloseStatusUpdate{} }
+func (m *CloseStatusUpdate) String() string            { return proto.CompactTextString(m) }
+func (*CloseStatusUpdate) ProtoMessage()               {}
+func (*CloseStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
-	*m = PendingChannelsResponse_WaitingCloseChannel{}
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 2}
-}
+type isCloseStatusUpdate_Update interface{ isCloseStatusUpdate_Update() }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
-	if m != nil {
-		return m.Channel
-	}
-	return nil
+type CloseStatusUpdate_ClosePending struct {
+	ClosePending *PendingUpdate `protobuf:"bytes,1,opt,name=close_pending,oneof"`
 }
-
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
-	if m != nil {
-		return m.LimboBalance
-	}
-	return 0
+type CloseStatusUpdate_Confirmation struct {
+	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,oneof"`
 }
-
-type PendingChannelsResponse_ClosedChannel struct {
-	// / The pending channel to be closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
-	// / The transaction id of the closing transaction
-	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid" json:"closing_txid,omitempty"`
+type CloseStatusUpdate_ChanClose struct {
+	ChanClose *ChannelCloseUpdate `protobuf:"bytes,3,opt,name=chan_close,oneof"`
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
-func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 3}
-}
+func (*CloseStatusUpdate_ClosePending) isCloseStatusUpdate_Update() {}
+func (*CloseStatusUpdate_Confirmation) isCloseStatusUpdate_Update() {}
+func (*CloseStatusUpdate_ChanClose) isCloseStatusUpdate_Update()    {}
 
-func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *CloseStatusUpdate) GetUpdate() isCloseStatusUpdate_Update {
 	if m != nil {
-		return m.Channel
+		return m.Update
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
-	if m != nil {
-		return m.ClosingTxid
+func (m *CloseStatusUpdate) GetClosePending() *PendingUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ClosePending); ok {
+		return x.ClosePending
 	}
-	return ""
-}
-
-type PendingChannelsResponse_ForceClosedChannel struct {
-	// / The pending channel to be force closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
-	// / The transaction id of the closing transaction
-	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid" json:"closing_txid,omitempty"`
-	// / The balance in satoshis encumbered in this pending channel
-	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance" json:"limbo_balance,omitempty"`
-	// / The height at which funds can be sweeped into the wallet
-	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height" json:"maturity_height,omitempty"`
-	//
-	// Remaining # of blocks until the commitment output can be swept.
-	// Negative values indicate how many blocks have passed since becoming
-	// mature.
-	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity" json:"blocks_til_maturity,omitempty"`
-	// / The total value of funds successfully recovered from this channel
-	RecoveredBalance int64          `protobuf:"varint,6,opt,name=recovered_balance" json:"recovered_balance,omitempty"`
-	PendingHtlcs     []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs" json:"pending_htlcs,omitempty"`
-}
-
-func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
-	*m = PendingChannelsResponse_ForceClosedChannel{}
-}
-func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{56, 4}
+	return nil
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
-	if m != nil {
-		return m.Channel
+func (m *CloseStatusUpdate) GetConfirmation() *ConfirmationUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_Confirmation); ok {
+		return x.Confirmation
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
-	if m != nil {
-		return m.ClosingTxid
+func (m *CloseStatusUpdate) GetChanClose() *ChannelCloseUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ChanClose); ok {
+		return x.ChanClose
 	}
-	return ""
+	return nil
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
-	if m != nil {
-		return m.LimboBalance
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*CloseStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _CloseStatusUpdate_OneofMarshaler, _CloseStatusUpdate_OneofUnmarshaler, _CloseStatusUpdate_OneofSizer, []interface{}{
+		(*CloseStatusUpdate_ClosePending)(nil),
+		(*CloseStatusUpdate_Confirmation)(nil),
+		(*CloseStatusUpdate_ChanClose)(nil),
 	}
-	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
-	if m != nil {
-		return m.MaturityHeight
-	}
-	return 0
-}
+func _CloseStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*CloseStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *CloseStatusUpdate_ClosePending:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ClosePending); err != nil {
+			return err
+		}
+	case *CloseStatusUpdate_Confirmation:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Confirmation); err != nil {
+			return err
+		}
+	case *CloseStatusUpdate_ChanClose:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanClose); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("CloseStatusUpdate.Update has unexpected type %T", x)
+	}
+	return nil
+}
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
-	if m != nil {
-		return m.BlocksTilMaturity
+func _CloseStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*CloseStatusUpdate)
+	switch tag {
+	case 1: // update.close_pending
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PendingUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &CloseStatusUpdate_ClosePending{msg}
+		return true, err
+	case 2: // update.confirmation
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfirmationUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &CloseStatusUpdate_Confirmation{msg}
+		return true, err
+	case 3: // update.chan_close
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelCloseUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &CloseStatusUpdate_ChanClose{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
-	if m != nil {
-		return m.RecoveredBalance
+func _CloseStatusUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*CloseStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *CloseStatusUpdate_ClosePending:
+		s := proto.Size(x.ClosePending)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CloseStatusUpdate_Confirmation:
+		s := proto.Size(x.Confirmation)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CloseStatusUpdate_ChanClose:
+		s := proto.Size(x.ChanClose)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
-	return 0
+	return n
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
+type PendingUpdate struct {
+	Txid        []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index" json:"output_index,omitempty"`
+}
+
+func (m *PendingUpdate) Reset()                    { *m = PendingUpdate{} }
+func (m *PendingUpdate) String() string            { return proto.CompactTextString(m) }
+func (*PendingUpdate) ProtoMessage()               {}
+func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *PendingUpdate) GetTxid() []byte {
 	if m != nil {
-		return m.PendingHtlcs
+		return m.Txid
 	}
 	return nil
 }
 
-type WalletBalanceRequest struct {
+func (m *PendingUpdate) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
 }
 
-func (m *WalletBalanceRequest) Reset()                    { *m = WalletBalanceRequest{} }
-func (m *WalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
-func (*WalletBalanceRequest) ProtoMessage()               {}
-func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
-
-type WalletBalanceResponse struct {
-	// / The balance of the wallet
-	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance" json:"total_balance,omitempty"`
-	// / The confirmed balance of a wallet(with >= 1 confirmations)
-	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance" json:"confirmed_balance,omitempty"`
-	// / The unconfirmed balance of a wallet(with 0 confirmations)
-	UnconfirmedBalance int64 `protobuf:"varint,3,opt,name=unconfirmed_balance" json:"unconfirmed_balance,omitempty"`
+type OpenChannelRequest struct {
+	// / The pubkey of the node to open a channel with
+	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The hex encoded pubkey of the node to open a channel with
+	NodePubkeyString string `protobuf:"bytes,3,opt,name=node_pubkey_string" json:"node_pubkey_string,omitempty"`
+	// / The number of satoshis the wallet should commit to the channel
+	LocalFundingAmount int64 `protobuf:"varint,4,opt,name=local_funding_amount" json:"local_funding_amount,omitempty"`
+	// / The number of satoshis to push to the remote side as part of the initial commitment state
+	PushSat int64 `protobuf:"varint,5,opt,name=push_sat" json:"push_sat,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,6,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte int64 `protobuf:"varint,7,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,8,opt,name=private" json:"private,omitempty"`
+	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
+	MinHtlcMsat int64 `protobuf:"varint,9,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
+	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay" json:"remote_csv_delay,omitempty"`
+	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs" json:"min_confs,omitempty"`
+	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
+	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed" json:"spend_unconfirmed,omitempty"`
+	// *
+	// An optional PSBT funding shim. If set, the funding transaction isn't
+	// created by the internal wallet. Instead, the funding output is handed to
+	// the caller through a psbt_fund update once the remote party accepted the
+	// channel, and the funding flow is continued with FundingStateStep.
+	PsbtShim *PsbtShim `protobuf:"bytes,13,opt,name=psbt_shim" json:"psbt_shim,omitempty"`
 }
 
-func (m *WalletBalanceResponse) Reset()                    { *m = WalletBalanceResponse{} }
-func (m *WalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
-func (*WalletBalanceResponse) ProtoMessage()               {}
-func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (m *OpenChannelRequest) Reset()                    { *m = OpenChannelRequest{} }
+func (m *OpenChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*OpenChannelRequest) ProtoMessage()               {}
+func (*OpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
-func (m *WalletBalanceResponse) GetTotalBalance() int64 {
+func (m *OpenChannelRequest) GetNodePubkey() []byte {
 	if m != nil {
-		return m.TotalBalance
+		return m.NodePubkey
 	}
-	return 0
+	return nil
 }
 
-func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
+func (m *OpenChannelRequest) GetNodePubkeyString() string {
 	if m != nil {
-		return m.ConfirmedBalance
+		return m.NodePubkeyString
 	}
-	return 0
+	return ""
 }
 
-func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
+func (m *OpenChannelRequest) GetLocalFundingAmount() int64 {
 	if m != nil {
-		return m.UnconfirmedBalance
+		return m.LocalFundingAmount
 	}
 	return 0
 }
 
-type ChannelBalanceRequest struct {
-}
-
-func (m *ChannelBalanceRequest) Reset()                    { *m = ChannelBalanceRequest{} }
-func (m *ChannelBalanceRequest) String() string            { return proto.CompactTextString(m) }
-func (*ChannelBalanceRequest) ProtoMessage()               {}
-func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
-
-type ChannelBalanceResponse struct {
-	// / Sum of channels balances denominated in satoshis
-	Balance int64 `protobuf:"varint,1,opt,name=balance" json:"balance,omitempty"`
-	// / Sum of channels pending balances denominated in satoshis
-	PendingOpenBalance int64 `protobuf:"varint,2,opt,name=pending_open_balance" json:"pending_open_balance,omitempty"`
+func (m *OpenChannelRequest) GetPushSat() int64 {
+	if m != nil {
+		return m.PushSat
+	}
+	return 0
 }
 
-func (m *ChannelBalanceResponse) Reset()                    { *m = ChannelBalanceResponse{} }
-func (m *ChannelBalanceResponse) String() string            { return proto.CompactTextString(m) }
-func (*ChannelBalanceResponse) ProtoMessage()               {}
-func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
-
-func (m *ChannelBalanceResponse) GetBalance() int64 {
+func (m *OpenChannelRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.Balance
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
+func (m *OpenChannelRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.PendingOpenBalance
+		return m.SatPerByte
 	}
 	return 0
 }
 
-type QueryRoutesRequest struct {
-	// / The 33-byte hex-encoded public key for the payment destination
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey" json:"pub_key,omitempty"`
-	// / The amount to send expressed in satoshis
-	Amt int64 `protobuf:"varint,2,opt,name=amt" json:"amt,omitempty"`
-	// / The max number of routes to return.
-	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes" json:"num_routes,omitempty"`
-	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
-	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta" json:"final_cltv_delta,omitempty"`
-	// *
-	// The maximum number of satoshis that will be paid as a fee of the payment.
-	// This value can be represented either as a percentage of the amount being
-	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
-	// send the payment.
-	FeeLimit *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit" json:"fee_limit,omitempty"`
+func (m *OpenChannelRequest) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
 }
 
-func (m *QueryRoutesRequest) Reset()                    { *m = QueryRoutesRequest{} }
-func (m *QueryRoutesRequest) String() string            { return proto.CompactTextString(m) }
-func (*QueryRoutesRequest) ProtoMessage()               {}
-func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
-
-func (m *QueryRoutesRequest) GetPubKey() string {
+func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
 	if m != nil {
-		return m.PubKey
+		return m.MinHtlcMsat
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryRoutesRequest) GetAmt() int64 {
+func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
 	if m != nil {
-		return m.Amt
+		return m.RemoteCsvDelay
 	}
 	return 0
 }
 
-func (m *QueryRoutesRequest) GetNumRoutes() int32 {
+func (m *OpenChannelRequest) GetMinConfs() int32 {
 	if m != nil {
-		return m.NumRoutes
+		return m.MinConfs
 	}
 	return 0
 }
 
-func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
+func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
 	if m != nil {
-		return m.FinalCltvDelta
+		return m.SpendUnconfirmed
 	}
-	return 0
+	return false
 }
 
-func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
+func (m *OpenChannelRequest) GetPsbtShim() *PsbtShim {
 	if m != nil {
-		return m.FeeLimit
+		return m.PsbtShim
 	}
 	return nil
 }
 
-type QueryRoutesResponse struct {
-	Routes []*Route `protobuf:"bytes,1,rep,name=routes" json:"routes,omitempty"`
+type PsbtShim struct {
+	// / A unique 32 byte identifier of the pending channel, chosen by the caller. It is needed to continue the funding flow with FundingStateStep.
+	PendingChanId []byte `protobuf:"bytes,1,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// / An optional base PSBT the funding output is added to, for example to batch the funding transaction with other transactions.
+	BasePsbt []byte `protobuf:"bytes,2,opt,name=base_psbt,proto3" json:"base_psbt,omitempty"`
+	// / If set, lnd won't broadcast the final funding transaction, which is then left to the caller.
+	NoPublish bool `protobuf:"varint,3,opt,name=no_publish" json:"no_publish,omitempty"`
 }
 
-func (m *QueryRoutesResponse) Reset()                    { *m = QueryRoutesResponse{} }
-func (m *QueryRoutesResponse) String() string            { return proto.CompactTextString(m) }
-func (*QueryRoutesResponse) ProtoMessage()               {}
-func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (m *PsbtShim) Reset()                    { *m = PsbtShim{} }
+func (m *PsbtShim) String() string            { return proto.CompactTextString(m) }
+func (*PsbtShim) ProtoMessage()               {}
+func (*PsbtShim) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
 
-func (m *QueryRoutesResponse) GetRoutes() []*Route {
+func (m *PsbtShim) GetPendingChanId() []byte {
 	if m != nil {
-		return m.Routes
+		return m.PendingChanId
 	}
 	return nil
 }
 
-type Hop struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
-	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity" json:"chan_capacity,omitempty"`
-	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward" json:"amt_to_forward,omitempty"`
-	Fee              int64  `protobuf:"varint,4,opt,name=fee" json:"fee,omitempty"`
-	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
-	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat" json:"amt_to_forward_msat,omitempty"`
-	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat" json:"fee_msat,omitempty"`
-	// *
-	// An optional public key of the hop. If the public key is given, the payment
-	// can be executed without relying on a copy of the channel graph.
-	PubKey string `protobuf:"bytes,8,opt,name=pub_key" json:"pub_key,omitempty"`
-}
-
-func (m *Hop) Reset()                    { *m = Hop{} }
-func (m *Hop) String() string            { return proto.CompactTextString(m) }
-func (*Hop) ProtoMessage()               {}
-func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
-
-func (m *Hop) GetChanId() uint64 {
+func (m *PsbtShim) GetBasePsbt() []byte {
 	if m != nil {
-		return m.ChanId
+		return m.BasePsbt
 	}
-	return 0
+	return nil
 }
 
-func (m *Hop) GetChanCapacity() int64 {
+func (m *PsbtShim) GetNoPublish() bool {
 	if m != nil {
-		return m.ChanCapacity
+		return m.NoPublish
 	}
-	return 0
+	return false
 }
 
-func (m *Hop) GetAmtToForward() int64 {
-	if m != nil {
-		return m.AmtToForward
-	}
-	return 0
+type BatchOpenChannelRequest struct {
+	// / The list of channels to open.
+	Channels []*BatchOpenChannel `protobuf:"bytes,1,rep,name=channels" json:"channels,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte int64 `protobuf:"varint,3,opt,name=sat_per_byte" json:"sat_per_byte,omitempty"`
+	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,4,opt,name=min_confs" json:"min_confs,omitempty"`
+	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
+	SpendUnconfirmed bool `protobuf:"varint,5,opt,name=spend_unconfirmed" json:"spend_unconfirmed,omitempty"`
 }
 
-func (m *Hop) GetFee() int64 {
+func (m *BatchOpenChannelRequest) Reset()                    { *m = BatchOpenChannelRequest{} }
+func (m *BatchOpenChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*BatchOpenChannelRequest) ProtoMessage()               {}
+func (*BatchOpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *BatchOpenChannelRequest) GetChannels() []*BatchOpenChannel {
 	if m != nil {
-		return m.Fee
+		return m.Channels
 	}
-	return 0
+	return nil
 }
 
-func (m *Hop) GetExpiry() uint32 {
+func (m *BatchOpenChannelRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.Expiry
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *Hop) GetAmtToForwardMsat() int64 {
+func (m *BatchOpenChannelRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.AmtToForwardMsat
+		return m.SatPerByte
 	}
 	return 0
 }
 
-func (m *Hop) GetFeeMsat() int64 {
+func (m *BatchOpenChannelRequest) GetMinConfs() int32 {
 	if m != nil {
-		return m.FeeMsat
+		return m.MinConfs
 	}
 	return 0
 }
 
-func (m *Hop) GetPubKey() string {
+func (m *BatchOpenChannelRequest) GetSpendUnconfirmed() bool {
 	if m != nil {
-		return m.PubKey
+		return m.SpendUnconfirmed
 	}
-	return ""
+	return false
 }
 
-// *
-// A path through the channel graph which runs over one or more channels in
-// succession. This struct carries all the information required to craft the
-// Sphinx onion packet, and send the payment along the first hop in the path. A
-// route is only selected as valid if all the channels have sufficient capacity to
-// carry the initial payment amount after fees are accounted for.
-type Route struct {
-	// *
-	// The cumulative (final) time lock across the entire route.  This is the CLTV
-	// value that should be extended to the first hop in the route. All other hops
-	// will decrement the time-lock as advertised, leaving enough time for all
-	// hops to wait for or present the payment preimage to complete the payment.
-	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock" json:"total_time_lock,omitempty"`
-	// *
-	// The sum of the fees paid at each hop within the final route.  In the case
-	// of a one-hop payment, this value will be zero as we don't need to pay a fee
-	// it ourself.
-	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees" json:"total_fees,omitempty"`
-	// *
-	// The total amount of funds required to complete a payment over this route.
-	// This value includes the cumulative fees at each hop. As a result, the HTLC
-	// extended to the first-hop in the route will need to have at least this many
-	// satoshis, otherwise the route will fail at an intermediate node due to an
-	// insufficient amount of fees.
-	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt" json:"total_amt,omitempty"`
-	// *
-	// Contains details concerning the specific forwarding details at each hop.
-	Hops []*Hop `protobuf:"bytes,4,rep,name=hops" json:"hops,omitempty"`
-	// *
-	// The total fees in millisatoshis.
-	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat" json:"total_fees_msat,omitempty"`
-	// *
-	// The total amount in millisatoshis.
-	TotalAmtMsat int64 `protobuf:"varint,6,opt,name=total_amt_msat" json:"total_amt_msat,omitempty"`
+type BatchOpenChannel struct {
+	// / The pubkey of the node to open a channel with
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The number of satoshis the wallet should commit to the channel
+	LocalFundingAmount int64 `protobuf:"varint,2,opt,name=local_funding_amount" json:"local_funding_amount,omitempty"`
+	// / The number of satoshis to push to the remote side as part of the initial commitment state
+	PushSat int64 `protobuf:"varint,3,opt,name=push_sat" json:"push_sat,omitempty"`
+	// / Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,4,opt,name=private" json:"private,omitempty"`
+	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
+	MinHtlcMsat int64 `protobuf:"varint,5,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
+	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,6,opt,name=remote_csv_delay" json:"remote_csv_delay,omitempty"`
 }
 
-func (m *Route) Reset()                    { *m = Route{} }
-func (m *Route) String() string            { return proto.CompactTextString(m) }
-func (*Route) ProtoMessage()               {}
-func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+func (m *BatchOpenChannel) Reset()                    { *m = BatchOpenChannel{} }
+func (m *BatchOpenChannel) String() string            { return proto.CompactTextString(m) }
+func (*BatchOpenChannel) ProtoMessage()               {}
+func (*BatchOpenChannel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
-func (m *Route) GetTotalTimeLock() uint32 {
+func (m *BatchOpenChannel) GetNodePubkey() []byte {
 	if m != nil {
-		return m.TotalTimeLock
+		return m.NodePubkey
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetTotalFees() int64 {
+func (m *BatchOpenChannel) GetLocalFundingAmount() int64 {
 	if m != nil {
-		return m.TotalFees
+		return m.LocalFundingAmount
 	}
 	return 0
 }
 
-func (m *Route) GetTotalAmt() int64 {
+func (m *BatchOpenChannel) GetPushSat() int64 {
 	if m != nil {
-		return m.TotalAmt
+		return m.PushSat
 	}
 	return 0
 }
 
-func (m *Route) GetHops() []*Hop {
+func (m *BatchOpenChannel) GetPrivate() bool {
 	if m != nil {
-		return m.Hops
+		return m.Private
 	}
-	return nil
+	return false
 }
 
-func (m *Route) GetTotalFeesMsat() int64 {
+func (m *BatchOpenChannel) GetMinHtlcMsat() int64 {
 	if m != nil {
-		return m.TotalFeesMsat
+		return m.MinHtlcMsat
 	}
 	return 0
 }
 
-func (m *Route) GetTotalAmtMsat() int64 {
+func (m *BatchOpenChannel) GetRemoteCsvDelay() uint32 {
 	if m != nil {
-		return m.TotalAmtMsat
+		return m.RemoteCsvDelay
 	}
 	return 0
 }
 
-type NodeInfoRequest struct {
-	// / The 33-byte hex-encoded compressed public of the target node
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey" json:"pub_key,omitempty"`
+type BatchOpenChannelResponse struct {
+	// / The funding outpoints of the pending channels, which all share the same funding transaction.
+	PendingChannels []*PendingUpdate `protobuf:"bytes,1,rep,name=pending_channels" json:"pending_channels,omitempty"`
 }
 
-func (m *NodeInfoRequest) Reset()                    { *m = NodeInfoRequest{} }
-func (m *NodeInfoRequest) String() string            { return proto.CompactTextString(m) }
-func (*NodeInfoRequest) ProtoMessage()               {}
-func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+func (m *BatchOpenChannelResponse) Reset()                    { *m = BatchOpenChannelResponse{} }
+func (m *BatchOpenChannelResponse) String() string            { return proto.CompactTextString(m) }
+func (*BatchOpenChannelResponse) ProtoMessage()               {}
+func (*BatchOpenChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
-func (m *NodeInfoRequest) GetPubKey() string {
+func (m *BatchOpenChannelResponse) GetPendingChannels() []*PendingUpdate {
 	if m != nil {
-		return m.PubKey
+		return m.PendingChannels
 	}
-	return ""
+	return nil
 }
 
-type NodeInfo struct {
-	// *
-	// An individual vertex/node within the channel graph. A node is
-	// connected to other nodes by one or more channel edges emanating from it. As
-	// the graph is directed, a node will also have an incoming edge attached to
-	// it for each outgoing edge.
-	Node          *LightningNode `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
-	NumChannels   uint32         `protobuf:"varint,2,opt,name=num_channels" json:"num_channels,omitempty"`
-	TotalCapacity int64          `protobuf:"varint,3,opt,name=total_capacity" json:"total_capacity,omitempty"`
+type OpenStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
+	//	*OpenStatusUpdate_ChanPending
+	//	*OpenStatusUpdate_Confirmation
+	//	*OpenStatusUpdate_ChanOpen
+	//	*OpenStatusUpdate_PsbtFund
+	Update isOpenStatusUpdate_Update `protobuf_oneof:"update"`
+	// / The pending channel ID of a channel opened with a PSBT shim.
+	PendingChanId []byte `protobuf:"bytes,4,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
 }
 
-func (m *NodeInfo) Reset()                    { *m = NodeInfo{} }
-func (m *NodeInfo) String() string            { return proto.CompactTextString(m) }
-func (*NodeInfo) ProtoMessage()               {}
-func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+func (m *OpenStatusUpdate) Reset()                    { *m = OpenStatusUpdate{} }
+func (m *OpenStatusUpdate) String() string            { return proto.CompactTextString(m) }
+func (*OpenStatusUpdate) ProtoMessage()               {}
+func (*OpenStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
-func (m *NodeInfo) GetNode() *LightningNode {
-	if m != nil {
-		return m.Node
-	}
-	return nil
-}
+type isOpenStatusUpdate_Update interface{ isOpenStatusUpdate_Update() }
 
-func (m *NodeInfo) GetNumChannels() uint32 {
-	if m != nil {
-		return m.NumChannels
-	}
-	return 0
+type OpenStatusUpdate_ChanPending struct {
+	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,oneof"`
 }
-
-func (m *NodeInfo) GetTotalCapacity() int64 {
-	if m != nil {
-		return m.TotalCapacity
-	}
-	return 0
+type OpenStatusUpdate_Confirmation struct {
+	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,oneof"`
 }
-
-// *
-// An individual vertex/node within the channel graph. A node is
-// connected to other nodes by one or more channel edges emanating from it. As the
-// graph is directed, a node will also have an incoming edge attached to it for
-// each outgoing edge.
-type LightningNode struct {
-	LastUpdate uint32         `protobuf:"varint,1,opt,name=last_update" json:"last_update,omitempty"`
-	PubKey     string         `protobuf:"bytes,2,opt,name=pub_key" json:"pub_key,omitempty"`
-	Alias      string         `protobuf:"bytes,3,opt,name=alias" json:"alias,omitempty"`
-	Addresses  []*NodeAddress `protobuf:"bytes,4,rep,name=addresses" json:"addresses,omitempty"`
-	Color      string         `protobuf:"bytes,5,opt,name=color" json:"color,omitempty"`
+type OpenStatusUpdate_ChanOpen struct {
+	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,oneof"`
+}
+type OpenStatusUpdate_PsbtFund struct {
+	PsbtFund *ReadyForPsbtFunding `protobuf:"bytes,5,opt,name=psbt_fund,oneof"`
 }
 
-func (m *LightningNode) Reset()                    { *m = LightningNode{} }
-func (m *LightningNode) String() string            { return proto.CompactTextString(m) }
-func (*LightningNode) ProtoMessage()               {}
-func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update()  {}
+func (*OpenStatusUpdate_Confirmation) isOpenStatusUpdate_Update() {}
+func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update()     {}
+func (*OpenStatusUpdate_PsbtFund) isOpenStatusUpdate_Update()     {}
 
-func (m *LightningNode) GetLastUpdate() uint32 {
+func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
 	if m != nil {
-		return m.LastUpdate
+		return m.Update
 	}
-	return 0
+	return nil
 }
 
-func (m *LightningNode) GetPubKey() string {
-	if m != nil {
-		return m.PubKey
+func (m *OpenStatusUpdate) GetChanPending() *PendingUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanPending); ok {
+		return x.ChanPending
 	}
-	return ""
+	return nil
 }
 
-func (m *LightningNode) GetAlias() string {
-	if m != nil {
-		return m.Alias
+func (m *OpenStatusUpdate) GetConfirmation() *ConfirmationUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_Confirmation); ok {
+		return x.Confirmation
 	}
-	return ""
+	return nil
 }
 
-func (m *LightningNode) GetAddresses() []*NodeAddress {
-	if m != nil {
-		return m.Addresses
+func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanOpen); ok {
+		return x.ChanOpen
 	}
 	return nil
 }
 
-func (m *LightningNode) GetColor() string {
-	if m != nil {
-		return m.Color
+func (m *OpenStatusUpdate) GetPsbtFund() *ReadyForPsbtFunding {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_PsbtFund); ok {
+		return x.PsbtFund
 	}
-	return ""
-}
-
-type NodeAddress struct {
-	Network string `protobuf:"bytes,1,opt,name=network" json:"network,omitempty"`
-	Addr    string `protobuf:"bytes,2,opt,name=addr" json:"addr,omitempty"`
+	return nil
 }
 
-func (m *NodeAddress) Reset()                    { *m = NodeAddress{} }
-func (m *NodeAddress) String() string            { return proto.CompactTextString(m) }
-func (*NodeAddress) ProtoMessage()               {}
-func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
-
-func (m *NodeAddress) GetNetwork() string {
+func (m *OpenStatusUpdate) GetPendingChanId() []byte {
 	if m != nil {
-		return m.Network
+		return m.PendingChanId
 	}
-	return ""
+	return nil
 }
 
-func (m *NodeAddress) GetAddr() string {
-	if m != nil {
-		return m.Addr
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*OpenStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _OpenStatusUpdate_OneofMarshaler, _OpenStatusUpdate_OneofUnmarshaler, _OpenStatusUpdate_OneofSizer, []interface{}{
+		(*OpenStatusUpdate_ChanPending)(nil),
+		(*OpenStatusUpdate_Confirmation)(nil),
+		(*OpenStatusUpdate_ChanOpen)(nil),
+		(*OpenStatusUpdate_PsbtFund)(nil),
 	}
-	return ""
-}
-
-type RoutingPolicy struct {
-	TimeLockDelta    uint32 `protobuf:"varint,1,opt,name=time_lock_delta" json:"time_lock_delta,omitempty"`
-	MinHtlc          int64  `protobuf:"varint,2,opt,name=min_htlc" json:"min_htlc,omitempty"`
-	FeeBaseMsat      int64  `protobuf:"varint,3,opt,name=fee_base_msat" json:"fee_base_msat,omitempty"`
-	FeeRateMilliMsat int64  `protobuf:"varint,4,opt,name=fee_rate_milli_msat" json:"fee_rate_milli_msat,omitempty"`
-	Disabled         bool   `protobuf:"varint,5,opt,name=disabled" json:"disabled,omitempty"`
 }
 
-func (m *RoutingPolicy) Reset()                    { *m = RoutingPolicy{} }
-func (m *RoutingPolicy) String() string            { return proto.CompactTextString(m) }
-func (*RoutingPolicy) ProtoMessage()               {}
-func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
-
-func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
-	if m != nil {
-		return m.TimeLockDelta
+func _OpenStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*OpenStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *OpenStatusUpdate_ChanPending:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanPending); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_Confirmation:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Confirmation); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_ChanOpen:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanOpen); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_PsbtFund:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PsbtFund); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("OpenStatusUpdate.Update has unexpected type %T", x)
 	}
-	return 0
+	return nil
 }
 
-func (m *RoutingPolicy) GetMinHtlc() int64 {
-	if m != nil {
-		return m.MinHtlc
+func _OpenStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*OpenStatusUpdate)
+	switch tag {
+	case 1: // update.chan_pending
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PendingUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_ChanPending{msg}
+		return true, err
+	case 2: // update.confirmation
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfirmationUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_Confirmation{msg}
+		return true, err
+	case 3: // update.chan_open
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelOpenUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_ChanOpen{msg}
+		return true, err
+	case 5: // update.psbt_fund
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ReadyForPsbtFunding)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_PsbtFund{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	return 0
 }
 
-func (m *RoutingPolicy) GetFeeBaseMsat() int64 {
+func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*OpenStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *OpenStatusUpdate_ChanPending:
+		s := proto.Size(x.ChanPending)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_Confirmation:
+		s := proto.Size(x.Confirmation)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_ChanOpen:
+		s := proto.Size(x.ChanOpen)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_PsbtFund:
+		s := proto.Size(x.PsbtFund)
+		n += proto.SizeVarint(5<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ReadyForPsbtFunding struct {
+	// / The address of the funding output of the channel.
+	FundingAddress string `protobuf:"bytes,1,opt,name=funding_address" json:"funding_address,omitempty"`
+	// / The value in satoshis the funding output must have.
+	FundingAmount int64 `protobuf:"varint,2,opt,name=funding_amount" json:"funding_amount,omitempty"`
+	// / The PSBT paying to the funding output, which is based on the base PSBT of the shim if one was provided.
+	Psbt []byte `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (m *ReadyForPsbtFunding) Reset()                    { *m = ReadyForPsbtFunding{} }
+func (m *ReadyForPsbtFunding) String() string            { return proto.CompactTextString(m) }
+func (*ReadyForPsbtFunding) ProtoMessage()               {}
+func (*ReadyForPsbtFunding) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *ReadyForPsbtFunding) GetFundingAddress() string {
 	if m != nil {
-		return m.FeeBaseMsat
+		return m.FundingAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *RoutingPolicy) GetFeeRateMilliMsat() int64 {
+func (m *ReadyForPsbtFunding) GetFundingAmount() int64 {
 	if m != nil {
-		return m.FeeRateMilliMsat
+		return m.FundingAmount
 	}
 	return 0
 }
 
-func (m *RoutingPolicy) GetDisabled() bool {
+func (m *ReadyForPsbtFunding) GetPsbt() []byte {
 	if m != nil {
-		return m.Disabled
+		return m.Psbt
 	}
-	return false
+	return nil
 }
 
-// *
-// A fully authenticated channel along with all its unique attributes.
-// Once an authenticated channel announcement has been processed on the network,
-// then an instance of ChannelEdgeInfo encapsulating the channels attributes is
-// stored. The other portions relevant to routing policy of a channel are stored
-// within a ChannelEdgePolicy for each direction of the channel.
-type ChannelEdge struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChannelId   uint64         `protobuf:"varint,1,opt,name=channel_id" json:"channel_id,omitempty"`
-	ChanPoint   string         `protobuf:"bytes,2,opt,name=chan_point" json:"chan_point,omitempty"`
-	LastUpdate  uint32         `protobuf:"varint,3,opt,name=last_update" json:"last_update,omitempty"`
-	Node1Pub    string         `protobuf:"bytes,4,opt,name=node1_pub" json:"node1_pub,omitempty"`
-	Node2Pub    string         `protobuf:"bytes,5,opt,name=node2_pub" json:"node2_pub,omitempty"`
-	Capacity    int64          `protobuf:"varint,6,opt,name=capacity" json:"capacity,omitempty"`
-	Node1Policy *RoutingPolicy `protobuf:"bytes,7,opt,name=node1_policy" json:"node1_policy,omitempty"`
-	Node2Policy *RoutingPolicy `protobuf:"bytes,8,opt,name=node2_policy" json:"node2_policy,omitempty"`
+type FundingTransitionMsg struct {
+	// Types that are valid to be assigned to Trigger:
+	//	*FundingTransitionMsg_ShimCancel
+	//	*FundingTransitionMsg_PsbtVerify
+	//	*FundingTransitionMsg_PsbtFinalize
+	Trigger isFundingTransitionMsg_Trigger `protobuf_oneof:"trigger"`
 }
 
-func (m *ChannelEdge) Reset()                    { *m = ChannelEdge{} }
-func (m *ChannelEdge) String() string            { return proto.CompactTextString(m) }
-func (*ChannelEdge) ProtoMessage()               {}
-func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+func (m *FundingTransitionMsg) Reset()                    { *m = FundingTransitionMsg{} }
+func (m *FundingTransitionMsg) String() string            { return proto.CompactTextString(m) }
+func (*FundingTransitionMsg) ProtoMessage()               {}
+func (*FundingTransitionMsg) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
 
-func (m *ChannelEdge) GetChannelId() uint64 {
-	if m != nil {
-		return m.ChannelId
-	}
-	return 0
+type isFundingTransitionMsg_Trigger interface{ isFundingTransitionMsg_Trigger() }
+
+type FundingTransitionMsg_ShimCancel struct {
+	ShimCancel *FundingShimCancel `protobuf:"bytes,1,opt,name=shim_cancel,oneof"`
+}
+type FundingTransitionMsg_PsbtVerify struct {
+	PsbtVerify *FundingPsbtVerify `protobuf:"bytes,2,opt,name=psbt_verify,oneof"`
+}
+type FundingTransitionMsg_PsbtFinalize struct {
+	PsbtFinalize *FundingPsbtFinalize `protobuf:"bytes,3,opt,name=psbt_finalize,oneof"`
 }
 
-func (m *ChannelEdge) GetChanPoint() string {
+func (*FundingTransitionMsg_ShimCancel) isFundingTransitionMsg_Trigger()   {}
+func (*FundingTransitionMsg_PsbtVerify) isFundingTransitionMsg_Trigger()   {}
+func (*FundingTransitionMsg_PsbtFinalize) isFundingTransitionMsg_Trigger() {}
+
+func (m *FundingTransitionMsg) GetTrigger() isFundingTransitionMsg_Trigger {
 	if m != nil {
-		return m.ChanPoint
+		return m.Trigger
 	}
-	return ""
+	return nil
 }
 
-func (m *ChannelEdge) GetLastUpdate() uint32 {
-	if m != nil {
-		return m.LastUpdate
+func (m *FundingTransitionMsg) GetShimCancel() *FundingShimCancel {
+	if x, ok := m.GetTrigger().(*FundingTransitionMsg_ShimCancel); ok {
+		return x.ShimCancel
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelEdge) GetNode1Pub() string {
-	if m != nil {
-		return m.Node1Pub
+func (m *FundingTransitionMsg) GetPsbtVerify() *FundingPsbtVerify {
+	if x, ok := m.GetTrigger().(*FundingTransitionMsg_PsbtVerify); ok {
+		return x.PsbtVerify
 	}
-	return ""
+	return nil
 }
 
-func (m *ChannelEdge) GetNode2Pub() string {
-	if m != nil {
-		return m.Node2Pub
+func (m *FundingTransitionMsg) GetPsbtFinalize() *FundingPsbtFinalize {
+	if x, ok := m.GetTrigger().(*FundingTransitionMsg_PsbtFinalize); ok {
+		return x.PsbtFinalize
 	}
-	return ""
+	return nil
 }
 
-func (m *ChannelEdge) GetCapacity() int64 {
-	if m != nil {
-		return m.Capacity
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*FundingTransitionMsg) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _FundingTransitionMsg_OneofMarshaler, _FundingTransitionMsg_OneofUnmarshaler, _FundingTransitionMsg_OneofSizer, []interface{}{
+		(*FundingTransitionMsg_ShimCancel)(nil),
+		(*FundingTransitionMsg_PsbtVerify)(nil),
+		(*FundingTransitionMsg_PsbtFinalize)(nil),
 	}
-	return 0
 }
 
-func (m *ChannelEdge) GetNode1Policy() *RoutingPolicy {
-	if m != nil {
-		return m.Node1Policy
+func _FundingTransitionMsg_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*FundingTransitionMsg)
+	// trigger
+	switch x := m.Trigger.(type) {
+	case *FundingTransitionMsg_ShimCancel:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ShimCancel); err != nil {
+			return err
+		}
+	case *FundingTransitionMsg_PsbtVerify:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PsbtVerify); err != nil {
+			return err
+		}
+	case *FundingTransitionMsg_PsbtFinalize:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PsbtFinalize); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("FundingTransitionMsg.Trigger has unexpected type %T", x)
 	}
 	return nil
 }
 
-func (m *ChannelEdge) GetNode2Policy() *RoutingPolicy {
-	if m != nil {
-		return m.Node2Policy
+func _FundingTransitionMsg_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*FundingTransitionMsg)
+	switch tag {
+	case 1: // trigger.shim_cancel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(FundingShimCancel)
+		err := b.DecodeMessage(msg)
+		m.Trigger = &FundingTransitionMsg_ShimCancel{msg}
+		return true, err
+	case 2: // trigger.psbt_verify
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(FundingPsbtVerify)
+		err := b.DecodeMessage(msg)
+		m.Trigger = &FundingTransitionMsg_PsbtVerify{msg}
+		return true, err
+	case 3: // trigger.psbt_finalize
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(FundingPsbtFinalize)
+		err := b.DecodeMessage(msg)
+		m.Trigger = &FundingTransitionMsg_PsbtFinalize{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	return nil
 }
 
-type ChannelGraphRequest struct {
-	// *
-	// Whether unannounced channels are included in the response or not. If set,
-	// unannounced channels are included. Unannounced channels are both private
-	// channels, and public channels that are not yet announced to the network.
-	IncludeUnannounced bool `protobuf:"varint,1,opt,name=include_unannounced" json:"include_unannounced,omitempty"`
+func _FundingTransitionMsg_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*FundingTransitionMsg)
+	// trigger
+	switch x := m.Trigger.(type) {
+	case *FundingTransitionMsg_ShimCancel:
+		s := proto.Size(x.ShimCancel)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FundingTransitionMsg_PsbtVerify:
+		s := proto.Size(x.PsbtVerify)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FundingTransitionMsg_PsbtFinalize:
+		s := proto.Size(x.PsbtFinalize)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
 }
 
-func (m *ChannelGraphRequest) Reset()                    { *m = ChannelGraphRequest{} }
-func (m *ChannelGraphRequest) String() string            { return proto.CompactTextString(m) }
-func (*ChannelGraphRequest) ProtoMessage()               {}
-func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+type FundingShimCancel struct {
+	// / The pending channel ID of the channel to cancel.
+	PendingChanId []byte `protobuf:"bytes,1,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+}
 
-func (m *ChannelGraphRequest) GetIncludeUnannounced() bool {
+func (m *FundingShimCancel) Reset()                    { *m = FundingShimCancel{} }
+func (m *FundingShimCancel) String() string            { return proto.CompactTextString(m) }
+func (*FundingShimCancel) ProtoMessage()               {}
+func (*FundingShimCancel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *FundingShimCancel) GetPendingChanId() []byte {
 	if m != nil {
-		return m.IncludeUnannounced
+		return m.PendingChanId
 	}
-	return false
+	return nil
 }
 
-// / Returns a new instance of the directed channel graph.
-type ChannelGraph struct {
-	// / The list of `LightningNode`s in this channel graph
-	Nodes []*LightningNode `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
-	// / The list of `ChannelEdge`s in this channel graph
-	Edges []*ChannelEdge `protobuf:"bytes,2,rep,name=edges" json:"edges,omitempty"`
+type FundingPsbtVerify struct {
+	// / The funded but not yet signed PSBT. All of its inputs must spend segwit outputs, so that its txid can't change once it is signed.
+	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,proto3" json:"funded_psbt,omitempty"`
+	// / The pending channel ID of the channel the PSBT funds.
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
 }
 
-func (m *ChannelGraph) Reset()                    { *m = ChannelGraph{} }
-func (m *ChannelGraph) String() string            { return proto.CompactTextString(m) }
-func (*ChannelGraph) ProtoMessage()               {}
-func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (m *FundingPsbtVerify) Reset()                    { *m = FundingPsbtVerify{} }
+func (m *FundingPsbtVerify) String() string            { return proto.CompactTextString(m) }
+func (*FundingPsbtVerify) ProtoMessage()               {}
+func (*FundingPsbtVerify) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
-func (m *ChannelGraph) GetNodes() []*LightningNode {
+func (m *FundingPsbtVerify) GetFundedPsbt() []byte {
 	if m != nil {
-		return m.Nodes
+		return m.FundedPsbt
 	}
 	return nil
 }
 
-func (m *ChannelGraph) GetEdges() []*ChannelEdge {
+func (m *FundingPsbtVerify) GetPendingChanId() []byte {
 	if m != nil {
-		return m.Edges
+		return m.PendingChanId
 	}
 	return nil
 }
 
-type ChanInfoRequest struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
+type FundingPsbtFinalize struct {
+	// / The signed PSBT, all of whose inputs must be finalized. Either this or final_raw_tx must be set.
+	SignedPsbt []byte `protobuf:"bytes,1,opt,name=signed_psbt,proto3" json:"signed_psbt,omitempty"`
+	// / The pending channel ID of the channel the PSBT funds.
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// / The fully signed funding transaction in its raw serialization, as an alternative to signed_psbt.
+	FinalRawTx []byte `protobuf:"bytes,3,opt,name=final_raw_tx,proto3" json:"final_raw_tx,omitempty"`
 }
 
-func (m *ChanInfoRequest) Reset()                    { *m = ChanInfoRequest{} }
-func (m *ChanInfoRequest) String() string            { return proto.CompactTextString(m) }
-func (*ChanInfoRequest) ProtoMessage()               {}
-func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (m *FundingPsbtFinalize) Reset()                    { *m = FundingPsbtFinalize{} }
+func (m *FundingPsbtFinalize) String() string            { return proto.CompactTextString(m) }
+func (*FundingPsbtFinalize) ProtoMessage()               {}
+func (*FundingPsbtFinalize) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
 
-func (m *ChanInfoRequest) GetChanId() uint64 {
+func (m *FundingPsbtFinalize) GetSignedPsbt() []byte {
 	if m != nil {
-		return m.ChanId
+		return m.SignedPsbt
 	}
-	return 0
+	return nil
 }
 
-type NetworkInfoRequest struct {
+func (m *FundingPsbtFinalize) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
 }
 
-func (m *NetworkInfoRequest) Reset()                    { *m = NetworkInfoRequest{} }
-func (m *NetworkInfoRequest) String() string            { return proto.CompactTextString(m) }
-func (*NetworkInfoRequest) ProtoMessage()               {}
-func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
-
-type NetworkInfo struct {
-	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter" json:"graph_diameter,omitempty"`
-	AvgOutDegree         float64 `protobuf:"fixed64,2,opt,name=avg_out_degree" json:"avg_out_degree,omitempty"`
-	MaxOutDegree         uint32  `protobuf:"varint,3,opt,name=max_out_degree" json:"max_out_degree,omitempty"`
-	NumNodes             uint32  `protobuf:"varint,4,opt,name=num_nodes" json:"num_nodes,omitempty"`
-	NumChannels          uint32  `protobuf:"varint,5,opt,name=num_channels" json:"num_channels,omitempty"`
-	TotalNetworkCapacity int64   `protobuf:"varint,6,opt,name=total_network_capacity" json:"total_network_capacity,omitempty"`
-	AvgChannelSize       float64 `protobuf:"fixed64,7,opt,name=avg_channel_size" json:"avg_channel_size,omitempty"`
-	MinChannelSize       int64   `protobuf:"varint,8,opt,name=min_channel_size" json:"min_channel_size,omitempty"`
-	MaxChannelSize       int64   `protobuf:"varint,9,opt,name=max_channel_size" json:"max_channel_size,omitempty"`
-}
-
-func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
-func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
-func (*NetworkInfo) ProtoMessage()               {}
-func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
-
-func (m *NetworkInfo) GetGraphDiameter() uint32 {
+func (m *FundingPsbtFinalize) GetFinalRawTx() []byte {
 	if m != nil {
-		return m.GraphDiameter
+		return m.FinalRawTx
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkInfo) GetAvgOutDegree() float64 {
-	if m != nil {
-		return m.AvgOutDegree
-	}
-	return 0
+type FundingStateStepResp struct {
 }
 
-func (m *NetworkInfo) GetMaxOutDegree() uint32 {
-	if m != nil {
-		return m.MaxOutDegree
-	}
-	return 0
+func (m *FundingStateStepResp) Reset()                    { *m = FundingStateStepResp{} }
+func (m *FundingStateStepResp) String() string            { return proto.CompactTextString(m) }
+func (*FundingStateStepResp) ProtoMessage()               {}
+func (*FundingStateStepResp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+type PendingHTLC struct {
+	// / The direction within the channel that the htlc was sent
+	Incoming bool `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
+	// / The total value of the htlc
+	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	// / The final output to be swept back to the user's wallet
+	Outpoint string `protobuf:"bytes,3,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The next block height at which we can spend the current stage
+	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height" json:"maturity_height,omitempty"`
+	// *
+	// The number of blocks remaining until the current stage can be swept.
+	// Negative values indicate how many blocks have passed since becoming
+	// mature.
+	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity" json:"blocks_til_maturity,omitempty"`
+	// / Indicates whether the htlc is in its first or second stage of recovery
+	Stage uint32 `protobuf:"varint,6,opt,name=stage" json:"stage,omitempty"`
 }
 
-func (m *NetworkInfo) GetNumNodes() uint32 {
+func (m *PendingHTLC) Reset()                    { *m = PendingHTLC{} }
+func (m *PendingHTLC) String() string            { return proto.CompactTextString(m) }
+func (*PendingHTLC) ProtoMessage()               {}
+func (*PendingHTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *PendingHTLC) GetIncoming() bool {
 	if m != nil {
-		return m.NumNodes
+		return m.Incoming
 	}
-	return 0
+	return false
 }
 
-func (m *NetworkInfo) GetNumChannels() uint32 {
+func (m *PendingHTLC) GetAmount() int64 {
 	if m != nil {
-		return m.NumChannels
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetTotalNetworkCapacity() int64 {
+func (m *PendingHTLC) GetOutpoint() string {
 	if m != nil {
-		return m.TotalNetworkCapacity
+		return m.Outpoint
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkInfo) GetAvgChannelSize() float64 {
+func (m *PendingHTLC) GetMaturityHeight() uint32 {
 	if m != nil {
-		return m.AvgChannelSize
+		return m.MaturityHeight
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetMinChannelSize() int64 {
+func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
 	if m != nil {
-		return m.MinChannelSize
+		return m.BlocksTilMaturity
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetMaxChannelSize() int64 {
+func (m *PendingHTLC) GetStage() uint32 {
 	if m != nil {
-		return m.MaxChannelSize
+		return m.Stage
 	}
 	return 0
 }
 
-type StopRequest struct {
+type PendingChannelsRequest struct {
 }
 
-func (m *StopRequest) Reset()                    { *m = StopRequest{} }
-func (m *StopRequest) String() string            { return proto.CompactTextString(m) }
-func (*StopRequest) ProtoMessage()               {}
-func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+func (m *PendingChannelsRequest) Reset()                    { *m = PendingChannelsRequest{} }
+func (m *PendingChannelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PendingChannelsRequest) ProtoMessage()               {}
+func (*PendingChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
 
-type StopResponse struct {
+type PendingChannelsResponse struct {
+	// / The balance in satoshis encumbered in pending channels
+	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance" json:"total_limbo_balance,omitempty"`
+	// / Channels pending opening
+	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels" json:"pending_open_channels,omitempty"`
+	// / Channels pending closing
+	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels" json:"pending_closing_channels,omitempty"`
+	// / Channels pending force closing
+	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels" json:"pending_force_closing_channels,omitempty"`
+	// / Channels waiting for closing tx to confirm
+	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels" json:"waiting_close_channels,omitempty"`
 }
 
-func (m *StopResponse) Reset()                    { *m = StopResponse{} }
-func (m *StopResponse) String() string            { return proto.CompactTextString(m) }
-func (*StopResponse) ProtoMessage()               {}
-func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (m *PendingChannelsResponse) Reset()                    { *m = PendingChannelsResponse{} }
+func (m *PendingChannelsResponse) String() string            { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse) ProtoMessage()               {}
+func (*PendingChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
 
-type GraphTopologySubscription struct {
+func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
+	if m != nil {
+		return m.TotalLimboBalance
+	}
+	return 0
 }
 
-func (m *GraphTopologySubscription) Reset()                    { *m = GraphTopologySubscription{} }
-func (m *GraphTopologySubscription) String() string            { return proto.CompactTextString(m) }
-func (*GraphTopologySubscription) ProtoMessage()               {}
-func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
-
-type GraphTopologyUpdate struct {
-	NodeUpdates    []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates" json:"node_updates,omitempty"`
-	ChannelUpdates []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates" json:"channel_updates,omitempty"`
-	ClosedChans    []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans" json:"closed_chans,omitempty"`
+func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
+	if m != nil {
+		return m.PendingOpenChannels
+	}
+	return nil
 }
 
-func (m *GraphTopologyUpdate) Reset()                    { *m = GraphTopologyUpdate{} }
-func (m *GraphTopologyUpdate) String() string            { return proto.CompactTextString(m) }
-func (*GraphTopologyUpdate) ProtoMessage()               {}
-func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
-
-func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
+func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
 	if m != nil {
-		return m.NodeUpdates
+		return m.PendingClosingChannels
 	}
 	return nil
 }
 
-func (m *GraphTopologyUpdate) GetChannelUpdates() []*ChannelEdgeUpdate {
+func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
 	if m != nil {
-		return m.ChannelUpdates
+		return m.PendingForceClosingChannels
 	}
 	return nil
 }
 
-func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
+func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
 	if m != nil {
-		return m.ClosedChans
+		return m.WaitingCloseChannels
 	}
 	return nil
 }
 
-type NodeUpdate struct {
-	Addresses      []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
-	IdentityKey    string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey" json:"identity_key,omitempty"`
-	GlobalFeatures []byte   `protobuf:"bytes,3,opt,name=global_features,json=globalFeatures,proto3" json:"global_features,omitempty"`
-	Alias          string   `protobuf:"bytes,4,opt,name=alias" json:"alias,omitempty"`
+type PendingChannelsResponse_PendingChannel struct {
+	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub" json:"remote_node_pub,omitempty"`
+	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point" json:"channel_point,omitempty"`
+	Capacity      int64  `protobuf:"varint,3,opt,name=capacity" json:"capacity,omitempty"`
+	LocalBalance  int64  `protobuf:"varint,4,opt,name=local_balance" json:"local_balance,omitempty"`
+	RemoteBalance int64  `protobuf:"varint,5,opt,name=remote_balance" json:"remote_balance,omitempty"`
 }
 
-func (m *NodeUpdate) Reset()                    { *m = NodeUpdate{} }
-func (m *NodeUpdate) String() string            { return proto.CompactTextString(m) }
-func (*NodeUpdate) ProtoMessage()               {}
-func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+func (m *PendingChannelsResponse_PendingChannel) Reset() {
+	*m = PendingChannelsResponse_PendingChannel{}
+}
+func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{87, 0}
+}
 
-func (m *NodeUpdate) GetAddresses() []string {
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
 	if m != nil {
-		return m.Addresses
+		return m.RemoteNodePub
 	}
-	return nil
+	return ""
 }
 
-func (m *NodeUpdate) GetIdentityKey() string {
+func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
 	if m != nil {
-		return m.IdentityKey
+		return m.ChannelPoint
 	}
 	return ""
 }
 
-func (m *NodeUpdate) GetGlobalFeatures() []byte {
+func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
 	if m != nil {
-		return m.GlobalFeatures
+		return m.Capacity
 	}
-	return nil
+	return 0
 }
 
-func (m *NodeUpdate) GetAlias() string {
+func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
 	if m != nil {
-		return m.Alias
+		return m.LocalBalance
 	}
-	return ""
-}
-
-type ChannelEdgeUpdate struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId          uint64         `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
-	ChanPoint       *ChannelPoint  `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint" json:"chan_point,omitempty"`
-	Capacity        int64          `protobuf:"varint,3,opt,name=capacity" json:"capacity,omitempty"`
-	RoutingPolicy   *RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy" json:"routing_policy,omitempty"`
-	AdvertisingNode string         `protobuf:"bytes,5,opt,name=advertising_node,json=advertisingNode" json:"advertising_node,omitempty"`
-	ConnectingNode  string         `protobuf:"bytes,6,opt,name=connecting_node,json=connectingNode" json:"connecting_node,omitempty"`
+	return 0
 }
 
-func (m *ChannelEdgeUpdate) Reset()                    { *m = ChannelEdgeUpdate{} }
-func (m *ChannelEdgeUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ChannelEdgeUpdate) ProtoMessage()               {}
-func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
-
-func (m *ChannelEdgeUpdate) GetChanId() uint64 {
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
 	if m != nil {
-		return m.ChanId
+		return m.RemoteBalance
 	}
 	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetChanPoint() *ChannelPoint {
+type PendingChannelsResponse_PendingOpenChannel struct {
+	// / The pending channel
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	// / The height at which this channel will be confirmed
+	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height" json:"confirmation_height,omitempty"`
+	// *
+	// The amount calculated to be paid in fees for the current set of
+	// commitment transactions. The fee amount is persisted with the channel
+	// in order to allow the fee amount to be removed and recalculated with
+	// each channel state update, including updates that happen after a system
+	// restart.
+	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee" json:"commit_fee,omitempty"`
+	// / The weight of the commitment transaction
+	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight" json:"commit_weight,omitempty"`
+	// *
+	// The required number of satoshis per kilo-weight that the requester will
+	// pay at all times, for both the funding transaction and commitment
+	// transaction. This value can later be updated once the channel is open.
+	FeePerKw int64 `protobuf:"varint,6,opt,name=fee_per_kw" json:"fee_per_kw,omitempty"`
+	// / The height at which the funding transaction was broadcast, only known for channels we initiated.
+	FundingBroadcastHeight uint32 `protobuf:"varint,7,opt,name=funding_broadcast_height" json:"funding_broadcast_height,omitempty"`
+	// / The number of confirmations the funding transaction requires before the channel can be used.
+	NumConfsRequired uint32 `protobuf:"varint,8,opt,name=num_confs_required" json:"num_confs_required,omitempty"`
+}
+
+func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
+	*m = PendingChannelsResponse_PendingOpenChannel{}
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{87, 1}
+}
+
+func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.ChanPoint
+		return m.Channel
 	}
 	return nil
 }
 
-func (m *ChannelEdgeUpdate) GetCapacity() int64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
 	if m != nil {
-		return m.Capacity
+		return m.ConfirmationHeight
 	}
 	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetRoutingPolicy() *RoutingPolicy {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
 	if m != nil {
-		return m.RoutingPolicy
+		return m.CommitFee
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetAdvertisingNode() string {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
 	if m != nil {
-		return m.AdvertisingNode
+		return m.CommitWeight
 	}
-	return ""
-}
-
-func (m *ChannelEdgeUpdate) GetConnectingNode() string {
-	if m != nil {
-		return m.ConnectingNode
-	}
-	return ""
-}
-
-type ClosedChannelUpdate struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId       uint64        `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
-	Capacity     int64         `protobuf:"varint,2,opt,name=capacity" json:"capacity,omitempty"`
-	ClosedHeight uint32        `protobuf:"varint,3,opt,name=closed_height,json=closedHeight" json:"closed_height,omitempty"`
-	ChanPoint    *ChannelPoint `protobuf:"bytes,4,opt,name=chan_point,json=chanPoint" json:"chan_point,omitempty"`
+	return 0
 }
 
-func (m *ClosedChannelUpdate) Reset()                    { *m = ClosedChannelUpdate{} }
-func (m *ClosedChannelUpdate) String() string            { return proto.CompactTextString(m) }
-func (*ClosedChannelUpdate) ProtoMessage()               {}
-func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
-
-func (m *ClosedChannelUpdate) GetChanId() uint64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
 	if m != nil {
-		return m.ChanId
+		return m.FeePerKw
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetCapacity() int64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetFundingBroadcastHeight() uint32 {
 	if m != nil {
-		return m.Capacity
+		return m.FundingBroadcastHeight
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetClosedHeight() uint32 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetNumConfsRequired() uint32 {
 	if m != nil {
-		return m.ClosedHeight
+		return m.NumConfsRequired
 	}
 	return 0
 }
 
-func (m *ClosedChannelUpdate) GetChanPoint() *ChannelPoint {
-	if m != nil {
-		return m.ChanPoint
-	}
-	return nil
+type PendingChannelsResponse_WaitingCloseChannel struct {
+	// / The pending channel waiting for closing tx to confirm
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	// / The balance in satoshis encumbered in this channel
+	LimboBalance int64 `protobuf:"varint,2,opt,name=limbo_balance" json:"limbo_balance,omitempty"`
 }
 
-type HopHint struct {
-	// / The public key of the node at the start of the channel.
-	NodeId string `protobuf:"bytes,1,opt,name=node_id" json:"node_id,omitempty"`
-	// / The unique identifier of the channel.
-	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id" json:"chan_id,omitempty"`
-	// / The base fee of the channel denominated in millisatoshis.
-	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat" json:"fee_base_msat,omitempty"`
-	// *
-	// The fee rate of the channel for sending one satoshi across it denominated in
-	// millionths of a satoshi.
-	FeeProportionalMillionths uint32 `protobuf:"varint,4,opt,name=fee_proportional_millionths" json:"fee_proportional_millionths,omitempty"`
-	// / The time-lock delta of the channel.
-	CltvExpiryDelta uint32 `protobuf:"varint,5,opt,name=cltv_expiry_delta" json:"cltv_expiry_delta,omitempty"`
+func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
+	*m = PendingChannelsResponse_WaitingCloseChannel{}
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{87, 2}
 }
 
-func (m *HopHint) Reset()                    { *m = HopHint{} }
-func (m *HopHint) String() string            { return proto.CompactTextString(m) }
-func (*HopHint) ProtoMessage()               {}
-func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
-
-func (m *HopHint) GetNodeId() string {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.NodeId
+		return m.Channel
 	}
-	return ""
+	return nil
 }
 
-func (m *HopHint) GetChanId() uint64 {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
 	if m != nil {
-		return m.ChanId
+		return m.LimboBalance
 	}
 	return 0
 }
 
-func (m *HopHint) GetFeeBaseMsat() uint32 {
-	if m != nil {
-		return m.FeeBaseMsat
-	}
-	return 0
+type PendingChannelsResponse_ClosedChannel struct {
+	// / The pending channel to be closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	// / The transaction id of the closing transaction
+	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid" json:"closing_txid,omitempty"`
 }
 
-func (m *HopHint) GetFeeProportionalMillionths() uint32 {
+func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
+func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{87, 3}
+}
+
+func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.FeeProportionalMillionths
+		return m.Channel
 	}
-	return 0
+	return nil
 }
 
-func (m *HopHint) GetCltvExpiryDelta() uint32 {
+func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
 	if m != nil {
-		return m.CltvExpiryDelta
+		return m.ClosingTxid
 	}
-	return 0
+	return ""
 }
 
-type RouteHint struct {
-	// *
-	// A list of hop hints that when chained together can assist in reaching a
-	// specific destination.
-	HopHints []*HopHint `protobuf:"bytes,1,rep,name=hop_hints" json:"hop_hints,omitempty"`
+type PendingChannelsResponse_ForceClosedChannel struct {
+	// / The pending channel to be force closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	// / The transaction id of the closing transaction
+	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid" json:"closing_txid,omitempty"`
+	// / The balance in satoshis encumbered in this pending channel
+	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance" json:"limbo_balance,omitempty"`
+	// / The height at which funds can be sweeped into the wallet
+	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height" json:"maturity_height,omitempty"`
+	//
+	// Remaining # of blocks until the commitment output can be swept.
+	// Negative values indicate how many blocks have passed since becoming
+	// mature.
+	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity" json:"blocks_til_maturity,omitempty"`
+	// / The total value of funds successfully recovered from this channel
+	RecoveredBalance int64          `protobuf:"varint,6,opt,name=recovered_balance" json:"recovered_balance,omitempty"`
+	PendingHtlcs     []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs" json:"pending_htlcs,omitempty"`
 }
 
-func (m *RouteHint) Reset()                    { *m = RouteHint{} }
-func (m *RouteHint) String() string            { return proto.CompactTextString(m) }
-func (*RouteHint) ProtoMessage()               {}
-func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
+	*m = PendingChannelsResponse_ForceClosedChannel{}
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{87, 4}
+}
 
-func (m *RouteHint) GetHopHints() []*HopHint {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.HopHints
+		return m.Channel
 	}
 	return nil
 }
 
-type Invoice struct {
-	// *
-	// An optional memo to attach along with the invoice. Used for record keeping
-	// purposes for the invoice's creator, and will also be set in the description
-	// field of the encoded payment request if the description_hash field is not
-	// being used.
-	Memo string `protobuf:"bytes,1,opt,name=memo" json:"memo,omitempty"`
-	// / An optional cryptographic receipt of payment
-	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
-	// *
-	// The hex-encoded preimage (32 byte) which will allow settling an incoming
-	// HTLC payable to this preimage
-	RPreimage []byte `protobuf:"bytes,3,opt,name=r_preimage,proto3" json:"r_preimage,omitempty"`
-	// / The hash of the preimage
-	RHash []byte `protobuf:"bytes,4,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	// / The value of this invoice in satoshis
-	Value int64 `protobuf:"varint,5,opt,name=value" json:"value,omitempty"`
-	// / Whether this invoice has been fulfilled
-	Settled bool `protobuf:"varint,6,opt,name=settled" json:"settled,omitempty"`
-	// / When this invoice was created
-	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date" json:"creation_date,omitempty"`
-	// / When this invoice was settled
-	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date" json:"settle_date,omitempty"`
-	// *
-	// A bare-bones invoice for a payment within the Lightning Network.  With the
-	// details of the invoice, the sender has all the data necessary to send a
-	// payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request" json:"payment_request,omitempty"`
-	// *
-	// Hash (SHA-256) of a description of the payment. Used if the description of
-	// payment (memo) is too long to naturally fit within the description field
-	// of an encoded payment request.
-	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
-	// / Payment request expiry time in seconds. Default is 3600 (1 hour).
-	Expiry int64 `protobuf:"varint,11,opt,name=expiry" json:"expiry,omitempty"`
-	// / Fallback on-chain address.
-	FallbackAddr string `protobuf:"bytes,12,opt,name=fallback_addr" json:"fallback_addr,omitempty"`
-	// / Delta to use for the time-lock of the CLTV extended to the final hop.
-	CltvExpiry uint64 `protobuf:"varint,13,opt,name=cltv_expiry" json:"cltv_expiry,omitempty"`
-	// *
-	// Route hints that can each be individually used to assist in reaching the
-	// invoice's destination.
-	RouteHints []*RouteHint `protobuf:"bytes,14,rep,name=route_hints" json:"route_hints,omitempty"`
-	// / Whether this invoice should include routing hints for private channels.
-	Private bool `protobuf:"varint,15,opt,name=private" json:"private,omitempty"`
-	// *
-	// The "add" index of this invoice. Each newly created invoice will increment
-	// this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all added
-	// invoices with an add_index greater than this one.
-	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index" json:"add_index,omitempty"`
-	// *
-	// The "settle" index of this invoice. Each newly settled invoice will
-	// increment this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all
-	// settled invoices with an settle_index greater than this one.
-	SettleIndex uint64 `protobuf:"varint,17,opt,name=settle_index" json:"settle_index,omitempty"`
-	// / Deprecated, use amt_paid_sat or amt_paid_msat.
-	AmtPaid int64 `protobuf:"varint,18,opt,name=amt_paid" json:"amt_paid,omitempty"`
-	// *
-	// The amount that was accepted for this invoice, in satoshis. This will ONLY
-	// be set if this invoice has been settled. We provide this field as if the
-	// invoice was created with a zero value, then we need to record what amount
-	// was ultimately accepted. Additionally, it's possible that the sender paid
-	// MORE that was specified in the original invoice. So we'll record that here
-	// as well.
-	AmtPaidSat int64 `protobuf:"varint,19,opt,name=amt_paid_sat" json:"amt_paid_sat,omitempty"`
-	// *
-	// The amount that was accepted for this invoice, in millisatoshis. This will
-	// ONLY be set if this invoice has been settled. We provide this field as if
-	// the invoice was created with a zero value, then we need to record what
-	// amount was ultimately accepted. Additionally, it's possible that the sender
-	// paid MORE that was specified in the original invoice. So we'll record that
-	// here as well.
-	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat" json:"amt_paid_msat,omitempty"`
-}
-
-func (m *Invoice) Reset()                    { *m = Invoice{} }
-func (m *Invoice) String() string            { return proto.CompactTextString(m) }
-func (*Invoice) ProtoMessage()               {}
-func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
-
-func (m *Invoice) GetMemo() string {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
 	if m != nil {
-		return m.Memo
+		return m.ClosingTxid
 	}
 	return ""
 }
 
-func (m *Invoice) GetReceipt() []byte {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
 	if m != nil {
-		return m.Receipt
+		return m.LimboBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *Invoice) GetRPreimage() []byte {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
 	if m != nil {
-		return m.RPreimage
+		return m.MaturityHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *Invoice) GetRHash() []byte {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
 	if m != nil {
-		return m.RHash
+		return m.BlocksTilMaturity
 	}
-	return nil
+	return 0
 }
 
-func (m *Invoice) GetValue() int64 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
 	if m != nil {
-		return m.Value
+		return m.RecoveredBalance
 	}
 	return 0
 }
 
-func (m *Invoice) GetSettled() bool {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
 	if m != nil {
-		return m.Settled
+		return m.PendingHtlcs
 	}
-	return false
+	return nil
 }
 
-func (m *Invoice) GetCreationDate() int64 {
-	if m != nil {
-		return m.CreationDate
-	}
-	return 0
+type WalletBalanceRequest struct {
 }
 
-func (m *Invoice) GetSettleDate() int64 {
+func (m *WalletBalanceRequest) Reset()                    { *m = WalletBalanceRequest{} }
+func (m *WalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*WalletBalanceRequest) ProtoMessage()               {}
+func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+type WalletAccountBalance struct {
+	// / The confirmed balance of the account (with >= 1 confirmations)
+	ConfirmedBalance int64 `protobuf:"varint,1,opt,name=confirmed_balance" json:"confirmed_balance,omitempty"`
+	// / The unconfirmed balance of the account (with 0 confirmations)
+	UnconfirmedBalance int64 `protobuf:"varint,2,opt,name=unconfirmed_balance" json:"unconfirmed_balance,omitempty"`
+}
+
+func (m *WalletAccountBalance) Reset()                    { *m = WalletAccountBalance{} }
+func (m *WalletAccountBalance) String() string            { return proto.CompactTextString(m) }
+func (*WalletAccountBalance) ProtoMessage()               {}
+func (*WalletAccountBalance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+
+func (m *WalletAccountBalance) GetConfirmedBalance() int64 {
 	if m != nil {
-		return m.SettleDate
+		return m.ConfirmedBalance
 	}
 	return 0
 }
 
-func (m *Invoice) GetPaymentRequest() string {
+func (m *WalletAccountBalance) GetUnconfirmedBalance() int64 {
 	if m != nil {
-		return m.PaymentRequest
+		return m.UnconfirmedBalance
 	}
-	return ""
+	return 0
 }
 
-func (m *Invoice) GetDescriptionHash() []byte {
-	if m != nil {
-		return m.DescriptionHash
-	}
-	return nil
+type WalletBalanceResponse struct {
+	// / The balance of the wallet
+	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance" json:"total_balance,omitempty"`
+	// / The confirmed balance of a wallet(with >= 1 confirmations)
+	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance" json:"confirmed_balance,omitempty"`
+	// / The unconfirmed balance of a wallet(with 0 confirmations)
+	UnconfirmedBalance int64 `protobuf:"varint,3,opt,name=unconfirmed_balance" json:"unconfirmed_balance,omitempty"`
+	// / The confirmed and unconfirmed balances of each account of the wallet, keyed by account name
+	AccountBalance map[string]*WalletAccountBalance `protobuf:"bytes,4,rep,name=account_balance" json:"account_balance,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// / The balance of the outputs that are locked, and therefore not part of the other balances
+	LockedBalance int64 `protobuf:"varint,5,opt,name=locked_balance" json:"locked_balance,omitempty"`
 }
 
-func (m *Invoice) GetExpiry() int64 {
+func (m *WalletBalanceResponse) Reset()                    { *m = WalletBalanceResponse{} }
+func (m *WalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*WalletBalanceResponse) ProtoMessage()               {}
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+
+func (m *WalletBalanceResponse) GetTotalBalance() int64 {
 	if m != nil {
-		return m.Expiry
+		return m.TotalBalance
 	}
 	return 0
 }
 
-func (m *Invoice) GetFallbackAddr() string {
+func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
 	if m != nil {
-		return m.FallbackAddr
+		return m.ConfirmedBalance
 	}
-	return ""
+	return 0
 }
 
-func (m *Invoice) GetCltvExpiry() uint64 {
+func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
 	if m != nil {
-		return m.CltvExpiry
+		return m.UnconfirmedBalance
 	}
 	return 0
 }
 
-func (m *Invoice) GetRouteHints() []*RouteHint {
+func (m *WalletBalanceResponse) GetAccountBalance() map[string]*WalletAccountBalance {
 	if m != nil {
-		return m.RouteHints
+		return m.AccountBalance
 	}
 	return nil
 }
 
-func (m *Invoice) GetPrivate() bool {
+func (m *WalletBalanceResponse) GetLockedBalance() int64 {
 	if m != nil {
-		return m.Private
+		return m.LockedBalance
 	}
-	return false
+	return 0
 }
 
-func (m *Invoice) GetAddIndex() uint64 {
+type Amount struct {
+	// / Value denominated in satoshis
+	Sat uint64 `protobuf:"varint,1,opt,name=sat" json:"sat,omitempty"`
+	// / Value denominated in milli-satoshis
+	Msat uint64 `protobuf:"varint,2,opt,name=msat" json:"msat,omitempty"`
+}
+
+func (m *Amount) Reset()                    { *m = Amount{} }
+func (m *Amount) String() string            { return proto.CompactTextString(m) }
+func (*Amount) ProtoMessage()               {}
+func (*Amount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+
+func (m *Amount) GetSat() uint64 {
 	if m != nil {
-		return m.AddIndex
+		return m.Sat
 	}
 	return 0
 }
 
-func (m *Invoice) GetSettleIndex() uint64 {
+func (m *Amount) GetMsat() uint64 {
 	if m != nil {
-		return m.SettleIndex
+		return m.Msat
 	}
 	return 0
 }
 
-func (m *Invoice) GetAmtPaid() int64 {
+type ChannelBalanceRequest struct {
+}
+
+func (m *ChannelBalanceRequest) Reset()                    { *m = ChannelBalanceRequest{} }
+func (m *ChannelBalanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChannelBalanceRequest) ProtoMessage()               {}
+func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+
+type ChannelBalanceResponse struct {
+	// / Sum of channels balances denominated in satoshis
+	Balance int64 `protobuf:"varint,1,opt,name=balance" json:"balance,omitempty"`
+	// / Sum of channels pending balances denominated in satoshis
+	PendingOpenBalance int64 `protobuf:"varint,2,opt,name=pending_open_balance" json:"pending_open_balance,omitempty"`
+	// / Sum of channels local balances
+	LocalBalance *Amount `protobuf:"bytes,3,opt,name=local_balance" json:"local_balance,omitempty"`
+	// / Sum of channels remote balances
+	RemoteBalance *Amount `protobuf:"bytes,4,opt,name=remote_balance" json:"remote_balance,omitempty"`
+	// / Sum of the in-flight HTLCs extended to us, which become part of the local balance once settled
+	UnsettledLocalBalance *Amount `protobuf:"bytes,5,opt,name=unsettled_local_balance" json:"unsettled_local_balance,omitempty"`
+	// / Sum of the in-flight HTLCs we extended, which become part of the remote balance once settled
+	UnsettledRemoteBalance *Amount `protobuf:"bytes,6,opt,name=unsettled_remote_balance" json:"unsettled_remote_balance,omitempty"`
+	// / Sum of pending open channels local balances
+	PendingOpenLocalBalance *Amount `protobuf:"bytes,7,opt,name=pending_open_local_balance" json:"pending_open_local_balance,omitempty"`
+	// / Sum of pending open channels remote balances
+	PendingOpenRemoteBalance *Amount `protobuf:"bytes,8,opt,name=pending_open_remote_balance" json:"pending_open_remote_balance,omitempty"`
+}
+
+func (m *ChannelBalanceResponse) Reset()                    { *m = ChannelBalanceResponse{} }
+func (m *ChannelBalanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChannelBalanceResponse) ProtoMessage()               {}
+func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+
+func (m *ChannelBalanceResponse) GetBalance() int64 {
 	if m != nil {
-		return m.AmtPaid
+		return m.Balance
 	}
 	return 0
 }
 
-func (m *Invoice) GetAmtPaidSat() int64 {
+func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
 	if m != nil {
-		return m.AmtPaidSat
+		return m.PendingOpenBalance
 	}
 	return 0
 }
 
-func (m *Invoice) GetAmtPaidMsat() int64 {
+func (m *ChannelBalanceResponse) GetLocalBalance() *Amount {
 	if m != nil {
-		return m.AmtPaidMsat
+		return m.LocalBalance
 	}
-	return 0
+	return nil
 }
 
-type AddInvoiceResponse struct {
-	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	// *
-	// A bare-bones invoice for a payment within the Lightning Network.  With the
-	// details of the invoice, the sender has all the data necessary to send a
-	// payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request" json:"payment_request,omitempty"`
-	// *
-	// The "add" index of this invoice. Each newly created invoice will increment
-	// this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all added
-	// invoices with an add_index greater than this one.
-	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index" json:"add_index,omitempty"`
+func (m *ChannelBalanceResponse) GetRemoteBalance() *Amount {
+	if m != nil {
+		return m.RemoteBalance
+	}
+	return nil
 }
 
-func (m *AddInvoiceResponse) Reset()                    { *m = AddInvoiceResponse{} }
-func (m *AddInvoiceResponse) String() string            { return proto.CompactTextString(m) }
-func (*AddInvoiceResponse) ProtoMessage()               {}
-func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+func (m *ChannelBalanceResponse) GetUnsettledLocalBalance() *Amount {
+	if m != nil {
+		return m.UnsettledLocalBalance
+	}
+	return nil
+}
 
-func (m *AddInvoiceResponse) GetRHash() []byte {
+func (m *ChannelBalanceResponse) GetUnsettledRemoteBalance() *Amount {
 	if m != nil {
-		return m.RHash
+		return m.UnsettledRemoteBalance
 	}
 	return nil
 }
 
-func (m *AddInvoiceResponse) GetPaymentRequest() string {
+func (m *ChannelBalanceResponse) GetPendingOpenLocalBalance() *Amount {
 	if m != nil {
-		return m.PaymentRequest
+		return m.PendingOpenLocalBalance
 	}
-	return ""
+	return nil
 }
 
-func (m *AddInvoiceResponse) GetAddIndex() uint64 {
+func (m *ChannelBalanceResponse) GetPendingOpenRemoteBalance() *Amount {
 	if m != nil {
-		return m.AddIndex
+		return m.PendingOpenRemoteBalance
 	}
-	return 0
+	return nil
 }
 
-type PaymentHash struct {
+type QueryRoutesRequest struct {
+	// / The 33-byte hex-encoded public key for the payment destination
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey" json:"pub_key,omitempty"`
+	// / The amount to send expressed in satoshis
+	Amt int64 `protobuf:"varint,2,opt,name=amt" json:"amt,omitempty"`
+	// / The max number of routes to return.
+	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes" json:"num_routes,omitempty"`
+	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
+	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta" json:"final_cltv_delta,omitempty"`
 	// *
-	// The hex-encoded payment hash of the invoice to be looked up. The passed
-	// payment hash must be exactly 32 bytes, otherwise an error is returned.
-	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str" json:"r_hash_str,omitempty"`
-	// / The payment hash of the invoice to be looked up.
-	RHash []byte `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// The maximum number of satoshis that will be paid as a fee of the payment.
+	// This value can be represented either as a percentage of the amount being
+	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
+	// send the payment.
+	FeeLimit *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit" json:"fee_limit,omitempty"`
 }
 
-func (m *PaymentHash) Reset()                    { *m = PaymentHash{} }
-func (m *PaymentHash) String() string            { return proto.CompactTextString(m) }
-func (*PaymentHash) ProtoMessage()               {}
-func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+func (m *QueryRoutesRequest) Reset()                    { *m = QueryRoutesRequest{} }
+func (m *QueryRoutesRequest) String() string            { return proto.CompactTextString(m) }
+func (*QueryRoutesRequest) ProtoMessage()               {}
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
 
-func (m *PaymentHash) GetRHashStr() string {
+func (m *QueryRoutesRequest) GetPubKey() string {
 	if m != nil {
-		return m.RHashStr
+		return m.PubKey
 	}
 	return ""
 }
 
-func (m *PaymentHash) GetRHash() []byte {
+func (m *QueryRoutesRequest) GetAmt() int64 {
 	if m != nil {
-		return m.RHash
+		return m.Amt
 	}
-	return nil
-}
-
-type ListInvoiceRequest struct {
-	// / If set, only unsettled invoices will be returned in the response.
-	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only" json:"pending_only,omitempty"`
-	// *
-	// The index of an invoice that will be used as either the start or end of a
-	// query to determine which invoices should be returned in the response.
-	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset" json:"index_offset,omitempty"`
-	// / The max number of invoices to return in the response to this query.
-	NumMaxInvoices uint64 `protobuf:"varint,5,opt,name=num_max_invoices" json:"num_max_invoices,omitempty"`
-	// *
-	// If set, the invoices returned will result from seeking backwards from the
-	// specified index offset. This can be used to paginate backwards.
-	Reversed bool `protobuf:"varint,6,opt,name=reversed" json:"reversed,omitempty"`
+	return 0
 }
 
-func (m *ListInvoiceRequest) Reset()                    { *m = ListInvoiceRequest{} }
-func (m *ListInvoiceRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListInvoiceRequest) ProtoMessage()               {}
-func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
-
-func (m *ListInvoiceRequest) GetPendingOnly() bool {
+func (m *QueryRoutesRequest) GetNumRoutes() int32 {
 	if m != nil {
-		return m.PendingOnly
+		return m.NumRoutes
 	}
-	return false
+	return 0
 }
 
-func (m *ListInvoiceRequest) GetIndexOffset() uint64 {
+func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
 	if m != nil {
-		return m.IndexOffset
+		return m.FinalCltvDelta
 	}
 	return 0
 }
 
-func (m *ListInvoiceRequest) GetNumMaxInvoices() uint64 {
+func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
 	if m != nil {
-		return m.NumMaxInvoices
+		return m.FeeLimit
 	}
-	return 0
+	return nil
 }
 
-func (m *ListInvoiceRequest) GetReversed() bool {
+type QueryRoutesResponse struct {
+	Routes []*Route `protobuf:"bytes,1,rep,name=routes" json:"routes,omitempty"`
+}
+
+func (m *QueryRoutesResponse) Reset()                    { *m = QueryRoutesResponse{} }
+func (m *QueryRoutesResponse) String() string            { return proto.CompactTextString(m) }
+func (*QueryRoutesResponse) ProtoMessage()               {}
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+
+func (m *QueryRoutesResponse) GetRoutes() []*Route {
 	if m != nil {
-		return m.Reversed
+		return m.Routes
 	}
-	return false
+	return nil
 }
 
-type ListInvoiceResponse struct {
-	// *
-	// A list of invoices from the time slice of the time series specified in the
-	// request.
-	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices" json:"invoices,omitempty"`
+type Hop struct {
 	// *
-	// The index of the last item in the set of returned invoices. This can be used
-	// to seek further, pagination style.
-	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset" json:"last_index_offset,omitempty"`
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
+	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity" json:"chan_capacity,omitempty"`
+	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward" json:"amt_to_forward,omitempty"`
+	Fee              int64  `protobuf:"varint,4,opt,name=fee" json:"fee,omitempty"`
+	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
+	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat" json:"amt_to_forward_msat,omitempty"`
+	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat" json:"fee_msat,omitempty"`
 	// *
-	// The index of the last item in the set of returned invoices. This can be used
-	// to seek backwards, pagination style.
-	FirstIndexOffset uint64 `protobuf:"varint,3,opt,name=first_index_offset" json:"first_index_offset,omitempty"`
+	// An optional public key of the hop. If the public key is given, the payment
+	// can be executed without relying on a copy of the channel graph.
+	PubKey string `protobuf:"bytes,8,opt,name=pub_key" json:"pub_key,omitempty"`
 }
 
-func (m *ListInvoiceResponse) Reset()                    { *m = ListInvoiceResponse{} }
-func (m *ListInvoiceResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListInvoiceResponse) ProtoMessage()               {}
-func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+func (m *Hop) Reset()                    { *m = Hop{} }
+func (m *Hop) String() string            { return proto.CompactTextString(m) }
+func (*Hop) ProtoMessage()               {}
+func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
 
-func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
+func (m *Hop) GetChanId() uint64 {
 	if m != nil {
-		return m.Invoices
+		return m.ChanId
 	}
-	return nil
+	return 0
 }
 
-func (m *ListInvoiceResponse) GetLastIndexOffset() uint64 {
+func (m *Hop) GetChanCapacity() int64 {
 	if m != nil {
-		return m.LastIndexOffset
+		return m.ChanCapacity
 	}
 	return 0
 }
 
-func (m *ListInvoiceResponse) GetFirstIndexOffset() uint64 {
+func (m *Hop) GetAmtToForward() int64 {
 	if m != nil {
-		return m.FirstIndexOffset
+		return m.AmtToForward
 	}
 	return 0
 }
 
-type InvoiceSubscription struct {
-	// *
-	// If specified (non-zero), then we'll first start by sending out
-	// notifications for all added indexes with an add_index greater than this
-	// value. This allows callers to catch up on any events they missed while they
-	// weren't connected to the streaming RPC.
-	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index" json:"add_index,omitempty"`
-	// *
-	// If specified (non-zero), then we'll first start by sending out
-	// notifications for all settled indexes with an settle_index greater than
-	// this value. This allows callers to catch up on any events they missed while
-	// they weren't connected to the streaming RPC.
-	SettleIndex uint64 `protobuf:"varint,2,opt,name=settle_index" json:"settle_index,omitempty"`
+func (m *Hop) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
 }
 
-func (m *InvoiceSubscription) Reset()                    { *m = InvoiceSubscription{} }
-func (m *InvoiceSubscription) String() string            { return proto.CompactTextString(m) }
-func (*InvoiceSubscription) ProtoMessage()               {}
-func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
-
-func (m *InvoiceSubscription) GetAddIndex() uint64 {
+func (m *Hop) GetExpiry() uint32 {
 	if m != nil {
-		return m.AddIndex
+		return m.Expiry
 	}
 	return 0
 }
 
-func (m *InvoiceSubscription) GetSettleIndex() uint64 {
+func (m *Hop) GetAmtToForwardMsat() int64 {
 	if m != nil {
-		return m.SettleIndex
+		return m.AmtToForwardMsat
 	}
 	return 0
 }
 
-type Payment struct {
-	// / The payment hash
-	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash" json:"payment_hash,omitempty"`
-	// / Deprecated, use value_sat or value_msat.
-	Value int64 `protobuf:"varint,2,opt,name=value" json:"value,omitempty"`
-	// / The date of this payment
-	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date" json:"creation_date,omitempty"`
-	// / The path this payment took
-	Path []string `protobuf:"bytes,4,rep,name=path" json:"path,omitempty"`
-	// / The fee paid for this payment in satoshis
-	Fee int64 `protobuf:"varint,5,opt,name=fee" json:"fee,omitempty"`
-	// / The payment preimage
-	PaymentPreimage string `protobuf:"bytes,6,opt,name=payment_preimage" json:"payment_preimage,omitempty"`
-	// / The value of the payment in satoshis
-	ValueSat int64 `protobuf:"varint,7,opt,name=value_sat" json:"value_sat,omitempty"`
-	// / The value of the payment in milli-satoshis
-	ValueMsat int64 `protobuf:"varint,8,opt,name=value_msat" json:"value_msat,omitempty"`
+func (m *Hop) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
 }
 
-func (m *Payment) Reset()                    { *m = Payment{} }
-func (m *Payment) String() string            { return proto.CompactTextString(m) }
-func (*Payment) ProtoMessage()               {}
-func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
-
-func (m *Payment) GetPaymentHash() string {
+func (m *Hop) GetPubKey() string {
 	if m != nil {
-		return m.PaymentHash
+		return m.PubKey
 	}
 	return ""
 }
 
-func (m *Payment) GetValue() int64 {
-	if m != nil {
-		return m.Value
-	}
-	return 0
+// *
+// A path through the channel graph which runs over one or more channels in
+// succession. This struct carries all the information required to craft the
+// Sphinx onion packet, and send the payment along the first hop in the path. A
+// route is only selected as valid if all the channels have sufficient capacity to
+// carry the initial payment amount after fees are accounted for.
+type Route struct {
+	// *
+	// The cumulative (final) time lock across the entire route.  This is the CLTV
+	// value that should be extended to the first hop in the route. All other hops
+	// will decrement the time-lock as advertised, leaving enough time for all
+	// hops to wait for or present the payment preimage to complete the payment.
+	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock" json:"total_time_lock,omitempty"`
+	// *
+	// The sum of the fees paid at each hop within the final route.  In the case
+	// of a one-hop payment, this value will be zero as we don't need to pay a fee
+	// it ourself.
+	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees" json:"total_fees,omitempty"`
+	// *
+	// The total amount of funds required to complete a payment over this route.
+	// This value includes the cumulative fees at each hop. As a result, the HTLC
+	// extended to the first-hop in the route will need to have at least this many
+	// satoshis, otherwise the route will fail at an intermediate node due to an
+	// insufficient amount of fees.
+	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt" json:"total_amt,omitempty"`
+	// *
+	// Contains details concerning the specific forwarding details at each hop.
+	Hops []*Hop `protobuf:"bytes,4,rep,name=hops" json:"hops,omitempty"`
+	// *
+	// The total fees in millisatoshis.
+	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat" json:"total_fees_msat,omitempty"`
+	// *
+	// The total amount in millisatoshis.
+	TotalAmtMsat int64 `protobuf:"varint,6,opt,name=total_amt_msat" json:"total_amt_msat,omitempty"`
 }
 
-func (m *Payment) GetCreationDate() int64 {
+func (m *Route) Reset()                    { *m = Route{} }
+func (m *Route) String() string            { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()               {}
+func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *Route) GetTotalTimeLock() uint32 {
 	if m != nil {
-		return m.CreationDate
+		return m.TotalTimeLock
 	}
 	return 0
 }
 
-func (m *Payment) GetPath() []string {
+func (m *Route) GetTotalFees() int64 {
 	if m != nil {
-		return m.Path
+		return m.TotalFees
 	}
-	return nil
+	return 0
 }
 
-func (m *Payment) GetFee() int64 {
+func (m *Route) GetTotalAmt() int64 {
 	if m != nil {
-		return m.Fee
+		return m.TotalAmt
 	}
 	return 0
 }
 
-func (m *Payment) GetPaymentPreimage() string {
+func (m *Route) GetHops() []*Hop {
 	if m != nil {
-		return m.PaymentPreimage
+		return m.Hops
 	}
-	return ""
+	return nil
 }
 
-func (m *Payment) GetValueSat() int64 {
+func (m *Route) GetTotalFeesMsat() int64 {
 	if m != nil {
-		return m.ValueSat
+		return m.TotalFeesMsat
 	}
 	return 0
 }
 
-func (m *Payment) GetValueMsat() int64 {
+func (m *Route) GetTotalAmtMsat() int64 {
 	if m != nil {
-		return m.ValueMsat
+		return m.TotalAmtMsat
 	}
 	return 0
 }
 
-type ListPaymentsRequest struct {
+type NodeInfoRequest struct {
+	// / The 33-byte hex-encoded compressed public of the target node
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey" json:"pub_key,omitempty"`
+	// / If true, will include all known channels associated with the node.
+	IncludeChannels bool `protobuf:"varint,2,opt,name=include_channels,json=includeChannels" json:"include_channels,omitempty"`
 }
 
-func (m *ListPaymentsRequest) Reset()                    { *m = ListPaymentsRequest{} }
-func (m *ListPaymentsRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListPaymentsRequest) ProtoMessage()               {}
-func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+func (m *NodeInfoRequest) Reset()                    { *m = NodeInfoRequest{} }
+func (m *NodeInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*NodeInfoRequest) ProtoMessage()               {}
+func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
 
-type ListPaymentsResponse struct {
-	// / The list of payments
-	Payments []*Payment `protobuf:"bytes,1,rep,name=payments" json:"payments,omitempty"`
+func (m *NodeInfoRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
 }
 
-func (m *ListPaymentsResponse) Reset()                    { *m = ListPaymentsResponse{} }
-func (m *ListPaymentsResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListPaymentsResponse) ProtoMessage()               {}
-func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
-
-func (m *ListPaymentsResponse) GetPayments() []*Payment {
+func (m *NodeInfoRequest) GetIncludeChannels() bool {
 	if m != nil {
-		return m.Payments
+		return m.IncludeChannels
 	}
-	return nil
+	return false
 }
 
-type DeleteAllPaymentsRequest struct {
+type NodeInfo struct {
+	// *
+	// An individual vertex/node within the channel graph. A node is
+	// connected to other nodes by one or more channel edges emanating from it. As
+	// the graph is directed, a node will also have an incoming edge attached to
+	// it for each outgoing edge.
+	Node          *LightningNode `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	NumChannels   uint32         `protobuf:"varint,2,opt,name=num_channels" json:"num_channels,omitempty"`
+	TotalCapacity int64          `protobuf:"varint,3,opt,name=total_capacity" json:"total_capacity,omitempty"`
+	// / A list of all known channels of the node, only populated if include_channels was set.
+	Channels []*ChannelEdge `protobuf:"bytes,4,rep,name=channels" json:"channels,omitempty"`
 }
 
-func (m *DeleteAllPaymentsRequest) Reset()                    { *m = DeleteAllPaymentsRequest{} }
-func (m *DeleteAllPaymentsRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteAllPaymentsRequest) ProtoMessage()               {}
-func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+func (m *NodeInfo) Reset()                    { *m = NodeInfo{} }
+func (m *NodeInfo) String() string            { return proto.CompactTextString(m) }
+func (*NodeInfo) ProtoMessage()               {}
+func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
 
-type DeleteAllPaymentsResponse struct {
+func (m *NodeInfo) GetNode() *LightningNode {
+	if m != nil {
+		return m.Node
+	}
+	return nil
 }
 
-func (m *DeleteAllPaymentsResponse) Reset()                    { *m = DeleteAllPaymentsResponse{} }
-func (m *DeleteAllPaymentsResponse) String() string            { return proto.CompactTextString(m) }
-func (*DeleteAllPaymentsResponse) ProtoMessage()               {}
-func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
-
-type AbandonChannelRequest struct {
-	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
+func (m *NodeInfo) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
 }
 
-func (m *AbandonChannelRequest) Reset()                    { *m = AbandonChannelRequest{} }
-func (m *AbandonChannelRequest) String() string            { return proto.CompactTextString(m) }
-func (*AbandonChannelRequest) ProtoMessage()               {}
-func (*AbandonChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
-
-func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
+func (m *NodeInfo) GetTotalCapacity() int64 {
 	if m != nil {
-		return m.ChannelPoint
+		return m.TotalCapacity
 	}
-	return nil
+	return 0
 }
 
-type AbandonChannelResponse struct {
+func (m *NodeInfo) GetChannels() []*ChannelEdge {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
 }
 
-func (m *AbandonChannelResponse) Reset()                    { *m = AbandonChannelResponse{} }
-func (m *AbandonChannelResponse) String() string            { return proto.CompactTextString(m) }
-func (*AbandonChannelResponse) ProtoMessage()               {}
-func (*AbandonChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
-
-type DebugLevelRequest struct {
-	Show      bool   `protobuf:"varint,1,opt,name=show" json:"show,omitempty"`
-	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec" json:"level_spec,omitempty"`
+// *
+// An individual vertex/node within the channel graph. A node is
+// connected to other nodes by one or more channel edges emanating from it. As the
+// graph is directed, a node will also have an incoming edge attached to it for
+// each outgoing edge.
+type LightningNode struct {
+	LastUpdate uint32         `protobuf:"varint,1,opt,name=last_update" json:"last_update,omitempty"`
+	PubKey     string         `protobuf:"bytes,2,opt,name=pub_key" json:"pub_key,omitempty"`
+	Alias      string         `protobuf:"bytes,3,opt,name=alias" json:"alias,omitempty"`
+	Addresses  []*NodeAddress `protobuf:"bytes,4,rep,name=addresses" json:"addresses,omitempty"`
+	Color      string         `protobuf:"bytes,5,opt,name=color" json:"color,omitempty"`
+	// / The global features advertised by the node, only known once its node announcement has been received.
+	GlobalFeatures []byte `protobuf:"bytes,6,opt,name=global_features,proto3" json:"global_features,omitempty"`
 }
 
-func (m *DebugLevelRequest) Reset()                    { *m = DebugLevelRequest{} }
-func (m *DebugLevelRequest) String() string            { return proto.CompactTextString(m) }
-func (*DebugLevelRequest) ProtoMessage()               {}
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+func (m *LightningNode) Reset()                    { *m = LightningNode{} }
+func (m *LightningNode) String() string            { return proto.CompactTextString(m) }
+func (*LightningNode) ProtoMessage()               {}
+func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
 
-func (m *DebugLevelRequest) GetShow() bool {
+func (m *LightningNode) GetLastUpdate() uint32 {
 	if m != nil {
-		return m.Show
+		return m.LastUpdate
 	}
-	return false
+	return 0
 }
 
-func (m *DebugLevelRequest) GetLevelSpec() string {
+func (m *LightningNode) GetPubKey() string {
 	if m != nil {
-		return m.LevelSpec
+		return m.PubKey
 	}
 	return ""
 }
 
-type DebugLevelResponse struct {
-	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems" json:"sub_systems,omitempty"`
-}
-
-func (m *DebugLevelResponse) Reset()                    { *m = DebugLevelResponse{} }
-func (m *DebugLevelResponse) String() string            { return proto.CompactTextString(m) }
-func (*DebugLevelResponse) ProtoMessage()               {}
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
-
-func (m *DebugLevelResponse) GetSubSystems() string {
+func (m *LightningNode) GetAlias() string {
 	if m != nil {
-		return m.SubSystems
+		return m.Alias
 	}
 	return ""
 }
 
-type PayReqString struct {
-	// / The payment request string to be decoded
-	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq" json:"pay_req,omitempty"`
+func (m *LightningNode) GetAddresses() []*NodeAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
 }
 
-func (m *PayReqString) Reset()                    { *m = PayReqString{} }
-func (m *PayReqString) String() string            { return proto.CompactTextString(m) }
-func (*PayReqString) ProtoMessage()               {}
-func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
-
-func (m *PayReqString) GetPayReq() string {
+func (m *LightningNode) GetColor() string {
 	if m != nil {
-		return m.PayReq
+		return m.Color
 	}
 	return ""
 }
 
-type PayReq struct {
-	Destination     string       `protobuf:"bytes,1,opt,name=destination" json:"destination,omitempty"`
-	PaymentHash     string       `protobuf:"bytes,2,opt,name=payment_hash" json:"payment_hash,omitempty"`
-	NumSatoshis     int64        `protobuf:"varint,3,opt,name=num_satoshis" json:"num_satoshis,omitempty"`
-	Timestamp       int64        `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
-	Expiry          int64        `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
-	Description     string       `protobuf:"bytes,6,opt,name=description" json:"description,omitempty"`
-	DescriptionHash string       `protobuf:"bytes,7,opt,name=description_hash" json:"description_hash,omitempty"`
-	FallbackAddr    string       `protobuf:"bytes,8,opt,name=fallback_addr" json:"fallback_addr,omitempty"`
-	CltvExpiry      int64        `protobuf:"varint,9,opt,name=cltv_expiry" json:"cltv_expiry,omitempty"`
-	RouteHints      []*RouteHint `protobuf:"bytes,10,rep,name=route_hints" json:"route_hints,omitempty"`
+func (m *LightningNode) GetGlobalFeatures() []byte {
+	if m != nil {
+		return m.GlobalFeatures
+	}
+	return nil
 }
 
-func (m *PayReq) Reset()                    { *m = PayReq{} }
-func (m *PayReq) String() string            { return proto.CompactTextString(m) }
-func (*PayReq) ProtoMessage()               {}
-func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+type NodeAddress struct {
+	Network string `protobuf:"bytes,1,opt,name=network" json:"network,omitempty"`
+	Addr    string `protobuf:"bytes,2,opt,name=addr" json:"addr,omitempty"`
+}
 
-func (m *PayReq) GetDestination() string {
+func (m *NodeAddress) Reset()                    { *m = NodeAddress{} }
+func (m *NodeAddress) String() string            { return proto.CompactTextString(m) }
+func (*NodeAddress) ProtoMessage()               {}
+func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+
+func (m *NodeAddress) GetNetwork() string {
 	if m != nil {
-		return m.Destination
+		return m.Network
 	}
 	return ""
 }
 
-func (m *PayReq) GetPaymentHash() string {
+func (m *NodeAddress) GetAddr() string {
 	if m != nil {
-		return m.PaymentHash
+		return m.Addr
 	}
 	return ""
 }
 
-func (m *PayReq) GetNumSatoshis() int64 {
-	if m != nil {
-		return m.NumSatoshis
-	}
-	return 0
+type RoutingPolicy struct {
+	TimeLockDelta    uint32 `protobuf:"varint,1,opt,name=time_lock_delta" json:"time_lock_delta,omitempty"`
+	MinHtlc          int64  `protobuf:"varint,2,opt,name=min_htlc" json:"min_htlc,omitempty"`
+	FeeBaseMsat      int64  `protobuf:"varint,3,opt,name=fee_base_msat" json:"fee_base_msat,omitempty"`
+	FeeRateMilliMsat int64  `protobuf:"varint,4,opt,name=fee_rate_milli_msat" json:"fee_rate_milli_msat,omitempty"`
+	Disabled         bool   `protobuf:"varint,5,opt,name=disabled" json:"disabled,omitempty"`
+	MaxHtlcMsat      uint64 `protobuf:"varint,6,opt,name=max_htlc_msat" json:"max_htlc_msat,omitempty"`
 }
 
-func (m *PayReq) GetTimestamp() int64 {
+func (m *RoutingPolicy) Reset()                    { *m = RoutingPolicy{} }
+func (m *RoutingPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RoutingPolicy) ProtoMessage()               {}
+func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+
+func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
 	if m != nil {
-		return m.Timestamp
+		return m.TimeLockDelta
 	}
 	return 0
 }
 
-func (m *PayReq) GetExpiry() int64 {
+func (m *RoutingPolicy) GetMinHtlc() int64 {
 	if m != nil {
-		return m.Expiry
+		return m.MinHtlc
 	}
 	return 0
 }
 
-func (m *PayReq) GetDescription() string {
+func (m *RoutingPolicy) GetFeeBaseMsat() int64 {
 	if m != nil {
-		return m.Description
+		return m.FeeBaseMsat
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetDescriptionHash() string {
+func (m *RoutingPolicy) GetFeeRateMilliMsat() int64 {
 	if m != nil {
-		return m.DescriptionHash
+		return m.FeeRateMilliMsat
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetFallbackAddr() string {
+func (m *RoutingPolicy) GetDisabled() bool {
 	if m != nil {
-		return m.FallbackAddr
+		return m.Disabled
 	}
-	return ""
+	return false
 }
 
-func (m *PayReq) GetCltvExpiry() int64 {
+func (m *RoutingPolicy) GetMaxHtlcMsat() uint64 {
 	if m != nil {
-		return m.CltvExpiry
+		return m.MaxHtlcMsat
 	}
 	return 0
 }
 
-func (m *PayReq) GetRouteHints() []*RouteHint {
-	if m != nil {
-		return m.RouteHints
-	}
-	return nil
-}
-
-type FeeReportRequest struct {
-}
-
-func (m *FeeReportRequest) Reset()                    { *m = FeeReportRequest{} }
-func (m *FeeReportRequest) String() string            { return proto.CompactTextString(m) }
-func (*FeeReportRequest) ProtoMessage()               {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
-
-type ChannelFeeReport struct {
-	// / The channel that this fee report belongs to.
-	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point" json:"chan_point,omitempty"`
-	// / The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat" json:"base_fee_msat,omitempty"`
-	// / The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
-	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil" json:"fee_per_mil,omitempty"`
-	// / The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
-	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate" json:"fee_rate,omitempty"`
+// *
+// A fully authenticated channel along with all its unique attributes.
+// Once an authenticated channel announcement has been processed on the network,
+// then an instance of ChannelEdgeInfo encapsulating the channels attributes is
+// stored. The other portions relevant to routing policy of a channel are stored
+// within a ChannelEdgePolicy for each direction of the channel.
+type ChannelEdge struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChannelId   uint64         `protobuf:"varint,1,opt,name=channel_id" json:"channel_id,omitempty"`
+	ChanPoint   string         `protobuf:"bytes,2,opt,name=chan_point" json:"chan_point,omitempty"`
+	LastUpdate  uint32         `protobuf:"varint,3,opt,name=last_update" json:"last_update,omitempty"`
+	Node1Pub    string         `protobuf:"bytes,4,opt,name=node1_pub" json:"node1_pub,omitempty"`
+	Node2Pub    string         `protobuf:"bytes,5,opt,name=node2_pub" json:"node2_pub,omitempty"`
+	Capacity    int64          `protobuf:"varint,6,opt,name=capacity" json:"capacity,omitempty"`
+	Node1Policy *RoutingPolicy `protobuf:"bytes,7,opt,name=node1_policy" json:"node1_policy,omitempty"`
+	Node2Policy *RoutingPolicy `protobuf:"bytes,8,opt,name=node2_policy" json:"node2_policy,omitempty"`
 }
 
-func (m *ChannelFeeReport) Reset()                    { *m = ChannelFeeReport{} }
-func (m *ChannelFeeReport) String() string            { return proto.CompactTextString(m) }
-func (*ChannelFeeReport) ProtoMessage()               {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+func (m *ChannelEdge) Reset()                    { *m = ChannelEdge{} }
+func (m *ChannelEdge) String() string            { return proto.CompactTextString(m) }
+func (*ChannelEdge) ProtoMessage()               {}
+func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
 
-func (m *ChannelFeeReport) GetChanPoint() string {
+func (m *ChannelEdge) GetChannelId() uint64 {
 	if m != nil {
-		return m.ChanPoint
+		return m.ChannelId
 	}
-	return ""
+	return 0
 }
 
-func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
+func (m *ChannelEdge) GetChanPoint() string {
 	if m != nil {
-		return m.BaseFeeMsat
+		return m.ChanPoint
 	}
-	return 0
+	return ""
 }
 
-func (m *ChannelFeeReport) GetFeePerMil() int64 {
+func (m *ChannelEdge) GetLastUpdate() uint32 {
 	if m != nil {
-		return m.FeePerMil
+		return m.LastUpdate
 	}
 	return 0
 }
 
-func (m *ChannelFeeReport) GetFeeRate() float64 {
+func (m *ChannelEdge) GetNode1Pub() string {
 	if m != nil {
-		return m.FeeRate
+		return m.Node1Pub
 	}
-	return 0
-}
-
-type FeeReportResponse struct {
-	// / An array of channel fee reports which describes the current fee schedule for each channel.
-	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees" json:"channel_fees,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
-	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum" json:"day_fee_sum,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
-	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum" json:"week_fee_sum,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
-	MonthFeeSum uint64 `protobuf:"varint,4,opt,name=month_fee_sum" json:"month_fee_sum,omitempty"`
+	return ""
 }
 
-func (m *FeeReportResponse) Reset()                    { *m = FeeReportResponse{} }
-func (m *FeeReportResponse) String() string            { return proto.CompactTextString(m) }
-func (*FeeReportResponse) ProtoMessage()               {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
-
-func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
+func (m *ChannelEdge) GetNode2Pub() string {
 	if m != nil {
-		return m.ChannelFees
+		return m.Node2Pub
 	}
-	return nil
+	return ""
 }
 
-func (m *FeeReportResponse) GetDayFeeSum() uint64 {
+func (m *ChannelEdge) GetCapacity() int64 {
 	if m != nil {
-		return m.DayFeeSum
+		return m.Capacity
 	}
 	return 0
 }
 
-func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
+func (m *ChannelEdge) GetNode1Policy() *RoutingPolicy {
 	if m != nil {
-		return m.WeekFeeSum
+		return m.Node1Policy
 	}
-	return 0
+	return nil
 }
 
-func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
+func (m *ChannelEdge) GetNode2Policy() *RoutingPolicy {
 	if m != nil {
-		return m.MonthFeeSum
+		return m.Node2Policy
 	}
-	return 0
+	return nil
 }
 
-type PolicyUpdateRequest struct {
-	// Types that are valid to be assigned to Scope:
-	//	*PolicyUpdateRequest_Global
-	//	*PolicyUpdateRequest_ChanPoint
-	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
-	// / The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat" json:"base_fee_msat,omitempty"`
-	// / The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
-	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate" json:"fee_rate,omitempty"`
-	// / The required timelock delta for HTLCs forwarded over the channel.
-	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta" json:"time_lock_delta,omitempty"`
+type ChannelGraphRequest struct {
+	// *
+	// Whether unannounced channels are included in the response or not. If set,
+	// unannounced channels are included. Unannounced channels are both private
+	// channels, and public channels that are not yet announced to the network.
+	IncludeUnannounced bool `protobuf:"varint,1,opt,name=include_unannounced" json:"include_unannounced,omitempty"`
 }
 
-func (m *PolicyUpdateRequest) Reset()                    { *m = PolicyUpdateRequest{} }
-func (m *PolicyUpdateRequest) String() string            { return proto.CompactTextString(m) }
-func (*PolicyUpdateRequest) ProtoMessage()               {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
-
-type isPolicyUpdateRequest_Scope interface{ isPolicyUpdateRequest_Scope() }
+func (m *ChannelGraphRequest) Reset()                    { *m = ChannelGraphRequest{} }
+func (m *ChannelGraphRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChannelGraphRequest) ProtoMessage()               {}
+func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
 
-type PolicyUpdateRequest_Global struct {
-	Global bool `protobuf:"varint,1,opt,name=global,oneof"`
+func (m *ChannelGraphRequest) GetIncludeUnannounced() bool {
+	if m != nil {
+		return m.IncludeUnannounced
+	}
+	return false
 }
-type PolicyUpdateRequest_ChanPoint struct {
-	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,oneof"`
+
+// / Returns a new instance of the directed channel graph.
+type ChannelGraph struct {
+	// / The list of `LightningNode`s in this channel graph
+	Nodes []*LightningNode `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+	// / The list of `ChannelEdge`s in this channel graph
+	Edges []*ChannelEdge `protobuf:"bytes,2,rep,name=edges" json:"edges,omitempty"`
 }
 
-func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope()    {}
-func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
+func (m *ChannelGraph) Reset()                    { *m = ChannelGraph{} }
+func (m *ChannelGraph) String() string            { return proto.CompactTextString(m) }
+func (*ChannelGraph) ProtoMessage()               {}
+func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
 
-func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
+func (m *ChannelGraph) GetNodes() []*LightningNode {
 	if m != nil {
-		return m.Scope
+		return m.Nodes
 	}
 	return nil
 }
 
-func (m *PolicyUpdateRequest) GetGlobal() bool {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
-		return x.Global
+func (m *ChannelGraph) GetEdges() []*ChannelEdge {
+	if m != nil {
+		return m.Edges
 	}
-	return false
+	return nil
 }
 
-func (m *PolicyUpdateRequest) GetChanPoint() *ChannelPoint {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_ChanPoint); ok {
-		return x.ChanPoint
-	}
-	return nil
+type ChanInfoRequest struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
 }
 
-func (m *PolicyUpdateRequest) GetBaseFeeMsat() int64 {
+func (m *ChanInfoRequest) Reset()                    { *m = ChanInfoRequest{} }
+func (m *ChanInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChanInfoRequest) ProtoMessage()               {}
+func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+
+func (m *ChanInfoRequest) GetChanId() uint64 {
 	if m != nil {
-		return m.BaseFeeMsat
+		return m.ChanId
 	}
 	return 0
 }
 
-func (m *PolicyUpdateRequest) GetFeeRate() float64 {
+type NetworkInfoRequest struct {
+}
+
+func (m *NetworkInfoRequest) Reset()                    { *m = NetworkInfoRequest{} }
+func (m *NetworkInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfoRequest) ProtoMessage()               {}
+func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
+
+type NetworkInfo struct {
+	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter" json:"graph_diameter,omitempty"`
+	AvgOutDegree         float64 `protobuf:"fixed64,2,opt,name=avg_out_degree" json:"avg_out_degree,omitempty"`
+	MaxOutDegree         uint32  `protobuf:"varint,3,opt,name=max_out_degree" json:"max_out_degree,omitempty"`
+	NumNodes             uint32  `protobuf:"varint,4,opt,name=num_nodes" json:"num_nodes,omitempty"`
+	NumChannels          uint32  `protobuf:"varint,5,opt,name=num_channels" json:"num_channels,omitempty"`
+	TotalNetworkCapacity int64   `protobuf:"varint,6,opt,name=total_network_capacity" json:"total_network_capacity,omitempty"`
+	AvgChannelSize       float64 `protobuf:"fixed64,7,opt,name=avg_channel_size" json:"avg_channel_size,omitempty"`
+	MinChannelSize       int64   `protobuf:"varint,8,opt,name=min_channel_size" json:"min_channel_size,omitempty"`
+	MaxChannelSize       int64   `protobuf:"varint,9,opt,name=max_channel_size" json:"max_channel_size,omitempty"`
+	// / The number of channels that are considered zombies, as neither of their ends has been updated recently, and will be pruned from the graph.
+	NumZombieChans uint64 `protobuf:"varint,10,opt,name=num_zombie_chans" json:"num_zombie_chans,omitempty"`
+}
+
+func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
+func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfo) ProtoMessage()               {}
+func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
+
+func (m *NetworkInfo) GetGraphDiameter() uint32 {
 	if m != nil {
-		return m.FeeRate
+		return m.GraphDiameter
 	}
 	return 0
 }
 
-func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
+func (m *NetworkInfo) GetAvgOutDegree() float64 {
 	if m != nil {
-		return m.TimeLockDelta
+		return m.AvgOutDegree
 	}
 	return 0
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*PolicyUpdateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _PolicyUpdateRequest_OneofMarshaler, _PolicyUpdateRequest_OneofUnmarshaler, _PolicyUpdateRequest_OneofSizer, []interface{}{
-		(*PolicyUpdateRequest_Global)(nil),
-		(*PolicyUpdateRequest_ChanPoint)(nil),
+func (m *NetworkInfo) GetMaxOutDegree() uint32 {
+	if m != nil {
+		return m.MaxOutDegree
 	}
+	return 0
 }
 
-func _PolicyUpdateRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*PolicyUpdateRequest)
-	// scope
-	switch x := m.Scope.(type) {
-	case *PolicyUpdateRequest_Global:
-		t := uint64(0)
-		if x.Global {
-			t = 1
-		}
-		b.EncodeVarint(1<<3 | proto.WireVarint)
-		b.EncodeVarint(t)
-	case *PolicyUpdateRequest_ChanPoint:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanPoint); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("PolicyUpdateRequest.Scope has unexpected type %T", x)
+func (m *NetworkInfo) GetNumNodes() uint32 {
+	if m != nil {
+		return m.NumNodes
 	}
-	return nil
+	return 0
 }
 
-func _PolicyUpdateRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*PolicyUpdateRequest)
-	switch tag {
-	case 1: // scope.global
-		if wire != proto.WireVarint {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeVarint()
-		m.Scope = &PolicyUpdateRequest_Global{x != 0}
-		return true, err
-	case 2: // scope.chan_point
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelPoint)
-		err := b.DecodeMessage(msg)
-		m.Scope = &PolicyUpdateRequest_ChanPoint{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *NetworkInfo) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
 	}
+	return 0
 }
 
-func _PolicyUpdateRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*PolicyUpdateRequest)
-	// scope
-	switch x := m.Scope.(type) {
-	case *PolicyUpdateRequest_Global:
-		n += proto.SizeVarint(1<<3 | proto.WireVarint)
-		n += 1
-	case *PolicyUpdateRequest_ChanPoint:
-		s := proto.Size(x.ChanPoint)
-		n += proto.SizeVarint(2<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *NetworkInfo) GetTotalNetworkCapacity() int64 {
+	if m != nil {
+		return m.TotalNetworkCapacity
 	}
-	return n
-}
-
-type PolicyUpdateResponse struct {
-}
-
-func (m *PolicyUpdateResponse) Reset()                    { *m = PolicyUpdateResponse{} }
-func (m *PolicyUpdateResponse) String() string            { return proto.CompactTextString(m) }
-func (*PolicyUpdateResponse) ProtoMessage()               {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
-
-type ForwardingHistoryRequest struct {
-	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
-	StartTime uint64 `protobuf:"varint,1,opt,name=start_time" json:"start_time,omitempty"`
-	// / End time is the end point of the forwarding history request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
-	EndTime uint64 `protobuf:"varint,2,opt,name=end_time" json:"end_time,omitempty"`
-	// / Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
-	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset" json:"index_offset,omitempty"`
-	// / The max number of events to return in the response to this query.
-	NumMaxEvents uint32 `protobuf:"varint,4,opt,name=num_max_events" json:"num_max_events,omitempty"`
+	return 0
 }
 
-func (m *ForwardingHistoryRequest) Reset()                    { *m = ForwardingHistoryRequest{} }
-func (m *ForwardingHistoryRequest) String() string            { return proto.CompactTextString(m) }
-func (*ForwardingHistoryRequest) ProtoMessage()               {}
-func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
-
-func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
+func (m *NetworkInfo) GetAvgChannelSize() float64 {
 	if m != nil {
-		return m.StartTime
+		return m.AvgChannelSize
 	}
 	return 0
 }
 
-func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
+func (m *NetworkInfo) GetMinChannelSize() int64 {
 	if m != nil {
-		return m.EndTime
+		return m.MinChannelSize
 	}
 	return 0
 }
 
-func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+func (m *NetworkInfo) GetMaxChannelSize() int64 {
 	if m != nil {
-		return m.IndexOffset
+		return m.MaxChannelSize
 	}
 	return 0
 }
 
-func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
+func (m *NetworkInfo) GetNumZombieChans() uint64 {
 	if m != nil {
-		return m.NumMaxEvents
+		return m.NumZombieChans
 	}
 	return 0
 }
 
-type ForwardingEvent struct {
-	// / Timestamp is the time (unix epoch offset) that this circuit was completed.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
-	// / The incoming channel ID that carried the HTLC that created the circuit.
-	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in" json:"chan_id_in,omitempty"`
-	// / The outgoing channel ID that carried the preimage that completed the circuit.
-	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out" json:"chan_id_out,omitempty"`
-	// / The total amount of the incoming HTLC that created half the circuit.
-	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in" json:"amt_in,omitempty"`
-	// / The total amount of the outgoign HTLC that created the second half of the circuit.
-	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out" json:"amt_out,omitempty"`
-	// / The total fee that this payment circuit carried.
-	Fee uint64 `protobuf:"varint,7,opt,name=fee" json:"fee,omitempty"`
+type StopRequest struct {
 }
 
-func (m *ForwardingEvent) Reset()                    { *m = ForwardingEvent{} }
-func (m *ForwardingEvent) String() string            { return proto.CompactTextString(m) }
-func (*ForwardingEvent) ProtoMessage()               {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
+func (m *StopRequest) Reset()                    { *m = StopRequest{} }
+func (m *StopRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopRequest) ProtoMessage()               {}
+func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
 
-func (m *ForwardingEvent) GetTimestamp() uint64 {
-	if m != nil {
-		return m.Timestamp
-	}
-	return 0
+type StopResponse struct {
 }
 
-func (m *ForwardingEvent) GetChanIdIn() uint64 {
-	if m != nil {
-		return m.ChanIdIn
-	}
-	return 0
+func (m *StopResponse) Reset()                    { *m = StopResponse{} }
+func (m *StopResponse) String() string            { return proto.CompactTextString(m) }
+func (*StopResponse) ProtoMessage()               {}
+func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{110} }
+
+type GraphTopologySubscription struct {
 }
 
-func (m *ForwardingEvent) GetChanIdOut() uint64 {
-	if m != nil {
-		return m.ChanIdOut
-	}
-	return 0
+func (m *GraphTopologySubscription) Reset()                    { *m = GraphTopologySubscription{} }
+func (m *GraphTopologySubscription) String() string            { return proto.CompactTextString(m) }
+func (*GraphTopologySubscription) ProtoMessage()               {}
+func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{111} }
+
+type GraphTopologyUpdate struct {
+	NodeUpdates    []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates" json:"node_updates,omitempty"`
+	ChannelUpdates []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates" json:"channel_updates,omitempty"`
+	ClosedChans    []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans" json:"closed_chans,omitempty"`
 }
 
-func (m *ForwardingEvent) GetAmtIn() uint64 {
+func (m *GraphTopologyUpdate) Reset()                    { *m = GraphTopologyUpdate{} }
+func (m *GraphTopologyUpdate) String() string            { return proto.CompactTextString(m) }
+func (*GraphTopologyUpdate) ProtoMessage()               {}
+func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{112} }
+
+func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
 	if m != nil {
-		return m.AmtIn
+		return m.NodeUpdates
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingEvent) GetAmtOut() uint64 {
+func (m *GraphTopologyUpdate) GetChannelUpdates() []*ChannelEdgeUpdate {
 	if m != nil {
-		return m.AmtOut
+		return m.ChannelUpdates
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingEvent) GetFee() uint64 {
+func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
 	if m != nil {
-		return m.Fee
+		return m.ClosedChans
 	}
-	return 0
+	return nil
 }
 
-type ForwardingHistoryResponse struct {
-	// / A list of forwarding events from the time slice of the time series specified in the request.
-	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events" json:"forwarding_events,omitempty"`
-	// / The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
-	LastOffsetIndex uint32 `protobuf:"varint,2,opt,name=last_offset_index" json:"last_offset_index,omitempty"`
+type NodeUpdate struct {
+	Addresses      []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	IdentityKey    string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey" json:"identity_key,omitempty"`
+	GlobalFeatures []byte   `protobuf:"bytes,3,opt,name=global_features,json=globalFeatures,proto3" json:"global_features,omitempty"`
+	Alias          string   `protobuf:"bytes,4,opt,name=alias" json:"alias,omitempty"`
 }
 
-func (m *ForwardingHistoryResponse) Reset()                    { *m = ForwardingHistoryResponse{} }
-func (m *ForwardingHistoryResponse) String() string            { return proto.CompactTextString(m) }
-func (*ForwardingHistoryResponse) ProtoMessage()               {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
+func (m *NodeUpdate) Reset()                    { *m = NodeUpdate{} }
+func (m *NodeUpdate) String() string            { return proto.CompactTextString(m) }
+func (*NodeUpdate) ProtoMessage()               {}
+func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113} }
 
-func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+func (m *NodeUpdate) GetAddresses() []string {
 	if m != nil {
-		return m.ForwardingEvents
+		return m.Addresses
 	}
 	return nil
 }
 
-func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
+func (m *NodeUpdate) GetIdentityKey() string {
 	if m != nil {
-		return m.LastOffsetIndex
+		return m.IdentityKey
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
-	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
-	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
-	proto.RegisterType((*InitWalletResponse)(nil), "lnrpc.InitWalletResponse")
-	proto.RegisterType((*UnlockWalletRequest)(nil), "lnrpc.UnlockWalletRequest")
-	proto.RegisterType((*UnlockWalletResponse)(nil), "lnrpc.UnlockWalletResponse")
-	proto.RegisterType((*ChangePasswordRequest)(nil), "lnrpc.ChangePasswordRequest")
-	proto.RegisterType((*ChangePasswordResponse)(nil), "lnrpc.ChangePasswordResponse")
-	proto.RegisterType((*Utxo)(nil), "lnrpc.Utxo")
-	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
-	proto.RegisterType((*GetTransactionsRequest)(nil), "lnrpc.GetTransactionsRequest")
-	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
-	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
-	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
-	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
-	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
-	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
-	proto.RegisterType((*LightningAddress)(nil), "lnrpc.LightningAddress")
-	proto.RegisterType((*SendManyRequest)(nil), "lnrpc.SendManyRequest")
-	proto.RegisterType((*SendManyResponse)(nil), "lnrpc.SendManyResponse")
-	proto.RegisterType((*SendCoinsRequest)(nil), "lnrpc.SendCoinsRequest")
-	proto.RegisterType((*SendCoinsResponse)(nil), "lnrpc.SendCoinsResponse")
-	proto.RegisterType((*ListUnspentRequest)(nil), "lnrpc.ListUnspentRequest")
-	proto.RegisterType((*ListUnspentResponse)(nil), "lnrpc.ListUnspentResponse")
-	proto.RegisterType((*NewAddressRequest)(nil), "lnrpc.NewAddressRequest")
-	proto.RegisterType((*NewAddressResponse)(nil), "lnrpc.NewAddressResponse")
-	proto.RegisterType((*SignMessageRequest)(nil), "lnrpc.SignMessageRequest")
-	proto.RegisterType((*SignMessageResponse)(nil), "lnrpc.SignMessageResponse")
-	proto.RegisterType((*VerifyMessageRequest)(nil), "lnrpc.VerifyMessageRequest")
-	proto.RegisterType((*VerifyMessageResponse)(nil), "lnrpc.VerifyMessageResponse")
-	proto.RegisterType((*ConnectPeerRequest)(nil), "lnrpc.ConnectPeerRequest")
-	proto.RegisterType((*ConnectPeerResponse)(nil), "lnrpc.ConnectPeerResponse")
-	proto.RegisterType((*DisconnectPeerRequest)(nil), "lnrpc.DisconnectPeerRequest")
-	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
-	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
-	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
-	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
-	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
-	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
-	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
-	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
-	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
-	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
-	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
-	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
-	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
-	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
-	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
-	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
-	proto.RegisterType((*CloseChannelRequest)(nil), "lnrpc.CloseChannelRequest")
-	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
-	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
-	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
-	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
-	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
-	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
-	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
-	proto.RegisterType((*PendingChannelsResponse_PendingChannel)(nil), "lnrpc.PendingChannelsResponse.PendingChannel")
-	proto.RegisterType((*PendingChannelsResponse_PendingOpenChannel)(nil), "lnrpc.PendingChannelsResponse.PendingOpenChannel")
-	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
-	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
-	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
-	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
-	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
-	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
-	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
-	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
-	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
-	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
-	proto.RegisterType((*Route)(nil), "lnrpc.Route")
-	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
-	proto.RegisterType((*NodeInfo)(nil), "lnrpc.NodeInfo")
-	proto.RegisterType((*LightningNode)(nil), "lnrpc.LightningNode")
-	proto.RegisterType((*NodeAddress)(nil), "lnrpc.NodeAddress")
-	proto.RegisterType((*RoutingPolicy)(nil), "lnrpc.RoutingPolicy")
-	proto.RegisterType((*ChannelEdge)(nil), "lnrpc.ChannelEdge")
-	proto.RegisterType((*ChannelGraphRequest)(nil), "lnrpc.ChannelGraphRequest")
-	proto.RegisterType((*ChannelGraph)(nil), "lnrpc.ChannelGraph")
-	proto.RegisterType((*ChanInfoRequest)(nil), "lnrpc.ChanInfoRequest")
-	proto.RegisterType((*NetworkInfoRequest)(nil), "lnrpc.NetworkInfoRequest")
-	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
-	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
-	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
-	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
-	proto.RegisterType((*GraphTopologyUpdate)(nil), "lnrpc.GraphTopologyUpdate")
-	proto.RegisterType((*NodeUpdate)(nil), "lnrpc.NodeUpdate")
-	proto.RegisterType((*ChannelEdgeUpdate)(nil), "lnrpc.ChannelEdgeUpdate")
-	proto.RegisterType((*ClosedChannelUpdate)(nil), "lnrpc.ClosedChannelUpdate")
-	proto.RegisterType((*HopHint)(nil), "lnrpc.HopHint")
-	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
-	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
-	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
-	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
-	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
-	proto.RegisterType((*ListInvoiceResponse)(nil), "lnrpc.ListInvoiceResponse")
-	proto.RegisterType((*InvoiceSubscription)(nil), "lnrpc.InvoiceSubscription")
-	proto.RegisterType((*Payment)(nil), "lnrpc.Payment")
-	proto.RegisterType((*ListPaymentsRequest)(nil), "lnrpc.ListPaymentsRequest")
-	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
-	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
-	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
-	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
-	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
-	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
-	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
-	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
-	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
-	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
-	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
-	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
-	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
-	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
-	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
-	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
-	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
-	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
-	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+func (m *NodeUpdate) GetGlobalFeatures() []byte {
+	if m != nil {
+		return m.GlobalFeatures
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for WalletUnlocker service
+func (m *NodeUpdate) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
 
-type WalletUnlockerClient interface {
-	// *
-	// GenSeed is the first method that should be used to instantiate a new lnd
-	// instance. This method allows a caller to generate a new aezeed cipher seed
-	// given an optional passphrase. If provided, the passphrase will be necessary
-	// to decrypt the cipherseed to expose the internal wallet seed.
-	//
-	// Once the cipherseed is obtained and verified by the user, the InitWallet
-	// method should be used to commit the newly generated seed, and create the
-	// wallet.
-	GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error)
+type ChannelEdgeUpdate struct {
 	// *
-	// InitWallet is used when lnd is starting up for the first time to fully
-	// initialize the daemon and its internal wallet. At the very least a wallet
-	// password must be provided. This will be used to encrypt sensitive material
-	// on disk.
-	//
-	// In the case of a recovery scenario, the user can also specify their aezeed
-	// mnemonic and passphrase. If set, then the daemon will use this prior state
-	// to initialize its internal wallet.
-	//
-	// Alternatively, this can be used along with the GenSeed RPC to obtain a
-	// seed, then present it to the user. Once it has been verified by the user,
-	// the seed can be fed into this RPC in order to commit the new wallet.
-	InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error)
-	// * lncli: `unlock`
-	// UnlockWallet is used at startup of lnd to provide a password to unlock
-	// the wallet database.
-	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
-	// * lncli: `changepassword`
-	// ChangePassword changes the password of the encrypted wallet. This will
-	// automatically unlock the wallet database if successful.
-	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId          uint64         `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
+	ChanPoint       *ChannelPoint  `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint" json:"chan_point,omitempty"`
+	Capacity        int64          `protobuf:"varint,3,opt,name=capacity" json:"capacity,omitempty"`
+	RoutingPolicy   *RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy" json:"routing_policy,omitempty"`
+	AdvertisingNode string         `protobuf:"bytes,5,opt,name=advertising_node,json=advertisingNode" json:"advertising_node,omitempty"`
+	ConnectingNode  string         `protobuf:"bytes,6,opt,name=connecting_node,json=connectingNode" json:"connecting_node,omitempty"`
 }
 
-type walletUnlockerClient struct {
-	cc *grpc.ClientConn
-}
+func (m *ChannelEdgeUpdate) Reset()                    { *m = ChannelEdgeUpdate{} }
+func (m *ChannelEdgeUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ChannelEdgeUpdate) ProtoMessage()               {}
+func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{114} }
 
-func NewWalletUnlockerClient(cc *grpc.ClientConn) WalletUnlockerClient {
-	return &walletUnlockerClient{cc}
+func (m *ChannelEdgeUpdate) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
 }
 
-func (c *walletUnlockerClient) GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error) {
-	out := new(GenSeedResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/GenSeed", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelEdgeUpdate) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletUnlockerClient) InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error) {
-	out := new(InitWalletResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/InitWallet", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelEdgeUpdate) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletUnlockerClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
-	out := new(UnlockWalletResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/UnlockWallet", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelEdgeUpdate) GetRoutingPolicy() *RoutingPolicy {
+	if m != nil {
+		return m.RoutingPolicy
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletUnlockerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
-	out := new(ChangePasswordResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/ChangePassword", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChannelEdgeUpdate) GetAdvertisingNode() string {
+	if m != nil {
+		return m.AdvertisingNode
 	}
-	return out, nil
+	return ""
 }
 
-// Server API for WalletUnlocker service
+func (m *ChannelEdgeUpdate) GetConnectingNode() string {
+	if m != nil {
+		return m.ConnectingNode
+	}
+	return ""
+}
 
-type WalletUnlockerServer interface {
-	// *
-	// GenSeed is the first method that should be used to instantiate a new lnd
-	// instance. This method allows a caller to generate a new aezeed cipher seed
-	// given an optional passphrase. If provided, the passphrase will be necessary
-	// to decrypt the cipherseed to expose the internal wallet seed.
-	//
-	// Once the cipherseed is obtained and verified by the user, the InitWallet
-	// method should be used to commit the newly generated seed, and create the
-	// wallet.
-	GenSeed(context.Context, *GenSeedRequest) (*GenSeedResponse, error)
+type ClosedChannelUpdate struct {
 	// *
-	// InitWallet is used when lnd is starting up for the first time to fully
-	// initialize the daemon and its internal wallet. At the very least a wallet
-	// password must be provided. This will be used to encrypt sensitive material
-	// on disk.
-	//
-	// In the case of a recovery scenario, the user can also specify their aezeed
-	// mnemonic and passphrase. If set, then the daemon will use this prior state
-	// to initialize its internal wallet.
-	//
-	// Alternatively, this can be used along with the GenSeed RPC to obtain a
-	// seed, then present it to the user. Once it has been verified by the user,
-	// the seed can be fed into this RPC in order to commit the new wallet.
-	InitWallet(context.Context, *InitWalletRequest) (*InitWalletResponse, error)
-	// * lncli: `unlock`
-	// UnlockWallet is used at startup of lnd to provide a password to unlock
-	// the wallet database.
-	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
-	// * lncli: `changepassword`
-	// ChangePassword changes the password of the encrypted wallet. This will
-	// automatically unlock the wallet database if successful.
-	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId       uint64        `protobuf:"varint,1,opt,name=chan_id,json=chanId" json:"chan_id,omitempty"`
+	Capacity     int64         `protobuf:"varint,2,opt,name=capacity" json:"capacity,omitempty"`
+	ClosedHeight uint32        `protobuf:"varint,3,opt,name=closed_height,json=closedHeight" json:"closed_height,omitempty"`
+	ChanPoint    *ChannelPoint `protobuf:"bytes,4,opt,name=chan_point,json=chanPoint" json:"chan_point,omitempty"`
 }
 
-func RegisterWalletUnlockerServer(s *grpc.Server, srv WalletUnlockerServer) {
-	s.RegisterService(&_WalletUnlocker_serviceDesc, srv)
-}
+func (m *ClosedChannelUpdate) Reset()                    { *m = ClosedChannelUpdate{} }
+func (m *ClosedChannelUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ClosedChannelUpdate) ProtoMessage()               {}
+func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
 
-func _WalletUnlocker_GenSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenSeedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).GenSeed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/GenSeed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).GenSeed(ctx, req.(*GenSeedRequest))
+func (m *ClosedChannelUpdate) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _WalletUnlocker_InitWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InitWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).InitWallet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/InitWallet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).InitWallet(ctx, req.(*InitWalletRequest))
+func (m *ClosedChannelUpdate) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _WalletUnlocker_UnlockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).UnlockWallet(ctx, in)
+func (m *ClosedChannelUpdate) GetClosedHeight() uint32 {
+	if m != nil {
+		return m.ClosedHeight
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/UnlockWallet",
+	return 0
+}
+
+func (m *ClosedChannelUpdate) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).UnlockWallet(ctx, req.(*UnlockWalletRequest))
+	return nil
+}
+
+type HopHint struct {
+	// / The public key of the node at the start of the channel.
+	NodeId string `protobuf:"bytes,1,opt,name=node_id" json:"node_id,omitempty"`
+	// / The unique identifier of the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The base fee of the channel denominated in millisatoshis.
+	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat" json:"fee_base_msat,omitempty"`
+	// *
+	// The fee rate of the channel for sending one satoshi across it denominated in
+	// millionths of a satoshi.
+	FeeProportionalMillionths uint32 `protobuf:"varint,4,opt,name=fee_proportional_millionths" json:"fee_proportional_millionths,omitempty"`
+	// / The time-lock delta of the channel.
+	CltvExpiryDelta uint32 `protobuf:"varint,5,opt,name=cltv_expiry_delta" json:"cltv_expiry_delta,omitempty"`
+}
+
+func (m *HopHint) Reset()                    { *m = HopHint{} }
+func (m *HopHint) String() string            { return proto.CompactTextString(m) }
+func (*HopHint) ProtoMessage()               {}
+func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
+
+func (m *HopHint) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _WalletUnlocker_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *HopHint) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
 	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).ChangePassword(ctx, in)
+	return 0
+}
+
+func (m *HopHint) GetFeeBaseMsat() uint32 {
+	if m != nil {
+		return m.FeeBaseMsat
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/ChangePassword",
+	return 0
+}
+
+func (m *HopHint) GetFeeProportionalMillionths() uint32 {
+	if m != nil {
+		return m.FeeProportionalMillionths
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	return 0
+}
+
+func (m *HopHint) GetCltvExpiryDelta() uint32 {
+	if m != nil {
+		return m.CltvExpiryDelta
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _WalletUnlocker_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lnrpc.WalletUnlocker",
-	HandlerType: (*WalletUnlockerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GenSeed",
-			Handler:    _WalletUnlocker_GenSeed_Handler,
-		},
-		{
-			MethodName: "InitWallet",
-			Handler:    _WalletUnlocker_InitWallet_Handler,
-		},
-		{
-			MethodName: "UnlockWallet",
-			Handler:    _WalletUnlocker_UnlockWallet_Handler,
-		},
-		{
-			MethodName: "ChangePassword",
-			Handler:    _WalletUnlocker_ChangePassword_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "rpc.proto",
+type RouteHint struct {
+	// *
+	// A list of hop hints that when chained together can assist in reaching a
+	// specific destination.
+	HopHints []*HopHint `protobuf:"bytes,1,rep,name=hop_hints" json:"hop_hints,omitempty"`
 }
 
-// Client API for Lightning service
+func (m *RouteHint) Reset()                    { *m = RouteHint{} }
+func (m *RouteHint) String() string            { return proto.CompactTextString(m) }
+func (*RouteHint) ProtoMessage()               {}
+func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
 
-type LightningClient interface {
-	// * lncli: `walletbalance`
-	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
-	// confirmed unspent outputs and all unconfirmed unspent outputs under control
-	// of the wallet.
-	WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error)
-	// * lncli: `channelbalance`
-	// ChannelBalance returns the total funds available across all open channels
-	// in satoshis.
-	ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error)
-	// * lncli: `listchaintxns`
-	// GetTransactions returns a list describing all the known transactions
-	// relevant to the wallet.
-	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error)
-	// * lncli: `sendcoins`
-	// SendCoins executes a request to send coins to a particular address. Unlike
-	// SendMany, this RPC call only allows creating a single output at a time. If
-	// neither target_conf, or sat_per_byte are set, then the internal wallet will
-	// consult its fee model to determine a fee for the default confirmation
-	// target.
-	SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error)
-	// * lncli: `listunspent`
-	// ListUnspent returns a list of all utxos spendable by the wallet with a
-	// number of confirmations between the specified minimum and maximum.
-	ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error)
+func (m *RouteHint) GetHopHints() []*HopHint {
+	if m != nil {
+		return m.HopHints
+	}
+	return nil
+}
+
+type Invoice struct {
 	// *
-	// SubscribeTransactions creates a uni-directional stream from the server to
-	// the client in which any newly discovered transactions relevant to the
-	// wallet are sent over.
-	SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error)
-	// * lncli: `sendmany`
-	// SendMany handles a request for a transaction that creates multiple specified
-	// outputs in parallel. If neither target_conf, or sat_per_byte are set, then
-	// the internal wallet will consult its fee model to determine a fee for the
-	// default confirmation target.
-	SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error)
-	// * lncli: `newaddress`
-	// NewAddress creates a new address under control of the local wallet.
-	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
-	// * lncli: `signmessage`
-	// SignMessage signs a message with this node's private key. The returned
-	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
-	// only the message digest and signature are needed for verification.
-	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
-	// * lncli: `verifymessage`
-	// VerifyMessage verifies a signature over a msg. The signature must be
-	// zbase32 encoded and signed by an active node in the resident node's
-	// channel database. In addition to returning the validity of the signature,
-	// VerifyMessage also returns the recovered pubkey from the signature.
-	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
-	// * lncli: `connect`
-	// ConnectPeer attempts to establish a connection to a remote peer. This is at
-	// the networking level, and is used for communication between nodes. This is
-	// distinct from establishing a channel with a peer.
-	ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error)
-	// * lncli: `disconnect`
-	// DisconnectPeer attempts to disconnect one peer from another identified by a
-	// given pubKey. In the case that we currently have a pending or active channel
-	// with the target peer, then this action will be not be allowed.
-	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
-	// * lncli: `listpeers`
-	// ListPeers returns a verbose listing of all currently active peers.
-	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
-	// * lncli: `getinfo`
-	// GetInfo returns general information concerning the lightning node including
-	// it's identity pubkey, alias, the chains it is connected to, and information
-	// concerning the number of open+pending channels.
-	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
-	// * lncli: `pendingchannels`
-	// PendingChannels returns a list of all the channels that are currently
-	// considered "pending". A channel is pending if it has finished the funding
-	// workflow and is waiting for confirmations for the funding txn, or is in the
-	// process of closure, either initiated cooperatively or non-cooperatively.
-	PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error)
-	// * lncli: `listchannels`
-	// ListChannels returns a description of all the open channels that this node
-	// is a participant in.
-	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
-	// * lncli: `closedchannels`
-	// ClosedChannels returns a description of all the closed channels that
-	// this node was a participant in.
-	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	// An optional memo to attach along with the invoice. Used for record keeping
+	// purposes for the invoice's creator, and will also be set in the description
+	// field of the encoded payment request if the description_hash field is not
+	// being used.
+	Memo string `protobuf:"bytes,1,opt,name=memo" json:"memo,omitempty"`
+	// / An optional cryptographic receipt of payment
+	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
 	// *
-	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
-	// call is meant to be consumed by clients to the REST proxy. As with all
-	// other sync calls, all byte slices are intended to be populated as hex
-	// encoded strings.
-	OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error)
-	// * lncli: `openchannel`
-	// OpenChannel attempts to open a singly funded channel specified in the
-	// request to a remote peer. Users are able to specify a target number of
-	// blocks that the funding transaction should be confirmed in, or a manual fee
-	// rate to us for the funding transaction. If neither are specified, then a
-	// lax block confirmation target is used.
-	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
-	// * lncli: `closechannel`
-	// CloseChannel attempts to close an active channel identified by its channel
-	// outpoint (ChannelPoint). The actions of this method can additionally be
-	// augmented to attempt a force close after a timeout period in the case of an
-	// inactive peer. If a non-force close (cooperative closure) is requested,
-	// then the user can specify either a target number of blocks until the
-	// closure transaction is confirmed, or a manual fee rate. If neither are
-	// specified, then a default lax, block confirmation target is used.
-	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error)
-	// * lncli: `abandonchannel`
-	// AbandonChannel removes all channel state from the database except for a
-	// close summary. This method can be used to get rid of permanently unusable
-	// channels due to bugs fixed in newer versions of lnd. Only available
-	// when in debug builds of lnd.
-	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
-	// * lncli: `sendpayment`
-	// SendPayment dispatches a bi-directional streaming RPC for sending payments
-	// through the Lightning Network. A single RPC invocation creates a persistent
-	// bi-directional stream allowing clients to rapidly send payments through the
-	// Lightning Network with a single persistent connection.
-	SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error)
+	// The hex-encoded preimage (32 byte) which will allow settling an incoming
+	// HTLC payable to this preimage
+	RPreimage []byte `protobuf:"bytes,3,opt,name=r_preimage,proto3" json:"r_preimage,omitempty"`
+	// / The hash of the preimage
+	RHash []byte `protobuf:"bytes,4,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// / The value of this invoice in satoshis
+	Value int64 `protobuf:"varint,5,opt,name=value" json:"value,omitempty"`
+	// / Whether this invoice has been fulfilled
+	Settled bool `protobuf:"varint,6,opt,name=settled" json:"settled,omitempty"`
+	// / When this invoice was created
+	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date" json:"creation_date,omitempty"`
+	// / When this invoice was settled
+	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date" json:"settle_date,omitempty"`
 	// *
-	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
-	// This RPC is intended to be consumed by clients of the REST proxy.
-	// Additionally, this RPC expects the destination's public key and the payment
-	// hash (if any) to be encoded as hex strings.
-	SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
-	// * lncli: `sendtoroute`
-	// SendToRoute is a bi-directional streaming RPC for sending payment through
-	// the Lightning Network. This method differs from SendPayment in that it
-	// allows users to specify a full route manually. This can be used for things
-	// like rebalancing, and atomic swaps.
-	SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error)
+	// A bare-bones invoice for a payment within the Lightning Network.  With the
+	// details of the invoice, the sender has all the data necessary to send a
+	// payment to the recipient.
+	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request" json:"payment_request,omitempty"`
 	// *
-	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
-	// until the payment either fails or succeeds.
-	SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error)
-	// * lncli: `addinvoice`
-	// AddInvoice attempts to add a new invoice to the invoice database. Any
-	// duplicated invoices are rejected, therefore all invoices *must* have a
-	// unique payment preimage.
-	AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error)
-	// * lncli: `listinvoices`
-	// ListInvoices returns a list of all the invoices currently stored within the
-	// database. Any active debug invoices are ignored. It has full support for
-	// paginated responses, allowing users to query for specific invoices through
-	// their add_index. This can be done by using either the first_index_offset or
-	// last_index_offset fields included in the response as the index_offset of the
-	// next request. The reversed flag is set by default in order to paginate
-	// backwards. If you wish to paginate forwards, you must explicitly set the
-	// flag to false. If none of the parameters are specified, then the last 100
-	// invoices will be returned.
-	ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error)
-	// * lncli: `lookupinvoice`
-	// LookupInvoice attempts to look up an invoice according to its payment hash.
-	// The passed payment hash *must* be exactly 32 bytes, if not, an error is
-	// returned.
-	LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error)
+	// Hash (SHA-256) of a description of the payment. Used if the description of
+	// payment (memo) is too long to naturally fit within the description field
+	// of an encoded payment request.
+	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	// / Payment request expiry time in seconds. Default is 3600 (1 hour).
+	Expiry int64 `protobuf:"varint,11,opt,name=expiry" json:"expiry,omitempty"`
+	// / Fallback on-chain address.
+	FallbackAddr string `protobuf:"bytes,12,opt,name=fallback_addr" json:"fallback_addr,omitempty"`
+	// / Delta to use for the time-lock of the CLTV extended to the final hop.
+	CltvExpiry uint64 `protobuf:"varint,13,opt,name=cltv_expiry" json:"cltv_expiry,omitempty"`
 	// *
-	// SubscribeInvoices returns a uni-directional stream (server -> client) for
-	// notifying the client of newly added/settled invoices. The caller can
-	// optionally specify the add_index and/or the settle_index. If the add_index
-	// is specified, then we'll first start by sending add invoice events for all
-	// invoices with an add_index greater than the specified value.  If the
-	// settle_index is specified, the next, we'll send out all settle events for
-	// invoices with a settle_index greater than the specified value.  One or both
-	// of these fields can be set. If no fields are set, then we'll only send out
-	// the latest add/settle events.
-	SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error)
-	// * lncli: `decodepayreq`
-	// DecodePayReq takes an encoded payment request string and attempts to decode
-	// it, returning a full description of the conditions encoded within the
-	// payment request.
-	DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error)
-	// * lncli: `listpayments`
-	// ListPayments returns a list of all outgoing payments.
-	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	// Route hints that can each be individually used to assist in reaching the
+	// invoice's destination.
+	RouteHints []*RouteHint `protobuf:"bytes,14,rep,name=route_hints" json:"route_hints,omitempty"`
+	// / Whether this invoice should include routing hints for private channels.
+	Private bool `protobuf:"varint,15,opt,name=private" json:"private,omitempty"`
 	// *
-	// DeleteAllPayments deletes all outgoing payments from DB.
-	DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error)
-	// * lncli: `describegraph`
-	// DescribeGraph returns a description of the latest graph state from the
-	// point of view of the node. The graph information is partitioned into two
-	// components: all the nodes/vertexes, and all the edges that connect the
-	// vertexes themselves.  As this is a directed graph, the edges also contain
-	// the node directional specific routing policy which includes: the time lock
-	// delta, fee information, etc.
-	DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error)
-	// * lncli: `getchaninfo`
-	// GetChanInfo returns the latest authenticated network announcement for the
-	// given channel identified by its channel ID: an 8-byte integer which
-	// uniquely identifies the location of transaction's funding output within the
-	// blockchain.
-	GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error)
-	// * lncli: `getnodeinfo`
-	// GetNodeInfo returns the latest advertised, aggregated, and authenticated
-	// channel information for the specified node identified by its public key.
-	GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error)
-	// * lncli: `queryroutes`
-	// QueryRoutes attempts to query the daemon's Channel Router for a possible
-	// route to a target destination capable of carrying a specific amount of
-	// satoshis. The retuned route contains the full details required to craft and
-	// send an HTLC, also including the necessary information that should be
-	// present within the Sphinx packet encapsulated within the HTLC.
-	QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error)
-	// * lncli: `getnetworkinfo`
-	// GetNetworkInfo returns some basic stats about the known channel graph from
-	// the point of view of the node.
-	GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
-	// * lncli: `stop`
-	// StopDaemon will send a shutdown request to the interrupt handler, triggering
-	// a graceful shutdown of the daemon.
-	StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	// The "add" index of this invoice. Each newly created invoice will increment
+	// this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all added
+	// invoices with an add_index greater than this one.
+	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index" json:"add_index,omitempty"`
 	// *
-	// SubscribeChannelGraph launches a streaming RPC that allows the caller to
-	// receive notifications upon any changes to the channel graph topology from
-	// the point of view of the responding node. Events notified include: new
-	// nodes coming online, nodes updating their authenticated attributes, new
-	// channels being advertised, updates in the routing policy for a directional
-	// channel edge, and when channels are closed on-chain.
-	SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error)
-	// * lncli: `debuglevel`
-	// DebugLevel allows a caller to programmatically set the logging verbosity of
-	// lnd. The logging can be targeted according to a coarse daemon-wide logging
-	// level, or in a granular fashion to specify the logging for a target
-	// sub-system.
-	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
-	// * lncli: `feereport`
-	// FeeReport allows the caller to obtain a report detailing the current fee
-	// schedule enforced by the node globally for each channel.
-	FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error)
-	// * lncli: `updatechanpolicy`
-	// UpdateChannelPolicy allows the caller to update the fee schedule and
-	// channel policies for all channels globally, or a particular channel.
-	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
-	// * lncli: `fwdinghistory`
-	// ForwardingHistory allows the caller to query the htlcswitch for a record of
-	// all HTLC's forwarded within the target time range, and integer offset
-	// within that time range. If no time-range is specified, then the first chunk
-	// of the past 24 hrs of forwarding history are returned.
-	//
-	// A list of forwarding events are returned. The size of each forwarding event
-	// is 40 bytes, and the max message size able to be returned in gRPC is 4 MiB.
-	// As a result each message can only contain 50k entries.  Each response has
-	// the index offset of the last entry. The index offset can be provided to the
-	// request to allow the caller to skip a series of records.
-	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// The "settle" index of this invoice. Each newly settled invoice will
+	// increment this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all
+	// settled invoices with an settle_index greater than this one.
+	SettleIndex uint64 `protobuf:"varint,17,opt,name=settle_index" json:"settle_index,omitempty"`
+	// / Deprecated, use amt_paid_sat or amt_paid_msat.
+	AmtPaid int64 `protobuf:"varint,18,opt,name=amt_paid" json:"amt_paid,omitempty"`
+	// *
+	// The amount that was accepted for this invoice, in satoshis. This will ONLY
+	// be set if this invoice has been settled. We provide this field as if the
+	// invoice was created with a zero value, then we need to record what amount
+	// was ultimately accepted. Additionally, it's possible that the sender paid
+	// MORE that was specified in the original invoice. So we'll record that here
+	// as well.
+	AmtPaidSat int64 `protobuf:"varint,19,opt,name=amt_paid_sat" json:"amt_paid_sat,omitempty"`
+	// *
+	// The amount that was accepted for this invoice, in millisatoshis. This will
+	// ONLY be set if this invoice has been settled. We provide this field as if
+	// the invoice was created with a zero value, then we need to record what
+	// amount was ultimately accepted. Additionally, it's possible that the sender
+	// paid MORE that was specified in the original invoice. So we'll record that
+	// here as well.
+	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat" json:"amt_paid_msat,omitempty"`
+	// / Optional opaque metadata stored along with the invoice, e.g. an order identifier.
+	Metadata []byte `protobuf:"bytes,21,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-type lightningClient struct {
-	cc *grpc.ClientConn
+func (m *Invoice) Reset()                    { *m = Invoice{} }
+func (m *Invoice) String() string            { return proto.CompactTextString(m) }
+func (*Invoice) ProtoMessage()               {}
+func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
+
+func (m *Invoice) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
 }
 
-func NewLightningClient(cc *grpc.ClientConn) LightningClient {
-	return &lightningClient{cc}
+func (m *Invoice) GetReceipt() []byte {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
 }
 
-func (c *lightningClient) WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error) {
-	out := new(WalletBalanceResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/WalletBalance", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetRPreimage() []byte {
+	if m != nil {
+		return m.RPreimage
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error) {
-	out := new(ChannelBalanceResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ChannelBalance", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error) {
-	out := new(TransactionDetails)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetTransactions", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error) {
-	out := new(SendCoinsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendCoins", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetSettled() bool {
+	if m != nil {
+		return m.Settled
 	}
-	return out, nil
+	return false
 }
 
-func (c *lightningClient) ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error) {
-	out := new(ListUnspentResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListUnspent", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[0], c.cc, "/lnrpc.Lightning/SubscribeTransactions", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningSubscribeTransactionsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *Invoice) GetSettleDate() int64 {
+	if m != nil {
+		return m.SettleDate
 	}
-	return x, nil
+	return 0
 }
 
-type Lightning_SubscribeTransactionsClient interface {
-	Recv() (*Transaction, error)
-	grpc.ClientStream
+func (m *Invoice) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
 }
 
-type lightningSubscribeTransactionsClient struct {
-	grpc.ClientStream
+func (m *Invoice) GetDescriptionHash() []byte {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return nil
 }
 
-func (x *lightningSubscribeTransactionsClient) Recv() (*Transaction, error) {
-	m := new(Transaction)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *Invoice) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
 	}
-	return m, nil
+	return 0
 }
 
-func (c *lightningClient) SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error) {
-	out := new(SendManyResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendMany", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetFallbackAddr() string {
+	if m != nil {
+		return m.FallbackAddr
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error) {
-	out := new(NewAddressResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/NewAddress", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetCltvExpiry() uint64 {
+	if m != nil {
+		return m.CltvExpiry
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
-	out := new(SignMessageResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SignMessage", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetRouteHints() []*RouteHint {
+	if m != nil {
+		return m.RouteHints
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error) {
-	out := new(VerifyMessageResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/VerifyMessage", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetPrivate() bool {
+	if m != nil {
+		return m.Private
 	}
-	return out, nil
+	return false
 }
 
-func (c *lightningClient) ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error) {
-	out := new(ConnectPeerResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ConnectPeer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetAddIndex() uint64 {
+	if m != nil {
+		return m.AddIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error) {
-	out := new(DisconnectPeerResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DisconnectPeer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetSettleIndex() uint64 {
+	if m != nil {
+		return m.SettleIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error) {
-	out := new(ListPeersResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListPeers", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetAmtPaid() int64 {
+	if m != nil {
+		return m.AmtPaid
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
-	out := new(GetInfoResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetAmtPaidSat() int64 {
+	if m != nil {
+		return m.AmtPaidSat
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error) {
-	out := new(PendingChannelsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/PendingChannels", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetAmtPaidMsat() int64 {
+	if m != nil {
+		return m.AmtPaidMsat
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
-	out := new(ListChannelsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListChannels", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Invoice) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error) {
-	out := new(ClosedChannelsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ClosedChannels", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AddInvoiceResponse struct {
+	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// *
+	// A bare-bones invoice for a payment within the Lightning Network.  With the
+	// details of the invoice, the sender has all the data necessary to send a
+	// payment to the recipient.
+	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request" json:"payment_request,omitempty"`
+	// *
+	// The "add" index of this invoice. Each newly created invoice will increment
+	// this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all added
+	// invoices with an add_index greater than this one.
+	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index" json:"add_index,omitempty"`
 }
 
-func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
-	out := new(ChannelPoint)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddInvoiceResponse) Reset()                    { *m = AddInvoiceResponse{} }
+func (m *AddInvoiceResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddInvoiceResponse) ProtoMessage()               {}
+func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
+
+func (m *AddInvoiceResponse) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[1], c.cc, "/lnrpc.Lightning/OpenChannel", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningOpenChannelClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *AddInvoiceResponse) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
 	}
-	return x, nil
+	return ""
 }
 
-type Lightning_OpenChannelClient interface {
-	Recv() (*OpenStatusUpdate, error)
-	grpc.ClientStream
+func (m *AddInvoiceResponse) GetAddIndex() uint64 {
+	if m != nil {
+		return m.AddIndex
+	}
+	return 0
 }
 
-type lightningOpenChannelClient struct {
-	grpc.ClientStream
+type CreateOfferRequest struct {
+	// *
+	// The amount in millisatoshis to request for each item. If zero, the payer
+	// chooses the amount.
+	AmtMsat int64 `protobuf:"varint,1,opt,name=amt_msat" json:"amt_msat,omitempty"`
+	// / A description of the purpose of the payment.
+	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	// / An optional human readable name of the issuer.
+	Issuer string `protobuf:"bytes,3,opt,name=issuer" json:"issuer,omitempty"`
+	// *
+	// If non-zero, the payer may request multiple items in a single payment,
+	// up to this limit.
+	QuantityMax uint64 `protobuf:"varint,4,opt,name=quantity_max" json:"quantity_max,omitempty"`
+	// / The number of seconds after which the offer expires. If zero, it never expires.
+	Expiry int64 `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
 }
 
-func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
-	m := new(OpenStatusUpdate)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *CreateOfferRequest) Reset()                    { *m = CreateOfferRequest{} }
+func (m *CreateOfferRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateOfferRequest) ProtoMessage()               {}
+func (*CreateOfferRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
+
+func (m *CreateOfferRequest) GetAmtMsat() int64 {
+	if m != nil {
+		return m.AmtMsat
 	}
-	return m, nil
+	return 0
 }
 
-func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[2], c.cc, "/lnrpc.Lightning/CloseChannel", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningCloseChannelClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *CreateOfferRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return x, nil
+	return ""
 }
 
-type Lightning_CloseChannelClient interface {
-	Recv() (*CloseStatusUpdate, error)
-	grpc.ClientStream
+func (m *CreateOfferRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
 }
 
-type lightningCloseChannelClient struct {
-	grpc.ClientStream
+func (m *CreateOfferRequest) GetQuantityMax() uint64 {
+	if m != nil {
+		return m.QuantityMax
+	}
+	return 0
 }
 
-func (x *lightningCloseChannelClient) Recv() (*CloseStatusUpdate, error) {
-	m := new(CloseStatusUpdate)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *CreateOfferRequest) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
 	}
-	return m, nil
+	return 0
 }
 
-func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error) {
-	out := new(AbandonChannelResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AbandonChannel", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CreateOfferResponse struct {
+	// / The bech32 encoded offer.
+	Offer string `protobuf:"bytes,1,opt,name=offer" json:"offer,omitempty"`
+	// / The id of the offer, which is the merkle root of its records.
+	OfferId []byte `protobuf:"bytes,2,opt,name=offer_id,proto3" json:"offer_id,omitempty"`
 }
 
-func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[3], c.cc, "/lnrpc.Lightning/SendPayment", opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateOfferResponse) Reset()                    { *m = CreateOfferResponse{} }
+func (m *CreateOfferResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateOfferResponse) ProtoMessage()               {}
+func (*CreateOfferResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
+
+func (m *CreateOfferResponse) GetOffer() string {
+	if m != nil {
+		return m.Offer
 	}
-	x := &lightningSendPaymentClient{stream}
-	return x, nil
+	return ""
 }
 
-type Lightning_SendPaymentClient interface {
-	Send(*SendRequest) error
-	Recv() (*SendResponse, error)
-	grpc.ClientStream
+func (m *CreateOfferResponse) GetOfferId() []byte {
+	if m != nil {
+		return m.OfferId
+	}
+	return nil
 }
 
-type lightningSendPaymentClient struct {
-	grpc.ClientStream
+type PayOfferRequest struct {
+	// / The bech32 encoded offer to pay.
+	Offer string `protobuf:"bytes,1,opt,name=offer" json:"offer,omitempty"`
+	// *
+	// The total amount in millisatoshis to pay. This is required if the offer
+	// doesn't specify an amount.
+	AmtMsat int64 `protobuf:"varint,2,opt,name=amt_msat" json:"amt_msat,omitempty"`
+	// / The number of items to pay for, if the offer permits multiple items.
+	Quantity uint64 `protobuf:"varint,3,opt,name=quantity" json:"quantity,omitempty"`
+	// / An optional note to include with the invoice request.
+	PayerNote string `protobuf:"bytes,4,opt,name=payer_note" json:"payer_note,omitempty"`
+	// *
+	// The maximum number of satoshis that will be paid as a fee of the payment.
+	// This value can be represented either as a percentage of the amount being
+	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
+	// send the payment.
+	FeeLimit *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit" json:"fee_limit,omitempty"`
 }
 
-func (x *lightningSendPaymentClient) Send(m *SendRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
+func (m *PayOfferRequest) Reset()                    { *m = PayOfferRequest{} }
+func (m *PayOfferRequest) String() string            { return proto.CompactTextString(m) }
+func (*PayOfferRequest) ProtoMessage()               {}
+func (*PayOfferRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
 
-func (x *lightningSendPaymentClient) Recv() (*SendResponse, error) {
-	m := new(SendResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *PayOfferRequest) GetOffer() string {
+	if m != nil {
+		return m.Offer
 	}
-	return m, nil
+	return ""
 }
 
-func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error) {
-	out := new(SendResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendPaymentSync", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PayOfferRequest) GetAmtMsat() int64 {
+	if m != nil {
+		return m.AmtMsat
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[4], c.cc, "/lnrpc.Lightning/SendToRoute", opts...)
-	if err != nil {
-		return nil, err
+func (m *PayOfferRequest) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
 	}
-	x := &lightningSendToRouteClient{stream}
-	return x, nil
+	return 0
 }
 
-type Lightning_SendToRouteClient interface {
-	Send(*SendToRouteRequest) error
-	Recv() (*SendResponse, error)
-	grpc.ClientStream
+func (m *PayOfferRequest) GetPayerNote() string {
+	if m != nil {
+		return m.PayerNote
+	}
+	return ""
 }
 
-type lightningSendToRouteClient struct {
-	grpc.ClientStream
+func (m *PayOfferRequest) GetFeeLimit() *FeeLimit {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return nil
 }
 
-func (x *lightningSendToRouteClient) Send(m *SendToRouteRequest) error {
-	return x.ClientStream.SendMsg(m)
+type PaymentHash struct {
+	// *
+	// The hex-encoded payment hash of the invoice to be looked up. The passed
+	// payment hash must be exactly 32 bytes, otherwise an error is returned.
+	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str" json:"r_hash_str,omitempty"`
+	// / The payment hash of the invoice to be looked up.
+	RHash []byte `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
 }
 
-func (x *lightningSendToRouteClient) Recv() (*SendResponse, error) {
-	m := new(SendResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *PaymentHash) Reset()                    { *m = PaymentHash{} }
+func (m *PaymentHash) String() string            { return proto.CompactTextString(m) }
+func (*PaymentHash) ProtoMessage()               {}
+func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{123} }
+
+func (m *PaymentHash) GetRHashStr() string {
+	if m != nil {
+		return m.RHashStr
 	}
-	return m, nil
+	return ""
 }
 
-func (c *lightningClient) SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error) {
-	out := new(SendResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendToRouteSync", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *PaymentHash) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
-	out := new(AddInvoiceResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AddInvoice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListInvoiceRequest struct {
+	// / If set, only unsettled invoices will be returned in the response.
+	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only" json:"pending_only,omitempty"`
+	// *
+	// The index of an invoice that will be used as either the start or end of a
+	// query to determine which invoices should be returned in the response.
+	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset" json:"index_offset,omitempty"`
+	// / The max number of invoices to return in the response to this query.
+	NumMaxInvoices uint64 `protobuf:"varint,5,opt,name=num_max_invoices" json:"num_max_invoices,omitempty"`
+	// *
+	// If set, the invoices returned will result from seeking backwards from the
+	// specified index offset. This can be used to paginate backwards.
+	Reversed bool `protobuf:"varint,6,opt,name=reversed" json:"reversed,omitempty"`
 }
 
-func (c *lightningClient) ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error) {
-	out := new(ListInvoiceResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListInvoices", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListInvoiceRequest) Reset()                    { *m = ListInvoiceRequest{} }
+func (m *ListInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListInvoiceRequest) ProtoMessage()               {}
+func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{124} }
+
+func (m *ListInvoiceRequest) GetPendingOnly() bool {
+	if m != nil {
+		return m.PendingOnly
 	}
-	return out, nil
+	return false
 }
 
-func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error) {
-	out := new(Invoice)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/LookupInvoice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListInvoiceRequest) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[5], c.cc, "/lnrpc.Lightning/SubscribeInvoices", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningSubscribeInvoicesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ListInvoiceRequest) GetNumMaxInvoices() uint64 {
+	if m != nil {
+		return m.NumMaxInvoices
 	}
-	return x, nil
+	return 0
 }
 
-type Lightning_SubscribeInvoicesClient interface {
-	Recv() (*Invoice, error)
-	grpc.ClientStream
+func (m *ListInvoiceRequest) GetReversed() bool {
+	if m != nil {
+		return m.Reversed
+	}
+	return false
 }
 
-type lightningSubscribeInvoicesClient struct {
-	grpc.ClientStream
+type ListInvoiceResponse struct {
+	// *
+	// A list of invoices from the time slice of the time series specified in the
+	// request.
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices" json:"invoices,omitempty"`
+	// *
+	// The index of the last item in the set of returned invoices. This can be used
+	// to seek further, pagination style.
+	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset" json:"last_index_offset,omitempty"`
+	// *
+	// The index of the last item in the set of returned invoices. This can be used
+	// to seek backwards, pagination style.
+	FirstIndexOffset uint64 `protobuf:"varint,3,opt,name=first_index_offset" json:"first_index_offset,omitempty"`
 }
 
-func (x *lightningSubscribeInvoicesClient) Recv() (*Invoice, error) {
-	m := new(Invoice)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ListInvoiceResponse) Reset()                    { *m = ListInvoiceResponse{} }
+func (m *ListInvoiceResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListInvoiceResponse) ProtoMessage()               {}
+func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{125} }
+
+func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
+	if m != nil {
+		return m.Invoices
 	}
-	return m, nil
+	return nil
 }
 
-func (c *lightningClient) DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error) {
-	out := new(PayReq)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DecodePayReq", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListInvoiceResponse) GetLastIndexOffset() uint64 {
+	if m != nil {
+		return m.LastIndexOffset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
-	out := new(ListPaymentsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListPayments", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListInvoiceResponse) GetFirstIndexOffset() uint64 {
+	if m != nil {
+		return m.FirstIndexOffset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error) {
-	out := new(DeleteAllPaymentsResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DeleteAllPayments", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type InvoiceSubscription struct {
+	// *
+	// If specified (non-zero), then we'll first start by sending out
+	// notifications for all added indexes with an add_index greater than this
+	// value. This allows callers to catch up on any events they missed while they
+	// weren't connected to the streaming RPC.
+	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index" json:"add_index,omitempty"`
+	// *
+	// If specified (non-zero), then we'll first start by sending out
+	// notifications for all settled indexes with an settle_index greater than
+	// this value. This allows callers to catch up on any events they missed while
+	// they weren't connected to the streaming RPC.
+	SettleIndex uint64 `protobuf:"varint,2,opt,name=settle_index" json:"settle_index,omitempty"`
+}
+
+func (m *InvoiceSubscription) Reset()                    { *m = InvoiceSubscription{} }
+func (m *InvoiceSubscription) String() string            { return proto.CompactTextString(m) }
+func (*InvoiceSubscription) ProtoMessage()               {}
+func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{126} }
+
+func (m *InvoiceSubscription) GetAddIndex() uint64 {
+	if m != nil {
+		return m.AddIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error) {
-	out := new(ChannelGraph)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DescribeGraph", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *InvoiceSubscription) GetSettleIndex() uint64 {
+	if m != nil {
+		return m.SettleIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error) {
-	out := new(ChannelEdge)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetChanInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type Payment struct {
+	// / The payment hash
+	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash" json:"payment_hash,omitempty"`
+	// / Deprecated, use value_sat or value_msat.
+	Value int64 `protobuf:"varint,2,opt,name=value" json:"value,omitempty"`
+	// / The date of this payment
+	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date" json:"creation_date,omitempty"`
+	// / The path this payment took
+	Path []string `protobuf:"bytes,4,rep,name=path" json:"path,omitempty"`
+	// / The fee paid for this payment in satoshis
+	Fee int64 `protobuf:"varint,5,opt,name=fee" json:"fee,omitempty"`
+	// / The payment preimage
+	PaymentPreimage string `protobuf:"bytes,6,opt,name=payment_preimage" json:"payment_preimage,omitempty"`
+	// / The value of the payment in satoshis
+	ValueSat int64 `protobuf:"varint,7,opt,name=value_sat" json:"value_sat,omitempty"`
+	// / The value of the payment in milli-satoshis
+	ValueMsat int64 `protobuf:"varint,8,opt,name=value_msat" json:"value_msat,omitempty"`
+	// / The opaque metadata stored along with the payment
+	Metadata []byte `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// / The status of the payment
+	Status Payment_PaymentStatus `protobuf:"varint,10,opt,name=status,enum=lnrpc.Payment_PaymentStatus" json:"status,omitempty"`
+	// *
+	// The index of the payment within the payment database. This can be used as the
+	// index_offset of a ListPayments request to paginate.
+	PaymentIndex uint64 `protobuf:"varint,11,opt,name=payment_index" json:"payment_index,omitempty"`
+	// / The date the payment succeeded, zero if it didn't
+	SettleDate int64 `protobuf:"varint,12,opt,name=settle_date" json:"settle_date,omitempty"`
+	// / The fee paid for this payment in milli-satoshis
+	FeeMsat int64 `protobuf:"varint,13,opt,name=fee_msat" json:"fee_msat,omitempty"`
+}
+
+func (m *Payment) Reset()                    { *m = Payment{} }
+func (m *Payment) String() string            { return proto.CompactTextString(m) }
+func (*Payment) ProtoMessage()               {}
+func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{127} }
+
+func (m *Payment) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
-	out := new(NodeInfo)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
-	out := new(QueryRoutesResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/QueryRoutes", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
-	out := new(NetworkInfo)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetNetworkInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetPath() []string {
+	if m != nil {
+		return m.Path
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
-	out := new(StopResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/StopDaemon", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetFee() int64 {
+	if m != nil {
+		return m.Fee
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[6], c.cc, "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetPaymentPreimage() string {
+	if m != nil {
+		return m.PaymentPreimage
 	}
-	x := &lightningSubscribeChannelGraphClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *Payment) GetValueSat() int64 {
+	if m != nil {
+		return m.ValueSat
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *Payment) GetValueMsat() int64 {
+	if m != nil {
+		return m.ValueMsat
 	}
-	return x, nil
+	return 0
 }
 
-type Lightning_SubscribeChannelGraphClient interface {
-	Recv() (*GraphTopologyUpdate, error)
-	grpc.ClientStream
+func (m *Payment) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
 
-type lightningSubscribeChannelGraphClient struct {
-	grpc.ClientStream
+func (m *Payment) GetStatus() Payment_PaymentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Payment_UNKNOWN
 }
 
-func (x *lightningSubscribeChannelGraphClient) Recv() (*GraphTopologyUpdate, error) {
-	m := new(GraphTopologyUpdate)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *Payment) GetPaymentIndex() uint64 {
+	if m != nil {
+		return m.PaymentIndex
 	}
-	return m, nil
+	return 0
 }
 
-func (c *lightningClient) DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error) {
-	out := new(DebugLevelResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DebugLevel", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetSettleDate() int64 {
+	if m != nil {
+		return m.SettleDate
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error) {
-	out := new(FeeReportResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FeeReport", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Payment) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error) {
-	out := new(PolicyUpdateResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/UpdateChannelPolicy", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type ListPaymentsRequest struct {
+	// *
+	// If set, payments that are still in flight or that failed will also be
+	// returned in the response, otherwise only the succeeded ones are.
+	IncludeIncomplete bool `protobuf:"varint,1,opt,name=include_incomplete" json:"include_incomplete,omitempty"`
+	// *
+	// The index of a payment that will be used as either the start or end of a
+	// query to determine which payments should be returned in the response.
+	IndexOffset uint64 `protobuf:"varint,2,opt,name=index_offset" json:"index_offset,omitempty"`
+	// *
+	// The max number of payments to return in the response to this query. If
+	// unset, all the matching payments are returned.
+	MaxPayments uint64 `protobuf:"varint,3,opt,name=max_payments" json:"max_payments,omitempty"`
+	// *
+	// If set, the payments returned will result from seeking backwards from the
+	// specified index offset. This can be used to paginate backwards.
+	Reversed bool `protobuf:"varint,4,opt,name=reversed" json:"reversed,omitempty"`
+}
+
+func (m *ListPaymentsRequest) Reset()                    { *m = ListPaymentsRequest{} }
+func (m *ListPaymentsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPaymentsRequest) ProtoMessage()               {}
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{128} }
+
+func (m *ListPaymentsRequest) GetIncludeIncomplete() bool {
+	if m != nil {
+		return m.IncludeIncomplete
 	}
-	return out, nil
+	return false
 }
 
-func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
-	out := new(ForwardingHistoryResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListPaymentsRequest) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
 	}
-	return out, nil
+	return 0
 }
 
-// Server API for Lightning service
+func (m *ListPaymentsRequest) GetMaxPayments() uint64 {
+	if m != nil {
+		return m.MaxPayments
+	}
+	return 0
+}
 
-type LightningServer interface {
-	// * lncli: `walletbalance`
-	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
-	// confirmed unspent outputs and all unconfirmed unspent outputs under control
-	// of the wallet.
-	WalletBalance(context.Context, *WalletBalanceRequest) (*WalletBalanceResponse, error)
-	// * lncli: `channelbalance`
-	// ChannelBalance returns the total funds available across all open channels
-	// in satoshis.
-	ChannelBalance(context.Context, *ChannelBalanceRequest) (*ChannelBalanceResponse, error)
-	// * lncli: `listchaintxns`
-	// GetTransactions returns a list describing all the known transactions
-	// relevant to the wallet.
-	GetTransactions(context.Context, *GetTransactionsRequest) (*TransactionDetails, error)
-	// * lncli: `sendcoins`
-	// SendCoins executes a request to send coins to a particular address. Unlike
-	// SendMany, this RPC call only allows creating a single output at a time. If
-	// neither target_conf, or sat_per_byte are set, then the internal wallet will
-	// consult its fee model to determine a fee for the default confirmation
-	// target.
-	SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error)
-	// * lncli: `listunspent`
-	// ListUnspent returns a list of all utxos spendable by the wallet with a
-	// number of confirmations between the specified minimum and maximum.
-	ListUnspent(context.Context, *ListUnspentRequest) (*ListUnspentResponse, error)
+func (m *ListPaymentsRequest) GetReversed() bool {
+	if m != nil {
+		return m.Reversed
+	}
+	return false
+}
+
+type ListPaymentsResponse struct {
+	// / The list of payments
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments" json:"payments,omitempty"`
 	// *
-	// SubscribeTransactions creates a uni-directional stream from the server to
-	// the client in which any newly discovered transactions relevant to the
-	// wallet are sent over.
-	SubscribeTransactions(*GetTransactionsRequest, Lightning_SubscribeTransactionsServer) error
-	// * lncli: `sendmany`
-	// SendMany handles a request for a transaction that creates multiple specified
-	// outputs in parallel. If neither target_conf, or sat_per_byte are set, then
-	// the internal wallet will consult its fee model to determine a fee for the
-	// default confirmation target.
-	SendMany(context.Context, *SendManyRequest) (*SendManyResponse, error)
-	// * lncli: `newaddress`
-	// NewAddress creates a new address under control of the local wallet.
-	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
-	// * lncli: `signmessage`
-	// SignMessage signs a message with this node's private key. The returned
-	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
-	// only the message digest and signature are needed for verification.
-	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
-	// * lncli: `verifymessage`
-	// VerifyMessage verifies a signature over a msg. The signature must be
-	// zbase32 encoded and signed by an active node in the resident node's
-	// channel database. In addition to returning the validity of the signature,
-	// VerifyMessage also returns the recovered pubkey from the signature.
-	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
-	// * lncli: `connect`
-	// ConnectPeer attempts to establish a connection to a remote peer. This is at
-	// the networking level, and is used for communication between nodes. This is
-	// distinct from establishing a channel with a peer.
-	ConnectPeer(context.Context, *ConnectPeerRequest) (*ConnectPeerResponse, error)
-	// * lncli: `disconnect`
-	// DisconnectPeer attempts to disconnect one peer from another identified by a
-	// given pubKey. In the case that we currently have a pending or active channel
-	// with the target peer, then this action will be not be allowed.
-	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
-	// * lncli: `listpeers`
-	// ListPeers returns a verbose listing of all currently active peers.
-	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
-	// * lncli: `getinfo`
-	// GetInfo returns general information concerning the lightning node including
-	// it's identity pubkey, alias, the chains it is connected to, and information
-	// concerning the number of open+pending channels.
-	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
-	// * lncli: `pendingchannels`
-	// PendingChannels returns a list of all the channels that are currently
-	// considered "pending". A channel is pending if it has finished the funding
-	// workflow and is waiting for confirmations for the funding txn, or is in the
-	// process of closure, either initiated cooperatively or non-cooperatively.
-	PendingChannels(context.Context, *PendingChannelsRequest) (*PendingChannelsResponse, error)
-	// * lncli: `listchannels`
-	// ListChannels returns a description of all the open channels that this node
-	// is a participant in.
-	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
-	// * lncli: `closedchannels`
-	// ClosedChannels returns a description of all the closed channels that
-	// this node was a participant in.
-	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
-	// *
-	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
-	// call is meant to be consumed by clients to the REST proxy. As with all
-	// other sync calls, all byte slices are intended to be populated as hex
-	// encoded strings.
-	OpenChannelSync(context.Context, *OpenChannelRequest) (*ChannelPoint, error)
-	// * lncli: `openchannel`
-	// OpenChannel attempts to open a singly funded channel specified in the
-	// request to a remote peer. Users are able to specify a target number of
-	// blocks that the funding transaction should be confirmed in, or a manual fee
-	// rate to us for the funding transaction. If neither are specified, then a
-	// lax block confirmation target is used.
-	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
-	// * lncli: `closechannel`
-	// CloseChannel attempts to close an active channel identified by its channel
-	// outpoint (ChannelPoint). The actions of this method can additionally be
-	// augmented to attempt a force close after a timeout period in the case of an
-	// inactive peer. If a non-force close (cooperative closure) is requested,
-	// then the user can specify either a target number of blocks until the
-	// closure transaction is confirmed, or a manual fee rate. If neither are
-	// specified, then a default lax, block confirmation target is used.
-	CloseChannel(*CloseChannelRequest, Lightning_CloseChannelServer) error
-	// * lncli: `abandonchannel`
-	// AbandonChannel removes all channel state from the database except for a
-	// close summary. This method can be used to get rid of permanently unusable
-	// channels due to bugs fixed in newer versions of lnd. Only available
-	// when in debug builds of lnd.
-	AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error)
-	// * lncli: `sendpayment`
-	// SendPayment dispatches a bi-directional streaming RPC for sending payments
-	// through the Lightning Network. A single RPC invocation creates a persistent
-	// bi-directional stream allowing clients to rapidly send payments through the
-	// Lightning Network with a single persistent connection.
-	SendPayment(Lightning_SendPaymentServer) error
-	// *
-	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
-	// This RPC is intended to be consumed by clients of the REST proxy.
-	// Additionally, this RPC expects the destination's public key and the payment
-	// hash (if any) to be encoded as hex strings.
-	SendPaymentSync(context.Context, *SendRequest) (*SendResponse, error)
-	// * lncli: `sendtoroute`
-	// SendToRoute is a bi-directional streaming RPC for sending payment through
-	// the Lightning Network. This method differs from SendPayment in that it
-	// allows users to specify a full route manually. This can be used for things
-	// like rebalancing, and atomic swaps.
-	SendToRoute(Lightning_SendToRouteServer) error
-	// *
-	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
-	// until the payment either fails or succeeds.
-	SendToRouteSync(context.Context, *SendToRouteRequest) (*SendResponse, error)
-	// * lncli: `addinvoice`
-	// AddInvoice attempts to add a new invoice to the invoice database. Any
-	// duplicated invoices are rejected, therefore all invoices *must* have a
-	// unique payment preimage.
-	AddInvoice(context.Context, *Invoice) (*AddInvoiceResponse, error)
-	// * lncli: `listinvoices`
-	// ListInvoices returns a list of all the invoices currently stored within the
-	// database. Any active debug invoices are ignored. It has full support for
-	// paginated responses, allowing users to query for specific invoices through
-	// their add_index. This can be done by using either the first_index_offset or
-	// last_index_offset fields included in the response as the index_offset of the
-	// next request. The reversed flag is set by default in order to paginate
-	// backwards. If you wish to paginate forwards, you must explicitly set the
-	// flag to false. If none of the parameters are specified, then the last 100
-	// invoices will be returned.
-	ListInvoices(context.Context, *ListInvoiceRequest) (*ListInvoiceResponse, error)
-	// * lncli: `lookupinvoice`
-	// LookupInvoice attempts to look up an invoice according to its payment hash.
-	// The passed payment hash *must* be exactly 32 bytes, if not, an error is
-	// returned.
-	LookupInvoice(context.Context, *PaymentHash) (*Invoice, error)
-	// *
-	// SubscribeInvoices returns a uni-directional stream (server -> client) for
-	// notifying the client of newly added/settled invoices. The caller can
-	// optionally specify the add_index and/or the settle_index. If the add_index
-	// is specified, then we'll first start by sending add invoice events for all
-	// invoices with an add_index greater than the specified value.  If the
-	// settle_index is specified, the next, we'll send out all settle events for
-	// invoices with a settle_index greater than the specified value.  One or both
-	// of these fields can be set. If no fields are set, then we'll only send out
-	// the latest add/settle events.
-	SubscribeInvoices(*InvoiceSubscription, Lightning_SubscribeInvoicesServer) error
-	// * lncli: `decodepayreq`
-	// DecodePayReq takes an encoded payment request string and attempts to decode
-	// it, returning a full description of the conditions encoded within the
-	// payment request.
-	DecodePayReq(context.Context, *PayReqString) (*PayReq, error)
-	// * lncli: `listpayments`
-	// ListPayments returns a list of all outgoing payments.
-	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	// The index of the first item in the set of returned payments. This can be
+	// used to seek backwards, pagination style.
+	FirstIndexOffset uint64 `protobuf:"varint,2,opt,name=first_index_offset" json:"first_index_offset,omitempty"`
 	// *
-	// DeleteAllPayments deletes all outgoing payments from DB.
-	DeleteAllPayments(context.Context, *DeleteAllPaymentsRequest) (*DeleteAllPaymentsResponse, error)
-	// * lncli: `describegraph`
-	// DescribeGraph returns a description of the latest graph state from the
-	// point of view of the node. The graph information is partitioned into two
-	// components: all the nodes/vertexes, and all the edges that connect the
-	// vertexes themselves.  As this is a directed graph, the edges also contain
-	// the node directional specific routing policy which includes: the time lock
-	// delta, fee information, etc.
-	DescribeGraph(context.Context, *ChannelGraphRequest) (*ChannelGraph, error)
-	// * lncli: `getchaninfo`
-	// GetChanInfo returns the latest authenticated network announcement for the
-	// given channel identified by its channel ID: an 8-byte integer which
-	// uniquely identifies the location of transaction's funding output within the
-	// blockchain.
-	GetChanInfo(context.Context, *ChanInfoRequest) (*ChannelEdge, error)
-	// * lncli: `getnodeinfo`
-	// GetNodeInfo returns the latest advertised, aggregated, and authenticated
-	// channel information for the specified node identified by its public key.
-	GetNodeInfo(context.Context, *NodeInfoRequest) (*NodeInfo, error)
-	// * lncli: `queryroutes`
-	// QueryRoutes attempts to query the daemon's Channel Router for a possible
-	// route to a target destination capable of carrying a specific amount of
-	// satoshis. The retuned route contains the full details required to craft and
-	// send an HTLC, also including the necessary information that should be
-	// present within the Sphinx packet encapsulated within the HTLC.
-	QueryRoutes(context.Context, *QueryRoutesRequest) (*QueryRoutesResponse, error)
-	// * lncli: `getnetworkinfo`
-	// GetNetworkInfo returns some basic stats about the known channel graph from
-	// the point of view of the node.
-	GetNetworkInfo(context.Context, *NetworkInfoRequest) (*NetworkInfo, error)
-	// * lncli: `stop`
-	// StopDaemon will send a shutdown request to the interrupt handler, triggering
-	// a graceful shutdown of the daemon.
-	StopDaemon(context.Context, *StopRequest) (*StopResponse, error)
+	// The index of the last item in the set of returned payments. This can be
+	// used to seek further, pagination style.
+	LastIndexOffset uint64 `protobuf:"varint,3,opt,name=last_index_offset" json:"last_index_offset,omitempty"`
+}
+
+func (m *ListPaymentsResponse) Reset()                    { *m = ListPaymentsResponse{} }
+func (m *ListPaymentsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListPaymentsResponse) ProtoMessage()               {}
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{129} }
+
+func (m *ListPaymentsResponse) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+func (m *ListPaymentsResponse) GetFirstIndexOffset() uint64 {
+	if m != nil {
+		return m.FirstIndexOffset
+	}
+	return 0
+}
+
+func (m *ListPaymentsResponse) GetLastIndexOffset() uint64 {
+	if m != nil {
+		return m.LastIndexOffset
+	}
+	return 0
+}
+
+type DeleteAllPaymentsRequest struct {
 	// *
-	// SubscribeChannelGraph launches a streaming RPC that allows the caller to
-	// receive notifications upon any changes to the channel graph topology from
-	// the point of view of the responding node. Events notified include: new
-	// nodes coming online, nodes updating their authenticated attributes, new
-	// channels being advertised, updates in the routing policy for a directional
-	// channel edge, and when channels are closed on-chain.
-	SubscribeChannelGraph(*GraphTopologySubscription, Lightning_SubscribeChannelGraphServer) error
-	// * lncli: `debuglevel`
-	// DebugLevel allows a caller to programmatically set the logging verbosity of
-	// lnd. The logging can be targeted according to a coarse daemon-wide logging
-	// level, or in a granular fashion to specify the logging for a target
-	// sub-system.
-	DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error)
-	// * lncli: `feereport`
-	// FeeReport allows the caller to obtain a report detailing the current fee
-	// schedule enforced by the node globally for each channel.
-	FeeReport(context.Context, *FeeReportRequest) (*FeeReportResponse, error)
-	// * lncli: `updatechanpolicy`
-	// UpdateChannelPolicy allows the caller to update the fee schedule and
-	// channel policies for all channels globally, or a particular channel.
-	UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error)
-	// * lncli: `fwdinghistory`
-	// ForwardingHistory allows the caller to query the htlcswitch for a record of
-	// all HTLC's forwarded within the target time range, and integer offset
-	// within that time range. If no time-range is specified, then the first chunk
-	// of the past 24 hrs of forwarding history are returned.
-	//
-	// A list of forwarding events are returned. The size of each forwarding event
-	// is 40 bytes, and the max message size able to be returned in gRPC is 4 MiB.
-	// As a result each message can only contain 50k entries.  Each response has
-	// the index offset of the last entry. The index offset can be provided to the
-	// request to allow the caller to skip a series of records.
-	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// If set, only the payments that failed are deleted, while the ones that
+	// succeeded are kept.
+	FailedPaymentsOnly bool `protobuf:"varint,1,opt,name=failed_payments_only" json:"failed_payments_only,omitempty"`
+}
+
+func (m *DeleteAllPaymentsRequest) Reset()                    { *m = DeleteAllPaymentsRequest{} }
+func (m *DeleteAllPaymentsRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteAllPaymentsRequest) ProtoMessage()               {}
+func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{130} }
+
+func (m *DeleteAllPaymentsRequest) GetFailedPaymentsOnly() bool {
+	if m != nil {
+		return m.FailedPaymentsOnly
+	}
+	return false
+}
+
+type DeleteAllPaymentsResponse struct {
+}
+
+func (m *DeleteAllPaymentsResponse) Reset()                    { *m = DeleteAllPaymentsResponse{} }
+func (m *DeleteAllPaymentsResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteAllPaymentsResponse) ProtoMessage()               {}
+func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{131} }
+
+type AbandonChannelRequest struct {
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
+}
+
+func (m *AbandonChannelRequest) Reset()                    { *m = AbandonChannelRequest{} }
+func (m *AbandonChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*AbandonChannelRequest) ProtoMessage()               {}
+func (*AbandonChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{132} }
+
+func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+type AbandonChannelResponse struct {
+}
+
+func (m *AbandonChannelResponse) Reset()                    { *m = AbandonChannelResponse{} }
+func (m *AbandonChannelResponse) String() string            { return proto.CompactTextString(m) }
+func (*AbandonChannelResponse) ProtoMessage()               {}
+func (*AbandonChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{133} }
+
+type DebugLevelRequest struct {
+	Show      bool   `protobuf:"varint,1,opt,name=show" json:"show,omitempty"`
+	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec" json:"level_spec,omitempty"`
+}
+
+func (m *DebugLevelRequest) Reset()                    { *m = DebugLevelRequest{} }
+func (m *DebugLevelRequest) String() string            { return proto.CompactTextString(m) }
+func (*DebugLevelRequest) ProtoMessage()               {}
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{134} }
+
+func (m *DebugLevelRequest) GetShow() bool {
+	if m != nil {
+		return m.Show
+	}
+	return false
+}
+
+func (m *DebugLevelRequest) GetLevelSpec() string {
+	if m != nil {
+		return m.LevelSpec
+	}
+	return ""
+}
+
+type DebugLevelResponse struct {
+	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems" json:"sub_systems,omitempty"`
+}
+
+func (m *DebugLevelResponse) Reset()                    { *m = DebugLevelResponse{} }
+func (m *DebugLevelResponse) String() string            { return proto.CompactTextString(m) }
+func (*DebugLevelResponse) ProtoMessage()               {}
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{135} }
+
+func (m *DebugLevelResponse) GetSubSystems() string {
+	if m != nil {
+		return m.SubSystems
+	}
+	return ""
+}
+
+type PayReqString struct {
+	// / The payment request string to be decoded
+	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq" json:"pay_req,omitempty"`
+}
+
+func (m *PayReqString) Reset()                    { *m = PayReqString{} }
+func (m *PayReqString) String() string            { return proto.CompactTextString(m) }
+func (*PayReqString) ProtoMessage()               {}
+func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{136} }
+
+func (m *PayReqString) GetPayReq() string {
+	if m != nil {
+		return m.PayReq
+	}
+	return ""
+}
+
+type PayReq struct {
+	Destination     string       `protobuf:"bytes,1,opt,name=destination" json:"destination,omitempty"`
+	PaymentHash     string       `protobuf:"bytes,2,opt,name=payment_hash" json:"payment_hash,omitempty"`
+	NumSatoshis     int64        `protobuf:"varint,3,opt,name=num_satoshis" json:"num_satoshis,omitempty"`
+	Timestamp       int64        `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	Expiry          int64        `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
+	Description     string       `protobuf:"bytes,6,opt,name=description" json:"description,omitempty"`
+	DescriptionHash string       `protobuf:"bytes,7,opt,name=description_hash" json:"description_hash,omitempty"`
+	FallbackAddr    string       `protobuf:"bytes,8,opt,name=fallback_addr" json:"fallback_addr,omitempty"`
+	CltvExpiry      int64        `protobuf:"varint,9,opt,name=cltv_expiry" json:"cltv_expiry,omitempty"`
+	RouteHints      []*RouteHint `protobuf:"bytes,10,rep,name=route_hints" json:"route_hints,omitempty"`
+	// / The amount requested by the invoice, in milli-satoshis.
+	NumMsat int64 `protobuf:"varint,11,opt,name=num_msat" json:"num_msat,omitempty"`
+	// / The feature bits the recipient supports for the payment, as set in the invoice.
+	Features []uint32 `protobuf:"varint,12,rep,packed,name=features" json:"features,omitempty"`
+}
+
+func (m *PayReq) Reset()                    { *m = PayReq{} }
+func (m *PayReq) String() string            { return proto.CompactTextString(m) }
+func (*PayReq) ProtoMessage()               {}
+func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{137} }
+
+func (m *PayReq) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *PayReq) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *PayReq) GetNumSatoshis() int64 {
+	if m != nil {
+		return m.NumSatoshis
+	}
+	return 0
+}
+
+func (m *PayReq) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PayReq) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *PayReq) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PayReq) GetDescriptionHash() string {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return ""
+}
+
+func (m *PayReq) GetFallbackAddr() string {
+	if m != nil {
+		return m.FallbackAddr
+	}
+	return ""
+}
+
+func (m *PayReq) GetCltvExpiry() int64 {
+	if m != nil {
+		return m.CltvExpiry
+	}
+	return 0
+}
+
+func (m *PayReq) GetRouteHints() []*RouteHint {
+	if m != nil {
+		return m.RouteHints
+	}
+	return nil
+}
+
+func (m *PayReq) GetNumMsat() int64 {
+	if m != nil {
+		return m.NumMsat
+	}
+	return 0
+}
+
+func (m *PayReq) GetFeatures() []uint32 {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+type FeeReportRequest struct {
+}
+
+func (m *FeeReportRequest) Reset()                    { *m = FeeReportRequest{} }
+func (m *FeeReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*FeeReportRequest) ProtoMessage()               {}
+func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{138} }
+
+type ChannelFeeReport struct {
+	// / The channel that this fee report belongs to.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point" json:"chan_point,omitempty"`
+	// / The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat" json:"base_fee_msat,omitempty"`
+	// / The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
+	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil" json:"fee_per_mil,omitempty"`
+	// / The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate" json:"fee_rate,omitempty"`
+	// / The number of forwards over this channel that violated its forwarding policy.
+	PolicyFailures uint64 `protobuf:"varint,5,opt,name=policy_failures" json:"policy_failures,omitempty"`
+	// / The number of forwards over this channel that were failed by the remote peer or a node further along the route.
+	DownstreamFailures uint64 `protobuf:"varint,6,opt,name=downstream_failures" json:"downstream_failures,omitempty"`
+	// / The number of forwards over this channel that failed due to insufficient local balance.
+	InsufficientBalanceFailures uint64 `protobuf:"varint,7,opt,name=insufficient_balance_failures" json:"insufficient_balance_failures,omitempty"`
+	// / The number of forwards over this channel that failed as the channel was offline.
+	LinkOfflineFailures uint64 `protobuf:"varint,8,opt,name=link_offline_failures" json:"link_offline_failures,omitempty"`
+	// / The total amount of fee revenue (in milli-satoshis) earned by forwarding over this channel over the past 24 hrs.
+	DayFeeSumMsat uint64 `protobuf:"varint,9,opt,name=day_fee_sum_msat" json:"day_fee_sum_msat,omitempty"`
+	// / The total amount of fee revenue (in milli-satoshis) earned by forwarding over this channel over the past 1 week.
+	WeekFeeSumMsat uint64 `protobuf:"varint,10,opt,name=week_fee_sum_msat" json:"week_fee_sum_msat,omitempty"`
+	// / The total amount of fee revenue (in milli-satoshis) earned by forwarding over this channel over the past 1 month.
+	MonthFeeSumMsat uint64 `protobuf:"varint,11,opt,name=month_fee_sum_msat" json:"month_fee_sum_msat,omitempty"`
+}
+
+func (m *ChannelFeeReport) Reset()                    { *m = ChannelFeeReport{} }
+func (m *ChannelFeeReport) String() string            { return proto.CompactTextString(m) }
+func (*ChannelFeeReport) ProtoMessage()               {}
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{139} }
+
+func (m *ChannelFeeReport) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
+	if m != nil {
+		return m.BaseFeeMsat
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetFeePerMil() int64 {
+	if m != nil {
+		return m.FeePerMil
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetFeeRate() float64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetPolicyFailures() uint64 {
+	if m != nil {
+		return m.PolicyFailures
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetDownstreamFailures() uint64 {
+	if m != nil {
+		return m.DownstreamFailures
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetInsufficientBalanceFailures() uint64 {
+	if m != nil {
+		return m.InsufficientBalanceFailures
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetLinkOfflineFailures() uint64 {
+	if m != nil {
+		return m.LinkOfflineFailures
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetDayFeeSumMsat() uint64 {
+	if m != nil {
+		return m.DayFeeSumMsat
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetWeekFeeSumMsat() uint64 {
+	if m != nil {
+		return m.WeekFeeSumMsat
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetMonthFeeSumMsat() uint64 {
+	if m != nil {
+		return m.MonthFeeSumMsat
+	}
+	return 0
+}
+
+type FeeReportResponse struct {
+	// / An array of channel fee reports which describes the current fee schedule for each channel.
+	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees" json:"channel_fees,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
+	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum" json:"day_fee_sum,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
+	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum" json:"week_fee_sum,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
+	MonthFeeSum uint64 `protobuf:"varint,4,opt,name=month_fee_sum" json:"month_fee_sum,omitempty"`
+}
+
+func (m *FeeReportResponse) Reset()                    { *m = FeeReportResponse{} }
+func (m *FeeReportResponse) String() string            { return proto.CompactTextString(m) }
+func (*FeeReportResponse) ProtoMessage()               {}
+func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{140} }
+
+func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
+	if m != nil {
+		return m.ChannelFees
+	}
+	return nil
+}
+
+func (m *FeeReportResponse) GetDayFeeSum() uint64 {
+	if m != nil {
+		return m.DayFeeSum
+	}
+	return 0
+}
+
+func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
+	if m != nil {
+		return m.WeekFeeSum
+	}
+	return 0
+}
+
+func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
+	if m != nil {
+		return m.MonthFeeSum
+	}
+	return 0
+}
+
+type PolicyUpdateRequest struct {
+	// Types that are valid to be assigned to Scope:
+	//	*PolicyUpdateRequest_Global
+	//	*PolicyUpdateRequest_ChanPoint
+	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
+	// / The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat" json:"base_fee_msat,omitempty"`
+	// / The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate" json:"fee_rate,omitempty"`
+	// / The required timelock delta for HTLCs forwarded over the channel.
+	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta" json:"time_lock_delta,omitempty"`
+	// / If set, the inbound fee charged for HTLCs entering through the channel, on top of the fee of the outgoing channel. If unset, the current inbound fee is left unchanged.
+	InboundFee *InboundFee `protobuf:"bytes,6,opt,name=inbound_fee" json:"inbound_fee,omitempty"`
+	// / If non-zero, the maximum HTLC size in milli-satoshis that will be forwarded over the channel. If zero, the current maximum is left unchanged.
+	MaxHtlcMsat uint64 `protobuf:"varint,7,opt,name=max_htlc_msat" json:"max_htlc_msat,omitempty"`
+	// / If non-zero, the minimum HTLC size in milli-satoshis that will be forwarded over the channel. If zero, the current minimum is left unchanged.
+	MinHtlcMsat uint64 `protobuf:"varint,8,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
+}
+
+func (m *PolicyUpdateRequest) Reset()                    { *m = PolicyUpdateRequest{} }
+func (m *PolicyUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*PolicyUpdateRequest) ProtoMessage()               {}
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{141} }
+
+type isPolicyUpdateRequest_Scope interface{ isPolicyUpdateRequest_Scope() }
+
+type PolicyUpdateRequest_Global struct {
+	Global bool `protobuf:"varint,1,opt,name=global,oneof"`
+}
+type PolicyUpdateRequest_ChanPoint struct {
+	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,oneof"`
+}
+
+func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope()    {}
+func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
+
+func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *PolicyUpdateRequest) GetGlobal() bool {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
+		return x.Global
+	}
+	return false
+}
+
+func (m *PolicyUpdateRequest) GetChanPoint() *ChannelPoint {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_ChanPoint); ok {
+		return x.ChanPoint
+	}
+	return nil
+}
+
+func (m *PolicyUpdateRequest) GetBaseFeeMsat() int64 {
+	if m != nil {
+		return m.BaseFeeMsat
+	}
+	return 0
+}
+
+func (m *PolicyUpdateRequest) GetFeeRate() float64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
+	if m != nil {
+		return m.TimeLockDelta
+	}
+	return 0
+}
+
+func (m *PolicyUpdateRequest) GetInboundFee() *InboundFee {
+	if m != nil {
+		return m.InboundFee
+	}
+	return nil
+}
+
+func (m *PolicyUpdateRequest) GetMaxHtlcMsat() uint64 {
+	if m != nil {
+		return m.MaxHtlcMsat
+	}
+	return 0
+}
+
+func (m *PolicyUpdateRequest) GetMinHtlcMsat() uint64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*PolicyUpdateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _PolicyUpdateRequest_OneofMarshaler, _PolicyUpdateRequest_OneofUnmarshaler, _PolicyUpdateRequest_OneofSizer, []interface{}{
+		(*PolicyUpdateRequest_Global)(nil),
+		(*PolicyUpdateRequest_ChanPoint)(nil),
+	}
+}
+
+func _PolicyUpdateRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*PolicyUpdateRequest)
+	// scope
+	switch x := m.Scope.(type) {
+	case *PolicyUpdateRequest_Global:
+		t := uint64(0)
+		if x.Global {
+			t = 1
+		}
+		b.EncodeVarint(1<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case *PolicyUpdateRequest_ChanPoint:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanPoint); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("PolicyUpdateRequest.Scope has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _PolicyUpdateRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*PolicyUpdateRequest)
+	switch tag {
+	case 1: // scope.global
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Scope = &PolicyUpdateRequest_Global{x != 0}
+		return true, err
+	case 2: // scope.chan_point
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelPoint)
+		err := b.DecodeMessage(msg)
+		m.Scope = &PolicyUpdateRequest_ChanPoint{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _PolicyUpdateRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*PolicyUpdateRequest)
+	// scope
+	switch x := m.Scope.(type) {
+	case *PolicyUpdateRequest_Global:
+		n += proto.SizeVarint(1<<3 | proto.WireVarint)
+		n += 1
+	case *PolicyUpdateRequest_ChanPoint:
+		s := proto.Size(x.ChanPoint)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type PolicyUpdateResponse struct {
+}
+
+func (m *PolicyUpdateResponse) Reset()                    { *m = PolicyUpdateResponse{} }
+func (m *PolicyUpdateResponse) String() string            { return proto.CompactTextString(m) }
+func (*PolicyUpdateResponse) ProtoMessage()               {}
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{142} }
+
+type ForwardingHistoryRequest struct {
+	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time" json:"start_time,omitempty"`
+	// / End time is the end point of the forwarding history request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time" json:"end_time,omitempty"`
+	// / Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
+	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset" json:"index_offset,omitempty"`
+	// / The max number of events to return in the response to this query.
+	NumMaxEvents uint32 `protobuf:"varint,4,opt,name=num_max_events" json:"num_max_events,omitempty"`
+}
+
+func (m *ForwardingHistoryRequest) Reset()                    { *m = ForwardingHistoryRequest{} }
+func (m *ForwardingHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingHistoryRequest) ProtoMessage()               {}
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{143} }
+
+func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
+	if m != nil {
+		return m.NumMaxEvents
+	}
+	return 0
+}
+
+type ForwardingEvent struct {
+	// / Timestamp is the time (unix epoch offset) that this circuit was completed.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// / The incoming channel ID that carried the HTLC that created the circuit.
+	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in" json:"chan_id_in,omitempty"`
+	// / The outgoing channel ID that carried the preimage that completed the circuit.
+	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out" json:"chan_id_out,omitempty"`
+	// / The total amount of the incoming HTLC that created half the circuit.
+	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in" json:"amt_in,omitempty"`
+	// / The total amount of the outgoign HTLC that created the second half of the circuit.
+	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out" json:"amt_out,omitempty"`
+	// / The total fee that this payment circuit carried.
+	Fee uint64 `protobuf:"varint,7,opt,name=fee" json:"fee,omitempty"`
+}
+
+func (m *ForwardingEvent) Reset()                    { *m = ForwardingEvent{} }
+func (m *ForwardingEvent) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingEvent) ProtoMessage()               {}
+func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{144} }
+
+func (m *ForwardingEvent) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetChanIdIn() uint64 {
+	if m != nil {
+		return m.ChanIdIn
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetChanIdOut() uint64 {
+	if m != nil {
+		return m.ChanIdOut
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetAmtIn() uint64 {
+	if m != nil {
+		return m.AmtIn
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetAmtOut() uint64 {
+	if m != nil {
+		return m.AmtOut
+	}
+	return 0
+}
+
+func (m *ForwardingEvent) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+type ForwardingHistoryResponse struct {
+	// / A list of forwarding events from the time slice of the time series specified in the request.
+	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events" json:"forwarding_events,omitempty"`
+	// / The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
+	LastOffsetIndex uint32 `protobuf:"varint,2,opt,name=last_offset_index" json:"last_offset_index,omitempty"`
+}
+
+func (m *ForwardingHistoryResponse) Reset()                    { *m = ForwardingHistoryResponse{} }
+func (m *ForwardingHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*ForwardingHistoryResponse) ProtoMessage()               {}
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{145} }
+
+func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+	if m != nil {
+		return m.ForwardingEvents
+	}
+	return nil
+}
+
+func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
+	if m != nil {
+		return m.LastOffsetIndex
+	}
+	return 0
+}
+
+type ListSweepableOutputsRequest struct {
+	// / The target number of blocks the fee estimate of each output is based on.
+	TargetConf int32 `protobuf:"varint,1,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte the fee estimate of each output is based on.
+	SatPerByte int64 `protobuf:"varint,2,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+}
+
+func (m *ListSweepableOutputsRequest) Reset()                    { *m = ListSweepableOutputsRequest{} }
+func (m *ListSweepableOutputsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListSweepableOutputsRequest) ProtoMessage()               {}
+func (*ListSweepableOutputsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{146} }
+
+func (m *ListSweepableOutputsRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *ListSweepableOutputsRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+type SweepableOutput struct {
+	// / The outpoint in format txid:n
+	Outpoint string `protobuf:"bytes,1,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The channel point of the force closed channel the output originates from.
+	ChannelPoint string `protobuf:"bytes,2,opt,name=channel_point" json:"channel_point,omitempty"`
+	// / The value of the output in satoshis.
+	AmountSat int64 `protobuf:"varint,3,opt,name=amount_sat" json:"amount_sat,omitempty"`
+	// / The type of witness required to spend the output.
+	WitnessType string `protobuf:"bytes,4,opt,name=witness_type" json:"witness_type,omitempty"`
+	// / The height at which the output is swept.
+	MaturityHeight uint32 `protobuf:"varint,5,opt,name=maturity_height" json:"maturity_height,omitempty"`
+	// / The number of blocks remaining until the output is swept. Negative if the output has already been swept, but the sweep has yet to confirm.
+	BlocksTilMaturity int32 `protobuf:"varint,6,opt,name=blocks_til_maturity" json:"blocks_til_maturity,omitempty"`
+	// / The fee in satoshis required to sweep the output on its own at the requested fee rate.
+	EstimatedFeeSat int64 `protobuf:"varint,7,opt,name=estimated_fee_sat" json:"estimated_fee_sat,omitempty"`
+	// / The txid of the transaction that was broadcast to sweep the output, if any.
+	SweepTxid string `protobuf:"bytes,8,opt,name=sweep_txid" json:"sweep_txid,omitempty"`
+}
+
+func (m *SweepableOutput) Reset()                    { *m = SweepableOutput{} }
+func (m *SweepableOutput) String() string            { return proto.CompactTextString(m) }
+func (*SweepableOutput) ProtoMessage()               {}
+func (*SweepableOutput) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{147} }
+
+func (m *SweepableOutput) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
+	}
+	return ""
+}
+
+func (m *SweepableOutput) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *SweepableOutput) GetAmountSat() int64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *SweepableOutput) GetWitnessType() string {
+	if m != nil {
+		return m.WitnessType
+	}
+	return ""
+}
+
+func (m *SweepableOutput) GetMaturityHeight() uint32 {
+	if m != nil {
+		return m.MaturityHeight
+	}
+	return 0
+}
+
+func (m *SweepableOutput) GetBlocksTilMaturity() int32 {
+	if m != nil {
+		return m.BlocksTilMaturity
+	}
+	return 0
+}
+
+func (m *SweepableOutput) GetEstimatedFeeSat() int64 {
+	if m != nil {
+		return m.EstimatedFeeSat
+	}
+	return 0
+}
+
+func (m *SweepableOutput) GetSweepTxid() string {
+	if m != nil {
+		return m.SweepTxid
+	}
+	return ""
+}
+
+type ListSweepableOutputsResponse struct {
+	// / The outputs being swept back into the wallet.
+	Outputs []*SweepableOutput `protobuf:"bytes,1,rep,name=outputs" json:"outputs,omitempty"`
+}
+
+func (m *ListSweepableOutputsResponse) Reset()                    { *m = ListSweepableOutputsResponse{} }
+func (m *ListSweepableOutputsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListSweepableOutputsResponse) ProtoMessage()               {}
+func (*ListSweepableOutputsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{148} }
+
+func (m *ListSweepableOutputsResponse) GetOutputs() []*SweepableOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+type SweepOutputsRequest struct {
+	// / The outpoints to sweep, in format txid:n
+	Outpoints []string `protobuf:"bytes,1,rep,name=outpoints" json:"outpoints,omitempty"`
+	// / The target number of blocks that the sweep transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the sweep transaction.
+	SatPerByte int64 `protobuf:"varint,3,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+}
+
+func (m *SweepOutputsRequest) Reset()                    { *m = SweepOutputsRequest{} }
+func (m *SweepOutputsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SweepOutputsRequest) ProtoMessage()               {}
+func (*SweepOutputsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{149} }
+
+func (m *SweepOutputsRequest) GetOutpoints() []string {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+func (m *SweepOutputsRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *SweepOutputsRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+type SweepOutputsResponse struct {
+	// / The transaction ID of the sweep transaction
+	SweepTxid string `protobuf:"bytes,1,opt,name=sweep_txid" json:"sweep_txid,omitempty"`
+}
+
+func (m *SweepOutputsResponse) Reset()                    { *m = SweepOutputsResponse{} }
+func (m *SweepOutputsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SweepOutputsResponse) ProtoMessage()               {}
+func (*SweepOutputsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{150} }
+
+func (m *SweepOutputsResponse) GetSweepTxid() string {
+	if m != nil {
+		return m.SweepTxid
+	}
+	return ""
+}
+
+type ArchiveClosedChannelsRequest struct {
+	// / The number of blocks after a channel was closed at which its record is deleted altogether. If zero, records are only compacted.
+	RetentionBlocks uint32 `protobuf:"varint,1,opt,name=retention_blocks" json:"retention_blocks,omitempty"`
+}
+
+func (m *ArchiveClosedChannelsRequest) Reset()                    { *m = ArchiveClosedChannelsRequest{} }
+func (m *ArchiveClosedChannelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ArchiveClosedChannelsRequest) ProtoMessage()               {}
+func (*ArchiveClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{151} }
+
+func (m *ArchiveClosedChannelsRequest) GetRetentionBlocks() uint32 {
+	if m != nil {
+		return m.RetentionBlocks
+	}
+	return 0
+}
+
+type ArchiveClosedChannelsResponse struct {
+	// / The number of channel records that were compacted.
+	NumArchived uint32 `protobuf:"varint,1,opt,name=num_archived" json:"num_archived,omitempty"`
+	// / The number of channel records that were deleted.
+	NumDeleted uint32 `protobuf:"varint,2,opt,name=num_deleted" json:"num_deleted,omitempty"`
+}
+
+func (m *ArchiveClosedChannelsResponse) Reset()         { *m = ArchiveClosedChannelsResponse{} }
+func (m *ArchiveClosedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*ArchiveClosedChannelsResponse) ProtoMessage()    {}
+func (*ArchiveClosedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{152}
+}
+
+func (m *ArchiveClosedChannelsResponse) GetNumArchived() uint32 {
+	if m != nil {
+		return m.NumArchived
+	}
+	return 0
+}
+
+func (m *ArchiveClosedChannelsResponse) GetNumDeleted() uint32 {
+	if m != nil {
+		return m.NumDeleted
+	}
+	return 0
+}
+
+type InboundFee struct {
+	// / The base inbound fee in milli-satoshis, which is a discount if negative.
+	BaseFeeMsat int32 `protobuf:"varint,1,opt,name=base_fee_msat" json:"base_fee_msat,omitempty"`
+	// / The proportional inbound fee in parts per million, which is a discount if negative.
+	FeeRatePpm int32 `protobuf:"varint,2,opt,name=fee_rate_ppm" json:"fee_rate_ppm,omitempty"`
+}
+
+func (m *InboundFee) Reset()                    { *m = InboundFee{} }
+func (m *InboundFee) String() string            { return proto.CompactTextString(m) }
+func (*InboundFee) ProtoMessage()               {}
+func (*InboundFee) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{153} }
+
+func (m *InboundFee) GetBaseFeeMsat() int32 {
+	if m != nil {
+		return m.BaseFeeMsat
+	}
+	return 0
+}
+
+func (m *InboundFee) GetFeeRatePpm() int32 {
+	if m != nil {
+		return m.FeeRatePpm
+	}
+	return 0
+}
+
+type CancelPaymentRequest struct {
+	// / The hash of the payment to cancel.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The hex-encoded hash of the payment to cancel.
+	PaymentHashStr string `protobuf:"bytes,2,opt,name=payment_hash_str" json:"payment_hash_str,omitempty"`
+}
+
+func (m *CancelPaymentRequest) Reset()                    { *m = CancelPaymentRequest{} }
+func (m *CancelPaymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelPaymentRequest) ProtoMessage()               {}
+func (*CancelPaymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{154} }
+
+func (m *CancelPaymentRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *CancelPaymentRequest) GetPaymentHashStr() string {
+	if m != nil {
+		return m.PaymentHashStr
+	}
+	return ""
+}
+
+type CancelPaymentResponse struct {
+}
+
+func (m *CancelPaymentResponse) Reset()                    { *m = CancelPaymentResponse{} }
+func (m *CancelPaymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*CancelPaymentResponse) ProtoMessage()               {}
+func (*CancelPaymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{155} }
+
+type ExportChannelAuditRequest struct {
+}
+
+func (m *ExportChannelAuditRequest) Reset()                    { *m = ExportChannelAuditRequest{} }
+func (m *ExportChannelAuditRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportChannelAuditRequest) ProtoMessage()               {}
+func (*ExportChannelAuditRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{156} }
+
+type ChannelAuditEntry struct {
+	// / The outpoint of the funding transaction of the channel.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
+	// / The short channel ID of the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The identity pubkey of the remote node.
+	RemotePubkey string `protobuf:"bytes,3,opt,name=remote_pubkey" json:"remote_pubkey,omitempty"`
+	// / The total amount of funds held in the channel.
+	Capacity int64 `protobuf:"varint,4,opt,name=capacity" json:"capacity,omitempty"`
+	// / Our balance within the latest local commitment.
+	LocalBalance int64 `protobuf:"varint,5,opt,name=local_balance" json:"local_balance,omitempty"`
+	// / The balance of the remote node within the latest local commitment.
+	RemoteBalance int64 `protobuf:"varint,6,opt,name=remote_balance" json:"remote_balance,omitempty"`
+	// / The height of the latest local commitment.
+	CommitHeight uint64 `protobuf:"varint,7,opt,name=commit_height" json:"commit_height,omitempty"`
+	// / The txid of the latest local commitment transaction.
+	CommitTxid string `protobuf:"bytes,8,opt,name=commit_txid" json:"commit_txid,omitempty"`
+	// / The fee paid by the latest local commitment transaction.
+	CommitFee int64 `protobuf:"varint,9,opt,name=commit_fee" json:"commit_fee,omitempty"`
+	// / The number of HTLCs pending within the latest local commitment.
+	NumPendingHtlcs uint32 `protobuf:"varint,10,opt,name=num_pending_htlcs" json:"num_pending_htlcs,omitempty"`
+	// / The total amount of the HTLCs pending within the latest local commitment.
+	PendingHtlcAmt int64 `protobuf:"varint,11,opt,name=pending_htlc_amt" json:"pending_htlc_amt,omitempty"`
+	// / Whether the funding transaction of the channel is yet to be confirmed.
+	Pending bool `protobuf:"varint,12,opt,name=pending" json:"pending,omitempty"`
+}
+
+func (m *ChannelAuditEntry) Reset()                    { *m = ChannelAuditEntry{} }
+func (m *ChannelAuditEntry) String() string            { return proto.CompactTextString(m) }
+func (*ChannelAuditEntry) ProtoMessage()               {}
+func (*ChannelAuditEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{157} }
+
+func (m *ChannelAuditEntry) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *ChannelAuditEntry) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ChannelAuditEntry) GetRemotePubkey() string {
+	if m != nil {
+		return m.RemotePubkey
+	}
+	return ""
+}
+
+func (m *ChannelAuditEntry) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ChannelAuditEntry) GetLocalBalance() int64 {
+	if m != nil {
+		return m.LocalBalance
+	}
+	return 0
+}
+
+func (m *ChannelAuditEntry) GetRemoteBalance() int64 {
+	if m != nil {
+		return m.RemoteBalance
+	}
+	return 0
+}
+
+func (m *ChannelAuditEntry) GetCommitHeight() uint64 {
+	if m != nil {
+		return m.CommitHeight
+	}
+	return 0
+}
+
+func (m *ChannelAuditEntry) GetCommitTxid() string {
+	if m != nil {
+		return m.CommitTxid
+	}
+	return ""
+}
+
+func (m *ChannelAuditEntry) GetCommitFee() int64 {
+	if m != nil {
+		return m.CommitFee
+	}
+	return 0
+}
+
+func (m *ChannelAuditEntry) GetNumPendingHtlcs() uint32 {
+	if m != nil {
+		return m.NumPendingHtlcs
+	}
+	return 0
+}
+
+func (m *ChannelAuditEntry) GetPendingHtlcAmt() int64 {
+	if m != nil {
+		return m.PendingHtlcAmt
+	}
+	return 0
+}
+
+func (m *ChannelAuditEntry) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+type ExportChannelAuditResponse struct {
+	// / The identity pubkey of the node that signed the snapshot.
+	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey" json:"identity_pubkey,omitempty"`
+	// / The height of the best block at the time of the snapshot.
+	BlockHeight uint32 `protobuf:"varint,2,opt,name=block_height" json:"block_height,omitempty"`
+	// / The hash of the best block at the time of the snapshot.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash" json:"block_hash,omitempty"`
+	// / The unix timestamp of the snapshot.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	// / The sum of our balances across all channels.
+	TotalLocalBalance int64 `protobuf:"varint,5,opt,name=total_local_balance" json:"total_local_balance,omitempty"`
+	// / The state of each of the open channels.
+	Channels []*ChannelAuditEntry `protobuf:"bytes,6,rep,name=channels" json:"channels,omitempty"`
+	// / The JSON serialization of the snapshot, over which the signature is made.
+	Snapshot []byte `protobuf:"bytes,7,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// / The zbase32 encoded signature of the snapshot by the identity key.
+	Signature string `protobuf:"bytes,8,opt,name=signature" json:"signature,omitempty"`
+}
+
+func (m *ExportChannelAuditResponse) Reset()                    { *m = ExportChannelAuditResponse{} }
+func (m *ExportChannelAuditResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExportChannelAuditResponse) ProtoMessage()               {}
+func (*ExportChannelAuditResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{158} }
+
+func (m *ExportChannelAuditResponse) GetIdentityPubkey() string {
+	if m != nil {
+		return m.IdentityPubkey
+	}
+	return ""
+}
+
+func (m *ExportChannelAuditResponse) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ExportChannelAuditResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *ExportChannelAuditResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportChannelAuditResponse) GetTotalLocalBalance() int64 {
+	if m != nil {
+		return m.TotalLocalBalance
+	}
+	return 0
+}
+
+func (m *ExportChannelAuditResponse) GetChannels() []*ChannelAuditEntry {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *ExportChannelAuditResponse) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+func (m *ExportChannelAuditResponse) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type FreezeChannelRequest struct {
+	// / The outpoint of the funding transaction of the channel.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
+	// / If true, the channel is taken out of drain mode, allowing it to carry new HTLCs once again.
+	Thaw bool `protobuf:"varint,2,opt,name=thaw" json:"thaw,omitempty"`
+}
+
+func (m *FreezeChannelRequest) Reset()                    { *m = FreezeChannelRequest{} }
+func (m *FreezeChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*FreezeChannelRequest) ProtoMessage()               {}
+func (*FreezeChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{159} }
+
+func (m *FreezeChannelRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *FreezeChannelRequest) GetThaw() bool {
+	if m != nil {
+		return m.Thaw
+	}
+	return false
+}
+
+type FreezeChannelResponse struct {
+	// / The number of HTLCs still in flight over the channel.
+	NumPendingHtlcs uint32 `protobuf:"varint,1,opt,name=num_pending_htlcs" json:"num_pending_htlcs,omitempty"`
+}
+
+func (m *FreezeChannelResponse) Reset()                    { *m = FreezeChannelResponse{} }
+func (m *FreezeChannelResponse) String() string            { return proto.CompactTextString(m) }
+func (*FreezeChannelResponse) ProtoMessage()               {}
+func (*FreezeChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{160} }
+
+func (m *FreezeChannelResponse) GetNumPendingHtlcs() uint32 {
+	if m != nil {
+		return m.NumPendingHtlcs
+	}
+	return 0
+}
+
+type UpdateChannelConstraintsRequest struct {
+	// / The outpoint of the funding transaction of the channel.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
+	// / The maximum total value of the HTLCs that the remote party may have in flight. If zero, the current value is kept.
+	MaxPendingAmtMsat uint64 `protobuf:"varint,2,opt,name=max_pending_amt_msat" json:"max_pending_amt_msat,omitempty"`
+	// / The smallest HTLC that the remote party may offer. If zero, the current value is kept.
+	MinHtlcMsat uint64 `protobuf:"varint,3,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
+	// / The maximum number of HTLCs that the remote party may have in flight. If zero, the current value is kept.
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,4,opt,name=max_accepted_htlcs" json:"max_accepted_htlcs,omitempty"`
+}
+
+func (m *UpdateChannelConstraintsRequest) Reset()         { *m = UpdateChannelConstraintsRequest{} }
+func (m *UpdateChannelConstraintsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateChannelConstraintsRequest) ProtoMessage()    {}
+func (*UpdateChannelConstraintsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{161}
+}
+
+func (m *UpdateChannelConstraintsRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *UpdateChannelConstraintsRequest) GetMaxPendingAmtMsat() uint64 {
+	if m != nil {
+		return m.MaxPendingAmtMsat
+	}
+	return 0
+}
+
+func (m *UpdateChannelConstraintsRequest) GetMinHtlcMsat() uint64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
+}
+
+func (m *UpdateChannelConstraintsRequest) GetMaxAcceptedHtlcs() uint32 {
+	if m != nil {
+		return m.MaxAcceptedHtlcs
+	}
+	return 0
+}
+
+type UpdateChannelConstraintsResponse struct {
+	// / The maximum total value of the HTLCs that the remote party may now have in flight.
+	MaxPendingAmtMsat uint64 `protobuf:"varint,1,opt,name=max_pending_amt_msat" json:"max_pending_amt_msat,omitempty"`
+	// / The smallest HTLC that the remote party may now offer.
+	MinHtlcMsat uint64 `protobuf:"varint,2,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
+	// / The maximum number of HTLCs that the remote party may now have in flight.
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,3,opt,name=max_accepted_htlcs" json:"max_accepted_htlcs,omitempty"`
+}
+
+func (m *UpdateChannelConstraintsResponse) Reset()         { *m = UpdateChannelConstraintsResponse{} }
+func (m *UpdateChannelConstraintsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateChannelConstraintsResponse) ProtoMessage()    {}
+func (*UpdateChannelConstraintsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{162}
+}
+
+func (m *UpdateChannelConstraintsResponse) GetMaxPendingAmtMsat() uint64 {
+	if m != nil {
+		return m.MaxPendingAmtMsat
+	}
+	return 0
+}
+
+func (m *UpdateChannelConstraintsResponse) GetMinHtlcMsat() uint64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
+}
+
+func (m *UpdateChannelConstraintsResponse) GetMaxAcceptedHtlcs() uint32 {
+	if m != nil {
+		return m.MaxAcceptedHtlcs
+	}
+	return 0
+}
+
+type ChannelEventSubscription struct {
+}
+
+func (m *ChannelEventSubscription) Reset()                    { *m = ChannelEventSubscription{} }
+func (m *ChannelEventSubscription) String() string            { return proto.CompactTextString(m) }
+func (*ChannelEventSubscription) ProtoMessage()               {}
+func (*ChannelEventSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{163} }
+
+type ChannelEventUpdate struct {
+	// / The channel that was opened, set for OPEN_CHANNEL updates.
+	OpenChannel *Channel `protobuf:"bytes,1,opt,name=open_channel" json:"open_channel,omitempty"`
+	// / The summary of the channel that was closed, set for CLOSED_CHANNEL updates.
+	ClosedChannel *ChannelCloseSummary `protobuf:"bytes,2,opt,name=closed_channel" json:"closed_channel,omitempty"`
+	// / The channel that became active, set for ACTIVE_CHANNEL updates.
+	ActiveChannel *ChannelPoint `protobuf:"bytes,3,opt,name=active_channel" json:"active_channel,omitempty"`
+	// / The channel that became inactive, set for INACTIVE_CHANNEL updates.
+	InactiveChannel *ChannelPoint `protobuf:"bytes,4,opt,name=inactive_channel" json:"inactive_channel,omitempty"`
+	// / The type of the update, determining which of the other fields is set.
+	Type ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
+	// / The funding outpoint of the channel pending open, set for PENDING_OPEN_CHANNEL updates.
+	PendingOpenChannel *PendingUpdate `protobuf:"bytes,6,opt,name=pending_open_channel" json:"pending_open_channel,omitempty"`
+	// / The channel whose contracts were all resolved, set for FULLY_RESOLVED_CHANNEL updates.
+	FullyResolvedChannel *ChannelPoint `protobuf:"bytes,7,opt,name=fully_resolved_channel" json:"fully_resolved_channel,omitempty"`
+}
+
+func (m *ChannelEventUpdate) Reset()                    { *m = ChannelEventUpdate{} }
+func (m *ChannelEventUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ChannelEventUpdate) ProtoMessage()               {}
+func (*ChannelEventUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{164} }
+
+func (m *ChannelEventUpdate) GetOpenChannel() *Channel {
+	if m != nil {
+		return m.OpenChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetClosedChannel() *ChannelCloseSummary {
+	if m != nil {
+		return m.ClosedChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetActiveChannel() *ChannelPoint {
+	if m != nil {
+		return m.ActiveChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetInactiveChannel() *ChannelPoint {
+	if m != nil {
+		return m.InactiveChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetType() ChannelEventUpdate_UpdateType {
+	if m != nil {
+		return m.Type
+	}
+	return ChannelEventUpdate_OPEN_CHANNEL
+}
+
+func (m *ChannelEventUpdate) GetPendingOpenChannel() *PendingUpdate {
+	if m != nil {
+		return m.PendingOpenChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetFullyResolvedChannel() *ChannelPoint {
+	if m != nil {
+		return m.FullyResolvedChannel
+	}
+	return nil
+}
+
+type PeerEventSubscription struct {
+}
+
+func (m *PeerEventSubscription) Reset()                    { *m = PeerEventSubscription{} }
+func (m *PeerEventSubscription) String() string            { return proto.CompactTextString(m) }
+func (*PeerEventSubscription) ProtoMessage()               {}
+func (*PeerEventSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{165} }
+
+type PeerEvent struct {
+	// / The identity pubkey of the peer.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
+	// / Whether the peer came online or went offline.
+	Type PeerEvent_EventType `protobuf:"varint,2,opt,name=type,enum=lnrpc.PeerEvent_EventType" json:"type,omitempty"`
+}
+
+func (m *PeerEvent) Reset()                    { *m = PeerEvent{} }
+func (m *PeerEvent) String() string            { return proto.CompactTextString(m) }
+func (*PeerEvent) ProtoMessage()               {}
+func (*PeerEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{166} }
+
+func (m *PeerEvent) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *PeerEvent) GetType() PeerEvent_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return PeerEvent_PEER_ONLINE
+}
+
+type DeletePaymentRequest struct {
+	// / The hash of the payment to delete.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The hex-encoded hash of the payment to delete.
+	PaymentHashStr string `protobuf:"bytes,2,opt,name=payment_hash_str" json:"payment_hash_str,omitempty"`
+}
+
+func (m *DeletePaymentRequest) Reset()                    { *m = DeletePaymentRequest{} }
+func (m *DeletePaymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeletePaymentRequest) ProtoMessage()               {}
+func (*DeletePaymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{167} }
+
+func (m *DeletePaymentRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *DeletePaymentRequest) GetPaymentHashStr() string {
+	if m != nil {
+		return m.PaymentHashStr
+	}
+	return ""
+}
+
+type DeletePaymentResponse struct {
+}
+
+func (m *DeletePaymentResponse) Reset()                    { *m = DeletePaymentResponse{} }
+func (m *DeletePaymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeletePaymentResponse) ProtoMessage()               {}
+func (*DeletePaymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{168} }
+
+type ExportChannelBackupRequest struct {
+	// / The target channel point to obtain a back up for.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point" json:"chan_point,omitempty"`
+}
+
+func (m *ExportChannelBackupRequest) Reset()                    { *m = ExportChannelBackupRequest{} }
+func (m *ExportChannelBackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportChannelBackupRequest) ProtoMessage()               {}
+func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{169} }
+
+func (m *ExportChannelBackupRequest) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+type ChannelBackup struct {
+	// / Identifies the channel that this backup belongs to.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point" json:"chan_point,omitempty"`
+	// / Is an encrypted single-chan backup.
+	ChanBackup []byte `protobuf:"bytes,2,opt,name=chan_backup,proto3" json:"chan_backup,omitempty"`
+}
+
+func (m *ChannelBackup) Reset()                    { *m = ChannelBackup{} }
+func (m *ChannelBackup) String() string            { return proto.CompactTextString(m) }
+func (*ChannelBackup) ProtoMessage()               {}
+func (*ChannelBackup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{170} }
+
+func (m *ChannelBackup) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *ChannelBackup) GetChanBackup() []byte {
+	if m != nil {
+		return m.ChanBackup
+	}
+	return nil
+}
+
+type MultiChanBackup struct {
+	// / Is the set of all channels that are included in this multi-channel backup.
+	ChanPoints []*ChannelPoint `protobuf:"bytes,1,rep,name=chan_points" json:"chan_points,omitempty"`
+	// *
+	// A single encrypted blob containing all the static channel backups of the
+	// channels listed above. This can be stored as a single file or blob, and
+	// safely be replaced with any prior/future versions.
+	MultiChanBackup []byte `protobuf:"bytes,2,opt,name=multi_chan_backup,proto3" json:"multi_chan_backup,omitempty"`
+}
+
+func (m *MultiChanBackup) Reset()                    { *m = MultiChanBackup{} }
+func (m *MultiChanBackup) String() string            { return proto.CompactTextString(m) }
+func (*MultiChanBackup) ProtoMessage()               {}
+func (*MultiChanBackup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{171} }
+
+func (m *MultiChanBackup) GetChanPoints() []*ChannelPoint {
+	if m != nil {
+		return m.ChanPoints
+	}
+	return nil
+}
+
+func (m *MultiChanBackup) GetMultiChanBackup() []byte {
+	if m != nil {
+		return m.MultiChanBackup
+	}
+	return nil
+}
+
+type ChanBackupExportRequest struct {
+}
+
+func (m *ChanBackupExportRequest) Reset()                    { *m = ChanBackupExportRequest{} }
+func (m *ChanBackupExportRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChanBackupExportRequest) ProtoMessage()               {}
+func (*ChanBackupExportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{172} }
+
+type ChanBackupSnapshot struct {
+	// / The set of single-chan backups of all channels currently known to lnd.
+	SingleChanBackups *ChannelBackups `protobuf:"bytes,1,opt,name=single_chan_backups" json:"single_chan_backups,omitempty"`
+	// / A multi-channel backup that covers all open channels currently known to lnd.
+	MultiChanBackup *MultiChanBackup `protobuf:"bytes,2,opt,name=multi_chan_backup" json:"multi_chan_backup,omitempty"`
+}
+
+func (m *ChanBackupSnapshot) Reset()                    { *m = ChanBackupSnapshot{} }
+func (m *ChanBackupSnapshot) String() string            { return proto.CompactTextString(m) }
+func (*ChanBackupSnapshot) ProtoMessage()               {}
+func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{173} }
+
+func (m *ChanBackupSnapshot) GetSingleChanBackups() *ChannelBackups {
+	if m != nil {
+		return m.SingleChanBackups
+	}
+	return nil
+}
+
+func (m *ChanBackupSnapshot) GetMultiChanBackup() *MultiChanBackup {
+	if m != nil {
+		return m.MultiChanBackup
+	}
+	return nil
+}
+
+type ChannelBackups struct {
+	// / A set of single-chan static channel backups.
+	ChanBackups []*ChannelBackup `protobuf:"bytes,1,rep,name=chan_backups" json:"chan_backups,omitempty"`
+}
+
+func (m *ChannelBackups) Reset()                    { *m = ChannelBackups{} }
+func (m *ChannelBackups) String() string            { return proto.CompactTextString(m) }
+func (*ChannelBackups) ProtoMessage()               {}
+func (*ChannelBackups) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{174} }
+
+func (m *ChannelBackups) GetChanBackups() []*ChannelBackup {
+	if m != nil {
+		return m.ChanBackups
+	}
+	return nil
+}
+
+type ChannelBackupSubscription struct {
+}
+
+func (m *ChannelBackupSubscription) Reset()                    { *m = ChannelBackupSubscription{} }
+func (m *ChannelBackupSubscription) String() string            { return proto.CompactTextString(m) }
+func (*ChannelBackupSubscription) ProtoMessage()               {}
+func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{175} }
+
+type RestoreChanBackupRequest struct {
+	// Types that are valid to be assigned to Backup:
+	//	*RestoreChanBackupRequest_ChanBackups
+	//	*RestoreChanBackupRequest_MultiChanBackup
+	Backup isRestoreChanBackupRequest_Backup `protobuf_oneof:"backup"`
+}
+
+func (m *RestoreChanBackupRequest) Reset()                    { *m = RestoreChanBackupRequest{} }
+func (m *RestoreChanBackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreChanBackupRequest) ProtoMessage()               {}
+func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{176} }
+
+type isRestoreChanBackupRequest_Backup interface{ isRestoreChanBackupRequest_Backup() }
+
+type RestoreChanBackupRequest_ChanBackups struct {
+	ChanBackups *ChannelBackups `protobuf:"bytes,1,opt,name=chan_backups,oneof"`
+}
+type RestoreChanBackupRequest_MultiChanBackup struct {
+	MultiChanBackup []byte `protobuf:"bytes,2,opt,name=multi_chan_backup,proto3,oneof"`
+}
+
+func (*RestoreChanBackupRequest_ChanBackups) isRestoreChanBackupRequest_Backup()     {}
+func (*RestoreChanBackupRequest_MultiChanBackup) isRestoreChanBackupRequest_Backup() {}
+
+func (m *RestoreChanBackupRequest) GetBackup() isRestoreChanBackupRequest_Backup {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+func (m *RestoreChanBackupRequest) GetChanBackups() *ChannelBackups {
+	if x, ok := m.GetBackup().(*RestoreChanBackupRequest_ChanBackups); ok {
+		return x.ChanBackups
+	}
+	return nil
+}
+
+func (m *RestoreChanBackupRequest) GetMultiChanBackup() []byte {
+	if x, ok := m.GetBackup().(*RestoreChanBackupRequest_MultiChanBackup); ok {
+		return x.MultiChanBackup
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*RestoreChanBackupRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _RestoreChanBackupRequest_OneofMarshaler, _RestoreChanBackupRequest_OneofUnmarshaler, _RestoreChanBackupRequest_OneofSizer, []interface{}{
+		(*RestoreChanBackupRequest_ChanBackups)(nil),
+		(*RestoreChanBackupRequest_MultiChanBackup)(nil),
+	}
+}
+
+func _RestoreChanBackupRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*RestoreChanBackupRequest)
+	// backup
+	switch x := m.Backup.(type) {
+	case *RestoreChanBackupRequest_ChanBackups:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanBackups); err != nil {
+			return err
+		}
+	case *RestoreChanBackupRequest_MultiChanBackup:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.MultiChanBackup)
+	case nil:
+	default:
+		return fmt.Errorf("RestoreChanBackupRequest.Backup has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _RestoreChanBackupRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*RestoreChanBackupRequest)
+	switch tag {
+	case 1: // backup.chan_backups
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelBackups)
+		err := b.DecodeMessage(msg)
+		m.Backup = &RestoreChanBackupRequest_ChanBackups{msg}
+		return true, err
+	case 2: // backup.multi_chan_backup
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Backup = &RestoreChanBackupRequest_MultiChanBackup{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _RestoreChanBackupRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*RestoreChanBackupRequest)
+	// backup
+	switch x := m.Backup.(type) {
+	case *RestoreChanBackupRequest_ChanBackups:
+		s := proto.Size(x.ChanBackups)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *RestoreChanBackupRequest_MultiChanBackup:
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.MultiChanBackup)))
+		n += len(x.MultiChanBackup)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type RestoreBackupResponse struct {
+}
+
+func (m *RestoreBackupResponse) Reset()                    { *m = RestoreBackupResponse{} }
+func (m *RestoreBackupResponse) String() string            { return proto.CompactTextString(m) }
+func (*RestoreBackupResponse) ProtoMessage()               {}
+func (*RestoreBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{177} }
+
+type VerifyChanBackupResponse struct {
+}
+
+func (m *VerifyChanBackupResponse) Reset()                    { *m = VerifyChanBackupResponse{} }
+func (m *VerifyChanBackupResponse) String() string            { return proto.CompactTextString(m) }
+func (*VerifyChanBackupResponse) ProtoMessage()               {}
+func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{178} }
+
+type BakeMacaroonRequest struct {
+	// / The list of permissions the new macaroon should grant.
+	Permissions []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions" json:"permissions,omitempty"`
+	// *
+	// The root key ID used to create the macaroon, must be a positive integer.
+	// If not set, the default root key ID 0 is used.
+	RootKeyId uint64 `protobuf:"varint,2,opt,name=root_key_id" json:"root_key_id,omitempty"`
+}
+
+func (m *BakeMacaroonRequest) Reset()                    { *m = BakeMacaroonRequest{} }
+func (m *BakeMacaroonRequest) String() string            { return proto.CompactTextString(m) }
+func (*BakeMacaroonRequest) ProtoMessage()               {}
+func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{179} }
+
+func (m *BakeMacaroonRequest) GetPermissions() []*MacaroonPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *BakeMacaroonRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
+}
+
+type BakeMacaroonResponse struct {
+	// / The hex encoded macaroon, serialized in binary format.
+	Macaroon string `protobuf:"bytes,1,opt,name=macaroon" json:"macaroon,omitempty"`
+}
+
+func (m *BakeMacaroonResponse) Reset()                    { *m = BakeMacaroonResponse{} }
+func (m *BakeMacaroonResponse) String() string            { return proto.CompactTextString(m) }
+func (*BakeMacaroonResponse) ProtoMessage()               {}
+func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{180} }
+
+func (m *BakeMacaroonResponse) GetMacaroon() string {
+	if m != nil {
+		return m.Macaroon
+	}
+	return ""
+}
+
+type MacaroonPermission struct {
+	// / The entity a permission grants access to.
+	Entity string `protobuf:"bytes,1,opt,name=entity" json:"entity,omitempty"`
+	// / The action that is granted.
+	Action string `protobuf:"bytes,2,opt,name=action" json:"action,omitempty"`
+}
+
+func (m *MacaroonPermission) Reset()                    { *m = MacaroonPermission{} }
+func (m *MacaroonPermission) String() string            { return proto.CompactTextString(m) }
+func (*MacaroonPermission) ProtoMessage()               {}
+func (*MacaroonPermission) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{181} }
+
+func (m *MacaroonPermission) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+func (m *MacaroonPermission) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type ListMacaroonIDsRequest struct {
+}
+
+func (m *ListMacaroonIDsRequest) Reset()                    { *m = ListMacaroonIDsRequest{} }
+func (m *ListMacaroonIDsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsRequest) ProtoMessage()               {}
+func (*ListMacaroonIDsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{182} }
+
+type ListMacaroonIDsResponse struct {
+	// / The list of root key IDs that are in use.
+	RootKeyIds []uint64 `protobuf:"varint,1,rep,packed,name=root_key_ids" json:"root_key_ids,omitempty"`
+}
+
+func (m *ListMacaroonIDsResponse) Reset()                    { *m = ListMacaroonIDsResponse{} }
+func (m *ListMacaroonIDsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsResponse) ProtoMessage()               {}
+func (*ListMacaroonIDsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{183} }
+
+func (m *ListMacaroonIDsResponse) GetRootKeyIds() []uint64 {
+	if m != nil {
+		return m.RootKeyIds
+	}
+	return nil
+}
+
+type DeleteMacaroonIDRequest struct {
+	// / The root key ID to be removed.
+	RootKeyId uint64 `protobuf:"varint,1,opt,name=root_key_id" json:"root_key_id,omitempty"`
+}
+
+func (m *DeleteMacaroonIDRequest) Reset()                    { *m = DeleteMacaroonIDRequest{} }
+func (m *DeleteMacaroonIDRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDRequest) ProtoMessage()               {}
+func (*DeleteMacaroonIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{184} }
+
+func (m *DeleteMacaroonIDRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
+}
+
+type DeleteMacaroonIDResponse struct {
+	// / A boolean indicates that the deletion is successful.
+	Deleted bool `protobuf:"varint,1,opt,name=deleted" json:"deleted,omitempty"`
+}
+
+func (m *DeleteMacaroonIDResponse) Reset()                    { *m = DeleteMacaroonIDResponse{} }
+func (m *DeleteMacaroonIDResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDResponse) ProtoMessage()               {}
+func (*DeleteMacaroonIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{185} }
+
+func (m *DeleteMacaroonIDResponse) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+type MacaroonPermissionList struct {
+	// / A list of macaroon permissions.
+	Permissions []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions" json:"permissions,omitempty"`
+}
+
+func (m *MacaroonPermissionList) Reset()                    { *m = MacaroonPermissionList{} }
+func (m *MacaroonPermissionList) String() string            { return proto.CompactTextString(m) }
+func (*MacaroonPermissionList) ProtoMessage()               {}
+func (*MacaroonPermissionList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{186} }
+
+func (m *MacaroonPermissionList) GetPermissions() []*MacaroonPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type ListPermissionsRequest struct {
+}
+
+func (m *ListPermissionsRequest) Reset()                    { *m = ListPermissionsRequest{} }
+func (m *ListPermissionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPermissionsRequest) ProtoMessage()               {}
+func (*ListPermissionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{187} }
+
+type ListPermissionsResponse struct {
+	// *
+	// A map between all RPC method URIs and their required macaroon permissions
+	// to access them.
+	MethodPermissions map[string]*MacaroonPermissionList `protobuf:"bytes,1,rep,name=method_permissions" json:"method_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ListPermissionsResponse) Reset()                    { *m = ListPermissionsResponse{} }
+func (m *ListPermissionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListPermissionsResponse) ProtoMessage()               {}
+func (*ListPermissionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{188} }
+
+func (m *ListPermissionsResponse) GetMethodPermissions() map[string]*MacaroonPermissionList {
+	if m != nil {
+		return m.MethodPermissions
+	}
+	return nil
+}
+
+type CheckMacPermRequest struct {
+	// / The binary serialized macaroon to check.
+	Macaroon []byte `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	// / The permissions the macaroon must grant.
+	Permissions []*MacaroonPermission `protobuf:"bytes,2,rep,name=permissions" json:"permissions,omitempty"`
+}
+
+func (m *CheckMacPermRequest) Reset()                    { *m = CheckMacPermRequest{} }
+func (m *CheckMacPermRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckMacPermRequest) ProtoMessage()               {}
+func (*CheckMacPermRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{189} }
+
+func (m *CheckMacPermRequest) GetMacaroon() []byte {
+	if m != nil {
+		return m.Macaroon
+	}
+	return nil
+}
+
+func (m *CheckMacPermRequest) GetPermissions() []*MacaroonPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type CheckMacPermResponse struct {
+	// / Whether the macaroon is valid and grants all of the permissions.
+	Valid bool `protobuf:"varint,1,opt,name=valid" json:"valid,omitempty"`
+}
+
+func (m *CheckMacPermResponse) Reset()                    { *m = CheckMacPermResponse{} }
+func (m *CheckMacPermResponse) String() string            { return proto.CompactTextString(m) }
+func (*CheckMacPermResponse) ProtoMessage()               {}
+func (*CheckMacPermResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{190} }
+
+func (m *CheckMacPermResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+type RPCMiddlewareRequest struct {
+	// *
+	// The unique ID of the intercepted RPC call. The request and the response of
+	// the same call share this ID.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id" json:"request_id,omitempty"`
+	// *
+	// The raw bytes of the complete macaroon as sent by the gRPC client in the
+	// request metadata.
+	RawMacaroon []byte `protobuf:"bytes,2,opt,name=raw_macaroon,proto3" json:"raw_macaroon,omitempty"`
+	// *
+	// The parsed condition of the macaroon's custom caveat that the middleware
+	// registered for.
+	CustomCaveatCondition string `protobuf:"bytes,3,opt,name=custom_caveat_condition" json:"custom_caveat_condition,omitempty"`
+	// *
+	// The intercepted client request. Only one of request and response is set
+	// for an intercepted message.
+	Request *RPCMessage `protobuf:"bytes,4,opt,name=request" json:"request,omitempty"`
+	// *
+	// The intercepted server response. Only one of request and response is set
+	// for an intercepted message.
+	Response *RPCMessage `protobuf:"bytes,5,opt,name=response" json:"response,omitempty"`
+	// *
+	// Set to true once the middleware has been registered successfully. No other
+	// fields are set in this message.
+	RegComplete bool `protobuf:"varint,6,opt,name=reg_complete" json:"reg_complete,omitempty"`
+	// *
+	// The unique message ID of this middleware intercept message. The middleware
+	// must reference this ID in its feedback.
+	MsgId uint64 `protobuf:"varint,7,opt,name=msg_id" json:"msg_id,omitempty"`
+}
+
+func (m *RPCMiddlewareRequest) Reset()                    { *m = RPCMiddlewareRequest{} }
+func (m *RPCMiddlewareRequest) String() string            { return proto.CompactTextString(m) }
+func (*RPCMiddlewareRequest) ProtoMessage()               {}
+func (*RPCMiddlewareRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{191} }
+
+func (m *RPCMiddlewareRequest) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *RPCMiddlewareRequest) GetRawMacaroon() []byte {
+	if m != nil {
+		return m.RawMacaroon
+	}
+	return nil
+}
+
+func (m *RPCMiddlewareRequest) GetCustomCaveatCondition() string {
+	if m != nil {
+		return m.CustomCaveatCondition
+	}
+	return ""
+}
+
+func (m *RPCMiddlewareRequest) GetRequest() *RPCMessage {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *RPCMiddlewareRequest) GetResponse() *RPCMessage {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *RPCMiddlewareRequest) GetRegComplete() bool {
+	if m != nil {
+		return m.RegComplete
+	}
+	return false
+}
+
+func (m *RPCMiddlewareRequest) GetMsgId() uint64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+type RPCMessage struct {
+	// / The full URI (in the format /<rpcpackage>.<ServiceName>/MethodName)
+	MethodFullUri string `protobuf:"bytes,1,opt,name=method_full_uri" json:"method_full_uri,omitempty"`
+	// / Indicates whether the message was sent over a streaming RPC.
+	StreamRpc bool `protobuf:"varint,2,opt,name=stream_rpc" json:"stream_rpc,omitempty"`
+	// / The full canonical gRPC name of the message type (lnrpc.Foo format).
+	TypeName string `protobuf:"bytes,3,opt,name=type_name" json:"type_name,omitempty"`
+	// / The full content of the gRPC message, serialized in the binary format.
+	Serialized []byte `protobuf:"bytes,4,opt,name=serialized,proto3" json:"serialized,omitempty"`
+}
+
+func (m *RPCMessage) Reset()                    { *m = RPCMessage{} }
+func (m *RPCMessage) String() string            { return proto.CompactTextString(m) }
+func (*RPCMessage) ProtoMessage()               {}
+func (*RPCMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{192} }
+
+func (m *RPCMessage) GetMethodFullUri() string {
+	if m != nil {
+		return m.MethodFullUri
+	}
+	return ""
+}
+
+func (m *RPCMessage) GetStreamRpc() bool {
+	if m != nil {
+		return m.StreamRpc
+	}
+	return false
+}
+
+func (m *RPCMessage) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *RPCMessage) GetSerialized() []byte {
+	if m != nil {
+		return m.Serialized
+	}
+	return nil
+}
+
+type RPCMiddlewareResponse struct {
+	// *
+	// The message ID of the intercept message the feedback is for. Must be zero
+	// for the registration message.
+	RefMsgId uint64 `protobuf:"varint,1,opt,name=ref_msg_id" json:"ref_msg_id,omitempty"`
+	// *
+	// The registration message that must be sent as the first message once the
+	// stream is opened.
+	Register *MiddlewareRegistration `protobuf:"bytes,2,opt,name=register" json:"register,omitempty"`
+	// / The middleware's feedback for an intercept message.
+	Feedback *InterceptFeedback `protobuf:"bytes,3,opt,name=feedback" json:"feedback,omitempty"`
+}
+
+func (m *RPCMiddlewareResponse) Reset()                    { *m = RPCMiddlewareResponse{} }
+func (m *RPCMiddlewareResponse) String() string            { return proto.CompactTextString(m) }
+func (*RPCMiddlewareResponse) ProtoMessage()               {}
+func (*RPCMiddlewareResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{193} }
+
+func (m *RPCMiddlewareResponse) GetRefMsgId() uint64 {
+	if m != nil {
+		return m.RefMsgId
+	}
+	return 0
+}
+
+func (m *RPCMiddlewareResponse) GetRegister() *MiddlewareRegistration {
+	if m != nil {
+		return m.Register
+	}
+	return nil
+}
+
+func (m *RPCMiddlewareResponse) GetFeedback() *InterceptFeedback {
+	if m != nil {
+		return m.Feedback
+	}
+	return nil
+}
+
+type MiddlewareRegistration struct {
+	// / The name of the middleware, must be unique among all middlewares.
+	MiddlewareName string `protobuf:"bytes,1,opt,name=middleware_name" json:"middleware_name,omitempty"`
+	// *
+	// The name of the custom macaroon caveat this middleware is responsible for.
+	// Only requests and responses of RPC calls made with a macaroon that carries
+	// this custom caveat are sent to the middleware.
+	CustomMacaroonCaveatName string `protobuf:"bytes,2,opt,name=custom_macaroon_caveat_name" json:"custom_macaroon_caveat_name,omitempty"`
+}
+
+func (m *MiddlewareRegistration) Reset()                    { *m = MiddlewareRegistration{} }
+func (m *MiddlewareRegistration) String() string            { return proto.CompactTextString(m) }
+func (*MiddlewareRegistration) ProtoMessage()               {}
+func (*MiddlewareRegistration) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{194} }
+
+func (m *MiddlewareRegistration) GetMiddlewareName() string {
+	if m != nil {
+		return m.MiddlewareName
+	}
+	return ""
+}
+
+func (m *MiddlewareRegistration) GetCustomMacaroonCaveatName() string {
+	if m != nil {
+		return m.CustomMacaroonCaveatName
+	}
+	return ""
+}
+
+type InterceptFeedback struct {
+	// *
+	// An error to return to the gRPC client. If set, the intercepted message is
+	// rejected and the error is returned instead.
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	// *
+	// Whether the intercepted message should be replaced with the content of
+	// replacement_serialized.
+	ReplaceMessage bool `protobuf:"varint,2,opt,name=replace_message" json:"replace_message,omitempty"`
+	// *
+	// The replacement message, serialized in the binary format. It must be of
+	// the same type as the intercepted message.
+	ReplacementSerialized []byte `protobuf:"bytes,3,opt,name=replacement_serialized,proto3" json:"replacement_serialized,omitempty"`
+}
+
+func (m *InterceptFeedback) Reset()                    { *m = InterceptFeedback{} }
+func (m *InterceptFeedback) String() string            { return proto.CompactTextString(m) }
+func (*InterceptFeedback) ProtoMessage()               {}
+func (*InterceptFeedback) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{195} }
+
+func (m *InterceptFeedback) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *InterceptFeedback) GetReplaceMessage() bool {
+	if m != nil {
+		return m.ReplaceMessage
+	}
+	return false
+}
+
+func (m *InterceptFeedback) GetReplacementSerialized() []byte {
+	if m != nil {
+		return m.ReplacementSerialized
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
+	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
+	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
+	proto.RegisterType((*InitWalletResponse)(nil), "lnrpc.InitWalletResponse")
+	proto.RegisterType((*UnlockWalletRequest)(nil), "lnrpc.UnlockWalletRequest")
+	proto.RegisterType((*UnlockWalletResponse)(nil), "lnrpc.UnlockWalletResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "lnrpc.ChangePasswordRequest")
+	proto.RegisterType((*ChangePasswordResponse)(nil), "lnrpc.ChangePasswordResponse")
+	proto.RegisterType((*SubscribeStateRequest)(nil), "lnrpc.SubscribeStateRequest")
+	proto.RegisterType((*SubscribeStateResponse)(nil), "lnrpc.SubscribeStateResponse")
+	proto.RegisterType((*GetStateRequest)(nil), "lnrpc.GetStateRequest")
+	proto.RegisterType((*GetStateResponse)(nil), "lnrpc.GetStateResponse")
+	proto.RegisterType((*Utxo)(nil), "lnrpc.Utxo")
+	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
+	proto.RegisterType((*GetTransactionsRequest)(nil), "lnrpc.GetTransactionsRequest")
+	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
+	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
+	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
+	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
+	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
+	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
+	proto.RegisterType((*LightningAddress)(nil), "lnrpc.LightningAddress")
+	proto.RegisterType((*SendManyRequest)(nil), "lnrpc.SendManyRequest")
+	proto.RegisterType((*SendManyResponse)(nil), "lnrpc.SendManyResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "lnrpc.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "lnrpc.EstimateFeeResponse")
+	proto.RegisterType((*SendCoinsRequest)(nil), "lnrpc.SendCoinsRequest")
+	proto.RegisterType((*SendCoinsResponse)(nil), "lnrpc.SendCoinsResponse")
+	proto.RegisterType((*ListUnspentRequest)(nil), "lnrpc.ListUnspentRequest")
+	proto.RegisterType((*ListUnspentResponse)(nil), "lnrpc.ListUnspentResponse")
+	proto.RegisterType((*NewAddressRequest)(nil), "lnrpc.NewAddressRequest")
+	proto.RegisterType((*NewAddressResponse)(nil), "lnrpc.NewAddressResponse")
+	proto.RegisterType((*ListAddressesRequest)(nil), "lnrpc.ListAddressesRequest")
+	proto.RegisterType((*WalletAddress)(nil), "lnrpc.WalletAddress")
+	proto.RegisterType((*ListAddressesResponse)(nil), "lnrpc.ListAddressesResponse")
+	proto.RegisterType((*SignMessageRequest)(nil), "lnrpc.SignMessageRequest")
+	proto.RegisterType((*SignMessageResponse)(nil), "lnrpc.SignMessageResponse")
+	proto.RegisterType((*VerifyMessageRequest)(nil), "lnrpc.VerifyMessageRequest")
+	proto.RegisterType((*VerifyMessageResponse)(nil), "lnrpc.VerifyMessageResponse")
+	proto.RegisterType((*ConnectPeerRequest)(nil), "lnrpc.ConnectPeerRequest")
+	proto.RegisterType((*ConnectPeerResponse)(nil), "lnrpc.ConnectPeerResponse")
+	proto.RegisterType((*DisconnectPeerRequest)(nil), "lnrpc.DisconnectPeerRequest")
+	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
+	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
+	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
+	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
+	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
+	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
+	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
+	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
+	proto.RegisterType((*LookupHtlcResolutionRequest)(nil), "lnrpc.LookupHtlcResolutionRequest")
+	proto.RegisterType((*LookupHtlcResolutionResponse)(nil), "lnrpc.LookupHtlcResolutionResponse")
+	proto.RegisterType((*HtlcRateLimitStats)(nil), "lnrpc.HtlcRateLimitStats")
+	proto.RegisterType((*HoldTimeStats)(nil), "lnrpc.HoldTimeStats")
+	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
+	proto.RegisterType((*SendCustomMessageRequest)(nil), "lnrpc.SendCustomMessageRequest")
+	proto.RegisterType((*SendCustomMessageResponse)(nil), "lnrpc.SendCustomMessageResponse")
+	proto.RegisterType((*SubscribeCustomMessagesRequest)(nil), "lnrpc.SubscribeCustomMessagesRequest")
+	proto.RegisterType((*CustomMessage)(nil), "lnrpc.CustomMessage")
+	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
+	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
+	proto.RegisterType((*UpdateNodeAnnouncementRequest)(nil), "lnrpc.UpdateNodeAnnouncementRequest")
+	proto.RegisterType((*UpdateNodeAnnouncementResponse)(nil), "lnrpc.UpdateNodeAnnouncementResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "lnrpc.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "lnrpc.GetRecoveryInfoResponse")
+	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
+	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
+	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
+	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
+	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
+	proto.RegisterType((*CloseChannelRequest)(nil), "lnrpc.CloseChannelRequest")
+	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
+	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
+	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
+	proto.RegisterType((*PsbtShim)(nil), "lnrpc.PsbtShim")
+	proto.RegisterType((*BatchOpenChannelRequest)(nil), "lnrpc.BatchOpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannel)(nil), "lnrpc.BatchOpenChannel")
+	proto.RegisterType((*BatchOpenChannelResponse)(nil), "lnrpc.BatchOpenChannelResponse")
+	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
+	proto.RegisterType((*ReadyForPsbtFunding)(nil), "lnrpc.ReadyForPsbtFunding")
+	proto.RegisterType((*FundingTransitionMsg)(nil), "lnrpc.FundingTransitionMsg")
+	proto.RegisterType((*FundingShimCancel)(nil), "lnrpc.FundingShimCancel")
+	proto.RegisterType((*FundingPsbtVerify)(nil), "lnrpc.FundingPsbtVerify")
+	proto.RegisterType((*FundingPsbtFinalize)(nil), "lnrpc.FundingPsbtFinalize")
+	proto.RegisterType((*FundingStateStepResp)(nil), "lnrpc.FundingStateStepResp")
+	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
+	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
+	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
+	proto.RegisterType((*PendingChannelsResponse_PendingChannel)(nil), "lnrpc.PendingChannelsResponse.PendingChannel")
+	proto.RegisterType((*PendingChannelsResponse_PendingOpenChannel)(nil), "lnrpc.PendingChannelsResponse.PendingOpenChannel")
+	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
+	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
+	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
+	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
+	proto.RegisterType((*WalletAccountBalance)(nil), "lnrpc.WalletAccountBalance")
+	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
+	proto.RegisterType((*Amount)(nil), "lnrpc.Amount")
+	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
+	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
+	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
+	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
+	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
+	proto.RegisterType((*Route)(nil), "lnrpc.Route")
+	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
+	proto.RegisterType((*NodeInfo)(nil), "lnrpc.NodeInfo")
+	proto.RegisterType((*LightningNode)(nil), "lnrpc.LightningNode")
+	proto.RegisterType((*NodeAddress)(nil), "lnrpc.NodeAddress")
+	proto.RegisterType((*RoutingPolicy)(nil), "lnrpc.RoutingPolicy")
+	proto.RegisterType((*ChannelEdge)(nil), "lnrpc.ChannelEdge")
+	proto.RegisterType((*ChannelGraphRequest)(nil), "lnrpc.ChannelGraphRequest")
+	proto.RegisterType((*ChannelGraph)(nil), "lnrpc.ChannelGraph")
+	proto.RegisterType((*ChanInfoRequest)(nil), "lnrpc.ChanInfoRequest")
+	proto.RegisterType((*NetworkInfoRequest)(nil), "lnrpc.NetworkInfoRequest")
+	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
+	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
+	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
+	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
+	proto.RegisterType((*GraphTopologyUpdate)(nil), "lnrpc.GraphTopologyUpdate")
+	proto.RegisterType((*NodeUpdate)(nil), "lnrpc.NodeUpdate")
+	proto.RegisterType((*ChannelEdgeUpdate)(nil), "lnrpc.ChannelEdgeUpdate")
+	proto.RegisterType((*ClosedChannelUpdate)(nil), "lnrpc.ClosedChannelUpdate")
+	proto.RegisterType((*HopHint)(nil), "lnrpc.HopHint")
+	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
+	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
+	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
+	proto.RegisterType((*CreateOfferRequest)(nil), "lnrpc.CreateOfferRequest")
+	proto.RegisterType((*CreateOfferResponse)(nil), "lnrpc.CreateOfferResponse")
+	proto.RegisterType((*PayOfferRequest)(nil), "lnrpc.PayOfferRequest")
+	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
+	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
+	proto.RegisterType((*ListInvoiceResponse)(nil), "lnrpc.ListInvoiceResponse")
+	proto.RegisterType((*InvoiceSubscription)(nil), "lnrpc.InvoiceSubscription")
+	proto.RegisterType((*Payment)(nil), "lnrpc.Payment")
+	proto.RegisterType((*ListPaymentsRequest)(nil), "lnrpc.ListPaymentsRequest")
+	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
+	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
+	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
+	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
+	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
+	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
+	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
+	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
+	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
+	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
+	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
+	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
+	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
+	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
+	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
+	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
+	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterType((*ListSweepableOutputsRequest)(nil), "lnrpc.ListSweepableOutputsRequest")
+	proto.RegisterType((*SweepableOutput)(nil), "lnrpc.SweepableOutput")
+	proto.RegisterType((*ListSweepableOutputsResponse)(nil), "lnrpc.ListSweepableOutputsResponse")
+	proto.RegisterType((*SweepOutputsRequest)(nil), "lnrpc.SweepOutputsRequest")
+	proto.RegisterType((*SweepOutputsResponse)(nil), "lnrpc.SweepOutputsResponse")
+	proto.RegisterType((*ArchiveClosedChannelsRequest)(nil), "lnrpc.ArchiveClosedChannelsRequest")
+	proto.RegisterType((*ArchiveClosedChannelsResponse)(nil), "lnrpc.ArchiveClosedChannelsResponse")
+	proto.RegisterType((*InboundFee)(nil), "lnrpc.InboundFee")
+	proto.RegisterType((*CancelPaymentRequest)(nil), "lnrpc.CancelPaymentRequest")
+	proto.RegisterType((*CancelPaymentResponse)(nil), "lnrpc.CancelPaymentResponse")
+	proto.RegisterType((*ExportChannelAuditRequest)(nil), "lnrpc.ExportChannelAuditRequest")
+	proto.RegisterType((*ChannelAuditEntry)(nil), "lnrpc.ChannelAuditEntry")
+	proto.RegisterType((*ExportChannelAuditResponse)(nil), "lnrpc.ExportChannelAuditResponse")
+	proto.RegisterType((*FreezeChannelRequest)(nil), "lnrpc.FreezeChannelRequest")
+	proto.RegisterType((*FreezeChannelResponse)(nil), "lnrpc.FreezeChannelResponse")
+	proto.RegisterType((*UpdateChannelConstraintsRequest)(nil), "lnrpc.UpdateChannelConstraintsRequest")
+	proto.RegisterType((*UpdateChannelConstraintsResponse)(nil), "lnrpc.UpdateChannelConstraintsResponse")
+	proto.RegisterType((*ChannelEventSubscription)(nil), "lnrpc.ChannelEventSubscription")
+	proto.RegisterType((*ChannelEventUpdate)(nil), "lnrpc.ChannelEventUpdate")
+	proto.RegisterType((*PeerEventSubscription)(nil), "lnrpc.PeerEventSubscription")
+	proto.RegisterType((*PeerEvent)(nil), "lnrpc.PeerEvent")
+	proto.RegisterType((*DeletePaymentRequest)(nil), "lnrpc.DeletePaymentRequest")
+	proto.RegisterType((*DeletePaymentResponse)(nil), "lnrpc.DeletePaymentResponse")
+	proto.RegisterType((*ExportChannelBackupRequest)(nil), "lnrpc.ExportChannelBackupRequest")
+	proto.RegisterType((*ChannelBackup)(nil), "lnrpc.ChannelBackup")
+	proto.RegisterType((*MultiChanBackup)(nil), "lnrpc.MultiChanBackup")
+	proto.RegisterType((*ChanBackupExportRequest)(nil), "lnrpc.ChanBackupExportRequest")
+	proto.RegisterType((*ChanBackupSnapshot)(nil), "lnrpc.ChanBackupSnapshot")
+	proto.RegisterType((*ChannelBackups)(nil), "lnrpc.ChannelBackups")
+	proto.RegisterType((*ChannelBackupSubscription)(nil), "lnrpc.ChannelBackupSubscription")
+	proto.RegisterType((*RestoreChanBackupRequest)(nil), "lnrpc.RestoreChanBackupRequest")
+	proto.RegisterType((*RestoreBackupResponse)(nil), "lnrpc.RestoreBackupResponse")
+	proto.RegisterType((*VerifyChanBackupResponse)(nil), "lnrpc.VerifyChanBackupResponse")
+	proto.RegisterType((*BakeMacaroonRequest)(nil), "lnrpc.BakeMacaroonRequest")
+	proto.RegisterType((*BakeMacaroonResponse)(nil), "lnrpc.BakeMacaroonResponse")
+	proto.RegisterType((*MacaroonPermission)(nil), "lnrpc.MacaroonPermission")
+	proto.RegisterType((*ListMacaroonIDsRequest)(nil), "lnrpc.ListMacaroonIDsRequest")
+	proto.RegisterType((*ListMacaroonIDsResponse)(nil), "lnrpc.ListMacaroonIDsResponse")
+	proto.RegisterType((*DeleteMacaroonIDRequest)(nil), "lnrpc.DeleteMacaroonIDRequest")
+	proto.RegisterType((*DeleteMacaroonIDResponse)(nil), "lnrpc.DeleteMacaroonIDResponse")
+	proto.RegisterType((*MacaroonPermissionList)(nil), "lnrpc.MacaroonPermissionList")
+	proto.RegisterType((*ListPermissionsRequest)(nil), "lnrpc.ListPermissionsRequest")
+	proto.RegisterType((*ListPermissionsResponse)(nil), "lnrpc.ListPermissionsResponse")
+	proto.RegisterType((*CheckMacPermRequest)(nil), "lnrpc.CheckMacPermRequest")
+	proto.RegisterType((*CheckMacPermResponse)(nil), "lnrpc.CheckMacPermResponse")
+	proto.RegisterType((*RPCMiddlewareRequest)(nil), "lnrpc.RPCMiddlewareRequest")
+	proto.RegisterType((*RPCMessage)(nil), "lnrpc.RPCMessage")
+	proto.RegisterType((*RPCMiddlewareResponse)(nil), "lnrpc.RPCMiddlewareResponse")
+	proto.RegisterType((*MiddlewareRegistration)(nil), "lnrpc.MiddlewareRegistration")
+	proto.RegisterType((*InterceptFeedback)(nil), "lnrpc.InterceptFeedback")
+	proto.RegisterEnum("lnrpc.WalletState", WalletState_name, WalletState_value)
+	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
+	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+	proto.RegisterEnum("lnrpc.Payment_PaymentStatus", Payment_PaymentStatus_name, Payment_PaymentStatus_value)
+	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
+	proto.RegisterEnum("lnrpc.PeerEvent_EventType", PeerEvent_EventType_name, PeerEvent_EventType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for WalletUnlocker service
+
+type WalletUnlockerClient interface {
+	// *
+	// GenSeed is the first method that should be used to instantiate a new lnd
+	// instance. This method allows a caller to generate a new aezeed cipher seed
+	// given an optional passphrase. If provided, the passphrase will be necessary
+	// to decrypt the cipherseed to expose the internal wallet seed.
+	//
+	// Once the cipherseed is obtained and verified by the user, the InitWallet
+	// method should be used to commit the newly generated seed, and create the
+	// wallet.
+	GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error)
+	// *
+	// InitWallet is used when lnd is starting up for the first time to fully
+	// initialize the daemon and its internal wallet. At the very least a wallet
+	// password must be provided. This will be used to encrypt sensitive material
+	// on disk.
+	//
+	// In the case of a recovery scenario, the user can also specify their aezeed
+	// mnemonic and passphrase. If set, then the daemon will use this prior state
+	// to initialize its internal wallet.
+	//
+	// Alternatively, this can be used along with the GenSeed RPC to obtain a
+	// seed, then present it to the user. Once it has been verified by the user,
+	// the seed can be fed into this RPC in order to commit the new wallet.
+	InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error)
+	// * lncli: `unlock`
+	// UnlockWallet is used at startup of lnd to provide a password to unlock
+	// the wallet database.
+	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
+	// * lncli: `changepassword`
+	// ChangePassword changes the password of the encrypted wallet. This will
+	// automatically unlock the wallet database if successful.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+}
+
+type walletUnlockerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletUnlockerClient(cc *grpc.ClientConn) WalletUnlockerClient {
+	return &walletUnlockerClient{cc}
+}
+
+func (c *walletUnlockerClient) GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error) {
+	out := new(GenSeedResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/GenSeed", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error) {
+	out := new(InitWalletResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/InitWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
+	out := new(UnlockWalletResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/UnlockWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/ChangePassword", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for WalletUnlocker service
+
+type WalletUnlockerServer interface {
+	// *
+	// GenSeed is the first method that should be used to instantiate a new lnd
+	// instance. This method allows a caller to generate a new aezeed cipher seed
+	// given an optional passphrase. If provided, the passphrase will be necessary
+	// to decrypt the cipherseed to expose the internal wallet seed.
+	//
+	// Once the cipherseed is obtained and verified by the user, the InitWallet
+	// method should be used to commit the newly generated seed, and create the
+	// wallet.
+	GenSeed(context.Context, *GenSeedRequest) (*GenSeedResponse, error)
+	// *
+	// InitWallet is used when lnd is starting up for the first time to fully
+	// initialize the daemon and its internal wallet. At the very least a wallet
+	// password must be provided. This will be used to encrypt sensitive material
+	// on disk.
+	//
+	// In the case of a recovery scenario, the user can also specify their aezeed
+	// mnemonic and passphrase. If set, then the daemon will use this prior state
+	// to initialize its internal wallet.
+	//
+	// Alternatively, this can be used along with the GenSeed RPC to obtain a
+	// seed, then present it to the user. Once it has been verified by the user,
+	// the seed can be fed into this RPC in order to commit the new wallet.
+	InitWallet(context.Context, *InitWalletRequest) (*InitWalletResponse, error)
+	// * lncli: `unlock`
+	// UnlockWallet is used at startup of lnd to provide a password to unlock
+	// the wallet database.
+	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
+	// * lncli: `changepassword`
+	// ChangePassword changes the password of the encrypted wallet. This will
+	// automatically unlock the wallet database if successful.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+}
+
+func RegisterWalletUnlockerServer(s *grpc.Server, srv WalletUnlockerServer) {
+	s.RegisterService(&_WalletUnlocker_serviceDesc, srv)
+}
+
+func _WalletUnlocker_GenSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).GenSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/GenSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).GenSeed(ctx, req.(*GenSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletUnlocker_InitWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).InitWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/InitWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).InitWallet(ctx, req.(*InitWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletUnlocker_UnlockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).UnlockWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/UnlockWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).UnlockWallet(ctx, req.(*UnlockWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletUnlocker_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WalletUnlocker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.WalletUnlocker",
+	HandlerType: (*WalletUnlockerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenSeed",
+			Handler:    _WalletUnlocker_GenSeed_Handler,
+		},
+		{
+			MethodName: "InitWallet",
+			Handler:    _WalletUnlocker_InitWallet_Handler,
+		},
+		{
+			MethodName: "UnlockWallet",
+			Handler:    _WalletUnlocker_UnlockWallet_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _WalletUnlocker_ChangePassword_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// Client API for State service
+
+type StateClient interface {
+	// * lncli: `state`
+	// SubscribeState subscribes to the state of the wallet. The current wallet
+	// state will always be delivered immediately.
+	SubscribeState(ctx context.Context, in *SubscribeStateRequest, opts ...grpc.CallOption) (State_SubscribeStateClient, error)
+	// * lncli: `state`
+	// GetState returns the current wallet state without streaming further
+	// changes.
+	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
+}
+
+type stateClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStateClient(cc *grpc.ClientConn) StateClient {
+	return &stateClient{cc}
+}
+
+func (c *stateClient) SubscribeState(ctx context.Context, in *SubscribeStateRequest, opts ...grpc.CallOption) (State_SubscribeStateClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_State_serviceDesc.Streams[0], c.cc, "/lnrpc.State/SubscribeState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateSubscribeStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type State_SubscribeStateClient interface {
+	Recv() (*SubscribeStateResponse, error)
+	grpc.ClientStream
+}
+
+type stateSubscribeStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateSubscribeStateClient) Recv() (*SubscribeStateResponse, error) {
+	m := new(SubscribeStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stateClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error) {
+	out := new(GetStateResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.State/GetState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for State service
+
+type StateServer interface {
+	// * lncli: `state`
+	// SubscribeState subscribes to the state of the wallet. The current wallet
+	// state will always be delivered immediately.
+	SubscribeState(*SubscribeStateRequest, State_SubscribeStateServer) error
+	// * lncli: `state`
+	// GetState returns the current wallet state without streaming further
+	// changes.
+	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
+}
+
+func RegisterStateServer(s *grpc.Server, srv StateServer) {
+	s.RegisterService(&_State_serviceDesc, srv)
+}
+
+func _State_SubscribeState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateServer).SubscribeState(m, &stateSubscribeStateServer{stream})
+}
+
+type State_SubscribeStateServer interface {
+	Send(*SubscribeStateResponse) error
+	grpc.ServerStream
+}
+
+type stateSubscribeStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateSubscribeStateServer) Send(m *SubscribeStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _State_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServer).GetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.State/GetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServer).GetState(ctx, req.(*GetStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _State_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.State",
+	HandlerType: (*StateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetState",
+			Handler:    _State_GetState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeState",
+			Handler:       _State_SubscribeState_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc.proto",
+}
+
+// Client API for Lightning service
+
+type LightningClient interface {
+	// * lncli: `walletbalance`
+	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
+	// confirmed unspent outputs and all unconfirmed unspent outputs under control
+	// of the wallet.
+	WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error)
+	// * lncli: `channelbalance`
+	// ChannelBalance returns the total funds available across all open channels
+	// in satoshis.
+	ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error)
+	// * lncli: `listchaintxns`
+	// GetTransactions returns a list describing all the known transactions
+	// relevant to the wallet.
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error)
+	// * lncli: `sendcoins`
+	// SendCoins executes a request to send coins to a particular address. Unlike
+	// SendMany, this RPC call only allows creating a single output at a time. If
+	// neither target_conf, or sat_per_byte are set, then the internal wallet will
+	// consult its fee model to determine a fee for the default confirmation
+	// target.
+	SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error)
+	// * lncli: `listunspent`
+	// ListUnspent returns a list of all utxos spendable by the wallet with a
+	// number of confirmations between the specified minimum and maximum.
+	ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error)
+	// *
+	// SubscribeTransactions creates a uni-directional stream from the server to
+	// the client in which any newly discovered transactions relevant to the
+	// wallet are sent over.
+	SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error)
+	// * lncli: `sendmany`
+	// SendMany handles a request for a transaction that creates multiple specified
+	// outputs in parallel. If neither target_conf, or sat_per_byte are set, then
+	// the internal wallet will consult its fee model to determine a fee for the
+	// default confirmation target.
+	SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error)
+	// * lncli: `estimatefee`
+	// EstimateFee asks the wallet to estimate the fee and fee rate of a
+	// transaction paying to the specified outputs, without creating or
+	// broadcasting it. The fee rate is derived from target_conf, or the default
+	// confirmation target if it isn't set.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	// * lncli: `newaddress`
+	// NewAddress creates a new address under control of the local wallet.
+	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
+	// * lncli: `listaddresses`
+	// ListAddresses returns all addresses the wallet has derived so far, along
+	// with their derivation paths, types and balances.
+	ListAddresses(ctx context.Context, in *ListAddressesRequest, opts ...grpc.CallOption) (*ListAddressesResponse, error)
+	// * lncli: `signmessage`
+	// SignMessage signs a message with this node's private key. The returned
+	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
+	// only the message digest and signature are needed for verification.
+	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
+	// * lncli: `verifymessage`
+	// VerifyMessage verifies a signature over a msg. The signature must be
+	// zbase32 encoded and signed by an active node in the resident node's
+	// channel database. In addition to returning the validity of the signature,
+	// VerifyMessage also returns the recovered pubkey from the signature.
+	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
+	// * lncli: `connect`
+	// ConnectPeer attempts to establish a connection to a remote peer. This is at
+	// the networking level, and is used for communication between nodes. This is
+	// distinct from establishing a channel with a peer.
+	ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error)
+	// * lncli: `disconnect`
+	// DisconnectPeer attempts to disconnect one peer from another identified by a
+	// given pubKey. In the case that we currently have a pending or active channel
+	// with the target peer, then this action will be not be allowed.
+	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
+	// * lncli: `listpeers`
+	// ListPeers returns a verbose listing of all currently active peers.
+	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	// * lncli: `getinfo`
+	// GetInfo returns general information concerning the lightning node including
+	// it's identity pubkey, alias, the chains it is connected to, and information
+	// concerning the number of open+pending channels.
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// * lncli: `pendingchannels`
+	// PendingChannels returns a list of all the channels that are currently
+	// considered "pending". A channel is pending if it has finished the funding
+	// workflow and is waiting for confirmations for the funding txn, or is in the
+	// process of closure, either initiated cooperatively or non-cooperatively.
+	PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error)
+	// * lncli: `listchannels`
+	// ListChannels returns a description of all the open channels that this node
+	// is a participant in.
+	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	// * lncli: `closedchannels`
+	// ClosedChannels returns a description of all the closed channels that
+	// this node was a participant in.
+	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	// *
+	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
+	// call is meant to be consumed by clients to the REST proxy. As with all
+	// other sync calls, all byte slices are intended to be populated as hex
+	// encoded strings.
+	OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error)
+	// * lncli: `openchannel`
+	// OpenChannel attempts to open a singly funded channel specified in the
+	// request to a remote peer. Users are able to specify a target number of
+	// blocks that the funding transaction should be confirmed in, or a manual fee
+	// rate to us for the funding transaction. If neither are specified, then a
+	// lax block confirmation target is used.
+	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
+	// * lncli: `batchopenchannel`
+	// BatchOpenChannel attempts to open multiple singly funded channels with a
+	// single funding transaction, which carries at most one change output. Either
+	// all channels of the batch are opened, or none of them are: the funding
+	// transaction is only broadcast once every peer has signed its commitment
+	// transaction, and the whole batch is aborted if any of the funding flows
+	// fails.
+	BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error)
+	// * lncli: `fundingstatestep`
+	// FundingStateStep continues the funding flow of a channel opened with a
+	// PSBT shim. The PSBT funded by the external wallet is first verified to pay
+	// to the funding output of the channel, then the signed funding transaction
+	// is handed over. lnd only broadcasts the funding transaction once it has
+	// both the signed transaction and the remote party's signature for our
+	// commitment transaction. A pending PSBT funding flow can also be cancelled.
+	FundingStateStep(ctx context.Context, in *FundingTransitionMsg, opts ...grpc.CallOption) (*FundingStateStepResp, error)
+	// * lncli: `closechannel`
+	// CloseChannel attempts to close an active channel identified by its channel
+	// outpoint (ChannelPoint). The actions of this method can additionally be
+	// augmented to attempt a force close after a timeout period in the case of an
+	// inactive peer. If a non-force close (cooperative closure) is requested,
+	// then the user can specify either a target number of blocks until the
+	// closure transaction is confirmed, or a manual fee rate. If neither are
+	// specified, then a default lax, block confirmation target is used.
+	// Our funds of a cooperative closure are sent to a fresh wallet address,
+	// unless a delivery address is specified. Close status updates are streamed
+	// until the closing transaction confirms.
+	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error)
+	// * lncli: `abandonchannel`
+	// AbandonChannel removes all channel state from the database except for a
+	// close summary. This method can be used to get rid of permanently unusable
+	// channels due to bugs fixed in newer versions of lnd. Only available
+	// when in debug builds of lnd.
+	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
+	// * lncli: `sendpayment`
+	// SendPayment dispatches a bi-directional streaming RPC for sending payments
+	// through the Lightning Network. A single RPC invocation creates a persistent
+	// bi-directional stream allowing clients to rapidly send payments through the
+	// Lightning Network with a single persistent connection.
+	SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error)
+	// *
+	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
+	// This RPC is intended to be consumed by clients of the REST proxy.
+	// Additionally, this RPC expects the destination's public key and the payment
+	// hash (if any) to be encoded as hex strings.
+	SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// * lncli: `sendtoroute`
+	// SendToRoute is a bi-directional streaming RPC for sending payment through
+	// the Lightning Network. This method differs from SendPayment in that it
+	// allows users to specify a full route manually. This can be used for things
+	// like rebalancing, and atomic swaps.
+	SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error)
+	// *
+	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
+	// until the payment either fails or succeeds.
+	SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// * lncli: `addinvoice`
+	// AddInvoice attempts to add a new invoice to the invoice database. Any
+	// duplicated invoices are rejected, therefore all invoices *must* have a
+	// unique payment preimage.
+	AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error)
+	// * lncli: `createoffer`
+	// CreateOffer creates a new BOLT-12 offer for our node. Invoice requests
+	// for the offer are answered over onion messages with a fresh invoice.
+	// This requires lnd to be started with --offers.
+	CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*CreateOfferResponse, error)
+	// * lncli: `payoffer`
+	// PayOffer requests an invoice for the passed BOLT-12 offer from its
+	// issuer over onion messages, and pays it. This requires lnd to be started
+	// with --offers.
+	PayOffer(ctx context.Context, in *PayOfferRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// * lncli: `listinvoices`
+	// ListInvoices returns a list of all the invoices currently stored within the
+	// database. Any active debug invoices are ignored. It has full support for
+	// paginated responses, allowing users to query for specific invoices through
+	// their add_index. This can be done by using either the first_index_offset or
+	// last_index_offset fields included in the response as the index_offset of the
+	// next request. The reversed flag is set by default in order to paginate
+	// backwards. If you wish to paginate forwards, you must explicitly set the
+	// flag to false. If none of the parameters are specified, then the last 100
+	// invoices will be returned.
+	ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error)
+	// * lncli: `lookupinvoice`
+	// LookupInvoice attempts to look up an invoice according to its payment hash.
+	// The passed payment hash *must* be exactly 32 bytes, if not, an error is
+	// returned.
+	LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error)
+	// *
+	// SubscribeInvoices returns a uni-directional stream (server -> client) for
+	// notifying the client of newly added/settled invoices. The caller can
+	// optionally specify the add_index and/or the settle_index. If the add_index
+	// is specified, then we'll first start by sending add invoice events for all
+	// invoices with an add_index greater than the specified value.  If the
+	// settle_index is specified, the next, we'll send out all settle events for
+	// invoices with a settle_index greater than the specified value.  One or both
+	// of these fields can be set. If no fields are set, then we'll only send out
+	// the latest add/settle events.
+	SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error)
+	// * lncli: `decodepayreq`
+	// DecodePayReq takes an encoded payment request string and attempts to decode
+	// it, returning a full description of the conditions encoded within the
+	// payment request.
+	DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error)
+	// * lncli: `listpayments`
+	// ListPayments returns a list of outgoing payments. By default, all the
+	// payments that succeeded are returned. Payments that are in flight or that
+	// failed can also be included, and the response can be paginated through the
+	// payment_index of the payments.
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	// *
+	// DeleteAllPayments deletes all outgoing payments from DB. If
+	// failed_payments_only is set, only the payments that failed are deleted.
+	DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error)
+	// * lncli: `describegraph`
+	// DescribeGraph returns a description of the latest graph state from the
+	// point of view of the node. The graph information is partitioned into two
+	// components: all the nodes/vertexes, and all the edges that connect the
+	// vertexes themselves.  As this is a directed graph, the edges also contain
+	// the node directional specific routing policy which includes: the time lock
+	// delta, fee information, etc.
+	DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error)
+	// * lncli: `getchaninfo`
+	// GetChanInfo returns the latest authenticated network announcement for the
+	// given channel identified by its channel ID: an 8-byte integer which
+	// uniquely identifies the location of transaction's funding output within the
+	// blockchain.
+	GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error)
+	// * lncli: `getnodeinfo`
+	// GetNodeInfo returns the latest advertised, aggregated, and authenticated
+	// channel information for the specified node identified by its public key.
+	GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error)
+	// * lncli: `queryroutes`
+	// QueryRoutes attempts to query the daemon's Channel Router for a possible
+	// route to a target destination capable of carrying a specific amount of
+	// satoshis. The retuned route contains the full details required to craft and
+	// send an HTLC, also including the necessary information that should be
+	// present within the Sphinx packet encapsulated within the HTLC.
+	QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error)
+	// * lncli: `getnetworkinfo`
+	// GetNetworkInfo returns some basic stats about the known channel graph from
+	// the point of view of the node.
+	GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
+	// * lncli: `stop`
+	// StopDaemon will send a shutdown request to the interrupt handler, triggering
+	// a graceful shutdown of the daemon.
+	StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	// *
+	// SubscribeChannelGraph launches a streaming RPC that allows the caller to
+	// receive notifications upon any changes to the channel graph topology from
+	// the point of view of the responding node. Events notified include: new
+	// nodes coming online, nodes updating their authenticated attributes, new
+	// channels being advertised, updates in the routing policy for a directional
+	// channel edge, and when channels are closed on-chain.
+	SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error)
+	// * lncli: `debuglevel`
+	// DebugLevel allows a caller to programmatically set the logging verbosity of
+	// lnd. The logging can be targeted according to a coarse daemon-wide logging
+	// level, or in a granular fashion to specify the logging for a target
+	// sub-system.
+	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
+	// * lncli: `feereport`
+	// FeeReport allows the caller to obtain a report detailing the current fee
+	// schedule enforced by the node globally for each channel.
+	FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error)
+	// * lncli: `updatechanpolicy`
+	// UpdateChannelPolicy allows the caller to update the fee schedule and
+	// channel policies for all channels globally, or a particular channel.
+	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
+	// * lncli: `fwdinghistory`
+	// ForwardingHistory allows the caller to query the htlcswitch for a record of
+	// all HTLC's forwarded within the target time range, and integer offset
+	// within that time range. If no time-range is specified, then the first chunk
+	// of the past 24 hrs of forwarding history are returned.
+	//
+	// A list of forwarding events are returned. The size of each forwarding event
+	// is 40 bytes, and the max message size able to be returned in gRPC is 4 MiB.
+	// As a result each message can only contain 50k entries.  Each response has
+	// the index offset of the last entry. The index offset can be provided to the
+	// request to allow the caller to skip a series of records.
+	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// * lncli: `listsweepable`
+	// ListSweepableOutputs returns the time-locked outputs of force closed
+	// channels that are being swept back into the wallet, along with the height
+	// at which each of them is swept, and the fee required to sweep it.
+	ListSweepableOutputs(ctx context.Context, in *ListSweepableOutputsRequest, opts ...grpc.CallOption) (*ListSweepableOutputsResponse, error)
+	// * lncli: `sweepoutputs`
+	// SweepOutputs immediately sweeps the selected outputs back into the wallet
+	// at the given fee rate, replacing the sweep transaction that was broadcast
+	// for them. All outputs swept together by that transaction must be
+	// selected.
+	SweepOutputs(ctx context.Context, in *SweepOutputsRequest, opts ...grpc.CallOption) (*SweepOutputsResponse, error)
+	// * lncli: `archiveclosedchannels`
+	// ArchiveClosedChannels compacts the records of all channels that have been
+	// fully closed and resolved, dropping the state that is only needed to help
+	// the remote party recover from data loss. If a retention window is set, the
+	// records of resolved channels closed before it are deleted altogether.
+	ArchiveClosedChannels(ctx context.Context, in *ArchiveClosedChannelsRequest, opts ...grpc.CallOption) (*ArchiveClosedChannelsResponse, error)
+	// * lncli: `cancelpayment`
+	// CancelPayment cancels a payment that's currently being sent. No further
+	// routes are attempted for the payment, and the pending SendPayment call
+	// returns once its outstanding HTLC, if any, has been resolved.
+	CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error)
+	// * lncli: `exportchanaudit`
+	// ExportChannelAudit returns a snapshot of the balances and commitments of
+	// all open channels, signed by the node's identity key. The snapshot holds
+	// no secrets, and can be handed to an auditor, who can verify it against
+	// the node's identity key in the same way as a message signed through
+	// SignMessage.
+	ExportChannelAudit(ctx context.Context, in *ExportChannelAuditRequest, opts ...grpc.CallOption) (*ExportChannelAuditResponse, error)
+	// * lncli: `freezechannel`
+	// FreezeChannel puts a channel into drain mode, in which it neither accepts
+	// nor offers any new HTLCs, while those already in flight are allowed to
+	// resolve. This is useful before closing a channel, or carrying out any
+	// maintenance on it. The channel remains frozen until thawed, or until the
+	// daemon is restarted.
+	FreezeChannel(ctx context.Context, in *FreezeChannelRequest, opts ...grpc.CallOption) (*FreezeChannelResponse, error)
+	// * lncli: `updatechanconstraints`
+	// UpdateChannelConstraints renegotiates the flow constraints which bound the
+	// HTLCs that the remote party may offer over a live channel. The channel is
+	// quiesced for the duration of the update, which requires the remote peer
+	// to support both the quiescence and dynamic commitments protocols.
+	UpdateChannelConstraints(ctx context.Context, in *UpdateChannelConstraintsRequest, opts ...grpc.CallOption) (*UpdateChannelConstraintsResponse, error)
+	// *
+	// SubscribeChannelEvents creates a uni-directional stream from the server to
+	// the client in which any updates relevant to the state of the channels are
+	// sent over. Events include channels pending open, newly opened channels,
+	// active and inactive channels, closed channels, and channels whose
+	// contracts have been fully resolved on-chain.
+	SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error)
+	// *
+	// SubscribePeerEvents creates a uni-directional stream from the server to
+	// the client in which an event is sent whenever a peer comes online or goes
+	// offline.
+	SubscribePeerEvents(ctx context.Context, in *PeerEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribePeerEventsClient, error)
+	// * lncli: `deletepayment`
+	// DeletePayment deletes the record of an outgoing payment from the database.
+	// Payments that are still in flight can't be deleted.
+	DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*DeletePaymentResponse, error)
+	// * lncli: `exportchanbackup`
+	// ExportChannelBackup attempts to return an encrypted static channel
+	// backup for the target channel identified by its channel point. The backup
+	// is encrypted with a key generated from the aezeed seed of the user.
+	ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error)
+	// * lncli: `exportchanbackup`
+	// ExportAllChannelBackups returns static channel backups for all existing
+	// channels known to lnd. A set of regular singular static channel backups for
+	// each channel are returned. Additionally, a multi-channel backup is returned
+	// as well, which contains a single encrypted blob containing the backups of
+	// each channel.
+	ExportAllChannelBackups(ctx context.Context, in *ChanBackupExportRequest, opts ...grpc.CallOption) (*ChanBackupSnapshot, error)
+	// * lncli: `verifychanbackup`
+	// VerifyChanBackup allows a caller to verify the integrity of a channel
+	// backup snapshot. This method will accept either a packed Single or a
+	// packed Multi. Specifying both will result in an error.
+	VerifyChanBackup(ctx context.Context, in *ChanBackupSnapshot, opts ...grpc.CallOption) (*VerifyChanBackupResponse, error)
+	// * lncli: `restorechanbackup`
+	// RestoreChannelBackups accepts a set of singular channel backups, or a
+	// single encrypted multi-chan backup and attempts to recover any funds
+	// remaining within the channel. If we are able to unpack the backup, then the
+	// new channel will be shown under listchannels, as well as pending channels.
+	RestoreChannelBackups(ctx context.Context, in *RestoreChanBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
+	// *
+	// SubscribeChannelBackups allows a client to subscribe to the most up to
+	// date information concerning the state of all channel backups. Each time a
+	// channel is funded, opened or closed, we send a new snapshot containing the
+	// single-chan backups of all current channels, along with a multi-chan
+	// backup covering all of them.
+	SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error)
+	// * lncli: `bakemacaroon`
+	// BakeMacaroon allows the creation of a new macaroon with custom read and
+	// write permissions. No first-party caveats are added since this can be done
+	// offline.
+	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	// * lncli: `listmacaroonids`
+	// ListMacaroonIDs returns all root key IDs that are in use.
+	ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error)
+	// * lncli: `deletemacaroonid`
+	// DeleteMacaroonID deletes the specified macaroon ID and invalidates all
+	// macaroons derived from that ID.
+	DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error)
+	// * lncli: `listpermissions`
+	// ListPermissions lists all RPC method URIs and the macaroon permissions they
+	// require.
+	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+	// *
+	// CheckMacaroonPermissions checks whether the given macaroon is valid and
+	// grants all of the given permissions. It allows services that sit in front of
+	// lnd to authorize calls before forwarding them.
+	CheckMacaroonPermissions(ctx context.Context, in *CheckMacPermRequest, opts ...grpc.CallOption) (*CheckMacPermResponse, error)
+	// *
+	// RegisterRPCMiddleware adds a new gRPC middleware to the interceptor chain. A
+	// gRPC middleware is software component external to lnd that aims to add
+	// additional business logic to lnd by observing/intercepting/validating
+	// incoming gRPC client requests and (if needed) replacing/overwriting outgoing
+	// messages before they're sent to the client. The first message sent by the
+	// middleware must be a registration message. Only requests and responses of
+	// RPC calls made with a macaroon that carries the custom caveat the
+	// middleware registered for are sent to it.
+	RegisterRPCMiddleware(ctx context.Context, opts ...grpc.CallOption) (Lightning_RegisterRPCMiddlewareClient, error)
+	// * lncli: `sendcustom`
+	// SendCustomMessage sends a custom peer message to the given connected peer.
+	// The message type must be odd and within the custom range, such that peers
+	// unaware of it will ignore it.
+	SendCustomMessage(ctx context.Context, in *SendCustomMessageRequest, opts ...grpc.CallOption) (*SendCustomMessageResponse, error)
+	// * lncli: `subscribecustom`
+	// SubscribeCustomMessages subscribes to a stream of incoming custom peer
+	// messages.
+	SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error)
+	// * lncli: `lookuphtlcresolution`
+	// LookupHtlcResolution returns how an HTLC that went to chain was finally
+	// resolved: whether it was settled or timed out, the outpoint that resolved
+	// it and the amount that was recovered.
+	LookupHtlcResolution(ctx context.Context, in *LookupHtlcResolutionRequest, opts ...grpc.CallOption) (*LookupHtlcResolutionResponse, error)
+	// * lncli: `updatenodeannouncement`
+	// UpdateNodeAnnouncement changes the alias, color, advertised addresses and
+	// feature bits of the node announcement at runtime. The announcement is
+	// then re-signed and broadcast to the network.
+	UpdateNodeAnnouncement(ctx context.Context, in *UpdateNodeAnnouncementRequest, opts ...grpc.CallOption) (*UpdateNodeAnnouncementResponse, error)
+	// * lncli: `getrecoveryinfo`
+	// GetRecoveryInfo returns whether the wallet is in recovery mode, whether
+	// the recovery is finished, and the progress made so far.
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+}
+
+type lightningClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLightningClient(cc *grpc.ClientConn) LightningClient {
+	return &lightningClient{cc}
+}
+
+func (c *lightningClient) WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error) {
+	out := new(WalletBalanceResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/WalletBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error) {
+	out := new(ChannelBalanceResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ChannelBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error) {
+	out := new(TransactionDetails)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetTransactions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error) {
+	out := new(SendCoinsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendCoins", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error) {
+	out := new(ListUnspentResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListUnspent", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[0], c.cc, "/lnrpc.Lightning/SubscribeTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeTransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error) {
+	out := new(SendManyResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendMany", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/EstimateFee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error) {
+	out := new(NewAddressResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/NewAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListAddresses(ctx context.Context, in *ListAddressesRequest, opts ...grpc.CallOption) (*ListAddressesResponse, error) {
+	out := new(ListAddressesResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListAddresses", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
+	out := new(SignMessageResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SignMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error) {
+	out := new(VerifyMessageResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/VerifyMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error) {
+	out := new(ConnectPeerResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ConnectPeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error) {
+	out := new(DisconnectPeerResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DisconnectPeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error) {
+	out := new(ListPeersResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListPeers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error) {
+	out := new(PendingChannelsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/PendingChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	out := new(ListChannelsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error) {
+	out := new(ClosedChannelsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ClosedChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
+	out := new(ChannelPoint)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[1], c.cc, "/lnrpc.Lightning/OpenChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningOpenChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_OpenChannelClient interface {
+	Recv() (*OpenStatusUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningOpenChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
+	m := new(OpenStatusUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error) {
+	out := new(BatchOpenChannelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/BatchOpenChannel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FundingStateStep(ctx context.Context, in *FundingTransitionMsg, opts ...grpc.CallOption) (*FundingStateStepResp, error) {
+	out := new(FundingStateStepResp)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FundingStateStep", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[2], c.cc, "/lnrpc.Lightning/CloseChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningCloseChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_CloseChannelClient interface {
+	Recv() (*CloseStatusUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningCloseChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningCloseChannelClient) Recv() (*CloseStatusUpdate, error) {
+	m := new(CloseStatusUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error) {
+	out := new(AbandonChannelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AbandonChannel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[3], c.cc, "/lnrpc.Lightning/SendPayment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSendPaymentClient{stream}
+	return x, nil
+}
+
+type Lightning_SendPaymentClient interface {
+	Send(*SendRequest) error
+	Recv() (*SendResponse, error)
+	grpc.ClientStream
+}
+
+type lightningSendPaymentClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSendPaymentClient) Send(m *SendRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningSendPaymentClient) Recv() (*SendResponse, error) {
+	m := new(SendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error) {
+	out := new(SendResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendPaymentSync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[4], c.cc, "/lnrpc.Lightning/SendToRoute", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSendToRouteClient{stream}
+	return x, nil
+}
+
+type Lightning_SendToRouteClient interface {
+	Send(*SendToRouteRequest) error
+	Recv() (*SendResponse, error)
+	grpc.ClientStream
+}
+
+type lightningSendToRouteClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSendToRouteClient) Send(m *SendToRouteRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningSendToRouteClient) Recv() (*SendResponse, error) {
+	m := new(SendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error) {
+	out := new(SendResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendToRouteSync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
+	out := new(AddInvoiceResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AddInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*CreateOfferResponse, error) {
+	out := new(CreateOfferResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/CreateOffer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) PayOffer(ctx context.Context, in *PayOfferRequest, opts ...grpc.CallOption) (*SendResponse, error) {
+	out := new(SendResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/PayOffer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error) {
+	out := new(ListInvoiceResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListInvoices", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error) {
+	out := new(Invoice)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/LookupInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[5], c.cc, "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeInvoicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeInvoicesClient interface {
+	Recv() (*Invoice, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeInvoicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeInvoicesClient) Recv() (*Invoice, error) {
+	m := new(Invoice)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error) {
+	out := new(PayReq)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DecodePayReq", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	out := new(ListPaymentsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListPayments", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error) {
+	out := new(DeleteAllPaymentsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DeleteAllPayments", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error) {
+	out := new(ChannelGraph)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DescribeGraph", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error) {
+	out := new(ChannelEdge)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetChanInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
+	out := new(NodeInfo)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
+	out := new(QueryRoutesResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/QueryRoutes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
+	out := new(NetworkInfo)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetNetworkInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
+	out := new(StopResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/StopDaemon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[6], c.cc, "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeChannelGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeChannelGraphClient interface {
+	Recv() (*GraphTopologyUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeChannelGraphClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeChannelGraphClient) Recv() (*GraphTopologyUpdate, error) {
+	m := new(GraphTopologyUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error) {
+	out := new(DebugLevelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DebugLevel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error) {
+	out := new(FeeReportResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FeeReport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error) {
+	out := new(PolicyUpdateResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/UpdateChannelPolicy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
+	out := new(ForwardingHistoryResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListSweepableOutputs(ctx context.Context, in *ListSweepableOutputsRequest, opts ...grpc.CallOption) (*ListSweepableOutputsResponse, error) {
+	out := new(ListSweepableOutputsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListSweepableOutputs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SweepOutputs(ctx context.Context, in *SweepOutputsRequest, opts ...grpc.CallOption) (*SweepOutputsResponse, error) {
+	out := new(SweepOutputsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SweepOutputs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ArchiveClosedChannels(ctx context.Context, in *ArchiveClosedChannelsRequest, opts ...grpc.CallOption) (*ArchiveClosedChannelsResponse, error) {
+	out := new(ArchiveClosedChannelsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ArchiveClosedChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error) {
+	out := new(CancelPaymentResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/CancelPayment", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ExportChannelAudit(ctx context.Context, in *ExportChannelAuditRequest, opts ...grpc.CallOption) (*ExportChannelAuditResponse, error) {
+	out := new(ExportChannelAuditResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ExportChannelAudit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FreezeChannel(ctx context.Context, in *FreezeChannelRequest, opts ...grpc.CallOption) (*FreezeChannelResponse, error) {
+	out := new(FreezeChannelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FreezeChannel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpdateChannelConstraints(ctx context.Context, in *UpdateChannelConstraintsRequest, opts ...grpc.CallOption) (*UpdateChannelConstraintsResponse, error) {
+	out := new(UpdateChannelConstraintsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/UpdateChannelConstraints", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[7], c.cc, "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeChannelEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeChannelEventsClient interface {
+	Recv() (*ChannelEventUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeChannelEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeChannelEventsClient) Recv() (*ChannelEventUpdate, error) {
+	m := new(ChannelEventUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SubscribePeerEvents(ctx context.Context, in *PeerEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribePeerEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[8], c.cc, "/lnrpc.Lightning/SubscribePeerEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribePeerEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribePeerEventsClient interface {
+	Recv() (*PeerEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribePeerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribePeerEventsClient) Recv() (*PeerEvent, error) {
+	m := new(PeerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*DeletePaymentResponse, error) {
+	out := new(DeletePaymentResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DeletePayment", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error) {
+	out := new(ChannelBackup)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ExportChannelBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ExportAllChannelBackups(ctx context.Context, in *ChanBackupExportRequest, opts ...grpc.CallOption) (*ChanBackupSnapshot, error) {
+	out := new(ChanBackupSnapshot)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ExportAllChannelBackups", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) VerifyChanBackup(ctx context.Context, in *ChanBackupSnapshot, opts ...grpc.CallOption) (*VerifyChanBackupResponse, error) {
+	out := new(VerifyChanBackupResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/VerifyChanBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) RestoreChannelBackups(ctx context.Context, in *RestoreChanBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error) {
+	out := new(RestoreBackupResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/RestoreChannelBackups", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[9], c.cc, "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeChannelBackupsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeChannelBackupsClient interface {
+	Recv() (*ChanBackupSnapshot, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeChannelBackupsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeChannelBackupsClient) Recv() (*ChanBackupSnapshot, error) {
+	m := new(ChanBackupSnapshot)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
+	out := new(BakeMacaroonResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/BakeMacaroon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error) {
+	out := new(ListMacaroonIDsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListMacaroonIDs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error) {
+	out := new(DeleteMacaroonIDResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DeleteMacaroonID", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	out := new(ListPermissionsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListPermissions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) CheckMacaroonPermissions(ctx context.Context, in *CheckMacPermRequest, opts ...grpc.CallOption) (*CheckMacPermResponse, error) {
+	out := new(CheckMacPermResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/CheckMacaroonPermissions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) RegisterRPCMiddleware(ctx context.Context, opts ...grpc.CallOption) (Lightning_RegisterRPCMiddlewareClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[10], c.cc, "/lnrpc.Lightning/RegisterRPCMiddleware", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningRegisterRPCMiddlewareClient{stream}
+	return x, nil
+}
+
+type Lightning_RegisterRPCMiddlewareClient interface {
+	Send(*RPCMiddlewareResponse) error
+	Recv() (*RPCMiddlewareRequest, error)
+	grpc.ClientStream
+}
+
+type lightningRegisterRPCMiddlewareClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningRegisterRPCMiddlewareClient) Send(m *RPCMiddlewareResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningRegisterRPCMiddlewareClient) Recv() (*RPCMiddlewareRequest, error) {
+	m := new(RPCMiddlewareRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SendCustomMessage(ctx context.Context, in *SendCustomMessageRequest, opts ...grpc.CallOption) (*SendCustomMessageResponse, error) {
+	out := new(SendCustomMessageResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SendCustomMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[11], c.cc, "/lnrpc.Lightning/SubscribeCustomMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeCustomMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeCustomMessagesClient interface {
+	Recv() (*CustomMessage, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeCustomMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeCustomMessagesClient) Recv() (*CustomMessage, error) {
+	m := new(CustomMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) LookupHtlcResolution(ctx context.Context, in *LookupHtlcResolutionRequest, opts ...grpc.CallOption) (*LookupHtlcResolutionResponse, error) {
+	out := new(LookupHtlcResolutionResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/LookupHtlcResolution", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpdateNodeAnnouncement(ctx context.Context, in *UpdateNodeAnnouncementRequest, opts ...grpc.CallOption) (*UpdateNodeAnnouncementResponse, error) {
+	out := new(UpdateNodeAnnouncementResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/UpdateNodeAnnouncement", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
+	out := new(GetRecoveryInfoResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetRecoveryInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Lightning service
+
+type LightningServer interface {
+	// * lncli: `walletbalance`
+	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
+	// confirmed unspent outputs and all unconfirmed unspent outputs under control
+	// of the wallet.
+	WalletBalance(context.Context, *WalletBalanceRequest) (*WalletBalanceResponse, error)
+	// * lncli: `channelbalance`
+	// ChannelBalance returns the total funds available across all open channels
+	// in satoshis.
+	ChannelBalance(context.Context, *ChannelBalanceRequest) (*ChannelBalanceResponse, error)
+	// * lncli: `listchaintxns`
+	// GetTransactions returns a list describing all the known transactions
+	// relevant to the wallet.
+	GetTransactions(context.Context, *GetTransactionsRequest) (*TransactionDetails, error)
+	// * lncli: `sendcoins`
+	// SendCoins executes a request to send coins to a particular address. Unlike
+	// SendMany, this RPC call only allows creating a single output at a time. If
+	// neither target_conf, or sat_per_byte are set, then the internal wallet will
+	// consult its fee model to determine a fee for the default confirmation
+	// target.
+	SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error)
+	// * lncli: `listunspent`
+	// ListUnspent returns a list of all utxos spendable by the wallet with a
+	// number of confirmations between the specified minimum and maximum.
+	ListUnspent(context.Context, *ListUnspentRequest) (*ListUnspentResponse, error)
+	// *
+	// SubscribeTransactions creates a uni-directional stream from the server to
+	// the client in which any newly discovered transactions relevant to the
+	// wallet are sent over.
+	SubscribeTransactions(*GetTransactionsRequest, Lightning_SubscribeTransactionsServer) error
+	// * lncli: `sendmany`
+	// SendMany handles a request for a transaction that creates multiple specified
+	// outputs in parallel. If neither target_conf, or sat_per_byte are set, then
+	// the internal wallet will consult its fee model to determine a fee for the
+	// default confirmation target.
+	SendMany(context.Context, *SendManyRequest) (*SendManyResponse, error)
+	// * lncli: `estimatefee`
+	// EstimateFee asks the wallet to estimate the fee and fee rate of a
+	// transaction paying to the specified outputs, without creating or
+	// broadcasting it. The fee rate is derived from target_conf, or the default
+	// confirmation target if it isn't set.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	// * lncli: `newaddress`
+	// NewAddress creates a new address under control of the local wallet.
+	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
+	// * lncli: `listaddresses`
+	// ListAddresses returns all addresses the wallet has derived so far, along
+	// with their derivation paths, types and balances.
+	ListAddresses(context.Context, *ListAddressesRequest) (*ListAddressesResponse, error)
+	// * lncli: `signmessage`
+	// SignMessage signs a message with this node's private key. The returned
+	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
+	// only the message digest and signature are needed for verification.
+	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
+	// * lncli: `verifymessage`
+	// VerifyMessage verifies a signature over a msg. The signature must be
+	// zbase32 encoded and signed by an active node in the resident node's
+	// channel database. In addition to returning the validity of the signature,
+	// VerifyMessage also returns the recovered pubkey from the signature.
+	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
+	// * lncli: `connect`
+	// ConnectPeer attempts to establish a connection to a remote peer. This is at
+	// the networking level, and is used for communication between nodes. This is
+	// distinct from establishing a channel with a peer.
+	ConnectPeer(context.Context, *ConnectPeerRequest) (*ConnectPeerResponse, error)
+	// * lncli: `disconnect`
+	// DisconnectPeer attempts to disconnect one peer from another identified by a
+	// given pubKey. In the case that we currently have a pending or active channel
+	// with the target peer, then this action will be not be allowed.
+	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
+	// * lncli: `listpeers`
+	// ListPeers returns a verbose listing of all currently active peers.
+	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	// * lncli: `getinfo`
+	// GetInfo returns general information concerning the lightning node including
+	// it's identity pubkey, alias, the chains it is connected to, and information
+	// concerning the number of open+pending channels.
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// * lncli: `pendingchannels`
+	// PendingChannels returns a list of all the channels that are currently
+	// considered "pending". A channel is pending if it has finished the funding
+	// workflow and is waiting for confirmations for the funding txn, or is in the
+	// process of closure, either initiated cooperatively or non-cooperatively.
+	PendingChannels(context.Context, *PendingChannelsRequest) (*PendingChannelsResponse, error)
+	// * lncli: `listchannels`
+	// ListChannels returns a description of all the open channels that this node
+	// is a participant in.
+	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	// * lncli: `closedchannels`
+	// ClosedChannels returns a description of all the closed channels that
+	// this node was a participant in.
+	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+	// *
+	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
+	// call is meant to be consumed by clients to the REST proxy. As with all
+	// other sync calls, all byte slices are intended to be populated as hex
+	// encoded strings.
+	OpenChannelSync(context.Context, *OpenChannelRequest) (*ChannelPoint, error)
+	// * lncli: `openchannel`
+	// OpenChannel attempts to open a singly funded channel specified in the
+	// request to a remote peer. Users are able to specify a target number of
+	// blocks that the funding transaction should be confirmed in, or a manual fee
+	// rate to us for the funding transaction. If neither are specified, then a
+	// lax block confirmation target is used.
+	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
+	// * lncli: `batchopenchannel`
+	// BatchOpenChannel attempts to open multiple singly funded channels with a
+	// single funding transaction, which carries at most one change output. Either
+	// all channels of the batch are opened, or none of them are: the funding
+	// transaction is only broadcast once every peer has signed its commitment
+	// transaction, and the whole batch is aborted if any of the funding flows
+	// fails.
+	BatchOpenChannel(context.Context, *BatchOpenChannelRequest) (*BatchOpenChannelResponse, error)
+	// * lncli: `fundingstatestep`
+	// FundingStateStep continues the funding flow of a channel opened with a
+	// PSBT shim. The PSBT funded by the external wallet is first verified to pay
+	// to the funding output of the channel, then the signed funding transaction
+	// is handed over. lnd only broadcasts the funding transaction once it has
+	// both the signed transaction and the remote party's signature for our
+	// commitment transaction. A pending PSBT funding flow can also be cancelled.
+	FundingStateStep(context.Context, *FundingTransitionMsg) (*FundingStateStepResp, error)
+	// * lncli: `closechannel`
+	// CloseChannel attempts to close an active channel identified by its channel
+	// outpoint (ChannelPoint). The actions of this method can additionally be
+	// augmented to attempt a force close after a timeout period in the case of an
+	// inactive peer. If a non-force close (cooperative closure) is requested,
+	// then the user can specify either a target number of blocks until the
+	// closure transaction is confirmed, or a manual fee rate. If neither are
+	// specified, then a default lax, block confirmation target is used.
+	// Our funds of a cooperative closure are sent to a fresh wallet address,
+	// unless a delivery address is specified. Close status updates are streamed
+	// until the closing transaction confirms.
+	CloseChannel(*CloseChannelRequest, Lightning_CloseChannelServer) error
+	// * lncli: `abandonchannel`
+	// AbandonChannel removes all channel state from the database except for a
+	// close summary. This method can be used to get rid of permanently unusable
+	// channels due to bugs fixed in newer versions of lnd. Only available
+	// when in debug builds of lnd.
+	AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error)
+	// * lncli: `sendpayment`
+	// SendPayment dispatches a bi-directional streaming RPC for sending payments
+	// through the Lightning Network. A single RPC invocation creates a persistent
+	// bi-directional stream allowing clients to rapidly send payments through the
+	// Lightning Network with a single persistent connection.
+	SendPayment(Lightning_SendPaymentServer) error
+	// *
+	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
+	// This RPC is intended to be consumed by clients of the REST proxy.
+	// Additionally, this RPC expects the destination's public key and the payment
+	// hash (if any) to be encoded as hex strings.
+	SendPaymentSync(context.Context, *SendRequest) (*SendResponse, error)
+	// * lncli: `sendtoroute`
+	// SendToRoute is a bi-directional streaming RPC for sending payment through
+	// the Lightning Network. This method differs from SendPayment in that it
+	// allows users to specify a full route manually. This can be used for things
+	// like rebalancing, and atomic swaps.
+	SendToRoute(Lightning_SendToRouteServer) error
+	// *
+	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
+	// until the payment either fails or succeeds.
+	SendToRouteSync(context.Context, *SendToRouteRequest) (*SendResponse, error)
+	// * lncli: `addinvoice`
+	// AddInvoice attempts to add a new invoice to the invoice database. Any
+	// duplicated invoices are rejected, therefore all invoices *must* have a
+	// unique payment preimage.
+	AddInvoice(context.Context, *Invoice) (*AddInvoiceResponse, error)
+	// * lncli: `createoffer`
+	// CreateOffer creates a new BOLT-12 offer for our node. Invoice requests
+	// for the offer are answered over onion messages with a fresh invoice.
+	// This requires lnd to be started with --offers.
+	CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResponse, error)
+	// * lncli: `payoffer`
+	// PayOffer requests an invoice for the passed BOLT-12 offer from its
+	// issuer over onion messages, and pays it. This requires lnd to be started
+	// with --offers.
+	PayOffer(context.Context, *PayOfferRequest) (*SendResponse, error)
+	// * lncli: `listinvoices`
+	// ListInvoices returns a list of all the invoices currently stored within the
+	// database. Any active debug invoices are ignored. It has full support for
+	// paginated responses, allowing users to query for specific invoices through
+	// their add_index. This can be done by using either the first_index_offset or
+	// last_index_offset fields included in the response as the index_offset of the
+	// next request. The reversed flag is set by default in order to paginate
+	// backwards. If you wish to paginate forwards, you must explicitly set the
+	// flag to false. If none of the parameters are specified, then the last 100
+	// invoices will be returned.
+	ListInvoices(context.Context, *ListInvoiceRequest) (*ListInvoiceResponse, error)
+	// * lncli: `lookupinvoice`
+	// LookupInvoice attempts to look up an invoice according to its payment hash.
+	// The passed payment hash *must* be exactly 32 bytes, if not, an error is
+	// returned.
+	LookupInvoice(context.Context, *PaymentHash) (*Invoice, error)
+	// *
+	// SubscribeInvoices returns a uni-directional stream (server -> client) for
+	// notifying the client of newly added/settled invoices. The caller can
+	// optionally specify the add_index and/or the settle_index. If the add_index
+	// is specified, then we'll first start by sending add invoice events for all
+	// invoices with an add_index greater than the specified value.  If the
+	// settle_index is specified, the next, we'll send out all settle events for
+	// invoices with a settle_index greater than the specified value.  One or both
+	// of these fields can be set. If no fields are set, then we'll only send out
+	// the latest add/settle events.
+	SubscribeInvoices(*InvoiceSubscription, Lightning_SubscribeInvoicesServer) error
+	// * lncli: `decodepayreq`
+	// DecodePayReq takes an encoded payment request string and attempts to decode
+	// it, returning a full description of the conditions encoded within the
+	// payment request.
+	DecodePayReq(context.Context, *PayReqString) (*PayReq, error)
+	// * lncli: `listpayments`
+	// ListPayments returns a list of outgoing payments. By default, all the
+	// payments that succeeded are returned. Payments that are in flight or that
+	// failed can also be included, and the response can be paginated through the
+	// payment_index of the payments.
+	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	// *
+	// DeleteAllPayments deletes all outgoing payments from DB. If
+	// failed_payments_only is set, only the payments that failed are deleted.
+	DeleteAllPayments(context.Context, *DeleteAllPaymentsRequest) (*DeleteAllPaymentsResponse, error)
+	// * lncli: `describegraph`
+	// DescribeGraph returns a description of the latest graph state from the
+	// point of view of the node. The graph information is partitioned into two
+	// components: all the nodes/vertexes, and all the edges that connect the
+	// vertexes themselves.  As this is a directed graph, the edges also contain
+	// the node directional specific routing policy which includes: the time lock
+	// delta, fee information, etc.
+	DescribeGraph(context.Context, *ChannelGraphRequest) (*ChannelGraph, error)
+	// * lncli: `getchaninfo`
+	// GetChanInfo returns the latest authenticated network announcement for the
+	// given channel identified by its channel ID: an 8-byte integer which
+	// uniquely identifies the location of transaction's funding output within the
+	// blockchain.
+	GetChanInfo(context.Context, *ChanInfoRequest) (*ChannelEdge, error)
+	// * lncli: `getnodeinfo`
+	// GetNodeInfo returns the latest advertised, aggregated, and authenticated
+	// channel information for the specified node identified by its public key.
+	GetNodeInfo(context.Context, *NodeInfoRequest) (*NodeInfo, error)
+	// * lncli: `queryroutes`
+	// QueryRoutes attempts to query the daemon's Channel Router for a possible
+	// route to a target destination capable of carrying a specific amount of
+	// satoshis. The retuned route contains the full details required to craft and
+	// send an HTLC, also including the necessary information that should be
+	// present within the Sphinx packet encapsulated within the HTLC.
+	QueryRoutes(context.Context, *QueryRoutesRequest) (*QueryRoutesResponse, error)
+	// * lncli: `getnetworkinfo`
+	// GetNetworkInfo returns some basic stats about the known channel graph from
+	// the point of view of the node.
+	GetNetworkInfo(context.Context, *NetworkInfoRequest) (*NetworkInfo, error)
+	// * lncli: `stop`
+	// StopDaemon will send a shutdown request to the interrupt handler, triggering
+	// a graceful shutdown of the daemon.
+	StopDaemon(context.Context, *StopRequest) (*StopResponse, error)
+	// *
+	// SubscribeChannelGraph launches a streaming RPC that allows the caller to
+	// receive notifications upon any changes to the channel graph topology from
+	// the point of view of the responding node. Events notified include: new
+	// nodes coming online, nodes updating their authenticated attributes, new
+	// channels being advertised, updates in the routing policy for a directional
+	// channel edge, and when channels are closed on-chain.
+	SubscribeChannelGraph(*GraphTopologySubscription, Lightning_SubscribeChannelGraphServer) error
+	// * lncli: `debuglevel`
+	// DebugLevel allows a caller to programmatically set the logging verbosity of
+	// lnd. The logging can be targeted according to a coarse daemon-wide logging
+	// level, or in a granular fashion to specify the logging for a target
+	// sub-system.
+	DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error)
+	// * lncli: `feereport`
+	// FeeReport allows the caller to obtain a report detailing the current fee
+	// schedule enforced by the node globally for each channel.
+	FeeReport(context.Context, *FeeReportRequest) (*FeeReportResponse, error)
+	// * lncli: `updatechanpolicy`
+	// UpdateChannelPolicy allows the caller to update the fee schedule and
+	// channel policies for all channels globally, or a particular channel.
+	UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error)
+	// * lncli: `fwdinghistory`
+	// ForwardingHistory allows the caller to query the htlcswitch for a record of
+	// all HTLC's forwarded within the target time range, and integer offset
+	// within that time range. If no time-range is specified, then the first chunk
+	// of the past 24 hrs of forwarding history are returned.
+	//
+	// A list of forwarding events are returned. The size of each forwarding event
+	// is 40 bytes, and the max message size able to be returned in gRPC is 4 MiB.
+	// As a result each message can only contain 50k entries.  Each response has
+	// the index offset of the last entry. The index offset can be provided to the
+	// request to allow the caller to skip a series of records.
+	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// * lncli: `listsweepable`
+	// ListSweepableOutputs returns the time-locked outputs of force closed
+	// channels that are being swept back into the wallet, along with the height
+	// at which each of them is swept, and the fee required to sweep it.
+	ListSweepableOutputs(context.Context, *ListSweepableOutputsRequest) (*ListSweepableOutputsResponse, error)
+	// * lncli: `sweepoutputs`
+	// SweepOutputs immediately sweeps the selected outputs back into the wallet
+	// at the given fee rate, replacing the sweep transaction that was broadcast
+	// for them. All outputs swept together by that transaction must be
+	// selected.
+	SweepOutputs(context.Context, *SweepOutputsRequest) (*SweepOutputsResponse, error)
+	// * lncli: `archiveclosedchannels`
+	// ArchiveClosedChannels compacts the records of all channels that have been
+	// fully closed and resolved, dropping the state that is only needed to help
+	// the remote party recover from data loss. If a retention window is set, the
+	// records of resolved channels closed before it are deleted altogether.
+	ArchiveClosedChannels(context.Context, *ArchiveClosedChannelsRequest) (*ArchiveClosedChannelsResponse, error)
+	// * lncli: `cancelpayment`
+	// CancelPayment cancels a payment that's currently being sent. No further
+	// routes are attempted for the payment, and the pending SendPayment call
+	// returns once its outstanding HTLC, if any, has been resolved.
+	CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error)
+	// * lncli: `exportchanaudit`
+	// ExportChannelAudit returns a snapshot of the balances and commitments of
+	// all open channels, signed by the node's identity key. The snapshot holds
+	// no secrets, and can be handed to an auditor, who can verify it against
+	// the node's identity key in the same way as a message signed through
+	// SignMessage.
+	ExportChannelAudit(context.Context, *ExportChannelAuditRequest) (*ExportChannelAuditResponse, error)
+	// * lncli: `freezechannel`
+	// FreezeChannel puts a channel into drain mode, in which it neither accepts
+	// nor offers any new HTLCs, while those already in flight are allowed to
+	// resolve. This is useful before closing a channel, or carrying out any
+	// maintenance on it. The channel remains frozen until thawed, or until the
+	// daemon is restarted.
+	FreezeChannel(context.Context, *FreezeChannelRequest) (*FreezeChannelResponse, error)
+	// * lncli: `updatechanconstraints`
+	// UpdateChannelConstraints renegotiates the flow constraints which bound the
+	// HTLCs that the remote party may offer over a live channel. The channel is
+	// quiesced for the duration of the update, which requires the remote peer
+	// to support both the quiescence and dynamic commitments protocols.
+	UpdateChannelConstraints(context.Context, *UpdateChannelConstraintsRequest) (*UpdateChannelConstraintsResponse, error)
+	// *
+	// SubscribeChannelEvents creates a uni-directional stream from the server to
+	// the client in which any updates relevant to the state of the channels are
+	// sent over. Events include channels pending open, newly opened channels,
+	// active and inactive channels, closed channels, and channels whose
+	// contracts have been fully resolved on-chain.
+	SubscribeChannelEvents(*ChannelEventSubscription, Lightning_SubscribeChannelEventsServer) error
+	// *
+	// SubscribePeerEvents creates a uni-directional stream from the server to
+	// the client in which an event is sent whenever a peer comes online or goes
+	// offline.
+	SubscribePeerEvents(*PeerEventSubscription, Lightning_SubscribePeerEventsServer) error
+	// * lncli: `deletepayment`
+	// DeletePayment deletes the record of an outgoing payment from the database.
+	// Payments that are still in flight can't be deleted.
+	DeletePayment(context.Context, *DeletePaymentRequest) (*DeletePaymentResponse, error)
+	// * lncli: `exportchanbackup`
+	// ExportChannelBackup attempts to return an encrypted static channel
+	// backup for the target channel identified by its channel point. The backup
+	// is encrypted with a key generated from the aezeed seed of the user.
+	ExportChannelBackup(context.Context, *ExportChannelBackupRequest) (*ChannelBackup, error)
+	// * lncli: `exportchanbackup`
+	// ExportAllChannelBackups returns static channel backups for all existing
+	// channels known to lnd. A set of regular singular static channel backups for
+	// each channel are returned. Additionally, a multi-channel backup is returned
+	// as well, which contains a single encrypted blob containing the backups of
+	// each channel.
+	ExportAllChannelBackups(context.Context, *ChanBackupExportRequest) (*ChanBackupSnapshot, error)
+	// * lncli: `verifychanbackup`
+	// VerifyChanBackup allows a caller to verify the integrity of a channel
+	// backup snapshot. This method will accept either a packed Single or a
+	// packed Multi. Specifying both will result in an error.
+	VerifyChanBackup(context.Context, *ChanBackupSnapshot) (*VerifyChanBackupResponse, error)
+	// * lncli: `restorechanbackup`
+	// RestoreChannelBackups accepts a set of singular channel backups, or a
+	// single encrypted multi-chan backup and attempts to recover any funds
+	// remaining within the channel. If we are able to unpack the backup, then the
+	// new channel will be shown under listchannels, as well as pending channels.
+	RestoreChannelBackups(context.Context, *RestoreChanBackupRequest) (*RestoreBackupResponse, error)
+	// *
+	// SubscribeChannelBackups allows a client to subscribe to the most up to
+	// date information concerning the state of all channel backups. Each time a
+	// channel is funded, opened or closed, we send a new snapshot containing the
+	// single-chan backups of all current channels, along with a multi-chan
+	// backup covering all of them.
+	SubscribeChannelBackups(*ChannelBackupSubscription, Lightning_SubscribeChannelBackupsServer) error
+	// * lncli: `bakemacaroon`
+	// BakeMacaroon allows the creation of a new macaroon with custom read and
+	// write permissions. No first-party caveats are added since this can be done
+	// offline.
+	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	// * lncli: `listmacaroonids`
+	// ListMacaroonIDs returns all root key IDs that are in use.
+	ListMacaroonIDs(context.Context, *ListMacaroonIDsRequest) (*ListMacaroonIDsResponse, error)
+	// * lncli: `deletemacaroonid`
+	// DeleteMacaroonID deletes the specified macaroon ID and invalidates all
+	// macaroons derived from that ID.
+	DeleteMacaroonID(context.Context, *DeleteMacaroonIDRequest) (*DeleteMacaroonIDResponse, error)
+	// * lncli: `listpermissions`
+	// ListPermissions lists all RPC method URIs and the macaroon permissions they
+	// require.
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	// *
+	// CheckMacaroonPermissions checks whether the given macaroon is valid and
+	// grants all of the given permissions. It allows services that sit in front of
+	// lnd to authorize calls before forwarding them.
+	CheckMacaroonPermissions(context.Context, *CheckMacPermRequest) (*CheckMacPermResponse, error)
+	// *
+	// RegisterRPCMiddleware adds a new gRPC middleware to the interceptor chain. A
+	// gRPC middleware is software component external to lnd that aims to add
+	// additional business logic to lnd by observing/intercepting/validating
+	// incoming gRPC client requests and (if needed) replacing/overwriting outgoing
+	// messages before they're sent to the client. The first message sent by the
+	// middleware must be a registration message. Only requests and responses of
+	// RPC calls made with a macaroon that carries the custom caveat the
+	// middleware registered for are sent to it.
+	RegisterRPCMiddleware(Lightning_RegisterRPCMiddlewareServer) error
+	// * lncli: `sendcustom`
+	// SendCustomMessage sends a custom peer message to the given connected peer.
+	// The message type must be odd and within the custom range, such that peers
+	// unaware of it will ignore it.
+	SendCustomMessage(context.Context, *SendCustomMessageRequest) (*SendCustomMessageResponse, error)
+	// * lncli: `subscribecustom`
+	// SubscribeCustomMessages subscribes to a stream of incoming custom peer
+	// messages.
+	SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error
+	// * lncli: `lookuphtlcresolution`
+	// LookupHtlcResolution returns how an HTLC that went to chain was finally
+	// resolved: whether it was settled or timed out, the outpoint that resolved
+	// it and the amount that was recovered.
+	LookupHtlcResolution(context.Context, *LookupHtlcResolutionRequest) (*LookupHtlcResolutionResponse, error)
+	// * lncli: `updatenodeannouncement`
+	// UpdateNodeAnnouncement changes the alias, color, advertised addresses and
+	// feature bits of the node announcement at runtime. The announcement is
+	// then re-signed and broadcast to the network.
+	UpdateNodeAnnouncement(context.Context, *UpdateNodeAnnouncementRequest) (*UpdateNodeAnnouncementResponse, error)
+	// * lncli: `getrecoveryinfo`
+	// GetRecoveryInfo returns whether the wallet is in recovery mode, whether
+	// the recovery is finished, and the progress made so far.
+	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
+}
+
+func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
+	s.RegisterService(&_Lightning_serviceDesc, srv)
+}
+
+func _Lightning_WalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).WalletBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/WalletBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).WalletBalance(ctx, req.(*WalletBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ChannelBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ChannelBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ChannelBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ChannelBalance(ctx, req.(*ChannelBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SendCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendCoins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendCoins(ctx, req.(*SendCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnspentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListUnspent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListUnspent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListUnspent(ctx, req.(*ListUnspentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeTransactions(m, &lightningSubscribeTransactionsServer{stream})
+}
+
+type Lightning_SubscribeTransactionsServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeTransactionsServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_SendMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendMany(ctx, req.(*SendManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_NewAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).NewAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/NewAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).NewAddress(ctx, req.(*NewAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListAddresses(ctx, req.(*ListAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SignMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SignMessage(ctx, req.(*SignMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/VerifyMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).VerifyMessage(ctx, req.(*VerifyMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ConnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ConnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ConnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ConnectPeer(ctx, req.(*ConnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DisconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListPeers(ctx, req.(*ListPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).PendingChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/PendingChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).PendingChannels(ctx, req.(*PendingChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
-	s.RegisterService(&_Lightning_serviceDesc, srv)
+func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ClosedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ClosedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ClosedChannels(ctx, req.(*ClosedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_WalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WalletBalanceRequest)
+func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).WalletBalance(ctx, in)
+		return srv.(LightningServer).OpenChannelSync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/WalletBalance",
+		FullMethod: "/lnrpc.Lightning/OpenChannelSync",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).WalletBalance(ctx, req.(*WalletBalanceRequest))
+		return srv.(LightningServer).OpenChannelSync(ctx, req.(*OpenChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ChannelBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelBalanceRequest)
+func _Lightning_OpenChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OpenChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).OpenChannel(m, &lightningOpenChannelServer{stream})
+}
+
+type Lightning_OpenChannelServer interface {
+	Send(*OpenStatusUpdate) error
+	grpc.ServerStream
+}
+
+type lightningOpenChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_BatchOpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchOpenChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ChannelBalance(ctx, in)
+		return srv.(LightningServer).BatchOpenChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ChannelBalance",
+		FullMethod: "/lnrpc.Lightning/BatchOpenChannel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ChannelBalance(ctx, req.(*ChannelBalanceRequest))
+		return srv.(LightningServer).BatchOpenChannel(ctx, req.(*BatchOpenChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionsRequest)
+func _Lightning_FundingStateStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundingTransitionMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetTransactions(ctx, in)
+		return srv.(LightningServer).FundingStateStep(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetTransactions",
+		FullMethod: "/lnrpc.Lightning/FundingStateStep",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+		return srv.(LightningServer).FundingStateStep(ctx, req.(*FundingTransitionMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SendCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendCoinsRequest)
+func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloseChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).CloseChannel(m, &lightningCloseChannelServer{stream})
+}
+
+type Lightning_CloseChannelServer interface {
+	Send(*CloseStatusUpdate) error
+	grpc.ServerStream
+}
+
+type lightningCloseChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningCloseChannelServer) Send(m *CloseStatusUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendCoins(ctx, in)
+		return srv.(LightningServer).AbandonChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendCoins",
+		FullMethod: "/lnrpc.Lightning/AbandonChannel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendCoins(ctx, req.(*SendCoinsRequest))
+		return srv.(LightningServer).AbandonChannel(ctx, req.(*AbandonChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUnspentRequest)
+func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
+}
+
+type Lightning_SendPaymentServer interface {
+	Send(*SendResponse) error
+	Recv() (*SendRequest, error)
+	grpc.ServerStream
+}
+
+type lightningSendPaymentServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSendPaymentServer) Send(m *SendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningSendPaymentServer) Recv() (*SendRequest, error) {
+	m := new(SendRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SendPaymentSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListUnspent(ctx, in)
+		return srv.(LightningServer).SendPaymentSync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListUnspent",
+		FullMethod: "/lnrpc.Lightning/SendPaymentSync",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListUnspent(ctx, req.(*ListUnspentRequest))
+		return srv.(LightningServer).SendPaymentSync(ctx, req.(*SendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SendToRoute_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).SendToRoute(&lightningSendToRouteServer{stream})
+}
+
+type Lightning_SendToRouteServer interface {
+	Send(*SendResponse) error
+	Recv() (*SendToRouteRequest, error)
+	grpc.ServerStream
+}
+
+type lightningSendToRouteServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSendToRouteServer) Send(m *SendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningSendToRouteServer) Recv() (*SendToRouteRequest, error) {
+	m := new(SendToRouteRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SendToRouteSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendToRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendToRouteSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendToRouteSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendToRouteSync(ctx, req.(*SendToRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Invoice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AddInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AddInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AddInvoice(ctx, req.(*Invoice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_CreateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).CreateOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/CreateOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).CreateOffer(ctx, req.(*CreateOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_PayOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).PayOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/PayOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).PayOffer(ctx, req.(*PayOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListInvoices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListInvoices(ctx, req.(*ListInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_LookupInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).LookupInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/LookupInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).LookupInvoice(ctx, req.(*PaymentHash))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTransactionsRequest)
+func _Lightning_SubscribeInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvoiceSubscription)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(LightningServer).SubscribeTransactions(m, &lightningSubscribeTransactionsServer{stream})
+	return srv.(LightningServer).SubscribeInvoices(m, &lightningSubscribeInvoicesServer{stream})
 }
 
-type Lightning_SubscribeTransactionsServer interface {
-	Send(*Transaction) error
+type Lightning_SubscribeInvoicesServer interface {
+	Send(*Invoice) error
 	grpc.ServerStream
 }
 
-type lightningSubscribeTransactionsServer struct {
+type lightningSubscribeInvoicesServer struct {
 	grpc.ServerStream
 }
 
-func (x *lightningSubscribeTransactionsServer) Send(m *Transaction) error {
+func (x *lightningSubscribeInvoicesServer) Send(m *Invoice) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Lightning_SendMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendManyRequest)
+func _Lightning_DecodePayReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayReqString)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendMany(ctx, in)
+		return srv.(LightningServer).DecodePayReq(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendMany",
+		FullMethod: "/lnrpc.Lightning/DecodePayReq",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendMany(ctx, req.(*SendManyRequest))
+		return srv.(LightningServer).DecodePayReq(ctx, req.(*PayReqString))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_NewAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewAddressRequest)
+func _Lightning_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).NewAddress(ctx, in)
+		return srv.(LightningServer).ListPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/NewAddress",
+		FullMethod: "/lnrpc.Lightning/ListPayments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).NewAddress(ctx, req.(*NewAddressRequest))
+		return srv.(LightningServer).ListPayments(ctx, req.(*ListPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignMessageRequest)
+func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SignMessage(ctx, in)
+		return srv.(LightningServer).DeleteAllPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SignMessage",
+		FullMethod: "/lnrpc.Lightning/DeleteAllPayments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SignMessage(ctx, req.(*SignMessageRequest))
+		return srv.(LightningServer).DeleteAllPayments(ctx, req.(*DeleteAllPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyMessageRequest)
+func _Lightning_DescribeGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelGraphRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).VerifyMessage(ctx, in)
+		return srv.(LightningServer).DescribeGraph(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/VerifyMessage",
+		FullMethod: "/lnrpc.Lightning/DescribeGraph",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).VerifyMessage(ctx, req.(*VerifyMessageRequest))
+		return srv.(LightningServer).DescribeGraph(ctx, req.(*ChannelGraphRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ConnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConnectPeerRequest)
+func _Lightning_GetChanInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChanInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ConnectPeer(ctx, in)
+		return srv.(LightningServer).GetChanInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ConnectPeer",
+		FullMethod: "/lnrpc.Lightning/GetChanInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ConnectPeer(ctx, req.(*ConnectPeerRequest))
+		return srv.(LightningServer).GetChanInfo(ctx, req.(*ChanInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisconnectPeerRequest)
+func _Lightning_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DisconnectPeer(ctx, in)
+		return srv.(LightningServer).GetNodeInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DisconnectPeer",
+		FullMethod: "/lnrpc.Lightning/GetNodeInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+		return srv.(LightningServer).GetNodeInfo(ctx, req.(*NodeInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPeersRequest)
+func _Lightning_QueryRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoutesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListPeers(ctx, in)
+		return srv.(LightningServer).QueryRoutes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListPeers",
+		FullMethod: "/lnrpc.Lightning/QueryRoutes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListPeers(ctx, req.(*ListPeersRequest))
+		return srv.(LightningServer).QueryRoutes(ctx, req.(*QueryRoutesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _Lightning_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetInfo(ctx, in)
+		return srv.(LightningServer).GetNetworkInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetInfo",
+		FullMethod: "/lnrpc.Lightning/GetNetworkInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetInfo(ctx, req.(*GetInfoRequest))
+		return srv.(LightningServer).GetNetworkInfo(ctx, req.(*NetworkInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PendingChannelsRequest)
+func _Lightning_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).PendingChannels(ctx, in)
+		return srv.(LightningServer).StopDaemon(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/PendingChannels",
+		FullMethod: "/lnrpc.Lightning/StopDaemon",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).PendingChannels(ctx, req.(*PendingChannelsRequest))
+		return srv.(LightningServer).StopDaemon(ctx, req.(*StopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListChannelsRequest)
+func _Lightning_SubscribeChannelGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GraphTopologySubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeChannelGraph(m, &lightningSubscribeChannelGraphServer{stream})
+}
+
+type Lightning_SubscribeChannelGraphServer interface {
+	Send(*GraphTopologyUpdate) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeChannelGraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeChannelGraphServer) Send(m *GraphTopologyUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugLevelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListChannels(ctx, in)
+		return srv.(LightningServer).DebugLevel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListChannels",
+		FullMethod: "/lnrpc.Lightning/DebugLevel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListChannels(ctx, req.(*ListChannelsRequest))
+		return srv.(LightningServer).DebugLevel(ctx, req.(*DebugLevelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClosedChannelsRequest)
+func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ClosedChannels(ctx, in)
+		return srv.(LightningServer).FeeReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ClosedChannels",
+		FullMethod: "/lnrpc.Lightning/FeeReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ClosedChannels(ctx, req.(*ClosedChannelsRequest))
+		return srv.(LightningServer).FeeReport(ctx, req.(*FeeReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OpenChannelRequest)
+func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyUpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).OpenChannelSync(ctx, in)
+		return srv.(LightningServer).UpdateChannelPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/OpenChannelSync",
+		FullMethod: "/lnrpc.Lightning/UpdateChannelPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).OpenChannelSync(ctx, req.(*OpenChannelRequest))
+		return srv.(LightningServer).UpdateChannelPolicy(ctx, req.(*PolicyUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_OpenChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(OpenChannelRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(LightningServer).OpenChannel(m, &lightningOpenChannelServer{stream})
-}
-
-type Lightning_OpenChannelServer interface {
-	Send(*OpenStatusUpdate) error
-	grpc.ServerStream
-}
-
-type lightningOpenChannelServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(CloseChannelRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(LightningServer).CloseChannel(m, &lightningCloseChannelServer{stream})
-}
-
-type Lightning_CloseChannelServer interface {
-	Send(*CloseStatusUpdate) error
-	grpc.ServerStream
-}
-
-type lightningCloseChannelServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningCloseChannelServer) Send(m *CloseStatusUpdate) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AbandonChannelRequest)
+func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardingHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).AbandonChannel(ctx, in)
+		return srv.(LightningServer).ForwardingHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/AbandonChannel",
+		FullMethod: "/lnrpc.Lightning/ForwardingHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).AbandonChannel(ctx, req.(*AbandonChannelRequest))
+		return srv.(LightningServer).ForwardingHistory(ctx, req.(*ForwardingHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
-}
-
-type Lightning_SendPaymentServer interface {
-	Send(*SendResponse) error
-	Recv() (*SendRequest, error)
-	grpc.ServerStream
-}
-
-type lightningSendPaymentServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningSendPaymentServer) Send(m *SendResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *lightningSendPaymentServer) Recv() (*SendRequest, error) {
-	m := new(SendRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _Lightning_SendPaymentSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendRequest)
+func _Lightning_ListSweepableOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSweepableOutputsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendPaymentSync(ctx, in)
+		return srv.(LightningServer).ListSweepableOutputs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendPaymentSync",
+		FullMethod: "/lnrpc.Lightning/ListSweepableOutputs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendPaymentSync(ctx, req.(*SendRequest))
+		return srv.(LightningServer).ListSweepableOutputs(ctx, req.(*ListSweepableOutputsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
-
-func _Lightning_SendToRoute_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(LightningServer).SendToRoute(&lightningSendToRouteServer{stream})
-}
-
-type Lightning_SendToRouteServer interface {
-	Send(*SendResponse) error
-	Recv() (*SendToRouteRequest, error)
-	grpc.ServerStream
-}
-
-type lightningSendToRouteServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningSendToRouteServer) Send(m *SendResponse) error {
-	return x.ServerStream.SendMsg(m)
+
+func _Lightning_SweepOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SweepOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SweepOutputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SweepOutputs(ctx, req.(*SweepOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *lightningSendToRouteServer) Recv() (*SendToRouteRequest, error) {
-	m := new(SendToRouteRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _Lightning_ArchiveClosedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveClosedChannelsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(LightningServer).ArchiveClosedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ArchiveClosedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ArchiveClosedChannels(ctx, req.(*ArchiveClosedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SendToRouteSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendToRouteRequest)
+func _Lightning_CancelPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendToRouteSync(ctx, in)
+		return srv.(LightningServer).CancelPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendToRouteSync",
+		FullMethod: "/lnrpc.Lightning/CancelPayment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendToRouteSync(ctx, req.(*SendToRouteRequest))
+		return srv.(LightningServer).CancelPayment(ctx, req.(*CancelPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Invoice)
+func _Lightning_ExportChannelAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChannelAuditRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).AddInvoice(ctx, in)
+		return srv.(LightningServer).ExportChannelAudit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/AddInvoice",
+		FullMethod: "/lnrpc.Lightning/ExportChannelAudit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).AddInvoice(ctx, req.(*Invoice))
+		return srv.(LightningServer).ExportChannelAudit(ctx, req.(*ExportChannelAuditRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListInvoiceRequest)
+func _Lightning_FreezeChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListInvoices(ctx, in)
+		return srv.(LightningServer).FreezeChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListInvoices",
+		FullMethod: "/lnrpc.Lightning/FreezeChannel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListInvoices(ctx, req.(*ListInvoiceRequest))
+		return srv.(LightningServer).FreezeChannel(ctx, req.(*FreezeChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_LookupInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaymentHash)
+func _Lightning_UpdateChannelConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelConstraintsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).LookupInvoice(ctx, in)
+		return srv.(LightningServer).UpdateChannelConstraints(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/LookupInvoice",
+		FullMethod: "/lnrpc.Lightning/UpdateChannelConstraints",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).LookupInvoice(ctx, req.(*PaymentHash))
+		return srv.(LightningServer).UpdateChannelConstraints(ctx, req.(*UpdateChannelConstraintsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SubscribeInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(InvoiceSubscription)
+func _Lightning_SubscribeChannelEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChannelEventSubscription)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(LightningServer).SubscribeInvoices(m, &lightningSubscribeInvoicesServer{stream})
+	return srv.(LightningServer).SubscribeChannelEvents(m, &lightningSubscribeChannelEventsServer{stream})
 }
 
-type Lightning_SubscribeInvoicesServer interface {
-	Send(*Invoice) error
+type Lightning_SubscribeChannelEventsServer interface {
+	Send(*ChannelEventUpdate) error
 	grpc.ServerStream
 }
 
-type lightningSubscribeInvoicesServer struct {
+type lightningSubscribeChannelEventsServer struct {
 	grpc.ServerStream
 }
 
-func (x *lightningSubscribeInvoicesServer) Send(m *Invoice) error {
+func (x *lightningSubscribeChannelEventsServer) Send(m *ChannelEventUpdate) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Lightning_DecodePayReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PayReqString)
+func _Lightning_SubscribePeerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PeerEventSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribePeerEvents(m, &lightningSubscribePeerEventsServer{stream})
+}
+
+type Lightning_SubscribePeerEventsServer interface {
+	Send(*PeerEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribePeerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribePeerEventsServer) Send(m *PeerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_DeletePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DecodePayReq(ctx, in)
+		return srv.(LightningServer).DeletePayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DecodePayReq",
+		FullMethod: "/lnrpc.Lightning/DeletePayment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DecodePayReq(ctx, req.(*PayReqString))
+		return srv.(LightningServer).DeletePayment(ctx, req.(*DeletePaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPaymentsRequest)
+func _Lightning_ExportChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChannelBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListPayments(ctx, in)
+		return srv.(LightningServer).ExportChannelBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListPayments",
+		FullMethod: "/lnrpc.Lightning/ExportChannelBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+		return srv.(LightningServer).ExportChannelBackup(ctx, req.(*ExportChannelBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAllPaymentsRequest)
+func _Lightning_ExportAllChannelBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChanBackupExportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DeleteAllPayments(ctx, in)
+		return srv.(LightningServer).ExportAllChannelBackups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DeleteAllPayments",
+		FullMethod: "/lnrpc.Lightning/ExportAllChannelBackups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DeleteAllPayments(ctx, req.(*DeleteAllPaymentsRequest))
+		return srv.(LightningServer).ExportAllChannelBackups(ctx, req.(*ChanBackupExportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_DescribeGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelGraphRequest)
+func _Lightning_VerifyChanBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChanBackupSnapshot)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DescribeGraph(ctx, in)
+		return srv.(LightningServer).VerifyChanBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DescribeGraph",
+		FullMethod: "/lnrpc.Lightning/VerifyChanBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DescribeGraph(ctx, req.(*ChannelGraphRequest))
+		return srv.(LightningServer).VerifyChanBackup(ctx, req.(*ChanBackupSnapshot))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetChanInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChanInfoRequest)
+func _Lightning_RestoreChannelBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreChanBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetChanInfo(ctx, in)
+		return srv.(LightningServer).RestoreChannelBackups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetChanInfo",
+		FullMethod: "/lnrpc.Lightning/RestoreChannelBackups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetChanInfo(ctx, req.(*ChanInfoRequest))
+		return srv.(LightningServer).RestoreChannelBackups(ctx, req.(*RestoreChanBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeInfoRequest)
+func _Lightning_SubscribeChannelBackups_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChannelBackupSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeChannelBackups(m, &lightningSubscribeChannelBackupsServer{stream})
+}
+
+type Lightning_SubscribeChannelBackupsServer interface {
+	Send(*ChanBackupSnapshot) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeChannelBackupsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeChannelBackupsServer) Send(m *ChanBackupSnapshot) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeMacaroonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetNodeInfo(ctx, in)
+		return srv.(LightningServer).BakeMacaroon(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetNodeInfo",
+		FullMethod: "/lnrpc.Lightning/BakeMacaroon",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetNodeInfo(ctx, req.(*NodeInfoRequest))
+		return srv.(LightningServer).BakeMacaroon(ctx, req.(*BakeMacaroonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_QueryRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRoutesRequest)
+func _Lightning_ListMacaroonIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMacaroonIDsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).QueryRoutes(ctx, in)
+		return srv.(LightningServer).ListMacaroonIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/QueryRoutes",
+		FullMethod: "/lnrpc.Lightning/ListMacaroonIDs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).QueryRoutes(ctx, req.(*QueryRoutesRequest))
+		return srv.(LightningServer).ListMacaroonIDs(ctx, req.(*ListMacaroonIDsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetworkInfoRequest)
+func _Lightning_DeleteMacaroonID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMacaroonIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetNetworkInfo(ctx, in)
+		return srv.(LightningServer).DeleteMacaroonID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetNetworkInfo",
+		FullMethod: "/lnrpc.Lightning/DeleteMacaroonID",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetNetworkInfo(ctx, req.(*NetworkInfoRequest))
+		return srv.(LightningServer).DeleteMacaroonID(ctx, req.(*DeleteMacaroonIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopRequest)
+func _Lightning_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).StopDaemon(ctx, in)
+		return srv.(LightningServer).ListPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/StopDaemon",
+		FullMethod: "/lnrpc.Lightning/ListPermissions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).StopDaemon(ctx, req.(*StopRequest))
+		return srv.(LightningServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SubscribeChannelGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GraphTopologySubscription)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Lightning_CheckMacaroonPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMacPermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(LightningServer).SubscribeChannelGraph(m, &lightningSubscribeChannelGraphServer{stream})
+	if interceptor == nil {
+		return srv.(LightningServer).CheckMacaroonPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/CheckMacaroonPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).CheckMacaroonPermissions(ctx, req.(*CheckMacPermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Lightning_SubscribeChannelGraphServer interface {
-	Send(*GraphTopologyUpdate) error
+func _Lightning_RegisterRPCMiddleware_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).RegisterRPCMiddleware(&lightningRegisterRPCMiddlewareServer{stream})
+}
+
+type Lightning_RegisterRPCMiddlewareServer interface {
+	Send(*RPCMiddlewareRequest) error
+	Recv() (*RPCMiddlewareResponse, error)
 	grpc.ServerStream
 }
 
-type lightningSubscribeChannelGraphServer struct {
+type lightningRegisterRPCMiddlewareServer struct {
 	grpc.ServerStream
 }
 
-func (x *lightningSubscribeChannelGraphServer) Send(m *GraphTopologyUpdate) error {
+func (x *lightningRegisterRPCMiddlewareServer) Send(m *RPCMiddlewareRequest) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DebugLevelRequest)
+func (x *lightningRegisterRPCMiddlewareServer) Recv() (*RPCMiddlewareResponse, error) {
+	m := new(RPCMiddlewareResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SendCustomMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCustomMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DebugLevel(ctx, in)
+		return srv.(LightningServer).SendCustomMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DebugLevel",
+		FullMethod: "/lnrpc.Lightning/SendCustomMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+		return srv.(LightningServer).SendCustomMessage(ctx, req.(*SendCustomMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FeeReportRequest)
+func _Lightning_SubscribeCustomMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCustomMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeCustomMessages(m, &lightningSubscribeCustomMessagesServer{stream})
+}
+
+type Lightning_SubscribeCustomMessagesServer interface {
+	Send(*CustomMessage) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeCustomMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeCustomMessagesServer) Send(m *CustomMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_LookupHtlcResolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupHtlcResolutionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).FeeReport(ctx, in)
+		return srv.(LightningServer).LookupHtlcResolution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/FeeReport",
+		FullMethod: "/lnrpc.Lightning/LookupHtlcResolution",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).FeeReport(ctx, req.(*FeeReportRequest))
+		return srv.(LightningServer).LookupHtlcResolution(ctx, req.(*LookupHtlcResolutionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyUpdateRequest)
+func _Lightning_UpdateNodeAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeAnnouncementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).UpdateChannelPolicy(ctx, in)
+		return srv.(LightningServer).UpdateNodeAnnouncement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/UpdateChannelPolicy",
+		FullMethod: "/lnrpc.Lightning/UpdateNodeAnnouncement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).UpdateChannelPolicy(ctx, req.(*PolicyUpdateRequest))
+		return srv.(LightningServer).UpdateNodeAnnouncement(ctx, req.(*UpdateNodeAnnouncementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ForwardingHistoryRequest)
+func _Lightning_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ForwardingHistory(ctx, in)
+		return srv.(LightningServer).GetRecoveryInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ForwardingHistory",
+		FullMethod: "/lnrpc.Lightning/GetRecoveryInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ForwardingHistory(ctx, req.(*ForwardingHistoryRequest))
+		return srv.(LightningServer).GetRecoveryInfo(ctx, req.(*GetRecoveryInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7281,10 +12453,18 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendMany",
 			Handler:    _Lightning_SendMany_Handler,
 		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Lightning_EstimateFee_Handler,
+		},
 		{
 			MethodName: "NewAddress",
 			Handler:    _Lightning_NewAddress_Handler,
 		},
+		{
+			MethodName: "ListAddresses",
+			Handler:    _Lightning_ListAddresses_Handler,
+		},
 		{
 			MethodName: "SignMessage",
 			Handler:    _Lightning_SignMessage_Handler,
@@ -7325,6 +12505,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
 		},
+		{
+			MethodName: "BatchOpenChannel",
+			Handler:    _Lightning_BatchOpenChannel_Handler,
+		},
+		{
+			MethodName: "FundingStateStep",
+			Handler:    _Lightning_FundingStateStep_Handler,
+		},
 		{
 			MethodName: "AbandonChannel",
 			Handler:    _Lightning_AbandonChannel_Handler,
@@ -7341,6 +12529,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddInvoice",
 			Handler:    _Lightning_AddInvoice_Handler,
 		},
+		{
+			MethodName: "CreateOffer",
+			Handler:    _Lightning_CreateOffer_Handler,
+		},
+		{
+			MethodName: "PayOffer",
+			Handler:    _Lightning_PayOffer_Handler,
+		},
 		{
 			MethodName: "ListInvoices",
 			Handler:    _Lightning_ListInvoices_Handler,
@@ -7401,6 +12597,90 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
 		},
+		{
+			MethodName: "ListSweepableOutputs",
+			Handler:    _Lightning_ListSweepableOutputs_Handler,
+		},
+		{
+			MethodName: "SweepOutputs",
+			Handler:    _Lightning_SweepOutputs_Handler,
+		},
+		{
+			MethodName: "ArchiveClosedChannels",
+			Handler:    _Lightning_ArchiveClosedChannels_Handler,
+		},
+		{
+			MethodName: "CancelPayment",
+			Handler:    _Lightning_CancelPayment_Handler,
+		},
+		{
+			MethodName: "ExportChannelAudit",
+			Handler:    _Lightning_ExportChannelAudit_Handler,
+		},
+		{
+			MethodName: "FreezeChannel",
+			Handler:    _Lightning_FreezeChannel_Handler,
+		},
+		{
+			MethodName: "UpdateChannelConstraints",
+			Handler:    _Lightning_UpdateChannelConstraints_Handler,
+		},
+		{
+			MethodName: "DeletePayment",
+			Handler:    _Lightning_DeletePayment_Handler,
+		},
+		{
+			MethodName: "ExportChannelBackup",
+			Handler:    _Lightning_ExportChannelBackup_Handler,
+		},
+		{
+			MethodName: "ExportAllChannelBackups",
+			Handler:    _Lightning_ExportAllChannelBackups_Handler,
+		},
+		{
+			MethodName: "VerifyChanBackup",
+			Handler:    _Lightning_VerifyChanBackup_Handler,
+		},
+		{
+			MethodName: "RestoreChannelBackups",
+			Handler:    _Lightning_RestoreChannelBackups_Handler,
+		},
+		{
+			MethodName: "BakeMacaroon",
+			Handler:    _Lightning_BakeMacaroon_Handler,
+		},
+		{
+			MethodName: "ListMacaroonIDs",
+			Handler:    _Lightning_ListMacaroonIDs_Handler,
+		},
+		{
+			MethodName: "DeleteMacaroonID",
+			Handler:    _Lightning_DeleteMacaroonID_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _Lightning_ListPermissions_Handler,
+		},
+		{
+			MethodName: "CheckMacaroonPermissions",
+			Handler:    _Lightning_CheckMacaroonPermissions_Handler,
+		},
+		{
+			MethodName: "SendCustomMessage",
+			Handler:    _Lightning_SendCustomMessage_Handler,
+		},
+		{
+			MethodName: "LookupHtlcResolution",
+			Handler:    _Lightning_LookupHtlcResolution_Handler,
+		},
+		{
+			MethodName: "UpdateNodeAnnouncement",
+			Handler:    _Lightning_UpdateNodeAnnouncement_Handler,
+		},
+		{
+			MethodName: "GetRecoveryInfo",
+			Handler:    _Lightning_GetRecoveryInfo_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -7440,6 +12720,32 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeChannelGraph_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeChannelEvents",
+			Handler:       _Lightning_SubscribeChannelEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribePeerEvents",
+			Handler:       _Lightning_SubscribePeerEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeChannelBackups",
+			Handler:       _Lightning_SubscribeChannelBackups_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RegisterRPCMiddleware",
+			Handler:       _Lightning_RegisterRPCMiddleware_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeCustomMessages",
+			Handler:       _Lightning_SubscribeCustomMessages_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -7447,419 +12753,699 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 6620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x4b, 0x6c, 0x1c, 0xd9,
-	0x75, 0xb6, 0xaa, 0xd9, 0x24, 0xbb, 0x4f, 0x37, 0xc9, 0xe6, 0xe5, 0xab, 0xd5, 0xa3, 0xd1, 0x68,
-	0xca, 0x83, 0x91, 0xcc, 0x7f, 0x7e, 0x49, 0x23, 0xdb, 0x83, 0xf1, 0xcc, 0xff, 0xdb, 0x3f, 0x45,
-	0x52, 0xa2, 0x7e, 0x73, 0x24, 0xba, 0x28, 0x59, 0xb1, 0x9d, 0xa0, 0x5d, 0xec, 0xbe, 0x6c, 0x96,
-	0xd5, 0x5d, 0xd5, 0xae, 0xaa, 0x26, 0xd5, 0x9e, 0x08, 0xc8, 0x0b, 0x09, 0x10, 0xc4, 0x30, 0x82,
-	0x2c, 0x02, 0x07, 0x08, 0x02, 0x38, 0x59, 0xd8, 0xcb, 0x2c, 0x62, 0x04, 0x48, 0xb2, 0xcb, 0x26,
-	0x01, 0x82, 0x2c, 0xbc, 0x0a, 0x02, 0x64, 0x93, 0x6c, 0x92, 0x20, 0x59, 0x04, 0xc8, 0x32, 0x41,
-	0x70, 0xce, 0x7d, 0xd4, 0xbd, 0x55, 0x45, 0x51, 0x7e, 0x24, 0xbb, 0xbe, 0xdf, 0x3d, 0x75, 0x9f,
-	0xe7, 0x75, 0xcf, 0x3d, 0xb7, 0xa1, 0x1e, 0x8f, 0x7b, 0x37, 0xc7, 0x71, 0x94, 0x46, 0x6c, 0x76,
-	0x18, 0xc6, 0xe3, 0x5e, 0xe7, 0xca, 0x20, 0x8a, 0x06, 0x43, 0x7e, 0xcb, 0x1f, 0x07, 0xb7, 0xfc,
-	0x30, 0x8c, 0x52, 0x3f, 0x0d, 0xa2, 0x30, 0x11, 0x44, 0xee, 0xd7, 0x60, 0xf1, 0x3e, 0x0f, 0x0f,
-	0x39, 0xef, 0x7b, 0xfc, 0x1b, 0x13, 0x9e, 0xa4, 0xec, 0x7f, 0xc1, 0xb2, 0xcf, 0xbf, 0xc9, 0x79,
-	0xbf, 0x3b, 0xf6, 0x93, 0x64, 0x7c, 0x12, 0xfb, 0x09, 0x6f, 0x3b, 0xd7, 0x9c, 0x1b, 0x4d, 0xaf,
-	0x25, 0x2a, 0x0e, 0x34, 0xce, 0xde, 0x84, 0x66, 0x82, 0xa4, 0x3c, 0x4c, 0xe3, 0x68, 0x3c, 0x6d,
-	0x57, 0x88, 0xae, 0x81, 0xd8, 0xae, 0x80, 0xdc, 0x21, 0x2c, 0xe9, 0x1e, 0x92, 0x71, 0x14, 0x26,
-	0x9c, 0xdd, 0x86, 0xd5, 0x5e, 0x30, 0x3e, 0xe1, 0x71, 0x97, 0x3e, 0x1e, 0x85, 0x7c, 0x14, 0x85,
-	0x41, 0xaf, 0xed, 0x5c, 0x9b, 0xb9, 0x51, 0xf7, 0x98, 0xa8, 0xc3, 0x2f, 0x3e, 0x92, 0x35, 0xec,
-	0x3a, 0x2c, 0xf1, 0x50, 0xe0, 0xbc, 0x4f, 0x5f, 0xc9, 0xae, 0x16, 0x33, 0x18, 0x3f, 0x70, 0xff,
-	0xdc, 0x81, 0xe5, 0x07, 0x61, 0x90, 0x3e, 0xf5, 0x87, 0x43, 0x9e, 0xaa, 0x39, 0x5d, 0x87, 0xa5,
-	0x33, 0x02, 0x68, 0x4e, 0x67, 0x51, 0xdc, 0x97, 0x33, 0x5a, 0x14, 0xf0, 0x81, 0x44, 0xcf, 0x1d,
-	0x59, 0xe5, 0xdc, 0x91, 0x95, 0x2e, 0xd7, 0xcc, 0x39, 0xcb, 0x75, 0x1d, 0x96, 0x62, 0xde, 0x8b,
-	0x4e, 0x79, 0x3c, 0xed, 0x9e, 0x05, 0x61, 0x3f, 0x3a, 0x6b, 0x57, 0xaf, 0x39, 0x37, 0x66, 0xbd,
-	0x45, 0x05, 0x3f, 0x25, 0xd4, 0x5d, 0x05, 0x66, 0xce, 0x42, 0xac, 0x9b, 0x3b, 0x80, 0x95, 0x27,
-	0xe1, 0x30, 0xea, 0x3d, 0xfb, 0x31, 0x67, 0x57, 0xd2, 0x7d, 0xa5, 0xb4, 0xfb, 0x75, 0x58, 0xb5,
-	0x3b, 0x92, 0x03, 0xe0, 0xb0, 0xb6, 0x7d, 0xe2, 0x87, 0x03, 0xae, 0x9a, 0x54, 0x43, 0xf8, 0x24,
-	0xb4, 0x7a, 0x93, 0x38, 0xe6, 0x61, 0x61, 0x0c, 0x4b, 0x12, 0xd7, 0x83, 0x78, 0x13, 0x9a, 0x21,
-	0x3f, 0xcb, 0xc8, 0x24, 0xcb, 0x84, 0xfc, 0x4c, 0x91, 0xb8, 0x6d, 0x58, 0xcf, 0x77, 0x23, 0x07,
-	0xf0, 0x2f, 0x0e, 0x54, 0x9f, 0xa4, 0xcf, 0x23, 0x76, 0x13, 0xaa, 0xe9, 0x74, 0x2c, 0x18, 0x73,
-	0xf1, 0x0e, 0xbb, 0x49, 0xbc, 0x7e, 0x73, 0xab, 0xdf, 0x8f, 0x79, 0x92, 0x3c, 0x9e, 0x8e, 0xb9,
-	0xd7, 0xf4, 0x45, 0xa1, 0x8b, 0x74, 0xac, 0x0d, 0xf3, 0xb2, 0x4c, 0x1d, 0xd6, 0x3d, 0x55, 0x64,
-	0x57, 0x01, 0xfc, 0x51, 0x34, 0x09, 0xd3, 0x6e, 0xe2, 0xa7, 0xb4, 0x73, 0x33, 0x9e, 0x81, 0xb0,
-	0xb7, 0x60, 0x21, 0xe9, 0xc5, 0xc1, 0x38, 0xed, 0x8e, 0x27, 0x47, 0xcf, 0xf8, 0x94, 0x76, 0xac,
-	0xee, 0xd9, 0x20, 0xbb, 0x05, 0xb5, 0x68, 0x92, 0x8e, 0xa3, 0x20, 0x4c, 0xdb, 0xb3, 0xd7, 0x9c,
-	0x1b, 0x8d, 0x3b, 0x2b, 0x72, 0x4c, 0x38, 0x93, 0x90, 0x0f, 0x0f, 0xb0, 0xca, 0xd3, 0x44, 0xd8,
-	0x6c, 0x2f, 0x0a, 0x8f, 0x83, 0x78, 0x24, 0xe4, 0xb1, 0x3d, 0x47, 0x3d, 0xdb, 0xa0, 0xfb, 0x9d,
-	0x0a, 0x34, 0x1e, 0xc7, 0x7e, 0x98, 0xf8, 0x3d, 0x04, 0x70, 0x1a, 0xe9, 0xf3, 0xee, 0x89, 0x9f,
-	0x9c, 0xd0, 0xcc, 0xeb, 0x9e, 0x2a, 0xb2, 0x75, 0x98, 0x13, 0x83, 0xa6, 0xf9, 0xcd, 0x78, 0xb2,
-	0xc4, 0xde, 0x81, 0xe5, 0x70, 0x32, 0xea, 0xda, 0x7d, 0xcd, 0xd0, 0xae, 0x17, 0x2b, 0x70, 0x31,
-	0x8e, 0x70, 0xdf, 0x45, 0x17, 0x62, 0xa6, 0x06, 0xc2, 0x5c, 0x68, 0xca, 0x12, 0x0f, 0x06, 0x27,
-	0x62, 0xaa, 0xb3, 0x9e, 0x85, 0x61, 0x1b, 0x69, 0x30, 0xe2, 0xdd, 0x24, 0xf5, 0x47, 0x63, 0x39,
-	0x2d, 0x03, 0xa1, 0xfa, 0x28, 0xf5, 0x87, 0xdd, 0x63, 0xce, 0x93, 0xf6, 0xbc, 0xac, 0xd7, 0x08,
-	0x7b, 0x1b, 0x16, 0xfb, 0x3c, 0x49, 0xbb, 0x72, 0x83, 0x78, 0xd2, 0xae, 0x91, 0xf4, 0xe5, 0x50,
-	0xe4, 0x92, 0xfb, 0x3c, 0x35, 0x56, 0x27, 0x91, 0xdc, 0xe8, 0xee, 0x03, 0x33, 0xe0, 0x1d, 0x9e,
-	0xfa, 0xc1, 0x30, 0x61, 0xef, 0x41, 0x33, 0x35, 0x88, 0x49, 0xdb, 0x34, 0x34, 0xeb, 0x18, 0x1f,
-	0x78, 0x16, 0x9d, 0x7b, 0x1f, 0x6a, 0xf7, 0x38, 0xdf, 0x0f, 0x46, 0x41, 0xca, 0xd6, 0x61, 0xf6,
-	0x38, 0x78, 0xce, 0x05, 0x73, 0xcf, 0xec, 0x5d, 0xf2, 0x44, 0x91, 0x75, 0x60, 0x7e, 0xcc, 0xe3,
-	0x1e, 0x57, 0xcb, 0xbf, 0x77, 0xc9, 0x53, 0xc0, 0xdd, 0x79, 0x98, 0x1d, 0xe2, 0xc7, 0xee, 0xf7,
-	0x2a, 0xd0, 0x38, 0xe4, 0xa1, 0x16, 0x1a, 0x06, 0x55, 0x9c, 0x92, 0x14, 0x14, 0xfa, 0xcd, 0xde,
-	0x80, 0x06, 0x4d, 0x33, 0x49, 0xe3, 0x20, 0x1c, 0x48, 0x5e, 0x05, 0x84, 0x0e, 0x09, 0x61, 0x2d,
-	0x98, 0xf1, 0x47, 0x8a, 0x4f, 0xf1, 0x27, 0x0a, 0xd4, 0xd8, 0x9f, 0x8e, 0x50, 0xf6, 0xf4, 0xae,
-	0x35, 0xbd, 0x86, 0xc4, 0xf6, 0x70, 0xdb, 0x6e, 0xc2, 0x8a, 0x49, 0xa2, 0x5a, 0x9f, 0xa5, 0xd6,
-	0x97, 0x0d, 0x4a, 0xd9, 0xc9, 0x75, 0x58, 0x52, 0xf4, 0xb1, 0x18, 0x2c, 0xed, 0x63, 0xdd, 0x5b,
-	0x94, 0xb0, 0x9a, 0xc2, 0x0d, 0x68, 0x1d, 0x07, 0xa1, 0x3f, 0xec, 0xf6, 0x86, 0xe9, 0x69, 0xb7,
-	0xcf, 0x87, 0xa9, 0x4f, 0x3b, 0x3a, 0xeb, 0x2d, 0x12, 0xbe, 0x3d, 0x4c, 0x4f, 0x77, 0x10, 0x65,
-	0xef, 0x40, 0xfd, 0x98, 0xf3, 0x2e, 0xad, 0x44, 0xbb, 0x46, 0x12, 0xb2, 0x24, 0x97, 0x5e, 0xad,
-	0xae, 0x57, 0x3b, 0x96, 0xbf, 0xdc, 0x3f, 0x76, 0xa0, 0x29, 0x96, 0x4a, 0x9a, 0x8c, 0xb7, 0x60,
-	0x41, 0x8d, 0x88, 0xc7, 0x71, 0x14, 0x4b, 0xf6, 0xb7, 0x41, 0xb6, 0x09, 0x2d, 0x05, 0x8c, 0x63,
-	0x1e, 0x8c, 0xfc, 0x01, 0x97, 0xfa, 0xa5, 0x80, 0xb3, 0x3b, 0x59, 0x8b, 0x71, 0x34, 0x49, 0x85,
-	0xd2, 0x6e, 0xdc, 0x69, 0xca, 0x41, 0x79, 0x88, 0x79, 0x36, 0x09, 0xb2, 0x7f, 0xc9, 0x52, 0x5b,
-	0x98, 0xfb, 0x2d, 0x07, 0x18, 0x0e, 0xfd, 0x71, 0x24, 0x9a, 0x90, 0x2b, 0x95, 0xdf, 0x25, 0xe7,
-	0x95, 0x77, 0xa9, 0x72, 0xde, 0x2e, 0xbd, 0x05, 0x73, 0x34, 0x2c, 0x94, 0xe7, 0x99, 0xc2, 0xd0,
-	0x65, 0x9d, 0xfb, 0x5d, 0x07, 0x9a, 0xa6, 0x0e, 0x62, 0xb7, 0x81, 0x1d, 0x4f, 0xc2, 0x7e, 0x10,
-	0x0e, 0xba, 0xe9, 0xf3, 0xa0, 0xdf, 0x3d, 0x9a, 0x62, 0x13, 0x34, 0x9e, 0xbd, 0x4b, 0x5e, 0x49,
-	0x1d, 0x7b, 0x07, 0x5a, 0x16, 0x9a, 0xa4, 0xb1, 0x18, 0xd5, 0xde, 0x25, 0xaf, 0x50, 0x83, 0x8b,
-	0x84, 0x5a, 0x6e, 0x92, 0x76, 0x83, 0xb0, 0xcf, 0x9f, 0xd3, 0xba, 0x2e, 0x78, 0x16, 0x76, 0x77,
-	0x11, 0x9a, 0xe6, 0x77, 0xee, 0xe7, 0xa0, 0xb5, 0x8f, 0xca, 0x23, 0x0c, 0xc2, 0x81, 0x54, 0xe2,
-	0xa8, 0xd1, 0xa4, 0xc6, 0x15, 0x7b, 0x2d, 0x4b, 0x28, 0x36, 0x27, 0x51, 0x92, 0xca, 0x75, 0xa1,
-	0xdf, 0xee, 0xdf, 0x3b, 0xb0, 0x84, 0x8b, 0xfe, 0x91, 0x1f, 0x4e, 0xd5, 0x8a, 0xef, 0x43, 0x13,
-	0x9b, 0x7a, 0x1c, 0x6d, 0x09, 0xbd, 0x28, 0xe4, 0xfd, 0x86, 0x5c, 0xa4, 0x1c, 0xf5, 0x4d, 0x93,
-	0x14, 0x5d, 0x97, 0xa9, 0x67, 0x7d, 0x8d, 0x82, 0x99, 0xfa, 0xf1, 0x80, 0xa7, 0xa4, 0x31, 0xa5,
-	0x06, 0x05, 0x01, 0x6d, 0x47, 0xe1, 0x31, 0xbb, 0x06, 0xcd, 0xc4, 0x4f, 0xbb, 0x63, 0x1e, 0xd3,
-	0xaa, 0x91, 0x70, 0xcd, 0x78, 0x90, 0xf8, 0xe9, 0x01, 0x8f, 0xef, 0x4e, 0x53, 0xde, 0xf9, 0x3c,
-	0x2c, 0x17, 0x7a, 0x41, 0x79, 0xce, 0xa6, 0x88, 0x3f, 0xd9, 0x2a, 0xcc, 0x9e, 0xfa, 0xc3, 0x09,
-	0x97, 0x8a, 0x5c, 0x14, 0x3e, 0xa8, 0xbc, 0xef, 0xb8, 0x6f, 0x43, 0x2b, 0x1b, 0xb6, 0x14, 0x0c,
-	0x06, 0x55, 0x5c, 0x41, 0xd9, 0x00, 0xfd, 0x76, 0x7f, 0xd1, 0x11, 0x84, 0xdb, 0x51, 0xa0, 0x95,
-	0x22, 0x12, 0xa2, 0xee, 0x54, 0x84, 0xf8, 0xfb, 0x5c, 0xa3, 0xf1, 0x93, 0x4f, 0xd6, 0xbd, 0x0e,
-	0xcb, 0xc6, 0x10, 0x5e, 0x32, 0xd8, 0x87, 0xc0, 0xf6, 0x83, 0x24, 0x7d, 0x12, 0x26, 0x63, 0x43,
-	0xb1, 0xbc, 0x06, 0xf5, 0x51, 0x10, 0x52, 0xf7, 0x82, 0x37, 0x67, 0xbd, 0xda, 0x28, 0x08, 0xb1,
-	0xf3, 0x84, 0x2a, 0xfd, 0xe7, 0xb2, 0xb2, 0x22, 0x2b, 0xfd, 0xe7, 0x54, 0xe9, 0xbe, 0x0f, 0x2b,
-	0x56, 0x7b, 0xb2, 0xeb, 0x37, 0x61, 0x76, 0x92, 0x3e, 0x8f, 0x94, 0xda, 0x6f, 0x48, 0x36, 0x40,
-	0x67, 0xc2, 0x13, 0x35, 0xee, 0x87, 0xb0, 0xfc, 0x90, 0x9f, 0x49, 0xf6, 0x53, 0x03, 0x79, 0xfb,
-	0x42, 0x47, 0x83, 0xea, 0xdd, 0x9b, 0xc0, 0xcc, 0x8f, 0x65, 0xaf, 0x86, 0xdb, 0xe1, 0x58, 0x6e,
-	0x87, 0xfb, 0x36, 0xb0, 0xc3, 0x60, 0x10, 0x7e, 0xc4, 0x93, 0xc4, 0x1f, 0x68, 0x2d, 0xd1, 0x82,
-	0x99, 0x51, 0x32, 0x90, 0xca, 0x01, 0x7f, 0xba, 0x9f, 0x82, 0x15, 0x8b, 0x4e, 0x36, 0x7c, 0x05,
-	0xea, 0x49, 0x30, 0x08, 0xfd, 0x74, 0x12, 0x73, 0xd9, 0x74, 0x06, 0xb8, 0xf7, 0x60, 0xf5, 0x4b,
-	0x3c, 0x0e, 0x8e, 0xa7, 0x17, 0x35, 0x6f, 0xb7, 0x53, 0xc9, 0xb7, 0xb3, 0x0b, 0x6b, 0xb9, 0x76,
-	0x64, 0xf7, 0x82, 0x47, 0xe5, 0x4e, 0xd6, 0x3c, 0x51, 0x30, 0x24, 0xb6, 0x62, 0x4a, 0xac, 0xfb,
-	0x04, 0xd8, 0x76, 0x14, 0x86, 0xbc, 0x97, 0x1e, 0x70, 0x1e, 0x67, 0x07, 0x8d, 0x8c, 0x21, 0x1b,
-	0x77, 0x36, 0xe4, 0xca, 0xe6, 0xd5, 0x80, 0xe4, 0x54, 0x06, 0xd5, 0x31, 0x8f, 0x47, 0xd4, 0x70,
-	0xcd, 0xa3, 0xdf, 0xee, 0x1a, 0xac, 0x58, 0xcd, 0x4a, 0x1f, 0xf1, 0x5d, 0x58, 0xdb, 0x09, 0x92,
-	0x5e, 0xb1, 0xc3, 0x36, 0xcc, 0x8f, 0x27, 0x47, 0xdd, 0x4c, 0xdc, 0x54, 0x11, 0x5d, 0x89, 0xfc,
-	0x27, 0xb2, 0xb1, 0x5f, 0x75, 0xa0, 0xba, 0xf7, 0x78, 0x7f, 0x9b, 0x75, 0xa0, 0x16, 0x84, 0xbd,
-	0x68, 0x84, 0x1a, 0x59, 0x4c, 0x5a, 0x97, 0xcf, 0x15, 0xa3, 0x2b, 0x50, 0x27, 0x45, 0x8e, 0xde,
-	0x91, 0x3c, 0x13, 0x64, 0x00, 0x7a, 0x66, 0xfc, 0xf9, 0x38, 0x88, 0xc9, 0xf5, 0x52, 0x0e, 0x55,
-	0x95, 0x94, 0x65, 0xb1, 0xc2, 0xfd, 0xcf, 0x2a, 0xcc, 0x4b, 0x35, 0x4e, 0xfd, 0xf5, 0xd2, 0xe0,
-	0x94, 0xcb, 0x91, 0xc8, 0x12, 0x1a, 0xc9, 0x98, 0x8f, 0xa2, 0x94, 0x77, 0xad, 0x6d, 0xb0, 0x41,
-	0xf2, 0x3c, 0x45, 0x43, 0x5d, 0xe1, 0xaf, 0xce, 0x08, 0x2a, 0x0b, 0xc4, 0xc5, 0x42, 0xa0, 0x1b,
-	0xf4, 0x69, 0x4c, 0x55, 0x4f, 0x15, 0x71, 0x25, 0x7a, 0xfe, 0xd8, 0xef, 0x05, 0xe9, 0x54, 0xca,
-	0xbd, 0x2e, 0x63, 0xdb, 0xc3, 0xa8, 0xe7, 0x0f, 0xbb, 0x47, 0xfe, 0xd0, 0x0f, 0x7b, 0x5c, 0x79,
-	0xb5, 0x16, 0x88, 0x1e, 0x9e, 0x1c, 0x92, 0x22, 0x13, 0x5e, 0x60, 0x0e, 0x45, 0x4f, 0xb1, 0x17,
-	0x8d, 0x46, 0x41, 0x8a, 0x8e, 0x21, 0x39, 0x0d, 0x33, 0x9e, 0x81, 0x08, 0x1f, 0x9a, 0x4a, 0x67,
-	0x62, 0xf5, 0xea, 0xca, 0x87, 0x36, 0x40, 0x6c, 0x05, 0x3d, 0x0f, 0xd4, 0x55, 0xcf, 0xce, 0xda,
-	0x20, 0x5a, 0xc9, 0x10, 0xdc, 0x87, 0x49, 0x98, 0xf0, 0x34, 0x1d, 0xf2, 0xbe, 0x1e, 0x50, 0x83,
-	0xc8, 0x8a, 0x15, 0xec, 0x36, 0xac, 0x08, 0x5f, 0x35, 0xf1, 0xd3, 0x28, 0x39, 0x09, 0x92, 0x6e,
-	0x82, 0x5e, 0x5f, 0x93, 0xe8, 0xcb, 0xaa, 0xd8, 0xfb, 0xb0, 0x91, 0x83, 0x63, 0xde, 0xe3, 0xc1,
-	0x29, 0xef, 0xb7, 0x17, 0xe8, 0xab, 0xf3, 0xaa, 0xd9, 0x35, 0x68, 0xa0, 0x8b, 0x3e, 0x19, 0xf7,
-	0x7d, 0x34, 0xd1, 0x8b, 0xb4, 0x0f, 0x26, 0xc4, 0xde, 0x85, 0x85, 0x31, 0x17, 0x76, 0xf4, 0x24,
-	0x1d, 0xf6, 0x92, 0xf6, 0x92, 0xa5, 0xdd, 0x90, 0x73, 0x3d, 0x9b, 0x02, 0x99, 0xb2, 0x97, 0x90,
-	0xaf, 0xe6, 0x4f, 0xdb, 0x2d, 0x62, 0xb7, 0x0c, 0x20, 0x19, 0x89, 0x83, 0x53, 0x3f, 0xe5, 0xed,
-	0x65, 0xe2, 0x2d, 0x55, 0x74, 0x7f, 0xcf, 0x11, 0x8a, 0x55, 0x32, 0xa1, 0x56, 0x90, 0x6f, 0x40,
-	0x43, 0xb0, 0x5f, 0x37, 0x0a, 0x87, 0x53, 0xc9, 0x91, 0x20, 0xa0, 0x47, 0xe1, 0x70, 0xca, 0x3e,
-	0x01, 0x0b, 0x41, 0x68, 0x92, 0x08, 0x19, 0x6e, 0x2a, 0x90, 0x88, 0xde, 0x80, 0xc6, 0x78, 0x72,
-	0x34, 0x0c, 0x7a, 0x82, 0x64, 0x46, 0xb4, 0x22, 0x20, 0x22, 0x40, 0xff, 0x49, 0x8c, 0x44, 0x50,
-	0x54, 0x89, 0xa2, 0x21, 0x31, 0x24, 0x71, 0xef, 0xc2, 0xaa, 0x3d, 0x40, 0xa9, 0xac, 0x36, 0xa1,
-	0x26, 0x79, 0x3b, 0x69, 0x37, 0x68, 0x7d, 0x16, 0xed, 0xb3, 0x99, 0xa7, 0xeb, 0xdd, 0x1f, 0x54,
-	0x61, 0x45, 0xa2, 0xdb, 0xc3, 0x28, 0xe1, 0x87, 0x93, 0xd1, 0xc8, 0x8f, 0x4b, 0x84, 0xc6, 0xb9,
-	0x40, 0x68, 0x2a, 0xb6, 0xd0, 0x20, 0x2b, 0x9f, 0xf8, 0x41, 0x28, 0x9c, 0x3f, 0x21, 0x71, 0x06,
-	0xc2, 0x6e, 0xc0, 0x52, 0x6f, 0x18, 0x25, 0xc2, 0x21, 0x32, 0x4f, 0x5f, 0x79, 0xb8, 0x28, 0xe4,
-	0xb3, 0x65, 0x42, 0x6e, 0x0a, 0xe9, 0x5c, 0x4e, 0x48, 0x5d, 0x68, 0x62, 0xa3, 0x5c, 0xe9, 0x9c,
-	0x79, 0xe1, 0xa0, 0x99, 0x18, 0x8e, 0x27, 0x2f, 0x12, 0x42, 0xfe, 0x96, 0xca, 0x04, 0x02, 0x0f,
-	0x77, 0xa8, 0xd3, 0x0c, 0xea, 0xba, 0x14, 0x88, 0x62, 0x15, 0xbb, 0x07, 0x20, 0xfa, 0x22, 0xc3,
-	0x0a, 0x64, 0x58, 0xdf, 0xb6, 0x77, 0xc4, 0x5c, 0xfb, 0x9b, 0x58, 0x98, 0xc4, 0x9c, 0x8c, 0xad,
-	0xf1, 0xa5, 0xfb, 0xeb, 0x0e, 0x34, 0x8c, 0x3a, 0xb6, 0x06, 0xcb, 0xdb, 0x8f, 0x1e, 0x1d, 0xec,
-	0x7a, 0x5b, 0x8f, 0x1f, 0x7c, 0x69, 0xb7, 0xbb, 0xbd, 0xff, 0xe8, 0x70, 0xb7, 0x75, 0x09, 0xe1,
-	0xfd, 0x47, 0xdb, 0x5b, 0xfb, 0xdd, 0x7b, 0x8f, 0xbc, 0x6d, 0x05, 0x3b, 0x6c, 0x1d, 0x98, 0xb7,
-	0xfb, 0xd1, 0xa3, 0xc7, 0xbb, 0x16, 0x5e, 0x61, 0x2d, 0x68, 0xde, 0xf5, 0x76, 0xb7, 0xb6, 0xf7,
-	0x24, 0x32, 0xc3, 0x56, 0xa1, 0x75, 0xef, 0xc9, 0xc3, 0x9d, 0x07, 0x0f, 0xef, 0x77, 0xb7, 0xb7,
-	0x1e, 0x6e, 0xef, 0xee, 0xef, 0xee, 0xb4, 0xaa, 0x6c, 0x01, 0xea, 0x5b, 0x77, 0xb7, 0x1e, 0xee,
-	0x3c, 0x7a, 0xb8, 0xbb, 0xd3, 0x9a, 0x75, 0xff, 0xce, 0x81, 0x35, 0x1a, 0x75, 0x3f, 0x2f, 0x20,
-	0xd7, 0xa0, 0xd1, 0x8b, 0xa2, 0x31, 0x47, 0x7d, 0xae, 0x55, 0xb6, 0x09, 0x21, 0xf3, 0x0b, 0x05,
-	0x79, 0x1c, 0xc5, 0x3d, 0x2e, 0xe5, 0x03, 0x08, 0xba, 0x87, 0x08, 0x32, 0xbf, 0xdc, 0x5e, 0x41,
-	0x21, 0xc4, 0xa3, 0x21, 0x30, 0x41, 0xb2, 0x0e, 0x73, 0x47, 0x31, 0xf7, 0x7b, 0x27, 0x52, 0x32,
-	0x64, 0x89, 0x7d, 0x32, 0xf3, 0xdd, 0x7b, 0xb8, 0xfa, 0x43, 0xde, 0x27, 0x8e, 0xa9, 0x79, 0x4b,
-	0x12, 0xdf, 0x96, 0x30, 0x6a, 0x06, 0xff, 0xc8, 0x0f, 0xfb, 0x51, 0xc8, 0xfb, 0xc4, 0x34, 0x35,
-	0x2f, 0x03, 0xdc, 0x03, 0x58, 0xcf, 0xcf, 0x4f, 0xca, 0xd7, 0x7b, 0x86, 0x7c, 0x09, 0xef, 0xaa,
-	0x73, 0xfe, 0x6e, 0x1a, 0xb2, 0xf6, 0x4f, 0x0e, 0x54, 0xd1, 0xd8, 0x9e, 0x6f, 0x98, 0x4d, 0xff,
-	0x69, 0xa6, 0x10, 0xb6, 0xa1, 0xc3, 0x89, 0x50, 0xbf, 0xc2, 0x44, 0x19, 0x48, 0x56, 0x1f, 0xf3,
-	0xde, 0x29, 0xcd, 0x58, 0xd7, 0x23, 0x82, 0x02, 0x82, 0x1e, 0x2c, 0x7d, 0x2d, 0x05, 0x44, 0x95,
-	0x55, 0x1d, 0x7d, 0x39, 0x9f, 0xd5, 0xd1, 0x77, 0x6d, 0x98, 0x0f, 0xc2, 0xa3, 0x68, 0x12, 0xf6,
-	0x49, 0x20, 0x6a, 0x9e, 0x2a, 0xe2, 0xf2, 0x8d, 0x49, 0x50, 0x83, 0x91, 0x62, 0xff, 0x0c, 0x70,
-	0x19, 0x9e, 0x70, 0x12, 0x72, 0x2e, 0x74, 0x9c, 0xe2, 0x3d, 0x58, 0x36, 0xb0, 0xcc, 0x51, 0x1d,
-	0x23, 0x90, 0x73, 0x54, 0xc9, 0x2b, 0x11, 0x35, 0x6e, 0x0b, 0x16, 0xef, 0xf3, 0xf4, 0x41, 0x78,
-	0x1c, 0xa9, 0x96, 0xbe, 0x5d, 0x85, 0x25, 0x0d, 0xc9, 0x86, 0x6e, 0xc0, 0x52, 0xd0, 0xe7, 0x61,
-	0x1a, 0xa4, 0xd3, 0xae, 0x75, 0x90, 0xca, 0xc3, 0xe8, 0xcd, 0xf9, 0xc3, 0xc0, 0x57, 0xa1, 0x31,
-	0x51, 0x60, 0x77, 0x60, 0x15, 0x4d, 0x8d, 0xb2, 0x1e, 0x7a, 0x8b, 0xc5, 0x79, 0xae, 0xb4, 0x0e,
-	0x95, 0x01, 0xe2, 0x52, 0xdb, 0xeb, 0x4f, 0x84, 0x57, 0x53, 0x56, 0x85, 0xab, 0x26, 0x5a, 0xc2,
-	0x29, 0xcf, 0x0a, 0x73, 0xa4, 0x81, 0x42, 0xbc, 0x69, 0x4e, 0xa8, 0xaa, 0x7c, 0xbc, 0xc9, 0x88,
-	0x59, 0xd5, 0x0a, 0x31, 0x2b, 0x54, 0x65, 0xd3, 0xb0, 0xc7, 0xfb, 0xdd, 0x34, 0xea, 0x92, 0xca,
-	0xa5, 0xdd, 0xa9, 0x79, 0x79, 0x98, 0xa2, 0x6b, 0x3c, 0x49, 0x43, 0x9e, 0x92, 0x56, 0xaa, 0x79,
-	0xaa, 0x88, 0xd2, 0x45, 0x24, 0xc2, 0x80, 0xd4, 0x3d, 0x59, 0x42, 0xb7, 0x74, 0x12, 0x07, 0x49,
-	0xbb, 0x49, 0x28, 0xfd, 0x66, 0x9f, 0x86, 0xb5, 0x23, 0x9e, 0xa4, 0xdd, 0x13, 0xee, 0xf7, 0x79,
-	0x4c, 0xbb, 0x2f, 0x42, 0x61, 0xc2, 0xda, 0x97, 0x57, 0x62, 0xdf, 0xa7, 0x3c, 0x4e, 0x82, 0x28,
-	0x24, 0x3b, 0x5f, 0xf7, 0x54, 0x11, 0xdb, 0xc3, 0x05, 0xd1, 0x36, 0x54, 0xaf, 0xea, 0x12, 0x2d,
-	0x46, 0x79, 0xa5, 0xfb, 0x4d, 0xf2, 0xb9, 0x75, 0x68, 0xef, 0x09, 0x39, 0x0c, 0x78, 0x72, 0x12,
-	0x2b, 0x93, 0x9c, 0xf8, 0xf2, 0x18, 0x50, 0x23, 0xe0, 0xf0, 0xc4, 0x47, 0x2d, 0x63, 0x2d, 0xb6,
-	0x38, 0x59, 0x35, 0x08, 0xdb, 0x13, 0x6b, 0xfd, 0x16, 0x2c, 0xaa, 0xa0, 0x61, 0xd2, 0x1d, 0xf2,
-	0xe3, 0x54, 0x9d, 0xee, 0xc3, 0xc9, 0x88, 0x8e, 0x5f, 0xfb, 0xfc, 0x38, 0x75, 0x1f, 0xc2, 0xb2,
-	0x94, 0xfc, 0x47, 0x63, 0xae, 0xba, 0xfe, 0x6c, 0x99, 0x05, 0x3d, 0x27, 0x4c, 0x6a, 0x53, 0xba,
-	0x1e, 0x30, 0x53, 0x93, 0xc8, 0x06, 0xa5, 0x19, 0x53, 0x31, 0x04, 0x39, 0x1d, 0x0b, 0xc3, 0x55,
-	0x4d, 0x26, 0xbd, 0x9e, 0x0a, 0xfb, 0xd6, 0x3c, 0x55, 0x74, 0xbf, 0xe7, 0xc0, 0x0a, 0xb5, 0xa6,
-	0x7c, 0x00, 0xa9, 0xad, 0xdf, 0xff, 0x11, 0x86, 0xd9, 0xec, 0x99, 0x71, 0x95, 0x55, 0x98, 0x35,
-	0xf5, 0xb7, 0x28, 0xfc, 0xe8, 0x47, 0xe9, 0x6a, 0xe1, 0x28, 0xfd, 0x37, 0x0e, 0x2c, 0x0b, 0x15,
-	0x9a, 0xfa, 0xe9, 0x24, 0x91, 0xd3, 0xff, 0x3f, 0xb0, 0x20, 0x6c, 0xa1, 0x14, 0x42, 0x39, 0xd0,
-	0x55, 0xad, 0x2f, 0x08, 0x15, 0xc4, 0x7b, 0x97, 0x3c, 0x9b, 0x98, 0x7d, 0x1e, 0x9a, 0x66, 0xe4,
-	0x97, 0xc6, 0xdc, 0xb8, 0x73, 0x59, 0xcd, 0xb2, 0xc0, 0x39, 0x7b, 0x97, 0x3c, 0xeb, 0x03, 0xf6,
-	0x21, 0x39, 0x34, 0x61, 0x97, 0x9a, 0x95, 0xb1, 0xb3, 0xcb, 0x25, 0x6a, 0x5f, 0x7f, 0x6e, 0x90,
-	0xdf, 0xad, 0xc1, 0x9c, 0xf0, 0x60, 0xdd, 0xfb, 0xb0, 0x60, 0x8d, 0xd4, 0x0a, 0x11, 0x34, 0x45,
-	0x88, 0xa0, 0x10, 0x51, 0xaa, 0x14, 0x23, 0x4a, 0xee, 0x1f, 0xce, 0x00, 0x43, 0x6e, 0xcb, 0x6d,
-	0x27, 0xba, 0xd0, 0x51, 0xdf, 0x3a, 0x10, 0x35, 0x3d, 0x13, 0x62, 0x37, 0x81, 0x19, 0x45, 0x15,
-	0x74, 0x13, 0xd6, 0xa6, 0xa4, 0x06, 0xd5, 0xa2, 0x34, 0xd6, 0xd2, 0xac, 0xca, 0xa3, 0x9f, 0xd8,
-	0xb7, 0xd2, 0x3a, 0x34, 0x28, 0xe3, 0x49, 0x72, 0x42, 0x37, 0x0c, 0xf2, 0xc8, 0xa4, 0xca, 0x79,
-	0x06, 0x99, 0xbb, 0x90, 0x41, 0xe6, 0xf3, 0x0c, 0x62, 0x3a, 0xed, 0x35, 0xcb, 0x69, 0x47, 0x67,
-	0x71, 0x84, 0x2e, 0x66, 0x3a, 0xec, 0x75, 0x47, 0xd8, 0xbb, 0x3c, 0x21, 0x59, 0x20, 0xdb, 0x84,
-	0x96, 0x74, 0x2f, 0xb2, 0x93, 0x01, 0xd0, 0x1a, 0x17, 0x70, 0xd4, 0xd7, 0x59, 0x60, 0xa6, 0x41,
-	0x83, 0xcd, 0x00, 0x3c, 0x4b, 0x25, 0xc8, 0x62, 0xdd, 0x49, 0x28, 0xb9, 0x85, 0xf7, 0xe9, 0x6c,
-	0x54, 0xf3, 0x8a, 0x15, 0xee, 0x0f, 0x1d, 0x68, 0xe1, 0x9e, 0x59, 0x7c, 0xfd, 0x01, 0x90, 0x58,
-	0xbd, 0x22, 0x5b, 0x5b, 0xb4, 0x3f, 0x39, 0x57, 0xbf, 0x0f, 0x75, 0x6a, 0x30, 0x1a, 0xf3, 0x50,
-	0x32, 0x75, 0xdb, 0x66, 0xea, 0x4c, 0xa3, 0xed, 0x5d, 0xf2, 0x32, 0x62, 0x83, 0xa5, 0xff, 0xda,
-	0x81, 0x86, 0x1c, 0xe6, 0x8f, 0x1d, 0x39, 0xe8, 0x18, 0xd7, 0x49, 0x82, 0x15, 0xb3, 0x9b, 0xa3,
-	0x1b, 0xb0, 0x34, 0xf2, 0xd3, 0x49, 0x8c, 0x06, 0xdc, 0x8a, 0x1a, 0xe4, 0x61, 0xb4, 0xc6, 0xa4,
-	0xbc, 0x93, 0x6e, 0x1a, 0x0c, 0xbb, 0xaa, 0x56, 0x5e, 0xda, 0x94, 0x55, 0xa1, 0x0e, 0x4b, 0x52,
-	0x7f, 0xc0, 0xa5, 0xa1, 0x15, 0x05, 0xb7, 0x0d, 0xeb, 0x72, 0x42, 0x39, 0xdf, 0xd6, 0xfd, 0xb3,
-	0x26, 0x6c, 0x14, 0xaa, 0xf4, 0x2d, 0xaf, 0x3c, 0x0e, 0x0f, 0x83, 0xd1, 0x51, 0xa4, 0x0f, 0x06,
-	0x8e, 0x79, 0x52, 0xb6, 0xaa, 0xd8, 0x00, 0xd6, 0x94, 0x47, 0x81, 0x6b, 0x9a, 0x59, 0xba, 0x0a,
-	0xb9, 0x42, 0xef, 0xda, 0x3c, 0x90, 0xef, 0x50, 0xe1, 0xa6, 0x16, 0x28, 0x6f, 0x8f, 0x9d, 0x40,
-	0x5b, 0xbb, 0x2e, 0xd2, 0x5c, 0x18, 0xee, 0x0d, 0xf6, 0xf5, 0xce, 0x05, 0x7d, 0x59, 0xae, 0xb0,
-	0x77, 0x6e, 0x6b, 0x6c, 0x0a, 0x57, 0x55, 0x1d, 0xd9, 0x83, 0x62, 0x7f, 0xd5, 0x57, 0x9a, 0x1b,
-	0x39, 0xf9, 0x76, 0xa7, 0x17, 0x34, 0xcc, 0xbe, 0x0e, 0xeb, 0x67, 0x7e, 0x90, 0xaa, 0x61, 0x19,
-	0x8e, 0xc3, 0x2c, 0x75, 0x79, 0xe7, 0x82, 0x2e, 0x9f, 0x8a, 0x8f, 0x2d, 0x23, 0x79, 0x4e, 0x8b,
-	0x9d, 0xbf, 0x74, 0x60, 0xd1, 0x6e, 0x07, 0xd9, 0x54, 0x2a, 0x0f, 0xa5, 0x44, 0x95, 0xfb, 0x99,
-	0x83, 0x8b, 0x67, 0xeb, 0x4a, 0xd9, 0xd9, 0xda, 0x3c, 0xd1, 0xce, 0x5c, 0x14, 0x76, 0xaa, 0xbe,
-	0x5a, 0xd8, 0x69, 0xb6, 0x2c, 0xec, 0xd4, 0xf9, 0x77, 0x07, 0x58, 0x91, 0x97, 0xd8, 0x7d, 0x71,
-	0xb8, 0x0f, 0xf9, 0x50, 0xea, 0xa4, 0xff, 0xfd, 0x6a, 0xfc, 0xa8, 0xd6, 0x4e, 0x7d, 0x8d, 0x82,
-	0x61, 0x2a, 0x1d, 0xd3, 0xdd, 0x5a, 0xf0, 0xca, 0xaa, 0x72, 0x81, 0xb0, 0xea, 0xc5, 0x81, 0xb0,
-	0xd9, 0x8b, 0x03, 0x61, 0x73, 0xf9, 0x40, 0x58, 0xe7, 0x57, 0x1c, 0x58, 0x29, 0xd9, 0xf4, 0x9f,
-	0xde, 0xc4, 0x71, 0x9b, 0x2c, 0x5d, 0x50, 0x91, 0xdb, 0x64, 0x82, 0x9d, 0x9f, 0x87, 0x05, 0x8b,
-	0xd1, 0x7f, 0x7a, 0xfd, 0xe7, 0x3d, 0x46, 0xc1, 0x67, 0x16, 0xd6, 0xf9, 0xe7, 0x0a, 0xb0, 0xa2,
-	0xb0, 0xfd, 0x8f, 0x8e, 0xa1, 0xb8, 0x4e, 0x33, 0x25, 0xeb, 0xf4, 0xdf, 0x6a, 0x07, 0xde, 0x81,
-	0x65, 0x99, 0x12, 0x62, 0x84, 0x74, 0x04, 0xc7, 0x14, 0x2b, 0xd0, 0x67, 0xb6, 0xa3, 0x90, 0x35,
-	0xeb, 0x6a, 0xdd, 0x30, 0x86, 0xb9, 0x60, 0xa4, 0xbb, 0x0e, 0xab, 0x22, 0xc5, 0xe4, 0xae, 0x68,
-	0x4a, 0xd9, 0x95, 0xdf, 0x75, 0x60, 0x2d, 0x57, 0x91, 0x5d, 0x04, 0x0b, 0xd3, 0x61, 0xdb, 0x13,
-	0x1b, 0xc4, 0xf1, 0x6b, 0x37, 0x23, 0xc7, 0x6d, 0xc5, 0x0a, 0x5c, 0x1f, 0xc3, 0x2d, 0xc9, 0xad,
-	0x7a, 0x59, 0x95, 0xbb, 0x21, 0x12, 0x61, 0x42, 0x3e, 0xcc, 0x0d, 0xfc, 0x58, 0xa4, 0xae, 0x98,
-	0x15, 0xd9, 0x55, 0x90, 0x3d, 0x64, 0x55, 0x44, 0x8f, 0xd2, 0x32, 0x53, 0xf6, 0x78, 0x4b, 0xeb,
-	0xdc, 0x1f, 0x38, 0xc0, 0xbe, 0x38, 0xe1, 0xf1, 0x94, 0x2e, 0x7b, 0x75, 0xac, 0x69, 0x23, 0x1f,
-	0x49, 0x99, 0x1b, 0x4f, 0x8e, 0xbe, 0xc0, 0xa7, 0x2a, 0x6d, 0xa0, 0x92, 0xa5, 0x0d, 0xbc, 0x0e,
-	0x80, 0x47, 0x39, 0x7d, 0x83, 0x4c, 0x9e, 0x5c, 0x38, 0x19, 0x89, 0x06, 0x4b, 0x6f, 0xf6, 0xab,
-	0x17, 0xdf, 0xec, 0xcf, 0x5e, 0x74, 0xb3, 0xff, 0x21, 0xac, 0x58, 0xe3, 0xd6, 0xdb, 0xaa, 0xee,
-	0xb2, 0x9d, 0x97, 0xdc, 0x65, 0xff, 0x5a, 0x05, 0x66, 0xf6, 0xa2, 0xb1, 0x19, 0x67, 0x75, 0xec,
-	0x38, 0xab, 0xb4, 0x25, 0x5d, 0x6d, 0x2a, 0xa4, 0x8a, 0xb1, 0x40, 0xb6, 0x09, 0x8b, 0xfe, 0x28,
-	0xc5, 0x83, 0xff, 0x71, 0x14, 0x9f, 0xf9, 0x71, 0x5f, 0xec, 0xf5, 0xdd, 0x4a, 0xdb, 0xf1, 0x72,
-	0x35, 0x6c, 0x15, 0x66, 0xb4, 0xd2, 0x25, 0x02, 0x2c, 0xa2, 0xe3, 0x46, 0x77, 0x34, 0x53, 0x19,
-	0xb3, 0x90, 0x25, 0x64, 0x25, 0xfb, 0x7b, 0xe1, 0x76, 0x0b, 0xd1, 0x29, 0xab, 0x42, 0xbb, 0x86,
-	0xcb, 0x47, 0x64, 0x32, 0xd8, 0xa4, 0xca, 0x66, 0x60, 0xac, 0x66, 0xdf, 0x58, 0xfd, 0xa3, 0x03,
-	0xb3, 0xb4, 0x36, 0xa8, 0x06, 0x04, 0xef, 0xeb, 0x50, 0x2b, 0xad, 0xc9, 0x82, 0x97, 0x87, 0x99,
-	0x6b, 0x25, 0xde, 0x54, 0xf4, 0x84, 0xcc, 0xe4, 0x9b, 0x6b, 0x50, 0x17, 0x25, 0x9d, 0x64, 0x42,
-	0x24, 0x19, 0xc8, 0xae, 0x42, 0xf5, 0x24, 0x1a, 0x2b, 0xbf, 0x05, 0xd4, 0x4d, 0x43, 0x34, 0xf6,
-	0x08, 0xcf, 0xc6, 0x83, 0xed, 0x89, 0x69, 0x09, 0x6b, 0x94, 0x87, 0xd1, 0x1e, 0xeb, 0x66, 0xcd,
-	0x65, 0xca, 0xa1, 0xee, 0x26, 0x2c, 0x3d, 0x8c, 0xfa, 0xdc, 0x88, 0x77, 0x9d, 0xcb, 0xe7, 0xee,
-	0x2f, 0x38, 0x50, 0x53, 0xc4, 0xec, 0x06, 0x54, 0xd1, 0xc9, 0xc8, 0x1d, 0x21, 0xf4, 0x0d, 0x23,
-	0xd2, 0x79, 0x44, 0x81, 0x5a, 0x99, 0xe2, 0x1a, 0x99, 0xc3, 0xa9, 0xa2, 0x1a, 0x99, 0x3f, 0xa5,
-	0x87, 0x9b, 0x73, 0x43, 0x72, 0xa8, 0xfb, 0x7d, 0x07, 0x16, 0xac, 0x3e, 0xf0, 0x10, 0x3a, 0xf4,
-	0x93, 0x54, 0xde, 0xda, 0xc8, 0xed, 0x31, 0x21, 0x73, 0xa3, 0x2b, 0x76, 0x04, 0x54, 0xc7, 0xe6,
-	0x66, 0xcc, 0xd8, 0xdc, 0x6d, 0xa8, 0x67, 0xe9, 0x51, 0x55, 0x4b, 0xdb, 0x62, 0x8f, 0xea, 0xee,
-	0x34, 0x23, 0xc2, 0x76, 0x7a, 0xd1, 0x30, 0x8a, 0xe5, 0x75, 0x81, 0x28, 0xb8, 0x1f, 0x42, 0xc3,
-	0xa0, 0xc7, 0x61, 0x84, 0x3c, 0x3d, 0x8b, 0xe2, 0x67, 0x2a, 0x10, 0x2b, 0x8b, 0x3a, 0x7b, 0xa0,
-	0x92, 0x65, 0x0f, 0xb8, 0x7f, 0xe1, 0xc0, 0x02, 0xf2, 0x60, 0x10, 0x0e, 0x0e, 0xa2, 0x61, 0xd0,
-	0x9b, 0xd2, 0xde, 0x2b, 0x76, 0x93, 0x3a, 0x43, 0xf1, 0xa2, 0x0d, 0x23, 0xd7, 0xab, 0x33, 0xa8,
-	0x14, 0x51, 0x5d, 0x46, 0x19, 0x46, 0x09, 0x38, 0xf2, 0x13, 0x29, 0x16, 0xd2, 0xfc, 0x59, 0x20,
-	0x4a, 0x1a, 0x02, 0xb1, 0x9f, 0xf2, 0xee, 0x28, 0x18, 0x0e, 0x03, 0x41, 0x2b, 0x9c, 0xa3, 0xb2,
-	0x2a, 0xec, 0xb3, 0x1f, 0x24, 0xfe, 0x51, 0x16, 0x02, 0xd7, 0x65, 0xf7, 0x4f, 0x2a, 0xd0, 0x90,
-	0x8a, 0x7b, 0xb7, 0x3f, 0xe0, 0xf2, 0xbe, 0x86, 0xdc, 0x4f, 0xad, 0x64, 0x0c, 0x44, 0xd5, 0x5b,
-	0x0e, 0xab, 0x81, 0xe4, 0xb7, 0x7c, 0xa6, 0xb8, 0xe5, 0x57, 0xa0, 0x8e, 0xac, 0xf7, 0x2e, 0x79,
-	0xc6, 0xe2, 0xae, 0x27, 0x03, 0x54, 0xed, 0x1d, 0xaa, 0x9d, 0xcd, 0x6a, 0x09, 0x78, 0xe9, 0xed,
-	0xce, 0xfb, 0xd0, 0x94, 0xcd, 0xd0, 0x9e, 0x90, 0x4e, 0xc9, 0x98, 0xdf, 0xda, 0x2f, 0xcf, 0xa2,
-	0x54, 0x5f, 0xde, 0x51, 0x5f, 0xd6, 0x2e, 0xfa, 0x52, 0x51, 0xba, 0xf7, 0xf5, 0xa5, 0xd9, 0xfd,
-	0xd8, 0x1f, 0x9f, 0x28, 0x29, 0xbd, 0x0d, 0x2b, 0x41, 0xd8, 0x1b, 0x4e, 0xfa, 0xbc, 0x3b, 0x09,
-	0xfd, 0x30, 0x8c, 0x26, 0x61, 0x8f, 0xab, 0x9c, 0x81, 0xb2, 0x2a, 0xb7, 0xaf, 0x73, 0x95, 0xa8,
-	0x21, 0xb6, 0x09, 0xb3, 0xd8, 0x91, 0xb2, 0x0a, 0xe5, 0x22, 0x2c, 0x48, 0xd8, 0x0d, 0x98, 0xe5,
-	0xfd, 0x01, 0x57, 0xa7, 0x45, 0x66, 0x9f, 0xdb, 0x71, 0x57, 0x3d, 0x41, 0x80, 0x0a, 0x05, 0xd1,
-	0x9c, 0x42, 0xb1, 0x2d, 0xca, 0x1c, 0x16, 0x1f, 0xf4, 0xdd, 0x55, 0x60, 0x0f, 0x85, 0x0c, 0x98,
-	0xf1, 0xf6, 0x5f, 0x9e, 0x81, 0x86, 0x01, 0xa3, 0x6e, 0x18, 0xe0, 0x80, 0xbb, 0xfd, 0xc0, 0x1f,
-	0xf1, 0x94, 0xc7, 0x92, 0xef, 0x73, 0x28, 0xd2, 0xf9, 0xa7, 0x83, 0x6e, 0x34, 0x49, 0xbb, 0x7d,
-	0x3e, 0x88, 0xb9, 0x30, 0xf2, 0x68, 0x74, 0x2c, 0x14, 0xe9, 0x46, 0xfe, 0x73, 0x93, 0x4e, 0x70,
-	0x50, 0x0e, 0x55, 0xd1, 0x73, 0xb1, 0x46, 0xd5, 0x2c, 0x7a, 0x2e, 0x56, 0x24, 0xaf, 0xd5, 0x66,
-	0x4b, 0xb4, 0xda, 0x7b, 0xb0, 0x2e, 0xf4, 0x97, 0x94, 0xf4, 0x6e, 0x8e, 0xb1, 0xce, 0xa9, 0x65,
-	0x9b, 0xd0, 0xc2, 0x31, 0x2b, 0x91, 0x48, 0x82, 0x6f, 0x8a, 0xc8, 0x94, 0xe3, 0x15, 0x70, 0xa4,
-	0xa5, 0x10, 0x91, 0x49, 0x2b, 0x6e, 0x13, 0x0b, 0x38, 0xd1, 0xfa, 0xcf, 0x6d, 0xda, 0xba, 0xa4,
-	0xcd, 0xe1, 0xee, 0x02, 0x34, 0x0e, 0xd3, 0x68, 0xac, 0x36, 0x65, 0x11, 0x9a, 0xa2, 0x28, 0x73,
-	0x37, 0x5e, 0x83, 0xcb, 0xc4, 0x45, 0x8f, 0xa3, 0x71, 0x34, 0x8c, 0x06, 0xd3, 0xc3, 0xc9, 0x91,
-	0x48, 0xda, 0x0d, 0xa2, 0xd0, 0xfd, 0x2b, 0x07, 0x56, 0xac, 0x5a, 0x19, 0x7e, 0xfa, 0xb4, 0x10,
-	0x02, 0x7d, 0xe9, 0x2e, 0x18, 0x6f, 0xd9, 0x50, 0xae, 0x82, 0x50, 0x04, 0x11, 0x9f, 0xc8, 0x7b,
-	0xf8, 0x2d, 0x58, 0x52, 0x23, 0x53, 0x1f, 0x0a, 0x2e, 0x6c, 0x17, 0xb9, 0x50, 0x7e, 0xbf, 0x28,
-	0x3f, 0x50, 0x4d, 0xfc, 0x5f, 0x79, 0x2b, 0xdb, 0xa7, 0x39, 0xaa, 0x38, 0x84, 0xbe, 0x49, 0x33,
-	0x4f, 0x23, 0x6a, 0x04, 0x3d, 0x0d, 0x26, 0xee, 0x6f, 0x38, 0x00, 0xd9, 0xe8, 0xe8, 0x2e, 0x4f,
-	0x1b, 0x08, 0x91, 0x57, 0x6f, 0x18, 0x83, 0x37, 0xa1, 0xa9, 0xef, 0x80, 0x32, 0x9b, 0xd3, 0x50,
-	0x18, 0x3a, 0x8c, 0xd7, 0x61, 0x69, 0x30, 0x8c, 0x8e, 0xc8, 0x60, 0x53, 0x32, 0x50, 0x22, 0x33,
-	0x58, 0x16, 0x05, 0x7c, 0x4f, 0xa2, 0x99, 0x81, 0xaa, 0x1a, 0x06, 0xca, 0xfd, 0x56, 0x45, 0xdf,
-	0x01, 0x64, 0x73, 0x3e, 0x57, 0xca, 0xd8, 0x9d, 0x82, 0x3a, 0x3d, 0x27, 0xe4, 0x4e, 0x11, 0xb7,
-	0x83, 0x0b, 0x03, 0x02, 0x1f, 0xc2, 0x62, 0x2c, 0xf4, 0x95, 0x52, 0x66, 0xd5, 0x97, 0x28, 0xb3,
-	0x85, 0xd8, 0xb2, 0x62, 0x9f, 0x84, 0x96, 0xdf, 0x3f, 0xe5, 0x71, 0x1a, 0xd0, 0x91, 0x8c, 0x5c,
-	0x08, 0xa1, 0x82, 0x97, 0x0c, 0x9c, 0x2c, 0xfb, 0x75, 0x58, 0x92, 0x59, 0x43, 0x9a, 0x52, 0x26,
-	0xca, 0x66, 0x30, 0x12, 0xba, 0xbf, 0xaf, 0xae, 0x1b, 0xec, 0x3d, 0x3c, 0x7f, 0x45, 0xcc, 0xd9,
-	0x55, 0x72, 0xb3, 0xfb, 0x84, 0x0c, 0xfd, 0xf7, 0xd5, 0xb9, 0x6f, 0xc6, 0xb8, 0xc1, 0xef, 0xcb,
-	0xab, 0x1a, 0x7b, 0x49, 0xab, 0xaf, 0xb2, 0xa4, 0xee, 0x0f, 0x1d, 0x98, 0xdf, 0x8b, 0xc6, 0x7b,
-	0x32, 0x97, 0x81, 0x04, 0x41, 0xa7, 0xeb, 0xa9, 0xe2, 0x4b, 0xb2, 0x1c, 0x4a, 0x2d, 0xf7, 0x42,
-	0xde, 0x72, 0xff, 0x3f, 0x78, 0x8d, 0xa2, 0x0e, 0x71, 0x34, 0x8e, 0x62, 0x14, 0x46, 0x7f, 0x28,
-	0xcc, 0x74, 0x14, 0xa6, 0x27, 0x4a, 0x8d, 0xbd, 0x8c, 0x84, 0x8e, 0x77, 0x78, 0x2c, 0x11, 0x4e,
-	0xb7, 0xf4, 0x34, 0x84, 0x76, 0x2b, 0x56, 0xb8, 0x9f, 0x85, 0x3a, 0xb9, 0xca, 0x34, 0xad, 0x77,
-	0xa0, 0x7e, 0x12, 0x8d, 0xbb, 0x27, 0x41, 0x98, 0x2a, 0xe1, 0x5e, 0xcc, 0x7c, 0xd8, 0x3d, 0x5a,
-	0x10, 0x4d, 0xe0, 0xfe, 0xf6, 0x2c, 0xcc, 0x3f, 0x08, 0x4f, 0xa3, 0xa0, 0x47, 0x37, 0x13, 0x23,
-	0x3e, 0x8a, 0x54, 0xf2, 0x22, 0xfe, 0xc6, 0xa5, 0xa0, 0x6c, 0x9d, 0x71, 0x2a, 0xaf, 0x16, 0x54,
-	0x11, 0x1d, 0x84, 0x38, 0x4b, 0x42, 0x16, 0xa2, 0x63, 0x20, 0x78, 0x80, 0x88, 0xcd, 0x24, 0x62,
-	0x59, 0xca, 0xb2, 0x3f, 0x67, 0x8d, 0xec, 0x4f, 0xba, 0xc7, 0x12, 0x79, 0x17, 0xf2, 0x62, 0x5e,
-	0x15, 0xe9, 0xc0, 0x13, 0x73, 0x11, 0x2d, 0x22, 0x57, 0x63, 0x5e, 0x1e, 0x78, 0x4c, 0x10, 0xdd,
-	0x11, 0xf1, 0x81, 0xa0, 0x11, 0xca, 0xd7, 0x84, 0xd0, 0x75, 0xcb, 0xa7, 0x7c, 0xd7, 0x05, 0xcf,
-	0xe7, 0x60, 0xd4, 0xd0, 0x7d, 0xae, 0x15, 0xa9, 0x98, 0x03, 0x88, 0x24, 0xeb, 0x3c, 0x6e, 0x1c,
-	0x93, 0x44, 0x42, 0x95, 0x3a, 0x26, 0x21, 0xa3, 0xf8, 0xc3, 0xe1, 0x91, 0xdf, 0x7b, 0x46, 0x19,
-	0xfd, 0x74, 0x47, 0x50, 0xf7, 0x6c, 0x90, 0x32, 0x27, 0xb2, 0xdd, 0xa4, 0xfb, 0xd3, 0xaa, 0x67,
-	0x42, 0xec, 0x0e, 0x34, 0xe8, 0x68, 0x28, 0xf7, 0x73, 0x91, 0xf6, 0xb3, 0x65, 0x9e, 0x1d, 0x69,
-	0x47, 0x4d, 0x22, 0xf3, 0xb6, 0x64, 0xc9, 0xbe, 0x2d, 0x11, 0x4a, 0x53, 0x5e, 0x32, 0xb5, 0xa8,
-	0xb7, 0x0c, 0x40, 0x6b, 0x2a, 0x17, 0x4c, 0x10, 0x2c, 0x13, 0x81, 0x85, 0xb1, 0xab, 0x50, 0xc3,
-	0x63, 0xcb, 0xd8, 0x0f, 0xfa, 0x6d, 0xa6, 0x4f, 0x4f, 0x1a, 0xc3, 0x36, 0xd4, 0x6f, 0xba, 0x0c,
-	0x5a, 0xa1, 0x55, 0xb1, 0x30, 0x5c, 0x1b, 0x5d, 0x26, 0x21, 0x5a, 0x15, 0x3b, 0x6a, 0x81, 0x6e,
-	0x0a, 0x6c, 0xab, 0xdf, 0x97, 0xbc, 0xa9, 0x8f, 0xd1, 0x19, 0x57, 0x39, 0x16, 0x57, 0x95, 0xec,
-	0x6e, 0xa5, 0x7c, 0x77, 0x5f, 0xba, 0x06, 0xee, 0x2e, 0x34, 0x0e, 0x8c, 0x8c, 0x75, 0x62, 0x72,
-	0x95, 0xab, 0x2e, 0x05, 0xc3, 0x40, 0x8c, 0xe1, 0x54, 0xcc, 0xe1, 0xb8, 0x7f, 0xe0, 0x88, 0xa4,
-	0x5f, 0x3d, 0x7c, 0xd1, 0xb7, 0x0b, 0x4d, 0x1d, 0xec, 0xc8, 0x72, 0xc9, 0x2c, 0x0c, 0x69, 0x68,
-	0x28, 0xdd, 0xe8, 0xf8, 0x38, 0xe1, 0x2a, 0xf3, 0xc3, 0xc2, 0x90, 0x43, 0xd1, 0xc7, 0x41, 0x7f,
-	0x21, 0x10, 0x3d, 0x24, 0x32, 0x03, 0xa4, 0x80, 0xa3, 0x9e, 0x8d, 0xf9, 0x29, 0x8f, 0x13, 0x2d,
-	0x5a, 0xba, 0xac, 0x53, 0xde, 0xf2, 0xab, 0xbc, 0x09, 0x35, 0xdd, 0xae, 0xad, 0x42, 0x14, 0xa5,
-	0xae, 0x47, 0x55, 0x45, 0x5e, 0xbf, 0x35, 0x68, 0xa1, 0x36, 0x8b, 0x15, 0xec, 0x26, 0xb0, 0xe3,
-	0x20, 0xce, 0x93, 0xcf, 0x10, 0x79, 0x49, 0x8d, 0xfb, 0x14, 0x56, 0x64, 0x97, 0xa6, 0x73, 0x63,
-	0x6f, 0xa2, 0x73, 0x11, 0x23, 0x57, 0x8a, 0x8c, 0xec, 0xfe, 0x87, 0x03, 0xf3, 0x72, 0xa7, 0x0b,
-	0xaf, 0x1e, 0xc4, 0x3e, 0x5b, 0x18, 0x6b, 0x5b, 0x49, 0xeb, 0xc4, 0xf5, 0x52, 0x75, 0x15, 0x14,
-	0xd4, 0x4c, 0x99, 0x82, 0x62, 0x50, 0x1d, 0xfb, 0xe9, 0x09, 0x9d, 0x65, 0xeb, 0x1e, 0xfd, 0x66,
-	0x2d, 0x11, 0x79, 0x11, 0x8a, 0x90, 0xa2, 0x2e, 0x65, 0xef, 0x3b, 0x84, 0xbd, 0x2d, 0xbe, 0xef,
-	0xb8, 0x02, 0x75, 0x1a, 0x40, 0x37, 0x0b, 0xac, 0x64, 0x00, 0x72, 0xae, 0x28, 0x90, 0x84, 0xc9,
-	0xd4, 0xd2, 0x0c, 0x71, 0xd7, 0xc4, 0xce, 0xcb, 0x25, 0xd0, 0xf7, 0x5d, 0x32, 0xc5, 0x30, 0x83,
-	0x33, 0x8e, 0x90, 0x03, 0xc8, 0x73, 0x84, 0x24, 0xf5, 0x74, 0xbd, 0xdb, 0x81, 0xf6, 0x0e, 0x1f,
-	0xf2, 0x94, 0x6f, 0x0d, 0x87, 0xf9, 0xf6, 0x5f, 0x83, 0xcb, 0x25, 0x75, 0xd2, 0x9f, 0xfd, 0x22,
-	0xac, 0x6d, 0x89, 0x74, 0xac, 0x9f, 0x56, 0xce, 0x82, 0xdb, 0x86, 0xf5, 0x7c, 0x93, 0xb2, 0xb3,
-	0x7b, 0xb0, 0xbc, 0xc3, 0x8f, 0x26, 0x83, 0x7d, 0x7e, 0x9a, 0x75, 0xc4, 0xa0, 0x9a, 0x9c, 0x44,
-	0x67, 0x52, 0x30, 0xe9, 0x37, 0x7b, 0x1d, 0x60, 0x88, 0x34, 0xdd, 0x64, 0xcc, 0x7b, 0x2a, 0x85,
-	0x9c, 0x90, 0xc3, 0x31, 0xef, 0xb9, 0xef, 0x01, 0x33, 0xdb, 0x91, 0xeb, 0x85, 0xf6, 0x68, 0x72,
-	0xd4, 0x4d, 0xa6, 0x49, 0xca, 0x47, 0x2a, 0x37, 0xde, 0x84, 0xdc, 0xeb, 0xd0, 0x3c, 0xf0, 0xa7,
-	0x1e, 0xff, 0x86, 0x7c, 0xec, 0xb2, 0x01, 0xf3, 0x63, 0x7f, 0x8a, 0x6a, 0x4a, 0x47, 0x7c, 0xa8,
-	0xda, 0xfd, 0xb7, 0x0a, 0xcc, 0x09, 0x4a, 0x6c, 0xb5, 0xcf, 0x93, 0x34, 0x08, 0xc5, 0xed, 0xaf,
-	0x6c, 0xd5, 0x80, 0x0a, 0xac, 0x5c, 0x29, 0x61, 0x65, 0x79, 0x6a, 0x52, 0xe9, 0xb8, 0x92, 0x5f,
-	0x2d, 0x0c, 0x99, 0x2b, 0xcb, 0xeb, 0x11, 0x21, 0x87, 0x0c, 0xc8, 0x05, 0x07, 0x33, 0xab, 0x27,
-	0xc6, 0xa7, 0xa4, 0x54, 0x72, 0xae, 0x09, 0x95, 0xda, 0xd6, 0x79, 0xc1, 0xe0, 0x05, 0xdb, 0x5a,
-	0xb0, 0xa1, 0xb5, 0x57, 0xb0, 0xa1, 0xe2, 0x28, 0xf5, 0x32, 0x1b, 0x0a, 0xaf, 0x60, 0x43, 0x5d,
-	0x06, 0xad, 0x7b, 0x9c, 0x7b, 0x1c, 0xbd, 0x33, 0xc5, 0xbb, 0xdf, 0x71, 0xa0, 0x25, 0xb9, 0x48,
-	0xd7, 0xb1, 0x37, 0x2d, 0x2f, 0xb4, 0x34, 0x69, 0xf6, 0x2d, 0x58, 0x20, 0xdf, 0x50, 0x47, 0x41,
-	0x65, 0xc8, 0xd6, 0x02, 0x71, 0x1e, 0xea, 0xaa, 0x6a, 0x14, 0x0c, 0xe5, 0xa6, 0x98, 0x90, 0x0a,
-	0xa4, 0xc6, 0xbe, 0x4c, 0xa2, 0x71, 0x3c, 0x5d, 0x76, 0xff, 0xd4, 0x81, 0x65, 0x63, 0xc0, 0x92,
-	0x0b, 0x3f, 0x04, 0x25, 0x0d, 0x22, 0x24, 0x2a, 0x24, 0x77, 0xc3, 0x16, 0x9b, 0xec, 0x33, 0x8b,
-	0x98, 0x36, 0xd3, 0x9f, 0xd2, 0x00, 0x93, 0xc9, 0x48, 0x2a, 0x51, 0x13, 0x42, 0x46, 0x3a, 0xe3,
-	0xfc, 0x99, 0x26, 0x11, 0x6a, 0xdc, 0xc2, 0x28, 0x41, 0x03, 0x7d, 0x5a, 0x4d, 0x24, 0xec, 0x99,
-	0x0d, 0xba, 0x7f, 0xeb, 0xc0, 0x8a, 0x38, 0x9c, 0xc8, 0xa3, 0x9f, 0x7e, 0xd1, 0x30, 0x27, 0x4e,
-	0x63, 0x42, 0x22, 0xf7, 0x2e, 0x79, 0xb2, 0xcc, 0x3e, 0xf3, 0x8a, 0x07, 0x2a, 0x9d, 0x98, 0x73,
-	0xce, 0x5e, 0xcc, 0x94, 0xed, 0xc5, 0x4b, 0x56, 0xba, 0x2c, 0x04, 0x38, 0x5b, 0x1a, 0x02, 0xbc,
-	0x3b, 0x0f, 0xb3, 0x49, 0x2f, 0x1a, 0x73, 0x77, 0x1d, 0x56, 0xed, 0xc9, 0x49, 0x15, 0xf4, 0x5d,
-	0x07, 0xda, 0xf7, 0x44, 0xa8, 0x3c, 0x08, 0x07, 0x7b, 0x41, 0x92, 0x46, 0xb1, 0x7e, 0xdd, 0x75,
-	0x15, 0x20, 0x49, 0xfd, 0x38, 0x15, 0xe9, 0x96, 0x32, 0x40, 0x97, 0x21, 0x38, 0x46, 0x1e, 0xf6,
-	0x45, 0xad, 0xd8, 0x1b, 0x5d, 0x2e, 0xf8, 0x10, 0xf2, 0xf8, 0x64, 0x59, 0xe2, 0xb7, 0x45, 0xa6,
-	0x1b, 0xfa, 0x0a, 0xfc, 0x94, 0xf4, 0xba, 0x38, 0x97, 0xe4, 0x50, 0xf7, 0x8f, 0x1c, 0x58, 0xca,
-	0x06, 0xb9, 0x8b, 0xa0, 0xad, 0x1d, 0xa4, 0xf9, 0xcd, 0xb4, 0x83, 0x0a, 0x1d, 0x06, 0x68, 0x8f,
-	0xe5, 0xd8, 0x0c, 0x84, 0x24, 0x56, 0x96, 0xa2, 0x89, 0x72, 0x70, 0x4c, 0x48, 0x64, 0x8d, 0xa0,
-	0x27, 0x20, 0xbd, 0x1a, 0x59, 0xa2, 0x6c, 0xd9, 0x51, 0x4a, 0x5f, 0xcd, 0x89, 0x83, 0x99, 0x2c,
-	0x2a, 0x53, 0x3a, 0x4f, 0x28, 0xfe, 0x74, 0xbf, 0xed, 0xc0, 0xe5, 0x92, 0xc5, 0x95, 0x92, 0xb1,
-	0x03, 0xcb, 0xc7, 0xba, 0x52, 0x2d, 0x80, 0x10, 0x8f, 0x75, 0x75, 0xb7, 0x63, 0x4f, 0xda, 0x2b,
-	0x7e, 0xa0, 0x7d, 0x1f, 0xb1, 0xa4, 0x56, 0xf2, 0x56, 0xb1, 0x62, 0xf3, 0x73, 0xd0, 0x30, 0x9e,
-	0x55, 0xb1, 0x0d, 0x58, 0x79, 0xfa, 0xe0, 0xf1, 0xc3, 0xdd, 0xc3, 0xc3, 0xee, 0xc1, 0x93, 0xbb,
-	0x5f, 0xd8, 0xfd, 0x72, 0x77, 0x6f, 0xeb, 0x70, 0xaf, 0x75, 0x89, 0xad, 0x03, 0x7b, 0xb8, 0x7b,
-	0xf8, 0x78, 0x77, 0xc7, 0xc2, 0x9d, 0x3b, 0xbf, 0x39, 0x03, 0x8b, 0xe2, 0xce, 0x50, 0xbc, 0x5d,
-	0xe7, 0x31, 0xfb, 0x08, 0xe6, 0xe5, 0x7f, 0x0f, 0xb0, 0x35, 0x39, 0x6c, 0xfb, 0xdf, 0x0e, 0x3a,
-	0xeb, 0x79, 0x58, 0xf2, 0xde, 0xca, 0x2f, 0xfd, 0xf0, 0x1f, 0x7e, 0xab, 0xb2, 0xc0, 0x1a, 0xb7,
-	0x4e, 0xdf, 0xbd, 0x35, 0xe0, 0x61, 0x82, 0x6d, 0xfc, 0x2c, 0x40, 0xf6, 0x2a, 0x9f, 0xb5, 0xb5,
-	0xcf, 0x97, 0xfb, 0xbb, 0x81, 0xce, 0xe5, 0x92, 0x1a, 0xd9, 0xee, 0x65, 0x6a, 0x77, 0xc5, 0x5d,
-	0xc4, 0x76, 0x83, 0x30, 0x48, 0xc5, 0x13, 0xfd, 0x0f, 0x9c, 0x4d, 0xd6, 0x87, 0xa6, 0xf9, 0xe8,
-	0x9e, 0xa9, 0xd0, 0x4f, 0xc9, 0x93, 0xff, 0xce, 0x6b, 0xa5, 0x75, 0x2a, 0xee, 0x45, 0x7d, 0xac,
-	0xb9, 0x2d, 0xec, 0x63, 0x42, 0x14, 0x59, 0x2f, 0x43, 0x58, 0xb4, 0xdf, 0xd6, 0xb3, 0x2b, 0x86,
-	0x5a, 0x28, 0xbc, 0xec, 0xef, 0xbc, 0x7e, 0x4e, 0xad, 0xec, 0xeb, 0x75, 0xea, 0x6b, 0xc3, 0x65,
-	0xd8, 0x57, 0x8f, 0x68, 0xd4, 0xcb, 0xfe, 0x0f, 0x9c, 0xcd, 0x3b, 0xff, 0xfa, 0x06, 0xd4, 0x75,
-	0xb0, 0x96, 0x7d, 0x1d, 0x16, 0xac, 0x4b, 0x5d, 0xa6, 0xa6, 0x51, 0x76, 0x07, 0xdc, 0xb9, 0x52,
-	0x5e, 0x29, 0x3b, 0xbe, 0x4a, 0x1d, 0xb7, 0xd9, 0x3a, 0x76, 0x2c, 0x6f, 0x45, 0x6f, 0xd1, 0x55,
-	0xb6, 0xc8, 0xe5, 0x7d, 0x26, 0xe6, 0x99, 0x5d, 0xc4, 0x5a, 0xf3, 0x2c, 0x5c, 0xdc, 0x5a, 0xf3,
-	0x2c, 0xde, 0xde, 0xba, 0x57, 0xa8, 0xbb, 0x75, 0xb6, 0x6a, 0x76, 0xa7, 0x83, 0xa8, 0x9c, 0xb2,
-	0xaf, 0xcd, 0xa7, 0xe8, 0xec, 0x75, 0xcd, 0x58, 0x65, 0x4f, 0xd4, 0x35, 0x8b, 0x14, 0xdf, 0xa9,
-	0xbb, 0x6d, 0xea, 0x8a, 0x31, 0xda, 0x3e, 0xf3, 0x25, 0x3a, 0xfb, 0x2a, 0xd4, 0xf5, 0x9b, 0x4a,
-	0xb6, 0x61, 0x3c, 0x64, 0x35, 0x1f, 0x7a, 0x76, 0xda, 0xc5, 0x8a, 0x32, 0xc6, 0x30, 0x5b, 0x46,
-	0xc6, 0x78, 0x0a, 0x0d, 0xe3, 0xdd, 0x24, 0xbb, 0xac, 0x43, 0xed, 0xf9, 0xb7, 0x99, 0x9d, 0x4e,
-	0x59, 0x95, 0xec, 0x62, 0x99, 0xba, 0x68, 0xb0, 0x3a, 0xf1, 0x5e, 0xfa, 0x3c, 0x4a, 0xd8, 0x3e,
-	0xac, 0xc9, 0xc3, 0xc9, 0x11, 0xff, 0x51, 0x96, 0xa8, 0xe4, 0x65, 0xfe, 0x6d, 0x87, 0x7d, 0x08,
-	0x35, 0xf5, 0x06, 0x96, 0xad, 0x97, 0xbf, 0xe5, 0xed, 0x6c, 0x14, 0x70, 0xa9, 0xd6, 0xbe, 0x0c,
-	0x90, 0x3d, 0xd2, 0xd4, 0x02, 0x5c, 0x78, 0xf4, 0xa9, 0x77, 0xa7, 0xf8, 0xa2, 0xd3, 0x5d, 0xa7,
-	0x09, 0xb6, 0x18, 0x09, 0x70, 0xc8, 0xcf, 0xd4, 0x7b, 0x84, 0xaf, 0x41, 0xc3, 0x78, 0xa7, 0xa9,
-	0x97, 0xaf, 0xf8, 0xc6, 0x53, 0x2f, 0x5f, 0xc9, 0xb3, 0x4e, 0xb7, 0x43, 0xad, 0xaf, 0xba, 0x4b,
-	0xd8, 0x7a, 0x12, 0x0c, 0xc2, 0x91, 0x20, 0xc0, 0x0d, 0x3a, 0x81, 0x05, 0xeb, 0x31, 0xa6, 0x96,
-	0x9e, 0xb2, 0xa7, 0x9e, 0x5a, 0x7a, 0x4a, 0xdf, 0x6f, 0x2a, 0x76, 0x76, 0x97, 0xb1, 0x9f, 0x53,
-	0x22, 0x31, 0x7a, 0xfa, 0x0a, 0x34, 0x8c, 0x87, 0x95, 0xcc, 0xc8, 0x9f, 0xcc, 0x3d, 0xa9, 0xd4,
-	0x73, 0x29, 0x7b, 0x87, 0xb9, 0x4a, 0x7d, 0x2c, 0xba, 0xc4, 0x0a, 0x94, 0xce, 0x8f, 0x6d, 0x7f,
-	0x1d, 0x16, 0xed, 0xa7, 0x96, 0x5a, 0x2e, 0x4b, 0x1f, 0x6d, 0x6a, 0xb9, 0x3c, 0xe7, 0x7d, 0xa6,
-	0x64, 0xe9, 0xcd, 0x15, 0xdd, 0xc9, 0xad, 0x8f, 0xe5, 0xd5, 0xe9, 0x0b, 0xf6, 0x45, 0x54, 0x3e,
-	0xf2, 0x7d, 0x05, 0xdb, 0x30, 0xb8, 0xd6, 0x7c, 0x85, 0xa1, 0xe5, 0xa5, 0xf0, 0x14, 0xc3, 0x66,
-	0x66, 0xf1, 0x20, 0x81, 0x2c, 0x0a, 0xbd, 0xb3, 0x30, 0x2c, 0x8a, 0xf9, 0x14, 0xc3, 0xb0, 0x28,
-	0xd6, 0x73, 0x8c, 0xbc, 0x45, 0x49, 0x03, 0x6c, 0x23, 0x84, 0xa5, 0x5c, 0x02, 0x91, 0x96, 0x8a,
-	0xf2, 0x8c, 0xcb, 0xce, 0xd5, 0x97, 0xe7, 0x1d, 0xd9, 0x8a, 0x4a, 0x29, 0xa8, 0x5b, 0x2a, 0x41,
-	0xf6, 0xe7, 0xa0, 0x69, 0x3e, 0x91, 0x63, 0xa6, 0x28, 0xe7, 0x7b, 0x7a, 0xad, 0xb4, 0xce, 0xde,
-	0x5c, 0xd6, 0x34, 0xbb, 0xc1, 0xcd, 0xb5, 0xdf, 0x08, 0x65, 0x4a, 0xb7, 0xec, 0x69, 0x54, 0xa6,
-	0x74, 0x4b, 0x1f, 0x16, 0xa9, 0xcd, 0x65, 0x2b, 0xd6, 0x5c, 0x44, 0x94, 0x9b, 0x7d, 0x05, 0x96,
-	0x8c, 0xec, 0xbc, 0xc3, 0x69, 0xd8, 0xd3, 0x8c, 0x5a, 0xcc, 0x03, 0xef, 0x94, 0xf9, 0xbe, 0xee,
-	0x06, 0xb5, 0xbf, 0xec, 0x5a, 0x93, 0x40, 0x26, 0xdd, 0x86, 0x86, 0x99, 0xf9, 0xf7, 0x92, 0x76,
-	0x37, 0x8c, 0x2a, 0x33, 0x8d, 0xf9, 0xb6, 0xc3, 0x7e, 0xc7, 0x81, 0xa6, 0x95, 0x47, 0x67, 0xdd,
-	0xe5, 0xe4, 0xda, 0x69, 0x9b, 0x75, 0x66, 0x43, 0xae, 0x47, 0x83, 0xdc, 0xdf, 0xfc, 0xff, 0xd6,
-	0x22, 0x7c, 0x6c, 0x9d, 0xa1, 0x6e, 0xe6, 0xff, 0x84, 0xe1, 0x45, 0x9e, 0xc0, 0xcc, 0x95, 0x7f,
-	0x71, 0xdb, 0x61, 0xdf, 0x77, 0x60, 0xd1, 0x3e, 0xf9, 0xeb, 0xad, 0x2a, 0x8d, 0x31, 0xe8, 0xad,
-	0x3a, 0x27, 0x5c, 0xf0, 0x15, 0x1a, 0xe5, 0xe3, 0x4d, 0xcf, 0x1a, 0xa5, 0x7c, 0x3d, 0xf6, 0x93,
-	0x8d, 0x96, 0x7d, 0x20, 0xfe, 0x36, 0x45, 0x85, 0xa3, 0x98, 0xa1, 0xdd, 0xf3, 0xdb, 0x6b, 0xfe,
-	0x67, 0xc8, 0x0d, 0xe7, 0xb6, 0xc3, 0xbe, 0x26, 0xfe, 0x17, 0x42, 0x7e, 0x4b, 0x5c, 0xf2, 0xaa,
-	0xdf, 0xbb, 0x6f, 0xd1, 0x9c, 0xae, 0xba, 0x97, 0xad, 0x39, 0xe5, 0xed, 0xe6, 0x96, 0x18, 0x9d,
-	0xfc, 0xbb, 0x8f, 0x4c, 0xf1, 0x17, 0xfe, 0x02, 0xe4, 0xfc, 0x41, 0x8e, 0xc4, 0x20, 0x25, 0xb9,
-	0xc5, 0xca, 0xaf, 0xd8, 0x8c, 0xbb, 0x49, 0x63, 0x7d, 0xcb, 0x7d, 0xe3, 0xdc, 0xb1, 0xde, 0xa2,
-	0xf3, 0x3b, 0x8e, 0xf8, 0x00, 0x20, 0x0b, 0x1d, 0xb3, 0x5c, 0xe8, 0x52, 0xdb, 0xbe, 0x62, 0x74,
-	0xd9, 0x96, 0x17, 0x15, 0xe1, 0xc4, 0x16, 0xbf, 0x2a, 0xd4, 0xca, 0x03, 0x15, 0xf4, 0x34, 0x9d,
-	0x07, 0x3b, 0xc6, 0x6b, 0x39, 0x0f, 0xf9, 0xf6, 0x2d, 0xa5, 0xa2, 0x23, 0xa8, 0x4f, 0x60, 0x61,
-	0x3f, 0x8a, 0x9e, 0x4d, 0xc6, 0xfa, 0x22, 0xc6, 0x0e, 0xad, 0xed, 0xf9, 0xc9, 0x49, 0x27, 0x37,
-	0x0b, 0xf7, 0x1a, 0x35, 0xd5, 0x61, 0x6d, 0xa3, 0xa9, 0x5b, 0x1f, 0x67, 0xa1, 0xe9, 0x17, 0xcc,
-	0x87, 0x65, 0xed, 0x96, 0xe8, 0x81, 0x77, 0xec, 0x66, 0xcc, 0xa0, 0x6a, 0xa1, 0x0b, 0xcb, 0x03,
-	0x55, 0xa3, 0xbd, 0x95, 0xa8, 0x36, 0x6f, 0x3b, 0xec, 0x00, 0x9a, 0x3b, 0xbc, 0x17, 0xf5, 0xb9,
-	0x8c, 0x4f, 0xad, 0x64, 0x03, 0xd7, 0x81, 0xad, 0xce, 0x82, 0x05, 0xda, 0xfa, 0x7b, 0xec, 0x4f,
-	0x63, 0xfe, 0x8d, 0x5b, 0x1f, 0xcb, 0xc8, 0xd7, 0x0b, 0xa5, 0xbf, 0x55, 0x68, 0xd0, 0xd2, 0xdf,
-	0xb9, 0x58, 0xa2, 0xa5, 0xbf, 0x0b, 0xb1, 0x44, 0x6b, 0xa9, 0x55, 0x68, 0x92, 0x0d, 0x61, 0xb9,
-	0x10, 0x7e, 0x64, 0x6f, 0x28, 0x0b, 0x7c, 0x4e, 0xd0, 0xb2, 0x73, 0xed, 0x7c, 0x02, 0xbb, 0xb7,
-	0x4d, 0xbb, 0xb7, 0x43, 0x58, 0xd8, 0xe1, 0x62, 0xb1, 0x44, 0xb6, 0x47, 0xee, 0xd9, 0xa8, 0x99,
-	0x4b, 0x92, 0x57, 0xe0, 0x54, 0x67, 0x1b, 0x68, 0x4a, 0xb5, 0x60, 0x5f, 0x85, 0xc6, 0x7d, 0x9e,
-	0xaa, 0xf4, 0x0e, 0xed, 0x22, 0xe6, 0xf2, 0x3d, 0x3a, 0x25, 0xd9, 0x21, 0x36, 0xcf, 0x50, 0x6b,
-	0xb7, 0x78, 0x7f, 0xc0, 0x85, 0x72, 0xea, 0x06, 0xfd, 0x17, 0xec, 0x67, 0xa8, 0x71, 0x9d, 0x5f,
-	0xb6, 0x6e, 0x64, 0x05, 0x98, 0x8d, 0x2f, 0xe5, 0xf0, 0xb2, 0x96, 0xc3, 0xa8, 0xcf, 0x0d, 0x57,
-	0x25, 0x84, 0x86, 0x91, 0x16, 0xa9, 0x05, 0xa8, 0x98, 0xe2, 0xa9, 0x05, 0xa8, 0x24, 0x8b, 0xd2,
-	0xbd, 0x41, 0xfd, 0xb8, 0xec, 0x5a, 0xd6, 0x8f, 0xc8, 0x9c, 0xcc, 0x7a, 0xba, 0xf5, 0xb1, 0x3f,
-	0x4a, 0x5f, 0xb0, 0xa7, 0xf4, 0x84, 0xd4, 0x4c, 0x61, 0xc9, 0x7c, 0xde, 0x7c, 0xb6, 0x8b, 0x5e,
-	0x2c, 0xa3, 0xca, 0xf6, 0x83, 0x45, 0x57, 0xe4, 0xd1, 0x7c, 0x06, 0xe0, 0x30, 0x8d, 0xc6, 0x3b,
-	0x3e, 0x1f, 0x45, 0x61, 0xa6, 0x6b, 0xb3, 0x34, 0x8d, 0x4c, 0x7f, 0x19, 0xb9, 0x1a, 0xec, 0xa9,
-	0x71, 0x48, 0xb0, 0x32, 0x80, 0x14, 0x73, 0x9d, 0x9b, 0xc9, 0xa1, 0x17, 0xa4, 0x24, 0x9b, 0xe3,
-	0xb6, 0xc3, 0xb6, 0x00, 0xb2, 0xf8, 0xb3, 0x76, 0xf9, 0x0b, 0xa1, 0x6d, 0xad, 0xf6, 0x4a, 0x82,
-	0xd5, 0x07, 0x50, 0xcf, 0x02, 0x9a, 0x1b, 0x59, 0x6a, 0xab, 0x15, 0xfe, 0xd4, 0x16, 0xbc, 0x10,
-	0x66, 0x74, 0x5b, 0xb4, 0x54, 0xc0, 0x6a, 0xb8, 0x54, 0x14, 0x3b, 0x0c, 0x60, 0x45, 0x0c, 0x50,
-	0xbb, 0x23, 0x94, 0x78, 0xa0, 0x66, 0x52, 0x12, 0xea, 0xd3, 0xd2, 0x5c, 0x1a, 0x29, 0xb3, 0xa2,
-	0x0a, 0xc8, 0xad, 0x22, 0xe9, 0x01, 0x55, 0xf3, 0x08, 0x96, 0x0b, 0x61, 0x1e, 0x2d, 0xd2, 0xe7,
-	0x45, 0xd7, 0xb4, 0x48, 0x9f, 0x1b, 0x21, 0x72, 0xd7, 0xa8, 0xcb, 0x25, 0x17, 0xe8, 0xa4, 0x72,
-	0x16, 0xa4, 0xbd, 0x93, 0x0f, 0x9c, 0xcd, 0xa3, 0x39, 0xfa, 0x5b, 0xc9, 0x4f, 0xfd, 0x57, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x4f, 0xaa, 0x41, 0x44, 0x88, 0x52, 0x00, 0x00,
+	// 11104 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x5b, 0x6c, 0x24, 0x49,
+	0x72, 0xd8, 0x54, 0x77, 0xf3, 0x15, 0xdd, 0x4d, 0x36, 0x93, 0xaf, 0x9e, 0x9a, 0xc7, 0xce, 0xd6,
+	0x8d, 0x76, 0x47, 0xa3, 0xd5, 0xcc, 0xec, 0xec, 0xde, 0x62, 0x1f, 0x77, 0x3a, 0x71, 0x38, 0x9c,
+	0xe1, 0xdc, 0x72, 0x38, 0xbc, 0xe2, 0xcc, 0xee, 0xbd, 0xa4, 0xba, 0x62, 0x77, 0x92, 0xac, 0x9d,
+	0xee, 0xaa, 0xbe, 0xaa, 0x6a, 0x72, 0x78, 0xab, 0x35, 0x60, 0x43, 0x3a, 0xdb, 0xb2, 0x0d, 0x5b,
+	0xb6, 0xbf, 0xf4, 0x63, 0x43, 0x32, 0x0c, 0x9f, 0x21, 0x58, 0x06, 0x0c, 0x0b, 0x36, 0x6c, 0xc0,
+	0x30, 0x20, 0x41, 0x80, 0x00, 0xc3, 0x1f, 0xfa, 0x32, 0x60, 0xd8, 0x12, 0xfc, 0x80, 0x05, 0xc3,
+	0x90, 0xbe, 0xad, 0x1f, 0x23, 0xf2, 0x55, 0x99, 0x55, 0xd9, 0xe4, 0xec, 0xed, 0xc9, 0x3f, 0x64,
+	0x67, 0x44, 0x56, 0x3e, 0x22, 0x23, 0x23, 0x23, 0x22, 0x23, 0x33, 0x61, 0x2e, 0x1d, 0xf5, 0x6e,
+	0x8d, 0xd2, 0x24, 0x4f, 0xc8, 0xd4, 0x20, 0x4e, 0x47, 0x3d, 0xf7, 0xf2, 0x61, 0x92, 0x1c, 0x0e,
+	0xe8, 0xed, 0x70, 0x14, 0xdd, 0x0e, 0xe3, 0x38, 0xc9, 0xc3, 0x3c, 0x4a, 0xe2, 0x8c, 0x67, 0xf2,
+	0xbe, 0x07, 0xf3, 0x0f, 0x69, 0xbc, 0x47, 0x69, 0xdf, 0xa7, 0xdf, 0x1f, 0xd3, 0x2c, 0x27, 0x3f,
+	0x03, 0x8b, 0x21, 0xfd, 0x01, 0xa5, 0xfd, 0x60, 0x14, 0x66, 0xd9, 0xe8, 0x28, 0x0d, 0x33, 0xda,
+	0x75, 0xae, 0x39, 0x37, 0x5a, 0x7e, 0x87, 0x23, 0x76, 0x15, 0x9c, 0xbc, 0x0a, 0xad, 0x0c, 0xb3,
+	0xd2, 0x38, 0x4f, 0x93, 0xd1, 0x69, 0xb7, 0xc6, 0xf2, 0x35, 0x11, 0xb6, 0xc9, 0x41, 0xde, 0x00,
+	0x16, 0x54, 0x0d, 0xd9, 0x28, 0x89, 0x33, 0x4a, 0xee, 0xc0, 0x72, 0x2f, 0x1a, 0x1d, 0xd1, 0x34,
+	0x60, 0x1f, 0x0f, 0x63, 0x3a, 0x4c, 0xe2, 0xa8, 0xd7, 0x75, 0xae, 0xd5, 0x6f, 0xcc, 0xf9, 0x84,
+	0xe3, 0xf0, 0x8b, 0xc7, 0x02, 0x43, 0x5e, 0x87, 0x05, 0x1a, 0x73, 0x38, 0xed, 0xb3, 0xaf, 0x44,
+	0x55, 0xf3, 0x05, 0x18, 0x3f, 0xf0, 0x7e, 0xd7, 0x81, 0xc5, 0x47, 0x71, 0x94, 0x7f, 0x1c, 0x0e,
+	0x06, 0x34, 0x97, 0x7d, 0x7a, 0x1d, 0x16, 0x4e, 0x18, 0x80, 0xf5, 0xe9, 0x24, 0x49, 0xfb, 0xa2,
+	0x47, 0xf3, 0x1c, 0xbc, 0x2b, 0xa0, 0x13, 0x5b, 0x56, 0x9b, 0xd8, 0x32, 0x2b, 0xb9, 0xea, 0x13,
+	0xc8, 0xf5, 0x3a, 0x2c, 0xa4, 0xb4, 0x97, 0x1c, 0xd3, 0xf4, 0x34, 0x38, 0x89, 0xe2, 0x7e, 0x72,
+	0xd2, 0x6d, 0x5c, 0x73, 0x6e, 0x4c, 0xf9, 0xf3, 0x12, 0xfc, 0x31, 0x83, 0x7a, 0xcb, 0x40, 0xf4,
+	0x5e, 0x70, 0xba, 0x79, 0x87, 0xb0, 0xf4, 0x2c, 0x1e, 0x24, 0xbd, 0xe7, 0x3f, 0x66, 0xef, 0x2c,
+	0xd5, 0xd7, 0xac, 0xd5, 0xaf, 0xc2, 0xb2, 0x59, 0x91, 0x68, 0x00, 0x85, 0x95, 0x8d, 0xa3, 0x30,
+	0x3e, 0xa4, 0xb2, 0x48, 0xd9, 0x84, 0x9f, 0x86, 0x4e, 0x6f, 0x9c, 0xa6, 0x34, 0xae, 0xb4, 0x61,
+	0x41, 0xc0, 0x55, 0x23, 0x5e, 0x85, 0x56, 0x4c, 0x4f, 0x8a, 0x6c, 0x82, 0x65, 0x62, 0x7a, 0x22,
+	0xb3, 0x78, 0x5d, 0x58, 0x2d, 0x57, 0x23, 0x1a, 0xb0, 0x06, 0x2b, 0x7b, 0xe3, 0xfd, 0xac, 0x97,
+	0x46, 0xfb, 0x74, 0x2f, 0x0f, 0x73, 0x2a, 0x1a, 0xe0, 0xdd, 0x83, 0xd5, 0x32, 0x42, 0x30, 0xdb,
+	0x0d, 0x98, 0xca, 0x10, 0xc0, 0xda, 0x33, 0x7f, 0x97, 0xdc, 0x62, 0xd3, 0xe2, 0x16, 0xef, 0x19,
+	0xcf, 0xca, 0x33, 0x78, 0x8b, 0xc8, 0xa9, 0xb9, 0x51, 0xec, 0x57, 0xa0, 0x53, 0x80, 0x3e, 0x77,
+	0x81, 0xff, 0xc7, 0x81, 0xc6, 0xb3, 0xfc, 0x45, 0x42, 0x6e, 0x41, 0x23, 0x3f, 0x1d, 0x95, 0xbf,
+	0x58, 0xef, 0xf7, 0x53, 0x9a, 0x65, 0x4f, 0x4f, 0x47, 0xd4, 0x6f, 0x85, 0x3c, 0x11, 0x60, 0x3e,
+	0xd2, 0x85, 0x19, 0x91, 0x66, 0xe4, 0x99, 0xf3, 0x65, 0x92, 0x5c, 0x05, 0x08, 0x87, 0xc9, 0x38,
+	0xce, 0x83, 0x2c, 0xcc, 0x19, 0x9f, 0xd5, 0x7d, 0x0d, 0x42, 0xae, 0x43, 0x1b, 0x89, 0x30, 0xca,
+	0x83, 0xd1, 0x78, 0xff, 0x39, 0x3d, 0x65, 0xfc, 0x35, 0xe7, 0x9b, 0x40, 0x72, 0x1b, 0x66, 0x93,
+	0x71, 0x3e, 0x4a, 0xa2, 0x38, 0xef, 0x4e, 0x5d, 0x73, 0x6e, 0x34, 0xef, 0x2e, 0x89, 0x36, 0x21,
+	0xdd, 0x63, 0x3a, 0xd8, 0x45, 0x94, 0xaf, 0x32, 0x61, 0xb1, 0xbd, 0x24, 0x3e, 0x88, 0xd2, 0x21,
+	0x97, 0x1e, 0xdd, 0x69, 0x56, 0xb3, 0x09, 0xf4, 0x7e, 0xbb, 0x06, 0xcd, 0xa7, 0x69, 0x18, 0x67,
+	0x61, 0x0f, 0x01, 0xd8, 0x8d, 0xfc, 0x45, 0x70, 0x14, 0x66, 0x47, 0xac, 0xe7, 0x73, 0xbe, 0x4c,
+	0x92, 0x55, 0x98, 0xe6, 0x8d, 0x66, 0xfd, 0xab, 0xfb, 0x22, 0x45, 0xde, 0x80, 0xc5, 0x78, 0x3c,
+	0x0c, 0xcc, 0xba, 0xea, 0x8c, 0x47, 0xab, 0x08, 0x24, 0xc6, 0x3e, 0x72, 0x29, 0xaf, 0x82, 0xf7,
+	0x54, 0x83, 0x10, 0x0f, 0x5a, 0x22, 0x45, 0xa3, 0xc3, 0x23, 0xde, 0xd5, 0x29, 0xdf, 0x80, 0x61,
+	0x19, 0x79, 0x34, 0xa4, 0x41, 0x96, 0x87, 0xc3, 0x91, 0xe8, 0x96, 0x06, 0x61, 0xf8, 0x24, 0x0f,
+	0x07, 0xc1, 0x01, 0xa5, 0x59, 0x77, 0x46, 0xe0, 0x15, 0x84, 0xbc, 0x06, 0xf3, 0x7d, 0x9a, 0xe5,
+	0x81, 0x18, 0x20, 0x9a, 0x75, 0x67, 0x99, 0xac, 0x28, 0x41, 0xc9, 0x32, 0x4c, 0x0d, 0xc2, 0x7d,
+	0x3a, 0xe8, 0xce, 0xb1, 0x66, 0xf2, 0x04, 0x72, 0xfa, 0x43, 0x9a, 0x6b, 0x34, 0xcb, 0x24, 0xe7,
+	0x6d, 0x03, 0xd1, 0xc0, 0xf7, 0x69, 0x1e, 0x46, 0x83, 0x8c, 0xbc, 0x03, 0xad, 0x5c, 0xcb, 0xcc,
+	0x24, 0x66, 0x53, 0x31, 0x94, 0xf6, 0x81, 0x6f, 0xe4, 0xf3, 0x1e, 0xc2, 0xec, 0x03, 0x4a, 0xb7,
+	0xa3, 0x61, 0x94, 0x93, 0x55, 0x98, 0x3a, 0x88, 0x5e, 0x50, 0x3e, 0x41, 0xeb, 0x5b, 0x17, 0x7c,
+	0x9e, 0x24, 0x2e, 0xcc, 0x8c, 0x68, 0xda, 0xa3, 0x72, 0x50, 0xb6, 0x2e, 0xf8, 0x12, 0x70, 0x6f,
+	0x06, 0xa6, 0x06, 0xf8, 0xb1, 0xf7, 0xbb, 0x35, 0x68, 0xee, 0xd1, 0x58, 0x4d, 0x7c, 0x02, 0x0d,
+	0xec, 0xa8, 0x98, 0xec, 0xec, 0x37, 0x79, 0x05, 0x9a, 0xac, 0xf3, 0x59, 0x9e, 0x46, 0xf1, 0xa1,
+	0xe0, 0x60, 0x40, 0xd0, 0x1e, 0x83, 0x90, 0x0e, 0xd4, 0xc3, 0xa1, 0xe4, 0x5e, 0xfc, 0x89, 0x42,
+	0x61, 0x14, 0x9e, 0x0e, 0x51, 0x7e, 0xa8, 0xb1, 0x6c, 0xf9, 0x4d, 0x01, 0xdb, 0xc2, 0xc1, 0xbc,
+	0x05, 0x4b, 0x7a, 0x16, 0x59, 0xfa, 0x14, 0x2b, 0x7d, 0x51, 0xcb, 0x29, 0x2a, 0x79, 0x1d, 0x16,
+	0x64, 0xfe, 0x94, 0x37, 0x96, 0x8d, 0xee, 0x9c, 0x3f, 0x2f, 0xc0, 0xb2, 0x0b, 0x37, 0xa0, 0x73,
+	0x10, 0xc5, 0xe1, 0x20, 0xe8, 0x0d, 0xf2, 0xe3, 0xa0, 0x4f, 0x07, 0x79, 0xc8, 0xc6, 0x79, 0xca,
+	0x9f, 0x67, 0xf0, 0x8d, 0x41, 0x7e, 0x7c, 0x1f, 0xa1, 0xe4, 0x0d, 0x98, 0x3b, 0xa0, 0x34, 0x60,
+	0x94, 0xe8, 0xce, 0xb2, 0x79, 0xb3, 0x20, 0x48, 0x2f, 0xa9, 0xeb, 0xcf, 0x1e, 0x88, 0x5f, 0xc4,
+	0x85, 0xd9, 0x21, 0xcd, 0xc3, 0x7e, 0x98, 0x87, 0x6c, 0xd0, 0x5b, 0xbe, 0x4a, 0x7b, 0xff, 0xca,
+	0x81, 0x16, 0x27, 0xa3, 0x10, 0x2a, 0xd7, 0xa1, 0x2d, 0x5b, 0x4b, 0xd3, 0x34, 0x49, 0xc5, 0x84,
+	0x31, 0x81, 0xe4, 0x26, 0x74, 0x24, 0x60, 0x94, 0xd2, 0x68, 0x18, 0x1e, 0x52, 0x21, 0x3f, 0x2b,
+	0x70, 0x72, 0xb7, 0x28, 0x31, 0x4d, 0xc6, 0x39, 0x5f, 0x94, 0x9a, 0x77, 0x5b, 0xa2, 0xc1, 0x3e,
+	0xc2, 0x7c, 0x33, 0x0b, 0x4e, 0x18, 0xcb, 0x30, 0x18, 0x30, 0xef, 0x47, 0x0e, 0x10, 0x6c, 0xfa,
+	0xd3, 0x84, 0x17, 0x21, 0xa8, 0x58, 0x1e, 0x41, 0xe7, 0xa5, 0x47, 0xb0, 0x36, 0x69, 0x04, 0xaf,
+	0xc3, 0x34, 0x6b, 0x16, 0x4a, 0x80, 0x7a, 0xa5, 0xe9, 0x02, 0x67, 0x90, 0xb9, 0x51, 0x22, 0xf3,
+	0x6f, 0x38, 0xd0, 0xd2, 0x25, 0x1a, 0xb9, 0x03, 0xe4, 0x60, 0x1c, 0xf7, 0xa3, 0xf8, 0x30, 0xc8,
+	0x5f, 0x44, 0xfd, 0x60, 0xff, 0x14, 0x8b, 0x67, 0x6d, 0xdd, 0xba, 0xe0, 0x5b, 0x70, 0xe4, 0x0d,
+	0xe8, 0x18, 0xd0, 0x2c, 0x4f, 0x79, 0x8b, 0xb7, 0x2e, 0xf8, 0x15, 0x0c, 0x12, 0x10, 0x65, 0xe6,
+	0x38, 0x0f, 0xa2, 0xb8, 0x4f, 0x5f, 0x30, 0x9a, 0xb7, 0x7d, 0x03, 0x76, 0x6f, 0x1e, 0x5a, 0xfa,
+	0x77, 0xde, 0xcf, 0x41, 0x67, 0x1b, 0x45, 0x51, 0x1c, 0xc5, 0x87, 0x62, 0x49, 0x40, 0xf9, 0x28,
+	0xe4, 0x37, 0xe7, 0x03, 0x91, 0xc2, 0xe9, 0x76, 0x94, 0x64, 0xb9, 0xa0, 0x19, 0xfb, 0xed, 0xfd,
+	0x37, 0x07, 0x16, 0x70, 0x40, 0x1e, 0x87, 0xf1, 0xa9, 0x1c, 0x8d, 0x6d, 0x68, 0x61, 0x51, 0x4f,
+	0x93, 0x75, 0x2e, 0x65, 0xb9, 0x9c, 0xb8, 0x21, 0x08, 0x58, 0xca, 0x7d, 0x4b, 0xcf, 0x8a, 0x6a,
+	0xdb, 0xa9, 0x6f, 0x7c, 0x8d, 0x13, 0x3a, 0x0f, 0xd3, 0x43, 0x9a, 0x33, 0xf9, 0x2b, 0xe4, 0x31,
+	0x70, 0xd0, 0x46, 0x12, 0x1f, 0x90, 0x6b, 0xd0, 0xca, 0xc2, 0x3c, 0x18, 0xd1, 0x94, 0x51, 0x8d,
+	0x4d, 0xca, 0xba, 0x0f, 0x59, 0x98, 0xef, 0xd2, 0xf4, 0xde, 0x69, 0x4e, 0xdd, 0xaf, 0xc1, 0x62,
+	0xa5, 0x16, 0x94, 0x03, 0x45, 0x17, 0xf1, 0x27, 0x4a, 0xc9, 0xe3, 0x70, 0x30, 0xa6, 0x62, 0x59,
+	0xe0, 0x89, 0xf7, 0x6b, 0xef, 0x3a, 0xde, 0x6b, 0xd0, 0x29, 0x9a, 0x2d, 0x26, 0x0d, 0x81, 0x06,
+	0x52, 0x50, 0x14, 0xc0, 0x7e, 0x7b, 0xbf, 0xef, 0x00, 0xd9, 0xcc, 0xf2, 0x68, 0x18, 0xe6, 0xf4,
+	0x01, 0x55, 0xec, 0xf9, 0xc4, 0x4a, 0x90, 0x9f, 0x11, 0x04, 0xa9, 0x7e, 0xf0, 0x79, 0x69, 0x52,
+	0x2b, 0xd3, 0xe4, 0x8b, 0xf7, 0xf8, 0x19, 0x2c, 0x19, 0xed, 0x12, 0x9d, 0xee, 0xc2, 0x0c, 0x0a,
+	0x21, 0x5c, 0xfe, 0x99, 0x00, 0xf7, 0x65, 0x92, 0xad, 0xfd, 0x62, 0x14, 0x8e, 0xd9, 0x30, 0x60,
+	0x91, 0x0d, 0xdf, 0x04, 0x7a, 0x7f, 0xb5, 0xc6, 0x29, 0xb9, 0x91, 0x44, 0x6a, 0xb5, 0x41, 0x4a,
+	0xe2, 0x52, 0x25, 0x29, 0x89, 0xbf, 0x27, 0xae, 0xd1, 0x5f, 0x9c, 0x1b, 0x70, 0xce, 0x66, 0x34,
+	0xee, 0x07, 0xe1, 0x60, 0xc0, 0x84, 0xf2, 0xac, 0xaf, 0xd2, 0xc5, 0x42, 0x39, 0xa3, 0x2d, 0x94,
+	0xa8, 0x18, 0x64, 0x23, 0xcc, 0x32, 0x8e, 0x85, 0x0e, 0x40, 0xfb, 0x4c, 0x04, 0xcf, 0xfa, 0x55,
+	0x44, 0x95, 0x12, 0x73, 0x36, 0x4a, 0xbc, 0x0e, 0x8b, 0x1a, 0x21, 0xce, 0xe0, 0xa9, 0x1d, 0x20,
+	0xdb, 0x51, 0x96, 0x3f, 0x8b, 0xb3, 0x91, 0xb6, 0x6e, 0x5c, 0x82, 0xb9, 0x61, 0x14, 0x33, 0x22,
+	0x70, 0x11, 0x32, 0xe5, 0xcf, 0x0e, 0xa3, 0x18, 0x49, 0x90, 0x31, 0x64, 0xf8, 0x42, 0x20, 0x6b,
+	0x02, 0x19, 0xbe, 0x60, 0x48, 0xef, 0x5d, 0x58, 0x32, 0xca, 0x13, 0x55, 0xbf, 0x0a, 0x53, 0xe3,
+	0xfc, 0x45, 0x22, 0x57, 0xf5, 0xa6, 0x60, 0x4e, 0xd4, 0x20, 0x7d, 0x8e, 0xf1, 0x3e, 0x80, 0xc5,
+	0x1d, 0x7a, 0x22, 0xa4, 0x84, 0x6c, 0xc8, 0x6b, 0xe7, 0x6a, 0x97, 0x0c, 0xef, 0xdd, 0x02, 0xa2,
+	0x7f, 0x5c, 0xf0, 0x93, 0xd4, 0x35, 0x1d, 0x43, 0xd7, 0x44, 0x2b, 0x00, 0x9b, 0xb9, 0x2e, 0x75,
+	0x18, 0xa9, 0x9a, 0xfc, 0xbe, 0x03, 0x6d, 0xae, 0xed, 0x0a, 0xd4, 0xe4, 0x32, 0x50, 0x61, 0xd1,
+	0x35, 0xdb, 0x6e, 0x6d, 0x62, 0x1b, 0x8d, 0x7c, 0xe4, 0x1a, 0x34, 0xa3, 0x2c, 0x88, 0xe2, 0x9c,
+	0xa6, 0x71, 0x38, 0x60, 0x4c, 0x36, 0xeb, 0xeb, 0x20, 0x72, 0x03, 0x16, 0xfa, 0x34, 0x8d, 0x8e,
+	0x99, 0x2e, 0x18, 0x8c, 0xc2, 0x5c, 0x6a, 0x80, 0x65, 0x30, 0xb6, 0x6e, 0x3f, 0x1c, 0x84, 0x71,
+	0x4f, 0xb2, 0xa2, 0x4c, 0x7a, 0x1f, 0xc2, 0x4a, 0xa9, 0x87, 0x82, 0x28, 0x77, 0x61, 0xae, 0x50,
+	0xe8, 0xf8, 0x70, 0x2c, 0x1b, 0x7a, 0xbe, 0xa4, 0x62, 0x91, 0xcd, 0x7b, 0x0d, 0xc8, 0x5e, 0x74,
+	0x18, 0x3f, 0xa6, 0x59, 0x16, 0x1e, 0x2a, 0xc1, 0xd3, 0x81, 0xfa, 0x30, 0x3b, 0x14, 0xcb, 0x21,
+	0xfe, 0xf4, 0xde, 0x82, 0x25, 0x23, 0x9f, 0xa8, 0xf2, 0x32, 0xcc, 0x65, 0xd1, 0x61, 0x1c, 0xe6,
+	0xe3, 0x94, 0x0a, 0x2a, 0x16, 0x00, 0xef, 0x01, 0x2c, 0x7f, 0x44, 0xd3, 0xe8, 0xe0, 0xf4, 0xbc,
+	0xe2, 0xcd, 0x72, 0x6a, 0xe5, 0x72, 0x36, 0x61, 0xa5, 0x54, 0x8e, 0xa8, 0x9e, 0xcb, 0x21, 0xc1,
+	0xf8, 0xb3, 0x3e, 0x4f, 0x68, 0xeb, 0x50, 0x4d, 0x5f, 0x87, 0xbc, 0x04, 0xc8, 0x46, 0x12, 0xc7,
+	0xb4, 0x97, 0xef, 0x52, 0x9a, 0x16, 0xae, 0x83, 0x42, 0x8a, 0x34, 0xef, 0xae, 0x09, 0x82, 0x95,
+	0x17, 0x37, 0x21, 0x5e, 0x08, 0x34, 0x46, 0x34, 0x1d, 0xb2, 0x82, 0x67, 0x7d, 0xf6, 0x9b, 0x19,
+	0x0c, 0xd1, 0x90, 0x26, 0x63, 0xae, 0x1c, 0x36, 0x7c, 0x99, 0xf4, 0x56, 0x60, 0xc9, 0xa8, 0x50,
+	0xd8, 0x83, 0x6f, 0xc2, 0xca, 0xfd, 0x28, 0xeb, 0x55, 0x9b, 0xd2, 0x85, 0x99, 0xd1, 0x78, 0x3f,
+	0x28, 0x84, 0xad, 0x4c, 0xa2, 0xca, 0x5d, 0xfe, 0x44, 0x14, 0xf6, 0xbf, 0x1c, 0x68, 0x6c, 0x3d,
+	0xdd, 0xde, 0x40, 0xf1, 0x14, 0xc5, 0xbd, 0x64, 0x88, 0xda, 0x09, 0x27, 0x87, 0x4a, 0x4f, 0x94,
+	0x8a, 0x97, 0x61, 0x8e, 0x29, 0x35, 0x68, 0x5b, 0x08, 0xfb, 0xbf, 0x00, 0xa0, 0xf8, 0xa2, 0x2f,
+	0x46, 0x51, 0xca, 0xb9, 0x52, 0x98, 0x23, 0x0d, 0xa6, 0x1c, 0x54, 0x11, 0x68, 0x73, 0x1c, 0x24,
+	0xe9, 0x49, 0x98, 0xf6, 0xa5, 0x86, 0x3b, 0xeb, 0x6b, 0x10, 0xc4, 0x1f, 0xe5, 0x83, 0x9e, 0xd0,
+	0x31, 0xa6, 0x19, 0xa5, 0x34, 0x08, 0x4e, 0x1e, 0x61, 0x12, 0x0e, 0x71, 0x99, 0x98, 0x61, 0x19,
+	0x74, 0x90, 0xf7, 0xd7, 0xa6, 0x61, 0x46, 0x28, 0x46, 0xac, 0x47, 0xbd, 0x3c, 0x3a, 0xa6, 0xa2,
+	0xaf, 0x22, 0x85, 0x42, 0x34, 0xa5, 0xc3, 0x24, 0xa7, 0x81, 0xc1, 0x02, 0x26, 0x10, 0x73, 0xf5,
+	0x78, 0x41, 0x01, 0xb7, 0x27, 0xeb, 0x3c, 0x97, 0x01, 0xc4, 0xe1, 0x40, 0x40, 0x10, 0xf5, 0x59,
+	0xaf, 0x1b, 0xbe, 0x4c, 0x22, 0xad, 0x7b, 0xe1, 0x28, 0xec, 0x45, 0xf9, 0xa9, 0x98, 0x9d, 0x2a,
+	0x8d, 0x65, 0x0f, 0x92, 0x5e, 0x38, 0x08, 0xe4, 0xf4, 0x15, 0x56, 0xa7, 0x01, 0x44, 0x0b, 0x4c,
+	0x34, 0x49, 0x66, 0xe3, 0x56, 0x5a, 0x09, 0x8a, 0x54, 0xeb, 0x25, 0xc3, 0x61, 0x94, 0xa3, 0xe1,
+	0xc6, 0xd6, 0x8e, 0xba, 0xaf, 0x41, 0xb8, 0x8d, 0xcb, 0x52, 0x27, 0x7c, 0x7c, 0xe6, 0xa4, 0x8d,
+	0xab, 0x01, 0xd9, 0xd8, 0x50, 0xca, 0x56, 0x91, 0xe7, 0x27, 0x5d, 0xe0, 0xa5, 0x14, 0x10, 0x1c,
+	0xe9, 0x71, 0x9c, 0xd1, 0x3c, 0x1f, 0xd0, 0xbe, 0x6a, 0x50, 0x93, 0x65, 0xab, 0x22, 0xc8, 0x1d,
+	0x58, 0xe2, 0xb6, 0x64, 0x16, 0xe6, 0x49, 0x76, 0x14, 0x65, 0x41, 0x86, 0xf6, 0x57, 0x8b, 0xe5,
+	0xb7, 0xa1, 0xc8, 0xbb, 0xb0, 0x56, 0x02, 0xa7, 0xb4, 0x47, 0xa3, 0x63, 0xda, 0xef, 0xb6, 0xd9,
+	0x57, 0x93, 0xd0, 0xc8, 0x15, 0x68, 0x42, 0x8f, 0x47, 0xfd, 0x10, 0x95, 0xde, 0x79, 0xce, 0x15,
+	0x1a, 0x88, 0xbc, 0x09, 0xed, 0x11, 0xe5, 0x9a, 0x29, 0x72, 0x53, 0xd6, 0x5d, 0x30, 0x16, 0x22,
+	0x9c, 0x1b, 0xbe, 0x99, 0x03, 0xd9, 0xbe, 0x97, 0x31, 0xab, 0x29, 0x3c, 0xed, 0x76, 0x18, 0x43,
+	0x17, 0x00, 0x36, 0x0b, 0x99, 0x2c, 0xa6, 0xdd, 0x45, 0xc6, 0x5b, 0x32, 0x89, 0xc3, 0x3e, 0x88,
+	0x0e, 0x28, 0x4e, 0xef, 0x2e, 0xe1, 0xc3, 0x2e, 0xd3, 0xc8, 0x90, 0xe3, 0x11, 0xc3, 0x2c, 0xf1,
+	0x29, 0xc6, 0x53, 0xe4, 0x6d, 0x80, 0xa3, 0x64, 0xd0, 0x0f, 0x30, 0x91, 0x75, 0x97, 0xaf, 0x39,
+	0x9a, 0x54, 0xde, 0x4a, 0x06, 0xfd, 0xa7, 0xd1, 0x90, 0xf9, 0x7e, 0x32, 0x5f, 0xcb, 0x87, 0x03,
+	0x36, 0xa2, 0x34, 0x0d, 0xc2, 0x41, 0x14, 0x66, 0xdd, 0x15, 0x6e, 0x8c, 0x16, 0x10, 0xef, 0xbf,
+	0x3a, 0x7c, 0x35, 0x16, 0xd3, 0x41, 0xad, 0xaa, 0xaf, 0x40, 0x93, 0x4f, 0x84, 0x20, 0x89, 0x07,
+	0xa7, 0x62, 0x6e, 0x00, 0x07, 0x3d, 0x89, 0x07, 0xa7, 0xe4, 0x4b, 0xd0, 0x8e, 0x62, 0x3d, 0x0b,
+	0x97, 0x64, 0xad, 0x28, 0xd6, 0x32, 0xbd, 0x02, 0xcd, 0xd1, 0x78, 0x7f, 0x10, 0xf5, 0x78, 0x16,
+	0xbe, 0x8e, 0x01, 0x07, 0xb1, 0x0c, 0x68, 0x37, 0x71, 0x9a, 0xf0, 0x1c, 0x0d, 0xbe, 0xd2, 0x09,
+	0x18, 0xcb, 0xc2, 0x24, 0x25, 0x4d, 0xd9, 0xf4, 0x68, 0xf9, 0xec, 0x37, 0xb9, 0x09, 0x8b, 0x45,
+	0x1f, 0x82, 0x41, 0x92, 0x3c, 0x1f, 0x8f, 0x84, 0x2a, 0xb5, 0x80, 0x88, 0x75, 0x84, 0x6f, 0x33,
+	0xb0, 0x77, 0x0f, 0x96, 0xcd, 0x0e, 0x0a, 0x91, 0x7f, 0x13, 0x66, 0xc5, 0x2c, 0xcd, 0xba, 0x4d,
+	0x36, 0xd2, 0xf3, 0xa6, 0x17, 0xc8, 0x57, 0x78, 0xef, 0x77, 0x1a, 0xb0, 0x24, 0xa0, 0x1b, 0x83,
+	0x24, 0xa3, 0x7b, 0xe3, 0xe1, 0x30, 0x4c, 0x2d, 0xd3, 0xdf, 0x39, 0x67, 0xfa, 0xd7, 0xcc, 0xe9,
+	0x8f, 0x93, 0xf2, 0x28, 0x8c, 0x62, 0x6e, 0x34, 0x72, 0xd9, 0xa1, 0x41, 0x70, 0x95, 0xef, 0x0d,
+	0x92, 0x8c, 0x1b, 0x4b, 0xba, 0x9f, 0xa7, 0x0c, 0xae, 0x8a, 0xab, 0x29, 0x9b, 0xb8, 0xd2, 0xc5,
+	0xcd, 0x74, 0x49, 0xdc, 0x78, 0xd0, 0xc2, 0x42, 0xa9, 0x94, 0xcf, 0x33, 0xdc, 0x78, 0xd3, 0x61,
+	0xd8, 0x9e, 0xf2, 0xe4, 0xe6, 0x92, 0x64, 0xc1, 0x36, 0xb5, 0xd1, 0x8d, 0x84, 0xf2, 0x5f, 0xcb,
+	0x3d, 0x27, 0xa6, 0x76, 0x15, 0x45, 0x1e, 0x00, 0xf0, 0xba, 0x98, 0xa6, 0x04, 0x4c, 0x53, 0x7a,
+	0xcd, 0x1c, 0x11, 0x9d, 0xf6, 0xb7, 0x30, 0x31, 0x4e, 0x29, 0xd3, 0x9e, 0xb4, 0x2f, 0xbd, 0x5f,
+	0x75, 0xa0, 0xa9, 0xe1, 0xc8, 0x0a, 0x2c, 0x6e, 0x3c, 0x79, 0xb2, 0xbb, 0xe9, 0xaf, 0x3f, 0x7d,
+	0xf4, 0xd1, 0x66, 0xb0, 0xb1, 0xfd, 0x64, 0x6f, 0xb3, 0x73, 0x01, 0xc1, 0xdb, 0x4f, 0x36, 0xd6,
+	0xb7, 0x83, 0x07, 0x4f, 0xfc, 0x0d, 0x09, 0x76, 0xc8, 0x2a, 0x10, 0x7f, 0xf3, 0xf1, 0x93, 0xa7,
+	0x9b, 0x06, 0xbc, 0x46, 0x3a, 0xd0, 0xba, 0xe7, 0x6f, 0xae, 0x6f, 0x6c, 0x09, 0x48, 0x9d, 0x2c,
+	0x43, 0xe7, 0xc1, 0xb3, 0x9d, 0xfb, 0x8f, 0x76, 0x1e, 0x06, 0x1b, 0xeb, 0x3b, 0x1b, 0x9b, 0xdb,
+	0x9b, 0xf7, 0x3b, 0x0d, 0xd2, 0x86, 0xb9, 0xf5, 0x7b, 0xeb, 0x3b, 0xf7, 0x9f, 0xec, 0x6c, 0xde,
+	0xef, 0x4c, 0x79, 0xff, 0xc5, 0x81, 0x15, 0xd6, 0xea, 0x7e, 0x79, 0x82, 0x5d, 0x83, 0x66, 0x2f,
+	0x49, 0x46, 0x34, 0x0d, 0xb5, 0xc5, 0x47, 0x07, 0xe1, 0xe4, 0xe1, 0xa2, 0xfe, 0x20, 0x49, 0x7b,
+	0x54, 0xcc, 0x2f, 0x60, 0xa0, 0x07, 0x08, 0xc1, 0xc9, 0x23, 0x86, 0x97, 0xe7, 0x10, 0x6a, 0x22,
+	0x87, 0xf1, 0x2c, 0xab, 0x30, 0xbd, 0x9f, 0xd2, 0xb0, 0x77, 0x24, 0x66, 0x96, 0x48, 0xa1, 0xc7,
+	0x5a, 0x5a, 0xe1, 0x3d, 0xa4, 0xfe, 0x80, 0xf6, 0xc5, 0x4a, 0xbb, 0x20, 0xe0, 0x1b, 0x02, 0x8c,
+	0x32, 0x2e, 0xdc, 0x0f, 0xe3, 0x7e, 0x12, 0xd3, 0xbe, 0x98, 0x63, 0x05, 0xc0, 0xdb, 0x85, 0xd5,
+	0x72, 0xff, 0xc4, 0xfc, 0x7a, 0x47, 0x9b, 0x5f, 0x5c, 0x87, 0x74, 0x27, 0x8f, 0xa6, 0x36, 0xd7,
+	0x32, 0xb8, 0xc4, 0x67, 0xee, 0x56, 0x3e, 0xe8, 0xf9, 0x34, 0x4b, 0x06, 0x63, 0xe6, 0xd2, 0x2b,
+	0x54, 0x1b, 0x39, 0x99, 0x9c, 0xca, 0x64, 0xd2, 0xf4, 0x82, 0x5a, 0x45, 0x2f, 0xd0, 0xf5, 0x9a,
+	0xba, 0xa9, 0xd7, 0x78, 0x39, 0x5c, 0xb6, 0x57, 0x5a, 0x98, 0x09, 0x82, 0xc3, 0xc5, 0x48, 0xc9,
+	0x24, 0x96, 0xaa, 0x9c, 0xc9, 0x5c, 0x45, 0x50, 0xe9, 0xf3, 0xdc, 0xd5, 0xe8, 0xe5, 0x64, 0xf5,
+	0x85, 0x39, 0x77, 0x9a, 0x31, 0xf1, 0x8d, 0x25, 0x86, 0xbd, 0x1e, 0x1d, 0xe5, 0x54, 0x76, 0x51,
+	0xa5, 0x11, 0x97, 0xd2, 0x4f, 0x68, 0x2f, 0xa7, 0x52, 0x96, 0xa8, 0xb4, 0xf7, 0x29, 0xb4, 0x8d,
+	0x75, 0x00, 0x67, 0x34, 0xae, 0x6f, 0x42, 0x75, 0xca, 0x44, 0x61, 0x06, 0x8c, 0xa9, 0xb8, 0x5f,
+	0xbe, 0x13, 0x0c, 0x33, 0xa9, 0xd0, 0xf1, 0x14, 0x83, 0xbf, 0xc7, 0xe0, 0x75, 0x01, 0x7f, 0xaf,
+	0x80, 0xbf, 0x87, 0xf0, 0x86, 0x84, 0x63, 0xca, 0xfb, 0xbd, 0x06, 0x34, 0x50, 0x9d, 0x9c, 0xac,
+	0x7a, 0xea, 0x66, 0x52, 0xbd, 0xe2, 0xd6, 0x67, 0xee, 0x26, 0xbe, 0xfc, 0x73, 0x15, 0x49, 0x83,
+	0x14, 0xf8, 0x94, 0xf6, 0x8e, 0xbb, 0x53, 0x3a, 0x1e, 0x21, 0xcc, 0xa0, 0x0e, 0x73, 0xfe, 0xb5,
+	0x10, 0x6b, 0x32, 0x2d, 0x71, 0xec, 0xcb, 0x99, 0x02, 0xc7, 0xbe, 0xeb, 0xc2, 0x4c, 0x14, 0xef,
+	0x27, 0xe3, 0x58, 0x1a, 0xd3, 0x32, 0x89, 0x4c, 0x3f, 0x62, 0xe2, 0x35, 0x1a, 0x4a, 0xa1, 0x55,
+	0x00, 0xc8, 0x06, 0x2c, 0x30, 0xbe, 0x4a, 0xc3, 0x5c, 0xfa, 0x43, 0x81, 0xad, 0xc7, 0x17, 0xe5,
+	0x7a, 0x5c, 0x19, 0x55, 0xbf, 0xfc, 0x45, 0x69, 0x3d, 0x6f, 0xbe, 0xe4, 0x7a, 0x7e, 0x13, 0x3a,
+	0x87, 0x49, 0x96, 0x45, 0xa3, 0x20, 0x3b, 0x8d, 0x7b, 0x01, 0x5b, 0x19, 0x5b, 0xac, 0xed, 0x15,
+	0x38, 0x76, 0xfd, 0x80, 0x32, 0xc3, 0x27, 0xeb, 0xb6, 0xaf, 0xd5, 0x6f, 0xb4, 0x7d, 0x95, 0x46,
+	0x92, 0x0e, 0xc2, 0x4c, 0xba, 0x5b, 0xe7, 0xf9, 0xca, 0x53, 0x40, 0xc8, 0x5d, 0x58, 0x2e, 0x52,
+	0xbc, 0x6e, 0xb6, 0x45, 0xb0, 0xc0, 0x68, 0x61, 0xc5, 0x31, 0xe5, 0x70, 0x10, 0x8e, 0x82, 0x1e,
+	0x33, 0x10, 0x3a, 0xdc, 0x33, 0x52, 0x40, 0x90, 0x1f, 0xd9, 0x77, 0x0c, 0x14, 0x67, 0x4c, 0x29,
+	0xaa, 0xfb, 0x06, 0xcc, 0xfb, 0x08, 0xba, 0xcc, 0x2b, 0x31, 0xce, 0xf2, 0x64, 0x58, 0xb2, 0xf6,
+	0xa4, 0x26, 0xe0, 0x68, 0x9a, 0x00, 0x11, 0xd6, 0x7f, 0x8d, 0xad, 0x56, 0xec, 0x37, 0xc2, 0x98,
+	0x3f, 0x94, 0xdb, 0x21, 0xec, 0xb7, 0x77, 0x09, 0x2e, 0x5a, 0xca, 0x15, 0xa6, 0xcf, 0x35, 0xb8,
+	0xaa, 0xb6, 0xcf, 0x8c, 0x1c, 0xca, 0xe8, 0xff, 0x10, 0xda, 0x06, 0xe2, 0x0b, 0xb5, 0x85, 0xa0,
+	0xcb, 0x33, 0x63, 0xd6, 0x97, 0xaa, 0xe0, 0x1d, 0x58, 0xd4, 0x60, 0x85, 0x4b, 0x04, 0x0b, 0x2e,
+	0xbb, 0x44, 0x30, 0x93, 0xcf, 0x31, 0xde, 0x9f, 0x38, 0x70, 0xe5, 0x19, 0x53, 0x60, 0x77, 0x92,
+	0x3e, 0x5d, 0x8f, 0xe3, 0x64, 0x1c, 0xf7, 0xa8, 0xee, 0xe0, 0x5f, 0x86, 0x29, 0xae, 0xfc, 0xf1,
+	0xe9, 0xc8, 0x13, 0x08, 0xed, 0x25, 0x83, 0x44, 0x78, 0x73, 0x7d, 0x9e, 0x40, 0x2d, 0x22, 0xec,
+	0xf7, 0xb5, 0xdd, 0x9c, 0x3a, 0xdb, 0xcd, 0x31, 0x81, 0xc8, 0x83, 0xb8, 0xc6, 0x1c, 0x53, 0x2d,
+	0x63, 0x83, 0x65, 0xac, 0xc0, 0x71, 0xcc, 0x33, 0x9a, 0x07, 0x92, 0xef, 0xba, 0x53, 0x8c, 0x0f,
+	0x0d, 0x18, 0x9a, 0x30, 0xe3, 0x58, 0x87, 0x74, 0xa7, 0x59, 0xae, 0x12, 0xd4, 0xfb, 0xa1, 0x03,
+	0x57, 0x27, 0xf5, 0xb5, 0xb0, 0xe3, 0x5f, 0xba, 0xb3, 0x97, 0x61, 0xae, 0xdc, 0xd1, 0x02, 0x60,
+	0x4c, 0x9e, 0x86, 0x39, 0x79, 0xc4, 0xbe, 0x95, 0x2f, 0x76, 0x8d, 0x1f, 0xc5, 0x07, 0x89, 0x1c,
+	0xc6, 0xbf, 0xee, 0xc0, 0x5a, 0x05, 0x55, 0x6c, 0x72, 0xa8, 0xfd, 0xe7, 0x61, 0xd2, 0x97, 0x6b,
+	0xbe, 0x09, 0x44, 0x0b, 0x4a, 0x01, 0x0e, 0xa2, 0x38, 0xca, 0x8e, 0x84, 0xa8, 0x9f, 0xf5, 0xab,
+	0x08, 0x6c, 0xe5, 0x28, 0x4d, 0x0e, 0x95, 0x50, 0x75, 0x7c, 0x95, 0xf6, 0x3a, 0x18, 0xdc, 0x90,
+	0xeb, 0xad, 0xfb, 0xd3, 0x06, 0x2c, 0x28, 0x90, 0xda, 0xcf, 0x5d, 0x88, 0xfa, 0x34, 0xce, 0xa3,
+	0xfc, 0x34, 0x30, 0x9c, 0xee, 0x65, 0x70, 0x41, 0xdb, 0x9a, 0x4e, 0xdb, 0xbb, 0xb0, 0x8c, 0x0b,
+	0x8a, 0xb4, 0x8b, 0xd4, 0x92, 0xcf, 0x7d, 0xff, 0x56, 0x1c, 0x2a, 0x87, 0x08, 0x17, 0xd6, 0x83,
+	0xfa, 0x84, 0x7b, 0x04, 0x6c, 0x28, 0x1c, 0x2b, 0x5e, 0x12, 0xce, 0x86, 0x29, 0x6e, 0x68, 0x29,
+	0x40, 0x65, 0xa7, 0x73, 0x9a, 0xab, 0xae, 0xe5, 0x9d, 0x4e, 0x6d, 0xb7, 0x74, 0xb6, 0xb2, 0x5b,
+	0x8a, 0xaa, 0xed, 0x69, 0xdc, 0xa3, 0xfd, 0x20, 0x4f, 0x02, 0xa6, 0x82, 0x33, 0xb9, 0x3f, 0xeb,
+	0x97, 0xc1, 0xcc, 0x4d, 0x43, 0xb3, 0x3c, 0xa6, 0x5c, 0xea, 0xcf, 0xfa, 0x32, 0x89, 0x8b, 0x23,
+	0xcb, 0xc2, 0x0d, 0x8a, 0x39, 0x5f, 0xa4, 0x50, 0x08, 0x8c, 0xd3, 0x28, 0xeb, 0xb6, 0x18, 0x94,
+	0xfd, 0x26, 0x6f, 0xc3, 0xca, 0x3e, 0xcd, 0xf2, 0xe0, 0x88, 0x86, 0x7d, 0xaa, 0x4b, 0x58, 0x6e,
+	0xc7, 0xda, 0x91, 0x58, 0xf7, 0x31, 0x4d, 0xb3, 0x28, 0x89, 0x85, 0xcc, 0x96, 0x49, 0x2c, 0x0f,
+	0x09, 0x12, 0xc5, 0x25, 0xd2, 0x31, 0x89, 0xdd, 0xf6, 0xed, 0x48, 0xb3, 0xd7, 0x87, 0x69, 0x38,
+	0x3a, 0xea, 0x76, 0xca, 0xbd, 0x66, 0x60, 0x63, 0x3e, 0x2c, 0x96, 0x16, 0x93, 0x2e, 0xcc, 0xc4,
+	0x34, 0x3f, 0x49, 0xd2, 0xe7, 0xcc, 0x9a, 0x9d, 0xf3, 0x65, 0xd2, 0xfb, 0x01, 0xf3, 0x94, 0xa9,
+	0x4d, 0x6b, 0x3e, 0x7b, 0xd1, 0x3d, 0xcc, 0x29, 0x9f, 0x1d, 0x85, 0x42, 0x82, 0xce, 0x32, 0xc0,
+	0xde, 0x51, 0x88, 0x5a, 0xad, 0x31, 0x98, 0xdc, 0x7d, 0xdc, 0x64, 0xb0, 0x2d, 0x3e, 0x96, 0xd7,
+	0x61, 0x5e, 0x6e, 0x87, 0x67, 0xc1, 0x80, 0x1e, 0xe4, 0x72, 0xa7, 0x29, 0x1e, 0x0f, 0xb1, 0xba,
+	0x6c, 0x9b, 0x1e, 0xe4, 0xde, 0x0e, 0x2c, 0x0a, 0x4d, 0xf3, 0xc9, 0x88, 0xca, 0xaa, 0xdf, 0xb3,
+	0x59, 0x6c, 0x13, 0x02, 0x00, 0xcc, 0x9c, 0x9e, 0x0f, 0x44, 0xd7, 0x5c, 0x45, 0x81, 0xc2, 0x6c,
+	0x92, 0xfb, 0x59, 0xa2, 0x3b, 0x06, 0x8c, 0x69, 0x8f, 0xe3, 0x5e, 0x4f, 0x06, 0x34, 0xcc, 0xfa,
+	0x32, 0xe9, 0xfd, 0x5f, 0x07, 0x96, 0x58, 0x69, 0xa2, 0x64, 0x29, 0xb4, 0xdf, 0xfd, 0x1c, 0xcd,
+	0x6c, 0xf5, 0xb4, 0x14, 0xce, 0x52, 0xdd, 0x5e, 0xe0, 0x89, 0xcf, 0xbf, 0x6b, 0xd1, 0xa8, 0xec,
+	0x5a, 0xdc, 0x84, 0x4e, 0x9f, 0x0e, 0x22, 0x26, 0x7f, 0xa4, 0x1e, 0xc7, 0x8d, 0xcc, 0x0a, 0xbc,
+	0xba, 0x03, 0x31, 0x6d, 0xdb, 0x81, 0xf8, 0x4f, 0x0e, 0x2c, 0x72, 0x23, 0x20, 0x0f, 0xf3, 0x71,
+	0x26, 0x08, 0xfa, 0x15, 0x68, 0x73, 0x6b, 0x4e, 0x88, 0x8d, 0xae, 0x63, 0xa8, 0x46, 0xbb, 0x1c,
+	0xca, 0x33, 0x6f, 0x5d, 0xf0, 0xcd, 0xcc, 0xe4, 0x6b, 0xd0, 0xd2, 0xa3, 0x24, 0xba, 0x35, 0x43,
+	0x2f, 0xab, 0xf2, 0xe2, 0xd6, 0x05, 0xdf, 0xf8, 0x80, 0x7c, 0xc0, 0x4c, 0xf2, 0x38, 0x60, 0xc5,
+	0x76, 0xeb, 0xe6, 0xe7, 0x95, 0xe1, 0xdf, 0xba, 0xe0, 0x6b, 0xd9, 0xef, 0xcd, 0xa2, 0xef, 0x06,
+	0xe1, 0xde, 0x43, 0x68, 0x1b, 0x2d, 0x35, 0x76, 0x56, 0x5a, 0x7c, 0x67, 0xa5, 0xb2, 0x5f, 0x5a,
+	0xab, 0xee, 0x97, 0x7a, 0x7f, 0x54, 0x07, 0x82, 0xfc, 0x5b, 0x62, 0x10, 0x74, 0x67, 0x25, 0x7d,
+	0xc3, 0x39, 0xd9, 0xf2, 0x75, 0x10, 0xb9, 0x05, 0x44, 0x4b, 0xca, 0xed, 0x66, 0xae, 0x79, 0x5b,
+	0x30, 0x4c, 0xe3, 0xe3, 0xe6, 0xa6, 0x30, 0x0c, 0x85, 0xa3, 0xb7, 0x21, 0x34, 0x3e, 0x0b, 0x8e,
+	0x2d, 0x3f, 0x63, 0xdc, 0xcb, 0x0e, 0x73, 0xe9, 0xbe, 0x94, 0xe9, 0x32, 0xcb, 0x4d, 0x9f, 0xcb,
+	0x72, 0x33, 0x15, 0x96, 0xd3, 0x1c, 0x68, 0xb3, 0xa6, 0x03, 0xed, 0x3a, 0xb4, 0x71, 0xf7, 0x89,
+	0xe9, 0xd4, 0xcc, 0xcb, 0x2b, 0xbc, 0x95, 0x06, 0x50, 0x2a, 0x2a, 0x39, 0x0d, 0x0a, 0x2f, 0x1d,
+	0x30, 0x1a, 0x57, 0xe0, 0xb8, 0xc2, 0x14, 0xfb, 0x59, 0x4d, 0xd6, 0xd8, 0x02, 0x60, 0xdf, 0x80,
+	0x6b, 0x4d, 0xda, 0x80, 0xfb, 0x59, 0x98, 0x1b, 0x65, 0xfb, 0x79, 0x90, 0x1d, 0x45, 0xc3, 0x6e,
+	0xdb, 0x88, 0x94, 0xd8, 0xcd, 0xf6, 0xf3, 0xbd, 0xa3, 0x68, 0xe8, 0x17, 0x39, 0xbc, 0x14, 0x66,
+	0x25, 0x18, 0x05, 0xb2, 0xbe, 0x5c, 0x06, 0x8a, 0x63, 0xca, 0x60, 0x6c, 0xf0, 0x7e, 0x88, 0x9c,
+	0x9f, 0xed, 0xe7, 0x62, 0xfc, 0x0b, 0x00, 0x2e, 0x77, 0x71, 0x12, 0x30, 0x4f, 0x9b, 0xf0, 0x2c,
+	0xcd, 0xfa, 0x1a, 0xc4, 0xfb, 0x23, 0x07, 0xd6, 0xee, 0x85, 0x79, 0xef, 0xc8, 0xc2, 0x5b, 0x6f,
+	0x55, 0x2c, 0x77, 0xb9, 0x99, 0x51, 0xf9, 0x42, 0x65, 0x44, 0x86, 0xac, 0xee, 0x08, 0xeb, 0x20,
+	0xe2, 0x95, 0xc6, 0x9b, 0x1b, 0x96, 0x06, 0xcc, 0x1c, 0x85, 0xc6, 0x4b, 0x8d, 0xc2, 0xd4, 0x84,
+	0x51, 0xf0, 0xfe, 0xcc, 0x81, 0x4e, 0xb9, 0xc1, 0xe5, 0x79, 0xe3, 0x54, 0xe7, 0xcd, 0xa4, 0x79,
+	0x50, 0x7b, 0xc9, 0x79, 0x50, 0x2f, 0xcd, 0x03, 0x8d, 0x89, 0x1b, 0xe7, 0x30, 0xf1, 0xd4, 0xcb,
+	0x32, 0xf1, 0xb4, 0x9d, 0x89, 0xbd, 0xef, 0x42, 0xb7, 0x3a, 0xa8, 0x42, 0xd1, 0xfb, 0x79, 0xe8,
+	0x54, 0x94, 0x34, 0x73, 0x6f, 0xcf, 0x10, 0x58, 0x7e, 0x25, 0xb7, 0xf7, 0xaf, 0x6b, 0xd0, 0xc1,
+	0x92, 0x0d, 0x71, 0xfd, 0x3e, 0xb0, 0xf5, 0xe7, 0x25, 0xa5, 0xb5, 0x91, 0xf7, 0x8b, 0x0b, 0xeb,
+	0x77, 0x61, 0x8e, 0x15, 0x98, 0x8c, 0x68, 0x2c, 0x64, 0x75, 0xd7, 0x94, 0xd5, 0xc5, 0xd2, 0xbf,
+	0x75, 0xc1, 0x2f, 0x32, 0x93, 0xf7, 0xc5, 0x14, 0xc5, 0x81, 0x14, 0x41, 0x80, 0xd2, 0x3d, 0xe5,
+	0xd3, 0xb0, 0x7f, 0xfa, 0x20, 0x49, 0x71, 0x4e, 0x3e, 0xe0, 0xe3, 0x8c, 0xdf, 0xaa, 0xec, 0xb6,
+	0x39, 0xda, 0xb0, 0xce, 0x51, 0x6d, 0x3d, 0xf8, 0x14, 0x96, 0x2c, 0xe5, 0x62, 0x51, 0x8a, 0x95,
+	0x8c, 0x2d, 0xe4, 0x32, 0x18, 0x8d, 0x24, 0x2b, 0x43, 0x96, 0xa0, 0xcc, 0x30, 0x45, 0x89, 0x20,
+	0x0c, 0x4e, 0xfc, 0xed, 0xfd, 0xb1, 0x03, 0xcb, 0xa2, 0x46, 0x16, 0x24, 0x17, 0x21, 0xf1, 0x1e,
+	0x67, 0x87, 0xe4, 0x2b, 0xd0, 0x44, 0x09, 0x24, 0x7c, 0x80, 0x5d, 0xc7, 0xa0, 0xa0, 0xf8, 0x02,
+	0xc5, 0x12, 0x77, 0x06, 0x6e, 0x5d, 0xf0, 0xf5, 0xec, 0xf8, 0x35, 0x23, 0xca, 0x31, 0xdb, 0x52,
+	0xed, 0xd6, 0x6c, 0x5f, 0x63, 0x67, 0xf9, 0x96, 0x2b, 0x7e, 0xad, 0x65, 0x27, 0xf7, 0xa0, 0xcd,
+	0x49, 0x1a, 0xc5, 0xe1, 0x20, 0xfa, 0x81, 0x5c, 0x6b, 0xdd, 0xea, 0xf7, 0x0f, 0x44, 0x0e, 0x5c,
+	0xed, 0x8d, 0x4f, 0xee, 0xcd, 0xc1, 0x4c, 0x9e, 0x46, 0x87, 0x87, 0x34, 0xf5, 0xbe, 0x0a, 0x8b,
+	0x95, 0x06, 0xbf, 0xbc, 0x34, 0xf5, 0x02, 0x58, 0xd4, 0x6a, 0xe4, 0x2d, 0x46, 0x61, 0x81, 0xd4,
+	0xa5, 0x7d, 0x2e, 0x64, 0x85, 0xb0, 0xd0, 0x40, 0xb6, 0x0a, 0x6a, 0xf6, 0x0a, 0x7e, 0xc5, 0x81,
+	0x25, 0x4b, 0x9f, 0xb0, 0x0e, 0xdc, 0x9f, 0x2e, 0xd5, 0xa1, 0x81, 0x5e, 0xbe, 0x0e, 0x94, 0xb0,
+	0x8c, 0x34, 0x41, 0x1a, 0x9e, 0x04, 0xf9, 0x0b, 0xc1, 0x03, 0x06, 0x0c, 0xc3, 0x1a, 0x24, 0x9d,
+	0xf2, 0x30, 0xa7, 0x7b, 0x39, 0x1d, 0xa1, 0x88, 0xf0, 0xfe, 0xa3, 0x03, 0x4d, 0x31, 0x5b, 0x7f,
+	0xec, 0x5d, 0x60, 0xdd, 0x17, 0x5a, 0x2f, 0xf9, 0x42, 0x6f, 0xc0, 0xc2, 0x10, 0xcd, 0x05, 0x34,
+	0x28, 0x8d, 0x1d, 0xe0, 0x32, 0x18, 0xad, 0x43, 0xa6, 0xec, 0x67, 0x41, 0x1e, 0x0d, 0x02, 0x89,
+	0x15, 0xe1, 0xab, 0x36, 0x14, 0xea, 0xbc, 0x59, 0x8e, 0xd1, 0x80, 0x5c, 0x2e, 0xf2, 0x04, 0x5a,
+	0xe9, 0xa2, 0x43, 0x25, 0xdf, 0xbb, 0xf7, 0xa3, 0x36, 0xac, 0x55, 0x50, 0x2a, 0x3a, 0x5f, 0x6c,
+	0x3c, 0x0e, 0xa2, 0xe1, 0x7e, 0xa2, 0x36, 0x2e, 0x1c, 0x7d, 0x4f, 0xd2, 0x40, 0x91, 0x43, 0x58,
+	0x91, 0x03, 0x81, 0xa2, 0xa5, 0x90, 0xae, 0x35, 0x26, 0x5d, 0xdf, 0x34, 0x45, 0x61, 0xb9, 0x42,
+	0x09, 0xd7, 0x45, 0xb6, 0xbd, 0x3c, 0x72, 0x04, 0x5d, 0x35, 0xe2, 0xc2, 0xbc, 0xd0, 0xcc, 0x6d,
+	0xac, 0xeb, 0x8d, 0x73, 0xea, 0x32, 0x5c, 0xf5, 0xfe, 0xc4, 0xd2, 0xc8, 0x29, 0x5c, 0x95, 0x38,
+	0x66, 0x3f, 0x54, 0xeb, 0x6b, 0xbc, 0x54, 0xdf, 0xd8, 0x26, 0x84, 0x59, 0xe9, 0x39, 0x05, 0x93,
+	0x4f, 0x60, 0xf5, 0x24, 0x8c, 0x72, 0xd9, 0x2c, 0xcd, 0x90, 0x9d, 0x62, 0x55, 0xde, 0x3d, 0xa7,
+	0xca, 0x8f, 0xf9, 0xc7, 0x86, 0x51, 0x35, 0xa1, 0x44, 0xf7, 0x0f, 0x1c, 0x98, 0x37, 0xcb, 0x41,
+	0x36, 0x15, 0xab, 0xaa, 0xd4, 0x09, 0xa4, 0x40, 0x2e, 0x81, 0xab, 0x7b, 0x7f, 0x35, 0xdb, 0xde,
+	0x9f, 0xbe, 0xe3, 0x56, 0x3f, 0x6f, 0x83, 0xbf, 0xf1, 0x72, 0x1b, 0xfc, 0x53, 0xb6, 0x0d, 0x7e,
+	0xf7, 0x8f, 0x6b, 0x40, 0xaa, 0xbc, 0x44, 0x1e, 0xf2, 0xfd, 0x92, 0x58, 0x89, 0xf7, 0x9f, 0x7d,
+	0x39, 0x7e, 0x94, 0xb4, 0x93, 0x5f, 0xe3, 0xc4, 0xd0, 0xd7, 0x5e, 0xdd, 0x3c, 0x6f, 0xfb, 0x36,
+	0x54, 0x29, 0xe4, 0xa0, 0x71, 0x7e, 0xc8, 0xc1, 0xd4, 0xf9, 0x21, 0x07, 0xd3, 0x95, 0x90, 0x83,
+	0xf7, 0xa1, 0x2b, 0x97, 0xc0, 0xfd, 0x34, 0x09, 0xfb, 0xbd, 0x90, 0x39, 0x4e, 0xb4, 0x3d, 0xcc,
+	0x89, 0x78, 0x66, 0x23, 0x29, 0x47, 0x02, 0xc6, 0x49, 0x47, 0xa9, 0x08, 0xac, 0x6b, 0xfb, 0x16,
+	0x8c, 0xfb, 0xcb, 0x0e, 0x2c, 0x59, 0x18, 0xec, 0x27, 0x47, 0x64, 0x64, 0x09, 0x43, 0xee, 0xd4,
+	0x04, 0x4b, 0xe8, 0x40, 0xf7, 0x97, 0xa0, 0x6d, 0x4c, 0xaa, 0x9f, 0x5c, 0xfd, 0x65, 0x6f, 0x06,
+	0xe7, 0x69, 0x03, 0xe6, 0xfe, 0xef, 0x1a, 0x90, 0xea, 0xc4, 0xfe, 0xff, 0xda, 0x86, 0x2a, 0x9d,
+	0xea, 0x16, 0x3a, 0xfd, 0x85, 0xae, 0x39, 0x85, 0x9f, 0x56, 0xdb, 0xde, 0xe6, 0xdc, 0x59, 0x45,
+	0xa0, 0x3f, 0xc7, 0x8c, 0x2d, 0x99, 0x35, 0x8e, 0x2e, 0x68, 0x0b, 0x6f, 0x29, 0xc4, 0x04, 0xd7,
+	0x6b, 0x1e, 0x73, 0x77, 0x8f, 0x17, 0x25, 0xd7, 0xb0, 0x63, 0x09, 0x5f, 0xef, 0xb1, 0xdd, 0x15,
+	0x81, 0xc6, 0x76, 0x29, 0x13, 0xa8, 0xb4, 0x7a, 0x55, 0x11, 0xd8, 0xef, 0x71, 0x5c, 0x01, 0x0b,
+	0xae, 0xb3, 0xa1, 0xbc, 0xbf, 0x5c, 0x87, 0x95, 0x52, 0x83, 0x0a, 0xff, 0x36, 0x5f, 0x1e, 0xcd,
+	0x5a, 0x4d, 0xa0, 0xbd, 0x7d, 0xb5, 0xcf, 0xd9, 0xbe, 0xfa, 0xc4, 0xf6, 0x91, 0x6f, 0xc3, 0x42,
+	0xc8, 0x29, 0xa2, 0x89, 0x55, 0xa4, 0xf5, 0x1d, 0x23, 0x82, 0xb1, 0xd4, 0xf8, 0x5b, 0x26, 0x15,
+	0x79, 0xc8, 0x73, 0xb9, 0x20, 0x14, 0xc5, 0xa5, 0x78, 0x06, 0x21, 0x8a, 0x4d, 0xa8, 0xfb, 0x8b,
+	0xb0, 0x64, 0x29, 0xcf, 0x12, 0xfe, 0xfc, 0xa6, 0x1e, 0xfe, 0xdc, 0xbc, 0x7b, 0xc9, 0x0c, 0xb2,
+	0x34, 0x8a, 0xd0, 0x63, 0xa3, 0x6f, 0xc1, 0xb4, 0x88, 0xc3, 0xee, 0x40, 0x5d, 0x86, 0x42, 0x37,
+	0x7c, 0xfc, 0x89, 0xfa, 0x3f, 0xb3, 0x25, 0xf9, 0xee, 0x30, 0xfb, 0x8d, 0xe7, 0xc6, 0xc4, 0x2c,
+	0x2b, 0x31, 0xd1, 0x0f, 0x1b, 0xb0, 0x5a, 0xc6, 0x14, 0x3b, 0xde, 0xe6, 0x38, 0xca, 0x24, 0x1a,
+	0xc8, 0x86, 0x7e, 0x62, 0x0e, 0xa2, 0x15, 0x47, 0xde, 0x2a, 0x2f, 0x75, 0x5c, 0xd9, 0x6f, 0xcb,
+	0x48, 0x58, 0xd6, 0x9b, 0xf2, 0xca, 0xf7, 0xe5, 0xca, 0xca, 0xd7, 0xb0, 0x7d, 0x55, 0xca, 0x44,
+	0x1e, 0xc2, 0x5a, 0x11, 0x6a, 0x66, 0xd6, 0x3a, 0x65, 0xfb, 0x7e, 0x52, 0x6e, 0xf2, 0x08, 0xba,
+	0x05, 0xaa, 0xd4, 0x92, 0x69, 0x5b, 0x49, 0x13, 0xb3, 0x93, 0xc7, 0xe0, 0x1a, 0x74, 0x31, 0x9b,
+	0x35, 0x63, 0x2b, 0xec, 0x8c, 0x0f, 0xc8, 0x13, 0xb8, 0x64, 0x60, 0x4b, 0x8d, 0x9b, 0xb5, 0x95,
+	0x77, 0xd6, 0x17, 0xde, 0xef, 0x38, 0x40, 0xbe, 0x31, 0xa6, 0xe9, 0x29, 0x3b, 0x5d, 0xa2, 0x82,
+	0x54, 0xd6, 0xca, 0x9b, 0xf9, 0x18, 0x01, 0xfb, 0x21, 0x3d, 0x95, 0x67, 0x98, 0x6a, 0xc5, 0x19,
+	0xa6, 0x2b, 0x00, 0xb8, 0x60, 0xaa, 0x23, 0x2b, 0xcc, 0x75, 0x13, 0x8f, 0x87, 0xbc, 0x40, 0xeb,
+	0x31, 0xa3, 0xc6, 0xf9, 0xc7, 0x8c, 0xa6, 0xce, 0x39, 0x66, 0xe4, 0x7d, 0x00, 0x4b, 0x46, 0xbb,
+	0x95, 0x2c, 0x92, 0x87, 0x67, 0x9c, 0xc9, 0x87, 0x67, 0xf0, 0x30, 0x40, 0x7d, 0x2b, 0x19, 0x9d,
+	0x11, 0x53, 0x22, 0x94, 0xbc, 0x40, 0xe9, 0x70, 0x62, 0x3d, 0x36, 0x80, 0xe4, 0x26, 0xcc, 0x87,
+	0xc3, 0x1c, 0xf7, 0x4a, 0x44, 0x5c, 0x05, 0x17, 0x50, 0xf7, 0x6a, 0x5d, 0xc7, 0x2f, 0x61, 0xc8,
+	0x32, 0xd4, 0x95, 0x36, 0xc4, 0x32, 0x60, 0x12, 0x2d, 0x2a, 0x16, 0x08, 0x7b, 0x2a, 0x36, 0xb7,
+	0x44, 0x0a, 0xe5, 0x9f, 0xf9, 0x3d, 0x77, 0x14, 0xf1, 0x75, 0xc6, 0x86, 0xe2, 0xfb, 0x34, 0xb4,
+	0x08, 0x7d, 0xad, 0xfb, 0x2a, 0xad, 0xc7, 0x66, 0xcc, 0x9a, 0x61, 0xc1, 0x7f, 0xe2, 0xc0, 0x14,
+	0xa3, 0x0d, 0xae, 0x99, 0x5c, 0x60, 0xab, 0x18, 0x2d, 0x46, 0x93, 0xb6, 0x5f, 0x06, 0x13, 0xcf,
+	0x38, 0x1b, 0x58, 0x53, 0x1d, 0xd2, 0xa0, 0xe4, 0x1a, 0xcc, 0xf1, 0x94, 0x3a, 0xf1, 0xc6, 0xb2,
+	0x14, 0x40, 0x72, 0x15, 0xcf, 0xf4, 0x8c, 0xa4, 0x41, 0x01, 0x2a, 0x00, 0x62, 0xe4, 0x33, 0x78,
+	0xd1, 0x1e, 0x2c, 0x4f, 0x77, 0x93, 0x95, 0xc1, 0x28, 0x9d, 0x55, 0xb1, 0x3a, 0x99, 0x4a, 0x50,
+	0xef, 0x19, 0x2c, 0xe0, 0xfe, 0xb1, 0xb6, 0x31, 0x3a, 0x99, 0xcf, 0x7f, 0x1a, 0x3a, 0x51, 0xdc,
+	0x1b, 0x8c, 0xfb, 0x54, 0x37, 0xeb, 0xd8, 0x06, 0x99, 0x80, 0x4b, 0xb5, 0xc6, 0xfb, 0xe7, 0x0e,
+	0xcc, 0xca, 0x72, 0xc9, 0x0d, 0x68, 0xc4, 0x72, 0x8b, 0xb7, 0xf0, 0x86, 0xa9, 0x58, 0x70, 0xcc,
+	0xe7, 0xb3, 0x1c, 0x32, 0x48, 0xc7, 0x28, 0xbd, 0xed, 0x1b, 0xb0, 0xa2, 0x67, 0x25, 0x53, 0xa2,
+	0x04, 0x25, 0xb7, 0x34, 0xc7, 0x6d, 0xc3, 0x50, 0x30, 0x44, 0x2b, 0x37, 0xfb, 0x87, 0x54, 0x0b,
+	0xb5, 0xfa, 0x43, 0x07, 0xda, 0x46, 0x9b, 0xd0, 0x1b, 0xc1, 0xc2, 0x31, 0xb8, 0xd7, 0x4a, 0x8c,
+	0xbc, 0x0e, 0xd2, 0x79, 0xa8, 0x66, 0xc6, 0xf7, 0xa8, 0xfd, 0xe1, 0xba, 0xbe, 0x3f, 0x7c, 0x47,
+	0xdf, 0x65, 0x37, 0x1b, 0xc5, 0x76, 0xf1, 0x2b, 0x27, 0x09, 0x8a, 0xdd, 0xfa, 0x29, 0x7d, 0xb7,
+	0xfe, 0x06, 0x2c, 0x1c, 0x0e, 0x92, 0x7d, 0x36, 0xe2, 0x2a, 0x4a, 0x80, 0x79, 0x41, 0x4a, 0x60,
+	0xef, 0x03, 0x68, 0x6a, 0x25, 0xeb, 0x9b, 0x93, 0x8e, 0xb1, 0x39, 0xa9, 0x8e, 0xfd, 0xd4, 0x8a,
+	0x63, 0x3f, 0xde, 0x9f, 0x3a, 0xd0, 0xc6, 0x89, 0x80, 0x6e, 0x9a, 0x64, 0x10, 0xf5, 0x4e, 0x19,
+	0x03, 0x4a, 0x9e, 0x17, 0x82, 0x4b, 0x4e, 0x08, 0x13, 0xcc, 0xce, 0xe2, 0x09, 0xd7, 0xad, 0x90,
+	0x13, 0x2a, 0x8d, 0x82, 0x04, 0xa7, 0x21, 0x73, 0xd0, 0x0f, 0x0b, 0x37, 0xb1, 0x09, 0xc4, 0xe9,
+	0x8e, 0x00, 0x16, 0x25, 0x34, 0x8c, 0x06, 0x83, 0x88, 0xe7, 0xe5, 0xa6, 0x93, 0x0d, 0x85, 0x75,
+	0xf6, 0xa3, 0x2c, 0xdc, 0x2f, 0x02, 0xf8, 0x54, 0x1a, 0xeb, 0xc4, 0x53, 0x38, 0x85, 0x7f, 0x59,
+	0xec, 0xc2, 0x19, 0x40, 0xef, 0xdf, 0xd4, 0xa0, 0xa9, 0xb1, 0x87, 0x88, 0x49, 0xc5, 0x64, 0x21,
+	0x0f, 0x35, 0x88, 0xc4, 0x1b, 0x46, 0xaf, 0x06, 0x29, 0xb3, 0x50, 0xbd, 0xca, 0x42, 0xb8, 0x99,
+	0x9f, 0xf4, 0xe9, 0x9b, 0xcc, 0xba, 0xe6, 0xf1, 0xac, 0x05, 0x40, 0x62, 0xef, 0x32, 0xec, 0x54,
+	0x81, 0x65, 0x80, 0x33, 0x23, 0x58, 0xdf, 0x85, 0x96, 0x28, 0x86, 0x8d, 0x5c, 0x77, 0xc6, 0x98,
+	0x7c, 0xc6, 0xa8, 0xfa, 0x46, 0x4e, 0xf9, 0xe5, 0x5d, 0xf9, 0xe5, 0xec, 0x79, 0x5f, 0xca, 0x9c,
+	0xde, 0x43, 0x15, 0x18, 0xfc, 0x10, 0xb7, 0xc9, 0xa5, 0x40, 0xb9, 0x03, 0x4b, 0x52, 0x6e, 0x8c,
+	0xe3, 0x50, 0x44, 0xaa, 0xc8, 0xd8, 0x41, 0x1b, 0xca, 0xeb, 0x43, 0x4b, 0x2f, 0x88, 0xdc, 0x84,
+	0x29, 0xac, 0xa8, 0xec, 0xa3, 0x37, 0x45, 0x08, 0xcf, 0x82, 0x67, 0xf2, 0x69, 0xff, 0x90, 0x4a,
+	0x8f, 0x93, 0x6d, 0xd2, 0xf3, 0x0c, 0xde, 0x4d, 0x58, 0x40, 0x68, 0x49, 0xf6, 0x99, 0x8b, 0x1f,
+	0x46, 0x2d, 0xc4, 0x8f, 0xfa, 0x78, 0x0b, 0xc3, 0x0e, 0x9f, 0x29, 0x5a, 0x76, 0xef, 0xb7, 0xeb,
+	0xd0, 0xd4, 0xc0, 0x28, 0x9b, 0x58, 0x80, 0x40, 0xd0, 0x8f, 0xc2, 0x21, 0xcd, 0x45, 0x48, 0x54,
+	0xdb, 0x2f, 0x41, 0x31, 0x5f, 0x78, 0x7c, 0x18, 0x24, 0xe3, 0x3c, 0xe8, 0xd3, 0xc3, 0x94, 0x72,
+	0x7d, 0xd1, 0xf1, 0x4b, 0x50, 0xcc, 0x87, 0xfc, 0xa9, 0xe5, 0xe3, 0x1c, 0x54, 0x82, 0xca, 0x88,
+	0x10, 0x4e, 0xa3, 0x46, 0x11, 0x11, 0xc2, 0x29, 0x52, 0x96, 0xaa, 0x53, 0x16, 0xa9, 0xfa, 0x0e,
+	0xac, 0x72, 0xf9, 0x29, 0xe4, 0x41, 0x50, 0x62, 0xac, 0x09, 0x58, 0xdc, 0x90, 0xc1, 0x36, 0xcb,
+	0x29, 0x91, 0xa1, 0xef, 0x7a, 0x86, 0xf5, 0xa5, 0x02, 0xc7, 0xbc, 0x6c, 0xfb, 0x4a, 0xcf, 0xcb,
+	0x23, 0xa6, 0x2b, 0x70, 0x96, 0x37, 0x7c, 0x61, 0xc0, 0xc4, 0xb6, 0x66, 0x05, 0x8e, 0x79, 0xb1,
+	0x2f, 0x3f, 0x48, 0x86, 0xfb, 0x11, 0x5f, 0x9a, 0x32, 0xb6, 0xb3, 0xd9, 0xf0, 0x2b, 0x70, 0xaf,
+	0x0d, 0xcd, 0xbd, 0x3c, 0x19, 0xc9, 0x01, 0x9c, 0x87, 0x16, 0x4f, 0x8a, 0xe0, 0xb7, 0x4b, 0x70,
+	0x91, 0x71, 0xdc, 0xd3, 0x64, 0x94, 0x0c, 0x92, 0xc3, 0x53, 0x11, 0x09, 0x37, 0xca, 0xa3, 0x24,
+	0xf6, 0xfe, 0x83, 0x03, 0x4b, 0x06, 0x56, 0xec, 0xfa, 0xbc, 0xcd, 0x27, 0x8c, 0x3a, 0x4e, 0xc1,
+	0x99, 0x74, 0x51, 0x13, 0xec, 0x3c, 0x23, 0xdf, 0x5a, 0xe3, 0xbf, 0x33, 0xb2, 0x0e, 0x0b, 0xb2,
+	0x17, 0xf2, 0x43, 0xce, 0xb1, 0xdd, 0x2a, 0xc7, 0x8a, 0xef, 0xe7, 0xc5, 0x07, 0xb2, 0x88, 0xaf,
+	0x8a, 0x28, 0xf5, 0xbe, 0xe8, 0x74, 0xdd, 0x8c, 0x2c, 0xd6, 0x3d, 0x12, 0xb2, 0x05, 0x3d, 0x05,
+	0xcc, 0xbc, 0xbf, 0xe9, 0x00, 0x14, 0xad, 0x33, 0x43, 0xc0, 0x9c, 0x72, 0x08, 0xd8, 0xab, 0xd0,
+	0x52, 0x31, 0x50, 0xc5, 0x7a, 0xd7, 0x94, 0x30, 0xd4, 0x0f, 0x5e, 0xaf, 0xae, 0x4a, 0xdc, 0xe9,
+	0x3e, 0xcf, 0xc1, 0x0f, 0x04, 0xb4, 0x58, 0x1c, 0x1b, 0xda, 0xe2, 0xe8, 0xfd, 0xad, 0x1a, 0x2c,
+	0x56, 0xfa, 0x3c, 0x71, 0x46, 0x92, 0xbb, 0x15, 0xd1, 0x3b, 0x21, 0x24, 0x84, 0x6d, 0x74, 0xed,
+	0x9e, 0xeb, 0x80, 0xfc, 0x00, 0xe6, 0x53, 0x2e, 0xdb, 0xa4, 0xe0, 0x6b, 0x9c, 0x21, 0xf8, 0xda,
+	0xa9, 0x9e, 0x44, 0xd5, 0x28, 0xec, 0x1f, 0xd3, 0x34, 0x8f, 0x98, 0x5b, 0x86, 0xa9, 0x3b, 0x5c,
+	0x5c, 0x2f, 0x68, 0x70, 0xa6, 0x55, 0xbc, 0x0e, 0x0b, 0xe2, 0xc4, 0x99, 0xca, 0x29, 0x2e, 0x23,
+	0x28, 0xc0, 0x98, 0xd1, 0xfb, 0x4d, 0x19, 0x0e, 0x63, 0x8e, 0xe1, 0x64, 0x8a, 0xe8, 0xbd, 0xab,
+	0x95, 0x7a, 0xf7, 0x25, 0x11, 0x48, 0xd2, 0x97, 0xbe, 0x9f, 0xba, 0x76, 0xa2, 0xa1, 0x2f, 0x42,
+	0x89, 0x4c, 0x92, 0x36, 0x5e, 0x86, 0xa4, 0xa8, 0x36, 0xcd, 0x6c, 0x25, 0xa3, 0x2d, 0x71, 0xb6,
+	0x83, 0x4d, 0x04, 0x75, 0x66, 0x56, 0x26, 0xcf, 0x38, 0xf5, 0x61, 0xd5, 0x05, 0xda, 0x65, 0x5d,
+	0xe0, 0xe7, 0xe1, 0x12, 0x02, 0x46, 0x69, 0x32, 0x4a, 0x52, 0x9c, 0x8c, 0xe1, 0x80, 0x2f, 0xfc,
+	0x49, 0x9c, 0x1f, 0x49, 0x91, 0x77, 0x56, 0x16, 0xe6, 0x6a, 0x41, 0x6b, 0x8b, 0xdb, 0x12, 0x42,
+	0x77, 0xe1, 0x92, 0xb0, 0x8a, 0xf0, 0xde, 0x83, 0x39, 0x66, 0x01, 0xb0, 0x6e, 0xbd, 0x01, 0x73,
+	0x47, 0xc9, 0x28, 0x38, 0x8a, 0xe2, 0x5c, 0x4e, 0xee, 0xf9, 0x42, 0x35, 0xdf, 0x62, 0x04, 0x51,
+	0x19, 0xbc, 0x7f, 0x31, 0x05, 0x33, 0x8f, 0xe2, 0xe3, 0x24, 0xea, 0xb1, 0x38, 0x97, 0x21, 0x1d,
+	0x26, 0xf2, 0x04, 0x31, 0xfe, 0x46, 0x52, 0xb0, 0x73, 0x58, 0x23, 0x19, 0xa8, 0x20, 0x93, 0xa8,
+	0x4c, 0xa4, 0xc5, 0x65, 0x0e, 0x7c, 0xea, 0x68, 0x10, 0xb4, 0x8b, 0x52, 0xfd, 0x32, 0x06, 0x91,
+	0x2a, 0xce, 0x8d, 0x4f, 0x69, 0xe7, 0xc6, 0xf5, 0x28, 0xfd, 0x69, 0x33, 0x4a, 0x1f, 0xed, 0xb8,
+	0x94, 0x72, 0xef, 0x34, 0x53, 0x4b, 0x66, 0x84, 0x1d, 0xa7, 0x03, 0xd9, 0x5e, 0x1c, 0xfb, 0x80,
+	0xe7, 0xe1, 0x82, 0x5a, 0x07, 0xb1, 0xbd, 0xb8, 0xd2, 0xb5, 0x1a, 0xfc, 0x46, 0x93, 0x32, 0x98,
+	0x87, 0x4b, 0x29, 0x41, 0xca, 0xfb, 0x00, 0xfc, 0xb2, 0x8a, 0x32, 0x5c, 0xb3, 0xfe, 0xf8, 0x51,
+	0x39, 0x91, 0x62, 0x8c, 0x12, 0x0e, 0x06, 0xfb, 0x61, 0xef, 0x39, 0xdb, 0x07, 0x66, 0x11, 0x27,
+	0x73, 0xbe, 0x09, 0xc4, 0x56, 0x6b, 0xa3, 0xc9, 0xe2, 0x4d, 0x1a, 0xbe, 0x0e, 0x22, 0x77, 0xa1,
+	0xc9, 0x2c, 0x5e, 0x31, 0x9e, 0xf3, 0x6c, 0x3c, 0x3b, 0xba, 0x49, 0xcc, 0x46, 0x54, 0xcf, 0xa4,
+	0x87, 0x2d, 0x2c, 0x98, 0x61, 0x0b, 0x5c, 0x68, 0x8a, 0x90, 0xa5, 0x0e, 0xab, 0xad, 0x00, 0x88,
+	0x80, 0x5f, 0x24, 0x18, 0xcf, 0xb0, 0xc8, 0x32, 0x18, 0x30, 0x72, 0x15, 0x66, 0xd1, 0x1a, 0x1b,
+	0x85, 0x51, 0xbf, 0x4b, 0x94, 0x51, 0xa8, 0x60, 0x58, 0x86, 0xfc, 0xcd, 0x42, 0x2a, 0xf8, 0x41,
+	0x38, 0x03, 0x86, 0xb4, 0x51, 0x69, 0x36, 0x89, 0x96, 0xf9, 0x88, 0x1a, 0x40, 0xe3, 0x7a, 0x8c,
+	0x95, 0xd2, 0xf5, 0x18, 0x39, 0x90, 0xf5, 0x7e, 0x5f, 0xf0, 0xad, 0xf2, 0x1c, 0x14, 0x1c, 0xe7,
+	0x18, 0x1c, 0x67, 0x19, 0xf9, 0x9a, 0x7d, 0xe4, 0xcf, 0xa4, 0x8f, 0xf7, 0x8f, 0x1d, 0x20, 0x1b,
+	0xc8, 0x75, 0xf4, 0xc9, 0xc1, 0x41, 0x71, 0x62, 0xd7, 0xe5, 0x24, 0x19, 0x16, 0x17, 0x1b, 0xa8,
+	0x34, 0x0e, 0xb0, 0xc6, 0x32, 0x72, 0x19, 0xd2, 0x40, 0xd8, 0xe8, 0x28, 0xcb, 0xc6, 0x34, 0x15,
+	0xb6, 0x97, 0x48, 0x21, 0x21, 0xbf, 0x3f, 0x0e, 0xf9, 0x0a, 0x36, 0x0c, 0x5f, 0x88, 0xa3, 0x15,
+	0x06, 0xac, 0xe4, 0x7a, 0x50, 0xcc, 0xc7, 0x34, 0x5b, 0xbd, 0x9d, 0x45, 0x84, 0x75, 0x82, 0x00,
+	0x19, 0x61, 0xcd, 0x12, 0xd8, 0x7c, 0xf6, 0xa3, 0xd8, 0x9c, 0x56, 0x69, 0xef, 0x9f, 0x39, 0xb0,
+	0xb0, 0x1b, 0x9e, 0x1a, 0xdd, 0x9d, 0x58, 0x8a, 0x22, 0x42, 0xad, 0x44, 0x04, 0x17, 0x66, 0x65,
+	0xb3, 0xc5, 0xe9, 0x68, 0x95, 0x66, 0x87, 0x1c, 0xc3, 0x53, 0x9a, 0x06, 0x71, 0x22, 0xa2, 0x6c,
+	0xe6, 0x7c, 0x0d, 0x82, 0xf1, 0x58, 0xe7, 0xba, 0x94, 0x8a, 0x1c, 0xde, 0x26, 0x34, 0x77, 0xb5,
+	0x8b, 0x5b, 0x98, 0x8c, 0x92, 0x57, 0xb6, 0x88, 0x06, 0x6b, 0x10, 0x8d, 0x63, 0x6a, 0x3a, 0xc7,
+	0x78, 0xff, 0xc8, 0xe1, 0x17, 0x27, 0x28, 0x0e, 0xe3, 0x5d, 0xc7, 0x5b, 0x66, 0xa4, 0x27, 0xae,
+	0x38, 0x5a, 0x69, 0xc0, 0x30, 0x0f, 0xe3, 0x96, 0x20, 0x39, 0x38, 0xc8, 0xa8, 0x3c, 0x12, 0x63,
+	0xc0, 0xa4, 0x0a, 0x88, 0xaa, 0x61, 0xc4, 0x6b, 0xc8, 0xc4, 0xd1, 0x98, 0x0a, 0x9c, 0x1f, 0x1b,
+	0xc2, 0x48, 0x61, 0x25, 0x19, 0x55, 0xda, 0xfb, 0x07, 0xe2, 0x04, 0x68, 0x79, 0x22, 0xdc, 0xc4,
+	0x00, 0x00, 0x51, 0xae, 0xb9, 0x02, 0xc8, 0x9c, 0x0a, 0x8f, 0x2b, 0x0d, 0x33, 0xf0, 0x8c, 0x46,
+	0xf3, 0x55, 0xaf, 0x8a, 0xc0, 0x5d, 0xb7, 0x83, 0x28, 0x2d, 0x67, 0xe7, 0x83, 0x6a, 0xc1, 0x78,
+	0x1f, 0xc3, 0x92, 0xa8, 0x52, 0xd7, 0x4d, 0xcd, 0x79, 0xe6, 0x9c, 0x27, 0x87, 0x6a, 0x55, 0x39,
+	0xe4, 0xfd, 0x79, 0x1d, 0x66, 0xc4, 0x48, 0x57, 0x2e, 0xff, 0xe1, 0xe3, 0x6c, 0xc0, 0x48, 0xd7,
+	0xb8, 0xad, 0x84, 0x09, 0x2d, 0x0e, 0xa8, 0xae, 0x2f, 0x75, 0xdb, 0xfa, 0x82, 0xb1, 0x39, 0xfc,
+	0xa6, 0x06, 0x16, 0x07, 0x8e, 0xbf, 0x49, 0x87, 0xfb, 0x03, 0xf9, 0xdc, 0xc3, 0x9f, 0xd6, 0x6b,
+	0x8e, 0xb8, 0xba, 0x54, 0x81, 0x23, 0x0d, 0x58, 0x03, 0x82, 0xc2, 0xdd, 0x57, 0x00, 0x90, 0x73,
+	0x79, 0x82, 0xcd, 0x28, 0x71, 0xe6, 0xbb, 0x80, 0x9c, 0x75, 0x47, 0x13, 0x79, 0x1b, 0xa6, 0x33,
+	0x16, 0xe7, 0x25, 0x8e, 0x62, 0x5e, 0x96, 0x5b, 0x55, 0xbc, 0x09, 0xf2, 0x3f, 0x8f, 0x05, 0xf3,
+	0x45, 0x5e, 0xfd, 0x22, 0x27, 0x4e, 0xf6, 0x26, 0x77, 0x39, 0x18, 0xc0, 0xf2, 0x3a, 0xdb, 0xaa,
+	0xae, 0xb3, 0xba, 0x17, 0xb3, 0x6d, 0x7a, 0x31, 0xbd, 0x07, 0xd0, 0x36, 0x2a, 0x27, 0x4d, 0x98,
+	0x79, 0xb6, 0xf3, 0xe1, 0xce, 0x93, 0x8f, 0x77, 0x3a, 0x17, 0xf0, 0x00, 0xe6, 0xa3, 0x9d, 0xe0,
+	0xc1, 0xf6, 0xa3, 0x87, 0x5b, 0x4f, 0x3b, 0x0e, 0x26, 0xf7, 0x9e, 0x6d, 0x6c, 0x6c, 0x6e, 0xde,
+	0xdf, 0xbc, 0xdf, 0xa9, 0x11, 0x80, 0xe9, 0x07, 0xeb, 0x8f, 0xf0, 0xa8, 0x66, 0xdd, 0xfb, 0x91,
+	0x60, 0x7c, 0x51, 0x98, 0x72, 0x7a, 0xdf, 0x02, 0x22, 0x0d, 0x74, 0x16, 0xf1, 0x32, 0x1a, 0xd0,
+	0x5c, 0x1e, 0xd6, 0xb0, 0x60, 0x2a, 0x93, 0xb5, 0x66, 0x99, 0xac, 0x1e, 0xb4, 0x70, 0x42, 0x0a,
+	0x32, 0x64, 0x82, 0xd9, 0x0d, 0x98, 0x31, 0x49, 0x1b, 0xa5, 0x49, 0xfa, 0x0f, 0x1d, 0x58, 0x36,
+	0xdb, 0x5a, 0xcc, 0x52, 0x55, 0xa8, 0x39, 0x4b, 0x45, 0x56, 0x5f, 0xe1, 0x27, 0xcc, 0xbb, 0xda,
+	0xa4, 0x79, 0x67, 0x9f, 0xd5, 0xf5, 0x09, 0xb3, 0xda, 0xdb, 0x81, 0xee, 0x7d, 0x8a, 0x04, 0x59,
+	0x1f, 0x0c, 0xca, 0x24, 0xbd, 0x0b, 0xcb, 0x07, 0x61, 0x34, 0x60, 0xd7, 0x44, 0x72, 0x8c, 0x2e,
+	0xfb, 0xac, 0x38, 0xb4, 0x4b, 0x2d, 0xe5, 0x09, 0xa3, 0xf5, 0x1b, 0xb0, 0xb2, 0xce, 0xcf, 0xa0,
+	0xfe, 0xa4, 0x02, 0xe7, 0x31, 0x5c, 0xa8, 0x5c, 0xa4, 0xa8, 0xec, 0x01, 0x2c, 0xde, 0xa7, 0xfb,
+	0xe3, 0xc3, 0x6d, 0x7a, 0x5c, 0x54, 0x44, 0xa0, 0x91, 0x1d, 0x25, 0x27, 0xa2, 0x0b, 0xec, 0x37,
+	0xee, 0x81, 0x0c, 0x30, 0x4f, 0x90, 0x8d, 0x68, 0x4f, 0xde, 0x3e, 0xc2, 0x20, 0x7b, 0x23, 0xda,
+	0xf3, 0xde, 0x01, 0xa2, 0x97, 0x23, 0x46, 0x10, 0x27, 0xc3, 0x78, 0x3f, 0xc8, 0x4e, 0xb3, 0x9c,
+	0x0e, 0x65, 0xf8, 0x9f, 0x0e, 0xf2, 0x5e, 0x87, 0xd6, 0x6e, 0x88, 0xd7, 0x55, 0x89, 0x9b, 0xc1,
+	0xd0, 0x5b, 0x1d, 0x9e, 0xa2, 0xbe, 0xa1, 0xbc, 0xd5, 0x0c, 0xed, 0xfd, 0xd3, 0x3a, 0x4c, 0xf3,
+	0x9c, 0x42, 0x67, 0xc8, 0xa3, 0x98, 0x47, 0x56, 0x3a, 0x4a, 0x67, 0x90, 0xa0, 0x8a, 0xc0, 0xab,
+	0x59, 0x04, 0x9e, 0x70, 0xa3, 0xc8, 0xdb, 0x14, 0x64, 0xc8, 0xae, 0x0e, 0x43, 0x11, 0x54, 0x1c,
+	0x5e, 0xe1, 0x9e, 0xca, 0x02, 0x30, 0x49, 0xbb, 0x28, 0xeb, 0x34, 0xd3, 0x55, 0x9d, 0xc6, 0xa6,
+	0x40, 0xcf, 0xc8, 0xf3, 0x06, 0x26, 0xbc, 0xaa, 0x28, 0xcf, 0xbe, 0x84, 0xa2, 0xcc, 0x7d, 0x2b,
+	0x67, 0x29, 0xca, 0xf0, 0x32, 0x8a, 0xb2, 0x0b, 0xb3, 0x6c, 0xbd, 0x45, 0x51, 0xc5, 0xd5, 0x77,
+	0x95, 0x36, 0x0e, 0xcd, 0xb4, 0x4a, 0x87, 0xc8, 0x08, 0x74, 0xd8, 0xc5, 0x56, 0x68, 0xba, 0x49,
+	0xdf, 0xcc, 0x9f, 0xd7, 0xa1, 0x23, 0xb8, 0x4f, 0xe1, 0xc8, 0xab, 0x86, 0x89, 0x6a, 0xbd, 0x61,
+	0xe0, 0x3a, 0xb4, 0x99, 0xe1, 0xa8, 0x64, 0xa6, 0xd8, 0xa6, 0x32, 0x80, 0x2c, 0x9c, 0x51, 0xc4,
+	0xcd, 0x0c, 0xa3, 0x81, 0x18, 0x4c, 0x1d, 0x24, 0xc5, 0x6e, 0x2a, 0x83, 0x95, 0x1d, 0x5f, 0xa5,
+	0x99, 0x02, 0xcc, 0x2c, 0xff, 0x00, 0xa7, 0xab, 0x38, 0xcc, 0x87, 0xb2, 0xa0, 0x0c, 0x46, 0xe7,
+	0x67, 0x3f, 0x39, 0x89, 0xb3, 0x3c, 0xa5, 0xe1, 0xb0, 0xc8, 0xcd, 0xbd, 0xcf, 0x36, 0x14, 0xb9,
+	0x0f, 0x57, 0xa2, 0x38, 0x1b, 0x1f, 0x1c, 0x44, 0xbd, 0x88, 0x16, 0x1b, 0xee, 0xc5, 0xb7, 0xfc,
+	0x12, 0x97, 0xb3, 0x33, 0xe1, 0x11, 0xa8, 0x41, 0x14, 0x3f, 0x47, 0x81, 0x34, 0x88, 0x62, 0xed,
+	0xeb, 0x59, 0xf6, 0xb5, 0x1d, 0xc9, 0xf8, 0x2c, 0x3c, 0x65, 0x54, 0xca, 0xe4, 0x38, 0xf2, 0x0b,
+	0xb3, 0x2a, 0x70, 0x94, 0x88, 0x27, 0x94, 0x3e, 0x37, 0x33, 0x73, 0xbf, 0x5b, 0x15, 0x81, 0xf2,
+	0x76, 0x88, 0x96, 0xb8, 0x99, 0x9d, 0xaf, 0x88, 0x16, 0x8c, 0xf7, 0x6f, 0x1d, 0x58, 0xd4, 0x58,
+	0x42, 0xc8, 0x87, 0x0f, 0x40, 0xca, 0x29, 0xbe, 0xd1, 0x66, 0x46, 0xe4, 0x97, 0xb9, 0xc5, 0x37,
+	0x32, 0xb3, 0x69, 0x56, 0x74, 0x42, 0xc8, 0x7a, 0x1d, 0x84, 0x53, 0x5c, 0x6f, 0xb9, 0x5c, 0x99,
+	0x74, 0x18, 0xdb, 0x48, 0xd0, 0x9b, 0x2b, 0xf4, 0x51, 0x13, 0xe8, 0xfd, 0xe7, 0x1a, 0x2c, 0x71,
+	0xdf, 0x90, 0xf0, 0xbc, 0xa9, 0x13, 0xfb, 0xd3, 0xdc, 0x19, 0xc6, 0x65, 0xe5, 0xd6, 0x05, 0x5f,
+	0xa4, 0xc9, 0x97, 0x5f, 0xd2, 0x9f, 0xa5, 0x4e, 0xd9, 0x4c, 0xe0, 0xf6, 0xba, 0x8d, 0xdb, 0xcf,
+	0xe1, 0xe5, 0xf2, 0x9e, 0xce, 0x94, 0x7d, 0x4f, 0xe7, 0x2d, 0x68, 0x8a, 0x33, 0xe1, 0x58, 0xb2,
+	0xd8, 0xf6, 0x5f, 0x54, 0x8a, 0x30, 0xc3, 0x20, 0xf1, 0xf5, 0x5c, 0xd5, 0x8d, 0x97, 0x19, 0xcb,
+	0xc6, 0x4b, 0x35, 0xfc, 0x7f, 0x56, 0xe4, 0xd2, 0x81, 0x78, 0xf7, 0x68, 0xd6, 0x4b, 0x46, 0x14,
+	0x03, 0x81, 0x4c, 0xea, 0x8a, 0xd5, 0xe9, 0x37, 0x1c, 0xe8, 0x3e, 0x50, 0xb7, 0x23, 0x6d, 0x45,
+	0x59, 0x9e, 0xa4, 0xea, 0x26, 0xc4, 0xab, 0x00, 0x59, 0x1e, 0xa6, 0x39, 0x3f, 0xc8, 0x2e, 0x36,
+	0x73, 0x0a, 0x08, 0x12, 0x89, 0xc6, 0xfc, 0x6c, 0xb9, 0xbc, 0x4f, 0x40, 0xa6, 0x2b, 0x7a, 0x8d,
+	0x70, 0x9f, 0xe9, 0x30, 0xf4, 0xd6, 0x4b, 0x63, 0x83, 0x1e, 0x33, 0x25, 0x84, 0xfb, 0xa5, 0x4a,
+	0x50, 0xef, 0x5f, 0x3a, 0xb0, 0x50, 0x34, 0x72, 0x13, 0x81, 0xe6, 0xc2, 0x21, 0xf4, 0x77, 0xe3,
+	0x30, 0xb9, 0xf0, 0x97, 0x05, 0x51, 0x2c, 0xda, 0xa6, 0x41, 0x98, 0x30, 0x17, 0x29, 0xbc, 0x30,
+	0xab, 0x21, 0xbc, 0x1e, 0x05, 0x88, 0x47, 0x29, 0xa3, 0x92, 0x22, 0xe4, 0x94, 0x48, 0xb1, 0x7b,
+	0x08, 0x86, 0x39, 0xfb, 0x8a, 0x8b, 0x24, 0x99, 0x94, 0xba, 0x38, 0x1f, 0x2d, 0xfc, 0xe9, 0xfd,
+	0x6d, 0x07, 0x2e, 0x5a, 0x88, 0x2b, 0xa6, 0xe6, 0x7d, 0x58, 0x2c, 0xee, 0xa5, 0x92, 0x04, 0xe0,
+	0xf3, 0x73, 0x55, 0xda, 0x97, 0x66, 0xa7, 0xfd, 0xea, 0x07, 0x4a, 0xcd, 0xe2, 0x24, 0x35, 0x8e,
+	0x82, 0x55, 0x11, 0xde, 0xf7, 0xe0, 0x12, 0x2a, 0x82, 0x7b, 0x27, 0x94, 0x8e, 0x70, 0x9b, 0xef,
+	0x09, 0x3b, 0x2c, 0xa6, 0xdf, 0xdb, 0xa3, 0x1f, 0xc3, 0x71, 0xce, 0x3d, 0x75, 0x55, 0x2b, 0x9f,
+	0xba, 0xf2, 0xfe, 0x7d, 0x0d, 0x16, 0x4a, 0xc5, 0x1b, 0x91, 0xdd, 0x4e, 0x29, 0xb2, 0xfb, 0xe5,
+	0x02, 0x61, 0xcf, 0xbb, 0xba, 0x19, 0xe5, 0x50, 0x94, 0xc7, 0xea, 0xaa, 0x3c, 0x6e, 0xc5, 0x1b,
+	0x30, 0x5b, 0x3c, 0xdf, 0xd4, 0xe7, 0x8a, 0xe7, 0x9b, 0x3e, 0x33, 0x9e, 0x8f, 0x8a, 0xfb, 0x26,
+	0xfb, 0x81, 0xbc, 0x62, 0x92, 0x5b, 0x54, 0x55, 0x04, 0x9b, 0x57, 0x48, 0x22, 0x1e, 0xa1, 0x28,
+	0x4e, 0x13, 0x17, 0x10, 0x6f, 0x17, 0x2e, 0xdb, 0x47, 0x49, 0x45, 0x99, 0xcf, 0xf0, 0x53, 0x7e,
+	0x65, 0x7e, 0x29, 0x7d, 0xe1, 0xcb, 0x6c, 0xde, 0x31, 0x2c, 0x31, 0x5c, 0x69, 0xbc, 0x2f, 0xc3,
+	0x9c, 0x1c, 0x08, 0xb5, 0x83, 0xa1, 0x00, 0xe7, 0x5e, 0xd3, 0x59, 0xe1, 0x86, 0x7a, 0x85, 0x1b,
+	0xde, 0x81, 0x65, 0xb3, 0x5e, 0xd1, 0x03, 0x93, 0x02, 0x4e, 0x85, 0x02, 0x5f, 0x87, 0xcb, 0xeb,
+	0x69, 0xef, 0x28, 0x3a, 0xa6, 0xf6, 0xfb, 0x6f, 0xd8, 0xb1, 0xa6, 0x9c, 0xc6, 0x4c, 0x89, 0xe3,
+	0x03, 0x22, 0x76, 0x0e, 0x2b, 0x70, 0x8f, 0xc2, 0x95, 0x09, 0x65, 0x89, 0xc6, 0x08, 0x3d, 0x35,
+	0xe4, 0x99, 0xfa, 0xa2, 0x20, 0x03, 0x26, 0x2f, 0x00, 0xeb, 0x33, 0x9b, 0xa2, 0x2f, 0x26, 0x98,
+	0x0e, 0xf2, 0x3e, 0x02, 0x28, 0x24, 0x7a, 0x75, 0x95, 0xe1, 0x73, 0xc9, 0x04, 0x62, 0xcd, 0x6a,
+	0x5b, 0x7e, 0x34, 0x1a, 0x0a, 0x12, 0x1b, 0x30, 0xef, 0x00, 0x96, 0xf9, 0x79, 0x94, 0x5d, 0xf3,
+	0xea, 0x65, 0xcf, 0x7a, 0x69, 0xb0, 0x01, 0xd3, 0x9d, 0x01, 0xca, 0x05, 0x55, 0x33, 0x9d, 0x01,
+	0x12, 0xce, 0xc2, 0xfc, 0xcc, 0x7a, 0x8a, 0x2d, 0xbe, 0xcd, 0x17, 0xa8, 0x1d, 0x08, 0xc2, 0xad,
+	0x8f, 0xfb, 0x91, 0xd2, 0x39, 0xff, 0x5d, 0x1d, 0x16, 0x75, 0x38, 0x8f, 0x55, 0xfc, 0xa2, 0x37,
+	0x5b, 0x55, 0xee, 0xa3, 0xaa, 0x9f, 0x77, 0x1f, 0x55, 0xe3, 0xbc, 0xe8, 0xf8, 0xa9, 0x97, 0x8b,
+	0x8e, 0x9f, 0xb6, 0x5e, 0x7f, 0x57, 0xc4, 0x9a, 0x6b, 0xa1, 0xe1, 0x0d, 0xdf, 0x04, 0xf2, 0x4b,
+	0x99, 0x18, 0x40, 0x9b, 0xd7, 0x3a, 0xa8, 0x14, 0xd3, 0x3e, 0x57, 0x89, 0x69, 0x17, 0x57, 0xb8,
+	0x9b, 0xc1, 0xbe, 0xfc, 0xcc, 0x69, 0x15, 0xc1, 0x46, 0x57, 0x03, 0xb0, 0x28, 0x29, 0x6e, 0x43,
+	0x54, 0xe0, 0xcc, 0x21, 0xcf, 0x61, 0xe2, 0xe0, 0xa9, 0x4c, 0x7a, 0x7f, 0x50, 0x03, 0xd7, 0x36,
+	0xbe, 0x9f, 0xfb, 0x86, 0x07, 0xcf, 0x72, 0xf4, 0xfe, 0xec, 0x7b, 0x14, 0xea, 0x95, 0x7b, 0x14,
+	0xce, 0x36, 0x07, 0x8b, 0xd3, 0x35, 0x96, 0xa1, 0xb5, 0xa1, 0xc8, 0xdb, 0x5a, 0x4c, 0xd3, 0xb4,
+	0x6d, 0xb3, 0xb8, 0x60, 0x5a, 0xed, 0x34, 0x2a, 0xde, 0xfa, 0x13, 0x87, 0xa3, 0xec, 0x28, 0xe1,
+	0x23, 0xdd, 0xf2, 0x55, 0xda, 0xbc, 0x22, 0x74, 0xb6, 0x7c, 0x45, 0x28, 0x85, 0xe5, 0x07, 0x29,
+	0xa5, 0x3f, 0x28, 0x9f, 0xc8, 0xff, 0xf1, 0x2f, 0x0e, 0x60, 0x47, 0xbf, 0x8f, 0xc2, 0x13, 0x79,
+	0xd7, 0x27, 0xfe, 0xc6, 0x9b, 0x48, 0x4b, 0xd5, 0x88, 0xd1, 0xb2, 0x32, 0x90, 0x33, 0x81, 0x81,
+	0xbc, 0xff, 0xe9, 0xc0, 0x2b, 0x5c, 0x1f, 0x14, 0xe5, 0x6c, 0x24, 0x68, 0x5c, 0x85, 0x91, 0xe6,
+	0x7c, 0xf9, 0x02, 0x2d, 0xbf, 0x0b, 0xcb, 0xcc, 0x45, 0x45, 0xe5, 0x11, 0x43, 0xcd, 0x39, 0xdf,
+	0xf0, 0xad, 0xb8, 0xaa, 0x5a, 0x5b, 0xb7, 0xa8, 0xb5, 0xcc, 0x36, 0x0a, 0x5f, 0x04, 0xf2, 0x62,
+	0x2b, 0xd1, 0x4f, 0xae, 0x3c, 0x5a, 0x30, 0xde, 0x6f, 0x39, 0x70, 0x6d, 0x72, 0x47, 0xd5, 0xbd,
+	0xb5, 0xf6, 0xe6, 0x3a, 0x9f, 0xa7, 0xb9, 0xb5, 0x97, 0x6f, 0x6e, 0x7d, 0x62, 0x73, 0x5d, 0xe8,
+	0xca, 0x7d, 0x7d, 0x54, 0xf2, 0x8c, 0x98, 0x8a, 0x3f, 0x6b, 0x00, 0xd1, 0x91, 0xbc, 0x5b, 0xe4,
+	0x2e, 0xb4, 0xf4, 0xe3, 0x5e, 0x62, 0x94, 0xca, 0x77, 0x12, 0x1a, 0x79, 0xc8, 0x3d, 0x98, 0xd7,
+	0xa2, 0x21, 0xf0, 0xab, 0x9a, 0x71, 0x88, 0xd2, 0x76, 0xd3, 0x5a, 0xe9, 0x0b, 0x0c, 0x02, 0x30,
+	0x6f, 0x05, 0xe9, 0xd6, 0x27, 0xf3, 0x47, 0x29, 0x2b, 0xf9, 0x1a, 0xc6, 0x47, 0x96, 0x3e, 0x3f,
+	0x63, 0x13, 0xbd, 0x92, 0x99, 0xbc, 0x2b, 0xee, 0x4c, 0x9a, 0x62, 0x4e, 0xe6, 0xeb, 0xe6, 0x47,
+	0x1a, 0x79, 0x6e, 0xf1, 0x7f, 0xc5, 0x7d, 0xce, 0x64, 0xab, 0x14, 0x86, 0x2e, 0xab, 0x9f, 0x9e,
+	0x7c, 0x00, 0xd9, 0xb7, 0x7e, 0x41, 0x3e, 0x84, 0xd5, 0x83, 0xf1, 0x60, 0x80, 0x1e, 0xb5, 0x2c,
+	0x19, 0x1c, 0x6b, 0xd4, 0x9c, 0x99, 0xdc, 0x95, 0x09, 0x9f, 0x78, 0x7f, 0xd7, 0x01, 0x28, 0xda,
+	0x8a, 0xf7, 0x06, 0x3e, 0xd9, 0xdd, 0xdc, 0x09, 0x36, 0xb6, 0xd6, 0x77, 0x76, 0x36, 0xb7, 0x3b,
+	0x17, 0x08, 0x81, 0x79, 0x76, 0x85, 0xe0, 0x7d, 0x05, 0x73, 0x10, 0xb6, 0xbe, 0xc1, 0xaf, 0x27,
+	0x14, 0xb0, 0x1a, 0xde, 0x2f, 0xf8, 0x68, 0xa7, 0x04, 0xad, 0x93, 0x2e, 0x2c, 0xef, 0x6e, 0xf2,
+	0x5b, 0x07, 0x8d, 0x72, 0x1b, 0xc4, 0x85, 0xd5, 0x07, 0xcf, 0xb6, 0xb7, 0xbf, 0x15, 0xf8, 0x9b,
+	0x7b, 0x4f, 0xb6, 0x3f, 0xd2, 0xca, 0x9f, 0x42, 0xcd, 0x00, 0x2f, 0x8d, 0xaa, 0xf2, 0xe2, 0xaf,
+	0x38, 0x30, 0xa7, 0x30, 0x67, 0xdc, 0xdd, 0x76, 0x4b, 0xbb, 0xda, 0x6a, 0x5e, 0xb1, 0x97, 0xfa,
+	0xf2, 0x16, 0xfb, 0x6b, 0x5c, 0xb6, 0x3d, 0xa7, 0x40, 0x64, 0x01, 0x9a, 0xbb, 0x9b, 0x9b, 0x7e,
+	0xf0, 0x64, 0x67, 0xfb, 0xd1, 0x0e, 0xde, 0xbd, 0xd8, 0x81, 0x16, 0x07, 0x3c, 0x78, 0xc0, 0x20,
+	0x0e, 0xaa, 0x48, 0xdc, 0xd9, 0xfb, 0x17, 0xaf, 0x22, 0x95, 0xea, 0x51, 0x0e, 0x65, 0x73, 0x09,
+	0xbd, 0x17, 0xf6, 0x9e, 0x8f, 0x47, 0xc5, 0x8d, 0x08, 0x65, 0x17, 0xdc, 0x04, 0xae, 0xd0, 0xb2,
+	0x79, 0x07, 0xd0, 0x36, 0x0a, 0xfb, 0xb1, 0x4a, 0x51, 0x76, 0xee, 0x3e, 0x2b, 0x43, 0x5e, 0xf4,
+	0xa1, 0x81, 0xbc, 0x63, 0x58, 0x78, 0x3c, 0x1e, 0xe4, 0x11, 0x16, 0x21, 0x6a, 0xfa, 0x32, 0x34,
+	0x8b, 0x22, 0xa4, 0x89, 0x61, 0xad, 0x4a, 0xcf, 0x87, 0x6b, 0xcf, 0x10, 0x4b, 0x0a, 0xaa, 0x35,
+	0x56, 0x11, 0xde, 0x45, 0x58, 0x2b, 0xaa, 0xe4, 0xc4, 0x93, 0x3a, 0xe5, 0x6f, 0x3a, 0x40, 0x0a,
+	0xdc, 0x9e, 0x5c, 0x79, 0x1f, 0xc2, 0x12, 0xc6, 0x04, 0x0d, 0xa8, 0x5e, 0x4e, 0x26, 0x28, 0xb1,
+	0x62, 0x36, 0x8f, 0x7f, 0x9a, 0xf9, 0xb6, 0x2f, 0xd0, 0xf0, 0xb6, 0x37, 0xb4, 0x30, 0xa4, 0x4a,
+	0x24, 0xb1, 0x75, 0xe0, 0xeb, 0x30, 0x6f, 0x56, 0x86, 0x71, 0xa0, 0xa5, 0x96, 0xe9, 0xb1, 0x97,
+	0x26, 0x6b, 0x18, 0x39, 0x51, 0xc5, 0x36, 0xd0, 0xc6, 0x2c, 0xfb, 0x35, 0x07, 0xba, 0x3e, 0x45,
+	0xdf, 0x01, 0xd5, 0x5a, 0x24, 0x78, 0xeb, 0x83, 0x4a, 0x9d, 0x93, 0xa9, 0xa1, 0x6e, 0x50, 0x90,
+	0x84, 0xb8, 0x35, 0x71, 0xc4, 0xb6, 0x2e, 0x58, 0xba, 0x8c, 0x17, 0x12, 0x88, 0xce, 0xaf, 0xc1,
+	0x8a, 0x68, 0x92, 0x6c, 0x8e, 0x98, 0x09, 0x2e, 0x74, 0xf9, 0xf1, 0x77, 0xbd, 0xa9, 0x02, 0x97,
+	0xc3, 0xd2, 0xbd, 0xf0, 0x39, 0x7d, 0x1c, 0xf6, 0xc2, 0x34, 0x29, 0xee, 0xe4, 0xfc, 0x00, 0x9a,
+	0x23, 0x9a, 0x0e, 0xa3, 0x2c, 0xd3, 0x9e, 0xe5, 0x91, 0xd7, 0x38, 0xc8, 0xcc, 0xbb, 0x2a, 0x87,
+	0xaf, 0xe7, 0x46, 0x06, 0x4f, 0x93, 0x24, 0x47, 0x31, 0x53, 0xd8, 0x11, 0x3a, 0xc8, 0xbb, 0x0b,
+	0xcb, 0x66, 0xad, 0x62, 0xb9, 0xc7, 0xed, 0x4b, 0x01, 0x93, 0x4e, 0x09, 0x99, 0xf6, 0xee, 0x03,
+	0xa9, 0x56, 0xcc, 0x76, 0x23, 0x78, 0x08, 0x81, 0xd8, 0x38, 0xe1, 0x29, 0x79, 0x09, 0xb8, 0x0a,
+	0xae, 0x10, 0x29, 0xdc, 0x13, 0x42, 0x33, 0x5e, 0x96, 0xf4, 0xe8, 0xbe, 0x3a, 0x42, 0xfe, 0x55,
+	0x58, 0xab, 0x60, 0x0a, 0x63, 0x54, 0x6b, 0x3d, 0x27, 0x47, 0xc3, 0x37, 0x60, 0xde, 0x07, 0xb0,
+	0xc6, 0xe5, 0x50, 0x51, 0x80, 0x76, 0xb3, 0x8f, 0x4e, 0x0f, 0xa7, 0x4a, 0x8f, 0xb7, 0xa1, 0x5b,
+	0xfd, 0xb8, 0x38, 0xb6, 0x25, 0x2d, 0x5c, 0x71, 0x51, 0xa9, 0x48, 0x7a, 0xcf, 0x60, 0xb5, 0x4a,
+	0x91, 0xed, 0xe8, 0x0b, 0x0e, 0x9f, 0x24, 0x51, 0x81, 0x56, 0x24, 0xfa, 0x1f, 0x0e, 0xac, 0x55,
+	0x50, 0xa2, 0x99, 0x14, 0xc8, 0x90, 0xe6, 0x47, 0x49, 0x3f, 0xa8, 0xd6, 0xfc, 0x65, 0x15, 0xea,
+	0x6c, 0xfd, 0xf6, 0xd6, 0x63, 0xf6, 0xa1, 0x86, 0xe1, 0xca, 0xbf, 0xa5, 0x40, 0xb7, 0x07, 0xab,
+	0xf6, 0xdc, 0x96, 0xb3, 0x78, 0x6f, 0x99, 0x67, 0xf1, 0xae, 0x4c, 0xec, 0x3f, 0xb6, 0x4b, 0x3f,
+	0x8d, 0x17, 0x63, 0x08, 0x38, 0xed, 0x3d, 0x7f, 0x1c, 0xf6, 0x30, 0x93, 0x16, 0xd1, 0x63, 0x70,
+	0x67, 0xab, 0xe0, 0xce, 0x32, 0xc5, 0x6b, 0x9f, 0x8b, 0xe2, 0x6f, 0xc0, 0xb2, 0x59, 0xdf, 0x59,
+	0x6f, 0x18, 0x78, 0xbf, 0x55, 0x83, 0x65, 0x7f, 0x77, 0xe3, 0x71, 0xd4, 0xef, 0x0f, 0xe8, 0x49,
+	0x98, 0x52, 0xcd, 0x35, 0x2c, 0x22, 0x96, 0x0a, 0x36, 0xd3, 0x20, 0x8c, 0x8d, 0xc3, 0x93, 0x40,
+	0xf5, 0x81, 0xaf, 0x03, 0x06, 0x0c, 0xaf, 0x63, 0xef, 0xb1, 0x6b, 0x31, 0x83, 0x5e, 0x78, 0x4c,
+	0x43, 0xe6, 0x66, 0xea, 0xb3, 0xdb, 0x45, 0x84, 0x65, 0x38, 0x09, 0x4d, 0x7e, 0x06, 0x66, 0x44,
+	0x5d, 0xdd, 0x86, 0xe1, 0x53, 0xc7, 0xb6, 0x8a, 0xeb, 0x39, 0x65, 0x0e, 0xf2, 0xb3, 0xb8, 0x33,
+	0xce, 0x7b, 0xd9, 0x9d, 0x9a, 0x94, 0x5b, 0x65, 0x61, 0x2d, 0xa7, 0x87, 0x81, 0xda, 0xba, 0xe7,
+	0x11, 0x2f, 0x06, 0x0c, 0x67, 0xfc, 0x30, 0x3b, 0xc4, 0x9e, 0x73, 0x47, 0x80, 0x48, 0x79, 0x7f,
+	0xdf, 0x01, 0x28, 0x0a, 0x65, 0x1e, 0x47, 0xce, 0x56, 0xa8, 0xee, 0x05, 0xe3, 0x34, 0x92, 0xb6,
+	0x73, 0x09, 0xcc, 0x3d, 0xed, 0x6c, 0x57, 0x2b, 0x1d, 0xf5, 0xe4, 0x65, 0xcd, 0x05, 0x84, 0xd9,
+	0xc5, 0xa7, 0x23, 0x1a, 0xc4, 0xe1, 0x90, 0x0a, 0xe2, 0x14, 0x00, 0xf6, 0x35, 0x4d, 0x23, 0x76,
+	0x25, 0x88, 0xbc, 0x4d, 0x46, 0x83, 0x78, 0xff, 0xc4, 0x81, 0x95, 0xd2, 0x28, 0x16, 0x7e, 0xb8,
+	0x94, 0x1e, 0x04, 0xa2, 0x33, 0x6a, 0x18, 0x25, 0x84, 0xbc, 0x87, 0xb4, 0x3b, 0x8c, 0xb2, 0x9c,
+	0xa6, 0x65, 0xce, 0xd6, 0x0a, 0xc3, 0x0c, 0xfc, 0x15, 0x06, 0x5f, 0x65, 0x47, 0xd3, 0xfb, 0x80,
+	0xd2, 0x3e, 0x2e, 0x18, 0xa5, 0xcb, 0x75, 0x1e, 0xc5, 0x39, 0x4d, 0xd1, 0xe0, 0x79, 0x20, 0xf0,
+	0xbe, 0xca, 0xe9, 0xfd, 0xb2, 0x03, 0xab, 0xf6, 0xa2, 0x19, 0x35, 0x15, 0x86, 0x53, 0x42, 0x52,
+	0xd3, 0x04, 0x63, 0xf0, 0xab, 0xe0, 0x1c, 0xc9, 0x6b, 0x92, 0x85, 0xd8, 0x57, 0x5c, 0x4a, 0x9f,
+	0x95, 0xc5, 0xfb, 0x1b, 0xec, 0x29, 0xcc, 0x52, 0x33, 0x71, 0x8e, 0xe8, 0x0f, 0x8c, 0xf1, 0x04,
+	0xbf, 0xf4, 0x61, 0x34, 0x08, 0x7b, 0x34, 0x18, 0xf2, 0x81, 0x97, 0x87, 0xbc, 0x4a, 0x60, 0x3c,
+	0x33, 0x20, 0x40, 0x4c, 0xaf, 0xd4, 0xc6, 0x8c, 0xc7, 0xae, 0x4e, 0xc0, 0xde, 0xfc, 0x2e, 0x34,
+	0xb5, 0x17, 0x12, 0x51, 0x01, 0xde, 0x79, 0xb2, 0x13, 0x6c, 0x7e, 0xf3, 0xd1, 0xde, 0xd3, 0x47,
+	0x3b, 0x0f, 0x3b, 0x17, 0x30, 0x30, 0x65, 0xfb, 0xc9, 0xc6, 0x87, 0x9b, 0xf7, 0x3b, 0x0e, 0x69,
+	0xc1, 0xec, 0xb3, 0x1d, 0x91, 0xaa, 0x91, 0x79, 0xc6, 0x90, 0x01, 0xb7, 0x04, 0x3a, 0x75, 0xb2,
+	0x08, 0xed, 0xbd, 0x4d, 0xff, 0xa3, 0x4d, 0x5f, 0x82, 0x1a, 0x37, 0x7f, 0x0e, 0x9a, 0xda, 0x5b,
+	0x32, 0x64, 0x0d, 0x96, 0x3e, 0x7e, 0xf4, 0x74, 0x67, 0x73, 0x6f, 0x2f, 0xd8, 0x7d, 0x76, 0xef,
+	0xc3, 0xcd, 0x6f, 0x05, 0x5b, 0xeb, 0x7b, 0x5b, 0x9d, 0x0b, 0x78, 0xb9, 0xf9, 0xce, 0xe6, 0xde,
+	0xd3, 0xcd, 0xfb, 0x06, 0xdc, 0xb9, 0xfb, 0x6b, 0x75, 0x98, 0xe7, 0xcd, 0xe3, 0xef, 0x5e, 0xd2,
+	0x94, 0x3c, 0x86, 0x19, 0xf1, 0x6e, 0x29, 0x91, 0xaa, 0x88, 0xf9, 0x52, 0xaa, 0xbb, 0x5a, 0x06,
+	0x0b, 0x1d, 0x61, 0xe9, 0xaf, 0xfc, 0xe1, 0x7f, 0xff, 0x7b, 0xb5, 0x36, 0x69, 0xde, 0x3e, 0x7e,
+	0xf3, 0xf6, 0x21, 0x8d, 0x33, 0x2c, 0xe3, 0xbb, 0x00, 0xc5, 0x8b, 0x9e, 0xa4, 0x60, 0xa3, 0xd2,
+	0x53, 0xa5, 0xee, 0x45, 0x0b, 0x46, 0x94, 0x7b, 0x91, 0x95, 0xbb, 0xe4, 0xcd, 0x63, 0xb9, 0x51,
+	0x1c, 0xe5, 0xfc, 0x79, 0xcf, 0xf7, 0x9d, 0x9b, 0xa4, 0x0f, 0x2d, 0xfd, 0xc1, 0x4e, 0x22, 0xed,
+	0x13, 0xcb, 0x73, 0xa1, 0xee, 0x25, 0x2b, 0x4e, 0x3a, 0x4a, 0x59, 0x1d, 0x2b, 0x5e, 0x07, 0xeb,
+	0x18, 0xb3, 0x1c, 0x45, 0x2d, 0x03, 0x98, 0x37, 0xdf, 0xe5, 0x24, 0x97, 0x35, 0x25, 0xad, 0xf2,
+	0x2a, 0xa8, 0x7b, 0x65, 0x02, 0x56, 0xd4, 0x75, 0x85, 0xd5, 0xb5, 0xe6, 0x11, 0xac, 0xab, 0xc7,
+	0xf2, 0xc8, 0x57, 0x41, 0xdf, 0x77, 0x6e, 0xde, 0xfd, 0x3d, 0x07, 0xa6, 0x38, 0xb3, 0x0c, 0x60,
+	0xde, 0x7c, 0xdc, 0x53, 0xd5, 0x6b, 0x7d, 0x0c, 0xd4, 0xbd, 0x32, 0x01, 0x6b, 0xf6, 0x91, 0x2c,
+	0x61, 0xbd, 0xec, 0xa5, 0xce, 0xdb, 0x99, 0xcc, 0x79, 0xc7, 0x21, 0x3b, 0x30, 0x2b, 0xdf, 0xfc,
+	0x24, 0xc5, 0x10, 0x1b, 0xef, 0x82, 0xba, 0x6b, 0x15, 0xb8, 0x28, 0x7b, 0x91, 0x95, 0xdd, 0x24,
+	0x73, 0xaa, 0xec, 0xbb, 0xbf, 0xfe, 0x55, 0x98, 0x53, 0x87, 0x96, 0xc8, 0x27, 0xf2, 0xed, 0x24,
+	0x79, 0x5d, 0xc1, 0x25, 0xfb, 0xa9, 0x7c, 0x5e, 0xcf, 0xe5, 0xb3, 0x8e, 0xec, 0x7b, 0x57, 0x59,
+	0x65, 0x5d, 0xb2, 0x8a, 0x95, 0x09, 0x77, 0xe1, 0x6d, 0xe6, 0x89, 0xe4, 0xf7, 0xb4, 0x3e, 0xd7,
+	0xd4, 0x7b, 0x5e, 0xd9, 0xe5, 0xb2, 0x52, 0x6d, 0xd4, 0x76, 0x65, 0x02, 0x56, 0x54, 0x77, 0x99,
+	0x55, 0xb7, 0x4a, 0x96, 0xf5, 0xea, 0x94, 0xc3, 0x91, 0xb2, 0x9b, 0x75, 0xf5, 0xa7, 0x2c, 0xc9,
+	0x95, 0x82, 0x4a, 0x96, 0x27, 0x2e, 0x15, 0xab, 0x57, 0xdf, 0xb9, 0xf4, 0xba, 0xac, 0x2a, 0x42,
+	0x18, 0x1b, 0xea, 0x2f, 0x59, 0x92, 0xef, 0xc0, 0x9c, 0x7a, 0xb4, 0x8b, 0xac, 0x69, 0x0f, 0xda,
+	0xe9, 0xef, 0x99, 0xb9, 0xdd, 0x2a, 0xc2, 0xc6, 0xe0, 0x7a, 0xc9, 0xc8, 0xe0, 0x1f, 0x43, 0x53,
+	0x7b, 0x98, 0x8b, 0x5c, 0xd4, 0xf4, 0x30, 0xf3, 0xf1, 0x2f, 0xd7, 0xb5, 0xa1, 0x6c, 0x3c, 0xc0,
+	0xde, 0xed, 0x22, 0x23, 0xed, 0xdd, 0xda, 0xcf, 0x43, 0x22, 0xcb, 0xcb, 0x9e, 0x9e, 0xc7, 0x8a,
+	0xbf, 0x4c, 0xdc, 0x72, 0x0f, 0x0c, 0x2e, 0xfe, 0x05, 0x98, 0x95, 0xef, 0xe5, 0x29, 0x2e, 0x2e,
+	0xbd, 0xfb, 0xe7, 0xae, 0x55, 0xe0, 0xa2, 0x07, 0xd7, 0x58, 0x15, 0xae, 0xb7, 0x52, 0xa9, 0x62,
+	0x18, 0xc6, 0xa7, 0x48, 0x29, 0x0a, 0x4d, 0xed, 0x71, 0x3a, 0x45, 0xa9, 0xea, 0x43, 0x7a, 0xae,
+	0x6b, 0x43, 0x89, 0x7a, 0x5e, 0x61, 0xf5, 0x5c, 0xf4, 0x96, 0x2b, 0xf5, 0x1c, 0x50, 0x8a, 0xd5,
+	0x7c, 0x0b, 0xa0, 0x78, 0xb2, 0x4c, 0x49, 0xcd, 0xca, 0x13, 0x68, 0xee, 0x45, 0x0b, 0x46, 0xd4,
+	0xb1, 0xca, 0xea, 0xe8, 0x10, 0x26, 0x35, 0x63, 0x7a, 0x22, 0xaf, 0x93, 0x0b, 0xa1, 0x6d, 0xbc,
+	0xfd, 0xa5, 0x26, 0xa2, 0xed, 0xcd, 0x33, 0xf7, 0xb2, 0x1d, 0x29, 0xea, 0x58, 0x61, 0x75, 0x2c,
+	0x90, 0x36, 0xd6, 0x51, 0x1c, 0x9f, 0xfa, 0x1e, 0x34, 0xb5, 0x97, 0xbe, 0x14, 0x91, 0xaa, 0xaf,
+	0x84, 0xb9, 0xae, 0x0d, 0x25, 0xcd, 0x51, 0x56, 0xf8, 0xb2, 0xb7, 0xc0, 0x44, 0x4a, 0x74, 0x18,
+	0x8b, 0xa5, 0x18, 0xe9, 0x73, 0x04, 0x6d, 0xe3, 0x39, 0x2f, 0xd5, 0x09, 0xdb, 0x63, 0x61, 0xee,
+	0x65, 0x3b, 0xd2, 0x9c, 0xde, 0xde, 0x22, 0xd6, 0xc3, 0xaf, 0xa8, 0xd3, 0x6a, 0xfa, 0x36, 0x34,
+	0xb5, 0x07, 0xb8, 0x88, 0x76, 0x45, 0x61, 0xe9, 0xe9, 0x2d, 0xd7, 0xb5, 0xa1, 0x44, 0x1d, 0xcb,
+	0xac, 0x8e, 0x79, 0x8f, 0x4d, 0x0d, 0x76, 0x75, 0x35, 0x96, 0xfd, 0x09, 0xcc, 0x9b, 0x4f, 0x72,
+	0x29, 0x39, 0x65, 0x7d, 0xdc, 0xcb, 0xbd, 0x32, 0x01, 0x6b, 0x4e, 0xf1, 0x9b, 0x4b, 0xaa, 0x92,
+	0xdb, 0x9f, 0x0a, 0x37, 0xde, 0x67, 0xe4, 0x1b, 0x30, 0xc7, 0xcd, 0x2a, 0x9a, 0x16, 0xf2, 0xa3,
+	0x7c, 0x19, 0xbd, 0xdb, 0xad, 0x22, 0x6c, 0x93, 0x9b, 0x15, 0xce, 0x35, 0x05, 0x76, 0xa7, 0xb8,
+	0xa6, 0x29, 0xe8, 0xd7, 0x8e, 0xbb, 0xab, 0x65, 0xb0, 0x5d, 0x53, 0xc8, 0x23, 0x2c, 0x23, 0x86,
+	0x85, 0xd2, 0x85, 0x41, 0x4a, 0x4a, 0xd8, 0x6f, 0x73, 0x73, 0xaf, 0x9e, 0x7d, 0xcf, 0x90, 0x29,
+	0xb8, 0xa5, 0xc0, 0xbe, 0x2d, 0xef, 0xa0, 0xfc, 0x05, 0x68, 0xe9, 0xcf, 0x03, 0x11, 0x5d, 0xb4,
+	0x95, 0x6b, 0xba, 0x64, 0xc5, 0x99, 0x83, 0x4b, 0x5a, 0x7a, 0x35, 0x38, 0xb8, 0xe6, 0x9e, 0x75,
+	0xb1, 0x08, 0xd9, 0xb6, 0xc5, 0xdd, 0x2b, 0x13, 0xb0, 0xb6, 0xc5, 0x5b, 0xf5, 0x85, 0x7b, 0xf4,
+	0xf1, 0xea, 0x1b, 0xed, 0xe6, 0xaf, 0xbd, 0xd3, 0xb8, 0xa7, 0x18, 0xb5, 0x7a, 0xcb, 0xab, 0x6b,
+	0x73, 0x07, 0x7a, 0x6b, 0xac, 0xfc, 0x45, 0xcf, 0xe8, 0x04, 0x32, 0x69, 0x0f, 0x9a, 0x5a, 0x19,
+	0x67, 0x95, 0xbb, 0xa6, 0xa1, 0xf4, 0x9b, 0x42, 0xe5, 0x7a, 0xed, 0x99, 0x6d, 0xe7, 0x26, 0xd2,
+	0xfb, 0xce, 0xcd, 0x3b, 0x0e, 0x49, 0x2d, 0x17, 0xb6, 0x5e, 0x9d, 0x74, 0xf5, 0xac, 0xa8, 0xee,
+	0x95, 0x89, 0xf8, 0x49, 0x7a, 0x16, 0xab, 0x76, 0x1f, 0xb3, 0x63, 0xc7, 0x22, 0xe8, 0x94, 0xef,
+	0x43, 0x54, 0x62, 0xc4, 0x76, 0x67, 0xa6, 0x5b, 0x42, 0x9a, 0xb7, 0x28, 0x1a, 0xeb, 0xab, 0xb8,
+	0x76, 0xec, 0x76, 0x96, 0xd3, 0x11, 0x56, 0xf5, 0xeb, 0xf8, 0x1e, 0xaf, 0x7e, 0x6d, 0x98, 0x71,
+	0x6c, 0xb5, 0xd4, 0xaf, 0xae, 0x8e, 0x33, 0xe8, 0xe8, 0xb3, 0x3a, 0xb6, 0x6f, 0x7e, 0xdd, 0xe8,
+	0xd0, 0xa7, 0xc6, 0xce, 0xdd, 0xad, 0xf2, 0xdb, 0xbc, 0x9f, 0x95, 0x33, 0xe8, 0x97, 0x4c, 0x7f,
+	0x76, 0xc7, 0x21, 0x3f, 0x72, 0x60, 0xde, 0x8c, 0x7f, 0x56, 0x9c, 0x6a, 0x8d, 0xb4, 0x76, 0xaf,
+	0x4c, 0xc0, 0x0a, 0xb2, 0x7f, 0x9b, 0xb5, 0xf2, 0xe9, 0x4d, 0xdf, 0x68, 0xa5, 0x78, 0x38, 0xe8,
+	0x8b, 0xb5, 0x96, 0xbc, 0xcf, 0x5f, 0xe1, 0x96, 0x47, 0x37, 0x88, 0xb6, 0x90, 0x97, 0xb9, 0x5b,
+	0x7f, 0x66, 0xfa, 0x86, 0x73, 0xc7, 0x21, 0xdf, 0x83, 0x05, 0xed, 0x5b, 0x36, 0x49, 0x5e, 0xf6,
+	0x7b, 0xef, 0x3a, 0xeb, 0xd3, 0x55, 0xef, 0xa2, 0xd1, 0xa7, 0xb2, 0x1a, 0xb5, 0x0e, 0x4d, 0xed,
+	0x85, 0xe8, 0x62, 0xdd, 0xab, 0xbc, 0x1a, 0x3d, 0xb9, 0x91, 0x43, 0x58, 0xd0, 0xb2, 0x1b, 0x33,
+	0xf9, 0x25, 0x8b, 0xf1, 0x6e, 0xb2, 0xb6, 0x5e, 0xf7, 0x5e, 0x99, 0xd8, 0xd6, 0xdb, 0x2c, 0x8a,
+	0x19, 0x5b, 0xbc, 0x0b, 0x50, 0x9c, 0x84, 0x23, 0xa5, 0x63, 0x3e, 0x4a, 0xbb, 0xa8, 0x1e, 0x96,
+	0x33, 0xc5, 0x85, 0x3c, 0x0d, 0x84, 0x25, 0x7e, 0x07, 0x9a, 0xda, 0xe1, 0xb1, 0x62, 0xbd, 0xac,
+	0x1c, 0x7c, 0x73, 0x5d, 0x1b, 0xca, 0x54, 0x2c, 0x3c, 0xc0, 0xe2, 0xd9, 0x11, 0x31, 0x56, 0xb8,
+	0x0f, 0xb3, 0xf2, 0x3c, 0x99, 0x52, 0xee, 0x4a, 0x07, 0xcc, 0xec, 0x34, 0x31, 0x4c, 0x48, 0x5e,
+	0xde, 0xed, 0x51, 0x78, 0xca, 0x1b, 0xdc, 0xd2, 0x0e, 0x41, 0x65, 0x86, 0xf2, 0x6b, 0x1e, 0xe0,
+	0x72, 0x5d, 0x1b, 0xca, 0xb6, 0x08, 0x48, 0x82, 0x90, 0x67, 0xd0, 0xe6, 0xaf, 0x4b, 0x49, 0x12,
+	0x13, 0xf3, 0x8c, 0x06, 0x1e, 0x33, 0x73, 0x4b, 0x64, 0x97, 0x5a, 0x28, 0xe9, 0x6a, 0x45, 0xdd,
+	0xfe, 0xb4, 0x38, 0x77, 0xf6, 0x19, 0x09, 0x61, 0x51, 0xa9, 0xd5, 0xaa, 0xe1, 0xae, 0x59, 0x8c,
+	0xbe, 0x0f, 0x51, 0xa9, 0xc2, 0xb0, 0xa0, 0x64, 0x6b, 0x0d, 0x3d, 0x7a, 0x17, 0x5a, 0xf7, 0x69,
+	0x2f, 0xe9, 0x53, 0x71, 0xac, 0x60, 0xa9, 0x68, 0xb8, 0x3a, 0x8f, 0xe0, 0xb6, 0x0d, 0xa0, 0xb9,
+	0xde, 0x8e, 0xc2, 0xd3, 0x94, 0x7e, 0xff, 0xf6, 0xa7, 0xe2, 0xc0, 0xc2, 0x67, 0x72, 0xbd, 0xdd,
+	0x55, 0xa7, 0x5e, 0x74, 0x5d, 0xc3, 0x3c, 0x36, 0xe2, 0x5e, 0xb2, 0xe2, 0x6c, 0xa4, 0x56, 0x67,
+	0x5c, 0x06, 0x78, 0x56, 0xa3, 0x74, 0x6a, 0x84, 0xc8, 0x35, 0x62, 0xd2, 0xf9, 0x14, 0xf7, 0xda,
+	0xe4, 0x0c, 0x66, 0x6d, 0x37, 0xcd, 0xda, 0xf6, 0xa0, 0x7d, 0x9f, 0x72, 0x62, 0xf1, 0x5b, 0x3b,
+	0x4a, 0x1b, 0xef, 0xfa, 0x9d, 0x20, 0xee, 0x92, 0x05, 0x67, 0x2a, 0x54, 0xfc, 0xa5, 0x8d, 0xef,
+	0x40, 0xf3, 0x21, 0xcd, 0xe5, 0x35, 0x1d, 0x8a, 0xc3, 0x4b, 0xf7, 0x76, 0xb8, 0x96, 0x5b, 0x3e,
+	0x4c, 0x9e, 0x61, 0xa5, 0xdd, 0xa6, 0xfd, 0x43, 0xca, 0xa5, 0x69, 0x10, 0xf5, 0x3f, 0x23, 0xdf,
+	0x64, 0x85, 0xab, 0x7b, 0x8a, 0x56, 0xb5, 0x1b, 0x1b, 0xf4, 0xc2, 0x17, 0x4a, 0x70, 0x5b, 0xc9,
+	0x71, 0xd2, 0xa7, 0x9a, 0x6a, 0x19, 0x43, 0x53, 0xbb, 0x89, 0x4b, 0x4d, 0xa0, 0xea, 0xad, 0x62,
+	0xae, 0x6b, 0x43, 0x09, 0x3a, 0xdf, 0x60, 0xf5, 0x78, 0xe4, 0x5a, 0x51, 0x0f, 0xbf, 0xac, 0xab,
+	0xa8, 0xe9, 0xf6, 0xa7, 0xe1, 0x30, 0xff, 0x8c, 0x7c, 0xcc, 0x9e, 0xb7, 0xd1, 0xaf, 0x22, 0x29,
+	0xcc, 0xa0, 0xf2, 0xad, 0x25, 0x2e, 0xa9, 0xa2, 0x4c, 0xd3, 0x88, 0x57, 0xc5, 0x34, 0xd0, 0xaf,
+	0x03, 0xe0, 0x05, 0x19, 0xf7, 0x43, 0x3a, 0x4c, 0xe2, 0x62, 0x71, 0x28, 0xae, 0xd0, 0x70, 0x97,
+	0x0c, 0x98, 0xa9, 0xcd, 0x7a, 0xb3, 0xdc, 0xf7, 0x91, 0xb0, 0x25, 0x3f, 0xd7, 0x2c, 0x5f, 0x7d,
+	0xdc, 0x89, 0xe4, 0xb8, 0x89, 0x57, 0x6f, 0xb8, 0xae, 0x2d, 0x87, 0x50, 0x01, 0x0c, 0x35, 0x90,
+	0x37, 0x5d, 0x9f, 0xb5, 0xdf, 0x05, 0x28, 0x0e, 0x1a, 0x29, 0xbb, 0xb1, 0x72, 0x86, 0xc9, 0xbd,
+	0x68, 0xc1, 0xd8, 0x44, 0x65, 0x1f, 0xf1, 0xec, 0x1c, 0x13, 0x5f, 0x2d, 0xe6, 0x8a, 0xc3, 0x29,
+	0x6b, 0xc5, 0x39, 0x5a, 0xe3, 0x28, 0x8b, 0xdb, 0xad, 0x22, 0x44, 0xd1, 0x1d, 0x56, 0x34, 0x10,
+	0x46, 0x28, 0x76, 0x4a, 0x21, 0x82, 0x25, 0x23, 0xb6, 0x47, 0xdc, 0x30, 0xa1, 0xc2, 0x0c, 0xaa,
+	0x87, 0x0a, 0xdc, 0x4b, 0x56, 0x9c, 0xad, 0xf1, 0xc8, 0xfa, 0xfc, 0x84, 0x0a, 0x36, 0x7e, 0x08,
+	0x8b, 0x95, 0x78, 0x6e, 0x25, 0x1f, 0x26, 0x85, 0xd1, 0xbb, 0xd7, 0x26, 0x67, 0xb0, 0x2d, 0x55,
+	0xd9, 0x49, 0x24, 0xb4, 0xcb, 0x8c, 0x1f, 0xdb, 0x2b, 0xc7, 0x01, 0x13, 0x4f, 0x93, 0x6c, 0x13,
+	0x42, 0xb9, 0xdd, 0x2f, 0x9d, 0x99, 0x47, 0xd4, 0x4b, 0x58, 0xbd, 0x2d, 0x22, 0xea, 0xa5, 0x74,
+	0x94, 0x91, 0x5f, 0x84, 0x96, 0x1e, 0xb2, 0xab, 0xe8, 0x68, 0x89, 0x1f, 0x76, 0x2f, 0x59, 0x71,
+	0xf6, 0x4e, 0x61, 0xe1, 0xd8, 0xa9, 0x5f, 0x75, 0x60, 0xc5, 0x1a, 0x8f, 0x4b, 0x64, 0x93, 0xcf,
+	0x8a, 0xfc, 0x75, 0xaf, 0x9f, 0x9d, 0x49, 0xd4, 0xfd, 0x1a, 0xab, 0xfb, 0x9a, 0x77, 0xc9, 0x62,
+	0xe9, 0xdc, 0x16, 0x41, 0xbd, 0xdc, 0x7a, 0x6e, 0x1b, 0x41, 0xaf, 0x4a, 0x79, 0xb7, 0x85, 0xdc,
+	0xba, 0x97, 0xed, 0x48, 0xd3, 0xa3, 0xe8, 0x2d, 0xe9, 0x42, 0xfe, 0x36, 0xbf, 0xed, 0x1e, 0xeb,
+	0x1a, 0x03, 0xa9, 0xc6, 0x59, 0xaa, 0xa9, 0x3c, 0x31, 0xc4, 0xd6, 0x7d, 0xf5, 0x8c, 0x1c, 0xa6,
+	0x9b, 0x83, 0x98, 0x56, 0x4a, 0xc8, 0x2a, 0xf8, 0x04, 0xda, 0x46, 0xac, 0x60, 0x61, 0x9f, 0x58,
+	0x02, 0x15, 0xdd, 0xcb, 0x76, 0xa4, 0xad, 0x8b, 0xaa, 0x9e, 0x03, 0x96, 0x17, 0xbb, 0xf8, 0x77,
+	0x1c, 0xe8, 0x4e, 0x8a, 0xb3, 0x23, 0xf2, 0x39, 0xd6, 0x73, 0x22, 0x0e, 0xdd, 0xd7, 0xcf, 0xcd,
+	0x27, 0x5a, 0xf3, 0x25, 0xd6, 0x9a, 0x2b, 0x5e, 0xd7, 0x1c, 0xe4, 0x22, 0x27, 0x36, 0xe9, 0x18,
+	0x56, 0xcb, 0x32, 0x74, 0xf3, 0xd8, 0x58, 0xd7, 0x27, 0x85, 0xda, 0xb9, 0x17, 0x27, 0xc6, 0x93,
+	0x99, 0xba, 0x8f, 0xaa, 0x5a, 0x97, 0xa2, 0x7d, 0x58, 0x52, 0xf5, 0xaa, 0x48, 0xa7, 0xc2, 0x7e,
+	0xb7, 0x06, 0x54, 0xb9, 0x9d, 0x32, 0xd6, 0x94, 0xd5, 0xdc, 0x1f, 0xa3, 0xd7, 0xf2, 0x09, 0xb4,
+	0xb9, 0xd6, 0x51, 0xe6, 0x5f, 0x5b, 0x3c, 0x94, 0x7b, 0xd9, 0x8e, 0x3c, 0x93, 0x7f, 0x79, 0x00,
+	0x00, 0x52, 0x72, 0x07, 0x96, 0x2c, 0x41, 0x4e, 0xc4, 0xca, 0x9e, 0x46, 0x90, 0x8a, 0x6b, 0x0d,
+	0x81, 0x21, 0xdf, 0x87, 0x35, 0xfe, 0xcd, 0xfa, 0x60, 0x50, 0x8a, 0xa4, 0xb9, 0xaa, 0x7d, 0x60,
+	0x89, 0x10, 0x72, 0x2f, 0x56, 0xf0, 0x32, 0x4a, 0x68, 0x82, 0x8f, 0x83, 0x87, 0xad, 0x90, 0x31,
+	0x74, 0xca, 0xd1, 0x29, 0x64, 0x72, 0x59, 0xca, 0x3b, 0x30, 0x31, 0xa2, 0xe5, 0xa7, 0x58, 0x65,
+	0xaf, 0x78, 0xae, 0xa5, 0x32, 0xe1, 0x06, 0x44, 0xca, 0xfd, 0x25, 0x15, 0x2d, 0x53, 0xea, 0xe7,
+	0x2b, 0xea, 0xd1, 0x10, 0x7b, 0x78, 0x8f, 0x7b, 0xd9, 0xcc, 0x50, 0xaa, 0xde, 0x2e, 0xe5, 0x44,
+	0xf5, 0x29, 0xff, 0x04, 0xeb, 0xff, 0x26, 0xac, 0x95, 0xe7, 0x80, 0x6c, 0xc1, 0x35, 0xdb, 0xd0,
+	0x4c, 0x9c, 0x05, 0x26, 0x7d, 0x98, 0x3d, 0xdc, 0xd2, 0x83, 0x6b, 0xd4, 0x62, 0x61, 0x89, 0xf3,
+	0x71, 0x2f, 0x59, 0x71, 0x36, 0x5b, 0x50, 0x6e, 0xc9, 0x72, 0x09, 0xbd, 0x50, 0x0a, 0x95, 0x51,
+	0x1e, 0x3d, 0x7b, 0x70, 0x8d, 0x7b, 0x75, 0x12, 0x5a, 0x54, 0x65, 0xec, 0x8f, 0xc8, 0xaa, 0x6e,
+	0x47, 0xfd, 0x8c, 0x9c, 0x40, 0xa7, 0x1c, 0x1a, 0xa3, 0x58, 0x71, 0x42, 0xc0, 0x8d, 0xfb, 0xca,
+	0x44, 0xbc, 0xa8, 0x4e, 0x6c, 0x39, 0xdc, 0x74, 0x8d, 0xea, 0x3e, 0xd5, 0x42, 0x72, 0x3e, 0x23,
+	0x29, 0xef, 0xa4, 0x16, 0x67, 0x62, 0x74, 0xb2, 0x1a, 0x1e, 0xe3, 0x5e, 0x9d, 0x84, 0x36, 0x77,
+	0x21, 0x48, 0xd7, 0xa8, 0x55, 0x8f, 0x9c, 0xfa, 0x0c, 0xba, 0x32, 0x10, 0xa4, 0x14, 0x30, 0x92,
+	0x69, 0x86, 0x48, 0x25, 0x32, 0xc5, 0xbd, 0x64, 0xc5, 0x99, 0x0a, 0xb8, 0x77, 0xc5, 0xa8, 0xb6,
+	0x87, 0x59, 0xb5, 0xba, 0x71, 0x5c, 0x3f, 0x82, 0x15, 0xbe, 0xbb, 0x4f, 0x53, 0x23, 0x34, 0x41,
+	0x49, 0x48, 0x6b, 0xc0, 0x82, 0x7b, 0xc9, 0x8e, 0x65, 0x4d, 0x63, 0xce, 0x8f, 0x1c, 0x16, 0x2b,
+	0x4f, 0xb5, 0xaa, 0x79, 0x36, 0xe9, 0x71, 0x58, 0xf7, 0xda, 0xe4, 0x0c, 0x36, 0x0f, 0x3f, 0x0f,
+	0x1d, 0xd0, 0x3c, 0xfc, 0x9f, 0xe9, 0x33, 0x4c, 0xff, 0x3e, 0x23, 0x3f, 0x55, 0xde, 0x50, 0xb5,
+	0xbe, 0x11, 0x5b, 0xc8, 0x48, 0x1d, 0x2b, 0x97, 0x38, 0x72, 0xa9, 0x52, 0xab, 0xb1, 0x0c, 0xfc,
+	0x12, 0x2c, 0xdb, 0x1e, 0xa0, 0x2e, 0x14, 0xc5, 0xc9, 0x4f, 0x62, 0xbb, 0x5f, 0x3a, 0x33, 0x8f,
+	0xcd, 0x73, 0x8c, 0x41, 0xea, 0xb7, 0xd3, 0xa2, 0x96, 0x1f, 0x3a, 0xb0, 0x6a, 0x7f, 0x59, 0x95,
+	0x5c, 0x37, 0xd6, 0xf2, 0x09, 0x8f, 0xcc, 0xba, 0x3f, 0x75, 0x4e, 0x2e, 0xdb, 0x8e, 0x17, 0xda,
+	0x8f, 0xa1, 0x96, 0x8b, 0xab, 0xe7, 0x0b, 0xa5, 0xe7, 0x53, 0xf5, 0x3d, 0x42, 0xcb, 0x8b, 0xab,
+	0xee, 0xd5, 0x49, 0x68, 0x5b, 0xbf, 0x0f, 0x69, 0x2e, 0xdf, 0x50, 0x45, 0x53, 0x6f, 0x7f, 0x7a,
+	0x94, 0x26, 0x79, 0xf2, 0xd6, 0xff, 0x1b, 0x00, 0xa6, 0xcc, 0x23, 0x24, 0x38, 0x96, 0x00, 0x00,
 }
@@ -0,0 +1,279 @@
+package lnrpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// MethodOverrideParam is the name of the query parameter that can be
+	// used to pick the HTTP method of a request proxied over a WebSocket.
+	// As the upgrade request is always a GET, this is needed to reach
+	// endpoints bound to other methods, such as POST /v1/channels/stream.
+	MethodOverrideParam = "method"
+
+	// HeaderWebSocketProtocol is the name of the header through which
+	// WebSocket clients offer their sub-protocols.
+	HeaderWebSocketProtocol = "Sec-Websocket-Protocol"
+
+	// WebSocketProtocolDelimiter separates the name of a header from its
+	// value within a WebSocket sub-protocol. As browsers can't set custom
+	// headers on WebSocket requests, a macaroon can instead be passed as
+	// the sub-protocol "Grpc-Metadata-Macaroon+<hex macaroon>".
+	WebSocketProtocolDelimiter = "+"
+
+	// WebSocketProtocolName is the sub-protocol selected by the proxy.
+	// Browsers fail the connection unless one of the sub-protocols they
+	// offered is selected, so clients passing headers as sub-protocols
+	// should offer it alongside them.
+	WebSocketProtocolName = "Grpc-Websockets"
+
+	// AllowAllOrigins can be passed as an allowed origin to accept
+	// WebSocket connections from any origin.
+	AllowAllOrigins = "*"
+)
+
+// WebSocketProxy is an http.Handler that wraps the REST proxy, allowing its
+// streaming endpoints to be consumed by web clients over WebSocket. Regular
+// HTTP requests are passed through untouched to the REST proxy.
+type WebSocketProxy struct {
+	backend http.Handler
+
+	// allowedOrigins is the set of origins from which browsers may open
+	// WebSocket connections.
+	allowedOrigins map[string]struct{}
+}
+
+// NewWebSocketProxy returns a new WebSocketProxy wrapping the passed REST
+// proxy handler. WebSocket connections are only accepted from the passed
+// origins, or any origin if AllowAllOrigins is among them. Connections that
+// carry no origin are only accepted if AllowAllOrigins is among them.
+func NewWebSocketProxy(backend http.Handler,
+	allowedOrigins []string) *WebSocketProxy {
+
+	origins := make(map[string]struct{}, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		origins[normalizeOrigin(origin)] = struct{}{}
+	}
+
+	return &WebSocketProxy{
+		backend:        backend,
+		allowedOrigins: origins,
+	}
+}
+
+// ServeHTTP upgrades WebSocket requests and proxies them to the wrapped
+// handler, while every other request is served directly by it.
+//
+// NOTE: This is part of the http.Handler interface.
+func (p *WebSocketProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		p.backend.ServeHTTP(w, r)
+		return
+	}
+
+	server := websocket.Server{
+		Handshake: p.handshake,
+		Handler:   p.proxyStream,
+	}
+	server.ServeHTTP(w, r)
+}
+
+// handshake accepts the WebSocket upgrade if it comes from an allowed origin.
+// Otherwise, any website could have the browsers visiting it open connections
+// to the proxy. Upgrades without an origin are rejected as well, unless all
+// origins are allowed, as we can't tell where they come from. If offered, WebSocketProtocolName is selected as the
+// sub-protocol. The other sub-protocols are never echoed back, as they may
+// carry credentials such as macaroons.
+func (p *WebSocketProxy) handshake(config *websocket.Config,
+	r *http.Request) error {
+
+	origin := r.Header.Get("Origin")
+	if !p.isOriginAllowed(origin) {
+		return fmt.Errorf("origin %q not allowed", origin)
+	}
+
+	var selected []string
+	for _, protocol := range config.Protocol {
+		if protocol == WebSocketProtocolName {
+			selected = []string{WebSocketProtocolName}
+			break
+		}
+	}
+	config.Protocol = selected
+
+	return nil
+}
+
+// isOriginAllowed returns true if WebSocket connections may be opened from
+// the passed origin. An empty origin is only allowed if all origins are.
+func (p *WebSocketProxy) isOriginAllowed(origin string) bool {
+	if _, ok := p.allowedOrigins[AllowAllOrigins]; ok {
+		return true
+	}
+
+	if origin == "" {
+		return false
+	}
+
+	_, ok := p.allowedOrigins[normalizeOrigin(origin)]
+	return ok
+}
+
+// normalizeOrigin returns the passed origin in a form suitable for
+// comparison, as origins are case-insensitive.
+func normalizeOrigin(origin string) string {
+	return strings.ToLower(strings.TrimSuffix(origin, "/"))
+}
+
+// proxyStream forwards the request that was upgraded to the wrapped handler,
+// and sends each of the newline-delimited JSON messages of its response as a
+// text frame. For endpoints expecting a request body, it must be sent by the
+// client as the first message once the connection is established. The
+// request is canceled as soon as the client goes away.
+func (p *WebSocketProxy) proxyStream(conn *websocket.Conn) {
+	defer conn.Close()
+
+	req := conn.Request()
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+
+	method := req.URL.Query().Get(MethodOverrideParam)
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body []byte
+	if method != http.MethodGet {
+		if err := websocket.Message.Receive(conn, &body); err != nil {
+			return
+		}
+	}
+
+	proxyReq, err := http.NewRequest(
+		method, req.URL.String(), bytes.NewReader(body),
+	)
+	if err != nil {
+		return
+	}
+	proxyReq = proxyReq.WithContext(ctx)
+
+	// Carry over the headers of the upgrade request, along with the ones
+	// passed as sub-protocols, so that macaroons reach the RPC server.
+	for name, values := range req.Header {
+		if isWebSocketHeader(name) {
+			continue
+		}
+		for _, value := range values {
+			proxyReq.Header.Add(name, value)
+		}
+	}
+	for _, protocol := range req.Header[HeaderWebSocketProtocol] {
+		for _, sub := range strings.Split(protocol, ",") {
+			parts := strings.SplitN(
+				strings.TrimSpace(sub),
+				WebSocketProtocolDelimiter, 2,
+			)
+			if len(parts) != 2 {
+				continue
+			}
+			proxyReq.Header.Set(parts[0], parts[1])
+		}
+	}
+
+	// Any message received from now on is ignored, we only watch for the
+	// client closing the connection to cancel the request.
+	go func() {
+		defer cancel()
+
+		for {
+			var msg []byte
+			err := websocket.Message.Receive(conn, &msg)
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	respReader, respWriter := io.Pipe()
+	defer respReader.Close()
+
+	go func() {
+		p.backend.ServeHTTP(newWsResponseWriter(respWriter), proxyReq)
+		respWriter.Close()
+	}()
+
+	reader := bufio.NewReader(respReader)
+	for {
+		line, err := reader.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			sendErr := websocket.Message.Send(conn, string(line))
+			if sendErr != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// isWebSocketHeader returns true if the header with the passed name is only
+// relevant to the WebSocket upgrade, and shouldn't be proxied.
+func isWebSocketHeader(name string) bool {
+	switch http.CanonicalHeaderKey(name) {
+	case "Upgrade", "Connection", "Origin":
+		return true
+	}
+
+	return strings.HasPrefix(http.CanonicalHeaderKey(name), "Sec-Websocket")
+}
+
+// wsResponseWriter is an http.ResponseWriter that pipes the response of the
+// REST proxy to the WebSocket connection it was requested from.
+type wsResponseWriter struct {
+	header http.Header
+	writer io.Writer
+}
+
+// newWsResponseWriter returns a new wsResponseWriter writing to the passed
+// writer.
+func newWsResponseWriter(writer io.Writer) *wsResponseWriter {
+	return &wsResponseWriter{
+		header: make(http.Header),
+		writer: writer,
+	}
+}
+
+// Header returns the headers of the response, which are discarded.
+//
+// NOTE: This is part of the http.ResponseWriter interface.
+func (w *wsResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// Write writes the passed bytes of the response body.
+//
+// NOTE: This is part of the http.ResponseWriter interface.
+func (w *wsResponseWriter) Write(b []byte) (int, error) {
+	return w.writer.Write(b)
+}
+
+// WriteHeader is a no-op, as errors are sent to the client within the body.
+//
+// NOTE: This is part of the http.ResponseWriter interface.
+func (w *wsResponseWriter) WriteHeader(int) {}
+
+// Flush is a no-op, as every write is directly piped to the client. It's
+// still required by the REST proxy to serve streaming responses.
+//
+// NOTE: This is part of the http.Flusher interface.
+func (w *wsResponseWriter) Flush() {}
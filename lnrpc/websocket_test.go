@@ -0,0 +1,152 @@
+package lnrpc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/websocket"
+)
+
+// TestWebSocketProxy asserts that the WebSocketProxy forwards the request
+// body, method and sub-protocol headers to the wrapped handler, and sends
+// each line of its response as a separate message. Connections should only
+// be accepted from allowed origins, and the sub-protocols carrying headers
+// should never be echoed back.
+func TestWebSocketProxy(t *testing.T) {
+	t.Parallel()
+
+	backend := http.HandlerFunc(func(w http.ResponseWriter,
+		r *http.Request) {
+
+		body, _ := ioutil.ReadAll(r.Body)
+		fmt.Fprintf(w, "%s %s\n", r.Method, r.URL.Path)
+		fmt.Fprintf(w, "%s\n", body)
+		fmt.Fprintf(w, "%s\n", r.Header.Get("Grpc-Metadata-Macaroon"))
+	})
+
+	// Our connections originate from the server itself, so we'll only
+	// allow its origin.
+	server := httptest.NewUnstartedServer(nil)
+	origin := "http://" + server.Listener.Addr().String()
+	server.Config.Handler = NewWebSocketProxy(
+		backend, []string{origin + "/"},
+	)
+	server.Start()
+	defer server.Close()
+
+	// Regular requests should be passed through untouched.
+	resp, err := http.Get(server.URL + "/v1/getinfo")
+	if err != nil {
+		t.Fatalf("unable to query proxy: %v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.HasPrefix(string(body), "GET /v1/getinfo\n") {
+		t.Fatalf("unexpected response: %q", body)
+	}
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") +
+		"/v1/channels/stream?" + MethodOverrideParam + "=POST"
+
+	// Connections from other origins should be rejected.
+	config, err := websocket.NewConfig(wsURL, "http://example.com")
+	if err != nil {
+		t.Fatalf("unable to create config: %v", err)
+	}
+	if _, err := websocket.DialConfig(config); err == nil {
+		t.Fatalf("expected connection from other origin to fail")
+	}
+
+	// So should connections without any origin, as all origins aren't
+	// allowed.
+	status := upgradeWithoutOrigin(t, server.URL+"/v1/channels/stream")
+	if status != http.StatusForbidden {
+		t.Fatalf("expected status %v for connection without origin, "+
+			"got %v", http.StatusForbidden, status)
+	}
+
+	config, err = websocket.NewConfig(wsURL, origin)
+	if err != nil {
+		t.Fatalf("unable to create config: %v", err)
+	}
+	config.Protocol = []string{
+		"Grpc-Metadata-Macaroon+abcd", WebSocketProtocolName,
+	}
+
+	conn, err := websocket.DialConfig(config)
+	if err != nil {
+		t.Fatalf("unable to dial proxy: %v", err)
+	}
+	defer conn.Close()
+
+	protocol := conn.Config().Protocol
+	if len(protocol) != 1 || protocol[0] != WebSocketProtocolName {
+		t.Fatalf("expected protocol %v, got %v", WebSocketProtocolName,
+			protocol)
+	}
+
+	reqBody := `{"local_funding_amount":1}`
+	if err := websocket.Message.Send(conn, reqBody); err != nil {
+		t.Fatalf("unable to send request: %v", err)
+	}
+
+	expectedMsgs := []string{
+		"POST /v1/channels/stream", reqBody, "abcd",
+	}
+	for _, expected := range expectedMsgs {
+		var msg string
+		if err := websocket.Message.Receive(conn, &msg); err != nil {
+			t.Fatalf("unable to receive message: %v", err)
+		}
+		if msg != expected {
+			t.Fatalf("expected message %q, got %q", expected, msg)
+		}
+	}
+}
+
+// TestWebSocketProxyAllowAllOrigins asserts that connections without an
+// origin are accepted once all origins are allowed.
+func TestWebSocketProxyAllowAllOrigins(t *testing.T) {
+	t.Parallel()
+
+	backend := http.HandlerFunc(func(w http.ResponseWriter,
+		r *http.Request) {
+	})
+	server := httptest.NewServer(
+		NewWebSocketProxy(backend, []string{AllowAllOrigins}),
+	)
+	defer server.Close()
+
+	status := upgradeWithoutOrigin(t, server.URL+"/v1/channels/stream")
+	if status != http.StatusSwitchingProtocols {
+		t.Fatalf("expected status %v for connection without origin, "+
+			"got %v", http.StatusSwitchingProtocols, status)
+	}
+}
+
+// upgradeWithoutOrigin sends a WebSocket upgrade request without an Origin
+// header to the passed URL, and returns the status code of the response.
+func upgradeWithoutOrigin(t *testing.T, url string) int {
+	t.Helper()
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		t.Fatalf("unable to create request: %v", err)
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unable to send upgrade request: %v", err)
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode
+}
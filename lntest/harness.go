@@ -73,6 +73,10 @@ type NetworkHarness struct {
 	// to main process.
 	lndErrorChan chan error
 
+	// proxies are the network proxies that have been created between
+	// nodes, which are closed once the harness is torn down.
+	proxies []*NetworkProxy
+
 	quit chan struct{}
 
 	mtx sync.Mutex
@@ -254,6 +258,10 @@ func (n *NetworkHarness) TearDownAll() error {
 		}
 	}
 
+	for _, proxy := range n.proxies {
+		proxy.Close()
+	}
+
 	close(n.lndErrorChan)
 	close(n.quit)
 
@@ -521,6 +529,40 @@ func (n *NetworkHarness) EnsureConnected(ctx context.Context, a, b *HarnessNode)
 // NOTE: This function may block for up to 15-seconds as it will not return
 // until the new connection is detected as being known to both nodes.
 func (n *NetworkHarness) ConnectNodes(ctx context.Context, a, b *HarnessNode) error {
+	return n.connectNodesAddr(ctx, a, b, b.cfg.P2PAddr())
+}
+
+// ConnectNodesViaProxy establishes a p2p connection from node a towards node b
+// through a NetworkProxy that simulates the given network conditions. The
+// returned proxy can be used to change the conditions, or to sever the
+// connection, over the course of the test.
+//
+// NOTE: Only connections initiated by node a pass through the proxy. If node
+// b reconnects to node a on its own, the connection will be direct.
+func (n *NetworkHarness) ConnectNodesViaProxy(ctx context.Context, a,
+	b *HarnessNode, conds LinkConditions) (*NetworkProxy, error) {
+
+	proxy, err := NewNetworkProxy(b.cfg.P2PAddr(), conds)
+	if err != nil {
+		return nil, err
+	}
+
+	n.mtx.Lock()
+	n.proxies = append(n.proxies, proxy)
+	n.mtx.Unlock()
+
+	if err := n.connectNodesAddr(ctx, a, b, proxy.Addr()); err != nil {
+		return nil, err
+	}
+
+	return proxy, nil
+}
+
+// connectNodesAddr establishes a p2p connection from node a towards node b,
+// dialing node b at the given address.
+func (n *NetworkHarness) connectNodesAddr(ctx context.Context, a,
+	b *HarnessNode, addr string) error {
+
 	bobInfo, err := b.GetInfo(ctx, &lnrpc.GetInfoRequest{})
 	if err != nil {
 		return err
@@ -529,7 +571,7 @@ func (n *NetworkHarness) ConnectNodes(ctx context.Context, a, b *HarnessNode) er
 	req := &lnrpc.ConnectPeerRequest{
 		Addr: &lnrpc.LightningAddress{
 			Pubkey: bobInfo.IdentityPubkey,
-			Host:   b.cfg.P2PAddr(),
+			Host:   addr,
 		},
 	}
 
@@ -0,0 +1,272 @@
+package lntest
+
+import (
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// proxyBufferSize is the size of the chunks read from each side of a
+	// proxied connection.
+	proxyBufferSize = 64 * 1024
+
+	// proxyQueueSize is the number of chunks that may be in flight in each
+	// direction of a proxied connection.
+	proxyQueueSize = 1024
+)
+
+// LinkConditions describes the network conditions simulated by a
+// NetworkProxy.
+type LinkConditions struct {
+	// Latency is the delay added to all data forwarded in either
+	// direction.
+	Latency time.Duration
+
+	// Jitter is the upper bound of an additional random delay added to
+	// each chunk of data. The bytes within each direction of a connection
+	// are never reordered, as that would corrupt the stream, but jitter
+	// reorders messages sent in opposite directions relative to each
+	// other.
+	Jitter time.Duration
+
+	// DisconnectProb is the probability with which forwarding a chunk of
+	// data severs the connection instead, simulating a lossy link that
+	// eventually causes the transport to fail.
+	DisconnectProb float64
+}
+
+// NetworkProxy is a TCP proxy that sits between two nodes, injecting
+// latency, jitter and disconnects into their connection. It allows
+// integration tests to regularly exercise the reconnection, retransmission
+// and timeout logic of the nodes.
+type NetworkProxy struct {
+	target   string
+	listener net.Listener
+
+	mtx   sync.Mutex
+	conds LinkConditions
+	rand  *rand.Rand
+	conns map[net.Conn]struct{}
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewNetworkProxy creates a proxy listening on a random local port, which
+// forwards all connections to the target address under the given network
+// conditions.
+func NewNetworkProxy(target string, conds LinkConditions) (*NetworkProxy,
+	error) {
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	p := &NetworkProxy{
+		target:   target,
+		listener: listener,
+		conds:    conds,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		conns:    make(map[net.Conn]struct{}),
+		quit:     make(chan struct{}),
+	}
+
+	p.wg.Add(1)
+	go p.acceptConns()
+
+	return p, nil
+}
+
+// Addr returns the address that should be dialed to reach the target through
+// the proxy.
+func (p *NetworkProxy) Addr() string {
+	return p.listener.Addr().String()
+}
+
+// SetConditions changes the network conditions simulated by the proxy. The
+// new conditions apply to all data forwarded from now on, including that of
+// existing connections.
+func (p *NetworkProxy) SetConditions(conds LinkConditions) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.conds = conds
+}
+
+// DisconnectAll severs all connections currently passing through the proxy.
+// New connections are still accepted afterwards.
+func (p *NetworkProxy) DisconnectAll() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for conn := range p.conns {
+		conn.Close()
+	}
+}
+
+// Close severs all connections, and stops accepting new ones.
+func (p *NetworkProxy) Close() error {
+	select {
+	case <-p.quit:
+		return nil
+	default:
+	}
+
+	close(p.quit)
+	err := p.listener.Close()
+	p.DisconnectAll()
+	p.wg.Wait()
+
+	return err
+}
+
+// acceptConns accepts incoming connections, and proxies each of them to the
+// target.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *NetworkProxy) acceptConns() {
+	defer p.wg.Done()
+
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		target, err := net.Dial("tcp", p.target)
+		if err != nil {
+			conn.Close()
+			continue
+		}
+
+		if !p.trackConns(conn, target) {
+			return
+		}
+
+		p.wg.Add(2)
+		go p.forward(conn, target)
+		go p.forward(target, conn)
+	}
+}
+
+// trackConns records both sides of a proxied connection, such that they can
+// be severed later. It returns false if the proxy is shutting down.
+func (p *NetworkProxy) trackConns(conns ...net.Conn) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	select {
+	case <-p.quit:
+		for _, conn := range conns {
+			conn.Close()
+		}
+		return false
+	default:
+	}
+
+	for _, conn := range conns {
+		p.conns[conn] = struct{}{}
+	}
+
+	return true
+}
+
+// sever closes both sides of a proxied connection.
+func (p *NetworkProxy) sever(src, dst net.Conn) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	src.Close()
+	dst.Close()
+	delete(p.conns, src)
+	delete(p.conns, dst)
+}
+
+// chunkFate decides whether the next chunk of data severs the connection,
+// and if not, the delay after which it's delivered.
+func (p *NetworkProxy) chunkFate() (time.Duration, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.rand.Float64() < p.conds.DisconnectProb {
+		return 0, false
+	}
+
+	delay := p.conds.Latency
+	if p.conds.Jitter > 0 {
+		delay += time.Duration(p.rand.Int63n(int64(p.conds.Jitter)))
+	}
+
+	return delay, true
+}
+
+// delayedChunk is a chunk of data that's due to be delivered at a later time.
+type delayedChunk struct {
+	data      []byte
+	deliverAt time.Time
+}
+
+// forward copies data from src to dst, delaying each chunk according to the
+// current network conditions. Reading is decoupled from writing, so the
+// delay adds latency without limiting throughput.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *NetworkProxy) forward(src, dst net.Conn) {
+	defer p.wg.Done()
+	defer p.sever(src, dst)
+
+	chunks := make(chan *delayedChunk, proxyQueueSize)
+	writerDone := make(chan struct{})
+
+	go func() {
+		defer close(writerDone)
+
+		for chunk := range chunks {
+			time.Sleep(time.Until(chunk.deliverAt))
+
+			if _, err := dst.Write(chunk.data); err != nil {
+				p.sever(src, dst)
+				return
+			}
+		}
+	}()
+
+	var lastDelivery time.Time
+	buf := make([]byte, proxyBufferSize)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			delay, ok := p.chunkFate()
+			if !ok {
+				p.sever(src, dst)
+				break
+			}
+
+			// Chunks are never delivered before those read prior
+			// to them, so the stream remains intact.
+			deliverAt := time.Now().Add(delay)
+			if deliverAt.Before(lastDelivery) {
+				deliverAt = lastDelivery
+			}
+			lastDelivery = deliverAt
+
+			data := make([]byte, n)
+			copy(data, buf[:n])
+
+			select {
+			case chunks <- &delayedChunk{data, deliverAt}:
+			case <-writerDone:
+				return
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	close(chunks)
+	<-writerDone
+}
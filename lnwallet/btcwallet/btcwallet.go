@@ -31,6 +31,10 @@ var (
 	// stored within the top-level waleltdb buckets of btcwallet.
 	waddrmgrNamespaceKey = []byte("waddrmgr")
 
+	// wtxmgrNamespaceKey is the namespace key that the wtxmgr state is
+	// stored within the top-level walletdb buckets of btcwallet.
+	wtxmgrNamespaceKey = []byte("wtxmgr")
+
 	// lightningAddrSchema is the scope addr schema for all keys that we
 	// derive. We'll treat them all as p2wkh addresses, as atm we must
 	// specify a particular type.
@@ -229,6 +233,35 @@ func (b *BtcWallet) ConfirmedBalance(confs int32) (btcutil.Amount, error) {
 	return balance, nil
 }
 
+// LockedBalance returns the sum of all the wallet's unspent outputs that are
+// currently locked, and are therefore excluded from coin selection.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) LockedBalance() (btcutil.Amount, error) {
+	var balance btcutil.Amount
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		unspent, err := b.wallet.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+
+		for _, output := range unspent {
+			if b.wallet.LockedOutpoint(output.OutPoint) {
+				balance += output.Amount
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return balance, nil
+}
+
 // NewAddress returns the next external or internal address for the wallet
 // dictated by the value of the `change` parameter. If change is true, then an
 // internal address will be returned, otherwise an external address should be
@@ -262,19 +295,130 @@ func (b *BtcWallet) IsOurAddress(a btcutil.Address) bool {
 	return result && (err == nil)
 }
 
+// ListAddresses returns all addresses derived by the wallet for the default
+// account, both external and internal ones, along with their derivation paths
+// and balances.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ListAddresses() ([]*lnwallet.AddressProperty, error) {
+	// First, we'll sum up the value of all unspent outputs, including the
+	// unconfirmed ones, for each address.
+	unspentOutputs, err := b.wallet.ListUnspent(0, math.MaxInt32, nil)
+	if err != nil {
+		return nil, err
+	}
+	balances := make(map[string]btcutil.Amount)
+	for _, output := range unspentOutputs {
+		amt, err := btcutil.NewAmount(output.Amount)
+		if err != nil {
+			return nil, err
+		}
+		balances[output.Address] += amt
+	}
+
+	var addrs []*lnwallet.AddressProperty
+	err = walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		scopedAddrs, err := listScopeAddresses(
+			b.wallet.Manager, addrmgrNs, waddrmgr.KeyScopeBIP0084,
+			lnwallet.WitnessPubKey,
+		)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, scopedAddrs...)
+
+		scopedAddrs, err = listScopeAddresses(
+			b.wallet.Manager, addrmgrNs,
+			waddrmgr.KeyScopeBIP0049Plus,
+			lnwallet.NestedWitnessPubKey,
+		)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, scopedAddrs...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, addr := range addrs {
+		addr.Balance = balances[addr.Address.EncodeAddress()]
+	}
+
+	return addrs, nil
+}
+
+// listScopeAddresses derives all addresses of the default account within the
+// passed key scope that have been handed out so far, on both the external and
+// the internal branch.
+func listScopeAddresses(addrMgr *waddrmgr.Manager,
+	addrmgrNs walletdb.ReadBucket, keyScope waddrmgr.KeyScope,
+	addrType lnwallet.AddressType) ([]*lnwallet.AddressProperty, error) {
+
+	scopedMgr, err := addrMgr.FetchScopedKeyManager(keyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key counts of the account tell us how many addresses have been
+	// derived on each branch.
+	props, err := scopedMgr.AccountProperties(addrmgrNs, defaultAccount)
+	if err != nil {
+		return nil, err
+	}
+	numKeys := map[uint32]uint32{
+		waddrmgr.ExternalBranch: props.ExternalKeyCount,
+		waddrmgr.InternalBranch: props.InternalKeyCount,
+	}
+
+	var addrs []*lnwallet.AddressProperty
+	for _, branch := range []uint32{
+		waddrmgr.ExternalBranch, waddrmgr.InternalBranch,
+	} {
+		for i := uint32(0); i < numKeys[branch]; i++ {
+			path := waddrmgr.DerivationPath{
+				Account: defaultAccount,
+				Branch:  branch,
+				Index:   i,
+			}
+			addr, err := scopedMgr.DeriveFromKeyPath(addrmgrNs, path)
+			if err != nil {
+				return nil, err
+			}
+
+			addrs = append(addrs, &lnwallet.AddressProperty{
+				Address:     addr.Address(),
+				AddressType: addrType,
+				Internal:    branch == waddrmgr.InternalBranch,
+				DerivationPath: fmt.Sprintf("m/%d'/%d'/%d'/%d/%d",
+					keyScope.Purpose, keyScope.Coin,
+					defaultAccount, branch, i),
+			})
+		}
+	}
+
+	return addrs, nil
+}
+
 // SendOutputs funds, signs, and broadcasts a Bitcoin transaction paying out to
 // the specified outputs. In the case the wallet has insufficient funds, or the
 // outputs are non-standard, a non-nil error will be returned.
 //
 // This is a part of the WalletController interface.
 func (b *BtcWallet) SendOutputs(outputs []*wire.TxOut,
-	feeRate lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+	feeRate lnwallet.SatPerKWeight, minConfs int32) (*wire.MsgTx, error) {
 
 	// Convert our fee rate from sat/kw to sat/kb since it's required by
 	// SendOutputs.
 	feeSatPerKB := btcutil.Amount(feeRate.FeePerKVByte())
 
-	return b.wallet.SendOutputs(outputs, defaultAccount, 1, feeSatPerKB)
+	return b.wallet.SendOutputs(
+		outputs, defaultAccount, minConfs, feeSatPerKB,
+	)
 }
 
 // LockOutpoint marks an outpoint as locked meaning it will no longer be deemed
@@ -350,6 +494,7 @@ func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 					Index: output.Vout,
 				},
 				Confirmations: output.Confirmations,
+				Account:       output.Account,
 			}
 			witnessOutputs = append(witnessOutputs, utxo)
 		}
@@ -523,6 +668,7 @@ func minedTransactionsToDetails(
 // for an unconfirmed transaction to a transaction detail.
 func unminedTransactionsToDetail(
 	summary base.TransactionSummary,
+	chainParams *chaincfg.Params,
 ) (*lnwallet.TransactionDetail, error) {
 	wireTx := &wire.MsgTx{}
 	txReader := bytes.NewReader(summary.Transaction)
@@ -531,10 +677,23 @@ func unminedTransactionsToDetail(
 		return nil, err
 	}
 
+	var destAddresses []btcutil.Address
+	for _, txOut := range wireTx.TxOut {
+		_, outAddresses, _, err := txscript.ExtractPkScriptAddrs(
+			txOut.PkScript, chainParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		destAddresses = append(destAddresses, outAddresses...)
+	}
+
 	txDetail := &lnwallet.TransactionDetail{
-		Hash:      *summary.Hash,
-		TotalFees: int64(summary.Fee),
-		Timestamp: summary.Timestamp,
+		Hash:          *summary.Hash,
+		TotalFees:     int64(summary.Fee),
+		Timestamp:     summary.Timestamp,
+		DestAddresses: destAddresses,
 	}
 
 	balanceDelta, err := extractBalanceDelta(summary, wireTx)
@@ -581,7 +740,7 @@ func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, err
 		txDetails = append(txDetails, details...)
 	}
 	for _, tx := range txns.UnminedTransactions {
-		detail, err := unminedTransactionsToDetail(tx)
+		detail, err := unminedTransactionsToDetail(tx, b.netParams)
 		if err != nil {
 			return nil, err
 		}
@@ -668,7 +827,9 @@ out:
 			// notifications for any newly unconfirmed transactions.
 			go func() {
 				for _, tx := range txNtfn.UnminedTransactions {
-					detail, err := unminedTransactionsToDetail(tx)
+					detail, err := unminedTransactionsToDetail(
+						tx, t.w.ChainParams(),
+					)
 					if err != nil {
 						continue
 					}
@@ -753,3 +914,61 @@ func (b *BtcWallet) IsSynced() (bool, int64, error) {
 
 	return true, bestTimestamp, nil
 }
+
+// GetRecoveryInfo returns a boolean indicating whether the wallet was started
+// in recovery mode, along with the progress of the recovery, ranging from 0 to
+// 1.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) GetRecoveryInfo() (bool, float64, error) {
+	// A zero recovery window means that no recovery was requested.
+	if b.cfg.RecoveryWindow == 0 {
+		return false, 0, nil
+	}
+
+	// The recovery scans the chain starting from the birthday block of
+	// the wallet, so we'll use it as the starting point of our progress.
+	var birthdayBlock waddrmgr.BlockStamp
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		var err error
+		birthdayBlock, _, err = b.wallet.Manager.BirthdayBlock(
+			addrmgrNs,
+		)
+		return err
+	})
+	switch {
+	// The birthday block is only set once the wallet has synced with the
+	// chain backend, so until then no progress has been made.
+	case waddrmgr.IsError(err, waddrmgr.ErrBirthdayBlockNotSet):
+		return true, 0, nil
+
+	case err != nil:
+		return true, 0, err
+	}
+
+	syncState := b.wallet.Manager.SyncedTo()
+	_, bestHeight, err := b.cfg.ChainSource.GetBestBlock()
+	if err != nil {
+		return true, 0, err
+	}
+
+	// If the wallet hasn't yet reached its birthday, or the chain was
+	// rolled back past it, then no progress has been made.
+	if syncState.Height < birthdayBlock.Height ||
+		bestHeight < birthdayBlock.Height {
+
+		return true, 0, nil
+	}
+
+	// The progress is the ratio of blocks scanned since the birthday over
+	// the number of blocks between the birthday and the chain tip.
+	progress := float64(syncState.Height-birthdayBlock.Height+1) /
+		float64(bestHeight-birthdayBlock.Height+1)
+	if progress > 1 {
+		progress = 1
+	}
+
+	return true, progress, nil
+}
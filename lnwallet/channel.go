@@ -100,6 +100,19 @@ var (
 	// both parties can retrieve their funds.
 	ErrCommitSyncRemoteDataLoss = fmt.Errorf("possible remote commitment " +
 		"state data loss")
+
+	// ErrForceCloseLocalDataLoss is returned when attempting to force
+	// close a channel for which we've detected that our state is stale.
+	// Broadcasting our latest known commitment would likely be seen as a
+	// breach by the remote party, so we must instead wait for them to
+	// force close the channel.
+	ErrForceCloseLocalDataLoss = fmt.Errorf("cannot force close channel " +
+		"with local data loss")
+
+	// ErrDanglingUpdates is returned when attempting to renegotiate the
+	// flow constraints of a channel which still has updates that haven't
+	// been irrevocably committed by both parties.
+	ErrDanglingUpdates = fmt.Errorf("channel has dangling updates")
 )
 
 // channelState is an enum like type which represents the current state of a
@@ -301,6 +314,14 @@ type PaymentDescriptor struct {
 	// NOTE: Populated only on add payment descriptor entry types.
 	OnionBlob []byte
 
+	// Endorsed indicates whether the sender of the HTLC signaled that it
+	// vouches for it.
+	//
+	// NOTE: Populated only on add payment descriptor entry types. It's
+	// persisted within the log updates of forwarding packages and commit
+	// diffs, but not within the HTLCs of a commitment.
+	Endorsed bool
+
 	// ShaOnionBlob is a sha of the onion blob.
 	//
 	// NOTE: Populated only in payment descriptor with MalfromedFail type.
@@ -375,6 +396,7 @@ func PayDescsFromRemoteLogUpdates(chanID lnwire.ShortChannelID, height uint64,
 				EntryType: Add,
 				HtlcIndex: wireMsg.ID,
 				LogIndex:  logUpdate.LogIndex,
+				Endorsed:  wireMsg.Endorsed,
 				SourceRef: &channeldb.AddRef{
 					Height: height,
 					Index:  uint16(i),
@@ -1527,6 +1549,7 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 			EntryType:             Add,
 			HtlcIndex:             wireMsg.ID,
 			LogIndex:              logUpdate.LogIndex,
+			Endorsed:              wireMsg.Endorsed,
 			addCommitHeightRemote: commitHeight,
 		}
 		pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
@@ -2871,6 +2894,7 @@ func (lc *LightningChannel) createCommitDiff(
 				Amount:      pd.Amount,
 				Expiry:      pd.Timeout,
 				PaymentHash: pd.RHash,
+				Endorsed:    pd.Endorsed,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -2988,7 +3012,7 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, erro
 	// party set up when we initially set up the channel. If we are, then
 	// we'll abort this state transition.
 	err := lc.validateCommitmentSanity(remoteACKedIndex,
-		lc.localUpdateLog.logIndex, true, nil)
+		lc.localUpdateLog.logIndex, true, nil, nil)
 	if err != nil {
 		return sig, htlcSigs, err
 	}
@@ -3446,6 +3470,8 @@ func (lc *LightningChannel) ProcessChanSyncMsg(
 //   3. We didn't get the last RevokeAndAck message they sent, so they'll
 //      re-send it.
 func ChanSyncMsg(c *channeldb.OpenChannel) (*lnwire.ChannelReestablish, error) {
+	isRestored := c.HasChanStatus(channeldb.Restored)
+
 	c.Lock()
 	defer c.Unlock()
 
@@ -3486,6 +3512,13 @@ func ChanSyncMsg(c *channeldb.OpenChannel) (*lnwire.ChannelReestablish, error) {
 		return nil, err
 	}
 
+	// If we've restored this channel from a backup, then we'll
+	// purposefully give them an invalid LocalUnrevokedCommitPoint, so
+	// they'll force close the channel, allowing us to sweep our funds.
+	if isRestored {
+		currentCommitSecret[0] ^= 1
+	}
+
 	return &lnwire.ChannelReestablish{
 		ChanID: lnwire.NewChanIDFromOutPoint(
 			&c.FundingOutpoint,
@@ -3605,23 +3638,26 @@ func (lc *LightningChannel) computeView(view *htlcView, remoteChain bool,
 
 // validateCommitmentSanity is used to validate the current state of the
 // commitment transaction in terms of the ChannelConstraints that we and our
-// remote peer agreed upon during the funding workflow. The predictAdded
+// remote peer agreed upon during the funding workflow. The predictOurAdd
 // parameter should be set to a valid PaymentDescriptor if we are validating
-// in the state when adding a new HTLC, or nil otherwise.
+// in the state when adding a new HTLC, or nil otherwise. Likewise,
+// predictTheirAdd should be set if we are validating an HTLC added by the
+// remote party.
 func (lc *LightningChannel) validateCommitmentSanity(theirLogCounter,
-	ourLogCounter uint64, remoteChain bool,
-	predictAdded *PaymentDescriptor) error {
+	ourLogCounter uint64, remoteChain bool, predictOurAdd,
+	predictTheirAdd *PaymentDescriptor) error {
 
 	// Fetch all updates not committed.
 	view := lc.fetchHTLCView(theirLogCounter, ourLogCounter)
 
 	// If we are checking if we can add a new HTLC, we add this to the
-	// update log, in order to validate the sanity of the commitment
-	// resulting from _actually adding_ this HTLC to the state.
-	if predictAdded != nil {
-		// If we are adding an HTLC, this will be an Add to the local
-		// update log.
-		view.ourUpdates = append(view.ourUpdates, predictAdded)
+	// appropriate update log, in order to validate the sanity of the
+	// commitment resulting from _actually adding_ this HTLC to the state.
+	if predictOurAdd != nil {
+		view.ourUpdates = append(view.ourUpdates, predictOurAdd)
+	}
+	if predictTheirAdd != nil {
+		view.theirUpdates = append(view.theirUpdates, predictTheirAdd)
 	}
 
 	commitChain := lc.localCommitChain
@@ -3977,7 +4013,7 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 	// the constraints we specified during initial channel setup. If not,
 	// then we'll abort the channel as they've violated our constraints.
 	err := lc.validateCommitmentSanity(lc.remoteUpdateLog.logIndex,
-		localACKedIndex, false, nil)
+		localACKedIndex, false, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -4166,6 +4202,299 @@ func (lc *LightningChannel) FullySynced() bool {
 	return localUpdatesSynced && remoteUpdatesSynced && !pendingFeeAck
 }
 
+// NoDanglingUpdates returns true if neither side has any updates within
+// their update log that haven't yet been irrevocably committed to by both
+// commitment chains. Unlike FullySynced, this also accounts for updates that
+// have been added to either log, but not yet signed for.
+func (lc *LightningChannel) NoDanglingUpdates() bool {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.noDanglingUpdates()
+}
+
+// noDanglingUpdates is the lock-free version of NoDanglingUpdates.
+//
+// NOTE: The channel's mutex MUST be held when calling this method.
+func (lc *LightningChannel) noDanglingUpdates() bool {
+	lastLocalCommit := lc.localCommitChain.tip()
+	lastRemoteCommit := lc.remoteCommitChain.tip()
+
+	localUpdatesSynced := lc.localUpdateLog.logIndex ==
+		lastLocalCommit.ourMessageIndex &&
+		lc.localUpdateLog.logIndex == lastRemoteCommit.ourMessageIndex
+
+	remoteUpdatesSynced := lc.remoteUpdateLog.logIndex ==
+		lastLocalCommit.theirMessageIndex &&
+		lc.remoteUpdateLog.logIndex == lastRemoteCommit.theirMessageIndex
+
+	// A fee update that hasn't yet been signed for is also considered to
+	// be dangling.
+	pendingFee := lc.pendingFeeUpdate != nil
+
+	return localUpdatesSynced && remoteUpdatesSynced && !pendingFee
+}
+
+// FlowConstraints are the channel constraints which bound the HTLCs that a
+// party may offer. Unlike the rest of the channel parameters, they only govern
+// which updates may be proposed, and play no part in the construction of the
+// commitment transactions. As a result, they can be renegotiated on a live
+// channel, once neither party has any updates pending.
+type FlowConstraints struct {
+	// MaxPendingAmount is the maximum total value of the HTLCs that the
+	// constrained party may have in flight.
+	MaxPendingAmount lnwire.MilliSatoshi
+
+	// MinHTLC is the smallest HTLC that the constrained party may offer.
+	MinHTLC lnwire.MilliSatoshi
+
+	// MaxAcceptedHtlcs is the maximum number of HTLCs that the
+	// constrained party may have in flight.
+	MaxAcceptedHtlcs uint16
+}
+
+// ValidateFlowConstraints checks whether the passed flow constraints can be
+// applied to the channel in its current state. If local is true, the
+// constraints bound the HTLCs offered by us, otherwise those offered by the
+// remote party. The HTLCs already in flight must satisfy the new constraints.
+func (lc *LightningChannel) ValidateFlowConstraints(c FlowConstraints,
+	local bool) error {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.validateFlowConstraints(c, local)
+}
+
+// validateFlowConstraints is the lock-free version of
+// ValidateFlowConstraints.
+//
+// NOTE: The channel's mutex MUST be held when calling this method.
+func (lc *LightningChannel) validateFlowConstraints(c FlowConstraints,
+	local bool) error {
+
+	// The constraints may only be changed once all updates have been
+	// irrevocably committed, otherwise the parties could disagree on
+	// which constraints an update is subject to.
+	if !lc.noDanglingUpdates() {
+		return ErrDanglingUpdates
+	}
+
+	// We apply the same sanity checks as we do when the constraints are
+	// first negotiated during the funding workflow.
+	const minNumHtlc = 5
+	switch {
+	case c.MaxAcceptedHtlcs > uint16(MaxHTLCNumber/2):
+		return ErrMaxHtlcNumTooLarge(
+			c.MaxAcceptedHtlcs, uint16(MaxHTLCNumber/2),
+		)
+
+	case c.MaxAcceptedHtlcs < minNumHtlc:
+		return ErrMaxHtlcNumTooSmall(c.MaxAcceptedHtlcs, minNumHtlc)
+
+	case c.MaxPendingAmount < minNumHtlc*c.MinHTLC:
+		return ErrMaxValueInFlightTooSmall(
+			c.MaxPendingAmount, minNumHtlc*c.MinHTLC,
+		)
+	}
+
+	// Finally, the HTLCs offered by the constrained party that are still
+	// in flight must fit within the new constraints, as they're accounted
+	// for whenever a new commitment is validated. From the point of view
+	// of our commitment, those offered by the remote party are the
+	// incoming ones.
+	var (
+		numInFlight uint16
+		amtInFlight lnwire.MilliSatoshi
+	)
+	for _, htlc := range lc.channelState.LocalCommitment.Htlcs {
+		if htlc.Incoming == local {
+			continue
+		}
+
+		if htlc.Amt < c.MinHTLC {
+			return ErrBelowMinHTLC
+		}
+
+		numInFlight++
+		amtInFlight += htlc.Amt
+	}
+	if numInFlight > c.MaxAcceptedHtlcs {
+		return ErrMaxHTLCNumber
+	}
+	if amtInFlight > c.MaxPendingAmount {
+		return ErrMaxPendingAmount
+	}
+
+	return nil
+}
+
+// UpdateFlowConstraints validates and applies the passed flow constraints to
+// the channel, persisting them to disk. If local is true, the constraints
+// bound the HTLCs offered by us, otherwise those offered by the remote party.
+// Both parties must agree on the new constraints before they're applied, which
+// is the responsibility of the caller.
+func (lc *LightningChannel) UpdateFlowConstraints(c FlowConstraints,
+	local bool) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	if err := lc.validateFlowConstraints(c, local); err != nil {
+		return err
+	}
+
+	return lc.applyFlowConstraints(c, local)
+}
+
+// applyFlowConstraints applies the passed flow constraints to the channel,
+// persisting them to disk, and clearing any pending flow constraints.
+//
+// NOTE: The channel's mutex MUST be held when calling this method.
+func (lc *LightningChannel) applyFlowConstraints(c FlowConstraints,
+	local bool) error {
+
+	localConstraints := lc.channelState.LocalChanCfg.ChannelConstraints
+	remoteConstraints := lc.channelState.RemoteChanCfg.ChannelConstraints
+
+	target := &remoteConstraints
+	if local {
+		target = &localConstraints
+	}
+	target.MaxPendingAmount = c.MaxPendingAmount
+	target.MinHTLC = c.MinHTLC
+	target.MaxAcceptedHtlcs = c.MaxAcceptedHtlcs
+
+	// As our channel configs point into the channel state, they'll
+	// reflect the new constraints once they've been persisted.
+	return lc.channelState.UpdateConstraints(
+		localConstraints, remoteConstraints,
+	)
+}
+
+// CurrentFlowConstraints returns the flow constraints currently applied to
+// the channel. If local is true, those bounding the HTLCs offered by us are
+// returned, otherwise those bounding the HTLCs offered by the remote party.
+func (lc *LightningChannel) CurrentFlowConstraints(local bool) FlowConstraints {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	constraints := lc.channelState.RemoteChanCfg.ChannelConstraints
+	if local {
+		constraints = lc.channelState.LocalChanCfg.ChannelConstraints
+	}
+
+	return FlowConstraints{
+		MaxPendingAmount: constraints.MaxPendingAmount,
+		MinHTLC:          constraints.MinHTLC,
+		MaxAcceptedHtlcs: constraints.MaxAcceptedHtlcs,
+	}
+}
+
+// AcceptFlowConstraints validates the flow constraints proposed by the remote
+// party to bound the HTLCs offered by us, and persists them as pending. They
+// aren't applied until the remote party commits to them as well, at which
+// point CommitFlowConstraints should be called.
+func (lc *LightningChannel) AcceptFlowConstraints(c FlowConstraints) error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	if err := lc.validateFlowConstraints(c, true); err != nil {
+		return err
+	}
+
+	pending := lc.channelState.LocalChanCfg.ChannelConstraints
+	pending.MaxPendingAmount = c.MaxPendingAmount
+	pending.MinHTLC = c.MinHTLC
+	pending.MaxAcceptedHtlcs = c.MaxAcceptedHtlcs
+
+	return lc.channelState.PutPendingConstraints(pending)
+}
+
+// PendingFlowConstraints returns the flow constraints accepted through
+// AcceptFlowConstraints that the remote party has yet to commit to, or nil if
+// there are none.
+func (lc *LightningChannel) PendingFlowConstraints() (*FlowConstraints,
+	error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	pending, err := lc.channelState.PendingConstraints()
+	switch {
+	case err == channeldb.ErrNoPendingConstraints:
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &FlowConstraints{
+		MaxPendingAmount: pending.MaxPendingAmount,
+		MinHTLC:          pending.MinHTLC,
+		MaxAcceptedHtlcs: pending.MaxAcceptedHtlcs,
+	}, nil
+}
+
+// CommitFlowConstraints applies the pending flow constraints accepted through
+// AcceptFlowConstraints, once the remote party has committed to them. They
+// were validated when accepted, and as the remote party has already applied
+// them on its end, they're applied unconditionally so both parties remain in
+// sync.
+func (lc *LightningChannel) CommitFlowConstraints() error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	pending, err := lc.channelState.PendingConstraints()
+	if err != nil {
+		return err
+	}
+
+	return lc.applyFlowConstraints(FlowConstraints{
+		MaxPendingAmount: pending.MaxPendingAmount,
+		MinHTLC:          pending.MinHTLC,
+		MaxAcceptedHtlcs: pending.MaxAcceptedHtlcs,
+	}, true)
+}
+
+// CancelFlowConstraints discards the pending flow constraints accepted
+// through AcceptFlowConstraints, as the remote party didn't commit to them.
+func (lc *LightningChannel) CancelFlowConstraints() error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	return lc.channelState.ClearPendingConstraints()
+}
+
+// OweCommitment returns a boolean value reflecting whether we have updates
+// that the remote party's commitment chain doesn't yet include. This is the
+// case if we've added updates to our local log since our last signature, if
+// we've locked in remote updates which we haven't yet signed for, or if we're
+// the initiator and have a fee update pending. Callers can use this after a
+// revocation opens up the window to coalesce all pending updates into a
+// single new commitment.
+func (lc *LightningChannel) OweCommitment() bool {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	lastLocalCommit := lc.localCommitChain.tail()
+	lastRemoteCommit := lc.remoteCommitChain.tip()
+
+	// Any update we've added to our own log since the last commitment we
+	// signed for the remote party still needs to be signed.
+	localUpdatesOwed := lc.localUpdateLog.logIndex >
+		lastRemoteCommit.ourMessageIndex
+
+	// Likewise, any remote update that we've already locked into our
+	// commitment needs to be reflected in theirs.
+	remoteUpdatesOwed := lastLocalCommit.theirMessageIndex >
+		lastRemoteCommit.theirMessageIndex
+
+	pendingFee := lc.channelState.IsInitiator && lc.pendingFeeUpdate != nil
+
+	return localUpdatesOwed || remoteUpdatesOwed || pendingFee
+}
+
 // RevokeCurrentCommitment revokes the next lowest unrevoked commitment
 // transaction in the local commitment chain. As a result the edge of our
 // revocation window is extended by one, and the tail of our local commitment
@@ -4367,6 +4696,7 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 				Amount:      pd.Amount,
 				Expiry:      pd.Timeout,
 				PaymentHash: pd.RHash,
+				Endorsed:    pd.Endorsed,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -4514,13 +4844,26 @@ func (lc *LightningChannel) AddHTLC(htlc *lnwire.UpdateAddHTLC,
 		HtlcIndex:      lc.localUpdateLog.htlcCounter,
 		OnionBlob:      htlc.OnionBlob[:],
 		OpenCircuitKey: openKey,
+		Endorsed:       htlc.Endorsed,
 	}
 
 	// Make sure adding this HTLC won't violate any of the constraints we
-	// must keep on our commitment transaction.
+	// must keep on the remote party's commitment transaction.
 	remoteACKedIndex := lc.localCommitChain.tail().theirMessageIndex
 	err := lc.validateCommitmentSanity(
-		remoteACKedIndex, lc.localUpdateLog.logIndex, true, pd,
+		remoteACKedIndex, lc.localUpdateLog.logIndex, true, pd, nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// We must also check that the HTLC can be added to our own commitment
+	// transaction, as otherwise the remote party will refuse to sign it.
+	// This includes all remote updates we know of, as they may be locked
+	// in before our HTLC is.
+	err = lc.validateCommitmentSanity(
+		lc.remoteUpdateLog.logIndex, lc.localUpdateLog.logIndex, false,
+		pd, nil,
 	)
 	if err != nil {
 		return 0, err
@@ -4551,6 +4894,18 @@ func (lc *LightningChannel) ReceiveHTLC(htlc *lnwire.UpdateAddHTLC) (uint64, err
 		LogIndex:  lc.remoteUpdateLog.logIndex,
 		HtlcIndex: lc.remoteUpdateLog.htlcCounter,
 		OnionBlob: htlc.OnionBlob[:],
+		Endorsed:  htlc.Endorsed,
+	}
+
+	// Reject the HTLC right away if it would violate the constraints we
+	// imposed on the remote party, rather than waiting for the commitment
+	// that includes it to fail validation.
+	localACKedIndex := lc.remoteCommitChain.tail().ourMessageIndex
+	err := lc.validateCommitmentSanity(
+		lc.remoteUpdateLog.logIndex, localACKedIndex, false, nil, pd,
+	)
+	if err != nil {
+		return 0, err
 	}
 
 	lc.remoteUpdateLog.appendHtlc(pd)
@@ -5618,6 +5973,16 @@ func (lc *LightningChannel) ForceClose() (*LocalForceCloseSummary, error) {
 	lc.Lock()
 	defer lc.Unlock()
 
+	// If we've detected that we lost state, then our latest commitment
+	// has most likely been revoked, so broadcasting it would hand our
+	// funds over to the remote party.
+	if lc.channelState.HasChanStatus(channeldb.LocalDataLoss) {
+		walletLog.Errorf("ChannelPoint(%v): refusing to force close "+
+			"channel with local data loss",
+			lc.channelState.FundingOutpoint)
+		return nil, ErrForceCloseLocalDataLoss
+	}
+
 	commitTx, err := lc.getSignedCommitTx()
 	if err != nil {
 		return nil, err
@@ -5926,6 +6291,49 @@ func (lc *LightningChannel) availableBalance() (lnwire.MilliSatoshi, int64) {
 	return ourBalance, commitWeight
 }
 
+// AvailableInFlight returns the value and the number of outgoing HTLCs that
+// can still be added to the channel without violating the max in-flight value
+// and max accepted HTLCs constraints the remote party imposed on us. As these
+// constraints are enforced by AddHTLC, this can be used to determine whether
+// the channel can accept an HTLC before attempting to add it.
+func (lc *LightningChannel) AvailableInFlight() (lnwire.MilliSatoshi, uint16) {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	// We'll evaluate the remote commitment including all of our updates,
+	// as that's where our outgoing HTLCs are first locked in.
+	remoteACKedIndex := lc.localCommitChain.tail().theirMessageIndex
+	htlcView := lc.fetchHTLCView(remoteACKedIndex,
+		lc.localUpdateLog.logIndex)
+	_, _, _, filteredView, _ := lc.computeView(htlcView, true, false)
+
+	var (
+		numInFlight uint16
+		amtInFlight lnwire.MilliSatoshi
+	)
+	for _, entry := range filteredView.ourUpdates {
+		if entry.EntryType == Add {
+			amtInFlight += entry.Amount
+			numInFlight++
+		}
+	}
+
+	constraints := lc.localChanCfg.ChannelConstraints
+
+	var (
+		availableAmt   lnwire.MilliSatoshi
+		availableSlots uint16
+	)
+	if amtInFlight < constraints.MaxPendingAmount {
+		availableAmt = constraints.MaxPendingAmount - amtInFlight
+	}
+	if numInFlight < constraints.MaxAcceptedHtlcs {
+		availableSlots = constraints.MaxAcceptedHtlcs - numInFlight
+	}
+
+	return availableAmt, availableSlots
+}
+
 // StateSnapshot returns a snapshot of the current fully committed state within
 // the channel.
 func (lc *LightningChannel) StateSnapshot() *channeldb.ChannelSnapshot {
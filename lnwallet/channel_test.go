@@ -17,6 +17,7 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -3590,6 +3591,15 @@ func TestChanSyncFailure(t *testing.T) {
 				err)
 		}
 
+		// Having detected the data loss, Alice must refuse to
+		// broadcast her stale commitment.
+		_, err = aliceOld.ForceClose()
+		if err != ErrForceCloseLocalDataLoss {
+			t.Fatalf("wrong error, expected "+
+				"ErrForceCloseLocalDataLoss instead got: %v",
+				err)
+		}
+
 		// Bob should detect that Alice probably lost state.
 		_, _, _, err = bobChannel.ProcessChanSyncMsg(aliceSyncMsg)
 		if err != ErrCommitSyncRemoteDataLoss {
@@ -4966,12 +4976,9 @@ func TestMaxAcceptedHTLCs(t *testing.T) {
 		t.Fatalf("expected ErrMaxHTLCNumber, instead received: %v", err)
 	}
 
-	// After receiving the next HTLC, next state transition should fail
-	// with ErrMaxHTLCNumber.
-	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
-		t.Fatalf("unable to recv htlc: %v", err)
-	}
-	err = forceStateTransition(aliceChannel, bobChannel)
+	// Bob should reject the HTLC as soon as he receives it, with the same
+	// ErrMaxHTLCNumber.
+	_, err = bobChannel.ReceiveHTLC(htlc)
 	if err != ErrMaxHTLCNumber {
 		t.Fatalf("expected ErrMaxHTLCNumber, instead received: %v", err)
 	}
@@ -5025,17 +5032,85 @@ func TestMaxPendingAmount(t *testing.T) {
 		t.Fatalf("expected ErrMaxPendingAmount, instead received: %v", err)
 	}
 
-	// And also Bob shouldn't be accepting this HTLC in the next state
-	// transition.
-	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
-		t.Fatalf("unable to recv htlc: %v", err)
-	}
-	err = forceStateTransition(aliceChannel, bobChannel)
+	// And also Bob shouldn't be accepting this HTLC upon receiving it.
+	_, err = bobChannel.ReceiveHTLC(htlc)
 	if err != ErrMaxPendingAmount {
 		t.Fatalf("expected ErrMaxPendingAmount, instead received: %v", err)
 	}
 }
 
+// TestAvailableInFlight tests that the remaining in-flight value and HTLC
+// slots reported for a channel reflect the constraints imposed by the remote
+// party, as well as the HTLCs we currently have in flight.
+func TestAvailableInFlight(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Bob only allows Alice to have 3 BTC, or 5 HTLCs, in flight at once.
+	const maxHtlcs = 5
+	maxPending := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin * 3)
+	aliceChannel.localChanCfg.MaxPendingAmount = maxPending
+	aliceChannel.localChanCfg.MaxAcceptedHtlcs = maxHtlcs
+	bobChannel.remoteChanCfg.MaxPendingAmount = maxPending
+	bobChannel.remoteChanCfg.MaxAcceptedHtlcs = maxHtlcs
+
+	assertInFlight := func(expAmt lnwire.MilliSatoshi, expSlots uint16) {
+		t.Helper()
+
+		amt, slots := aliceChannel.AvailableInFlight()
+		if amt != expAmt {
+			t.Fatalf("expected %v available in flight, got %v",
+				expAmt, amt)
+		}
+		if slots != expSlots {
+			t.Fatalf("expected %v available slots, got %v",
+				expSlots, slots)
+		}
+	}
+
+	// Initially, the full limits should be available.
+	assertInFlight(maxPending, maxHtlcs)
+
+	// Once Alice adds an HTLC of 1 BTC, the limits should be reduced
+	// accordingly, even before the HTLC is locked in.
+	htlcAmt := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	htlc, preimage := createHTLC(0, htlcAmt)
+	aliceHtlcIndex, err := aliceChannel.AddHTLC(htlc, nil)
+	if err != nil {
+		t.Fatalf("unable to add htlc: %v", err)
+	}
+	bobHtlcIndex, err := bobChannel.ReceiveHTLC(htlc)
+	if err != nil {
+		t.Fatalf("unable to recv htlc: %v", err)
+	}
+	assertInFlight(maxPending-htlcAmt, maxHtlcs-1)
+
+	if err := forceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state update: %v", err)
+	}
+	assertInFlight(maxPending-htlcAmt, maxHtlcs-1)
+
+	// After the HTLC is settled, the full limits should be available
+	// again.
+	err = bobChannel.SettleHTLC(preimage, bobHtlcIndex, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("bob unable to settle htlc: %v", err)
+	}
+	err = aliceChannel.ReceiveHTLCSettle(preimage, aliceHtlcIndex)
+	if err != nil {
+		t.Fatalf("alice unable to accept settle: %v", err)
+	}
+	if err := forceStateTransition(bobChannel, aliceChannel); err != nil {
+		t.Fatalf("unable to complete state update: %v", err)
+	}
+	assertInFlight(maxPending, maxHtlcs)
+}
+
 func assertChannelBalances(t *testing.T, alice, bob *LightningChannel,
 	aliceBalance, bobBalance btcutil.Amount) {
 
@@ -5151,11 +5226,8 @@ func TestChanReserve(t *testing.T) {
 		t.Fatalf("expected ErrBelowChanReserve, instead received: %v", err)
 	}
 
-	// Alice will reject this htlc when a state transition is attempted.
-	if _, err := aliceChannel.ReceiveHTLC(htlc); err != nil {
-		t.Fatalf("unable to recv htlc: %v", err)
-	}
-	err = forceStateTransition(aliceChannel, bobChannel)
+	// Alice will reject this htlc upon receiving it.
+	_, err = aliceChannel.ReceiveHTLC(htlc)
 	if err != ErrBelowChanReserve {
 		t.Fatalf("expected ErrBelowChanReserve, instead received: %v", err)
 	}
@@ -5198,12 +5270,9 @@ func TestChanReserve(t *testing.T) {
 		t.Fatalf("expected ErrBelowChanReserve, instead received: %v", err)
 	}
 
-	// Likewise, Bob will reject a state transition after this htlc is
-	// received, of the same reason.
-	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
-		t.Fatalf("unable to recv htlc: %v", err)
-	}
-	err = forceStateTransition(aliceChannel, bobChannel)
+	// Likewise, Bob will reject receiving this htlc, for the same
+	// reason.
+	_, err = bobChannel.ReceiveHTLC(htlc)
 	if err != ErrBelowChanReserve {
 		t.Fatalf("expected ErrBelowChanReserve, instead received: %v", err)
 	}
@@ -5324,13 +5393,8 @@ func TestMinHTLC(t *testing.T) {
 		t.Fatalf("expected ErrBelowMinHTLC, instead received: %v", err)
 	}
 
-	// Bob will receive this HTLC, but reject the next state update, since
-	// the htlc is too small.
+	// Bob will reject this HTLC upon receiving it, since it's too small.
 	_, err = bobChannel.ReceiveHTLC(htlc)
-	if err != nil {
-		t.Fatalf("error receiving htlc: %v", err)
-	}
-	err = forceStateTransition(aliceChannel, bobChannel)
 	if err != ErrBelowMinHTLC {
 		t.Fatalf("expected ErrBelowMinHTLC, instead received: %v", err)
 	}
@@ -6156,3 +6220,347 @@ func TestChannelRestoreCommitHeight(t *testing.T) {
 	aliceChannel.Stop()
 	bobChannel.Stop()
 }
+
+// TestNoDanglingUpdates asserts that updates which haven't yet been locked
+// in by both commitment chains are detected as dangling, even before either
+// side has signed for them.
+func TestNoDanglingUpdates(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	assertNoDangling := func(expected bool) {
+		t.Helper()
+
+		if aliceChannel.NoDanglingUpdates() != expected {
+			t.Fatalf("alice: expected no dangling updates=%v",
+				expected)
+		}
+		if bobChannel.NoDanglingUpdates() != expected {
+			t.Fatalf("bob: expected no dangling updates=%v",
+				expected)
+		}
+	}
+
+	// Fresh channels shouldn't have any dangling updates.
+	assertNoDangling(true)
+
+	// Once Alice adds an HTLC, both sides should report dangling updates,
+	// even though neither has signed a new commitment yet.
+	htlcAmt := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	htlc, _ := createHTLC(0, htlcAmt)
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("unable to recv htlc: %v", err)
+	}
+	assertNoDangling(false)
+
+	// After a full state transition, the HTLC is locked in on both
+	// commitments, so there should be no dangling updates left.
+	if err := forceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state transition: %v", err)
+	}
+	assertNoDangling(true)
+}
+
+// TestUpdateFlowConstraints asserts that the flow constraints of a channel
+// can only be renegotiated once it has no dangling updates, that they must
+// accommodate the HTLCs already in flight, and that once applied, they bound
+// the HTLCs offered by the constrained party, persisting across restarts.
+func TestUpdateFlowConstraints(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Alice adds an HTLC, which leaves the channel with dangling updates
+	// until it's been locked in.
+	htlcAmt := lnwire.NewMSatFromSatoshis(0.5 * btcutil.SatoshiPerBitcoin)
+	htlc, _ := createHTLC(0, htlcAmt)
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("unable to recv htlc: %v", err)
+	}
+
+	// Bob will constrain the HTLCs offered by Alice, which she'll apply
+	// to her local config, and Bob to his remote one.
+	constraints := FlowConstraints{
+		MaxPendingAmount: htlcAmt * 2,
+		MinHTLC:          htlcAmt / 10,
+		MaxAcceptedHtlcs: 5,
+	}
+	err = bobChannel.ValidateFlowConstraints(constraints, false)
+	if err != ErrDanglingUpdates {
+		t.Fatalf("expected ErrDanglingUpdates, got %v", err)
+	}
+
+	if err := forceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state transition: %v", err)
+	}
+
+	// Constraints that don't pass the funding sanity checks, or that
+	// can't accommodate Alice's HTLC in flight, should be rejected.
+	invalidConstraints := []FlowConstraints{
+		{
+			MaxPendingAmount: htlcAmt * 2,
+			MinHTLC:          htlcAmt / 10,
+			MaxAcceptedHtlcs: 4,
+		},
+		{
+			MaxPendingAmount: htlcAmt * 2,
+			MinHTLC:          htlcAmt,
+			MaxAcceptedHtlcs: 5,
+		},
+		{
+			MaxPendingAmount: htlcAmt / 2,
+			MinHTLC:          htlcAmt / 10,
+			MaxAcceptedHtlcs: 5,
+		},
+		{
+			MaxPendingAmount: htlcAmt * 10,
+			MinHTLC:          htlcAmt * 2,
+			MaxAcceptedHtlcs: 5,
+		},
+	}
+	for i, c := range invalidConstraints {
+		err := bobChannel.ValidateFlowConstraints(c, false)
+		if err == nil {
+			t.Fatalf("expected constraints #%d to be rejected", i)
+		}
+	}
+
+	err = aliceChannel.UpdateFlowConstraints(constraints, true)
+	if err != nil {
+		t.Fatalf("unable to update alice's constraints: %v", err)
+	}
+	err = bobChannel.UpdateFlowConstraints(constraints, false)
+	if err != nil {
+		t.Fatalf("unable to update bob's constraints: %v", err)
+	}
+
+	// Alice should no longer be able to offer HTLCs below the new
+	// minimum, or beyond the new maximum value in flight.
+	htlc, _ = createHTLC(1, constraints.MinHTLC-1)
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != ErrBelowMinHTLC {
+		t.Fatalf("expected ErrBelowMinHTLC, got %v", err)
+	}
+	htlc, _ = createHTLC(1, htlcAmt*2)
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	if err != ErrMaxPendingAmount {
+		t.Fatalf("expected ErrMaxPendingAmount, got %v", err)
+	}
+
+	// The new constraints should have been persisted on both sides.
+	aliceChannels, err := aliceChannel.channelState.Db.FetchOpenChannels(
+		aliceChannel.channelState.IdentityPub,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch channel: %v", err)
+	}
+	bobChannels, err := bobChannel.channelState.Db.FetchOpenChannels(
+		bobChannel.channelState.IdentityPub,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch channel: %v", err)
+	}
+
+	aliceLocal := aliceChannels[0].LocalChanCfg.ChannelConstraints
+	bobRemote := bobChannels[0].RemoteChanCfg.ChannelConstraints
+	for _, c := range []channeldb.ChannelConstraints{aliceLocal, bobRemote} {
+		if c.MaxPendingAmount != constraints.MaxPendingAmount ||
+			c.MinHTLC != constraints.MinHTLC ||
+			c.MaxAcceptedHtlcs != constraints.MaxAcceptedHtlcs {
+
+			t.Fatalf("constraints not persisted: %v", spew.Sdump(c))
+		}
+	}
+}
+
+// TestPendingFlowConstraints asserts that flow constraints accepted on behalf
+// of the remote party are only applied once it commits to them, and that
+// pending constraints persist until they're either committed or discarded.
+func TestPendingFlowConstraints(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// fetchPending fetches the pending constraints of Alice from a fresh
+	// copy of her channel state, to ensure they were persisted.
+	fetchPending := func() *channeldb.ChannelConstraints {
+		channels, err := aliceChannel.channelState.Db.FetchOpenChannels(
+			aliceChannel.channelState.IdentityPub,
+		)
+		if err != nil {
+			t.Fatalf("unable to fetch channel: %v", err)
+		}
+
+		pending, err := channels[0].PendingConstraints()
+		switch {
+		case err == channeldb.ErrNoPendingConstraints:
+			return nil
+
+		case err != nil:
+			t.Fatalf("unable to fetch pending constraints: %v", err)
+		}
+
+		return pending
+	}
+
+	original := aliceChannel.CurrentFlowConstraints(true)
+	htlcAmt := lnwire.NewMSatFromSatoshis(0.5 * btcutil.SatoshiPerBitcoin)
+	constraints := FlowConstraints{
+		MaxPendingAmount: htlcAmt * 2,
+		MinHTLC:          htlcAmt / 10,
+		MaxAcceptedHtlcs: 5,
+	}
+
+	// Once Alice accepts the constraints, they should be pending, but not
+	// yet applied.
+	if err := aliceChannel.AcceptFlowConstraints(constraints); err != nil {
+		t.Fatalf("unable to accept constraints: %v", err)
+	}
+	pending, err := aliceChannel.PendingFlowConstraints()
+	if err != nil {
+		t.Fatalf("unable to fetch pending constraints: %v", err)
+	}
+	if pending == nil || *pending != constraints {
+		t.Fatalf("expected pending constraints %v, got %v",
+			spew.Sdump(constraints), spew.Sdump(pending))
+	}
+	if fetchPending() == nil {
+		t.Fatalf("pending constraints not persisted")
+	}
+	if aliceChannel.CurrentFlowConstraints(true) != original {
+		t.Fatalf("constraints applied before being committed")
+	}
+
+	// Discarding them should leave the channel untouched.
+	if err := aliceChannel.CancelFlowConstraints(); err != nil {
+		t.Fatalf("unable to cancel constraints: %v", err)
+	}
+	pending, err = aliceChannel.PendingFlowConstraints()
+	if err != nil {
+		t.Fatalf("unable to fetch pending constraints: %v", err)
+	}
+	if pending != nil || fetchPending() != nil {
+		t.Fatalf("pending constraints not discarded")
+	}
+	if aliceChannel.CurrentFlowConstraints(true) != original {
+		t.Fatalf("discarded constraints were applied")
+	}
+
+	// Once accepted again and committed, they should be applied, and no
+	// longer pending.
+	if err := aliceChannel.AcceptFlowConstraints(constraints); err != nil {
+		t.Fatalf("unable to accept constraints: %v", err)
+	}
+	if err := aliceChannel.CommitFlowConstraints(); err != nil {
+		t.Fatalf("unable to commit constraints: %v", err)
+	}
+	if aliceChannel.CurrentFlowConstraints(true) != constraints {
+		t.Fatalf("committed constraints weren't applied")
+	}
+	if fetchPending() != nil {
+		t.Fatalf("committed constraints still pending")
+	}
+}
+
+// TestOweCommitment asserts that OweCommitment reports updates which the
+// remote commitment chain doesn't yet include, allowing updates added while a
+// commitment is in flight to be coalesced into the next signature.
+func TestOweCommitment(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	assertOwe := func(c *LightningChannel, name string, expected bool) {
+		t.Helper()
+
+		if c.OweCommitment() != expected {
+			t.Fatalf("%v: expected owe commitment=%v", name,
+				expected)
+		}
+	}
+
+	addHTLC := func(id int) {
+		t.Helper()
+
+		htlcAmt := lnwire.NewMSatFromSatoshis(10000)
+		htlc, _ := createHTLC(id, htlcAmt)
+		if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+			t.Fatalf("unable to add htlc: %v", err)
+		}
+		if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+			t.Fatalf("unable to recv htlc: %v", err)
+		}
+	}
+
+	// Fresh channels don't owe a commitment.
+	assertOwe(aliceChannel, "alice", false)
+	assertOwe(bobChannel, "bob", false)
+
+	// Once Alice adds an HTLC, she owes Bob a signature, while Bob has
+	// nothing to sign for yet.
+	addHTLC(0)
+	assertOwe(aliceChannel, "alice", true)
+	assertOwe(bobChannel, "bob", false)
+
+	// Alice signs a new commitment for Bob, which covers her update.
+	aliceSig, aliceHtlcSigs, err := aliceChannel.SignNextCommitment()
+	if err != nil {
+		t.Fatalf("unable to sign commitment: %v", err)
+	}
+	assertOwe(aliceChannel, "alice", false)
+
+	// While her commitment is in flight, Alice adds another HTLC. Her
+	// revocation window is closed, but she now owes a commitment again.
+	addHTLC(1)
+	assertOwe(aliceChannel, "alice", true)
+	if _, _, err := aliceChannel.SignNextCommitment(); err != ErrNoWindow {
+		t.Fatalf("expected ErrNoWindow, got %v", err)
+	}
+
+	// Bob receives the signature and revokes his prior state. He has now
+	// locked in Alice's first HTLC, so he owes her a commitment.
+	err = bobChannel.ReceiveNewCommitment(aliceSig, aliceHtlcSigs)
+	if err != nil {
+		t.Fatalf("unable to receive commitment: %v", err)
+	}
+	bobRevocation, _, err := bobChannel.RevokeCurrentCommitment()
+	if err != nil {
+		t.Fatalf("unable to revoke commitment: %v", err)
+	}
+	assertOwe(bobChannel, "bob", true)
+
+	// Once Alice receives the revocation, her window opens up again. She
+	// still owes a commitment for the second HTLC, and a single signature
+	// should be enough to cover it.
+	_, _, _, err = aliceChannel.ReceiveRevocation(bobRevocation)
+	if err != nil {
+		t.Fatalf("unable to receive revocation: %v", err)
+	}
+	assertOwe(aliceChannel, "alice", true)
+	if _, _, err := aliceChannel.SignNextCommitment(); err != nil {
+		t.Fatalf("unable to sign commitment: %v", err)
+	}
+	assertOwe(aliceChannel, "alice", false)
+}
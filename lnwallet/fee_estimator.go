@@ -414,3 +414,87 @@ func (b *BitcoindFeeEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight,
 // A compile-time assertion to ensure that BitcoindFeeEstimator implements the
 // FeeEstimator interface.
 var _ FeeEstimator = (*BitcoindFeeEstimator)(nil)
+
+// FailoverFeeEstimator is a FeeEstimator that queries several fee estimators
+// in order of preference, falling back on the next one whenever an estimate
+// can't be obtained. This allows fee estimation to survive the outage of a
+// single chain backend.
+type FailoverFeeEstimator struct {
+	estimators []FeeEstimator
+}
+
+// NewFailoverFeeEstimator creates a new FailoverFeeEstimator from the given
+// estimators, in order of preference.
+func NewFailoverFeeEstimator(estimators ...FeeEstimator) *FailoverFeeEstimator {
+	return &FailoverFeeEstimator{
+		estimators: estimators,
+	}
+}
+
+// Start starts all the underlying fee estimators. Those that fail to start
+// are left out, and an error is only returned if none of them could be
+// started.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (f *FailoverFeeEstimator) Start() error {
+	var (
+		started []FeeEstimator
+		lastErr error
+	)
+	for _, estimator := range f.estimators {
+		if err := estimator.Start(); err != nil {
+			walletLog.Warnf("Unable to start fee estimator: %v",
+				err)
+			lastErr = err
+			continue
+		}
+
+		started = append(started, estimator)
+	}
+
+	if len(started) == 0 {
+		return lastErr
+	}
+	f.estimators = started
+
+	return nil
+}
+
+// Stop stops all the underlying fee estimators.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (f *FailoverFeeEstimator) Stop() error {
+	for _, estimator := range f.estimators {
+		if err := estimator.Stop(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EstimateFeePerKW returns the estimate of the first fee estimator able to
+// provide one.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (f *FailoverFeeEstimator) EstimateFeePerKW(
+	numBlocks uint32) (SatPerKWeight, error) {
+
+	var lastErr error
+	for i, estimator := range f.estimators {
+		feeRate, err := estimator.EstimateFeePerKW(numBlocks)
+		if err == nil {
+			return feeRate, nil
+		}
+
+		walletLog.Warnf("Unable to obtain fee estimate from "+
+			"estimator %d: %v", i, err)
+		lastErr = err
+	}
+
+	return 0, lastErr
+}
+
+// A compile-time assertion to ensure that FailoverFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*FailoverFeeEstimator)(nil)
@@ -1,6 +1,7 @@
 package lnwallet_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/btcsuite/btcutil"
@@ -91,3 +92,47 @@ func TestStaticFeeEstimator(t *testing.T) {
 		t.Fatalf("expected fee rate %v, got %v", feePerKw, feeRate)
 	}
 }
+
+// failingFeeEstimator is a FeeEstimator that's unable to provide estimates.
+type failingFeeEstimator struct {
+	lnwallet.StaticFeeEstimator
+}
+
+func (e failingFeeEstimator) EstimateFeePerKW(
+	numBlocks uint32) (lnwallet.SatPerKWeight, error) {
+
+	return 0, errors.New("backend unreachable")
+}
+
+// TestFailoverFeeEstimator checks that the FailoverFeeEstimator falls back on
+// the next estimator when an estimate can't be obtained.
+func TestFailoverFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	const feePerKw = lnwallet.FeePerKwFloor * 2
+
+	feeEstimator := lnwallet.NewFailoverFeeEstimator(
+		failingFeeEstimator{},
+		&lnwallet.StaticFeeEstimator{FeePerKW: feePerKw},
+	)
+	if err := feeEstimator.Start(); err != nil {
+		t.Fatalf("unable to start fee estimator: %v", err)
+	}
+	defer feeEstimator.Stop()
+
+	feeRate, err := feeEstimator.EstimateFeePerKW(6)
+	if err != nil {
+		t.Fatalf("unable to get fee rate: %v", err)
+	}
+
+	if feeRate != feePerKw {
+		t.Fatalf("expected fee rate %v, got %v", feePerKw, feeRate)
+	}
+
+	// If none of the estimators can provide an estimate, then the error
+	// should be returned.
+	feeEstimator = lnwallet.NewFailoverFeeEstimator(failingFeeEstimator{})
+	if _, err := feeEstimator.EstimateFeePerKW(6); err == nil {
+		t.Fatalf("expected fee estimation to fail")
+	}
+}
@@ -53,12 +53,35 @@ type Utxo struct {
 	AddressType   AddressType
 	Value         btcutil.Amount
 	Confirmations int64
+	Account       string
 	PkScript      []byte
 	RedeemScript  []byte
 	WitnessScript []byte
 	wire.OutPoint
 }
 
+// AddressProperty describes an address of the wallet along with the path it
+// was derived from.
+type AddressProperty struct {
+	// Address is the address itself.
+	Address btcutil.Address
+
+	// AddressType is the type of the address.
+	AddressType AddressType
+
+	// Internal is true if the address is a change address, and false if
+	// it was handed out to receive funds.
+	Internal bool
+
+	// DerivationPath is the BIP32 path the key of the address was derived
+	// from, e.g. m/84'/0'/0'/0/1.
+	DerivationPath string
+
+	// Balance is the total value of the unspent outputs, including
+	// unconfirmed ones, paying to the address.
+	Balance btcutil.Amount
+}
+
 // TransactionDetail describes a transaction with either inputs which belong to
 // the wallet, or has outputs that pay to the wallet.
 type TransactionDetail struct {
@@ -141,6 +164,11 @@ type WalletController interface {
 	// witness inputs can be used for funding channels.
 	ConfirmedBalance(confs int32) (btcutil.Amount, error)
 
+	// LockedBalance returns the sum of all the wallet's unspent outputs
+	// that are currently locked. Locked outputs aren't eligible for coin
+	// selection, and are therefore excluded from ConfirmedBalance.
+	LockedBalance() (btcutil.Amount, error)
+
 	// NewAddress returns the next external or internal address for the
 	// wallet dictated by the value of the `change` parameter. If change is
 	// true, then an internal address should be used, otherwise an external
@@ -152,13 +180,19 @@ type WalletController interface {
 	// IsOurAddress checks if the passed address belongs to this wallet
 	IsOurAddress(a btcutil.Address) bool
 
+	// ListAddresses returns all addresses derived by the wallet for the
+	// default account, along with their derivation paths and balances.
+	ListAddresses() ([]*AddressProperty, error)
+
 	// SendOutputs funds, signs, and broadcasts a Bitcoin transaction paying
 	// out to the specified outputs. In the case the wallet has insufficient
 	// funds, or the outputs are non-standard, an error should be returned.
 	// This method also takes the target fee expressed in sat/kw that should
-	// be used when crafting the transaction.
-	SendOutputs(outputs []*wire.TxOut,
-		feeRate SatPerKWeight) (*wire.MsgTx, error)
+	// be used when crafting the transaction, and the minimum number of
+	// confirmations the coins spent by it must have. Passing 0 as minConfs
+	// allows unconfirmed coins to be spent.
+	SendOutputs(outputs []*wire.TxOut, feeRate SatPerKWeight,
+		minConfs int32) (*wire.MsgTx, error)
 
 	// ListUnspentWitness returns all unspent outputs which are version 0
 	// witness programs. The 'minconfirms' and 'maxconfirms' parameters
@@ -209,6 +243,11 @@ type WalletController interface {
 	// known to the wallet, expressed in Unix epoch time
 	IsSynced() (bool, int64, error)
 
+	// GetRecoveryInfo returns a boolean indicating whether the wallet was
+	// started in recovery mode, along with the progress of the recovery
+	// as a ratio ranging from 0 to 1.
+	GetRecoveryInfo() (bool, float64, error)
+
 	// Start initializes the wallet, making any necessary connections,
 	// starting up required goroutines etc.
 	Start() error
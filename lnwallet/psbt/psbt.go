@@ -0,0 +1,518 @@
+// Package psbt implements the subset of BIP 174 partially signed bitcoin
+// transactions needed to let an external wallet fund and sign a channel
+// funding transaction. Only the fields required to assemble and finalize a
+// transaction are interpreted, all other key-value pairs are retained as is so
+// that a packet survives a round trip through this package unchanged.
+//
+// For the full specification see:
+// https://github.com/bitcoin/bips/blob/master/bip-0174.mediawiki
+package psbt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// globalUnsignedTxType is the key type of the unsigned transaction
+	// within the global map of a packet.
+	globalUnsignedTxType = 0x00
+
+	// inputNonWitnessUtxoType is the key type of the full transaction
+	// spent by an input.
+	inputNonWitnessUtxoType = 0x00
+
+	// inputWitnessUtxoType is the key type of the output spent by a
+	// segwit input.
+	inputWitnessUtxoType = 0x01
+
+	// inputFinalScriptSigType is the key type of the finalized signature
+	// script of an input.
+	inputFinalScriptSigType = 0x07
+
+	// inputFinalScriptWitnessType is the key type of the finalized witness
+	// of an input.
+	inputFinalScriptWitnessType = 0x08
+
+	// maxPsbtValueSize is the maximum size of a single key or value within
+	// a packet, which is bound by the maximum size of a transaction.
+	maxPsbtValueSize = wire.MaxBlockPayload
+)
+
+var (
+	// magic is the prefix of every serialized packet: "psbt" followed by
+	// the separator 0xff.
+	magic = [5]byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+	// ErrInvalidMagic is returned when the passed data doesn't start with
+	// the psbt magic bytes.
+	ErrInvalidMagic = errors.New("invalid psbt magic bytes")
+
+	// ErrDuplicateKey is returned when a key appears more than once
+	// within the same map of a packet.
+	ErrDuplicateKey = errors.New("duplicate key in psbt")
+
+	// ErrInvalidKey is returned when a key of a known type has an
+	// unexpected length.
+	ErrInvalidKey = errors.New("invalid psbt key")
+
+	// ErrNoUnsignedTx is returned when a packet doesn't contain the
+	// unsigned transaction.
+	ErrNoUnsignedTx = errors.New("psbt has no unsigned transaction")
+
+	// ErrInvalidUnsignedTx is returned when the unsigned transaction of a
+	// packet carries signature scripts or witnesses.
+	ErrInvalidUnsignedTx = errors.New("unsigned transaction of psbt " +
+		"must not contain signature scripts or witnesses")
+)
+
+// Unknown is a key-value pair of a packet that isn't interpreted by this
+// package.
+type Unknown struct {
+	Key   []byte
+	Value []byte
+}
+
+// PInput holds the information of a packet about one of the inputs of its
+// unsigned transaction.
+type PInput struct {
+	// NonWitnessUtxo is the full transaction that created the output
+	// spent by the input.
+	NonWitnessUtxo *wire.MsgTx
+
+	// WitnessUtxo is the output spent by the input, which is only set for
+	// segwit inputs.
+	WitnessUtxo *wire.TxOut
+
+	// FinalScriptSig is the final signature script of the input.
+	FinalScriptSig []byte
+
+	// FinalScriptWitness is the final witness of the input.
+	FinalScriptWitness wire.TxWitness
+
+	// Unknowns are all other key-value pairs of the input.
+	Unknowns []*Unknown
+}
+
+// IsFinalized returns true if the input carries its final signature script or
+// witness.
+func (i *PInput) IsFinalized() bool {
+	return i.FinalScriptSig != nil || i.FinalScriptWitness != nil
+}
+
+// POutput holds the information of a packet about one of the outputs of its
+// unsigned transaction. None of the output fields are interpreted.
+type POutput struct {
+	// Unknowns are all key-value pairs of the output.
+	Unknowns []*Unknown
+}
+
+// Packet is a partially signed bitcoin transaction.
+type Packet struct {
+	// UnsignedTx is the transaction the packet is about. None of its
+	// inputs carry a signature script or witness.
+	UnsignedTx *wire.MsgTx
+
+	// Inputs holds one entry for every input of UnsignedTx.
+	Inputs []*PInput
+
+	// Outputs holds one entry for every output of UnsignedTx.
+	Outputs []*POutput
+
+	// Unknowns are all other key-value pairs of the global map.
+	Unknowns []*Unknown
+}
+
+// New creates a new packet for the passed unsigned transaction, which must not
+// contain any signature scripts or witnesses.
+func New(unsignedTx *wire.MsgTx) (*Packet, error) {
+	if !isUnsigned(unsignedTx) {
+		return nil, ErrInvalidUnsignedTx
+	}
+
+	p := &Packet{
+		UnsignedTx: unsignedTx,
+		Inputs:     make([]*PInput, len(unsignedTx.TxIn)),
+		Outputs:    make([]*POutput, len(unsignedTx.TxOut)),
+	}
+	for i := range p.Inputs {
+		p.Inputs[i] = &PInput{}
+	}
+	for i := range p.Outputs {
+		p.Outputs[i] = &POutput{}
+	}
+
+	return p, nil
+}
+
+// AddOutput appends the passed output to the unsigned transaction of the
+// packet.
+func (p *Packet) AddOutput(txOut *wire.TxOut) {
+	p.UnsignedTx.AddTxOut(txOut)
+	p.Outputs = append(p.Outputs, &POutput{})
+}
+
+// IsComplete returns true if all inputs of the packet have been finalized.
+func (p *Packet) IsComplete() bool {
+	for _, pIn := range p.Inputs {
+		if !pIn.IsFinalized() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Extract returns the final, fully signed transaction of a packet whose inputs
+// have all been finalized.
+func Extract(p *Packet) (*wire.MsgTx, error) {
+	finalTx := p.UnsignedTx.Copy()
+	for i, pIn := range p.Inputs {
+		if !pIn.IsFinalized() {
+			return nil, fmt.Errorf("input %d of psbt is not "+
+				"finalized", i)
+		}
+
+		finalTx.TxIn[i].SignatureScript = pIn.FinalScriptSig
+		finalTx.TxIn[i].Witness = pIn.FinalScriptWitness
+	}
+
+	return finalTx, nil
+}
+
+// Parse decodes a packet from its binary serialization.
+func Parse(r io.Reader) (*Packet, error) {
+	var m [5]byte
+	if _, err := io.ReadFull(r, m[:]); err != nil {
+		return nil, err
+	}
+	if m != magic {
+		return nil, ErrInvalidMagic
+	}
+
+	p := &Packet{}
+	err := readMap(r, func(key, value []byte) error {
+		if key[0] != globalUnsignedTxType {
+			p.Unknowns = append(p.Unknowns, &Unknown{key, value})
+			return nil
+		}
+		if len(key) != 1 {
+			return ErrInvalidKey
+		}
+
+		tx := &wire.MsgTx{}
+		err := tx.DeserializeNoWitness(bytes.NewReader(value))
+		if err != nil {
+			return err
+		}
+		if !isUnsigned(tx) {
+			return ErrInvalidUnsignedTx
+		}
+		p.UnsignedTx = tx
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if p.UnsignedTx == nil {
+		return nil, ErrNoUnsignedTx
+	}
+
+	p.Inputs = make([]*PInput, len(p.UnsignedTx.TxIn))
+	for i := range p.Inputs {
+		pIn := &PInput{}
+		if err := readMap(r, pIn.parse); err != nil {
+			return nil, fmt.Errorf("unable to parse input %d: %v",
+				i, err)
+		}
+		p.Inputs[i] = pIn
+	}
+
+	p.Outputs = make([]*POutput, len(p.UnsignedTx.TxOut))
+	for i := range p.Outputs {
+		pOut := &POutput{}
+		err := readMap(r, func(key, value []byte) error {
+			pOut.Unknowns = append(
+				pOut.Unknowns, &Unknown{key, value},
+			)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse output %d: %v",
+				i, err)
+		}
+		p.Outputs[i] = pOut
+	}
+
+	return p, nil
+}
+
+// parse interprets a single key-value pair of an input map.
+func (i *PInput) parse(key, value []byte) error {
+	switch key[0] {
+	case inputNonWitnessUtxoType:
+		if len(key) != 1 {
+			return ErrInvalidKey
+		}
+
+		tx := &wire.MsgTx{}
+		if err := tx.Deserialize(bytes.NewReader(value)); err != nil {
+			return err
+		}
+		i.NonWitnessUtxo = tx
+
+	case inputWitnessUtxoType:
+		if len(key) != 1 {
+			return ErrInvalidKey
+		}
+
+		txOut, err := readTxOut(value)
+		if err != nil {
+			return err
+		}
+		i.WitnessUtxo = txOut
+
+	case inputFinalScriptSigType:
+		if len(key) != 1 {
+			return ErrInvalidKey
+		}
+		i.FinalScriptSig = value
+
+	case inputFinalScriptWitnessType:
+		if len(key) != 1 {
+			return ErrInvalidKey
+		}
+
+		witness, err := readWitness(value)
+		if err != nil {
+			return err
+		}
+		i.FinalScriptWitness = witness
+
+	default:
+		i.Unknowns = append(i.Unknowns, &Unknown{key, value})
+	}
+
+	return nil
+}
+
+// Serialize writes the binary serialization of the packet to w.
+func (p *Packet) Serialize(w io.Writer) error {
+	if _, err := w.Write(magic[:]); err != nil {
+		return err
+	}
+
+	var tx bytes.Buffer
+	if err := p.UnsignedTx.SerializeNoWitness(&tx); err != nil {
+		return err
+	}
+	err := writeKeyValue(w, []byte{globalUnsignedTxType}, tx.Bytes())
+	if err != nil {
+		return err
+	}
+	if err := writeMapEnd(w, p.Unknowns); err != nil {
+		return err
+	}
+
+	for _, pIn := range p.Inputs {
+		if err := pIn.serialize(w); err != nil {
+			return err
+		}
+	}
+
+	for _, pOut := range p.Outputs {
+		if err := writeMapEnd(w, pOut.Unknowns); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// serialize writes the map of the input to w.
+func (i *PInput) serialize(w io.Writer) error {
+	if i.NonWitnessUtxo != nil {
+		var tx bytes.Buffer
+		if err := i.NonWitnessUtxo.Serialize(&tx); err != nil {
+			return err
+		}
+
+		err := writeKeyValue(
+			w, []byte{inputNonWitnessUtxoType}, tx.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if i.WitnessUtxo != nil {
+		var txOut bytes.Buffer
+		err := binary.Write(
+			&txOut, binary.LittleEndian, i.WitnessUtxo.Value,
+		)
+		if err != nil {
+			return err
+		}
+		err = wire.WriteVarBytes(&txOut, 0, i.WitnessUtxo.PkScript)
+		if err != nil {
+			return err
+		}
+
+		err = writeKeyValue(
+			w, []byte{inputWitnessUtxoType}, txOut.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if i.FinalScriptSig != nil {
+		err := writeKeyValue(
+			w, []byte{inputFinalScriptSigType}, i.FinalScriptSig,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if i.FinalScriptWitness != nil {
+		var witness bytes.Buffer
+		err := wire.WriteVarInt(
+			&witness, 0, uint64(len(i.FinalScriptWitness)),
+		)
+		if err != nil {
+			return err
+		}
+		for _, item := range i.FinalScriptWitness {
+			if err := wire.WriteVarBytes(&witness, 0, item); err != nil {
+				return err
+			}
+		}
+
+		err = writeKeyValue(
+			w, []byte{inputFinalScriptWitnessType}, witness.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeMapEnd(w, i.Unknowns)
+}
+
+// isUnsigned returns true if none of the inputs of tx carry a signature script
+// or witness.
+func isUnsigned(tx *wire.MsgTx) bool {
+	for _, txIn := range tx.TxIn {
+		if len(txIn.SignatureScript) != 0 || len(txIn.Witness) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// readMap reads the key-value pairs of a single map up to and including its
+// separator, handing each pair to the passed callback. The key passed to the
+// callback is never empty.
+func readMap(r io.Reader, cb func(key, value []byte) error) error {
+	seen := make(map[string]struct{})
+	for {
+		key, err := wire.ReadVarBytes(r, 0, maxPsbtValueSize, "key")
+		if err != nil {
+			return err
+		}
+
+		// A zero length key is the separator that terminates the map.
+		if len(key) == 0 {
+			return nil
+		}
+
+		if _, ok := seen[string(key)]; ok {
+			return ErrDuplicateKey
+		}
+		seen[string(key)] = struct{}{}
+
+		value, err := wire.ReadVarBytes(r, 0, maxPsbtValueSize, "value")
+		if err != nil {
+			return err
+		}
+
+		if err := cb(key, value); err != nil {
+			return err
+		}
+	}
+}
+
+// writeKeyValue writes a single key-value pair to w.
+func writeKeyValue(w io.Writer, key, value []byte) error {
+	if err := wire.WriteVarBytes(w, 0, key); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, value)
+}
+
+// writeMapEnd writes the passed unknown key-value pairs to w, followed by the
+// separator that terminates a map.
+func writeMapEnd(w io.Writer, unknowns []*Unknown) error {
+	for _, u := range unknowns {
+		if err := writeKeyValue(w, u.Key, u.Value); err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write([]byte{0x00})
+	return err
+}
+
+// readTxOut decodes a transaction output from its serialization.
+func readTxOut(b []byte) (*wire.TxOut, error) {
+	r := bytes.NewReader(b)
+
+	var value int64
+	if err := binary.Read(r, binary.LittleEndian, &value); err != nil {
+		return nil, err
+	}
+	pkScript, err := wire.ReadVarBytes(r, 0, maxPsbtValueSize, "pkScript")
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewTxOut(value, pkScript), nil
+}
+
+// readWitness decodes a witness stack from its serialization.
+func readWitness(b []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(b)
+
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each item takes up at least one byte for its length, which bounds
+	// the number of items we'll allocate for.
+	if numItems > uint64(len(b)) {
+		return nil, fmt.Errorf("witness has too many items: %v",
+			numItems)
+	}
+
+	witness := make(wire.TxWitness, numItems)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(
+			r, 0, maxPsbtValueSize, "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return witness, nil
+}
@@ -0,0 +1,162 @@
+package psbt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// newTestPacket returns a packet spending a single segwit output to two
+// outputs.
+func newTestPacket(t *testing.T) *Packet {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{1},
+			Index: 2,
+		},
+		Sequence: wire.MaxTxInSequenceNum,
+	})
+	tx.AddTxOut(wire.NewTxOut(100000, []byte{0x00, 0x14, 0x01}))
+	tx.AddTxOut(wire.NewTxOut(200000, []byte{0x00, 0x20, 0x02}))
+
+	p, err := New(tx)
+	if err != nil {
+		t.Fatalf("unable to create packet: %v", err)
+	}
+	p.Inputs[0].WitnessUtxo = wire.NewTxOut(310000, []byte{0x00, 0x14})
+
+	return p
+}
+
+// TestPacketRoundTrip ensures that a packet, including the key-value pairs
+// that aren't interpreted, survives a serialization round trip.
+func TestPacketRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	p := newTestPacket(t)
+	p.Unknowns = []*Unknown{{Key: []byte{0xfc, 0x01}, Value: []byte{1}}}
+	p.Inputs[0].Unknowns = []*Unknown{
+		{Key: []byte{0x02, 0x03}, Value: []byte{2, 3}},
+	}
+	p.Outputs[1].Unknowns = []*Unknown{
+		{Key: []byte{0x00, 0x04}, Value: []byte{4}},
+	}
+
+	var b bytes.Buffer
+	if err := p.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+
+	p2, err := Parse(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatalf("unable to parse packet: %v", err)
+	}
+	if p2.UnsignedTx.TxHash() != p.UnsignedTx.TxHash() {
+		t.Fatalf("unsigned tx mismatch")
+	}
+	if !reflect.DeepEqual(p2.Inputs[0].WitnessUtxo, p.Inputs[0].WitnessUtxo) {
+		t.Fatalf("witness utxo mismatch: expected %v, got %v",
+			p.Inputs[0].WitnessUtxo, p2.Inputs[0].WitnessUtxo)
+	}
+	if !reflect.DeepEqual(p2.Unknowns, p.Unknowns) ||
+		!reflect.DeepEqual(p2.Inputs[0].Unknowns, p.Inputs[0].Unknowns) ||
+		!reflect.DeepEqual(p2.Outputs[1].Unknowns, p.Outputs[1].Unknowns) {
+
+		t.Fatalf("unknown key-value pairs not retained")
+	}
+
+	var b2 bytes.Buffer
+	if err := p2.Serialize(&b2); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	if !bytes.Equal(b.Bytes(), b2.Bytes()) {
+		t.Fatalf("serialization mismatch: expected %x, got %x",
+			b.Bytes(), b2.Bytes())
+	}
+}
+
+// TestPacketExtract ensures that the final transaction can only be extracted
+// once all inputs of a packet have been finalized.
+func TestPacketExtract(t *testing.T) {
+	t.Parallel()
+
+	p := newTestPacket(t)
+	if p.IsComplete() {
+		t.Fatalf("packet without final witness is complete")
+	}
+	if _, err := Extract(p); err == nil {
+		t.Fatalf("expected extraction of incomplete packet to fail")
+	}
+
+	witness := wire.TxWitness{{1, 2, 3}, {4, 5}}
+	p.Inputs[0].FinalScriptWitness = witness
+
+	// The final witness must be retained when the packet is serialized by
+	// the external signer.
+	var b bytes.Buffer
+	if err := p.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	p, err := Parse(&b)
+	if err != nil {
+		t.Fatalf("unable to parse packet: %v", err)
+	}
+
+	finalTx, err := Extract(p)
+	if err != nil {
+		t.Fatalf("unable to extract final tx: %v", err)
+	}
+	if !reflect.DeepEqual(finalTx.TxIn[0].Witness, witness) {
+		t.Fatalf("witness mismatch: expected %v, got %v", witness,
+			finalTx.TxIn[0].Witness)
+	}
+
+	// The signatures of segwit inputs don't alter the txid.
+	if finalTx.TxHash() != p.UnsignedTx.TxHash() {
+		t.Fatalf("txid of final tx doesn't match unsigned tx")
+	}
+}
+
+// TestParseInvalid ensures that malformed packets are rejected.
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+	if err := newTestPacket(t).Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	valid := b.Bytes()
+
+	badMagic := append([]byte{}, valid...)
+	badMagic[4] = 0x00
+	if _, err := Parse(bytes.NewReader(badMagic)); err != ErrInvalidMagic {
+		t.Fatalf("expected ErrInvalidMagic, got %v", err)
+	}
+
+	// Duplicating the global unsigned tx entry results in the same key
+	// appearing twice within the global map.
+	txLen := int(valid[7])
+	globalTx := valid[5 : 5+3+txLen]
+	dupKey := append([]byte{}, valid[:5]...)
+	dupKey = append(dupKey, globalTx...)
+	dupKey = append(dupKey, valid[5:]...)
+	if _, err := Parse(bytes.NewReader(dupKey)); err != ErrDuplicateKey {
+		t.Fatalf("expected ErrDuplicateKey, got %v", err)
+	}
+
+	// A packet without the global unsigned tx is invalid.
+	noTx := append([]byte{}, magic[:]...)
+	noTx = append(noTx, 0x00)
+	if _, err := Parse(bytes.NewReader(noTx)); err != ErrNoUnsignedTx {
+		t.Fatalf("expected ErrNoUnsignedTx, got %v", err)
+	}
+
+	// Truncated packets can't be parsed.
+	if _, err := Parse(bytes.NewReader(valid[:len(valid)-1])); err == nil {
+		t.Fatalf("expected truncated packet to be rejected")
+	}
+}
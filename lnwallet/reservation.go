@@ -1,6 +1,7 @@
 package lnwallet
 
 import (
+	"fmt"
 	"net"
 	"sync"
 
@@ -384,6 +385,28 @@ func (r *ChannelReservation) ProcessContribution(theirContribution *ChannelContr
 	return <-errChan
 }
 
+// ProcessExternalContribution is the counterpart of ProcessContribution for
+// externally funded reservations. Rather than building a funding transaction
+// of its own, the reservation is funded by the passed transaction, which must
+// pay to the multi-sig output of the channel. The transaction doesn't need to
+// be signed yet, as long as its txid is final: this is the case if all of its
+// inputs spend segwit outputs. Once processed, our signature for the
+// counterparty's version of the commitment transaction is available.
+func (r *ChannelReservation) ProcessExternalContribution(
+	theirContribution *ChannelContribution, fundingTx *wire.MsgTx) error {
+
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addContributionMsg{
+		pendingFundingID: r.reservationID,
+		contribution:     theirContribution,
+		fundingTx:        fundingTx,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
 // ProcessSingleContribution verifies, and records the initiator's contribution
 // to this pending single funder channel. Internally, no further action is
 // taken other than recording the initiator's contribution to the single funder
@@ -426,6 +449,20 @@ func (r *ChannelReservation) OurSignatures() ([]*InputScript, []byte) {
 	return r.ourFundingInputScripts, r.ourCommitmentSig
 }
 
+// VerifyCommitSig checks that the passed signature of the counterparty is
+// valid for our version of the commitment transaction, without completing the
+// reservation. This allows the signatures of all channels in a funding batch
+// to be checked before any of them is committed to disk.
+//
+// NOTE: This can only be called after a call to .ProcessContribution() or
+// .ProcessExternalContribution().
+func (r *ChannelReservation) VerifyCommitSig(theirCommitSig []byte) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	return verifyCommitSig(r, theirCommitSig)
+}
+
 // CompleteReservation finalizes the pending channel reservation, transitioning
 // from a pending payment channel, to an open payment channel. All passed
 // signatures to the counterparty's inputs to the funding transaction will be
@@ -508,6 +545,33 @@ func (r *ChannelReservation) FinalFundingTx() *wire.MsgTx {
 	return r.fundingTx
 }
 
+// SetFinalFundingTx replaces the yet unsigned funding transaction of an
+// externally funded reservation with its final, fully signed version. An
+// error is returned if the txid of the passed transaction doesn't match the
+// one the commitment transactions were built upon.
+//
+// NOTE: This can only be called after a call to
+// .ProcessExternalContribution(), and before the reservation is completed.
+func (r *ChannelReservation) SetFinalFundingTx(fundingTx *wire.MsgTx) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.fundingTx == nil {
+		return fmt.Errorf("reservation has no funding transaction")
+	}
+
+	fundingTxID := fundingTx.TxHash()
+	if fundingTxID != r.fundingTx.TxHash() {
+		return fmt.Errorf("txid of final funding tx %v doesn't match "+
+			"the expected txid %v", fundingTxID,
+			r.fundingTx.TxHash())
+	}
+
+	r.fundingTx = fundingTx
+
+	return nil
+}
+
 // FundingOutpoint returns the outpoint of the funding transaction.
 //
 // NOTE: The pointer returned will only be set once the .ProcessContribution()
@@ -68,6 +68,12 @@ type htlcIndexErr struct {
 	*verifyJob
 }
 
+// verifyBatch is a set of verification jobs handed to a single worker of the
+// sigPool, which verifies them in sequence. Handing out jobs in batches rather
+// than one at a time avoids a round trip through the job queue for each HTLC
+// signature of a large commitment.
+type verifyBatch []verifyJob
+
 // signJob is a job sent to the sigPool to generate a valid signature according
 // to the passed SignDescriptor for the passed transaction. Jobs are intended
 // to be sent in batches in order to parallelize the job of generating
@@ -130,7 +136,7 @@ type sigPool struct {
 
 	signer Signer
 
-	verifyJobs chan verifyJob
+	verifyJobs chan verifyBatch
 	signJobs   chan signJob
 
 	wg   sync.WaitGroup
@@ -146,7 +152,7 @@ func newSigPool(numWorkers int, signer Signer) *sigPool {
 	return &sigPool{
 		signer:     signer,
 		numWorkers: numWorkers,
-		verifyJobs: make(chan verifyJob, jobBuffer),
+		verifyJobs: make(chan verifyBatch, jobBuffer),
 		signJobs:   make(chan signJob, jobBuffer),
 		quit:       make(chan struct{}),
 	}
@@ -223,44 +229,21 @@ func (s *sigPool) poolWorker() {
 				return
 			}
 
-		// We've just received a new verification job from the outside
-		// world. We'll attempt to construct the sighash, parse the
-		// signature, and finally verify the signature.
-		case verifyMsg := <-s.verifyJobs:
-			sigHash, err := verifyMsg.sigHash()
-			if err != nil {
-				select {
-				case verifyMsg.errResp <- &htlcIndexErr{
-					error:     err,
-					verifyJob: &verifyMsg,
-				}:
-					continue
-				case <-verifyMsg.cancel:
-					continue
-				}
-			}
-
-			rawSig := verifyMsg.sig
-
-			if !rawSig.Verify(sigHash, verifyMsg.pubKey) {
-				err := fmt.Errorf("invalid signature "+
-					"sighash: %x, sig: %x", sigHash, rawSig.Serialize())
-				select {
-				case verifyMsg.errResp <- &htlcIndexErr{
-					error:     err,
-					verifyJob: &verifyMsg,
-				}:
-				case <-verifyMsg.cancel:
-				case <-s.quit:
-					return
-				}
-			} else {
+		// We've just received a new batch of verification jobs from
+		// the outside world. We'll verify each of them in turn, unless
+		// the batch is cancelled due to an invalid signature.
+		case batch := <-s.verifyJobs:
+		batchLoop:
+			for i := range batch {
 				select {
-				case verifyMsg.errResp <- nil:
-				case <-verifyMsg.cancel:
+				case <-batch[i].cancel:
+					break batchLoop
 				case <-s.quit:
 					return
+				default:
 				}
+
+				s.verify(&batch[i])
 			}
 
 		// The sigPool is exiting, so we will as well.
@@ -270,6 +253,32 @@ func (s *sigPool) poolWorker() {
 	}
 }
 
+// verify attempts to construct the sighash of a verification job, and to
+// verify the job's signature against it. The result is sent over the job's
+// response channel, which must be buffered for every job of the batch.
+func (s *sigPool) verify(job *verifyJob) {
+	sigHash, err := job.sigHash()
+	if err != nil {
+		job.errResp <- &htlcIndexErr{
+			error:     err,
+			verifyJob: job,
+		}
+		return
+	}
+
+	if !job.sig.Verify(sigHash, job.pubKey) {
+		err := fmt.Errorf("invalid signature sighash: %x, sig: %x",
+			sigHash, job.sig.Serialize())
+		job.errResp <- &htlcIndexErr{
+			error:     err,
+			verifyJob: job,
+		}
+		return
+	}
+
+	job.errResp <- nil
+}
+
 // SubmitSignBatch submits a batch of signature jobs to the sigPool. The
 // response and cancel channels for each of the signJob's are expected to be
 // fully populated, as the response for each job will be sent over the response
@@ -291,20 +300,34 @@ func (s *sigPool) SubmitSignBatch(signJobs []signJob) {
 // denoting if signature verification was valid or not. The passed cancelChan
 // allows the caller to cancel all pending jobs in the case that they wish to
 // bail early.
+//
+// The jobs are split evenly amongst the workers of the pool, such that each
+// worker receives at most a single batch of jobs.
 func (s *sigPool) SubmitVerifyBatch(verifyJobs []verifyJob,
 	cancelChan chan struct{}) <-chan *htlcIndexErr {
 
 	errChan := make(chan *htlcIndexErr, len(verifyJobs))
 
-	for _, job := range verifyJobs {
-		job.cancel = cancelChan
-		job.errResp = errChan
+	for i := range verifyJobs {
+		verifyJobs[i].cancel = cancelChan
+		verifyJobs[i].errResp = errChan
+	}
+
+	batchSize := (len(verifyJobs) + s.numWorkers - 1) / s.numWorkers
+	for len(verifyJobs) > 0 {
+		if batchSize > len(verifyJobs) {
+			batchSize = len(verifyJobs)
+		}
 
 		select {
-		case s.verifyJobs <- job:
-		case <-job.cancel:
+		case s.verifyJobs <- verifyBatch(verifyJobs[:batchSize]):
+		case <-cancelChan:
+			return errChan
+		case <-s.quit:
 			return errChan
 		}
+
+		verifyJobs = verifyJobs[batchSize:]
 	}
 
 	return errChan
@@ -0,0 +1,83 @@
+package lnwallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// TestSigPoolVerifyBatch asserts that all jobs of a verification batch are
+// verified once split amongst the workers of the pool, and that an invalid
+// signature is reported along with the index of its HTLC.
+func TestSigPoolVerifyBatch(t *testing.T) {
+	t.Parallel()
+
+	pool := newSigPool(3, nil)
+	if err := pool.Start(); err != nil {
+		t.Fatalf("unable to start sig pool: %v", err)
+	}
+	defer pool.Stop()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	// We'll create a batch that's larger than the number of workers, in
+	// which only the signature of the HTLC at index 7 is invalid.
+	const numJobs = 10
+	const invalidIndex = 7
+
+	jobs := make([]verifyJob, numJobs)
+	for i := range jobs {
+		sigHash := chainhash.DoubleHashB([]byte{byte(i)})
+		signedHash := sigHash
+		if i == invalidIndex {
+			signedHash = chainhash.DoubleHashB([]byte("bogus"))
+		}
+
+		sig, err := privKey.Sign(signedHash)
+		if err != nil {
+			t.Fatalf("unable to sign: %v", err)
+		}
+
+		jobs[i] = verifyJob{
+			pubKey: privKey.PubKey(),
+			sig:    sig,
+			sigHash: func() ([]byte, error) {
+				return sigHash, nil
+			},
+			htlcIndex: uint64(i),
+		}
+	}
+
+	cancel := make(chan struct{})
+	defer close(cancel)
+	resps := pool.SubmitVerifyBatch(jobs, cancel)
+
+	var numInvalid int
+	for i := 0; i < numJobs; i++ {
+		select {
+		case resp := <-resps:
+			if resp == nil {
+				continue
+			}
+
+			numInvalid++
+			if resp.htlcIndex != invalidIndex {
+				t.Fatalf("expected invalid signature for htlc "+
+					"%v, got %v", invalidIndex,
+					resp.htlcIndex)
+			}
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("verification of job %v timed out", i)
+		}
+	}
+
+	if numInvalid != 1 {
+		t.Fatalf("expected 1 invalid signature, got %v", numInvalid)
+	}
+}
@@ -66,6 +66,7 @@ func TestTxWeightEstimator(t *testing.T) {
 		numP2WKHOutputs      int
 		numP2WSHOutputs      int
 		numP2SHOutputs       int
+		numTxOutputs         int
 	}{
 		{
 			numP2PKHInputs:  1,
@@ -103,6 +104,10 @@ func TestTxWeightEstimator(t *testing.T) {
 			numNestedP2WSHInputs: 1,
 			numP2WKHOutputs:      1,
 		},
+		{
+			numP2WKHInputs: 1,
+			numTxOutputs:   2,
+		},
 	}
 
 	for i, test := range testCases {
@@ -180,6 +185,11 @@ func TestTxWeightEstimator(t *testing.T) {
 			weightEstimate.AddP2SHOutput()
 			tx.AddTxOut(&wire.TxOut{PkScript: p2shScript})
 		}
+		for j := 0; j < test.numTxOutputs; j++ {
+			txOut := &wire.TxOut{PkScript: p2wshScript}
+			weightEstimate.AddTxOutput(txOut)
+			tx.AddTxOut(txOut)
+		}
 
 		expectedWeight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
 		if weightEstimate.Weight() != int(expectedWeight) {
@@ -98,6 +98,15 @@ type InitFundingReserveMsg struct {
 	// output selected to fund the channel should satisfy.
 	MinConfs int32
 
+	// ExternalFunding indicates that the funding transaction of the
+	// reservation isn't assembled from coins selected for this channel
+	// alone. This is the case for channels that are part of a batch
+	// funded by a shared transaction created with CreateBatchFundingTx,
+	// and for channels funded by an external wallet. No coins are
+	// selected for such a reservation, instead the funding transaction is
+	// handed to it through ProcessExternalContribution.
+	ExternalFunding bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -136,6 +145,11 @@ type addContributionMsg struct {
 	// TODO(roasbeef): Should also carry SPV proofs in we're in SPV mode
 	contribution *ChannelContribution
 
+	// fundingTx is the externally created funding transaction of the
+	// reservation. If nil, the funding transaction is assembled from the
+	// contributions of both parties.
+	fundingTx *wire.MsgTx
+
 	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
 	err chan error
 }
@@ -472,13 +486,15 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 	reservation.partialState.IdentityPub = req.NodeID
 
 	// If we're on the receiving end of a single funder channel then we
-	// don't need to perform any coin selection. Otherwise, attempt to
-	// obtain enough coins to meet the required funding amount.
-	if req.FundingAmount != 0 {
+	// don't need to perform any coin selection. The same goes for
+	// externally funded reservations, as the coins of their funding
+	// transaction are selected elsewhere. Otherwise, attempt to obtain
+	// enough coins to meet the required funding amount.
+	if req.FundingAmount != 0 && !req.ExternalFunding {
 		// Coin selection is done on the basis of sat/kw, so we'll use
 		// the fee rate passed in to perform coin selection.
 		err := l.selectCoinsAndChange(
-			req.FundingFeePerKw, req.FundingAmount, req.MinConfs,
+			req.FundingFeePerKw, req.FundingAmount, 1, req.MinConfs,
 			reservation.ourContribution,
 		)
 		if err != nil {
@@ -687,34 +703,15 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	pendingReservation.Lock()
 	defer pendingReservation.Unlock()
 
-	// Create a blank, fresh transaction. Soon to be a complete funding
-	// transaction which will allow opening a lightning channel.
-	pendingReservation.fundingTx = wire.NewMsgTx(1)
-	fundingTx := pendingReservation.fundingTx
-
 	// Some temporary variables to cut down on the resolution verbosity.
 	pendingReservation.theirContribution = req.contribution
 	theirContribution := req.contribution
 	ourContribution := pendingReservation.ourContribution
 
-	// Add all multi-party inputs and outputs to the transaction.
-	for _, ourInput := range ourContribution.Inputs {
-		fundingTx.AddTxIn(ourInput)
-	}
-	for _, theirInput := range theirContribution.Inputs {
-		fundingTx.AddTxIn(theirInput)
-	}
-	for _, ourChangeOutput := range ourContribution.ChangeOutputs {
-		fundingTx.AddTxOut(ourChangeOutput)
-	}
-	for _, theirChangeOutput := range theirContribution.ChangeOutputs {
-		fundingTx.AddTxOut(theirChangeOutput)
-	}
-
 	ourKey := pendingReservation.ourContribution.MultiSigKey
 	theirKey := theirContribution.MultiSigKey
 
-	// Finally, add the 2-of-2 multi-sig output which will set up the lightning
+	// Generate the 2-of-2 multi-sig output which will set up the lightning
 	// channel.
 	channelCapacity := int64(pendingReservation.partialState.Capacity)
 	witnessScript, multiSigOut, err := GenFundingPkScript(
@@ -726,52 +723,33 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		return
 	}
 
-	// Sort the transaction. Since both side agree to a canonical ordering,
-	// by sorting we no longer need to send the entire transaction. Only
-	// signatures will be exchanged.
-	fundingTx.AddTxOut(multiSigOut)
-	txsort.InPlaceSort(pendingReservation.fundingTx)
-
-	// Next, sign all inputs that are ours, collecting the signatures in
-	// order of the inputs.
-	pendingReservation.ourFundingInputScripts = make([]*InputScript, 0,
-		len(ourContribution.Inputs))
-	signDesc := SignDescriptor{
-		HashType:  txscript.SigHashAll,
-		SigHashes: txscript.NewTxSigHashes(fundingTx),
-	}
-	for i, txIn := range fundingTx.TxIn {
-		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
-		if err == ErrNotMine {
-			continue
-		} else if err != nil {
-			req.err <- err
-			return
-		}
-
-		signDesc.Output = info
-		signDesc.InputIndex = i
-
-		inputScript, err := l.Cfg.Signer.ComputeInputScript(fundingTx,
-			&signDesc)
+	// An externally funded reservation comes with a funding transaction
+	// that has already been assembled, so we'll only need to ensure it
+	// actually pays to our multi-sig output. Otherwise, we'll create the
+	// funding transaction from the contributions of both parties.
+	if req.fundingTx != nil {
+		pendingReservation.fundingTx = req.fundingTx
+	} else {
+		err := l.assembleFundingTx(pendingReservation, multiSigOut)
 		if err != nil {
 			req.err <- err
 			return
 		}
-
-		txIn.SignatureScript = inputScript.ScriptSig
-		txIn.Witness = inputScript.Witness
-		pendingReservation.ourFundingInputScripts = append(
-			pendingReservation.ourFundingInputScripts,
-			inputScript,
-		)
 	}
+	fundingTx := pendingReservation.fundingTx
 
 	// Locate the index of the multi-sig outpoint in order to record it
 	// since the outputs are canonically sorted. If this is a single funder
 	// workflow, then we'll also need to send this to the remote node.
 	fundingTxID := fundingTx.TxHash()
-	_, multiSigIndex := FindScriptOutputIndex(fundingTx, multiSigOut.PkScript)
+	found, multiSigIndex := FindScriptOutputIndex(
+		fundingTx, multiSigOut.PkScript,
+	)
+	if !found {
+		req.err <- fmt.Errorf("funding tx %v doesn't pay to the "+
+			"multi-sig output of the channel", fundingTxID)
+		return
+	}
 	fundingOutpoint := wire.NewOutPoint(&fundingTxID, multiSigIndex)
 	pendingReservation.partialState.FundingOutpoint = *fundingOutpoint
 
@@ -862,7 +840,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 
 	// Generate a signature for their version of the initial commitment
 	// transaction.
-	signDesc = SignDescriptor{
+	signDesc := SignDescriptor{
 		WitnessScript: witnessScript,
 		KeyDesc:       ourKey,
 		Output:        multiSigOut,
@@ -880,6 +858,268 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	req.err <- nil
 }
 
+// assembleFundingTx creates the funding transaction of a dual funder
+// reservation from the inputs and change outputs of both parties along with
+// the passed multi-sig output, and signs all inputs belonging to the wallet.
+func (l *LightningWallet) assembleFundingTx(res *ChannelReservation,
+	multiSigOut *wire.TxOut) error {
+
+	// Create a blank, fresh transaction. Soon to be a complete funding
+	// transaction which will allow opening a lightning channel.
+	res.fundingTx = wire.NewMsgTx(1)
+	fundingTx := res.fundingTx
+
+	ourContribution := res.ourContribution
+	theirContribution := res.theirContribution
+
+	// Add all multi-party inputs and outputs to the transaction.
+	for _, ourInput := range ourContribution.Inputs {
+		fundingTx.AddTxIn(ourInput)
+	}
+	for _, theirInput := range theirContribution.Inputs {
+		fundingTx.AddTxIn(theirInput)
+	}
+	for _, ourChangeOutput := range ourContribution.ChangeOutputs {
+		fundingTx.AddTxOut(ourChangeOutput)
+	}
+	for _, theirChangeOutput := range theirContribution.ChangeOutputs {
+		fundingTx.AddTxOut(theirChangeOutput)
+	}
+
+	// Sort the transaction. Since both side agree to a canonical ordering,
+	// by sorting we no longer need to send the entire transaction. Only
+	// signatures will be exchanged.
+	fundingTx.AddTxOut(multiSigOut)
+	txsort.InPlaceSort(fundingTx)
+
+	// Next, sign all inputs that are ours, collecting the signatures in
+	// order of the inputs.
+	inputScripts, err := l.signFundingInputs(fundingTx)
+	if err != nil {
+		return err
+	}
+	res.ourFundingInputScripts = inputScripts
+
+	return nil
+}
+
+// signFundingInputs signs all inputs of the passed funding transaction that
+// belong to the wallet, and returns the input scripts in the order of the
+// inputs.
+func (l *LightningWallet) signFundingInputs(
+	fundingTx *wire.MsgTx) ([]*InputScript, error) {
+
+	var inputScripts []*InputScript
+	signDesc := SignDescriptor{
+		HashType:  txscript.SigHashAll,
+		SigHashes: txscript.NewTxSigHashes(fundingTx),
+	}
+	for i, txIn := range fundingTx.TxIn {
+		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err == ErrNotMine {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		signDesc.Output = info
+		signDesc.InputIndex = i
+
+		inputScript, err := l.Cfg.Signer.ComputeInputScript(fundingTx,
+			&signDesc)
+		if err != nil {
+			return nil, err
+		}
+
+		txIn.SignatureScript = inputScript.ScriptSig
+		txIn.Witness = inputScript.Witness
+		inputScripts = append(inputScripts, inputScript)
+	}
+
+	return inputScripts, nil
+}
+
+// CreateBatchFundingTx creates and signs a single transaction that funds all
+// channels of a batch. The passed outputs are the multi-sig outputs of the
+// channels, the transaction pays to all of them and carries at most one
+// change output. The coins spent by the transaction remain locked until the
+// batch is either completed or released with ReleaseBatchFundingTx.
+func (l *LightningWallet) CreateBatchFundingTx(outputs []*wire.TxOut,
+	feeRate SatPerKWeight, minConfs int32) (*wire.MsgTx, error) {
+
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("a funding batch needs at least one " +
+			"output")
+	}
+
+	var amt btcutil.Amount
+	for _, output := range outputs {
+		amt += btcutil.Amount(output.Value)
+	}
+
+	// Select enough coins to fund all channels of the batch, accounting
+	// for the fees of a transaction with one multi-sig output per channel.
+	contribution := &ChannelContribution{}
+	err := l.selectCoinsAndChange(
+		feeRate, amt, len(outputs), minConfs, contribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fundingTx := wire.NewMsgTx(1)
+	for _, input := range contribution.Inputs {
+		fundingTx.AddTxIn(input)
+	}
+	for _, changeOutput := range contribution.ChangeOutputs {
+		fundingTx.AddTxOut(changeOutput)
+	}
+	for _, output := range outputs {
+		fundingTx.AddTxOut(output)
+	}
+	txsort.InPlaceSort(fundingTx)
+
+	if _, err := l.signFundingInputs(fundingTx); err != nil {
+		l.ReleaseBatchFundingTx(fundingTx)
+		return nil, err
+	}
+
+	walletLog.Debugf("Batch funding tx %v generated: %v",
+		fundingTx.TxHash(), spew.Sdump(fundingTx))
+
+	return fundingTx, nil
+}
+
+// ReleaseBatchFundingTx unlocks the coins spent by a funding transaction
+// created with CreateBatchFundingTx, making them available for future funding
+// requests again. This MUST only be called if the transaction is never going
+// to be broadcast.
+func (l *LightningWallet) ReleaseBatchFundingTx(fundingTx *wire.MsgTx) {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	for _, txIn := range fundingTx.TxIn {
+		delete(l.lockedOutPoints, txIn.PreviousOutPoint)
+		l.UnlockOutpoint(txIn.PreviousOutPoint)
+	}
+}
+
+// EstimateFee performs a dry run of the coin selection for a transaction
+// paying to the passed outputs at the given fee rate, and returns the total
+// fee the transaction would pay along with its estimated weight. None of the
+// selected coins are locked.
+func (l *LightningWallet) EstimateFee(outputs []*wire.TxOut,
+	feeRate SatPerKWeight, minConfs int32) (btcutil.Amount, int64, error) {
+
+	if len(outputs) == 0 {
+		return 0, 0, fmt.Errorf("at least one output must be specified")
+	}
+
+	var (
+		amt          btcutil.Amount
+		outputWeight TxWeightEstimator
+	)
+	for _, output := range outputs {
+		amt += btcutil.Amount(output.Value)
+		outputWeight.AddTxOutput(output)
+	}
+
+	// We hold the coin select mutex while querying for outputs to make
+	// sure coins of concurrent funding flows aren't taken into account.
+	l.coinSelectMtx.Lock()
+	coins, err := l.ListUnspentWitness(minConfs, math.MaxInt32)
+	l.coinSelectMtx.Unlock()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	selectedCoins, changeAmt, err := coinSelect(
+		feeRate, amt, outputWeight, coins,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// With the coins selected, we'll estimate the weight of the final
+	// transaction. Just like the actual spend, a change output is only
+	// added if it isn't dust, otherwise its value goes towards the fees.
+	weightEstimate := outputWeight
+	var totalSat btcutil.Amount
+	for _, coin := range selectedCoins {
+		totalSat += coin.Value
+		if err := addInputWeight(&weightEstimate, coin); err != nil {
+			return 0, 0, err
+		}
+	}
+	fee := totalSat - amt
+	if changeAmt != 0 && changeAmt > DefaultDustLimit() {
+		weightEstimate.AddP2WKHOutput()
+		fee -= changeAmt
+	}
+
+	return fee, int64(weightEstimate.Weight()), nil
+}
+
+// SendAll creates, signs and broadcasts a transaction that sweeps all unlocked
+// wallet outputs with at least minConfs confirmations to the passed output
+// script. The fee of the transaction, computed with the given fee rate, is
+// deducted from the swept value.
+func (l *LightningWallet) SendAll(pkScript []byte, feeRate SatPerKWeight,
+	minConfs int32) (*wire.MsgTx, error) {
+
+	// We hold the coin select mutex until the transaction is broadcast to
+	// make sure no concurrent funding flow selects any of the swept coins.
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	coins, err := l.ListUnspentWitness(minConfs, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	if len(coins) == 0 {
+		return nil, fmt.Errorf("no spendable coins to sweep")
+	}
+
+	sweepTx := wire.NewMsgTx(2)
+	sweepOutput := &wire.TxOut{PkScript: pkScript}
+
+	var (
+		totalSat       btcutil.Amount
+		weightEstimate TxWeightEstimator
+	)
+	for _, coin := range coins {
+		if err := addInputWeight(&weightEstimate, coin); err != nil {
+			return nil, err
+		}
+		totalSat += coin.Value
+		sweepTx.AddTxIn(wire.NewTxIn(&coin.OutPoint, nil, nil))
+	}
+	weightEstimate.AddTxOutput(sweepOutput)
+
+	fee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+	sweepAmt := totalSat - fee
+	if sweepAmt <= DefaultDustLimit() {
+		return nil, fmt.Errorf("swept amount %v after fees of %v is "+
+			"below dust limit", sweepAmt, fee)
+	}
+	sweepOutput.Value = int64(sweepAmt)
+	sweepTx.AddTxOut(sweepOutput)
+	txsort.InPlaceSort(sweepTx)
+
+	if _, err := l.signFundingInputs(sweepTx); err != nil {
+		return nil, err
+	}
+
+	walletLog.Infof("Sweeping %v from %v coins to %x with tx %v", sweepAmt,
+		len(coins), pkScript, sweepTx.TxHash())
+
+	if err := l.PublishTransaction(sweepTx); err != nil {
+		return nil, err
+	}
+
+	return sweepTx, nil
+}
+
 // handleSingleContribution is called as the second step to a single funder
 // workflow to which we are the responder. It simply saves the remote peer's
 // contribution to the channel, as solely the remote peer will contribute any
@@ -1010,48 +1250,11 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	// At this point, we can also record and verify their signature for our
 	// commitment transaction.
 	res.theirCommitmentSig = msg.theirCommitmentSig
-	commitTx := res.partialState.LocalCommitment.CommitTx
-	ourKey := res.ourContribution.MultiSigKey
-	theirKey := res.theirContribution.MultiSigKey
-
-	// Re-generate both the witnessScript and p2sh output. We sign the
-	// witnessScript script, but include the p2sh output as the subscript
-	// for verification.
-	witnessScript, _, err := GenFundingPkScript(
-		ourKey.PubKey.SerializeCompressed(),
-		theirKey.PubKey.SerializeCompressed(),
-		int64(res.partialState.Capacity),
-	)
-	if err != nil {
-		msg.err <- err
-		msg.completeChan <- nil
-		return
-	}
-
-	// Next, create the spending scriptSig, and then verify that the script
-	// is complete, allowing us to spend from the funding transaction.
-	channelValue := int64(res.partialState.Capacity)
-	hashCache := txscript.NewTxSigHashes(commitTx)
-	sigHash, err := txscript.CalcWitnessSigHash(witnessScript, hashCache,
-		txscript.SigHashAll, commitTx, 0, channelValue)
-	if err != nil {
-		msg.err <- err
-		msg.completeChan <- nil
-		return
-	}
-
-	// Verify that we've received a valid signature from the remote party
-	// for our version of the commitment transaction.
 	theirCommitSig := msg.theirCommitmentSig
-	sig, err := btcec.ParseSignature(theirCommitSig, btcec.S256())
-	if err != nil {
+	if err := verifyCommitSig(res, theirCommitSig); err != nil {
 		msg.err <- err
 		msg.completeChan <- nil
 		return
-	} else if !sig.Verify(sigHash, theirKey.PubKey) {
-		msg.err <- fmt.Errorf("counterparty's commitment signature is invalid")
-		msg.completeChan <- nil
-		return
 	}
 	res.partialState.LocalCommitment.CommitSig = theirCommitSig
 
@@ -1096,6 +1299,47 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	msg.err <- nil
 }
 
+// verifyCommitSig verifies that the passed signature of the remote party is
+// valid for our version of the commitment transaction of the reservation.
+func verifyCommitSig(res *ChannelReservation, theirCommitSig []byte) error {
+	commitTx := res.partialState.LocalCommitment.CommitTx
+	ourKey := res.ourContribution.MultiSigKey
+	theirKey := res.theirContribution.MultiSigKey
+
+	// Re-generate both the witnessScript and p2sh output. We sign the
+	// witnessScript script, but include the p2sh output as the subscript
+	// for verification.
+	witnessScript, _, err := GenFundingPkScript(
+		ourKey.PubKey.SerializeCompressed(),
+		theirKey.PubKey.SerializeCompressed(),
+		int64(res.partialState.Capacity),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Next, create the spending scriptSig, and then verify that the script
+	// is complete, allowing us to spend from the funding transaction.
+	channelValue := int64(res.partialState.Capacity)
+	hashCache := txscript.NewTxSigHashes(commitTx)
+	sigHash, err := txscript.CalcWitnessSigHash(witnessScript, hashCache,
+		txscript.SigHashAll, commitTx, 0, channelValue)
+	if err != nil {
+		return err
+	}
+
+	// Verify that we've received a valid signature from the remote party
+	// for our version of the commitment transaction.
+	sig, err := btcec.ParseSignature(theirCommitSig, btcec.S256())
+	if err != nil {
+		return err
+	} else if !sig.Verify(sigHash, theirKey.PubKey) {
+		return fmt.Errorf("counterparty's commitment signature is invalid")
+	}
+
+	return nil
+}
+
 // handleSingleFunderSigs is called once the remote peer who initiated the
 // single funder workflow has assembled the funding transaction, and generated
 // a signature for our version of the commitment transaction. This method
@@ -1260,10 +1504,11 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 // outputs which sum to at least 'numCoins' amount of satoshis. If coin
 // selection is successful/possible, then the selected coins are available
 // within the passed contribution's inputs. If necessary, a change address will
-// also be generated.
+// also be generated. The fees are estimated for a transaction paying to
+// numFundingOutputs channel funding outputs.
 // TODO(roasbeef): remove hardcoded fees.
 func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
-	amt btcutil.Amount, minConfs int32,
+	amt btcutil.Amount, numFundingOutputs int, minConfs int32,
 	contribution *ChannelContribution) error {
 
 	// We hold the coin select mutex while querying for outputs, and
@@ -1285,7 +1530,14 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	// Perform coin selection over our available, unlocked unspent outputs
 	// in order to find enough coins to meet the funding amount
 	// requirements.
-	selectedCoins, changeAmt, err := coinSelect(feeRate, amt, coins)
+	var outputWeight TxWeightEstimator
+	for i := 0; i < numFundingOutputs; i++ {
+		// Channel funding multisig outputs are P2WSH.
+		outputWeight.AddP2WSHOutput()
+	}
+	selectedCoins, changeAmt, err := coinSelect(
+		feeRate, amt, outputWeight, coins,
+	)
 	if err != nil {
 		return err
 	}
@@ -1379,12 +1631,30 @@ func selectInputs(amt btcutil.Amount, coins []*Utxo) (btcutil.Amount, []*Utxo, e
 	return 0, nil, &ErrInsufficientFunds{amt, satSelected}
 }
 
+// addInputWeight updates the passed weight estimate to account for an
+// additional input spending the given wallet output.
+func addInputWeight(weightEstimate *TxWeightEstimator, utxo *Utxo) error {
+	switch utxo.AddressType {
+	case WitnessPubKey:
+		weightEstimate.AddP2WKHInput()
+	case NestedWitnessPubKey:
+		weightEstimate.AddNestedP2WKHInput()
+	default:
+		return fmt.Errorf("Unsupported address type: %v",
+			utxo.AddressType)
+	}
+
+	return nil
+}
+
 // coinSelect attempts to select a sufficient amount of coins, including a
 // change output to fund amt satoshis, adhering to the specified fee rate. The
 // specified fee rate should be expressed in sat/kw for coin selection to
-// function properly.
+// function properly. The passed outputWeight should account for all outputs
+// of the transaction, except for the change output.
 func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
-	coins []*Utxo) ([]*Utxo, btcutil.Amount, error) {
+	outputWeight TxWeightEstimator, coins []*Utxo) ([]*Utxo, btcutil.Amount,
+	error) {
 
 	amtNeeded := amt
 	for {
@@ -1395,23 +1665,14 @@ func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
 			return nil, 0, err
 		}
 
-		var weightEstimate TxWeightEstimator
-
+		weightEstimate := outputWeight
 		for _, utxo := range selectedUtxos {
-			switch utxo.AddressType {
-			case WitnessPubKey:
-				weightEstimate.AddP2WKHInput()
-			case NestedWitnessPubKey:
-				weightEstimate.AddNestedP2WKHInput()
-			default:
-				return nil, 0, fmt.Errorf("Unsupported address type: %v",
-					utxo.AddressType)
+			err := addInputWeight(&weightEstimate, utxo)
+			if err != nil {
+				return nil, 0, err
 			}
 		}
 
-		// Channel funding multisig output is P2WSH.
-		weightEstimate.AddP2WSHOutput()
-
 		// Assume that change output is a P2WKH output.
 		//
 		// TODO: Handle wallets that generate non-witness change
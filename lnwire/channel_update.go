@@ -25,6 +25,12 @@ const (
 	ChanUpdateDisabled
 )
 
+// ChanUpdateOptionMaxHtlc is a bit that indicates that the optional
+// HtlcMaximumMsat field is present within the ChannelUpdate. It corresponds to
+// the least-significant bit of the message_flags byte, which is transmitted
+// ahead of the channel_flags byte that holds the bits above.
+const ChanUpdateOptionMaxHtlc ChanUpdateFlag = 1 << 8
+
 // ChannelUpdate message is used after channel has been initially announced.
 // Each side independently announces its fees and minimum expiry for HTLCs and
 // other parameters. Also this message is used to redeclare initially set
@@ -75,6 +81,11 @@ type ChannelUpdate struct {
 	// satoshi.
 	FeeRate uint32
 
+	// HtlcMaximumMsat is the maximum HTLC value which will be forwarded
+	// over the channel. It's only present if the ChanUpdateOptionMaxHtlc
+	// bit is set within Flags.
+	HtlcMaximumMsat MilliSatoshi
+
 	// ExtraOpaqueData is the set of data that was appended to this
 	// message, some of which we may not actually know how to iterate or
 	// parse. By holding onto this data, we ensure that we're able to
@@ -108,6 +119,13 @@ func (a *ChannelUpdate) Decode(r io.Reader, pver uint32) error {
 		return err
 	}
 
+	// The maximum HTLC value is only present if signalled by the flags.
+	if a.Flags&ChanUpdateOptionMaxHtlc != 0 {
+		if err := readElements(r, &a.HtlcMaximumMsat); err != nil {
+			return err
+		}
+	}
+
 	// Now that we've read out all the fields that we explicitly know of,
 	// we'll collect the remainder into the ExtraOpaqueData field. If there
 	// aren't any bytes, then we'll snip off the slice to avoid carrying
@@ -128,18 +146,12 @@ func (a *ChannelUpdate) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (a *ChannelUpdate) Encode(w io.Writer, pver uint32) error {
-	return writeElements(w,
-		a.Signature,
-		a.ChainHash[:],
-		a.ShortChannelID,
-		a.Timestamp,
-		a.Flags,
-		a.TimeLockDelta,
-		a.HtlcMinimumMsat,
-		a.BaseFee,
-		a.FeeRate,
-		a.ExtraOpaqueData,
-	)
+	err := writeElements(w, a.Signature)
+	if err != nil {
+		return err
+	}
+
+	return a.encodeSignedData(w)
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -164,7 +176,17 @@ func (a *ChannelUpdate) DataToSign() ([]byte, error) {
 
 	// We should not include the signatures itself.
 	var w bytes.Buffer
-	err := writeElements(&w,
+	if err := a.encodeSignedData(&w); err != nil {
+		return nil, err
+	}
+
+	return w.Bytes(), nil
+}
+
+// encodeSignedData serializes all the fields of the ChannelUpdate that are
+// covered by its signature, which is all of them but the signature itself.
+func (a *ChannelUpdate) encodeSignedData(w io.Writer) error {
+	err := writeElements(w,
 		a.ChainHash[:],
 		a.ShortChannelID,
 		a.Timestamp,
@@ -173,11 +195,17 @@ func (a *ChannelUpdate) DataToSign() ([]byte, error) {
 		a.HtlcMinimumMsat,
 		a.BaseFee,
 		a.FeeRate,
-		a.ExtraOpaqueData,
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return w.Bytes(), nil
+	// The maximum HTLC value is only present if signalled by the flags.
+	if a.Flags&ChanUpdateOptionMaxHtlc != 0 {
+		if err := writeElements(w, a.HtlcMaximumMsat); err != nil {
+			return err
+		}
+	}
+
+	return writeElements(w, a.ExtraOpaqueData)
 }
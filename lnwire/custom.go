@@ -0,0 +1,83 @@
+package lnwire
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// CustomTypeStart is the start of the range of message types that are
+// reserved for custom messages, which are exchanged by applications built on
+// top of the Lightning protocol rather than by lnd itself.
+const CustomTypeStart MessageType = 32768
+
+// ErrInvalidCustomType is returned when creating a custom message with a type
+// outside of the custom range.
+var ErrInvalidCustomType = errors.New("custom message type must be at " +
+	"least 32768")
+
+// Custom is a message of a type in the custom range. Its payload is opaque to
+// lnd, and is handed as is to the applications that subscribed to custom
+// messages.
+type Custom struct {
+	// Type is the message type of the custom message.
+	Type MessageType
+
+	// Data is the raw payload of the custom message.
+	Data []byte
+}
+
+// NewCustom creates a new custom message of the given type and payload.
+func NewCustom(msgType MessageType, data []byte) (*Custom, error) {
+	if msgType < CustomTypeStart {
+		return nil, ErrInvalidCustomType
+	}
+
+	return &Custom{
+		Type: msgType,
+		Data: data,
+	}, nil
+}
+
+// A compile time check to ensure Custom implements the lnwire.Message
+// interface.
+var _ Message = (*Custom)(nil)
+
+// Decode deserializes the serialized Custom message stored in the passed
+// io.Reader into the target Custom message. As the payload isn't length
+// delimited, all remaining data of the reader is consumed.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Decode(r io.Reader, pver uint32) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	c.Data = data
+
+	return nil
+}
+
+// Encode serializes the target Custom message into the passed io.Writer
+// implementation.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Encode(w io.Writer, pver uint32) error {
+	_, err := w.Write(c.Data)
+	return err
+}
+
+// MsgType returns the message type of the custom message.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MsgType() MessageType {
+	return c.Type
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a Custom
+// message.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
@@ -0,0 +1,36 @@
+package lnwire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// TestCustomMessage asserts that custom messages can only be created within
+// the custom range, and that they survive a round trip over the wire.
+func TestCustomMessage(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewCustom(CustomTypeStart-1, []byte{1})
+	if err != ErrInvalidCustomType {
+		t.Fatalf("expected ErrInvalidCustomType, got %v", err)
+	}
+
+	msg, err := NewCustom(CustomTypeStart+1, []byte{1, 2, 3})
+	if err != nil {
+		t.Fatalf("unable to create custom message: %v", err)
+	}
+
+	var b bytes.Buffer
+	if _, err := WriteMessage(&b, msg, 0); err != nil {
+		t.Fatalf("unable to write message: %v", err)
+	}
+	newMsg, err := ReadMessage(&b, 0)
+	if err != nil {
+		t.Fatalf("unable to read message: %v", err)
+	}
+
+	if !reflect.DeepEqual(msg, newMsg) {
+		t.Fatalf("expected %v, got %v", msg, newMsg)
+	}
+}
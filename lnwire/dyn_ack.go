@@ -0,0 +1,75 @@
+package lnwire
+
+import "io"
+
+// DynAck acknowledges a proposal of new flow constraints. The receiver of a
+// DynPropose sends it once it has accepted the proposed constraints, and the
+// initiator echoes it back once it has applied them, at which point the
+// receiver applies them as well. The constraints are repeated so that an
+// acknowledgement re-sent upon reconnection can be matched to its proposal.
+type DynAck struct {
+	// ChanID is the unique identifier of the channel whose constraints
+	// are being renegotiated.
+	ChanID ChannelID
+
+	// MaxValueInFlight is the maximum total value of the HTLCs that the
+	// receiver of the proposal may have in flight towards its sender.
+	MaxValueInFlight MilliSatoshi
+
+	// HtlcMinimum is the smallest HTLC that the receiver of the proposal
+	// may offer to its sender.
+	HtlcMinimum MilliSatoshi
+
+	// MaxAcceptedHTLCs is the maximum number of HTLCs that the receiver
+	// of the proposal may have in flight towards its sender.
+	MaxAcceptedHTLCs uint16
+}
+
+// A compile time check to ensure DynAck implements the lnwire.Message
+// interface.
+var _ Message = (*DynAck)(nil)
+
+// Decode deserializes the serialized DynAck message stored in the passed
+// io.Reader into the target DynAck using the deserialization rules defined by
+// the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynAck) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		&d.ChanID,
+		&d.MaxValueInFlight,
+		&d.HtlcMinimum,
+		&d.MaxAcceptedHTLCs,
+	)
+}
+
+// Encode serializes the target DynAck message into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynAck) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		d.ChanID,
+		d.MaxValueInFlight,
+		d.HtlcMinimum,
+		d.MaxAcceptedHTLCs,
+	)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as
+// a DynAck message on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynAck) MsgType() MessageType {
+	return MsgDynAck
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a DynAck
+// message.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynAck) MaxPayloadLength(uint32) uint32 {
+	// 32 + 8 + 8 + 2
+	return 50
+}
@@ -0,0 +1,75 @@
+package lnwire
+
+import "io"
+
+// DynPropose is sent by the initiator of a quiescence session to propose new
+// flow constraints for the channel. The proposed constraints bound the HTLCs
+// that the receiver may offer to the sender, in the same way as those sent
+// within the OpenChannel and AcceptChannel messages do. The receiver either
+// accepts them and responds with a DynAck, or refuses them with a DynReject.
+type DynPropose struct {
+	// ChanID is the unique identifier of the channel whose constraints
+	// are to be renegotiated.
+	ChanID ChannelID
+
+	// MaxValueInFlight is the maximum total value of the HTLCs that the
+	// receiver may have in flight towards the sender.
+	MaxValueInFlight MilliSatoshi
+
+	// HtlcMinimum is the smallest HTLC that the receiver may offer to the
+	// sender.
+	HtlcMinimum MilliSatoshi
+
+	// MaxAcceptedHTLCs is the maximum number of HTLCs that the receiver
+	// may have in flight towards the sender.
+	MaxAcceptedHTLCs uint16
+}
+
+// A compile time check to ensure DynPropose implements the lnwire.Message
+// interface.
+var _ Message = (*DynPropose)(nil)
+
+// Decode deserializes the serialized DynPropose message stored in the passed
+// io.Reader into the target DynPropose using the deserialization rules defined
+// by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynPropose) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		&d.ChanID,
+		&d.MaxValueInFlight,
+		&d.HtlcMinimum,
+		&d.MaxAcceptedHTLCs,
+	)
+}
+
+// Encode serializes the target DynPropose message into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynPropose) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		d.ChanID,
+		d.MaxValueInFlight,
+		d.HtlcMinimum,
+		d.MaxAcceptedHTLCs,
+	)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as
+// a DynPropose message on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynPropose) MsgType() MessageType {
+	return MsgDynPropose
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// DynPropose message.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynPropose) MaxPayloadLength(uint32) uint32 {
+	// 32 + 8 + 8 + 2
+	return 50
+}
@@ -0,0 +1,56 @@
+package lnwire
+
+import "io"
+
+// DynReject is sent in response to a DynPropose, to signal that the receiver
+// refuses the proposed flow constraints. It's also sent by the initiator in
+// response to a DynAck re-sent upon reconnection, if it never applied the
+// acknowledged constraints. In either case, the channel is left untouched.
+type DynReject struct {
+	// ChanID is the unique identifier of the channel whose constraints
+	// were proposed.
+	ChanID ChannelID
+
+	// Reason is a human readable explanation of why the proposal was
+	// rejected.
+	Reason ErrorData
+}
+
+// A compile time check to ensure DynReject implements the lnwire.Message
+// interface.
+var _ Message = (*DynReject)(nil)
+
+// Decode deserializes the serialized DynReject message stored in the passed
+// io.Reader into the target DynReject using the deserialization rules defined
+// by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynReject) Decode(r io.Reader, pver uint32) error {
+	return readElements(r, &d.ChanID, &d.Reason)
+}
+
+// Encode serializes the target DynReject message into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynReject) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w, d.ChanID, d.Reason)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as
+// a DynReject message on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynReject) MsgType() MessageType {
+	return MsgDynReject
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// DynReject message.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynReject) MaxPayloadLength(uint32) uint32 {
+	// 32 + 2 + 65501
+	return 65535
+}
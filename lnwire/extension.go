@@ -0,0 +1,119 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// ExtensibleMessage is implemented by messages that may carry optional
+// extension data following their fixed fields. The extension is encoded as a
+// TLV stream, where unknown odd records are ignored and unknown even records
+// cause the message to be rejected.
+//
+// As the extension isn't length delimited, it can only be decoded when the
+// message is read from a buffer holding exactly that message, as is the case
+// for messages received from the wire. Messages embedded within a larger
+// stream are length prefixed by WriteEmbeddedMessage whenever they carry an
+// extension.
+type ExtensibleMessage interface {
+	Message
+
+	// DecodeExtension parses the extension data that followed the fixed
+	// fields of the message.
+	DecodeExtension(ext []byte) error
+
+	// HasExtension returns true if the message carries any extension
+	// data.
+	HasExtension() bool
+}
+
+// extensionRecord is a single record within a message extension.
+type extensionRecord struct {
+	typ   uint64
+	value []byte
+}
+
+// writeExtension serializes the passed records, which must be sorted by
+// type, as a TLV stream.
+func writeExtension(w io.Writer, records []extensionRecord) error {
+	for _, rec := range records {
+		if err := tlv.WriteBigSize(w, rec.typ); err != nil {
+			return err
+		}
+		if err := tlv.WriteBigSize(w, uint64(len(rec.value))); err != nil {
+			return err
+		}
+		if _, err := w.Write(rec.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseExtension parses a TLV stream into its records, invoking handle for
+// each known record. Unknown odd records are skipped, while unknown even
+// records result in an error.
+func parseExtension(ext []byte,
+	handle func(typ uint64, value []byte) (bool, error)) error {
+
+	r := bytes.NewReader(ext)
+
+	var lastType uint64
+	for i := 0; r.Len() > 0; i++ {
+		typ, err := tlv.ReadBigSize(r)
+		if err != nil {
+			return err
+		}
+		if i > 0 && typ <= lastType {
+			return fmt.Errorf("extension records out of order")
+		}
+		lastType = typ
+
+		length, err := tlv.ReadBigSize(r)
+		if err != nil {
+			return err
+		}
+		if length > uint64(r.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		value := make([]byte, length)
+		if _, err := io.ReadFull(r, value); err != nil {
+			return err
+		}
+
+		known, err := handle(typ, value)
+		if err != nil {
+			return err
+		}
+		if !known && typ%2 == 0 {
+			return fmt.Errorf("unknown required extension "+
+				"record %d", typ)
+		}
+	}
+
+	return nil
+}
+
+// readExtension reads any remaining data within r and hands it to the
+// message as its extension, if the message supports one.
+func readExtension(r io.Reader, msg Message) error {
+	extMsg, ok := msg.(ExtensibleMessage)
+	if !ok {
+		return nil
+	}
+
+	ext, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(ext) == 0 {
+		return nil
+	}
+
+	return extMsg.DecodeExtension(ext)
+}
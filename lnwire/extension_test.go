@@ -0,0 +1,129 @@
+package lnwire
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestUpdateAddHTLCExtension asserts that the endorsement signal survives a
+// round trip over the wire, as well as when messages are embedded within a
+// larger stream, and that unknown extension records are handled according to
+// the "it's OK to be odd" rule.
+func TestUpdateAddHTLCExtension(t *testing.T) {
+	t.Parallel()
+
+	add := &UpdateAddHTLC{
+		ID:       7,
+		Amount:   1000,
+		Expiry:   144,
+		Endorsed: true,
+	}
+
+	var b bytes.Buffer
+	if _, err := WriteMessage(&b, add, 0); err != nil {
+		t.Fatalf("unable to write msg: %v", err)
+	}
+	msg, err := ReadMessage(&b, 0)
+	if err != nil {
+		t.Fatalf("unable to read msg: %v", err)
+	}
+	if !msg.(*UpdateAddHTLC).Endorsed {
+		t.Fatalf("endorsement lost over the wire")
+	}
+
+	// When embedded back to back, the endorsement must survive, and the
+	// following message must still be readable. Messages without an
+	// extension should be embedded exactly as they're written on the
+	// wire.
+	unendorsed := *add
+	unendorsed.Endorsed = false
+
+	b.Reset()
+	for _, m := range []*UpdateAddHTLC{add, &unendorsed, add} {
+		if _, err := WriteEmbeddedMessage(&b, m, 0); err != nil {
+			t.Fatalf("unable to write msg: %v", err)
+		}
+	}
+	for _, endorsed := range []bool{true, false, true} {
+		msg, err := ReadEmbeddedMessage(&b, 0)
+		if err != nil {
+			t.Fatalf("unable to read msg: %v", err)
+		}
+		if msg.(*UpdateAddHTLC).ID != 7 {
+			t.Fatalf("embedded message corrupted")
+		}
+		if msg.(*UpdateAddHTLC).Endorsed != endorsed {
+			t.Fatalf("expected endorsed=%v", endorsed)
+		}
+	}
+
+	var wire, embedded bytes.Buffer
+	if _, err := WriteMessage(&wire, &unendorsed, 0); err != nil {
+		t.Fatalf("unable to write msg: %v", err)
+	}
+	if _, err := WriteEmbeddedMessage(&embedded, &unendorsed, 0); err != nil {
+		t.Fatalf("unable to write msg: %v", err)
+	}
+	if !bytes.Equal(wire.Bytes(), embedded.Bytes()) {
+		t.Fatalf("unextended message embedded differently")
+	}
+
+	// Unknown odd records should be ignored, while unknown even records
+	// should cause the message to be rejected.
+	var decoded UpdateAddHTLC
+	if err := decoded.DecodeExtension([]byte{0x01, 0x00, 0x6a, 0x01, 0x01}); err != nil {
+		t.Fatalf("unable to decode extension: %v", err)
+	}
+	if !decoded.Endorsed {
+		t.Fatalf("endorsement not decoded")
+	}
+	if err := decoded.DecodeExtension([]byte{0x02, 0x00}); err == nil {
+		t.Fatalf("unknown even record accepted")
+	}
+}
+
+// TestInboundFee asserts that the inbound fee of a channel can be set within
+// the extra opaque data of a ChannelUpdate, without disturbing any other
+// records within it.
+func TestInboundFee(t *testing.T) {
+	t.Parallel()
+
+	// We'll start with extra data that carries an unrelated record.
+	extraData := []byte{0x01, 0x01, 0xaa}
+
+	fee, err := ParseInboundFee(extraData)
+	if err != nil {
+		t.Fatalf("unable to parse inbound fee: %v", err)
+	}
+	if fee != nil {
+		t.Fatalf("expected no inbound fee, got %v", fee)
+	}
+
+	discount := &InboundFee{BaseFee: -1000, FeeRate: -200}
+	withFee, err := SetInboundFee(extraData, discount)
+	if err != nil {
+		t.Fatalf("unable to set inbound fee: %v", err)
+	}
+
+	fee, err = ParseInboundFee(withFee)
+	if err != nil {
+		t.Fatalf("unable to parse inbound fee: %v", err)
+	}
+	if fee == nil || *fee != *discount {
+		t.Fatalf("expected inbound fee %v, got %v", discount, fee)
+	}
+	if fee.CalcFee(1000000) != -1200 {
+		t.Fatalf("expected inbound fee of -1200, got %v",
+			fee.CalcFee(1000000))
+	}
+
+	// Removing the inbound fee should restore the original data.
+	withoutFee, err := SetInboundFee(withFee, nil)
+	if err != nil {
+		t.Fatalf("unable to remove inbound fee: %v", err)
+	}
+	if !bytes.Equal(withoutFee, extraData) {
+		t.Fatalf("expected extra data %x, got %x", extraData,
+			withoutFee)
+	}
+}
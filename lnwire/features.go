@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sort"
 )
 
 // FeatureBit represents a feature that can be enabled in either a local or
@@ -46,6 +47,44 @@ const (
 	// efficient network view reconciliation.
 	GossipQueriesOptional FeatureBit = 7
 
+	// QuiescenceRequired is a required feature bit that signals that the
+	// setting peer requires support for the quiescence protocol, allowing
+	// either side to bring a channel to a state with no pending updates.
+	QuiescenceRequired FeatureBit = 34
+
+	// QuiescenceOptional is an optional feature bit that signals that the
+	// setting peer supports the quiescence protocol.
+	QuiescenceOptional FeatureBit = 35
+
+	// DynamicCommitmentsRequired is a required feature bit that signals
+	// that the setting peer requires support for renegotiating the flow
+	// constraints of a channel once it has been quiesced.
+	DynamicCommitmentsRequired FeatureBit = 36
+
+	// DynamicCommitmentsOptional is an optional feature bit that signals
+	// that the setting peer supports renegotiating the flow constraints of
+	// a quiesced channel.
+	DynamicCommitmentsOptional FeatureBit = 37
+
+	// OnionMessagesRequired is a global feature bit that signals that the
+	// advertising node requires peers to forward onion messages.
+	OnionMessagesRequired FeatureBit = 38
+
+	// OnionMessagesOptional is a global feature bit that signals that the
+	// advertising node forwards onion messages, which is how invoices for
+	// BOLT-12 offers are requested.
+	OnionMessagesOptional FeatureBit = 39
+
+	// GossipQueriesZlibRequired is a local feature bit that signals that
+	// the setting peer requires the short channel IDs within gossip query
+	// replies to be zlib compressed.
+	GossipQueriesZlibRequired FeatureBit = 40
+
+	// GossipQueriesZlibOptional is a local feature bit that signals that
+	// the setting peer is able to decode zlib compressed short channel IDs
+	// within gossip queries and their replies.
+	GossipQueriesZlibOptional FeatureBit = 41
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -72,13 +111,24 @@ var LocalFeatures = map[FeatureBit]string{
 	InitialRoutingSync:      "initial-routing-sync",
 	GossipQueriesRequired:   "gossip-queries-required",
 	GossipQueriesOptional:   "gossip-queries-optional",
+	QuiescenceRequired:      "quiescence-required",
+	QuiescenceOptional:      "quiescence-optional",
+
+	DynamicCommitmentsRequired: "dynamic-commitments-required",
+	DynamicCommitmentsOptional: "dynamic-commitments-optional",
+
+	GossipQueriesZlibRequired: "gossip-queries-zlib-required",
+	GossipQueriesZlibOptional: "gossip-queries-zlib-optional",
 }
 
 // GlobalFeatures is a mapping of known global feature bits to a descriptive
 // name. All known global feature bits must be assigned a name in this mapping.
 // Global features are those which are advertised to the entire network. A full
 // description of these feature bits is provided in the BOLT-09 specification.
-var GlobalFeatures map[FeatureBit]string
+var GlobalFeatures = map[FeatureBit]string{
+	OnionMessagesRequired: "onion-messages",
+	OnionMessagesOptional: "onion-messages",
+}
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
 // RawFeatureVector itself just stores a set of bit flags but can be used to
@@ -114,6 +164,21 @@ func (fv *RawFeatureVector) Unset(feature FeatureBit) {
 	delete(fv.features, feature)
 }
 
+// Features returns all the feature bits enabled in the vector, in ascending
+// order.
+func (fv *RawFeatureVector) Features() []FeatureBit {
+	features := make([]FeatureBit, 0, len(fv.features))
+	for feature := range fv.features {
+		features = append(features, feature)
+	}
+
+	sort.Slice(features, func(i, j int) bool {
+		return features[i] < features[j]
+	})
+
+	return features
+}
+
 // SerializeSize returns the number of bytes needed to represent feature vector
 // in byte format.
 func (fv *RawFeatureVector) SerializeSize() int {
@@ -260,3 +260,26 @@ func TestFeatureNames(t *testing.T) {
 		}
 	}
 }
+
+// TestUpdateNodeAnnFeatures checks that feature bits of a node announcement
+// can be set and unset, without modifying the original feature vector.
+func TestUpdateNodeAnnFeatures(t *testing.T) {
+	t.Parallel()
+
+	original := NewRawFeatureVector(1, 3)
+	nodeAnn := &NodeAnnouncement{Features: original}
+
+	UpdateNodeAnnFeatures([]FeatureBit{5}, []FeatureBit{1})(nodeAnn)
+
+	expected := []FeatureBit{3, 5}
+	if !reflect.DeepEqual(nodeAnn.Features.Features(), expected) {
+		t.Fatalf("expected features %v, got %v", expected,
+			nodeAnn.Features.Features())
+	}
+
+	expected = []FeatureBit{1, 3}
+	if !reflect.DeepEqual(original.Features(), expected) {
+		t.Fatalf("original features were modified: %v",
+			original.Features())
+	}
+}
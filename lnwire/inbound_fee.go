@@ -0,0 +1,114 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// InboundFeeRecordType is the type of the record within the extra opaque data
+// of a ChannelUpdate that carries the inbound fee of the channel. Being odd,
+// nodes that don't understand inbound fees simply ignore it.
+const InboundFeeRecordType uint64 = 55555
+
+// InboundFee is the fee that a node charges for HTLCs entering it through a
+// particular channel, in addition to the regular fee charged by the outgoing
+// channel. Either component may be negative, in which case the inbound fee is
+// a discount, allowing routing nodes to attract traffic through channels in
+// which they hold too much liquidity. The total fee of a forward is never
+// negative though.
+type InboundFee struct {
+	// BaseFee is the base inbound fee, in milli-satoshis.
+	BaseFee int32
+
+	// FeeRate is the proportional inbound fee, in millionths of the
+	// amount of the HTLC.
+	FeeRate int32
+}
+
+// CalcFee returns the inbound fee of an HTLC of the given amount, which is
+// negative if the inbound fee is a discount.
+func (f InboundFee) CalcFee(amt MilliSatoshi) int64 {
+	return int64(f.BaseFee) + int64(amt)*int64(f.FeeRate)/1000000
+}
+
+// String returns a human readable representation of the inbound fee.
+func (f InboundFee) String() string {
+	return fmt.Sprintf("base_fee=%v, fee_rate=%v", f.BaseFee, f.FeeRate)
+}
+
+// ParseInboundFee extracts the inbound fee from the extra opaque data of a
+// ChannelUpdate. If the data doesn't carry an inbound fee, then nil is
+// returned.
+func ParseInboundFee(extraData []byte) (*InboundFee, error) {
+	var fee *InboundFee
+	err := parseExtension(extraData, func(typ uint64, value []byte) (bool,
+		error) {
+
+		if typ != InboundFeeRecordType {
+			return true, nil
+		}
+		if len(value) != 8 {
+			return false, fmt.Errorf("invalid inbound fee "+
+				"length: %d", len(value))
+		}
+
+		fee = &InboundFee{
+			BaseFee: int32(binary.BigEndian.Uint32(value[:4])),
+			FeeRate: int32(binary.BigEndian.Uint32(value[4:])),
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fee, nil
+}
+
+// SetInboundFee returns a copy of the extra opaque data of a ChannelUpdate in
+// which the inbound fee record has been replaced by the passed fee, or removed
+// if the fee is nil. All other records are preserved.
+func SetInboundFee(extraData []byte, fee *InboundFee) ([]byte, error) {
+	var records []extensionRecord
+	err := parseExtension(extraData, func(typ uint64, value []byte) (bool,
+		error) {
+
+		if typ != InboundFeeRecordType {
+			records = append(records, extensionRecord{
+				typ:   typ,
+				value: value,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if fee != nil {
+		var value [8]byte
+		binary.BigEndian.PutUint32(value[:4], uint32(fee.BaseFee))
+		binary.BigEndian.PutUint32(value[4:], uint32(fee.FeeRate))
+
+		records = append(records, extensionRecord{
+			typ:   InboundFeeRecordType,
+			value: value[:],
+		})
+		sort.Slice(records, func(i, j int) bool {
+			return records[i].typ < records[j].typ
+		})
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	var b bytes.Buffer
+	if err := writeExtension(&b, records); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
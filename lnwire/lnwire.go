@@ -424,6 +424,13 @@ func writeElements(w io.Writer, elements ...interface{}) error {
 	return nil
 }
 
+// WriteElements writes each element in the elements slice to the passed
+// io.Writer using the serialization format of lnwire. This allows other
+// packages to persist data using the same encoding as the wire protocol.
+func WriteElements(w io.Writer, elements ...interface{}) error {
+	return writeElements(w, elements...)
+}
+
 // readElement is a one-stop utility function to deserialize any datastructure
 // encoded using the serialization format of lnwire.
 func readElement(r io.Reader, element interface{}) error {
@@ -808,3 +815,9 @@ func readElements(r io.Reader, elements ...interface{}) error {
 	}
 	return nil
 }
+
+// ReadElements deserializes a variable number of elements from the passed
+// io.Reader, using the serialization format of lnwire.
+func ReadElements(r io.Reader, elements ...interface{}) error {
+	return readElements(r, elements...)
+}
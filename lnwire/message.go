@@ -26,7 +26,8 @@ type MessageType uint16
 // The currently defined message types within this current version of the
 // Lightning protocol.
 const (
-	MsgInit                    MessageType = 16
+	MsgStfu                    MessageType = 2
+	MsgInit                                = 16
 	MsgError                               = 17
 	MsgPing                                = 18
 	MsgPong                                = 19
@@ -37,6 +38,9 @@ const (
 	MsgFundingLocked                       = 36
 	MsgShutdown                            = 38
 	MsgClosingSigned                       = 39
+	MsgDynPropose                          = 111
+	MsgDynAck                              = 113
+	MsgDynReject                           = 115
 	MsgUpdateAddHTLC                       = 128
 	MsgUpdateFulfillHTLC                   = 130
 	MsgUpdateFailHTLC                      = 131
@@ -54,6 +58,7 @@ const (
 	MsgQueryChannelRange                   = 263
 	MsgReplyChannelRange                   = 264
 	MsgGossipTimestampRange                = 265
+	MsgOnionMessage                        = 513
 )
 
 // String return the string representation of message type.
@@ -115,7 +120,21 @@ func (t MessageType) String() string {
 		return "ReplyChannelRange"
 	case MsgGossipTimestampRange:
 		return "GossipTimestampRange"
+	case MsgOnionMessage:
+		return "OnionMessage"
+	case MsgStfu:
+		return "Stfu"
+	case MsgDynPropose:
+		return "DynPropose"
+	case MsgDynAck:
+		return "DynAck"
+	case MsgDynReject:
+		return "DynReject"
 	default:
+		if t >= CustomTypeStart {
+			return "Custom"
+		}
+
 		return "<unknown>"
 	}
 }
@@ -216,7 +235,24 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 		msg = &ReplyChannelRange{}
 	case MsgGossipTimestampRange:
 		msg = &GossipTimestampRange{}
+	case MsgOnionMessage:
+		msg = &OnionMessage{}
+	case MsgStfu:
+		msg = &Stfu{}
+	case MsgDynPropose:
+		msg = &DynPropose{}
+	case MsgDynAck:
+		msg = &DynAck{}
+	case MsgDynReject:
+		msg = &DynReject{}
 	default:
+		// Messages of the custom range are handed to the
+		// applications that subscribed to them.
+		if msgType >= CustomTypeStart {
+			msg = &Custom{Type: msgType}
+			break
+		}
+
 		return nil, &UnknownMessage{msgType}
 	}
 
@@ -271,7 +307,9 @@ func WriteMessage(w io.Writer, msg Message, pver uint32) (int, error) {
 }
 
 // ReadMessage reads, validates, and parses the next Lightning message from r
-// for the provided protocol version.
+// for the provided protocol version. As any data following the fixed fields
+// of an ExtensibleMessage is treated as its extension, r MUST contain exactly
+// one message.
 func ReadMessage(r io.Reader, pver uint32) (Message, error) {
 	// First, we'll read out the first two bytes of the message so we can
 	// create the proper empty message.
@@ -282,6 +320,90 @@ func ReadMessage(r io.Reader, pver uint32) (Message, error) {
 
 	msgType := MessageType(binary.BigEndian.Uint16(mType[:]))
 
+	msg, err := decodeMessage(r, msgType, pver)
+	if err != nil {
+		return nil, err
+	}
+	if err := readExtension(r, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// embeddedExtensionMarker is written in place of the message type by
+// WriteEmbeddedMessage when the message carries an extension. It's followed
+// by the length of the message, and then by the message itself, including its
+// extension. The value is never used as a message type on the wire.
+const embeddedExtensionMarker = 0xffff
+
+// WriteEmbeddedMessage writes a lightning Message to w in a form that can be
+// stored back to back with other messages within a larger stream, such as
+// within on-disk state. As the extension of a message isn't length delimited,
+// messages carrying one are prefixed with a marker and their length. All
+// other messages are written exactly as by WriteMessage, so that they remain
+// readable by older versions.
+func WriteEmbeddedMessage(w io.Writer, msg Message, pver uint32) (int, error) {
+	extMsg, ok := msg.(ExtensibleMessage)
+	if !ok || !extMsg.HasExtension() {
+		return WriteMessage(w, msg, pver)
+	}
+
+	var b bytes.Buffer
+	if _, err := WriteMessage(&b, msg, pver); err != nil {
+		return 0, err
+	}
+
+	var header [4]byte
+	binary.BigEndian.PutUint16(header[:2], embeddedExtensionMarker)
+	binary.BigEndian.PutUint16(header[2:], uint16(b.Len()))
+
+	totalBytes, err := w.Write(header[:])
+	if err != nil {
+		return totalBytes, err
+	}
+
+	n, err := w.Write(b.Bytes())
+	totalBytes += n
+
+	return totalBytes, err
+}
+
+// ReadEmbeddedMessage reads, validates, and parses the next Lightning message
+// from r, leaving any data following the message unread. It is the
+// counterpart of WriteEmbeddedMessage.
+func ReadEmbeddedMessage(r io.Reader, pver uint32) (Message, error) {
+	var mType [2]byte
+	if _, err := io.ReadFull(r, mType[:]); err != nil {
+		return nil, err
+	}
+
+	// If the message carries an extension, it's length prefixed, so we
+	// can read it in full, extension included.
+	if binary.BigEndian.Uint16(mType[:]) == embeddedExtensionMarker {
+		var msgLen [2]byte
+		if _, err := io.ReadFull(r, msgLen[:]); err != nil {
+			return nil, err
+		}
+
+		msgBytes := make([]byte, binary.BigEndian.Uint16(msgLen[:]))
+		if _, err := io.ReadFull(r, msgBytes); err != nil {
+			return nil, err
+		}
+
+		return ReadMessage(bytes.NewReader(msgBytes), pver)
+	}
+
+	msgType := MessageType(binary.BigEndian.Uint16(mType[:]))
+
+	return decodeMessage(r, msgType, pver)
+}
+
+// decodeMessage creates an empty message of the given type and decodes its
+// fixed fields from r.
+func decodeMessage(r io.Reader, msgType MessageType,
+	pver uint32) (Message, error) {
+
 	// Now that we know the target message type, we can create the proper
 	// empty message type and decode the message into it.
 	msg, err := makeEmptyMessage(msgType)
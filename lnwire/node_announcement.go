@@ -102,6 +102,45 @@ func UpdateNodeAnnAddrs(addrs []net.Addr) func(*NodeAnnouncement) {
 	}
 }
 
+// UpdateNodeAnnAlias is a functional option that allows updating the alias of
+// the given node announcement.
+func UpdateNodeAnnAlias(alias NodeAlias) func(*NodeAnnouncement) {
+	return func(nodeAnn *NodeAnnouncement) {
+		nodeAnn.Alias = alias
+	}
+}
+
+// UpdateNodeAnnColor is a functional option that allows updating the color of
+// the given node announcement.
+func UpdateNodeAnnColor(rgb color.RGBA) func(*NodeAnnouncement) {
+	return func(nodeAnn *NodeAnnouncement) {
+		nodeAnn.RGBColor = rgb
+	}
+}
+
+// UpdateNodeAnnFeatures is a functional option that allows setting and
+// unsetting feature bits of the given node announcement. As the feature vector
+// of the announcement may be shared, it's replaced by an updated copy rather
+// than modified in place.
+func UpdateNodeAnnFeatures(set, unset []FeatureBit) func(*NodeAnnouncement) {
+	return func(nodeAnn *NodeAnnouncement) {
+		features := NewRawFeatureVector()
+		if nodeAnn.Features != nil {
+			features = NewRawFeatureVector(
+				nodeAnn.Features.Features()...,
+			)
+		}
+		for _, bit := range set {
+			features.Set(bit)
+		}
+		for _, bit := range unset {
+			features.Unset(bit)
+		}
+
+		nodeAnn.Features = features
+	}
+}
+
 // A compile time check to ensure NodeAnnouncement implements the
 // lnwire.Message interface.
 var _ Message = (*NodeAnnouncement)(nil)
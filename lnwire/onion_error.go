@@ -78,6 +78,7 @@ const (
 	CodeFinalIncorrectCltvExpiry      FailCode = 18
 	CodeFinalIncorrectHtlcAmount      FailCode = 19
 	CodeExpiryTooFar                  FailCode = 21
+	CodeMPPTimeout                    FailCode = 23
 )
 
 // String returns the string representation of the failure code.
@@ -149,6 +150,9 @@ func (c FailCode) String() string {
 	case CodeExpiryTooFar:
 		return "ExpiryTooFar"
 
+	case CodeMPPTimeout:
+		return "MPPTimeout"
+
 	default:
 		return "<unknown>"
 	}
@@ -1062,6 +1066,26 @@ func (f FailExpiryTooFar) Error() string {
 	return f.Code().String()
 }
 
+// FailMPPTimeout is returned by the final node if the complete amount of a
+// multi-part payment wasn't received within a reasonable time.
+//
+// NOTE: May only be returned by the final node in the path.
+type FailMPPTimeout struct{}
+
+// Code returns the failure unique code.
+//
+// NOTE: Part of the FailureMessage interface.
+func (f FailMPPTimeout) Code() FailCode {
+	return CodeMPPTimeout
+}
+
+// Returns a human readable string describing the target FailureMessage.
+//
+// NOTE: Implements the error interface.
+func (f FailMPPTimeout) Error() string {
+	return f.Code().String()
+}
+
 // DecodeFailure decodes, validates, and parses the lnwire onion failure, for
 // the provided protocol version.
 func DecodeFailure(r io.Reader, pver uint32) (FailureMessage, error) {
@@ -1227,6 +1251,9 @@ func makeEmptyOnionError(code FailCode) (FailureMessage, error) {
 	case CodeExpiryTooFar:
 		return &FailExpiryTooFar{}, nil
 
+	case CodeMPPTimeout:
+		return &FailMPPTimeout{}, nil
+
 	default:
 		return nil, errors.Errorf("unknown error code: %v", code)
 	}
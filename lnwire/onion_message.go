@@ -0,0 +1,90 @@
+package lnwire
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// OnionMessage carries an onion encrypted payload between nodes without the
+// use of HTLCs. Each hop peels a layer of the onion using the blinding point
+// to derive its blinded node key, and either forwards the inner onion to the
+// next node or processes the final payload.
+type OnionMessage struct {
+	// BlindingPoint is the ephemeral key used by the receiving node to
+	// decrypt the route blinding data for its hop.
+	BlindingPoint *btcec.PublicKey
+
+	// OnionBlob is the serialized onion packet for the receiving node.
+	OnionBlob []byte
+}
+
+// NewOnionMessage creates a new OnionMessage message.
+func NewOnionMessage(blindingPoint *btcec.PublicKey,
+	onionBlob []byte) *OnionMessage {
+
+	return &OnionMessage{
+		BlindingPoint: blindingPoint,
+		OnionBlob:     onionBlob,
+	}
+}
+
+// A compile time check to ensure OnionMessage implements the lnwire.Message
+// interface.
+var _ Message = (*OnionMessage)(nil)
+
+// Decode deserializes a serialized OnionMessage message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) Decode(r io.Reader, pver uint32) error {
+	if err := readElement(r, &o.BlindingPoint); err != nil {
+		return err
+	}
+
+	var l [2]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return err
+	}
+	blobLen := binary.BigEndian.Uint16(l[:])
+
+	o.OnionBlob = make([]byte, blobLen)
+	_, err := io.ReadFull(r, o.OnionBlob)
+	return err
+}
+
+// Encode serializes the target OnionMessage into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) Encode(w io.Writer, pver uint32) error {
+	if err := writeElement(w, o.BlindingPoint); err != nil {
+		return err
+	}
+
+	var l [2]byte
+	binary.BigEndian.PutUint16(l[:], uint16(len(o.OnionBlob)))
+	if _, err := w.Write(l[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(o.OnionBlob)
+	return err
+}
+
+// MsgType returns the integer uniquely identifying an OnionMessage message
+// on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) MsgType() MessageType {
+	return MsgOnionMessage
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for an
+// OnionMessage complete message observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
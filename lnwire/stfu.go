@@ -0,0 +1,95 @@
+package lnwire
+
+import (
+	"fmt"
+	"io"
+)
+
+// Stfu is sent by either party of a channel to signal that it wishes to
+// enter quiescence. Once both parties have sent and received an Stfu message,
+// neither may propose any further updates to the channel until quiescence is
+// terminated, which allows protocols such as channel upgrades or splicing to
+// operate on a clean channel state.
+type Stfu struct {
+	// ChanID is the unique identifier of the channel that is to be
+	// quiesced.
+	ChanID ChannelID
+
+	// Initiator is true if the sender is the party requesting quiescence,
+	// and false if it's responding to an Stfu sent by its peer.
+	Initiator bool
+}
+
+// NewStfu creates a new Stfu message for the target channel.
+func NewStfu(chanID ChannelID, initiator bool) *Stfu {
+	return &Stfu{
+		ChanID:    chanID,
+		Initiator: initiator,
+	}
+}
+
+// A compile time check to ensure Stfu implements the lnwire.Message
+// interface.
+var _ Message = (*Stfu)(nil)
+
+// Decode deserializes the serialized Stfu message stored in the passed
+// io.Reader into the target Stfu using the deserialization rules defined by
+// the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) Decode(r io.Reader, pver uint32) error {
+	var initiator uint8
+	if err := readElements(r, &s.ChanID, &initiator); err != nil {
+		return err
+	}
+
+	switch initiator {
+	case 0:
+		s.Initiator = false
+	case 1:
+		s.Initiator = true
+	default:
+		return fmt.Errorf("invalid stfu initiator value: %v", initiator)
+	}
+
+	return nil
+}
+
+// Encode serializes the target Stfu message into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) Encode(w io.Writer, pver uint32) error {
+	var initiator uint8
+	if s.Initiator {
+		initiator = 1
+	}
+
+	return writeElements(w, s.ChanID, initiator)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as
+// an Stfu message on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) MsgType() MessageType {
+	return MsgStfu
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for an Stfu
+// message.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) MaxPayloadLength(uint32) uint32 {
+	var length uint32
+
+	// ChanID - 32 bytes
+	length += 32
+
+	// Initiator - 1 byte
+	length++
+
+	// 33 bytes
+	return length
+}
@@ -1,6 +1,10 @@
 package lnwire
 
-import "io"
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
 
 // OnionPacketSize is the size of the serialized Sphinx onion packet included
 // in each UpdateAddHTLC message. The breakdown of the onion packet is as
@@ -8,6 +12,10 @@ import "io"
 // of per-hop data, and a 32-byte HMAC over the entire packet.
 const OnionPacketSize = 1366
 
+// endorsedRecordType is the extension record type which carries the
+// endorsement signal of an HTLC.
+const endorsedRecordType = 106
+
 // UpdateAddHTLC is the message sent by Alice to Bob when she wishes to add an
 // HTLC to his remote commitment transaction. In addition to information
 // detailing the value, the ID, expiry, and the onion blob is also included
@@ -50,6 +58,12 @@ type UpdateAddHTLC struct {
 	// should strip off a layer of encryption, exposing the next hop to be
 	// used in the subsequent UpdateAddHTLC message.
 	OnionBlob [OnionPacketSize]byte
+
+	// Endorsed signals that the sender vouches for this HTLC, meaning it
+	// expects it to resolve quickly and may use the resources reserved
+	// for endorsed HTLCs along the route. It's carried within the
+	// message's extension.
+	Endorsed bool
 }
 
 // NewUpdateAddHTLC returns a new empty UpdateAddHTLC message.
@@ -57,9 +71,9 @@ func NewUpdateAddHTLC() *UpdateAddHTLC {
 	return &UpdateAddHTLC{}
 }
 
-// A compile time check to ensure UpdateAddHTLC implements the lnwire.Message
-// interface.
-var _ Message = (*UpdateAddHTLC)(nil)
+// A compile time check to ensure UpdateAddHTLC implements the
+// lnwire.ExtensibleMessage interface.
+var _ ExtensibleMessage = (*UpdateAddHTLC)(nil)
 
 // Decode deserializes a serialized UpdateAddHTLC message stored in the passed
 // io.Reader observing the specified protocol version.
@@ -81,7 +95,7 @@ func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *UpdateAddHTLC) Encode(w io.Writer, pver uint32) error {
-	return writeElements(w,
+	err := writeElements(w,
 		c.ChanID,
 		c.ID,
 		c.Amount,
@@ -89,6 +103,46 @@ func (c *UpdateAddHTLC) Encode(w io.Writer, pver uint32) error {
 		c.Expiry,
 		c.OnionBlob[:],
 	)
+	if err != nil {
+		return err
+	}
+
+	if !c.Endorsed {
+		return nil
+	}
+
+	return writeExtension(w, []extensionRecord{
+		{typ: endorsedRecordType, value: []byte{1}},
+	})
+}
+
+// DecodeExtension parses the extension data that followed the fixed fields of
+// the message.
+//
+// This is part of the lnwire.ExtensibleMessage interface.
+func (c *UpdateAddHTLC) DecodeExtension(ext []byte) error {
+	return parseExtension(ext, func(typ uint64, value []byte) (bool, error) {
+		switch typ {
+		case endorsedRecordType:
+			if len(value) != 1 {
+				return false, fmt.Errorf("invalid endorsed "+
+					"record length %d", len(value))
+			}
+			c.Endorsed = !bytes.Equal(value, []byte{0})
+
+			return true, nil
+
+		default:
+			return false, nil
+		}
+	})
+}
+
+// HasExtension returns true if the message carries any extension data.
+//
+// This is part of the lnwire.ExtensibleMessage interface.
+func (c *UpdateAddHTLC) HasExtension() bool {
+	return c.Endorsed
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -104,6 +158,6 @@ func (c *UpdateAddHTLC) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (c *UpdateAddHTLC) MaxPayloadLength(uint32) uint32 {
-	// 1450
-	return 32 + 8 + 4 + 8 + 32 + 1366
+	// 1453, including the endorsed extension record.
+	return 32 + 8 + 4 + 8 + 32 + 1366 + 3
 }
@@ -15,14 +15,23 @@ import (
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/chanfitness"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/feepolicy"
 	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/liquidity"
 	"github.com/lightningnetwork/lnd/lnrpc/autopilotrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/offers"
+	"github.com/lightningnetwork/lnd/onionmsg"
+	"github.com/lightningnetwork/lnd/paystream"
+	"github.com/lightningnetwork/lnd/peernotifier"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/sweep"
@@ -71,6 +80,15 @@ var (
 	sgnrLog = build.NewSubLogger("SGNR", backendLog.Logger)
 	wlktLog = build.NewSubLogger("WLKT", backendLog.Logger)
 	arpcLog = build.NewSubLogger("ARPC", backendLog.Logger)
+	ofrsLog = build.NewSubLogger("OFRS", backendLog.Logger)
+	onmsLog = build.NewSubLogger("ONMS", backendLog.Logger)
+	chftLog = build.NewSubLogger("CHFT", backendLog.Logger)
+	feepLog = build.NewSubLogger("FEEP", backendLog.Logger)
+	lqmgLog = build.NewSubLogger("LQMG", backendLog.Logger)
+	pstrLog = build.NewSubLogger("PSTR", backendLog.Logger)
+	chnfLog = build.NewSubLogger("CHNF", backendLog.Logger)
+	prnfLog = build.NewSubLogger("PRNF", backendLog.Logger)
+	chbuLog = build.NewSubLogger("CHBU", backendLog.Logger)
 )
 
 // Initialize package-global logger variables.
@@ -91,6 +109,15 @@ func init() {
 	signrpc.UseLogger(sgnrLog)
 	walletrpc.UseLogger(wlktLog)
 	autopilotrpc.UseLogger(arpcLog)
+	offers.UseLogger(ofrsLog)
+	onionmsg.UseLogger(onmsLog)
+	chanfitness.UseLogger(chftLog)
+	feepolicy.UseLogger(feepLog)
+	liquidity.UseLogger(lqmgLog)
+	paystream.UseLogger(pstrLog)
+	channelnotifier.UseLogger(chnfLog)
+	peernotifier.UseLogger(prnfLog)
+	chanbackup.UseLogger(chbuLog)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
@@ -117,6 +144,15 @@ var subsystemLoggers = map[string]btclog.Logger{
 	"SGNR": sgnrLog,
 	"WLKT": wlktLog,
 	"ARPC": arpcLog,
+	"OFRS": ofrsLog,
+	"ONMS": onmsLog,
+	"CHFT": chftLog,
+	"FEEP": feepLog,
+	"LQMG": lqmgLog,
+	"PSTR": pstrLog,
+	"CHNF": chnfLog,
+	"PRNF": prnfLog,
+	"CHBU": chbuLog,
 }
 
 // initLogRotator initializes the logging rotator to write logs to logFile and
@@ -1,8 +1,10 @@
 package macaroons
 
 import (
+	"bytes"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/peer"
@@ -13,6 +15,25 @@ import (
 	"golang.org/x/net/context"
 )
 
+const (
+	// CondLndCustom is the first party caveat condition name that is used
+	// for all custom caveats in lnd. Every custom caveat entry is encoded
+	// as the string "lnd-custom <custom-caveat-name> <condition>" in the
+	// serialized macaroon.
+	CondLndCustom = "lnd-custom"
+)
+
+// CustomCaveatAcceptor is an interface that contains a single method for
+// checking whether a macaroon with the given custom caveat name should be
+// accepted or not.
+type CustomCaveatAcceptor interface {
+	// CustomCaveatSupported returns nil if a macaroon with the given
+	// custom caveat name can be validated by any component in lnd (for
+	// example an RPC middleware). If no component is registered to handle
+	// the given custom caveat, an error is returned.
+	CustomCaveatSupported(customCaveatName string) error
+}
+
 // Constraint type adds a layer of indirection over macaroon caveats.
 type Constraint func(*macaroon.Macaroon) error
 
@@ -88,3 +109,67 @@ func IPLockChecker() (string, checkers.Func) {
 		return nil
 	}
 }
+
+// CustomConstraint returns a function that adds a custom caveat condition to
+// a macaroon. The caveat name must not be empty and must not contain any
+// spaces, as the first space separates the name from the condition.
+func CustomConstraint(name, condition string) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if name == "" {
+			return fmt.Errorf("custom caveat name cannot be empty")
+		}
+		if strings.Contains(name, " ") {
+			return fmt.Errorf("custom caveat name cannot contain " +
+				"spaces")
+		}
+
+		caveat := checkers.Condition(
+			CondLndCustom, fmt.Sprintf("%s %s", name, condition),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// CustomChecker returns a Checker function that is used by the macaroon
+// bakery to check whether a custom caveat is supported by lnd at all. The
+// condition of the caveat itself is not checked here, that is the job of the
+// component that registered for the custom caveat name.
+func CustomChecker(acceptor CustomCaveatAcceptor) Checker {
+	return func() (string, checkers.Func) {
+		return CondLndCustom, func(ctx context.Context, _,
+			arg string) error {
+
+			// The argument is the custom caveat name, followed by
+			// the condition, separated by a space.
+			name := strings.SplitN(arg, " ", 2)[0]
+			if name == "" {
+				return fmt.Errorf("invalid custom caveat: %s",
+					arg)
+			}
+
+			return acceptor.CustomCaveatSupported(name)
+		}
+	}
+}
+
+// GetCustomCaveatCondition returns the condition of the custom caveat with
+// the given name from the macaroon. The second return value is false if the
+// macaroon doesn't carry a custom caveat with that name.
+func GetCustomCaveatCondition(mac *macaroon.Macaroon,
+	customCaveatName string) (string, bool) {
+
+	if mac == nil || customCaveatName == "" {
+		return "", false
+	}
+
+	caveatPrefix := []byte(fmt.Sprintf(
+		"%s %s ", CondLndCustom, customCaveatName,
+	))
+	for _, caveat := range mac.Caveats() {
+		if bytes.HasPrefix(caveat.Id, caveatPrefix) {
+			return string(caveat.Id[len(caveatPrefix):]), true
+		}
+	}
+
+	return "", false
+}
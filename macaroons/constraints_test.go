@@ -106,3 +106,40 @@ func TestIPLockBadIP(t *testing.T) {
 		t.Fatalf("IPLockConstraint with bad IP should fail.")
 	}
 }
+
+// TestCustomConstraint tests that a custom caveat is added to a macaroon and
+// that its condition can be extracted again by name.
+func TestCustomConstraint(t *testing.T) {
+	constraintFunc := macaroons.CustomConstraint("unit-test", "test-value")
+	testMacaroon := createDummyMacaroon(t)
+	err := constraintFunc(testMacaroon)
+	if err != nil {
+		t.Fatalf("Error applying custom constraint: %v", err)
+	}
+
+	expectedCaveat := "lnd-custom unit-test test-value"
+	if string(testMacaroon.Caveats()[0].Id) != expectedCaveat {
+		t.Fatalf("Added caveat '%s' does not meet the expectations!",
+			testMacaroon.Caveats()[0].Id)
+	}
+
+	// The condition should be found under the caveat name we used, but
+	// not under any other name.
+	condition, ok := macaroons.GetCustomCaveatCondition(
+		testMacaroon, "unit-test",
+	)
+	if !ok || condition != "test-value" {
+		t.Fatalf("Expected condition 'test-value', got '%s' (ok=%v)",
+			condition, ok)
+	}
+	_, ok = macaroons.GetCustomCaveatCondition(testMacaroon, "unit")
+	if ok {
+		t.Fatalf("Found condition for unknown custom caveat name")
+	}
+
+	// Caveat names with spaces can't be parsed, so they must be rejected.
+	constraintFunc = macaroons.CustomConstraint("unit test", "test-value")
+	if err := constraintFunc(createDummyMacaroon(t)); err == nil {
+		t.Fatalf("CustomConstraint with space in name should fail.")
+	}
+}
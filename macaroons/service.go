@@ -168,14 +168,24 @@ func (svc *Service) ValidateMacaroon(ctx context.Context,
 	}
 
 	// With the macaroon obtained, we'll now decode the hex-string
-	// encoding, then unmarshal it from binary into its concrete struct
-	// representation.
+	// encoding, and check it against the required permissions.
 	macBytes, err := hex.DecodeString(md["macaroon"][0])
 	if err != nil {
 		return err
 	}
+
+	return svc.CheckMacAuth(ctx, macBytes, requiredPermissions)
+}
+
+// CheckMacAuth checks that the binary serialized macaroon is valid, and that
+// it grants all of the required permissions.
+func (svc *Service) CheckMacAuth(ctx context.Context, macBytes []byte,
+	requiredPermissions []bakery.Op) error {
+
+	// Unmarshal the macaroon from binary into its concrete struct
+	// representation.
 	mac := &macaroon.Macaroon{}
-	err = mac.UnmarshalBinary(macBytes)
+	err := mac.UnmarshalBinary(macBytes)
 	if err != nil {
 		return err
 	}
@@ -198,3 +208,37 @@ func (svc *Service) Close() error {
 func (svc *Service) CreateUnlock(password *[]byte) error {
 	return svc.rks.CreateUnlock(password)
 }
+
+// NewMacaroon wraps around the function Oven.NewMacaroon, always using
+// bakery.LatestVersion and no caveats. In addition, it takes a rootKeyID
+// parameter and puts it into the context that is passed through
+// Oven.NewMacaroon, whose call to RootKey reads the root key ID back from it.
+func (svc *Service) NewMacaroon(ctx context.Context, rootKeyID []byte,
+	ops ...bakery.Op) (*bakery.Macaroon, error) {
+
+	// Check rootKeyID is not called with nil or empty bytes. We want the
+	// caller to be aware the value of root key ID used, so we won't
+	// replace it with the DefaultRootKeyID if not specified.
+	if len(rootKeyID) == 0 {
+		return nil, ErrMissingRootKeyID
+	}
+
+	// Pass the root key ID to context.
+	ctx = ContextWithRootKeyID(ctx, rootKeyID)
+
+	return svc.Oven.NewMacaroon(ctx, bakery.LatestVersion, nil, ops...)
+}
+
+// ListMacaroonIDs returns all the root key ID values except the value of
+// encryptedKeyID.
+func (svc *Service) ListMacaroonIDs(ctx context.Context) ([][]byte, error) {
+	return svc.rks.ListMacaroonIDs(ctx)
+}
+
+// DeleteMacaroonID removes one specific root key ID. If the root key ID is
+// found and deleted, it will be returned.
+func (svc *Service) DeleteMacaroonID(ctx context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	return svc.rks.DeleteMacaroonID(ctx, rootKeyID)
+}
@@ -133,4 +133,124 @@ func TestValidateMacaroon(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Error validating the macaroon: %v", err)
 	}
+
+	// The raw macaroon should also be accepted for the permissions it
+	// grants, but not for any others.
+	err = service.CheckMacAuth(
+		mockContext, macaroonBinary, []bakery.Op{testOperation},
+	)
+	if err != nil {
+		t.Fatalf("Error checking the macaroon: %v", err)
+	}
+	err = service.CheckMacAuth(
+		mockContext, macaroonBinary, []bakery.Op{{
+			Entity: "testEntity",
+			Action: "write",
+		}},
+	)
+	if err == nil {
+		t.Fatalf("Macaroon shouldn't grant write permission")
+	}
+}
+
+// TestDeleteMacaroonID tests that a macaroon baked with a custom root key ID
+// is no longer valid once that root key ID has been deleted, while macaroons
+// baked with the default root key ID are unaffected.
+func TestDeleteMacaroonID(t *testing.T) {
+	// First, initialize the service and unlock it.
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(tempDir, macaroons.IPLockChecker)
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	defer service.Close()
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	// Baking a macaroon without a root key ID isn't allowed.
+	_, err = service.NewMacaroon(context.Background(), nil, testOperation)
+	if err != macaroons.ErrMissingRootKeyID {
+		t.Fatalf("Received %v instead of ErrMissingRootKeyID", err)
+	}
+
+	// newMacContext bakes a macaroon with the given root key ID and
+	// returns an incoming context carrying it.
+	newMacContext := func(rootKeyID []byte) context.Context {
+		mac, err := service.NewMacaroon(
+			context.Background(), rootKeyID, testOperation,
+		)
+		if err != nil {
+			t.Fatalf("Error creating macaroon from service: %v", err)
+		}
+		macBinary, err := mac.M().MarshalBinary()
+		if err != nil {
+			t.Fatalf("Error serializing macaroon: %v", err)
+		}
+
+		md := metadata.New(map[string]string{
+			"macaroon": hex.EncodeToString(macBinary),
+		})
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	defaultCtx := newMacContext(macaroons.DefaultRootKeyID)
+	customCtx := newMacContext([]byte("1"))
+
+	// Both macaroons should be valid, and both root key IDs should be
+	// listed.
+	ops := []bakery.Op{testOperation}
+	if err := service.ValidateMacaroon(defaultCtx, ops); err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+	if err := service.ValidateMacaroon(customCtx, ops); err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+	ids, err := service.ListMacaroonIDs(context.Background())
+	if err != nil {
+		t.Fatalf("Error listing root key IDs: %v", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("Expected 2 root key IDs, got %d", len(ids))
+	}
+
+	// Deleting the default root key ID is forbidden.
+	_, err = service.DeleteMacaroonID(
+		context.Background(), macaroons.DefaultRootKeyID,
+	)
+	if err != macaroons.ErrDeletionForbidden {
+		t.Fatalf("Received %v instead of ErrDeletionForbidden", err)
+	}
+
+	// Now delete the custom root key ID, which should revoke the macaroon
+	// baked with it.
+	deleted, err := service.DeleteMacaroonID(
+		context.Background(), []byte("1"),
+	)
+	if err != nil {
+		t.Fatalf("Error deleting root key ID: %v", err)
+	}
+	if string(deleted) != "1" {
+		t.Fatalf("Expected root key ID 1 to be deleted, got %s",
+			deleted)
+	}
+	if err := service.ValidateMacaroon(customCtx, ops); err == nil {
+		t.Fatalf("Expected macaroon of deleted root key to be invalid")
+	}
+	if err := service.ValidateMacaroon(defaultCtx, ops); err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+
+	// Deleting an unknown root key ID is a no-op.
+	deleted, err = service.DeleteMacaroonID(
+		context.Background(), []byte("1"),
+	)
+	if err != nil {
+		t.Fatalf("Error deleting root key ID: %v", err)
+	}
+	if deleted != nil {
+		t.Fatalf("Expected nothing to be deleted, got %s", deleted)
+	}
 }
@@ -1,6 +1,7 @@
 package macaroons
 
 import (
+	"bytes"
 	"crypto/rand"
 	"fmt"
 	"io"
@@ -21,11 +22,9 @@ var (
 	// rootKeyBucketName is the name of the root key store bucket.
 	rootKeyBucketName = []byte("macrootkeys")
 
-	// defaultRootKeyID is the ID of the default root key. The first is
+	// DefaultRootKeyID is the ID of the default root key. The first is
 	// just 0, to emulate the memory storage that comes with bakery.
-	//
-	// TODO(aakselrod): Add support for key rotation.
-	defaultRootKeyID = []byte("0")
+	DefaultRootKeyID = []byte("0")
 
 	// encryptedKeyID is the name of the database key that stores the
 	// encryption key, encrypted with a salted + hashed password. The
@@ -42,6 +41,17 @@ var (
 
 	// ErrPasswordRequired specifies that a nil password has been passed.
 	ErrPasswordRequired = fmt.Errorf("a non-nil password is required")
+
+	// ErrMissingRootKeyID specifies that the root key ID is missing.
+	ErrMissingRootKeyID = fmt.Errorf("missing root key ID")
+
+	// ErrDeletionForbidden is used when attempting to delete the
+	// DefaultRootKeyID or the encryptedKeyID.
+	ErrDeletionForbidden = fmt.Errorf("the specified ID cannot be deleted")
+
+	// ErrKeyValueForbidden is used when the root key ID uses the same
+	// value as the encryptedKeyID.
+	ErrKeyValueForbidden = fmt.Errorf("root key ID value is not allowed")
 )
 
 // RootKeyStorage implements the bakery.RootKeyStorage interface.
@@ -148,15 +158,33 @@ func (r *RootKeyStorage) Get(_ context.Context, id []byte) ([]byte, error) {
 }
 
 // RootKey implements the RootKey method for the bakery.RootKeyStorage
-// interface.
-// TODO(aakselrod): Add support for key rotation.
-func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
+// interface. The ID of the root key to use is read from the passed context,
+// see ContextWithRootKeyID. If the context doesn't carry a root key ID, the
+// DefaultRootKeyID is used. A new root key is created and stored if none
+// exists yet for the ID, which allows callers to rotate to a fresh root key
+// by simply using a new ID.
+func (r *RootKeyStorage) RootKey(ctx context.Context) ([]byte, []byte, error) {
 	if r.encKey == nil {
 		return nil, nil, ErrStoreLocked
 	}
+
+	id, err := RootKeyIDFromContext(ctx)
+	switch {
+	case err == ErrContextRootKeyID:
+		id = DefaultRootKeyID
+
+	case err != nil:
+		return nil, nil, err
+	}
+
+	// The ID of the encryption key shares the bucket with the root keys,
+	// so it can't be used as a root key ID.
+	if bytes.Equal(id, encryptedKeyID) {
+		return nil, nil, ErrKeyValueForbidden
+	}
+
 	var rootKey []byte
-	id := defaultRootKeyID
-	err := r.Update(func(tx *bbolt.Tx) error {
+	err = r.Update(func(tx *bbolt.Tx) error {
 		ns := tx.Bucket(rootKeyBucketName)
 		dbKey := ns.Get(id)
 
@@ -193,6 +221,83 @@ func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
 	return rootKey, id, nil
 }
 
+// ListMacaroonIDs returns all the root key ID values except the value of
+// encryptedKeyID.
+func (r *RootKeyStorage) ListMacaroonIDs(_ context.Context) ([][]byte, error) {
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	var rootKeySlice [][]byte
+	err := r.View(func(tx *bbolt.Tx) error {
+		// Read all the items in the bucket and collect the keys, which
+		// are the root key IDs we want.
+		return tx.Bucket(rootKeyBucketName).ForEach(func(k, _ []byte) error {
+			if bytes.Equal(k, encryptedKeyID) {
+				return nil
+			}
+
+			// The key slice is only valid for the life of the
+			// transaction, so we'll need to copy it.
+			id := make([]byte, len(k))
+			copy(id, k)
+			rootKeySlice = append(rootKeySlice, id)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rootKeySlice, nil
+}
+
+// DeleteMacaroonID removes one specific root key ID. If the root key ID is
+// found and deleted, it will be returned. All macaroons that were baked with
+// the root key will no longer be valid once it has been deleted.
+func (r *RootKeyStorage) DeleteMacaroonID(_ context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	// Check the rootKeyID is not empty.
+	if len(rootKeyID) == 0 {
+		return nil, ErrMissingRootKeyID
+	}
+
+	// Deleting encryptedKeyID or DefaultRootKeyID is not allowed.
+	if bytes.Equal(rootKeyID, encryptedKeyID) ||
+		bytes.Equal(rootKeyID, DefaultRootKeyID) {
+
+		return nil, ErrDeletionForbidden
+	}
+
+	var rootKeyIDDeleted []byte
+	err := r.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+
+		// If the key can't be found, there's nothing to delete.
+		if bucket.Get(rootKeyID) == nil {
+			return nil
+		}
+
+		if err := bucket.Delete(rootKeyID); err != nil {
+			return err
+		}
+		rootKeyIDDeleted = rootKeyID
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rootKeyIDDeleted, nil
+}
+
 // Close closes the underlying database and zeroes the encryption key stored
 // in memory.
 func (r *RootKeyStorage) Close() error {
@@ -214,6 +214,9 @@ func (*mockWalletController) FetchInputInfo(
 func (*mockWalletController) ConfirmedBalance(confs int32) (btcutil.Amount, error) {
 	return 0, nil
 }
+func (*mockWalletController) LockedBalance() (btcutil.Amount, error) {
+	return 0, nil
+}
 
 // NewAddress is called to get new addresses for delivery, change etc.
 func (m *mockWalletController) NewAddress(addrType lnwallet.AddressType,
@@ -226,8 +229,14 @@ func (*mockWalletController) IsOurAddress(a btcutil.Address) bool {
 	return false
 }
 
+func (*mockWalletController) ListAddresses() ([]*lnwallet.AddressProperty,
+	error) {
+
+	return nil, nil
+}
+
 func (*mockWalletController) SendOutputs(outputs []*wire.TxOut,
-	_ lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+	_ lnwallet.SatPerKWeight, _ int32) (*wire.MsgTx, error) {
 
 	return nil, nil
 }
@@ -265,6 +274,9 @@ func (*mockWalletController) SubscribeTransactions() (lnwallet.TransactionSubscr
 func (*mockWalletController) IsSynced() (bool, int64, error) {
 	return true, int64(0), nil
 }
+func (*mockWalletController) GetRecoveryInfo() (bool, float64, error) {
+	return false, 0, nil
+}
 func (*mockWalletController) Start() error {
 	return nil
 }
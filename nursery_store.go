@@ -140,6 +140,12 @@ type NurseryStore interface {
 	// result in a different txid from a preceding broadcast.
 	FinalizeKinder(height uint32, tx *wire.MsgTx) error
 
+	// ReplaceFinalizedKinder replaces the kindergarten sweep txn
+	// previously finalized at the given height, without modifying the
+	// last finalized height. This should only be used once the
+	// replacement has been broadcast in place of the original txn.
+	ReplaceFinalizedKinder(height uint32, tx *wire.MsgTx) error
+
 	// LastFinalizedHeight returns the last block height for which the
 	// nursery store finalized a kindergarten class.
 	LastFinalizedHeight() (uint32, error)
@@ -611,6 +617,24 @@ func (ns *nurseryStore) FinalizeKinder(height uint32,
 	})
 }
 
+// ReplaceFinalizedKinder replaces the kindergarten sweep txn previously
+// finalized at the given height, leaving the last finalized height untouched.
+func (ns *nurseryStore) ReplaceFinalizedKinder(height uint32,
+	finalTx *wire.MsgTx) error {
+
+	return ns.db.Update(func(tx *bbolt.Tx) error {
+		hghtBucket := ns.getHeightBucket(tx, height)
+		if hghtBucket == nil ||
+			hghtBucket.Get(finalizedKndrTxnKey) == nil {
+
+			return fmt.Errorf("no kindergarten txn finalized at "+
+				"height=%d", height)
+		}
+
+		return ns.putFinalizedTxn(hghtBucket, finalTx)
+	})
+}
+
 // GraduateHeight persists the provided height as the nursery store's last
 // graduated height.
 func (ns *nurseryStore) GraduateHeight(height uint32) error {
@@ -1399,6 +1423,14 @@ func (ns *nurseryStore) finalizeKinder(tx *bbolt.Tx, height uint32,
 		return nil
 	}
 
+	return ns.putFinalizedTxn(hghtBucket, finalTx)
+}
+
+// putFinalizedTxn writes the serialized kindergarten sweep txn to the given
+// height bucket.
+func (ns *nurseryStore) putFinalizedTxn(hghtBucket *bbolt.Bucket,
+	finalTx *wire.MsgTx) error {
+
 	var finalTxnBuf bytes.Buffer
 	if err := finalTx.Serialize(&finalTxnBuf); err != nil {
 		return err
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/signal"
+)
+
+// observerMethods is the set of RPC methods served in observer mode. These
+// are the read-only methods that can be answered from the channel database
+// alone, without any of the subsystems of a running node.
+var observerMethods = map[string]struct{}{
+	"/lnrpc.Lightning/ClosedChannels":    {},
+	"/lnrpc.Lightning/ListInvoices":      {},
+	"/lnrpc.Lightning/ListPayments":      {},
+	"/lnrpc.Lightning/DescribeGraph":     {},
+	"/lnrpc.Lightning/GetChanInfo":       {},
+	"/lnrpc.Lightning/GetNodeInfo":       {},
+	"/lnrpc.Lightning/GetNetworkInfo":    {},
+	"/lnrpc.Lightning/ForwardingHistory": {},
+}
+
+// errObserverMode is returned for any RPC that isn't served in observer mode.
+func errObserverMode(method string) error {
+	return fmt.Errorf("%s: method not available in observer mode", method)
+}
+
+// observerUnaryInterceptor rejects any unary RPC that isn't served in
+// observer mode, before handing the request to the macaroon interceptor, if
+// any.
+func observerUnaryInterceptor(
+	mac grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if _, ok := observerMethods[info.FullMethod]; !ok {
+			return nil, errObserverMode(info.FullMethod)
+		}
+
+		if mac != nil {
+			return mac(ctx, req, info, handler)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// observerStreamInterceptor rejects any streaming RPC that isn't served in
+// observer mode, before handing the request to the macaroon interceptor, if
+// any.
+func observerStreamInterceptor(
+	mac grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if _, ok := observerMethods[info.FullMethod]; !ok {
+			return errObserverMode(info.FullMethod)
+		}
+
+		if mac != nil {
+			return mac(srv, ss, info, handler)
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// newObserverRPCServer creates an RPC server that only serves the methods
+// within observerMethods, answering them from the passed read-only channel
+// database. No sub-servers are registered.
+func newObserverRPCServer(chanDB *channeldb.DB,
+	macService *macaroons.Service, serverOpts []grpc.ServerOption,
+	restServerOpts []grpc.DialOption, tlsCfg *tls.Config) *rpcServer {
+
+	var (
+		macUnary  grpc.UnaryServerInterceptor
+		macStream grpc.StreamServerInterceptor
+	)
+	if macService != nil {
+		macUnary = macService.UnaryServerInterceptor(permissions)
+		macStream = macService.StreamServerInterceptor(permissions)
+	}
+
+	serverOpts = append(serverOpts,
+		grpc.UnaryInterceptor(observerUnaryInterceptor(macUnary)),
+		grpc.StreamInterceptor(observerStreamInterceptor(macStream)),
+	)
+
+	// The methods we serve only ever access the channel database of the
+	// server, so that's the only dependency we populate.
+	grpcServer := grpc.NewServer(serverOpts...)
+	rootRPCServer := &rpcServer{
+		restServerOpts: restServerOpts,
+		tlsCfg:         tlsCfg,
+		grpcServer:     grpcServer,
+		server: &server{
+			chanDB: chanDB,
+		},
+		quit: make(chan struct{}, 1),
+	}
+	lnrpc.RegisterLightningServer(grpcServer, rootRPCServer)
+
+	return rootRPCServer
+}
+
+// runObserver serves the observer RPC server until lnd is shut down.
+func runObserver(chanDB *channeldb.DB, macService *macaroons.Service,
+	serverOpts []grpc.ServerOption, restServerOpts []grpc.DialOption,
+	tlsCfg *tls.Config) error {
+
+	ltndLog.Infof("Running in observer mode, channel database at %v is "+
+		"opened read-only", chanDB.Path())
+
+	rpcServer := newObserverRPCServer(
+		chanDB, macService, serverOpts, restServerOpts, tlsCfg,
+	)
+	if err := rpcServer.Start(); err != nil {
+		return err
+	}
+	defer rpcServer.Stop()
+
+	<-signal.ShutdownChannel()
+	return nil
+}
@@ -0,0 +1,150 @@
+package offers
+
+import (
+	"fmt"
+	"strings"
+)
+
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// NOTE: BOLT-12 strings use the bech32 character set, but unlike BIP-173 and
+// BOLT-11 they carry no checksum, as they are always signed or are expected
+// to be scanned from a QR code. Additionally, a '+' followed by optional
+// whitespace may be used to split long strings across multiple lines.
+
+// encodeBech32 encodes the passed 8-bit data under the given human-readable
+// part, without appending a checksum.
+func encodeBech32(hrp string, data []byte) (string, error) {
+	converted, err := convertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.Grow(len(hrp) + 1 + len(converted))
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, c := range converted {
+		b.WriteByte(charset[c])
+	}
+
+	return b.String(), nil
+}
+
+// decodeBech32 decodes a checksum-less bech32 string, returning the
+// human-readable part and the 8-bit payload. Any '+' continuation markers and
+// their trailing whitespace are removed before decoding.
+func decodeBech32(s string) (string, []byte, error) {
+	s = stripContinuations(s)
+
+	lower := strings.ToLower(s)
+	if s != lower && s != strings.ToUpper(s) {
+		return "", nil, fmt.Errorf("string not all lowercase or all " +
+			"uppercase")
+	}
+	s = lower
+
+	one := strings.LastIndexByte(s, '1')
+	if one < 1 || one == len(s)-1 {
+		return "", nil, fmt.Errorf("invalid index of 1")
+	}
+
+	hrp := s[:one]
+	data := s[one+1:]
+
+	decoded := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		idx := strings.IndexByte(charset, data[i])
+		if idx < 0 {
+			return "", nil, fmt.Errorf("invalid character not "+
+				"part of charset: %v", data[i])
+		}
+		decoded = append(decoded, byte(idx))
+	}
+
+	payload, err := convertBits(decoded, 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return hrp, payload, nil
+}
+
+// stripContinuations removes every '+' joiner along with any whitespace that
+// follows it, as permitted when offers are split over multiple lines.
+func stripContinuations(s string) string {
+	if !strings.Contains(s, "+") {
+		return strings.TrimSpace(s)
+	}
+
+	var b strings.Builder
+	skipSpace := false
+	for _, c := range strings.TrimSpace(s) {
+		switch {
+		case c == '+':
+			skipSpace = true
+			continue
+
+		case skipSpace && (c == ' ' || c == '\t' || c == '\r' ||
+			c == '\n'):
+			continue
+		}
+
+		skipSpace = false
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+// convertBits converts a byte slice where each byte is encoding fromBits bits,
+// to a byte slice where each byte is encoding toBits bits.
+func convertBits(data []byte, fromBits, toBits uint8, pad bool) ([]byte, error) {
+	var (
+		regrouped  []byte
+		nextByte   byte
+		filledBits uint8
+	)
+
+	for _, b := range data {
+		// Discard unused bits.
+		b = b << (8 - fromBits)
+
+		remFromBits := fromBits
+		for remFromBits > 0 {
+			remToBits := toBits - filledBits
+
+			toExtract := remFromBits
+			if remToBits < toExtract {
+				toExtract = remToBits
+			}
+
+			nextByte = (nextByte << toExtract) | (b >> (8 - toExtract))
+
+			b = b << toExtract
+			remFromBits -= toExtract
+			filledBits += toExtract
+
+			if filledBits == toBits {
+				regrouped = append(regrouped, nextByte)
+				filledBits = 0
+				nextByte = 0
+			}
+		}
+	}
+
+	// We pad any unfinished group if specified.
+	if pad && filledBits > 0 {
+		nextByte = nextByte << (toBits - filledBits)
+		regrouped = append(regrouped, nextByte)
+		filledBits = 0
+		nextByte = 0
+	}
+
+	// Any incomplete group must be <= 4 bits, and all zeroes.
+	if filledBits > 0 && (filledBits > 4 || nextByte != 0) {
+		return nil, fmt.Errorf("invalid incomplete group")
+	}
+
+	return regrouped, nil
+}
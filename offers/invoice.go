@@ -0,0 +1,336 @@
+package offers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// InvoiceHRP is the human-readable part of a bech32 encoded offer
+	// invoice. Invoices are normally delivered over onion messages, but
+	// the string form is used to persist them alongside regular BOLT-11
+	// payment requests.
+	InvoiceHRP = "lni"
+
+	// DefaultRelativeExpiry is the default time an invoice is valid for
+	// after its creation if it doesn't specify an explicit expiry.
+	DefaultRelativeExpiry = 7200 * time.Second
+
+	// DefaultMinFinalCLTVExpiry is the final CLTV delta required for
+	// payments to offer invoices.
+	//
+	// NOTE: As we don't yet support blinded paths, which carry the final
+	// delta for each path, this mirrors the BOLT-11 default.
+	DefaultMinFinalCLTVExpiry = 9
+
+	// invoiceMessageName is the name of the invoice message, which is
+	// included in the tag of its signature.
+	invoiceMessageName = "invoice"
+)
+
+// The set of TLV types specific to an invoice.
+const (
+	typeInvoiceCreatedAt      uint64 = 164
+	typeInvoiceRelativeExpiry uint64 = 166
+	typeInvoicePaymentHash    uint64 = 168
+	typeInvoiceAmount         uint64 = 170
+	typeInvoiceFeatures       uint64 = 174
+	typeInvoiceNodeID         uint64 = 176
+)
+
+var (
+	// ErrInvoiceExpired is returned when attempting to pay an invoice
+	// that's no longer valid.
+	ErrInvoiceExpired = errors.New("invoice has expired")
+
+	// ErrNodeIDMismatch is returned when an invoice isn't signed by the
+	// node that issued the offer.
+	ErrNodeIDMismatch = errors.New("invoice node id doesn't match offer")
+
+	// knownInvoiceTypes is the set of even invoice types we understand.
+	knownInvoiceTypes = mergeKnown(knownInvReqTypes, map[uint64]struct{}{
+		typeInvoiceCreatedAt:      {},
+		typeInvoiceRelativeExpiry: {},
+		typeInvoicePaymentHash:    {},
+		typeInvoiceAmount:         {},
+		typeInvoiceFeatures:       {},
+		typeInvoiceNodeID:         {},
+	})
+)
+
+// Invoice is issued by the offering node in response to an invoice request.
+// It mirrors all fields of the request it answers, and is signed by the node
+// that issued the offer.
+//
+// NOTE: Blinded payment paths are not yet supported, so invoices are paid by
+// routing directly to NodeID.
+type Invoice struct {
+	// Request is the invoice request this invoice is in response to.
+	Request InvoiceRequest
+
+	// CreatedAt is the time the invoice was created.
+	CreatedAt time.Time
+
+	// RelativeExpiry is the duration after CreatedAt the invoice is
+	// valid for. If zero, DefaultRelativeExpiry is used.
+	RelativeExpiry time.Duration
+
+	// PaymentHash is the hash of the preimage that will be released upon
+	// payment.
+	PaymentHash [32]byte
+
+	// Amount is the amount to be paid.
+	Amount lnwire.MilliSatoshi
+
+	// Features is the set of features the issuer requires from the payer.
+	Features *lnwire.RawFeatureVector
+
+	// NodeID is the key that signed the invoice, which must match the
+	// node id of the offer.
+	NodeID *btcec.PublicKey
+
+	// Signature is the signature of NodeID over the invoice.
+	Signature []byte
+
+	// extra holds any unknown odd records found within the invoice range.
+	extra []record
+}
+
+// records returns the TLV records representing the invoice, sorted by type.
+func (i *Invoice) records() ([]record, error) {
+	// The invoice carries all fields of the request except its
+	// signature.
+	req := i.Request
+	req.Signature = nil
+	records, err := req.records()
+	if err != nil {
+		return nil, err
+	}
+
+	if !i.CreatedAt.IsZero() {
+		records = append(records, record{
+			typeInvoiceCreatedAt,
+			encodeTu64(uint64(i.CreatedAt.Unix())),
+		})
+	}
+	if i.RelativeExpiry != 0 {
+		records = append(records, record{
+			typeInvoiceRelativeExpiry,
+			encodeTu64(uint64(i.RelativeExpiry / time.Second)),
+		})
+	}
+	records = append(records, record{
+		typeInvoicePaymentHash, i.PaymentHash[:],
+	})
+	records = append(records, record{
+		typeInvoiceAmount, encodeTu64(uint64(i.Amount)),
+	})
+	if i.Features != nil && i.Features.SerializeSize() > 0 {
+		features, err := encodeFeatures(i.Features)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record{typeInvoiceFeatures, features})
+	}
+	if i.NodeID != nil {
+		records = append(records, record{
+			typeInvoiceNodeID, i.NodeID.SerializeCompressed(),
+		})
+	}
+
+	records = mergeRecords(records, i.extra)
+
+	if len(i.Signature) > 0 {
+		records = append(records, record{typeSignature, i.Signature})
+	}
+
+	return records, nil
+}
+
+// fromRecords populates the invoice from the passed set of records.
+func (i *Invoice) fromRecords(records []record) error {
+	if err := i.Request.fromRecords(records); err != nil {
+		return err
+	}
+
+	// The signature record belongs to the invoice, not the request.
+	i.Request.Signature = nil
+
+	for _, rec := range records {
+		var err error
+		switch rec.typ {
+		case typeInvoiceCreatedAt:
+			var created uint64
+			created, err = decodeTu64(rec.value)
+			i.CreatedAt = time.Unix(int64(created), 0)
+
+		case typeInvoiceRelativeExpiry:
+			var expiry uint64
+			expiry, err = decodeTu64(rec.value)
+			i.RelativeExpiry = time.Duration(expiry) * time.Second
+
+		case typeInvoicePaymentHash:
+			if len(rec.value) != 32 {
+				return fmt.Errorf("invalid payment hash length "+
+					"%d", len(rec.value))
+			}
+			copy(i.PaymentHash[:], rec.value)
+
+		case typeInvoiceAmount:
+			var amt uint64
+			amt, err = decodeTu64(rec.value)
+			i.Amount = lnwire.MilliSatoshi(amt)
+
+		case typeInvoiceFeatures:
+			i.Features, err = decodeFeatures(rec.value)
+
+		case typeInvoiceNodeID:
+			i.NodeID, err = btcec.ParsePubKey(rec.value, btcec.S256())
+
+		case typeSignature:
+			i.Signature = rec.value
+
+		default:
+			if rec.typ >= 160 && rec.typ < 240 && rec.typ%2 != 0 {
+				i.extra = append(i.extra, rec)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sign signs the invoice using the passed signer, which must be backed by the
+// private key of NodeID.
+func (i *Invoice) Sign(signer SignFunc) error {
+	i.Signature = nil
+
+	records, err := i.records()
+	if err != nil {
+		return err
+	}
+	digest, err := signatureDigest(invoiceMessageName, records)
+	if err != nil {
+		return err
+	}
+
+	sig, err := signer(digest)
+	if err != nil {
+		return err
+	}
+	i.Signature = sig
+
+	return nil
+}
+
+// Expiry returns the time after which the invoice is no longer valid.
+func (i *Invoice) Expiry() time.Time {
+	expiry := i.RelativeExpiry
+	if expiry == 0 {
+		expiry = DefaultRelativeExpiry
+	}
+
+	return i.CreatedAt.Add(expiry)
+}
+
+// IsExpired returns true if the invoice is no longer valid at the given time.
+func (i *Invoice) IsExpired(now time.Time) bool {
+	return now.After(i.Expiry())
+}
+
+// Validate checks that the invoice is well formed and signed by the node that
+// issued the offer.
+func (i *Invoice) Validate() error {
+	if i.NodeID == nil {
+		return ErrMissingNodeID
+	}
+	if i.Request.Offer.NodeID != nil &&
+		!i.Request.Offer.NodeID.IsEqual(i.NodeID) {
+
+		return ErrNodeIDMismatch
+	}
+	if i.Features != nil {
+		fv := lnwire.NewFeatureVector(i.Features, lnwire.GlobalFeatures)
+		if unknown := fv.UnknownRequiredFeatures(); len(unknown) > 0 {
+			return fmt.Errorf("invoice requires unknown "+
+				"features: %v", unknown)
+		}
+	}
+
+	records, err := i.records()
+	if err != nil {
+		return err
+	}
+
+	return verifySignature(
+		invoiceMessageName, records, i.Signature, i.NodeID,
+	)
+}
+
+// Serialize returns the TLV stream of the invoice.
+func (i *Invoice) Serialize() ([]byte, error) {
+	records, err := i.records()
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeRecords(records)
+}
+
+// Encode serializes the invoice into its bech32 string form.
+func (i *Invoice) Encode() (string, error) {
+	stream, err := i.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(InvoiceHRP, stream)
+}
+
+// DecodeInvoice parses an invoice from its TLV stream and validates it.
+func DecodeInvoice(stream []byte) (*Invoice, error) {
+	records, err := decodeRecords(stream)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUnknownEven(records, knownInvoiceTypes); err != nil {
+		return nil, err
+	}
+
+	var invoice Invoice
+	if err := invoice.fromRecords(records); err != nil {
+		return nil, err
+	}
+	if err := invoice.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid invoice: %v", err)
+	}
+
+	return &invoice, nil
+}
+
+// DecodeInvoiceString parses an invoice from its bech32 string form.
+func DecodeInvoiceString(invoiceStr string) (*Invoice, error) {
+	hrp, stream, err := decodeBech32(invoiceStr)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != InvoiceHRP {
+		return nil, fmt.Errorf("invalid invoice prefix: %v", hrp)
+	}
+
+	return DecodeInvoice(stream)
+}
+
+// IsInvoiceString returns true if the passed string appears to be a bech32
+// encoded offer invoice.
+func IsInvoiceString(s string) bool {
+	return strings.HasPrefix(strings.ToLower(s), InvoiceHRP+"1")
+}
@@ -0,0 +1,317 @@
+package offers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// The set of TLV types specific to an invoice request.
+const (
+	typeInvReqMetadata  uint64 = 0
+	typeInvReqChain     uint64 = 80
+	typeInvReqAmount    uint64 = 82
+	typeInvReqFeatures  uint64 = 84
+	typeInvReqQuantity  uint64 = 86
+	typeInvReqPayerID   uint64 = 88
+	typeInvReqPayerNote uint64 = 89
+)
+
+// invReqMessageName is the name of the invoice request message, which is
+// included in the tag of its signature.
+const invReqMessageName = "invoice_request"
+
+var (
+	// ErrMissingPayerID is returned when an invoice request doesn't
+	// include the key of the payer.
+	ErrMissingPayerID = errors.New("invoice request is missing payer id")
+
+	// ErrInvalidAmount is returned when the amount of an invoice request
+	// isn't compatible with the offer it refers to.
+	ErrInvalidAmount = errors.New("invalid amount for offer")
+
+	// ErrInvalidQuantity is returned when the quantity of an invoice
+	// request isn't compatible with the offer it refers to.
+	ErrInvalidQuantity = errors.New("invalid quantity for offer")
+
+	// knownInvReqTypes is the set of even invoice request types we
+	// understand.
+	knownInvReqTypes = mergeKnown(knownOfferTypes, map[uint64]struct{}{
+		typeInvReqMetadata: {},
+		typeInvReqChain:    {},
+		typeInvReqAmount:   {},
+		typeInvReqFeatures: {},
+		typeInvReqQuantity: {},
+		typeInvReqPayerID:  {},
+		typeSignature:      {},
+	})
+)
+
+// InvoiceRequest is sent by a payer to the issuer of an offer in order to
+// obtain an invoice. It mirrors all fields of the offer, and is signed by a
+// transient payer key so the issuer can't link multiple payments together.
+type InvoiceRequest struct {
+	// Offer is the offer this request is for.
+	Offer Offer
+
+	// Metadata is unique data chosen by the payer to ensure the request
+	// is unique.
+	Metadata []byte
+
+	// Chain is the chain the payer would like to pay on. It's omitted if
+	// the payer wishes to pay on bitcoin mainnet.
+	Chain *chainhash.Hash
+
+	// Amount is the total amount the payer intends to pay, which is
+	// required if the offer doesn't specify an amount.
+	Amount lnwire.MilliSatoshi
+
+	// Features is the set of features the payer supports.
+	Features *lnwire.RawFeatureVector
+
+	// Quantity is the number of items requested, if the offer permits
+	// it.
+	Quantity uint64
+
+	// PayerID is the transient key used to sign the request.
+	PayerID *btcec.PublicKey
+
+	// PayerNote is an optional note for the issuer.
+	PayerNote string
+
+	// Signature is the signature of PayerID over the request.
+	Signature []byte
+
+	// extra holds any unknown odd records found within the invoice
+	// request range.
+	extra []record
+}
+
+// records returns the TLV records representing the invoice request, sorted
+// by type.
+func (r *InvoiceRequest) records() ([]record, error) {
+	var records []record
+
+	if len(r.Metadata) > 0 {
+		records = append(records, record{
+			typeInvReqMetadata, r.Metadata,
+		})
+	}
+
+	offerRecords, err := r.Offer.records()
+	if err != nil {
+		return nil, err
+	}
+	records = append(records, offerRecords...)
+
+	if r.Chain != nil {
+		records = append(records, record{typeInvReqChain, r.Chain[:]})
+	}
+	if r.Amount != 0 {
+		records = append(records, record{
+			typeInvReqAmount, encodeTu64(uint64(r.Amount)),
+		})
+	}
+	if r.Features != nil && r.Features.SerializeSize() > 0 {
+		features, err := encodeFeatures(r.Features)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record{typeInvReqFeatures, features})
+	}
+	if r.Quantity != 0 {
+		records = append(records, record{
+			typeInvReqQuantity, encodeTu64(r.Quantity),
+		})
+	}
+	if r.PayerID != nil {
+		records = append(records, record{
+			typeInvReqPayerID, r.PayerID.SerializeCompressed(),
+		})
+	}
+	if r.PayerNote != "" {
+		records = append(records, record{
+			typeInvReqPayerNote, []byte(r.PayerNote),
+		})
+	}
+
+	records = mergeRecords(records, r.extra)
+
+	if len(r.Signature) > 0 {
+		records = append(records, record{typeSignature, r.Signature})
+	}
+
+	return records, nil
+}
+
+// fromRecords populates the invoice request from the passed set of records.
+func (r *InvoiceRequest) fromRecords(records []record) error {
+	if err := r.Offer.fromRecords(records); err != nil {
+		return err
+	}
+
+	for _, rec := range records {
+		var err error
+		switch rec.typ {
+		case typeInvReqMetadata:
+			r.Metadata = rec.value
+
+		case typeInvReqChain:
+			r.Chain, err = chainhash.NewHash(rec.value)
+
+		case typeInvReqAmount:
+			var amt uint64
+			amt, err = decodeTu64(rec.value)
+			r.Amount = lnwire.MilliSatoshi(amt)
+
+		case typeInvReqFeatures:
+			r.Features, err = decodeFeatures(rec.value)
+
+		case typeInvReqQuantity:
+			r.Quantity, err = decodeTu64(rec.value)
+
+		case typeInvReqPayerID:
+			r.PayerID, err = btcec.ParsePubKey(rec.value, btcec.S256())
+
+		case typeInvReqPayerNote:
+			r.PayerNote = string(rec.value)
+
+		case typeSignature:
+			r.Signature = rec.value
+
+		default:
+			if rec.typ >= 80 && rec.typ < 160 && rec.typ%2 != 0 {
+				r.extra = append(r.extra, rec)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sign signs the invoice request using the passed signer, which must be
+// backed by the private key of PayerID.
+func (r *InvoiceRequest) Sign(signer SignFunc) error {
+	r.Signature = nil
+
+	records, err := r.records()
+	if err != nil {
+		return err
+	}
+	digest, err := signatureDigest(invReqMessageName, records)
+	if err != nil {
+		return err
+	}
+
+	sig, err := signer(digest)
+	if err != nil {
+		return err
+	}
+	r.Signature = sig
+
+	return nil
+}
+
+// Validate checks that the invoice request is well formed, is signed by its
+// payer, and is compatible with the offer it's for.
+func (r *InvoiceRequest) Validate() error {
+	if err := r.Offer.Validate(); err != nil {
+		return err
+	}
+	if r.PayerID == nil {
+		return ErrMissingPayerID
+	}
+
+	records, err := r.records()
+	if err != nil {
+		return err
+	}
+	err = verifySignature(
+		invReqMessageName, records, r.Signature, r.PayerID,
+	)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case r.Offer.QuantityMax == 0 && r.Quantity != 0:
+		return ErrInvalidQuantity
+
+	case r.Offer.QuantityMax != 0 && (r.Quantity == 0 ||
+		r.Quantity > r.Offer.QuantityMax):
+
+		return ErrInvalidQuantity
+	}
+
+	// If the offer doesn't specify an amount, the payer must. Otherwise,
+	// the payer may only choose to pay more than the offer requires.
+	if r.Offer.Amount == 0 && r.Amount == 0 {
+		return ErrInvalidAmount
+	}
+	if r.Amount != 0 && r.Amount < r.ExpectedAmount() {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+// ExpectedAmount returns the minimum amount the invoice request expects to
+// be invoiced for, based on the offer amount and the requested quantity.
+func (r *InvoiceRequest) ExpectedAmount() lnwire.MilliSatoshi {
+	quantity := r.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	return r.Offer.Amount * lnwire.MilliSatoshi(quantity)
+}
+
+// Serialize returns the TLV stream of the invoice request.
+func (r *InvoiceRequest) Serialize() ([]byte, error) {
+	records, err := r.records()
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeRecords(records)
+}
+
+// DecodeInvoiceRequest parses an invoice request from its TLV stream and
+// validates it.
+func DecodeInvoiceRequest(stream []byte) (*InvoiceRequest, error) {
+	records, err := decodeRecords(stream)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUnknownEven(records, knownInvReqTypes); err != nil {
+		return nil, err
+	}
+
+	var req InvoiceRequest
+	if err := req.fromRecords(records); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid invoice request: %v", err)
+	}
+
+	return &req, nil
+}
+
+// mergeKnown returns the union of the passed sets of known types.
+func mergeKnown(sets ...map[uint64]struct{}) map[uint64]struct{} {
+	known := make(map[uint64]struct{})
+	for _, set := range sets {
+		for typ := range set {
+			known[typ] = struct{}{}
+		}
+	}
+
+	return known
+}
@@ -0,0 +1,603 @@
+package offers
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"golang.org/x/time/rate"
+)
+
+// The set of onion message payload types used to carry offer messages.
+const (
+	// MsgInvoiceRequest is the onion message payload type carrying an
+	// invoice request.
+	MsgInvoiceRequest uint64 = 64
+
+	// MsgInvoice is the onion message payload type carrying an invoice.
+	MsgInvoice uint64 = 66
+
+	// MsgInvoiceError is the onion message payload type carrying an
+	// error in response to an invoice request.
+	MsgInvoiceError uint64 = 68
+)
+
+const (
+	// typeInvoiceErrorMessage is the TLV type of the human readable error
+	// within an invoice error message.
+	typeInvoiceErrorMessage uint64 = 5
+
+	// DefaultFetchTimeout is the default time we'll wait for a reply to an
+	// invoice request.
+	DefaultFetchTimeout = 30 * time.Second
+
+	// DefaultInvoiceRate is the default number of invoice requests per
+	// second we'll answer.
+	DefaultInvoiceRate = 1
+
+	// DefaultInvoiceBurst is the default number of invoice requests we'll
+	// answer in a single burst.
+	DefaultInvoiceBurst = 10
+)
+
+var (
+	// ErrNoTransport is returned when attempting to exchange messages
+	// with another node without a message transport configured.
+	ErrNoTransport = errors.New("no onion message transport available")
+
+	// ErrFetchTimeout is returned when the offer issuer doesn't reply to
+	// an invoice request in time.
+	ErrFetchTimeout = errors.New("timed out waiting for invoice")
+
+	// ErrUnknownOffer is returned when receiving an invoice request for
+	// an offer we didn't issue.
+	ErrUnknownOffer = errors.New("invoice request for unknown offer")
+
+	// ErrUnexpectedInvoice is returned when receiving an invoice, or an
+	// invoice error, that doesn't match any outstanding invoice request.
+	ErrUnexpectedInvoice = errors.New("unexpected invoice")
+
+	// ErrManagerShuttingDown is returned when the manager is stopped
+	// while waiting on a reply.
+	ErrManagerShuttingDown = errors.New("offer manager shutting down")
+
+	// ErrInvoiceRateLimited is returned when an invoice request is dropped
+	// for exceeding the rate at which we answer invoice requests.
+	ErrInvoiceRateLimited = errors.New("invoice request rate limit " +
+		"exceeded")
+)
+
+// MessageTransport abstracts the delivery of onion messages between nodes,
+// which is how invoice requests and invoices are exchanged.
+type MessageTransport interface {
+	// SendMessage delivers the payload of the given type to the target
+	// node. Any reply to the message is received along with the passed
+	// path id.
+	SendMessage(target *btcec.PublicKey, msgType uint64, payload,
+		pathID []byte) error
+}
+
+// ReplyFunc delivers a reply to the sender of a received message. As onion
+// messages don't reveal their sender, replies are typically sent over the
+// reply path included within the original message.
+type ReplyFunc func(msgType uint64, payload []byte) error
+
+// Config houses the set of dependencies required by the Manager.
+type Config struct {
+	// NodeID is the public key of the local node, which is used as the
+	// node id of offers we create.
+	NodeID *btcec.PublicKey
+
+	// SignNodeDigest signs the passed digest with the private key of
+	// NodeID, returning a BIP-340 signature.
+	SignNodeDigest SignFunc
+
+	// ChainHash is the genesis hash of the chain we're operating on.
+	ChainHash chainhash.Hash
+
+	// AddInvoice persists an invoice created in response to an invoice
+	// request, so that incoming HTLCs can be settled against it.
+	AddInvoice func(*channeldb.Invoice) (uint64, error)
+
+	// SendPayment dispatches a payment for a fetched invoice.
+	SendPayment func(*routing.LightningPayment) ([32]byte, *routing.Route,
+		error)
+
+	// Transport is used to deliver invoice requests, invoices and invoice
+	// errors. If nil, offers can be created and decoded, but no invoices
+	// can be fetched or issued.
+	Transport MessageTransport
+
+	// FetchTimeout is the time we'll wait for a reply to an invoice
+	// request. If zero, DefaultFetchTimeout is used.
+	FetchTimeout time.Duration
+
+	// InvoiceRate is the number of invoice requests per second we'll
+	// answer. As each answered request persists a new invoice, and onion
+	// messages don't reveal their sender, this bounds the rate at which
+	// anyone can make us store invoices. If zero, DefaultInvoiceRate is
+	// used.
+	InvoiceRate float64
+
+	// InvoiceBurst is the number of invoice requests we'll answer in quick
+	// succession before being subject to InvoiceRate. If zero,
+	// DefaultInvoiceBurst is used.
+	InvoiceBurst int
+}
+
+// fetchResult is delivered to a pending invoice request once the issuer
+// replies.
+type fetchResult struct {
+	invoice *Invoice
+	err     error
+}
+
+// Manager implements both sides of the BOLT-12 offer flow: as an issuer it
+// creates offers and answers invoice requests for them, and as a payer it
+// fetches invoices for remote offers and pays them.
+//
+// Offers are stateless for the issuer: as every invoice request echoes the
+// full offer, we only need to check that it was made out to our node id.
+type Manager struct {
+	cfg *Config
+
+	// pending tracks outstanding invoice requests, keyed by the path id
+	// of the reply path included with each request.
+	pendingMtx sync.Mutex
+	pending    map[[32]byte]chan *fetchResult
+
+	// invoiceLimiter limits the rate at which we answer invoice requests,
+	// across all senders.
+	invoiceLimiter *rate.Limiter
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+
+	stopped sync.Once
+}
+
+// NewManager creates a new offer manager backed by the given config.
+func NewManager(cfg *Config) *Manager {
+	if cfg.InvoiceRate == 0 {
+		cfg.InvoiceRate = DefaultInvoiceRate
+	}
+	if cfg.InvoiceBurst == 0 {
+		cfg.InvoiceBurst = DefaultInvoiceBurst
+	}
+
+	return &Manager{
+		cfg:     cfg,
+		pending: make(map[[32]byte]chan *fetchResult),
+		invoiceLimiter: rate.NewLimiter(
+			rate.Limit(cfg.InvoiceRate), cfg.InvoiceBurst,
+		),
+		quit: make(chan struct{}),
+	}
+}
+
+// Stop signals any callers waiting on invoice replies to exit.
+func (m *Manager) Stop() {
+	m.stopped.Do(func() {
+		close(m.quit)
+		m.wg.Wait()
+	})
+}
+
+// isMainnet returns true if the manager operates on bitcoin mainnet.
+func (m *Manager) isMainnet() bool {
+	return m.cfg.ChainHash == *chaincfg.MainNetParams.GenesisHash
+}
+
+// CreateOffer creates a new offer for our node. A zero amount creates an
+// offer for which the payer chooses the amount, and a zero expiry creates an
+// offer that never expires.
+func (m *Manager) CreateOffer(amt lnwire.MilliSatoshi, description,
+	issuer string, quantityMax uint64, expiry time.Duration) (*Offer,
+	error) {
+
+	// An offer needs some unique data so that two offers with identical
+	// terms can be told apart.
+	metadata := make([]byte, 16)
+	if _, err := rand.Read(metadata); err != nil {
+		return nil, err
+	}
+
+	offer := &Offer{
+		Metadata:    metadata,
+		Amount:      amt,
+		Description: description,
+		Issuer:      issuer,
+		QuantityMax: quantityMax,
+		NodeID:      m.cfg.NodeID,
+	}
+	if !m.isMainnet() {
+		offer.Chains = []chainhash.Hash{m.cfg.ChainHash}
+	}
+	if expiry != 0 {
+		offer.AbsoluteExpiry = time.Now().Add(expiry)
+	}
+
+	if err := offer.Validate(); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// HandleMessage processes an offer related onion message. Any response to the
+// message is delivered using the passed reply function. The path id is the
+// one of the reply path we included in the message being replied to, if any,
+// and is used to match invoices and invoice errors to their request.
+func (m *Manager) HandleMessage(reply ReplyFunc, pathID []byte,
+	msgType uint64, payload []byte) error {
+
+	switch msgType {
+	case MsgInvoiceRequest:
+		return m.handleInvoiceRequest(reply, payload)
+
+	case MsgInvoice:
+		return m.handleInvoice(pathID, payload)
+
+	case MsgInvoiceError:
+		return m.handleInvoiceError(pathID, payload)
+
+	default:
+		return fmt.Errorf("unknown offer message type %d", msgType)
+	}
+}
+
+// handleInvoiceRequest answers an invoice request for one of our offers with
+// a freshly created invoice, or an invoice error if the request can't be
+// satisfied. Requests in excess of our rate limit are dropped without a
+// reply, before doing any work on them.
+func (m *Manager) handleInvoiceRequest(reply ReplyFunc,
+	payload []byte) error {
+
+	if !m.invoiceLimiter.Allow() {
+		return ErrInvoiceRateLimited
+	}
+
+	invoice, err := m.createInvoice(payload)
+	if err != nil {
+		log.Debugf("Rejecting invoice request: %v", err)
+
+		return m.sendInvoiceError(reply, err)
+	}
+
+	stream, err := invoice.Serialize()
+	if err != nil {
+		return err
+	}
+
+	return reply(MsgInvoice, stream)
+}
+
+// createInvoice validates the serialized invoice request, then creates,
+// signs and persists an invoice for it.
+func (m *Manager) createInvoice(payload []byte) (*Invoice, error) {
+	req, err := DecodeInvoiceRequest(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	offer := &req.Offer
+	if !offer.NodeID.IsEqual(m.cfg.NodeID) {
+		return nil, ErrUnknownOffer
+	}
+	if offer.IsExpired(time.Now()) {
+		return nil, ErrOfferExpired
+	}
+
+	chain := m.cfg.ChainHash
+	if req.Chain != nil {
+		chain = *req.Chain
+	}
+	if chain != m.cfg.ChainHash || !offer.SupportsChain(chain,
+		m.isMainnet()) {
+
+		return nil, fmt.Errorf("unsupported chain %v", chain)
+	}
+
+	amt := req.Amount
+	if amt == 0 {
+		amt = req.ExpectedAmount()
+	}
+
+	var preimage [32]byte
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return nil, err
+	}
+
+	invoice := &Invoice{
+		Request:     *req,
+		CreatedAt:   time.Now(),
+		PaymentHash: sha256.Sum256(preimage[:]),
+		Amount:      amt,
+		NodeID:      m.cfg.NodeID,
+	}
+	if err := invoice.Sign(m.cfg.SignNodeDigest); err != nil {
+		return nil, err
+	}
+
+	invoiceStr, err := invoice.Encode()
+	if err != nil {
+		return nil, err
+	}
+	if len(invoiceStr) > channeldb.MaxPaymentRequestSize {
+		return nil, fmt.Errorf("invoice too large: %d bytes",
+			len(invoiceStr))
+	}
+
+	// Persist the invoice as we would a regular BOLT-11 invoice, using its
+	// bech32 form as the payment request so that the registry can parse
+	// the terms back when an HTLC arrives.
+	_, err = m.cfg.AddInvoice(&channeldb.Invoice{
+		CreationDate:   invoice.CreatedAt,
+		Memo:           []byte(offer.Description),
+		PaymentRequest: []byte(invoiceStr),
+		Terms: channeldb.ContractTerm{
+			Value:           amt,
+			PaymentPreimage: preimage,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Created invoice %x for offer %v, amt=%v",
+		invoice.PaymentHash[:], offer.Description, amt)
+
+	return invoice, nil
+}
+
+// handleInvoice delivers an invoice to the pending request it answers, as
+// identified by the path id of the reply path it was received over.
+func (m *Manager) handleInvoice(pathID, payload []byte) error {
+	invoice, err := DecodeInvoice(payload)
+	if err != nil {
+		return err
+	}
+
+	return m.resolvePending(pathID, &fetchResult{invoice: invoice})
+}
+
+// handleInvoiceError fails the pending request the error answers, as
+// identified by the path id of the reply path it was received over.
+func (m *Manager) handleInvoiceError(pathID, payload []byte) error {
+	records, err := decodeRecords(payload)
+	if err != nil {
+		return err
+	}
+
+	reason := "unknown error"
+	for _, rec := range records {
+		if rec.typ == typeInvoiceErrorMessage {
+			reason = string(rec.value)
+		}
+	}
+	fetchErr := fmt.Errorf("invoice request rejected: %v", reason)
+
+	return m.resolvePending(pathID, &fetchResult{err: fetchErr})
+}
+
+// resolvePending delivers the result to the pending request whose reply path
+// carries the given path id.
+func (m *Manager) resolvePending(pathID []byte, result *fetchResult) error {
+	var key [32]byte
+	if len(pathID) != len(key) {
+		return ErrUnexpectedInvoice
+	}
+	copy(key[:], pathID)
+
+	m.pendingMtx.Lock()
+	defer m.pendingMtx.Unlock()
+
+	resultChan, ok := m.pending[key]
+	if !ok {
+		return ErrUnexpectedInvoice
+	}
+	delete(m.pending, key)
+
+	// The channel is buffered, so this will never block.
+	resultChan <- result
+
+	return nil
+}
+
+// FetchInvoice sends an invoice request for the given offer to its issuer
+// and waits for the resulting invoice. The amount may be zero if the offer
+// specifies one, and quantity may be zero if the offer doesn't permit
+// multiple items.
+func (m *Manager) FetchInvoice(offer *Offer, amt lnwire.MilliSatoshi,
+	quantity uint64, payerNote string) (*Invoice, error) {
+
+	if m.cfg.Transport == nil {
+		return nil, ErrNoTransport
+	}
+	if err := offer.Validate(); err != nil {
+		return nil, err
+	}
+	if offer.IsExpired(time.Now()) {
+		return nil, ErrOfferExpired
+	}
+	if !offer.SupportsChain(m.cfg.ChainHash, m.isMainnet()) {
+		return nil, fmt.Errorf("offer not valid for chain %v",
+			m.cfg.ChainHash)
+	}
+
+	// Each request is signed by a fresh key, so the issuer can't link
+	// requests from the same payer.
+	payerKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	metadata := make([]byte, 32)
+	if _, err := rand.Read(metadata); err != nil {
+		return nil, err
+	}
+
+	req := &InvoiceRequest{
+		Offer:     *offer,
+		Metadata:  metadata,
+		Amount:    amt,
+		Quantity:  quantity,
+		PayerID:   payerKey.PubKey(),
+		PayerNote: payerNote,
+	}
+	if !m.isMainnet() {
+		req.Chain = &m.cfg.ChainHash
+	}
+	if err := req.Sign(KeySigner(payerKey)); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	stream, err := req.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	// The issuer replies over the reply path included with our request,
+	// which carries a fresh path id so that we can tell which request the
+	// reply answers.
+	var key [32]byte
+	if _, err := rand.Read(key[:]); err != nil {
+		return nil, err
+	}
+	resultChan := make(chan *fetchResult, 1)
+
+	m.pendingMtx.Lock()
+	m.pending[key] = resultChan
+	m.pendingMtx.Unlock()
+
+	m.wg.Add(1)
+	defer m.wg.Done()
+
+	defer func() {
+		m.pendingMtx.Lock()
+		delete(m.pending, key)
+		m.pendingMtx.Unlock()
+	}()
+
+	err = m.send(offer.NodeID, MsgInvoiceRequest, stream, key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := m.cfg.FetchTimeout
+	if timeout == 0 {
+		timeout = DefaultFetchTimeout
+	}
+
+	select {
+	case result := <-resultChan:
+		if result.err != nil {
+			return nil, result.err
+		}
+		if err := checkInvoice(req, result.invoice); err != nil {
+			return nil, err
+		}
+
+		return result.invoice, nil
+
+	case <-time.After(timeout):
+		return nil, ErrFetchTimeout
+
+	case <-m.quit:
+		return nil, ErrManagerShuttingDown
+	}
+}
+
+// checkInvoice ensures the invoice received answers the request we sent.
+func checkInvoice(req *InvoiceRequest, invoice *Invoice) error {
+	reqRecords, err := req.records()
+	if err != nil {
+		return err
+	}
+	reqRoot, err := merkleRoot(reqRecords)
+	if err != nil {
+		return err
+	}
+
+	echoed := invoice.Request
+	echoed.Signature = nil
+	echoedRecords, err := echoed.records()
+	if err != nil {
+		return err
+	}
+	echoedRoot, err := merkleRoot(echoedRecords)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(reqRoot, echoedRoot) {
+		return fmt.Errorf("invoice doesn't match invoice request")
+	}
+	if invoice.Amount < req.ExpectedAmount() ||
+		(req.Amount != 0 && invoice.Amount != req.Amount) {
+
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+// PayInvoice pays an invoice previously fetched for an offer.
+func (m *Manager) PayInvoice(invoice *Invoice,
+	feeLimit lnwire.MilliSatoshi) ([32]byte, *routing.Route, error) {
+
+	if err := invoice.Validate(); err != nil {
+		return [32]byte{}, nil, err
+	}
+	if invoice.IsExpired(time.Now()) {
+		return [32]byte{}, nil, ErrInvoiceExpired
+	}
+
+	finalDelta := uint16(DefaultMinFinalCLTVExpiry)
+	payment := &routing.LightningPayment{
+		Target:         invoice.NodeID,
+		Amount:         invoice.Amount,
+		FeeLimit:       feeLimit,
+		PaymentHash:    invoice.PaymentHash,
+		FinalCLTVDelta: &finalDelta,
+	}
+
+	return m.cfg.SendPayment(payment)
+}
+
+// sendInvoiceError replies to an invoice request with the passed error.
+func (m *Manager) sendInvoiceError(reply ReplyFunc, reqErr error) error {
+
+	stream, err := encodeRecords([]record{
+		{typeInvoiceErrorMessage, []byte(reqErr.Error())},
+	})
+	if err != nil {
+		return err
+	}
+
+	return reply(MsgInvoiceError, stream)
+}
+
+// send delivers the message over the configured transport, receiving any
+// reply along with the given path id.
+func (m *Manager) send(target *btcec.PublicKey, msgType uint64, payload,
+	pathID []byte) error {
+
+	if m.cfg.Transport == nil {
+		return ErrNoTransport
+	}
+
+	return m.cfg.Transport.SendMessage(target, msgType, payload, pathID)
+}
@@ -0,0 +1,381 @@
+package offers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// OfferHRP is the human-readable part of a bech32 encoded offer.
+	OfferHRP = "lno"
+
+	// MaxOfferSize is the maximum size in bytes we'll accept for an
+	// encoded offer string.
+	MaxOfferSize = 4096
+)
+
+// The set of TLV types that make up an offer.
+const (
+	typeOfferChains         uint64 = 2
+	typeOfferMetadata       uint64 = 4
+	typeOfferAmount         uint64 = 8
+	typeOfferDescription    uint64 = 10
+	typeOfferFeatures       uint64 = 12
+	typeOfferAbsoluteExpiry uint64 = 14
+	typeOfferIssuer         uint64 = 18
+	typeOfferQuantityMax    uint64 = 20
+	typeOfferNodeID         uint64 = 22
+)
+
+var (
+	// ErrOfferExpired is returned when an action is attempted on an offer
+	// whose absolute expiry has passed.
+	ErrOfferExpired = errors.New("offer has expired")
+
+	// ErrMissingNodeID is returned when an offer doesn't specify the node
+	// that invoice requests should be sent to.
+	ErrMissingNodeID = errors.New("offer is missing node id")
+
+	// ErrMissingDescription is returned when an offer that specifies an
+	// amount doesn't include a description.
+	ErrMissingDescription = errors.New("offer with amount is missing " +
+		"description")
+
+	// knownOfferTypes is the set of even offer types we understand.
+	knownOfferTypes = map[uint64]struct{}{
+		typeOfferChains:         {},
+		typeOfferMetadata:       {},
+		typeOfferAmount:         {},
+		typeOfferDescription:    {},
+		typeOfferFeatures:       {},
+		typeOfferAbsoluteExpiry: {},
+		typeOfferIssuer:         {},
+		typeOfferQuantityMax:    {},
+		typeOfferNodeID:         {},
+	}
+)
+
+// Offer is a reusable, long-lived payment request as defined in BOLT-12.
+// Unlike a BOLT-11 invoice, an offer doesn't commit to a payment hash.
+// Instead, payers use it to fetch a fresh invoice from the offering node over
+// onion messages.
+type Offer struct {
+	// Chains is the set of chains the offer is valid for. If empty, the
+	// offer is implicitly valid only for bitcoin mainnet.
+	Chains []chainhash.Hash
+
+	// Metadata is opaque data the issuer may use to later recognize the
+	// offer when receiving an invoice request for it.
+	Metadata []byte
+
+	// Amount is the amount expected per item. A zero amount indicates the
+	// payer is free to choose how much to pay.
+	Amount lnwire.MilliSatoshi
+
+	// Description is a human readable description of the purpose of the
+	// payment.
+	Description string
+
+	// Features is the set of features the issuer requires from payers.
+	Features *lnwire.RawFeatureVector
+
+	// AbsoluteExpiry, if non-zero, is the time after which the offer
+	// should no longer be used.
+	AbsoluteExpiry time.Time
+
+	// Issuer is an optional human readable name of the issuer.
+	Issuer string
+
+	// QuantityMax, if non-zero, signals that the payer may request
+	// multiple items, up to this limit.
+	QuantityMax uint64
+
+	// NodeID is the public key of the node that invoice requests should
+	// be sent to, and which will sign the resulting invoices.
+	NodeID *btcec.PublicKey
+
+	// extra holds any unknown odd records found while decoding, so that
+	// they're carried over when re-encoding the offer.
+	extra []record
+}
+
+// records returns the TLV records representing the offer, sorted by type.
+func (o *Offer) records() ([]record, error) {
+	var records []record
+
+	if len(o.Chains) > 0 {
+		var b bytes.Buffer
+		for _, chain := range o.Chains {
+			b.Write(chain[:])
+		}
+		records = append(records, record{typeOfferChains, b.Bytes()})
+	}
+	if len(o.Metadata) > 0 {
+		records = append(records, record{typeOfferMetadata, o.Metadata})
+	}
+	if o.Amount != 0 {
+		records = append(records, record{
+			typeOfferAmount, encodeTu64(uint64(o.Amount)),
+		})
+	}
+	if o.Description != "" {
+		records = append(records, record{
+			typeOfferDescription, []byte(o.Description),
+		})
+	}
+	if o.Features != nil && o.Features.SerializeSize() > 0 {
+		features, err := encodeFeatures(o.Features)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record{typeOfferFeatures, features})
+	}
+	if !o.AbsoluteExpiry.IsZero() {
+		records = append(records, record{
+			typeOfferAbsoluteExpiry,
+			encodeTu64(uint64(o.AbsoluteExpiry.Unix())),
+		})
+	}
+	if o.Issuer != "" {
+		records = append(records, record{
+			typeOfferIssuer, []byte(o.Issuer),
+		})
+	}
+	if o.QuantityMax != 0 {
+		records = append(records, record{
+			typeOfferQuantityMax, encodeTu64(o.QuantityMax),
+		})
+	}
+	if o.NodeID != nil {
+		records = append(records, record{
+			typeOfferNodeID, o.NodeID.SerializeCompressed(),
+		})
+	}
+
+	return mergeRecords(records, o.extra), nil
+}
+
+// fromRecords populates the offer from the passed set of records, ignoring
+// any records outside the offer's type range.
+func (o *Offer) fromRecords(records []record) error {
+	for _, rec := range records {
+		var err error
+		switch rec.typ {
+		case typeOfferChains:
+			if len(rec.value)%chainhash.HashSize != 0 {
+				return fmt.Errorf("invalid offer chains length %d",
+					len(rec.value))
+			}
+			for i := 0; i < len(rec.value); i += chainhash.HashSize {
+				var chain chainhash.Hash
+				copy(chain[:], rec.value[i:])
+				o.Chains = append(o.Chains, chain)
+			}
+
+		case typeOfferMetadata:
+			o.Metadata = rec.value
+
+		case typeOfferAmount:
+			var amt uint64
+			amt, err = decodeTu64(rec.value)
+			o.Amount = lnwire.MilliSatoshi(amt)
+
+		case typeOfferDescription:
+			o.Description = string(rec.value)
+
+		case typeOfferFeatures:
+			o.Features, err = decodeFeatures(rec.value)
+
+		case typeOfferAbsoluteExpiry:
+			var expiry uint64
+			expiry, err = decodeTu64(rec.value)
+			o.AbsoluteExpiry = time.Unix(int64(expiry), 0)
+
+		case typeOfferIssuer:
+			o.Issuer = string(rec.value)
+
+		case typeOfferQuantityMax:
+			o.QuantityMax, err = decodeTu64(rec.value)
+
+		case typeOfferNodeID:
+			o.NodeID, err = btcec.ParsePubKey(rec.value, btcec.S256())
+
+		default:
+			// Only unknown odd records within the offer range
+			// are retained, everything else belongs to the
+			// message the offer is embedded within.
+			if rec.typ < 80 && rec.typ%2 != 0 {
+				o.extra = append(o.extra, rec)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the offer is well formed according to the rules a
+// reader of an offer must apply.
+func (o *Offer) Validate() error {
+	if o.NodeID == nil {
+		return ErrMissingNodeID
+	}
+	if o.Amount != 0 && o.Description == "" {
+		return ErrMissingDescription
+	}
+	if o.Features != nil {
+		fv := lnwire.NewFeatureVector(o.Features, lnwire.GlobalFeatures)
+		if unknown := fv.UnknownRequiredFeatures(); len(unknown) > 0 {
+			return fmt.Errorf("offer requires unknown features: "+
+				"%v", unknown)
+		}
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the offer has an absolute expiry which has
+// passed at the given time.
+func (o *Offer) IsExpired(now time.Time) bool {
+	return !o.AbsoluteExpiry.IsZero() && now.After(o.AbsoluteExpiry)
+}
+
+// SupportsChain returns true if the offer is valid for the given chain.
+func (o *Offer) SupportsChain(chain chainhash.Hash, mainnet bool) bool {
+	if len(o.Chains) == 0 {
+		return mainnet
+	}
+	for _, c := range o.Chains {
+		if c == chain {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ID returns a unique identifier for the offer, which is the merkle root of
+// its TLV records as defined by BOLT-12.
+func (o *Offer) ID() ([32]byte, error) {
+	var id [32]byte
+
+	records, err := o.records()
+	if err != nil {
+		return id, err
+	}
+	root, err := merkleRoot(records)
+	if err != nil {
+		return id, err
+	}
+	copy(id[:], root)
+
+	return id, nil
+}
+
+// Encode serializes the offer into its bech32 string form.
+func (o *Offer) Encode() (string, error) {
+	records, err := o.records()
+	if err != nil {
+		return "", err
+	}
+	stream, err := encodeRecords(records)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(OfferHRP, stream)
+}
+
+// DecodeOffer parses the passed bech32 string into an Offer, validating it in
+// the process.
+func DecodeOffer(offerStr string) (*Offer, error) {
+	if len(offerStr) > MaxOfferSize {
+		return nil, fmt.Errorf("offer too large: %d bytes",
+			len(offerStr))
+	}
+
+	hrp, stream, err := decodeBech32(offerStr)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != OfferHRP {
+		return nil, fmt.Errorf("invalid offer prefix: %v", hrp)
+	}
+
+	records, err := decodeRecords(stream)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUnknownEven(records, knownOfferTypes); err != nil {
+		return nil, err
+	}
+
+	var offer Offer
+	if err := offer.fromRecords(records); err != nil {
+		return nil, err
+	}
+	if err := offer.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &offer, nil
+}
+
+// encodeFeatures serializes a raw feature vector without the two byte length
+// prefix used within lnwire messages, as the TLV length already conveys it.
+func encodeFeatures(fv *lnwire.RawFeatureVector) ([]byte, error) {
+	var b bytes.Buffer
+	if err := fv.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes()[2:], nil
+}
+
+// decodeFeatures parses a raw feature vector that was serialized without its
+// length prefix.
+func decodeFeatures(features []byte) (*lnwire.RawFeatureVector, error) {
+	if len(features) > 0xffff {
+		return nil, fmt.Errorf("feature vector too large")
+	}
+
+	var b bytes.Buffer
+	var l [2]byte
+	binary.BigEndian.PutUint16(l[:], uint16(len(features)))
+	b.Write(l[:])
+	b.Write(features)
+
+	fv := lnwire.NewRawFeatureVector()
+	if err := fv.Decode(&b); err != nil {
+		return nil, err
+	}
+
+	return fv, nil
+}
+
+// mergeRecords merges two sets of records that are each sorted by type into
+// a single sorted set.
+func mergeRecords(a, b []record) []record {
+	merged := make([]record, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		if a[0].typ < b[0].typ {
+			merged = append(merged, a[0])
+			a = a[1:]
+		} else {
+			merged = append(merged, b[0])
+			b = b[1:]
+		}
+	}
+	merged = append(merged, a...)
+
+	return append(merged, b...)
+}
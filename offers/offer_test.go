@@ -0,0 +1,594 @@
+package offers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+)
+
+var (
+	testNodeKey, _  = btcec.NewPrivateKey(btcec.S256())
+	testPayerKey, _ = btcec.NewPrivateKey(btcec.S256())
+)
+
+// TestBigSizeEncoding asserts that BigSize integers round trip, and that
+// non-canonical encodings are rejected.
+func TestBigSizeEncoding(t *testing.T) {
+	t.Parallel()
+
+	values := []uint64{0, 0xfc, 0xfd, 0xffff, 0x10000, 0xffffffff,
+		0x100000000, 0xffffffffffffffff}
+	for _, val := range values {
+		var b bytes.Buffer
+		if err := writeBigSize(&b, val); err != nil {
+			t.Fatalf("unable to write %d: %v", val, err)
+		}
+		decoded, err := readBigSize(&b)
+		if err != nil {
+			t.Fatalf("unable to read %d: %v", val, err)
+		}
+		if decoded != val {
+			t.Fatalf("expected %d, got %d", val, decoded)
+		}
+	}
+
+	_, err := readBigSize(bytes.NewReader([]byte{0xfd, 0x00, 0xfc}))
+	if err != ErrNonCanonicalBigSize {
+		t.Fatalf("expected ErrNonCanonicalBigSize, got %v", err)
+	}
+}
+
+// TestOfferEncodeDecode asserts that an offer survives a round trip through
+// its bech32 string encoding, including when split with '+' joiners.
+func TestOfferEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	offer := &Offer{
+		Chains:         []chainhash.Hash{*chaincfg.TestNet3Params.GenesisHash},
+		Metadata:       []byte{1, 2, 3},
+		Amount:         100000,
+		Description:    "coffee",
+		Features:       lnwire.NewRawFeatureVector(lnwire.GossipQueriesOptional),
+		AbsoluteExpiry: time.Unix(2000000000, 0),
+		Issuer:         "cafe",
+		QuantityMax:    5,
+		NodeID:         testNodeKey.PubKey(),
+	}
+
+	encoded, err := offer.Encode()
+	if err != nil {
+		t.Fatalf("unable to encode offer: %v", err)
+	}
+
+	decoded, err := DecodeOffer(encoded)
+	if err != nil {
+		t.Fatalf("unable to decode offer: %v", err)
+	}
+	if !reflect.DeepEqual(offer, decoded) {
+		t.Fatalf("offer mismatch: expected %v, got %v", offer, decoded)
+	}
+
+	// Splitting the string over multiple lines shouldn't change the
+	// result.
+	split := encoded[:20] + "+\n  " + encoded[20:]
+	decoded, err = DecodeOffer(split)
+	if err != nil {
+		t.Fatalf("unable to decode split offer: %v", err)
+	}
+	if !reflect.DeepEqual(offer, decoded) {
+		t.Fatalf("offer mismatch: expected %v, got %v", offer, decoded)
+	}
+
+	// An offer without a node id must be rejected.
+	offer.NodeID = nil
+	encoded, err = offer.Encode()
+	if err != nil {
+		t.Fatalf("unable to encode offer: %v", err)
+	}
+	if _, err := DecodeOffer(encoded); err != ErrMissingNodeID {
+		t.Fatalf("expected ErrMissingNodeID, got %v", err)
+	}
+}
+
+// TestInvoiceRequestSignature asserts that invoice requests are bound to
+// their payer key, and that tampering invalidates the signature.
+func TestInvoiceRequestSignature(t *testing.T) {
+	t.Parallel()
+
+	req := &InvoiceRequest{
+		Offer: Offer{
+			Amount:      1000,
+			Description: "tea",
+			QuantityMax: 2,
+			NodeID:      testNodeKey.PubKey(),
+		},
+		Metadata: []byte{9, 9, 9},
+		Quantity: 2,
+		PayerID:  testPayerKey.PubKey(),
+	}
+	if err := req.Sign(KeySigner(testPayerKey)); err != nil {
+		t.Fatalf("unable to sign request: %v", err)
+	}
+
+	stream, err := req.Serialize()
+	if err != nil {
+		t.Fatalf("unable to serialize request: %v", err)
+	}
+	decoded, err := DecodeInvoiceRequest(stream)
+	if err != nil {
+		t.Fatalf("unable to decode request: %v", err)
+	}
+	if decoded.ExpectedAmount() != 2000 {
+		t.Fatalf("expected amount 2000, got %v",
+			decoded.ExpectedAmount())
+	}
+
+	// Requesting more items than the offer allows must fail.
+	decoded.Quantity = 3
+	if err := decoded.Sign(KeySigner(testPayerKey)); err != nil {
+		t.Fatalf("unable to sign request: %v", err)
+	}
+	if err := decoded.Validate(); err != ErrInvalidQuantity {
+		t.Fatalf("expected ErrInvalidQuantity, got %v", err)
+	}
+
+	// Modifying the request after signing must invalidate it.
+	decoded.Quantity = 1
+	if err := decoded.Validate(); err != ErrInvalidSignature {
+		t.Fatalf("expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+// TestMerkleRoot asserts that the merkle root of a set of records matches
+// the test vectors of BOLT-12, and that signature records aren't covered.
+func TestMerkleRoot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		records []record
+		root    string
+	}{
+		{
+			name: "single record",
+			records: []record{
+				{typ: 1, value: []byte{0x03, 0xe8}},
+			},
+			root: "b013756c8fee86503a0b4abdab4cddeb1af5d344ca6fc2" +
+				"fa8b6c08938caa6f93",
+		},
+		{
+			name: "two records",
+			records: []record{
+				{typ: 1, value: []byte{0x03, 0xe8}},
+				{typ: 2, value: []byte{0, 0, 1, 0, 0, 2, 0, 3}},
+			},
+			root: "c3774abbf4815aa54ccaa026bff6581f01f3be5fe814c6" +
+				"20a252534f434bc0d1",
+		},
+		{
+			name: "signature excluded",
+			records: []record{
+				{typ: 1, value: []byte{0x03, 0xe8}},
+				{typ: typeSignature, value: make([]byte, 64)},
+			},
+			root: "b013756c8fee86503a0b4abdab4cddeb1af5d344ca6fc2" +
+				"fa8b6c08938caa6f93",
+		},
+	}
+
+	for _, test := range tests {
+		root, err := merkleRoot(test.records)
+		if err != nil {
+			t.Fatalf("%s: unable to compute root: %v", test.name,
+				err)
+		}
+		if hex.EncodeToString(root) != test.root {
+			t.Fatalf("%s: expected root %s, got %x", test.name,
+				test.root, root)
+		}
+	}
+}
+
+// TestSchnorrSignature asserts that signatures match the test vectors of
+// BIP-340 and verify against the signing key.
+func TestSchnorrSignature(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		key     string
+		auxRand string
+		msg     string
+		sig     string
+	}{
+		{
+			key: "00000000000000000000000000000000000000000000" +
+				"00000000000000000003",
+			auxRand: "0000000000000000000000000000000000000000" +
+				"000000000000000000000000",
+			msg: "00000000000000000000000000000000000000000000" +
+				"00000000000000000000",
+			sig: "E907831F80848D1069A5371B402410364BDF1C5F8307" +
+				"B0084C55F1CE2DCA821525F66A4A85EA8B71E482A7" +
+				"4F382D2CE5EBEEE8FDB2172F477DF4900D310536C0",
+		},
+		{
+			key: "B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4" +
+				"DA56A784D9045190CFEF",
+			auxRand: "0000000000000000000000000000000000000000" +
+				"000000000000000000000001",
+			msg: "243F6A8885A308D313198A2E03707344A4093822299F" +
+				"31D0082EFA98EC4E6C89",
+			sig: "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43" +
+				"F917DC8DCF8C78DE33418906D11AC976ABCCB20B09" +
+				"1292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+		},
+	}
+
+	decode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			t.Fatalf("unable to decode %s: %v", s, err)
+		}
+		return b
+	}
+
+	for i, test := range tests {
+		key, pubKey := btcec.PrivKeyFromBytes(
+			btcec.S256(), decode(test.key),
+		)
+		msg := decode(test.msg)
+
+		sig, err := schnorrSign(key, msg, decode(test.auxRand))
+		if err != nil {
+			t.Fatalf("test %d: unable to sign: %v", i, err)
+		}
+		if !bytes.Equal(sig, decode(test.sig)) {
+			t.Fatalf("test %d: expected signature %s, got %X", i,
+				test.sig, sig)
+		}
+		if !schnorrVerify(pubKey, msg, sig) {
+			t.Fatalf("test %d: signature didn't verify", i)
+		}
+
+		// The signature must not verify for another message.
+		msg[0] ^= 1
+		if schnorrVerify(pubKey, msg, sig) {
+			t.Fatalf("test %d: signature verified for another "+
+				"message", i)
+		}
+	}
+}
+
+// mockTransport delivers messages directly to the manager of the target
+// node, replying to the sending node.
+type mockTransport struct {
+	self     *btcec.PublicKey
+	managers map[[33]byte]*Manager
+}
+
+func (m *mockTransport) SendMessage(target *btcec.PublicKey, msgType uint64,
+	payload, pathID []byte) error {
+
+	return m.deliver(target, nil, msgType, payload, pathID)
+}
+
+// deliver hands the message to the manager of the target node as if it was
+// received over a path with the given path id. Replies are delivered back to
+// us along with the reply path id.
+func (m *mockTransport) deliver(target *btcec.PublicKey, pathID []byte,
+	msgType uint64, payload, replyPathID []byte) error {
+
+	var key [33]byte
+	copy(key[:], target.SerializeCompressed())
+
+	reply := func(replyType uint64, replyPayload []byte) error {
+		return m.deliver(
+			m.self, replyPathID, replyType, replyPayload, nil,
+		)
+	}
+	go m.managers[key].HandleMessage(reply, pathID, msgType, payload)
+
+	return nil
+}
+
+// captureTransport records the path ids of the messages sent over it without
+// delivering them.
+type captureTransport struct {
+	pathIDs chan []byte
+}
+
+func (c *captureTransport) SendMessage(target *btcec.PublicKey,
+	msgType uint64, payload, pathID []byte) error {
+
+	c.pathIDs <- pathID
+	return nil
+}
+
+// TestFetchAndPayInvoice exercises the full flow of creating an offer,
+// fetching an invoice for it, and paying that invoice.
+func TestFetchAndPayInvoice(t *testing.T) {
+	t.Parallel()
+
+	managers := make(map[[33]byte]*Manager)
+	chainHash := *chaincfg.TestNet3Params.GenesisHash
+
+	var added []*channeldb.Invoice
+	issuer := NewManager(&Config{
+		NodeID:         testNodeKey.PubKey(),
+		SignNodeDigest: KeySigner(testNodeKey),
+		ChainHash:      chainHash,
+		AddInvoice: func(inv *channeldb.Invoice) (uint64, error) {
+			added = append(added, inv)
+			return uint64(len(added)), nil
+		},
+		Transport: &mockTransport{
+			self:     testNodeKey.PubKey(),
+			managers: managers,
+		},
+	})
+	defer issuer.Stop()
+
+	var payment *routing.LightningPayment
+	payer := NewManager(&Config{
+		NodeID:         testPayerKey.PubKey(),
+		SignNodeDigest: KeySigner(testPayerKey),
+		ChainHash:      chainHash,
+		SendPayment: func(p *routing.LightningPayment) ([32]byte,
+			*routing.Route, error) {
+
+			payment = p
+			return [32]byte{}, nil, nil
+		},
+		Transport: &mockTransport{
+			self:     testPayerKey.PubKey(),
+			managers: managers,
+		},
+		FetchTimeout: 5 * time.Second,
+	})
+	defer payer.Stop()
+
+	var issuerKey, payerKey [33]byte
+	copy(issuerKey[:], testNodeKey.PubKey().SerializeCompressed())
+	copy(payerKey[:], testPayerKey.PubKey().SerializeCompressed())
+	managers[issuerKey] = issuer
+	managers[payerKey] = payer
+
+	offer, err := issuer.CreateOffer(5000, "sandwich", "deli", 0, time.Hour)
+	if err != nil {
+		t.Fatalf("unable to create offer: %v", err)
+	}
+	encoded, err := offer.Encode()
+	if err != nil {
+		t.Fatalf("unable to encode offer: %v", err)
+	}
+	offer, err = DecodeOffer(encoded)
+	if err != nil {
+		t.Fatalf("unable to decode offer: %v", err)
+	}
+
+	invoice, err := payer.FetchInvoice(offer, 0, 0, "no onions")
+	if err != nil {
+		t.Fatalf("unable to fetch invoice: %v", err)
+	}
+	if invoice.Amount != 5000 {
+		t.Fatalf("expected amount 5000, got %v", invoice.Amount)
+	}
+	if invoice.Request.PayerNote != "no onions" {
+		t.Fatalf("payer note not echoed: %v", invoice.Request.PayerNote)
+	}
+
+	// The issuer should have persisted an invoice whose preimage matches
+	// the payment hash, and whose payment request decodes to the invoice
+	// we received.
+	if len(added) != 1 {
+		t.Fatalf("expected 1 invoice to be added, got %d", len(added))
+	}
+	preimage := added[0].Terms.PaymentPreimage
+	if sha256.Sum256(preimage[:]) != invoice.PaymentHash {
+		t.Fatalf("persisted preimage doesn't match payment hash")
+	}
+	payReq := string(added[0].PaymentRequest)
+	if !IsInvoiceString(payReq) {
+		t.Fatalf("unexpected payment request: %v", payReq)
+	}
+	if _, err := DecodeInvoiceString(payReq); err != nil {
+		t.Fatalf("unable to decode persisted invoice: %v", err)
+	}
+
+	if _, _, err := payer.PayInvoice(invoice, 100); err != nil {
+		t.Fatalf("unable to pay invoice: %v", err)
+	}
+	if payment.PaymentHash != invoice.PaymentHash ||
+		payment.Amount != 5000 ||
+		!payment.Target.IsEqual(testNodeKey.PubKey()) {
+
+		t.Fatalf("unexpected payment: %v", payment)
+	}
+}
+
+// TestFetchInvoiceRejected asserts that an invoice error from the issuer is
+// surfaced to the payer.
+func TestFetchInvoiceRejected(t *testing.T) {
+	t.Parallel()
+
+	managers := make(map[[33]byte]*Manager)
+	chainHash := *chaincfg.TestNet3Params.GenesisHash
+
+	// The issuer is configured with a different node key than the one
+	// the offer is made out to, so it'll reject the request.
+	otherKey, _ := btcec.NewPrivateKey(btcec.S256())
+	issuer := NewManager(&Config{
+		NodeID:         otherKey.PubKey(),
+		SignNodeDigest: KeySigner(otherKey),
+		ChainHash:      chainHash,
+		Transport: &mockTransport{
+			self:     testNodeKey.PubKey(),
+			managers: managers,
+		},
+	})
+	defer issuer.Stop()
+
+	payer := NewManager(&Config{
+		NodeID:    testPayerKey.PubKey(),
+		ChainHash: chainHash,
+		Transport: &mockTransport{
+			self:     testPayerKey.PubKey(),
+			managers: managers,
+		},
+		FetchTimeout: 5 * time.Second,
+	})
+	defer payer.Stop()
+
+	var issuerKey, payerKey [33]byte
+	copy(issuerKey[:], testNodeKey.PubKey().SerializeCompressed())
+	copy(payerKey[:], testPayerKey.PubKey().SerializeCompressed())
+	managers[issuerKey] = issuer
+	managers[payerKey] = payer
+
+	offer := &Offer{
+		Chains:      []chainhash.Hash{chainHash},
+		Amount:      1000,
+		Description: "ghost",
+		NodeID:      testNodeKey.PubKey(),
+	}
+
+	_, err := payer.FetchInvoice(offer, 0, 0, "")
+	if err == nil || err == ErrFetchTimeout {
+		t.Fatalf("expected invoice error, got %v", err)
+	}
+}
+
+// TestInvoiceErrorCorrelation asserts that an invoice error only fails the
+// invoice request it answers.
+func TestInvoiceErrorCorrelation(t *testing.T) {
+	t.Parallel()
+
+	chainHash := *chaincfg.TestNet3Params.GenesisHash
+	transport := &captureTransport{pathIDs: make(chan []byte, 2)}
+	payer := NewManager(&Config{
+		NodeID:       testPayerKey.PubKey(),
+		ChainHash:    chainHash,
+		Transport:    transport,
+		FetchTimeout: time.Second,
+	})
+	defer payer.Stop()
+
+	offer := &Offer{
+		Chains:      []chainhash.Hash{chainHash},
+		Amount:      1000,
+		Description: "coffee",
+		NodeID:      testNodeKey.PubKey(),
+	}
+
+	// We'll fetch two invoices for the offer concurrently, capturing the
+	// path id of the reply path of each request.
+	fetch := func() (chan error, []byte) {
+		errChan := make(chan error, 1)
+		go func() {
+			_, err := payer.FetchInvoice(offer, 0, 0, "")
+			errChan <- err
+		}()
+
+		select {
+		case pathID := <-transport.pathIDs:
+			return errChan, pathID
+		case <-time.After(5 * time.Second):
+			t.Fatalf("invoice request not sent")
+		}
+
+		return nil, nil
+	}
+	firstErr, firstPathID := fetch()
+	secondErr, secondPathID := fetch()
+	if bytes.Equal(firstPathID, secondPathID) {
+		t.Fatalf("requests share the same path id")
+	}
+
+	// An invoice error received over a path we didn't create shouldn't
+	// fail either request.
+	errPayload, err := encodeRecords([]record{
+		{typeInvoiceErrorMessage, []byte("sold out")},
+	})
+	if err != nil {
+		t.Fatalf("unable to encode invoice error: %v", err)
+	}
+	err = payer.HandleMessage(nil, nil, MsgInvoiceError, errPayload)
+	if err != ErrUnexpectedInvoice {
+		t.Fatalf("expected ErrUnexpectedInvoice, got %v", err)
+	}
+
+	// An invoice error received over the reply path of the first request
+	// should only fail the first request.
+	err = payer.HandleMessage(nil, firstPathID, MsgInvoiceError, errPayload)
+	if err != nil {
+		t.Fatalf("unable to handle invoice error: %v", err)
+	}
+
+	select {
+	case err := <-firstErr:
+		if err == nil || err == ErrFetchTimeout {
+			t.Fatalf("expected invoice error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("first request not failed")
+	}
+
+	select {
+	case err := <-secondErr:
+		if err != ErrFetchTimeout {
+			t.Fatalf("expected ErrFetchTimeout, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("second request didn't time out")
+	}
+}
+
+// TestInvoiceRequestRateLimit asserts that invoice requests in excess of the
+// issuer's rate limit are dropped without a reply.
+func TestInvoiceRequestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	issuer := NewManager(&Config{
+		NodeID:       testNodeKey.PubKey(),
+		ChainHash:    *chaincfg.TestNet3Params.GenesisHash,
+		InvoiceRate:  0.001,
+		InvoiceBurst: 1,
+	})
+	defer issuer.Stop()
+
+	var numReplies int
+	reply := func(uint64, []byte) error {
+		numReplies++
+		return nil
+	}
+
+	// The first request is within the limit, so it's answered, though
+	// only with an invoice error as it's malformed.
+	err := issuer.HandleMessage(reply, nil, MsgInvoiceRequest, []byte{1})
+	if err != nil {
+		t.Fatalf("unable to handle invoice request: %v", err)
+	}
+	if numReplies != 1 {
+		t.Fatalf("expected 1 reply, got %d", numReplies)
+	}
+
+	// The second one exceeds the limit, so it's dropped.
+	err = issuer.HandleMessage(reply, nil, MsgInvoiceRequest, []byte{1})
+	if err != ErrInvoiceRateLimited {
+		t.Fatalf("expected ErrInvoiceRateLimited, got %v", err)
+	}
+	if numReplies != 1 {
+		t.Fatalf("expected 1 reply, got %d", numReplies)
+	}
+}
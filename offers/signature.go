@@ -0,0 +1,276 @@
+package offers
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+const (
+	// typeSignature is the TLV type carrying the signature of invoice
+	// requests and invoices.
+	typeSignature uint64 = 240
+
+	// maxSignatureType is the highest TLV type within the signature
+	// range. Records within the range aren't covered by the merkle root.
+	maxSignatureType uint64 = 1000
+
+	// signatureSize is the size of a BIP-340 signature.
+	signatureSize = 64
+
+	// signatureTagPrefix prefixes the tag of the hash that is signed,
+	// which is followed by the name of the message and of its signature
+	// field.
+	signatureTagPrefix = "lightning"
+
+	// signatureFieldName is the name of the signature field of both
+	// invoice requests and invoices.
+	signatureFieldName = "signature"
+)
+
+var (
+	// ErrInvalidSignature is returned when a message's signature doesn't
+	// commit to its contents or wasn't made by the expected key.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// SignFunc signs the passed 32-byte message using BIP-340, returning a
+// 64-byte Schnorr signature.
+type SignFunc func(msg []byte) ([]byte, error)
+
+// taggedHash returns the BIP-340 tagged hash of the concatenated messages,
+// SHA256(SHA256(tag) || SHA256(tag) || msg).
+func taggedHash(tag []byte, msgs ...[]byte) []byte {
+	tagHash := sha256.Sum256(tag)
+
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, msg := range msgs {
+		h.Write(msg)
+	}
+
+	return h.Sum(nil)
+}
+
+// merkleBranch returns the inner node committing to both passed nodes, which
+// are sorted so that no ordering needs to be conveyed along with a proof.
+func merkleBranch(a, b []byte) []byte {
+	if string(b) < string(a) {
+		a, b = b, a
+	}
+
+	return taggedHash([]byte("LnBranch"), a, b)
+}
+
+// merkleRoot computes the root of the merkle tree over the passed records,
+// as defined by BOLT-12. Records within the signature range are excluded.
+//
+// Every record contributes a leaf committing to it and a nonce leaf
+// committing to its type and the first record of the stream, which keeps
+// the records that aren't revealed within a proof private. If the number of
+// records isn't a power of two, the tree is deepest at the lowest types.
+func merkleRoot(records []record) ([]byte, error) {
+	var signed []record
+	for _, rec := range records {
+		if rec.typ >= typeSignature && rec.typ <= maxSignatureType {
+			continue
+		}
+		signed = append(signed, rec)
+	}
+	if len(signed) == 0 {
+		return nil, errors.New("no records to sign")
+	}
+
+	firstRecord, err := encodeRecords(signed[:1])
+	if err != nil {
+		return nil, err
+	}
+	nonceTag := append([]byte("LnNonce"), firstRecord...)
+
+	nodes := make([][]byte, len(signed))
+	for i, rec := range signed {
+		stream, err := encodeRecords([]record{rec})
+		if err != nil {
+			return nil, err
+		}
+		var typeBytes bytes.Buffer
+		if err := writeBigSize(&typeBytes, rec.typ); err != nil {
+			return nil, err
+		}
+
+		nodes[i] = merkleBranch(
+			taggedHash([]byte("LnLeaf"), stream),
+			taggedHash(nonceTag, typeBytes.Bytes()),
+		)
+	}
+
+	for step := 1; step < len(nodes); step *= 2 {
+		for i := 0; i+step < len(nodes); i += 2 * step {
+			nodes[i] = merkleBranch(nodes[i], nodes[i+step])
+		}
+	}
+
+	return nodes[0], nil
+}
+
+// signatureDigest returns the message that is signed for the passed set of
+// records of the named message: a hash of their merkle root, tagged with the
+// names of the message and of its signature field.
+func signatureDigest(messageName string, records []record) ([]byte, error) {
+	root, err := merkleRoot(records)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := signatureTagPrefix + messageName + signatureFieldName
+
+	return taggedHash([]byte(tag), root), nil
+}
+
+// verifySignature checks that sig is a valid signature by pubKey over the
+// passed set of records of the named message.
+func verifySignature(messageName string, records []record, sig []byte,
+	pubKey *btcec.PublicKey) error {
+
+	if len(sig) != signatureSize || pubKey == nil {
+		return ErrInvalidSignature
+	}
+
+	digest, err := signatureDigest(messageName, records)
+	if err != nil {
+		return err
+	}
+
+	if !schnorrVerify(pubKey, digest, sig) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// KeySigner returns a SignFunc backed by the passed private key.
+func KeySigner(key *btcec.PrivateKey) SignFunc {
+	return func(msg []byte) ([]byte, error) {
+		var auxRand [32]byte
+		if _, err := rand.Read(auxRand[:]); err != nil {
+			return nil, err
+		}
+
+		return schnorrSign(key, msg, auxRand[:])
+	}
+}
+
+// intTo32 returns the 32-byte big-endian encoding of the passed integer.
+func intTo32(i *big.Int) []byte {
+	var b [32]byte
+	raw := i.Bytes()
+	copy(b[32-len(raw):], raw)
+
+	return b[:]
+}
+
+// schnorrSign creates a BIP-340 signature of msg using the passed private
+// key and auxiliary randomness.
+func schnorrSign(key *btcec.PrivateKey, msg, auxRand []byte) ([]byte,
+	error) {
+
+	curve := btcec.S256()
+	order := curve.Params().N
+
+	d := new(big.Int).Set(key.D)
+	if d.Sign() == 0 || d.Cmp(order) >= 0 {
+		return nil, errors.New("invalid private key")
+	}
+
+	// The public key is implicitly the one with an even y coordinate, so
+	// we'll negate the private key if its point has an odd one.
+	px, py := curve.ScalarBaseMult(intTo32(d))
+	if py.Bit(0) == 1 {
+		d.Sub(order, d)
+	}
+
+	// The nonce is derived from the private key, the message and the
+	// auxiliary randomness, which protects against side channels.
+	mask := taggedHash([]byte("BIP0340/aux"), auxRand)
+	t := intTo32(d)
+	for i := range t {
+		t[i] ^= mask[i]
+	}
+	nonce := taggedHash([]byte("BIP0340/nonce"), t, intTo32(px), msg)
+
+	k := new(big.Int).SetBytes(nonce)
+	k.Mod(k, order)
+	if k.Sign() == 0 {
+		return nil, errors.New("invalid nonce")
+	}
+
+	rx, ry := curve.ScalarBaseMult(intTo32(k))
+	if ry.Bit(0) == 1 {
+		k.Sub(order, k)
+	}
+
+	e := new(big.Int).SetBytes(taggedHash(
+		[]byte("BIP0340/challenge"), intTo32(rx), intTo32(px), msg,
+	))
+	e.Mod(e, order)
+
+	s := new(big.Int).Mul(e, d)
+	s.Add(s, k)
+	s.Mod(s, order)
+
+	sig := make([]byte, 0, signatureSize)
+	sig = append(sig, intTo32(rx)...)
+	sig = append(sig, intTo32(s)...)
+
+	return sig, nil
+}
+
+// schnorrVerify returns whether sig is a valid BIP-340 signature of msg by
+// the x-only form of the passed public key.
+func schnorrVerify(pubKey *btcec.PublicKey, msg, sig []byte) bool {
+	if len(sig) != signatureSize {
+		return false
+	}
+
+	curve := btcec.S256()
+	params := curve.Params()
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if r.Cmp(params.P) >= 0 || s.Cmp(params.N) >= 0 {
+		return false
+	}
+
+	// Only the x coordinate of the public key is signed for, and it's
+	// taken to have an even y coordinate.
+	px := pubKey.X
+	py := new(big.Int).Set(pubKey.Y)
+	if py.Bit(0) == 1 {
+		py.Sub(params.P, py)
+	}
+
+	e := new(big.Int).SetBytes(taggedHash(
+		[]byte("BIP0340/challenge"), sig[:32], intTo32(px), msg,
+	))
+	e.Mod(e, params.N)
+
+	// R = s*G - e*P must be a point with an even y coordinate, whose x
+	// coordinate is r.
+	sx, sy := curve.ScalarBaseMult(intTo32(s))
+	ex, ey := curve.ScalarMult(px, py, intTo32(e))
+	if ey.Sign() != 0 {
+		ey = new(big.Int).Sub(params.P, ey)
+	}
+	rx, ry := curve.Add(sx, sy, ex, ey)
+
+	if rx.Sign() == 0 && ry.Sign() == 0 {
+		return false
+	}
+
+	return ry.Bit(0) == 0 && rx.Cmp(r) == 0
+}
@@ -0,0 +1,100 @@
+package offers
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// ErrNonCanonicalBigSize is returned when a BigSize integer is not
+	// encoded using the minimal number of bytes.
+	ErrNonCanonicalBigSize = tlv.ErrNonCanonicalBigSize
+
+	// ErrRecordsOutOfOrder is returned when the records of a TLV stream
+	// are not sorted by strictly increasing type.
+	ErrRecordsOutOfOrder = tlv.ErrRecordsOutOfOrder
+)
+
+// record is a single type-length-value entry within a TLV stream. All offer
+// messages are modeled as an ordered list of these records, with unknown odd
+// records being carried along untouched so signatures remain verifiable.
+type record struct {
+	typ   uint64
+	value []byte
+}
+
+// writeBigSize writes the passed integer to w using the BigSize variable
+// length encoding defined in BOLT-01.
+func writeBigSize(w io.Writer, val uint64) error {
+	return tlv.WriteBigSize(w, val)
+}
+
+// readBigSize reads a BigSize encoded integer from r, rejecting any
+// non-minimal encodings.
+func readBigSize(r io.Reader) (uint64, error) {
+	return tlv.ReadBigSize(r)
+}
+
+// encodeTu64 returns the truncated big-endian encoding of val, stripping all
+// leading zero bytes as required for tu64 fields.
+func encodeTu64(val uint64) []byte {
+	return tlv.EncodeTu64(val)
+}
+
+// decodeTu64 parses a truncated big-endian integer, rejecting encodings that
+// are too long or that carry leading zero bytes.
+func decodeTu64(b []byte) (uint64, error) {
+	return tlv.DecodeTu64(b)
+}
+
+// encodeRecords serializes the set of records as a TLV stream. The records
+// MUST already be sorted by type.
+func encodeRecords(records []record) ([]byte, error) {
+	tlvRecords := make([]tlv.Record, 0, len(records))
+	for _, rec := range records {
+		tlvRecords = append(tlvRecords, tlv.Record{
+			Type:  rec.typ,
+			Value: rec.value,
+		})
+	}
+
+	return tlv.EncodeStream(tlvRecords)
+}
+
+// decodeRecords parses a TLV stream into its component records, ensuring the
+// types are strictly increasing.
+func decodeRecords(stream []byte) ([]record, error) {
+	tlvRecords, err := tlv.DecodeStream(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]record, 0, len(tlvRecords))
+	for _, rec := range tlvRecords {
+		records = append(records, record{
+			typ:   rec.Type,
+			value: rec.Value,
+		})
+	}
+
+	return records, nil
+}
+
+// checkUnknownEven returns an error if any record within the set is of an
+// even type that isn't contained in the set of known types. Following the
+// "it's OK to be odd" rule, unknown odd records are ignored.
+func checkUnknownEven(records []record, known map[uint64]struct{}) error {
+	for _, rec := range records {
+		if rec.typ%2 != 0 {
+			continue
+		}
+		if _, ok := known[rec.typ]; !ok {
+			return fmt.Errorf("unknown required tlv type %d",
+				rec.typ)
+		}
+	}
+
+	return nil
+}
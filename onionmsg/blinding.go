@@ -0,0 +1,377 @@
+package onionmsg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/tlv"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// typeEncPadding is the encrypted data record used to pad all hops of
+	// a blinded path to the same size.
+	typeEncPadding = 1
+
+	// typeEncNextNodeID is the encrypted data record holding the node id
+	// of the next hop.
+	typeEncNextNodeID = 4
+
+	// typeEncPathID is the encrypted data record that lets the recipient
+	// recognize blinded paths it created.
+	typeEncPathID = 6
+
+	// typeEncNextBlindingOverride is the encrypted data record that
+	// replaces the blinding point for the next hop, used to concatenate
+	// blinded paths.
+	typeEncNextBlindingOverride = 8
+
+	// maxBlindedHops is the maximum number of hops we'll accept within a
+	// blinded path.
+	maxBlindedHops = 20
+)
+
+var (
+	// ErrNoBlindedHops is returned when creating or decoding a blinded
+	// path without any hops.
+	ErrNoBlindedHops = errors.New("blinded path has no hops")
+)
+
+// knownEncryptedTypes is the set of encrypted data records we understand.
+var knownEncryptedTypes = map[uint64]struct{}{
+	typeEncNextNodeID:           {},
+	typeEncPathID:               {},
+	typeEncNextBlindingOverride: {},
+}
+
+// BlindedHop is a single hop within a blinded path.
+type BlindedHop struct {
+	// BlindedNodeID is the blinded public key of the hop, which is used
+	// to construct the hop's layer of the onion.
+	BlindedNodeID *btcec.PublicKey
+
+	// EncryptedData is the routing data for the hop, which only the hop
+	// itself can decrypt.
+	EncryptedData []byte
+}
+
+// BlindedPath is a route to a node that hides the identity of all hops but
+// the introduction node.
+type BlindedPath struct {
+	// IntroductionNode is the unblinded public key of the first hop.
+	IntroductionNode *btcec.PublicKey
+
+	// BlindingPoint is the ephemeral key the introduction node uses to
+	// decrypt its routing data.
+	BlindingPoint *btcec.PublicKey
+
+	// Hops is the set of blinded hops, starting with the introduction
+	// node.
+	Hops []*BlindedHop
+}
+
+// encode serializes the blinded path.
+func (p *BlindedPath) encode(w io.Writer) error {
+	if len(p.Hops) == 0 || len(p.Hops) > maxBlindedHops {
+		return fmt.Errorf("invalid number of blinded hops: %d",
+			len(p.Hops))
+	}
+
+	var b bytes.Buffer
+	b.Write(p.IntroductionNode.SerializeCompressed())
+	b.Write(p.BlindingPoint.SerializeCompressed())
+	b.WriteByte(uint8(len(p.Hops)))
+
+	for _, hop := range p.Hops {
+		var l [2]byte
+		binary.BigEndian.PutUint16(l[:], uint16(len(hop.EncryptedData)))
+
+		b.Write(hop.BlindedNodeID.SerializeCompressed())
+		b.Write(l[:])
+		b.Write(hop.EncryptedData)
+	}
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// readPubKey reads a compressed public key from r.
+func readPubKey(r io.Reader) (*btcec.PublicKey, error) {
+	var b [33]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return nil, err
+	}
+
+	return btcec.ParsePubKey(b[:], btcec.S256())
+}
+
+// decodeBlindedPath parses a serialized blinded path.
+func decodeBlindedPath(r io.Reader) (*BlindedPath, error) {
+	var (
+		path BlindedPath
+		err  error
+	)
+	path.IntroductionNode, err = readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+	path.BlindingPoint, err = readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var numHops [1]byte
+	if _, err := io.ReadFull(r, numHops[:]); err != nil {
+		return nil, err
+	}
+	if numHops[0] == 0 {
+		return nil, ErrNoBlindedHops
+	}
+	if numHops[0] > maxBlindedHops {
+		return nil, fmt.Errorf("too many blinded hops: %d", numHops[0])
+	}
+
+	for i := 0; i < int(numHops[0]); i++ {
+		var hop BlindedHop
+		hop.BlindedNodeID, err = readPubKey(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var l [2]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return nil, err
+		}
+		hop.EncryptedData = make([]byte, binary.BigEndian.Uint16(l[:]))
+		if _, err := io.ReadFull(r, hop.EncryptedData); err != nil {
+			return nil, err
+		}
+
+		path.Hops = append(path.Hops, &hop)
+	}
+
+	return &path, nil
+}
+
+// routingData is the decrypted routing data of a single blinded hop.
+type routingData struct {
+	// nextNodeID is the node to forward the onion message to. It's nil
+	// for the final hop.
+	nextNodeID *btcec.PublicKey
+
+	// pathID is set by the recipient so it can recognize paths it
+	// created.
+	pathID []byte
+
+	// nextBlindingOverride, if set, replaces the blinding point sent to
+	// the next node.
+	nextBlindingOverride *btcec.PublicKey
+}
+
+// records returns the TLV records of the routing data.
+func (d *routingData) records() []tlv.Record {
+	var records []tlv.Record
+	if d.nextNodeID != nil {
+		records = append(records, tlv.Record{
+			Type:  typeEncNextNodeID,
+			Value: d.nextNodeID.SerializeCompressed(),
+		})
+	}
+	if d.pathID != nil {
+		records = append(records, tlv.Record{
+			Type:  typeEncPathID,
+			Value: d.pathID,
+		})
+	}
+	if d.nextBlindingOverride != nil {
+		records = append(records, tlv.Record{
+			Type:  typeEncNextBlindingOverride,
+			Value: d.nextBlindingOverride.SerializeCompressed(),
+		})
+	}
+
+	return records
+}
+
+// decodeRoutingData parses the decrypted routing data of a blinded hop.
+func decodeRoutingData(b []byte) (*routingData, error) {
+	records, err := tlv.DecodeStream(b)
+	if err != nil {
+		return nil, err
+	}
+	err = tlv.CheckUnknownEven(records, knownEncryptedTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	var data routingData
+	for _, rec := range records {
+		switch rec.Type {
+		case typeEncNextNodeID:
+			data.nextNodeID, err = btcec.ParsePubKey(
+				rec.Value, btcec.S256(),
+			)
+		case typeEncPathID:
+			data.pathID = rec.Value
+		case typeEncNextBlindingOverride:
+			data.nextBlindingOverride, err = btcec.ParsePubKey(
+				rec.Value, btcec.S256(),
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+// encryptData encrypts a hop's routing data under its rho key.
+func encryptData(secret [32]byte, plaintext []byte) ([]byte, error) {
+	rho := generateKey("rho", secret[:])
+	aead, err := chacha20poly1305.New(rho[:])
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce [chacha20poly1305.NonceSize]byte
+	return aead.Seal(nil, nonce[:], plaintext, nil), nil
+}
+
+// decryptData decrypts a hop's routing data using its rho key.
+func decryptData(secret [32]byte, ciphertext []byte) ([]byte, error) {
+	rho := generateKey("rho", secret[:])
+	aead, err := chacha20poly1305.New(rho[:])
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce [chacha20poly1305.NonceSize]byte
+	return aead.Open(nil, nonce[:], ciphertext, nil)
+}
+
+// newBlindedPath creates a blinded path through the given nodes, where the
+// final hop receives the passed routing data. Each hop's routing data is
+// padded to the same size, so the path doesn't reveal which hop is the
+// recipient.
+func newBlindedPath(sessionKey *btcec.PrivateKey, nodes []*btcec.PublicKey,
+	final *routingData) (*BlindedPath, error) {
+
+	if len(nodes) == 0 {
+		return nil, ErrNoBlindedHops
+	}
+	if len(nodes) > maxBlindedHops {
+		return nil, fmt.Errorf("too many blinded hops: %d", len(nodes))
+	}
+
+	// First, we'll serialize the routing data of each hop, so we can pad
+	// them all to the size of the largest.
+	plaintexts := make([][]tlv.Record, len(nodes))
+	var maxSize int
+	for i := range nodes {
+		data := final
+		if i < len(nodes)-1 {
+			data = &routingData{nextNodeID: nodes[i+1]}
+		}
+		plaintexts[i] = data.records()
+
+		stream, err := tlv.EncodeStream(plaintexts[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(stream) > maxSize {
+			maxSize = len(stream)
+		}
+	}
+
+	path := &BlindedPath{
+		IntroductionNode: nodes[0],
+		BlindingPoint:    sessionKey.PubKey(),
+	}
+
+	ephemeralPriv := sessionKey
+	for i, node := range nodes {
+		secret := ecdh(ephemeralPriv, node)
+
+		records := padRoutingData(plaintexts[i], maxSize)
+		plaintext, err := tlv.EncodeStream(records)
+		if err != nil {
+			return nil, err
+		}
+
+		encrypted, err := encryptData(secret, plaintext)
+		if err != nil {
+			return nil, err
+		}
+
+		blindedKey := generateKey("blinded_node_id", secret[:])
+		path.Hops = append(path.Hops, &BlindedHop{
+			BlindedNodeID: multPubKey(node, blindedKey[:]),
+			EncryptedData: encrypted,
+		})
+
+		factor := blindingFactor(ephemeralPriv.PubKey(), secret)
+		ephemeralPriv = multPrivKey(ephemeralPriv, factor[:])
+	}
+
+	return path, nil
+}
+
+// padRoutingData prepends a padding record to the routing data, so that its
+// serialization reaches the target size.
+func padRoutingData(records []tlv.Record, size int) []tlv.Record {
+	stream, _ := tlv.EncodeStream(records)
+	if len(stream) >= size {
+		return records
+	}
+
+	// The padding record itself takes at least two bytes for its type and
+	// length. As the sizes of all hops only differ by a few bytes, the
+	// length will always fit within a single byte.
+	padLen := size - len(stream) - 2
+	if padLen < 0 {
+		padLen = 0
+	}
+
+	padded := []tlv.Record{{
+		Type:  typeEncPadding,
+		Value: make([]byte, padLen),
+	}}
+
+	return append(padded, records...)
+}
+
+// unblind derives the keys needed by the hop with the given private key to
+// process an onion message sent with the blinding point. It returns the
+// shared secret used to decrypt the hop's routing data, and the blinded
+// private key used to peel the onion.
+func unblind(nodeKey *btcec.PrivateKey,
+	blindingPoint *btcec.PublicKey) ([32]byte, *btcec.PrivateKey) {
+
+	secret := ecdh(nodeKey, blindingPoint)
+	blindedKey := generateKey("blinded_node_id", secret[:])
+
+	return secret, multPrivKey(nodeKey, blindedKey[:])
+}
+
+// nextBlindingPoint returns the blinding point to send to the next hop.
+func nextBlindingPoint(blindingPoint *btcec.PublicKey,
+	secret [32]byte) *btcec.PublicKey {
+
+	factor := blindingFactor(blindingPoint, secret)
+	return multPubKey(blindingPoint, factor[:])
+}
+
+// serializeBlindedPath returns the serialization of the blinded path.
+func serializeBlindedPath(p *BlindedPath) ([]byte, error) {
+	var b bytes.Buffer
+	if err := p.encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
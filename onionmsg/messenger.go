@@ -0,0 +1,543 @@
+package onionmsg
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// typeReplyPath is the hop payload record that carries a blinded
+	// path the recipient can use to reply.
+	typeReplyPath = 2
+
+	// typeEncryptedData is the hop payload record that carries the
+	// hop's encrypted routing data.
+	typeEncryptedData = 4
+
+	// MinApplicationType is the lowest record type that may be used for
+	// application payloads within an onion message.
+	MinApplicationType = 64
+
+	// DefaultPeerRate is the default number of onion messages per second
+	// we'll accept from each peer.
+	DefaultPeerRate = 10
+
+	// DefaultPeerBurst is the default number of onion messages we'll
+	// accept from a peer in a single burst.
+	DefaultPeerBurst = 50
+
+	// MaxPathLength is the maximum number of hops we'll route an onion
+	// message through, which ensures the hop payloads fit within a
+	// single onion packet.
+	MaxPathLength = 10
+
+	// pathIDSize is the size of the path id we include within the reply
+	// paths we create.
+	pathIDSize = 32
+)
+
+var (
+	// ErrRateLimited is returned when a peer sends us onion messages
+	// faster than we allow.
+	ErrRateLimited = errors.New("onion message rate limit exceeded")
+
+	// ErrNoPath is returned when no route to the destination of an onion
+	// message is known.
+	ErrNoPath = errors.New("no path to onion message destination")
+
+	// ErrHandlerExists is returned when registering a second handler for
+	// the same message type.
+	ErrHandlerExists = errors.New("onion message handler already " +
+		"registered")
+
+	// ErrMessengerShuttingDown is returned when a message is sent or
+	// received while the messenger is shutting down.
+	ErrMessengerShuttingDown = errors.New("onion messenger shutting down")
+)
+
+// ReceivedMessage is an onion message payload delivered to us.
+type ReceivedMessage struct {
+	// Type is the record type of the application payload.
+	Type uint64
+
+	// Payload is the application payload.
+	Payload []byte
+
+	// PathID is the path id from the blinded path the message was sent
+	// over, if we created it. It's nil if the message was sent over a
+	// path created by the sender.
+	PathID []byte
+
+	// ReplyPath is the blinded path included by the sender that can be
+	// used to reply, if any.
+	ReplyPath *BlindedPath
+}
+
+// Handler is invoked for each received onion message payload of the type it
+// was registered for.
+type Handler func(msg *ReceivedMessage)
+
+// Config houses the set of dependencies required by the Messenger.
+type Config struct {
+	// NodeKey is the private key of the local node, used to decrypt onion
+	// messages sent to or through us.
+	NodeKey *btcec.PrivateKey
+
+	// SendToPeer sends the message to the connected peer with the given
+	// public key.
+	SendToPeer func(target *btcec.PublicKey, msg lnwire.Message) error
+
+	// FindPath returns the sequence of nodes to route an onion message
+	// through to reach the target, starting with one of our peers and
+	// ending with the target itself. If nil, messages can only be sent to
+	// our direct peers.
+	FindPath func(target *btcec.PublicKey) ([]*btcec.PublicKey, error)
+
+	// IsPeer returns true if we're currently connected to the node.
+	IsPeer func(node *btcec.PublicKey) bool
+
+	// PeerRate is the number of onion messages per second we'll accept
+	// from each peer. If zero, DefaultPeerRate is used.
+	PeerRate float64
+
+	// PeerBurst is the number of onion messages we'll accept from a peer
+	// in a single burst. If zero, DefaultPeerBurst is used.
+	PeerBurst int
+}
+
+// Messenger forwards onion messages on behalf of other nodes and allows
+// applications to send and receive onion message payloads. All messages are
+// sent over blinded paths, and include a blinded reply path so the recipient
+// can respond without learning who we are.
+type Messenger struct {
+	cfg *Config
+
+	nodeID *btcec.PublicKey
+
+	handlerMtx sync.RWMutex
+	handlers   map[uint64]Handler
+
+	limiterMtx sync.Mutex
+	limiters   map[[33]byte]*rate.Limiter
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+	stop sync.Once
+}
+
+// NewMessenger creates a new onion messenger from the passed config.
+func NewMessenger(cfg *Config) *Messenger {
+	if cfg.PeerRate == 0 {
+		cfg.PeerRate = DefaultPeerRate
+	}
+	if cfg.PeerBurst == 0 {
+		cfg.PeerBurst = DefaultPeerBurst
+	}
+
+	return &Messenger{
+		cfg:      cfg,
+		nodeID:   cfg.NodeKey.PubKey(),
+		handlers: make(map[uint64]Handler),
+		limiters: make(map[[33]byte]*rate.Limiter),
+		quit:     make(chan struct{}),
+	}
+}
+
+// Stop signals the messenger to shut down, and waits for all handlers that
+// are still running to exit.
+func (m *Messenger) Stop() {
+	m.stop.Do(func() {
+		close(m.quit)
+		m.wg.Wait()
+	})
+}
+
+// RegisterHandler registers the handler to be invoked for every received
+// application payload of the given type.
+func (m *Messenger) RegisterHandler(msgType uint64, handler Handler) error {
+	if msgType < MinApplicationType {
+		return fmt.Errorf("onion message type %d is reserved", msgType)
+	}
+
+	m.handlerMtx.Lock()
+	defer m.handlerMtx.Unlock()
+
+	if _, ok := m.handlers[msgType]; ok {
+		return ErrHandlerExists
+	}
+	m.handlers[msgType] = handler
+
+	return nil
+}
+
+// allow returns whether another onion message from the peer is within its
+// rate limit.
+func (m *Messenger) allow(peer *btcec.PublicKey) bool {
+	var key [33]byte
+	copy(key[:], peer.SerializeCompressed())
+
+	m.limiterMtx.Lock()
+	limiter, ok := m.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(
+			rate.Limit(m.cfg.PeerRate), m.cfg.PeerBurst,
+		)
+		m.limiters[key] = limiter
+	}
+	m.limiterMtx.Unlock()
+
+	return limiter.Allow()
+}
+
+// PeerDisconnected releases the rate limiting state held for the peer.
+func (m *Messenger) PeerDisconnected(peer *btcec.PublicKey) {
+	var key [33]byte
+	copy(key[:], peer.SerializeCompressed())
+
+	m.limiterMtx.Lock()
+	delete(m.limiters, key)
+	m.limiterMtx.Unlock()
+}
+
+// HandleMessage processes an onion message received from the peer. If we're
+// an intermediate hop, the message is forwarded to the next node, otherwise
+// its payloads are delivered to the registered handlers.
+func (m *Messenger) HandleMessage(peer *btcec.PublicKey,
+	msg *lnwire.OnionMessage) error {
+
+	select {
+	case <-m.quit:
+		return ErrMessengerShuttingDown
+	default:
+	}
+
+	if !m.allow(peer) {
+		return ErrRateLimited
+	}
+
+	pkt, err := decodePacket(msg.OnionBlob)
+	if err != nil {
+		return err
+	}
+
+	// Using the blinding point, we'll derive the key needed to decrypt
+	// our routing data, as well as our blinded private key which the
+	// sender used to construct our layer of the onion.
+	secret, blindedKey := unblind(m.cfg.NodeKey, msg.BlindingPoint)
+
+	// Unlike payment onions, onion messages aren't bound to any
+	// associated data.
+	processed, err := processPacket(blindedKey, pkt, nil)
+	if err != nil {
+		return err
+	}
+
+	records, err := tlv.DecodeStream(processed.payload)
+	if err != nil {
+		return err
+	}
+
+	var (
+		encrypted []byte
+		replyPath *BlindedPath
+		payloads  []tlv.Record
+	)
+	for _, rec := range records {
+		switch {
+		case rec.Type == typeReplyPath:
+			replyPath, err = decodeBlindedPath(
+				bytes.NewReader(rec.Value),
+			)
+			if err != nil {
+				return err
+			}
+
+		case rec.Type == typeEncryptedData:
+			encrypted = rec.Value
+
+		case rec.Type >= MinApplicationType:
+			payloads = append(payloads, rec)
+
+		case rec.Type%2 == 0:
+			return fmt.Errorf("unknown required onion message "+
+				"record %d", rec.Type)
+		}
+	}
+	if encrypted == nil {
+		return fmt.Errorf("onion message missing encrypted data")
+	}
+
+	plaintext, err := decryptData(secret, encrypted)
+	if err != nil {
+		return err
+	}
+	data, err := decodeRoutingData(plaintext)
+	if err != nil {
+		return err
+	}
+
+	// If there's a next hop, then we're only an intermediate node and
+	// will forward the message along.
+	if data.nextNodeID != nil {
+		if processed.next == nil {
+			return fmt.Errorf("onion message has next node but " +
+				"no next packet")
+		}
+
+		nextBlinding := data.nextBlindingOverride
+		if nextBlinding == nil {
+			nextBlinding = nextBlindingPoint(
+				msg.BlindingPoint, secret,
+			)
+		}
+
+		log.Tracef("Forwarding onion message to %x",
+			data.nextNodeID.SerializeCompressed())
+
+		return m.cfg.SendToPeer(data.nextNodeID, lnwire.NewOnionMessage(
+			nextBlinding, processed.next.encode(),
+		))
+	}
+
+	if processed.next != nil {
+		return fmt.Errorf("final onion message hop has next packet")
+	}
+
+	m.deliver(data.pathID, replyPath, payloads)
+
+	return nil
+}
+
+// deliver hands each application payload of a message sent to us to its
+// registered handler.
+func (m *Messenger) deliver(pathID []byte, replyPath *BlindedPath,
+	payloads []tlv.Record) {
+
+	m.handlerMtx.RLock()
+	defer m.handlerMtx.RUnlock()
+
+	for _, payload := range payloads {
+		handler, ok := m.handlers[payload.Type]
+		if !ok {
+			log.Debugf("No handler for onion message type %d",
+				payload.Type)
+			continue
+		}
+
+		msg := &ReceivedMessage{
+			Type:      payload.Type,
+			Payload:   payload.Value,
+			PathID:    pathID,
+			ReplyPath: replyPath,
+		}
+
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			handler(msg)
+		}()
+	}
+}
+
+// findPath returns the route to the target node.
+func (m *Messenger) findPath(target *btcec.PublicKey) ([]*btcec.PublicKey,
+	error) {
+
+	if m.cfg.IsPeer != nil && m.cfg.IsPeer(target) {
+		return []*btcec.PublicKey{target}, nil
+	}
+	if m.cfg.FindPath == nil {
+		return nil, ErrNoPath
+	}
+
+	return m.cfg.FindPath(target)
+}
+
+// SendMessage sends the application payload of the given type to the target
+// node, including a reply path back to us. The path id of the reply path,
+// which is returned along with any reply we receive over it, allows replies
+// to be matched to the message they answer. If the passed path id is nil, a
+// random one is used.
+func (m *Messenger) SendMessage(target *btcec.PublicKey, msgType uint64,
+	payload, pathID []byte) error {
+
+	route, err := m.findPath(target)
+	if err != nil {
+		return err
+	}
+	if len(route) == 0 || len(route) > MaxPathLength {
+		return ErrNoPath
+	}
+
+	sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return err
+	}
+
+	// As we construct the path to the target ourselves, the target needs
+	// no routing data beyond the path itself.
+	path, err := newBlindedPath(sessionKey, route, &routingData{})
+	if err != nil {
+		return err
+	}
+
+	return m.sendOverPath(path, route, msgType, payload, pathID)
+}
+
+// SendReply sends the application payload of the given type over the
+// blinded path provided by the sender of a message we received, including a
+// reply path back to us.
+func (m *Messenger) SendReply(path *BlindedPath, msgType uint64,
+	payload []byte) error {
+
+	if len(path.Hops) == 0 {
+		return ErrNoBlindedHops
+	}
+
+	// If the introduction node isn't one of our peers, we'll need to
+	// route to it first. We do so by creating our own blinded path to the
+	// introduction node, which overrides the blinding point it receives
+	// with the one from the reply path.
+	var route []*btcec.PublicKey
+	if !path.IntroductionNode.IsEqual(m.nodeID) {
+		var err error
+		route, err = m.findPath(path.IntroductionNode)
+		if err != nil {
+			return err
+		}
+	}
+	if len(route) > 1 {
+		sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			return err
+		}
+
+		prefix, err := newBlindedPath(
+			sessionKey, route[:len(route)-1], &routingData{
+				nextNodeID:           path.IntroductionNode,
+				nextBlindingOverride: path.BlindingPoint,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		path = &BlindedPath{
+			IntroductionNode: prefix.IntroductionNode,
+			BlindingPoint:    prefix.BlindingPoint,
+			Hops:             append(prefix.Hops, path.Hops...),
+		}
+	}
+
+	return m.sendOverPath(path, route, msgType, payload, nil)
+}
+
+// sendOverPath constructs an onion message over the blinded path, which
+// reaches its destination via the unblinded route, and sends it to the
+// introduction node. The reply path included within the message carries the
+// passed path id, or a random one if nil.
+func (m *Messenger) sendOverPath(path *BlindedPath,
+	route []*btcec.PublicKey, msgType uint64, payload,
+	pathID []byte) error {
+
+	if msgType < MinApplicationType {
+		return fmt.Errorf("onion message type %d is reserved", msgType)
+	}
+
+	replyPath, err := m.newReplyPath(route, pathID)
+	if err != nil {
+		return err
+	}
+	replyBytes, err := serializeBlindedPath(replyPath)
+	if err != nil {
+		return err
+	}
+
+	// With the reply path created, we'll now assemble the payload for
+	// each hop of the blinded path. Only the final hop receives the
+	// reply path and the application payload.
+	numHops := len(path.Hops)
+	hops := make([]*btcec.PublicKey, numHops)
+	payloads := make([][]byte, numHops)
+	for i, hop := range path.Hops {
+		hops[i] = hop.BlindedNodeID
+
+		var records []tlv.Record
+		if i == numHops-1 {
+			records = append(records, tlv.Record{
+				Type:  typeReplyPath,
+				Value: replyBytes,
+			})
+		}
+		records = append(records, tlv.Record{
+			Type:  typeEncryptedData,
+			Value: hop.EncryptedData,
+		})
+		if i == numHops-1 {
+			records = append(records, tlv.Record{
+				Type:  msgType,
+				Value: payload,
+			})
+		}
+
+		payloads[i], err = hopPayload(records)
+		if err != nil {
+			return err
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return err
+	}
+	pkt, err := newPacket(sessionKey, hops, payloads, nil)
+	if err != nil {
+		return err
+	}
+
+	msg := lnwire.NewOnionMessage(path.BlindingPoint, pkt.encode())
+
+	// If we're the introduction node of the path, which happens when
+	// replying to a path created by one of our peers, we'll process the
+	// message ourselves so it's forwarded along.
+	if path.IntroductionNode.IsEqual(m.nodeID) {
+		return m.HandleMessage(m.nodeID, msg)
+	}
+
+	return m.cfg.SendToPeer(path.IntroductionNode, msg)
+}
+
+// newReplyPath creates a blinded path back to us along the reverse of the
+// route to the destination, carrying the passed path id. If the path id is
+// nil, a random one is generated.
+func (m *Messenger) newReplyPath(route []*btcec.PublicKey,
+	pathID []byte) (*BlindedPath, error) {
+
+	nodes := make([]*btcec.PublicKey, 0, len(route))
+	for i := len(route) - 2; i >= 0; i-- {
+		nodes = append(nodes, route[i])
+	}
+	nodes = append(nodes, m.nodeID)
+
+	if pathID == nil {
+		pathID = make([]byte, pathIDSize)
+		if _, err := rand.Read(pathID); err != nil {
+			return nil, err
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	return newBlindedPath(sessionKey, nodes, &routingData{pathID: pathID})
+}
@@ -0,0 +1,261 @@
+package onionmsg
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// testNetwork delivers onion messages between a set of in-memory messengers.
+type testNetwork struct {
+	messengers map[[33]byte]*Messenger
+	links      map[[33]byte]map[[33]byte]struct{}
+}
+
+func nodeKey(pub *btcec.PublicKey) [33]byte {
+	var key [33]byte
+	copy(key[:], pub.SerializeCompressed())
+	return key
+}
+
+// addNode creates a messenger for a new node within the network.
+func (n *testNetwork) addNode(t *testing.T, rate float64,
+	burst int) *Messenger {
+
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	self := priv.PubKey()
+
+	m := NewMessenger(&Config{
+		NodeKey: priv,
+		SendToPeer: func(target *btcec.PublicKey,
+			msg lnwire.Message) error {
+
+			if !n.connected(self, target) {
+				return fmt.Errorf("not connected")
+			}
+			peer := n.messengers[nodeKey(target)]
+			return peer.HandleMessage(
+				self, msg.(*lnwire.OnionMessage),
+			)
+		},
+		IsPeer: func(node *btcec.PublicKey) bool {
+			return n.connected(self, node)
+		},
+		FindPath: func(target *btcec.PublicKey) ([]*btcec.PublicKey,
+			error) {
+
+			return n.findPath(self, target)
+		},
+		PeerRate:  rate,
+		PeerBurst: burst,
+	})
+	n.messengers[nodeKey(self)] = m
+
+	return m
+}
+
+// connect links the two nodes together.
+func (n *testNetwork) connect(a, b *Messenger) {
+	for _, pair := range [][2]*Messenger{{a, b}, {b, a}} {
+		from := nodeKey(pair[0].nodeID)
+		if n.links[from] == nil {
+			n.links[from] = make(map[[33]byte]struct{})
+		}
+		n.links[from][nodeKey(pair[1].nodeID)] = struct{}{}
+	}
+}
+
+func (n *testNetwork) connected(a, b *btcec.PublicKey) bool {
+	_, ok := n.links[nodeKey(a)][nodeKey(b)]
+	return ok
+}
+
+// findPath performs a breadth first search for the target.
+func (n *testNetwork) findPath(source,
+	target *btcec.PublicKey) ([]*btcec.PublicKey, error) {
+
+	prev := map[[33]byte][33]byte{nodeKey(source): {}}
+	queue := [][33]byte{nodeKey(source)}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		if node == nodeKey(target) {
+			var path []*btcec.PublicKey
+			for node != nodeKey(source) {
+				pub, _ := btcec.ParsePubKey(
+					node[:], btcec.S256(),
+				)
+				path = append([]*btcec.PublicKey{pub}, path...)
+				node = prev[node]
+			}
+			return path, nil
+		}
+
+		for next := range n.links[node] {
+			if _, ok := prev[next]; ok {
+				continue
+			}
+			prev[next] = node
+			queue = append(queue, next)
+		}
+	}
+
+	return nil, ErrNoPath
+}
+
+func newTestNetwork() *testNetwork {
+	return &testNetwork{
+		messengers: make(map[[33]byte]*Messenger),
+		links:      make(map[[33]byte]map[[33]byte]struct{}),
+	}
+}
+
+// TestOnionMessageRoundTrip asserts that an onion message can be sent
+// through an intermediate node, and that the recipient can reply using the
+// included reply path.
+func TestOnionMessageRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	network := newTestNetwork()
+	alice := network.addNode(t, 0, 0)
+	bob := network.addNode(t, 0, 0)
+	carol := network.addNode(t, 0, 0)
+	network.connect(alice, bob)
+	network.connect(bob, carol)
+	defer alice.Stop()
+	defer bob.Stop()
+	defer carol.Stop()
+
+	const (
+		pingType = 65
+		pongType = 67
+	)
+	ping := []byte("ping")
+	pong := []byte("pong")
+
+	// Carol will reply to each ping with a pong over the reply path.
+	carolErr := make(chan error, 1)
+	err := carol.RegisterHandler(pingType, func(msg *ReceivedMessage) {
+		if !bytes.Equal(msg.Payload, ping) {
+			carolErr <- fmt.Errorf("unexpected payload: %x",
+				msg.Payload)
+			return
+		}
+		if msg.ReplyPath == nil {
+			carolErr <- fmt.Errorf("no reply path")
+			return
+		}
+		carolErr <- carol.SendReply(msg.ReplyPath, pongType, pong)
+	})
+	if err != nil {
+		t.Fatalf("unable to register handler: %v", err)
+	}
+	err = carol.RegisterHandler(pingType, nil)
+	if err != ErrHandlerExists {
+		t.Fatalf("expected ErrHandlerExists, got %v", err)
+	}
+
+	received := make(chan *ReceivedMessage, 1)
+	err = alice.RegisterHandler(pongType, func(msg *ReceivedMessage) {
+		received <- msg
+	})
+	if err != nil {
+		t.Fatalf("unable to register handler: %v", err)
+	}
+
+	// Alice isn't connected to Carol, so the message must be routed
+	// through Bob. She'll include a path id in her reply path, so she can
+	// match Carol's reply to her message.
+	pathID := bytes.Repeat([]byte{0x01}, pathIDSize)
+	err = alice.SendMessage(carol.nodeID, pingType, ping, pathID)
+	if err != nil {
+		t.Fatalf("unable to send message: %v", err)
+	}
+
+	select {
+	case err := <-carolErr:
+		if err != nil {
+			t.Fatalf("carol unable to reply: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("carol didn't receive message")
+	}
+
+	select {
+	case msg := <-received:
+		if !bytes.Equal(msg.Payload, pong) {
+			t.Fatalf("unexpected payload: %x", msg.Payload)
+		}
+		if !bytes.Equal(msg.PathID, pathID) {
+			t.Fatalf("reply not received over our reply path")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("alice didn't receive reply")
+	}
+}
+
+// TestOnionMessageRateLimit asserts that messages from a peer exceeding its
+// rate limit are dropped.
+func TestOnionMessageRateLimit(t *testing.T) {
+	t.Parallel()
+
+	network := newTestNetwork()
+	alice := network.addNode(t, 0.001, 1)
+	bob := network.addNode(t, 0.001, 1)
+	network.connect(alice, bob)
+	defer alice.Stop()
+	defer bob.Stop()
+
+	if err := alice.SendMessage(bob.nodeID, 65, nil, nil); err != nil {
+		t.Fatalf("unable to send message: %v", err)
+	}
+	err := alice.SendMessage(bob.nodeID, 65, nil, nil)
+	if err != ErrRateLimited {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+
+	// Once the peer disconnects, its rate limit should be reset.
+	bob.PeerDisconnected(alice.nodeID)
+	if err := alice.SendMessage(bob.nodeID, 65, nil, nil); err != nil {
+		t.Fatalf("unable to send message: %v", err)
+	}
+}
+
+// TestOnionMessageTampered asserts that a modified onion message is
+// rejected.
+func TestOnionMessageTampered(t *testing.T) {
+	t.Parallel()
+
+	network := newTestNetwork()
+	alice := network.addNode(t, 0, 0)
+	bob := network.addNode(t, 0, 0)
+	network.connect(alice, bob)
+	defer alice.Stop()
+	defer bob.Stop()
+
+	var sent *lnwire.OnionMessage
+	alice.cfg.SendToPeer = func(_ *btcec.PublicKey,
+		msg lnwire.Message) error {
+
+		sent = msg.(*lnwire.OnionMessage)
+		return nil
+	}
+	err := alice.SendMessage(bob.nodeID, 65, []byte("hi"), nil)
+	if err != nil {
+		t.Fatalf("unable to send message: %v", err)
+	}
+
+	sent.OnionBlob[100] ^= 0x01
+	err = bob.HandleMessage(alice.nodeID, sent)
+	if err != ErrInvalidHMAC {
+		t.Fatalf("expected ErrInvalidHMAC, got %v", err)
+	}
+}
@@ -0,0 +1,364 @@
+package onionmsg
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/aead/chacha20"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// packetVersion is the only onion packet version we understand.
+	packetVersion = 0
+
+	// routingInfoSize is the size of the encrypted routing information
+	// within each onion packet.
+	routingInfoSize = 1300
+
+	// hmacSize is the size of the HMAC that authenticates each layer of
+	// the onion.
+	hmacSize = 32
+
+	// PacketSize is the size of a serialized onion message packet: the
+	// version byte, the ephemeral key, the routing information and the
+	// HMAC.
+	PacketSize = 1 + 33 + routingInfoSize + hmacSize
+)
+
+var (
+	// ErrInvalidHMAC is returned when the HMAC of an onion packet doesn't
+	// match its contents, indicating it was tampered with or not meant
+	// for us.
+	ErrInvalidHMAC = errors.New("onion packet has invalid hmac")
+
+	// ErrPayloadTooLarge is returned when the hop payloads of a route
+	// don't fit within a single onion packet.
+	ErrPayloadTooLarge = errors.New("hop payloads exceed onion packet " +
+		"size")
+)
+
+// packet is a variable payload onion packet, as described in BOLT-04. Each
+// hop's payload is prefixed by its BigSize length and followed by the HMAC
+// for the next hop.
+type packet struct {
+	version      byte
+	ephemeralKey *btcec.PublicKey
+	routingInfo  [routingInfoSize]byte
+	hmac         [hmacSize]byte
+}
+
+// encode serializes the onion packet.
+func (p *packet) encode() []byte {
+	b := make([]byte, 0, PacketSize)
+	b = append(b, p.version)
+	b = append(b, p.ephemeralKey.SerializeCompressed()...)
+	b = append(b, p.routingInfo[:]...)
+	b = append(b, p.hmac[:]...)
+
+	return b
+}
+
+// decodePacket parses a serialized onion packet.
+func decodePacket(b []byte) (*packet, error) {
+	if len(b) != PacketSize {
+		return nil, fmt.Errorf("invalid onion packet size: %d", len(b))
+	}
+
+	p := &packet{version: b[0]}
+	if p.version != packetVersion {
+		return nil, fmt.Errorf("unknown onion packet version: %d",
+			p.version)
+	}
+
+	var err error
+	p.ephemeralKey, err = btcec.ParsePubKey(b[1:34], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	copy(p.routingInfo[:], b[34:34+routingInfoSize])
+	copy(p.hmac[:], b[34+routingInfoSize:])
+
+	return p, nil
+}
+
+// ecdh computes the shared secret between the private and public key, which
+// is the sha256 of the compressed shared point.
+func ecdh(priv *btcec.PrivateKey, pub *btcec.PublicKey) [32]byte {
+	x, y := btcec.S256().ScalarMult(pub.X, pub.Y, priv.D.Bytes())
+	shared := &btcec.PublicKey{Curve: btcec.S256(), X: x, Y: y}
+
+	return sha256.Sum256(shared.SerializeCompressed())
+}
+
+// generateKey derives a key of the given type from the shared secret.
+func generateKey(keyType string, secret []byte) [32]byte {
+	var key [32]byte
+	mac := hmac.New(sha256.New, []byte(keyType))
+	mac.Write(secret)
+	copy(key[:], mac.Sum(nil))
+
+	return key
+}
+
+// cipherStream returns numBytes of the ChaCha20 key stream for the key.
+func cipherStream(key [32]byte, numBytes int) []byte {
+	var nonce [8]byte
+	cipher, err := chacha20.NewCipher(nonce[:], key[:])
+	if err != nil {
+		panic(err)
+	}
+
+	stream := make([]byte, numBytes)
+	cipher.XORKeyStream(stream, stream)
+
+	return stream
+}
+
+// xor sets dst to the xor of a and b, up to the shortest of the three.
+func xor(dst, a, b []byte) {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	if len(dst) < n {
+		n = len(dst)
+	}
+	for i := 0; i < n; i++ {
+		dst[i] = a[i] ^ b[i]
+	}
+}
+
+// computeMAC returns the HMAC-SHA256 of the routing info followed by the
+// associated data under the key.
+func computeMAC(key [32]byte, routingInfo, assocData []byte) [hmacSize]byte {
+	var sum [hmacSize]byte
+	mac := hmac.New(sha256.New, key[:])
+	mac.Write(routingInfo)
+	mac.Write(assocData)
+	copy(sum[:], mac.Sum(nil))
+
+	return sum
+}
+
+// blindingFactor returns the factor by which the ephemeral key is tweaked
+// after each hop.
+func blindingFactor(ephemeral *btcec.PublicKey, secret [32]byte) [32]byte {
+	h := sha256.New()
+	h.Write(ephemeral.SerializeCompressed())
+	h.Write(secret[:])
+
+	var factor [32]byte
+	copy(factor[:], h.Sum(nil))
+
+	return factor
+}
+
+// multPubKey multiplies the public key by the scalar.
+func multPubKey(pub *btcec.PublicKey, scalar []byte) *btcec.PublicKey {
+	x, y := btcec.S256().ScalarMult(pub.X, pub.Y, scalar)
+	return &btcec.PublicKey{Curve: btcec.S256(), X: x, Y: y}
+}
+
+// multPrivKey multiplies the private key by the scalar modulo the curve
+// order.
+func multPrivKey(priv *btcec.PrivateKey, scalar []byte) *btcec.PrivateKey {
+	d := new(big.Int).Mul(priv.D, new(big.Int).SetBytes(scalar))
+	d.Mod(d, btcec.S256().N)
+
+	key, _ := btcec.PrivKeyFromBytes(btcec.S256(), d.Bytes())
+	return key
+}
+
+// hopPayload serializes a hop's TLV payload along with its BigSize length
+// prefix.
+func hopPayload(records []tlv.Record) ([]byte, error) {
+	stream, err := tlv.EncodeStream(records)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := tlv.WriteBigSize(&b, uint64(len(stream))); err != nil {
+		return nil, err
+	}
+	b.Write(stream)
+
+	return b.Bytes(), nil
+}
+
+// newPacket constructs an onion packet that delivers each payload to the
+// corresponding hop. The HMAC of every layer commits to the associated data,
+// which onion messages leave empty.
+func newPacket(sessionKey *btcec.PrivateKey, hops []*btcec.PublicKey,
+	payloads [][]byte, assocData []byte) (*packet, error) {
+
+	numHops := len(hops)
+	if numHops == 0 || numHops != len(payloads) {
+		return nil, fmt.Errorf("invalid route")
+	}
+
+	var totalSize int
+	for _, payload := range payloads {
+		totalSize += len(payload) + hmacSize
+	}
+	if totalSize > routingInfoSize {
+		return nil, ErrPayloadTooLarge
+	}
+
+	// First, we'll derive the shared secret with each hop, tweaking our
+	// ephemeral key as we go.
+	secrets := make([][32]byte, numHops)
+	ephemeralPriv := sessionKey
+	for i, hop := range hops {
+		secrets[i] = ecdh(ephemeralPriv, hop)
+
+		factor := blindingFactor(ephemeralPriv.PubKey(), secrets[i])
+		ephemeralPriv = multPrivKey(ephemeralPriv, factor[:])
+	}
+
+	// Next, we'll generate the filler that the hops will reveal as they
+	// shift their own payload out of the routing info, so that the final
+	// HMACs can be computed.
+	filler := generateFiller(secrets, payloads)
+
+	// We'll initialize the routing info with pseudo-random bytes, so the
+	// unused portion of the packet reveals nothing about the route length.
+	var routingInfo [routingInfoSize]byte
+	padKey := generateKey("pad", sessionKey.Serialize())
+	copy(routingInfo[:], cipherStream(padKey, routingInfoSize))
+
+	// Now we'll wrap each layer of the onion from the final hop
+	// backwards.
+	var nextHMAC [hmacSize]byte
+	for i := numHops - 1; i >= 0; i-- {
+		rhoKey := generateKey("rho", secrets[i][:])
+		muKey := generateKey("mu", secrets[i][:])
+
+		shift := len(payloads[i]) + hmacSize
+		copy(routingInfo[shift:], routingInfo[:routingInfoSize-shift])
+		copy(routingInfo[:], payloads[i])
+		copy(routingInfo[len(payloads[i]):], nextHMAC[:])
+
+		xor(
+			routingInfo[:], routingInfo[:],
+			cipherStream(rhoKey, routingInfoSize),
+		)
+
+		if i == numHops-1 {
+			copy(routingInfo[routingInfoSize-len(filler):], filler)
+		}
+
+		nextHMAC = computeMAC(muKey, routingInfo[:], assocData)
+	}
+
+	return &packet{
+		version:      packetVersion,
+		ephemeralKey: sessionKey.PubKey(),
+		routingInfo:  routingInfo,
+		hmac:         nextHMAC,
+	}, nil
+}
+
+// generateFiller computes the filler bytes appended to the routing info by
+// each hop but the last as it decrypts its layer.
+func generateFiller(secrets [][32]byte, payloads [][]byte) []byte {
+	numHops := len(secrets)
+
+	var fillerSize int
+	for _, payload := range payloads[:numHops-1] {
+		fillerSize += len(payload) + hmacSize
+	}
+	filler := make([]byte, fillerSize)
+
+	fillerStart := routingInfoSize
+	for i := 0; i < numHops-1; i++ {
+		hopSize := len(payloads[i]) + hmacSize
+		if i > 0 {
+			fillerStart -= len(payloads[i-1]) + hmacSize
+		}
+		fillerEnd := routingInfoSize + hopSize
+
+		rhoKey := generateKey("rho", secrets[i][:])
+		stream := cipherStream(rhoKey, 2*routingInfoSize)
+		xor(filler, filler, stream[fillerStart:fillerEnd])
+	}
+
+	return filler
+}
+
+// processedPacket is the result of peeling a layer off an onion packet.
+type processedPacket struct {
+	// payload is the TLV payload meant for us.
+	payload []byte
+
+	// next is the packet to forward to the next hop, or nil if we're the
+	// final hop.
+	next *packet
+}
+
+// processPacket peels a layer off the onion packet using the passed private
+// key, returning our payload and the packet for the next hop. The associated
+// data must match the one the packet was constructed with.
+func processPacket(priv *btcec.PrivateKey, p *packet,
+	assocData []byte) (*processedPacket, error) {
+
+	secret := ecdh(priv, p.ephemeralKey)
+
+	muKey := generateKey("mu", secret[:])
+	expectedMAC := computeMAC(muKey, p.routingInfo[:], assocData)
+	if !hmac.Equal(expectedMAC[:], p.hmac[:]) {
+		return nil, ErrInvalidHMAC
+	}
+
+	rhoKey := generateKey("rho", secret[:])
+	extended := make([]byte, 2*routingInfoSize)
+	copy(extended, p.routingInfo[:])
+	xor(extended, extended, cipherStream(rhoKey, 2*routingInfoSize))
+
+	r := bytes.NewReader(extended)
+	payloadLen, err := tlv.ReadBigSize(r)
+	if err != nil {
+		return nil, err
+	}
+	prefixLen := len(extended) - r.Len()
+	if payloadLen == 0 ||
+		uint64(prefixLen)+payloadLen+hmacSize > routingInfoSize {
+
+		return nil, fmt.Errorf("invalid hop payload length: %d",
+			payloadLen)
+	}
+
+	payload := make([]byte, payloadLen)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+
+	var nextHMAC [hmacSize]byte
+	if _, err := io.ReadFull(r, nextHMAC[:]); err != nil {
+		return nil, err
+	}
+
+	processed := &processedPacket{payload: payload}
+	if nextHMAC == [hmacSize]byte{} {
+		return processed, nil
+	}
+
+	hopSize := prefixLen + int(payloadLen) + hmacSize
+	factor := blindingFactor(p.ephemeralKey, secret)
+
+	processed.next = &packet{
+		version:      packetVersion,
+		ephemeralKey: multPubKey(p.ephemeralKey, factor[:]),
+		hmac:         nextHMAC,
+	}
+	copy(processed.next.routingInfo[:], extended[hopSize:])
+
+	return processed, nil
+}
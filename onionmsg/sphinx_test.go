@@ -0,0 +1,349 @@
+package onionmsg
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// onionVectorsPath is the path of the BOLT-4 test vectors for onion
+	// packets carrying variable size payloads.
+	onionVectorsPath = "testdata/onion-test.json"
+
+	// blindedOnionVectorsPath is the path of the BOLT-4 test vectors for
+	// onion packets sent to a blinded route.
+	blindedOnionVectorsPath = "testdata/onion-route-blinding-test.json"
+
+	// routeBlindingVectorsPath is the path of the BOLT-4 test vectors for
+	// creating and unblinding blinded routes.
+	routeBlindingVectorsPath = "testdata/route-blinding-test.json"
+)
+
+// vectorHop is a hop of an onion constructed within the test vectors.
+type vectorHop struct {
+	PubKey  string `json:"pubkey"`
+	Payload string `json:"payload"`
+}
+
+// onionVectors are the test vectors of onion-test.json.
+type onionVectors struct {
+	Generate struct {
+		SessionKey string      `json:"session_key"`
+		AssocData  string      `json:"associated_data"`
+		Hops       []vectorHop `json:"hops"`
+	} `json:"generate"`
+	Onion  string   `json:"onion"`
+	Decode []string `json:"decode"`
+}
+
+// blindedOnionVectors are the test vectors of
+// onion-route-blinding-test.json.
+type blindedOnionVectors struct {
+	Generate struct {
+		SessionKey string `json:"session_key"`
+		AssocData  string `json:"associated_data"`
+		FullRoute  struct {
+			Hops []vectorHop `json:"hops"`
+		} `json:"full_route"`
+		Onion string `json:"onion"`
+	} `json:"generate"`
+	Decrypt struct {
+		Hops []struct {
+			Onion        string `json:"onion"`
+			NodePrivKey  string `json:"node_privkey"`
+			NextBlinding string `json:"next_blinding"`
+		} `json:"hops"`
+	} `json:"decrypt"`
+}
+
+// routeBlindingVectors are the test vectors of route-blinding-test.json.
+type routeBlindingVectors struct {
+	Generate struct {
+		Hops []struct {
+			NodeID           string `json:"node_id"`
+			EncodedTLVs      string `json:"encoded_tlvs"`
+			EphemeralPrivKey string `json:"ephemeral_privkey"`
+			EphemeralPubKey  string `json:"ephemeral_pubkey"`
+			SharedSecret     string `json:"shared_secret"`
+			Rho              string `json:"rho"`
+			EncryptedData    string `json:"encrypted_data"`
+			BlindedNodeID    string `json:"blinded_node_id"`
+		} `json:"hops"`
+	} `json:"generate"`
+	Route struct {
+		Hops []struct {
+			EncryptedData string `json:"encrypted_data"`
+		} `json:"hops"`
+	} `json:"route"`
+	Unblind struct {
+		Hops []struct {
+			NodePrivKey         string `json:"node_privkey"`
+			EphemeralPubKey     string `json:"ephemeral_pubkey"`
+			BlindedPrivKey      string `json:"blinded_privkey"`
+			DecryptedData       string `json:"decrypted_data"`
+			NextEphemeralPubKey string `json:"next_ephemeral_pubkey"`
+		} `json:"hops"`
+	} `json:"unblind"`
+}
+
+func loadVectors(t *testing.T, path string, vectors interface{}) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read test vectors: %v", err)
+	}
+	if err := json.Unmarshal(b, vectors); err != nil {
+		t.Fatalf("unable to parse test vectors: %v", err)
+	}
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("unable to decode hex: %v", err)
+	}
+	return b
+}
+
+func parsePrivKey(t *testing.T, s string) *btcec.PrivateKey {
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), decodeHex(t, s))
+	return priv
+}
+
+func parsePubKey(t *testing.T, s string) *btcec.PublicKey {
+	pub, err := btcec.ParsePubKey(decodeHex(t, s), btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to parse public key: %v", err)
+	}
+	return pub
+}
+
+// hopPayloads returns the public keys and length prefixed payloads of the
+// hops of a test vector onion.
+func hopPayloads(t *testing.T, hops []vectorHop) ([]*btcec.PublicKey,
+	[][]byte) {
+
+	pubKeys := make([]*btcec.PublicKey, 0, len(hops))
+	payloads := make([][]byte, 0, len(hops))
+	for _, hop := range hops {
+		pubKeys = append(pubKeys, parsePubKey(t, hop.PubKey))
+		payloads = append(payloads, decodeHex(t, hop.Payload))
+	}
+
+	return pubKeys, payloads
+}
+
+// stripLength removes the BigSize length prefix of a hop payload.
+func stripLength(t *testing.T, payload []byte) []byte {
+	r := bytes.NewReader(payload)
+	if _, err := tlv.ReadBigSize(r); err != nil {
+		t.Fatalf("unable to read payload length: %v", err)
+	}
+	return payload[len(payload)-r.Len():]
+}
+
+// TestOnionVectors asserts that the onion packets we construct and process
+// match the BOLT-4 test vectors, which covers the filler generation, the
+// layering of variable size payloads and the HMACs over the associated
+// data.
+func TestOnionVectors(t *testing.T) {
+	t.Parallel()
+
+	var vectors onionVectors
+	loadVectors(t, onionVectorsPath, &vectors)
+
+	gen := vectors.Generate
+	assocData := decodeHex(t, gen.AssocData)
+	hops, payloads := hopPayloads(t, gen.Hops)
+
+	pkt, err := newPacket(
+		parsePrivKey(t, gen.SessionKey), hops, payloads, assocData,
+	)
+	if err != nil {
+		t.Fatalf("unable to create packet: %v", err)
+	}
+	if hex.EncodeToString(pkt.encode()) != vectors.Onion {
+		t.Fatalf("packet mismatch: expected %v, got %x",
+			vectors.Onion, pkt.encode())
+	}
+
+	// Each hop should be able to peel its layer off the onion, revealing
+	// its payload and the packet for the next hop.
+	if len(vectors.Decode) != len(hops) {
+		t.Fatalf("expected %d keys, got %d", len(hops),
+			len(vectors.Decode))
+	}
+	for i, key := range vectors.Decode {
+		processed, err := processPacket(
+			parsePrivKey(t, key), pkt, assocData,
+		)
+		if err != nil {
+			t.Fatalf("hop %d unable to process packet: %v", i, err)
+		}
+
+		expected := stripLength(t, payloads[i])
+		if !bytes.Equal(processed.payload, expected) {
+			t.Fatalf("hop %d payload mismatch: expected %x, got %x",
+				i, expected, processed.payload)
+		}
+
+		final := i == len(hops)-1
+		if final != (processed.next == nil) {
+			t.Fatalf("hop %d: expected final=%v", i, final)
+		}
+		pkt = processed.next
+	}
+
+	// Tampering with the associated data must invalidate the onion.
+	pkt, err = decodePacket(decodeHex(t, vectors.Onion))
+	if err != nil {
+		t.Fatalf("unable to decode packet: %v", err)
+	}
+	_, err = processPacket(parsePrivKey(t, vectors.Decode[0]), pkt, nil)
+	if err != ErrInvalidHMAC {
+		t.Fatalf("expected ErrInvalidHMAC, got %v", err)
+	}
+}
+
+// TestBlindedOnionVectors asserts that onions sent to a blinded route match
+// the BOLT-4 test vectors, with the hops within the blinded route peeling
+// their layer using their blinded private key.
+func TestBlindedOnionVectors(t *testing.T) {
+	t.Parallel()
+
+	var vectors blindedOnionVectors
+	loadVectors(t, blindedOnionVectorsPath, &vectors)
+
+	gen := vectors.Generate
+	assocData := decodeHex(t, gen.AssocData)
+	hops, payloads := hopPayloads(t, gen.FullRoute.Hops)
+
+	pkt, err := newPacket(
+		parsePrivKey(t, gen.SessionKey), hops, payloads, assocData,
+	)
+	if err != nil {
+		t.Fatalf("unable to create packet: %v", err)
+	}
+	if hex.EncodeToString(pkt.encode()) != gen.Onion {
+		t.Fatalf("packet mismatch: expected %v, got %x", gen.Onion,
+			pkt.encode())
+	}
+
+	decrypt := vectors.Decrypt.Hops
+	if len(decrypt) != len(hops) {
+		t.Fatalf("expected %d hops, got %d", len(hops), len(decrypt))
+	}
+	for i, hop := range decrypt {
+		if hex.EncodeToString(pkt.encode()) != hop.Onion {
+			t.Fatalf("hop %d packet mismatch: expected %v, got %x",
+				i, hop.Onion, pkt.encode())
+		}
+
+		// The first hop isn't part of the blinded route, and the
+		// second is its introduction node, so both use their node key
+		// directly. All following hops unblind their key using the
+		// blinding point handed to them by the previous hop.
+		key := parsePrivKey(t, hop.NodePrivKey)
+		if i > 1 {
+			blindingPoint := parsePubKey(t, decrypt[i-1].NextBlinding)
+			_, key = unblind(key, blindingPoint)
+		}
+
+		processed, err := processPacket(key, pkt, assocData)
+		if err != nil {
+			t.Fatalf("hop %d unable to process packet: %v", i, err)
+		}
+
+		expected := stripLength(t, payloads[i])
+		if !bytes.Equal(processed.payload, expected) {
+			t.Fatalf("hop %d payload mismatch: expected %x, got %x",
+				i, expected, processed.payload)
+		}
+		pkt = processed.next
+	}
+	if pkt != nil {
+		t.Fatalf("final hop returned a packet to forward")
+	}
+}
+
+// TestRouteBlindingVectors asserts that the shared secrets, blinded node ids,
+// encrypted data and blinding points of blinded routes match the BOLT-4
+// route blinding test vectors.
+func TestRouteBlindingVectors(t *testing.T) {
+	t.Parallel()
+
+	var vectors routeBlindingVectors
+	loadVectors(t, routeBlindingVectorsPath, &vectors)
+
+	for i, hop := range vectors.Generate.Hops {
+		ephemeralPriv := parsePrivKey(t, hop.EphemeralPrivKey)
+		ephemeralPub := ephemeralPriv.PubKey().SerializeCompressed()
+		if hex.EncodeToString(ephemeralPub) != hop.EphemeralPubKey {
+			t.Fatalf("hop %d ephemeral key mismatch", i)
+		}
+
+		secret := ecdh(ephemeralPriv, parsePubKey(t, hop.NodeID))
+		if hex.EncodeToString(secret[:]) != hop.SharedSecret {
+			t.Fatalf("hop %d shared secret mismatch", i)
+		}
+
+		rho := generateKey("rho", secret[:])
+		if hex.EncodeToString(rho[:]) != hop.Rho {
+			t.Fatalf("hop %d rho mismatch", i)
+		}
+
+		encrypted, err := encryptData(
+			secret, decodeHex(t, hop.EncodedTLVs),
+		)
+		if err != nil {
+			t.Fatalf("hop %d unable to encrypt data: %v", i, err)
+		}
+		if hex.EncodeToString(encrypted) != hop.EncryptedData {
+			t.Fatalf("hop %d encrypted data mismatch: expected "+
+				"%v, got %x", i, hop.EncryptedData, encrypted)
+		}
+
+		blindedKey := generateKey("blinded_node_id", secret[:])
+		blindedNodeID := multPubKey(
+			parsePubKey(t, hop.NodeID), blindedKey[:],
+		).SerializeCompressed()
+		if hex.EncodeToString(blindedNodeID) != hop.BlindedNodeID {
+			t.Fatalf("hop %d blinded node id mismatch", i)
+		}
+	}
+
+	routeHops := vectors.Route.Hops
+	for i, hop := range vectors.Unblind.Hops {
+		blindingPoint := parsePubKey(t, hop.EphemeralPubKey)
+		secret, blindedPriv := unblind(
+			parsePrivKey(t, hop.NodePrivKey), blindingPoint,
+		)
+		if hex.EncodeToString(blindedPriv.Serialize()) !=
+			hop.BlindedPrivKey {
+
+			t.Fatalf("hop %d blinded private key mismatch", i)
+		}
+
+		decrypted, err := decryptData(
+			secret, decodeHex(t, routeHops[i].EncryptedData),
+		)
+		if err != nil {
+			t.Fatalf("hop %d unable to decrypt data: %v", i, err)
+		}
+		if hex.EncodeToString(decrypted) != hop.DecryptedData {
+			t.Fatalf("hop %d decrypted data mismatch", i)
+		}
+
+		next := nextBlindingPoint(blindingPoint, secret)
+		if hex.EncodeToString(next.SerializeCompressed()) !=
+			hop.NextEphemeralPubKey {
+
+			t.Fatalf("hop %d next blinding point mismatch", i)
+		}
+	}
+}
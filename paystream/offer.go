@@ -0,0 +1,40 @@
+package paystream
+
+import (
+	"errors"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/offers"
+)
+
+// ErrFixedAmountOffer is returned when attempting to stream payments to an
+// offer that specifies its own amount.
+var ErrFixedAmountOffer = errors.New("offer specifies a fixed amount")
+
+// NewOfferPayFunc returns a PayFunc that streams payments to the issuer of
+// an offer. For every shard, a fresh invoice for the amount of the shard is
+// requested from the issuer, so the offer must leave the amount to the payer.
+// The fee limit applies to each shard separately.
+func NewOfferPayFunc(mgr *offers.Manager, offer *offers.Offer,
+	feeLimit lnwire.MilliSatoshi) (PayFunc, error) {
+
+	if offer.Amount != 0 {
+		return nil, ErrFixedAmountOffer
+	}
+
+	pay := func(amt lnwire.MilliSatoshi) (lnwire.MilliSatoshi, error) {
+		invoice, err := mgr.FetchInvoice(offer, amt, 0, "")
+		if err != nil {
+			return 0, err
+		}
+
+		_, route, err := mgr.PayInvoice(invoice, feeLimit)
+		if err != nil {
+			return 0, err
+		}
+
+		return route.TotalFees, nil
+	}
+
+	return pay, nil
+}
@@ -0,0 +1,255 @@
+package paystream
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultInterval is the default interval at which a stream accrues
+	// the amount owed to the destination, and pays it out.
+	DefaultInterval = time.Second
+
+	// minShardDivisor is used to derive the default minimum shard size
+	// from the maximum shard size.
+	minShardDivisor = 16
+)
+
+var (
+	// ErrZeroRate is returned when a stream is requested without a rate.
+	ErrZeroRate = errors.New("stream rate must be positive")
+
+	// ErrInvalidShardSize is returned if the minimum shard size exceeds
+	// the maximum shard size.
+	ErrInvalidShardSize = errors.New("min shard size exceeds max shard " +
+		"size")
+)
+
+// PayFunc pays a single shard of the given amount to the destination of the
+// stream, returning the fees paid to do so.
+type PayFunc func(amt lnwire.MilliSatoshi) (lnwire.MilliSatoshi, error)
+
+// Config houses the parameters of a payment stream.
+type Config struct {
+	// Pay pays a single shard to the destination. As each shard is an
+	// independent payment, they may take different routes, which allows
+	// the stream to make use of the liquidity of several channels.
+	Pay PayFunc
+
+	// Rate is the amount that should be delivered to the destination per
+	// second.
+	Rate lnwire.MilliSatoshi
+
+	// Total is the total amount to deliver, after which the stream
+	// completes. If zero, the stream runs until it's cancelled.
+	Total lnwire.MilliSatoshi
+
+	// MaxShard is the largest amount that will be paid in a single shard.
+	// If zero, the amount accrued per second is used.
+	MaxShard lnwire.MilliSatoshi
+
+	// MinShard is the smallest amount that will be paid in a single
+	// shard. Shards are halved in size whenever they fail, down to this
+	// amount. If zero, a sixteenth of MaxShard is used.
+	MinShard lnwire.MilliSatoshi
+
+	// Ticker determines when the stream accrues the amount owed to the
+	// destination, and attempts to pay it out. It should tick every
+	// Interval.
+	Ticker ticker.Ticker
+
+	// Interval is the interval of the Ticker.
+	Interval time.Duration
+}
+
+// Update reports the progress of a payment stream.
+type Update struct {
+	// Delivered is the total amount delivered to the destination.
+	Delivered lnwire.MilliSatoshi
+
+	// Fees is the total amount of fees paid to deliver the stream.
+	Fees lnwire.MilliSatoshi
+
+	// NumShards is the number of shards successfully paid.
+	NumShards uint64
+
+	// NumFailed is the number of shards that failed to be paid.
+	NumFailed uint64
+
+	// ShardSize is the current size of the shards being paid.
+	ShardSize lnwire.MilliSatoshi
+}
+
+// Stream delivers many small payments to a destination at a target rate.
+// The size of each payment is adjusted to the conditions of the network: it
+// grows additively while payments succeed, and is halved whenever one fails.
+// If even the smallest payments fail, the stream backs off until the next
+// interval. The amount owed to the destination is capped to what accrues
+// over a couple of intervals, such that the stream won't try to catch up on
+// amounts it failed to deliver earlier with a burst of payments.
+type Stream struct {
+	cfg Config
+
+	// perTick is the amount that accrues every interval.
+	perTick lnwire.MilliSatoshi
+
+	// owed is the amount accrued that has yet to be delivered.
+	owed lnwire.MilliSatoshi
+
+	update Update
+}
+
+// New creates a new payment stream from the given config.
+func New(cfg Config) (*Stream, error) {
+	if cfg.Rate == 0 {
+		return nil, ErrZeroRate
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.MaxShard == 0 {
+		cfg.MaxShard = cfg.Rate
+	}
+	if cfg.MinShard == 0 {
+		cfg.MinShard = cfg.MaxShard / minShardDivisor
+		if cfg.MinShard == 0 {
+			cfg.MinShard = 1
+		}
+	}
+	if cfg.MinShard > cfg.MaxShard {
+		return nil, ErrInvalidShardSize
+	}
+
+	perTick := cfg.Rate * lnwire.MilliSatoshi(cfg.Interval) /
+		lnwire.MilliSatoshi(time.Second)
+	if perTick == 0 {
+		perTick = 1
+	}
+
+	return &Stream{
+		cfg:     cfg,
+		perTick: perTick,
+		update: Update{
+			ShardSize: cfg.MaxShard,
+		},
+	}, nil
+}
+
+// Run streams payments until the total amount has been delivered, or the
+// quit channel is closed. The notify closure is called with the progress of
+// the stream after every interval in which shards were attempted. If notify
+// returns an error, the stream is stopped and the error returned.
+func (s *Stream) Run(notify func(Update) error, quit <-chan struct{}) error {
+	s.cfg.Ticker.Resume()
+	defer s.cfg.Ticker.Stop()
+
+	for {
+		select {
+		case <-s.cfg.Ticker.Ticks():
+			attempted := s.tick()
+			if !attempted {
+				continue
+			}
+
+			if err := notify(s.update); err != nil {
+				return err
+			}
+
+			if s.done() {
+				return nil
+			}
+
+		case <-quit:
+			return nil
+		}
+	}
+}
+
+// done returns true if the total amount of the stream has been delivered.
+func (s *Stream) done() bool {
+	return s.cfg.Total != 0 && s.update.Delivered >= s.cfg.Total
+}
+
+// tick accrues the amount owed for the past interval, and attempts to pay it
+// out. It returns true if any shards were attempted.
+func (s *Stream) tick() bool {
+	// We only allow a couple of intervals worth of payments to build up,
+	// so that failures translate into a lower delivered rate, rather than
+	// a burst of payments once the network recovers.
+	s.owed += s.perTick
+	maxOwed := 2 * s.perTick
+	if maxOwed < s.cfg.MinShard {
+		maxOwed = s.cfg.MinShard
+	}
+	if s.owed > maxOwed {
+		s.owed = maxOwed
+	}
+
+	var attempted bool
+	for s.owed > 0 && !s.done() {
+		amt := s.nextShard()
+
+		// Unless it's the last shard of the stream, we'll wait until
+		// at least the minimum shard size has accrued.
+		if amt < s.cfg.MinShard && !s.isFinalShard(amt) {
+			break
+		}
+
+		attempted = true
+
+		fee, err := s.cfg.Pay(amt)
+		if err != nil {
+			log.Debugf("Unable to pay stream shard of %v: %v",
+				amt, err)
+
+			s.update.NumFailed++
+
+			// If the smallest shard we're allowed to send failed,
+			// then we'll back off until the next interval.
+			if s.update.ShardSize <= s.cfg.MinShard {
+				break
+			}
+
+			s.update.ShardSize /= 2
+			if s.update.ShardSize < s.cfg.MinShard {
+				s.update.ShardSize = s.cfg.MinShard
+			}
+			continue
+		}
+
+		s.owed -= amt
+		s.update.Delivered += amt
+		s.update.Fees += fee
+		s.update.NumShards++
+
+		// As the shard succeeded, we'll carefully probe for larger
+		// shards.
+		s.update.ShardSize += s.cfg.MinShard
+		if s.update.ShardSize > s.cfg.MaxShard {
+			s.update.ShardSize = s.cfg.MaxShard
+		}
+	}
+
+	return attempted
+}
+
+// isFinalShard returns true if paying the amount completes the stream.
+func (s *Stream) isFinalShard(amt lnwire.MilliSatoshi) bool {
+	return s.cfg.Total != 0 && s.update.Delivered+amt == s.cfg.Total
+}
+
+// nextShard returns the amount of the next shard to pay.
+func (s *Stream) nextShard() lnwire.MilliSatoshi {
+	amt := s.update.ShardSize
+	if amt > s.owed {
+		amt = s.owed
+	}
+	if s.cfg.Total != 0 && amt > s.cfg.Total-s.update.Delivered {
+		amt = s.cfg.Total - s.update.Delivered
+	}
+
+	return amt
+}
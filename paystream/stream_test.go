@@ -0,0 +1,145 @@
+package paystream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+var errNoRoute = errors.New("no route")
+
+// TestStreamShardSize asserts that the shard size is halved when shards fail,
+// grows additively when they succeed, and that the stream backs off once
+// shards of the minimum size fail.
+func TestStreamShardSize(t *testing.T) {
+	t.Parallel()
+
+	var maxAmt lnwire.MilliSatoshi = 400
+	pay := func(amt lnwire.MilliSatoshi) (lnwire.MilliSatoshi, error) {
+		if amt > maxAmt {
+			return 0, errNoRoute
+		}
+		return 1, nil
+	}
+
+	s, err := New(Config{
+		Pay:      pay,
+		Rate:     1000,
+		MinShard: 100,
+		Ticker:   ticker.MockNew(time.Second),
+	})
+	if err != nil {
+		t.Fatalf("unable to create stream: %v", err)
+	}
+
+	// Shards of 1000 and 500 should fail, after which shards of 250, 350
+	// and the remaining 400 succeed.
+	if !s.tick() {
+		t.Fatalf("expected shards to be attempted")
+	}
+	expected := Update{
+		Delivered: 1000,
+		Fees:      3,
+		NumShards: 3,
+		NumFailed: 2,
+		ShardSize: 550,
+	}
+	if s.update != expected {
+		t.Fatalf("expected update %v, got %v", expected, s.update)
+	}
+
+	// If no route can be found at all, the shard size should drop to the
+	// minimum, after which the stream backs off.
+	maxAmt = 0
+	if !s.tick() {
+		t.Fatalf("expected shards to be attempted")
+	}
+	if s.update.NumFailed != 6 {
+		t.Fatalf("expected 6 failed shards, got %v", s.update.NumFailed)
+	}
+	if s.update.ShardSize != 100 {
+		t.Fatalf("expected min shard size, got %v", s.update.ShardSize)
+	}
+
+	// The amount owed shouldn't exceed two intervals worth of payments, so
+	// that it isn't delivered in a burst once routes are available again.
+	s.tick()
+	s.tick()
+	if s.owed != 2000 {
+		t.Fatalf("expected 2000 msat owed, got %v", s.owed)
+	}
+}
+
+// TestStreamRun asserts that a stream delivers its total amount at the
+// configured rate, notifying the caller of its progress.
+func TestStreamRun(t *testing.T) {
+	t.Parallel()
+
+	pay := func(amt lnwire.MilliSatoshi) (lnwire.MilliSatoshi, error) {
+		return 0, nil
+	}
+
+	mockTicker := ticker.MockNew(time.Second)
+	s, err := New(Config{
+		Pay:    pay,
+		Rate:   1000,
+		Total:  1500,
+		Ticker: mockTicker,
+	})
+	if err != nil {
+		t.Fatalf("unable to create stream: %v", err)
+	}
+
+	updates := make(chan Update, 2)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- s.Run(func(u Update) error {
+			updates <- u
+			return nil
+		}, make(chan struct{}))
+	}()
+
+	for _, delivered := range []lnwire.MilliSatoshi{1000, 1500} {
+		select {
+		case mockTicker.Force <- time.Time{}:
+		case <-time.After(time.Second):
+			t.Fatalf("stream didn't consume tick")
+		}
+
+		select {
+		case u := <-updates:
+			if u.Delivered != delivered {
+				t.Fatalf("expected %v delivered, got %v",
+					delivered, u.Delivered)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no update received")
+		}
+	}
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("stream failed: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("stream didn't complete")
+	}
+}
+
+// TestNewStreamInvalid asserts that invalid stream parameters are rejected.
+func TestNewStreamInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(Config{}); err != ErrZeroRate {
+		t.Fatalf("expected ErrZeroRate, got %v", err)
+	}
+
+	_, err := New(Config{Rate: 1000, MaxShard: 10, MinShard: 20})
+	if err != ErrInvalidShardSize {
+		t.Fatalf("expected ErrInvalidShardSize, got %v", err)
+	}
+}
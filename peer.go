@@ -110,6 +110,16 @@ type peer struct {
 	// our last ping message.  To be used atomically.
 	pingLastSend int64
 
+	// gossipSyncPeer is set to 1 if we're requesting channel updates from
+	// this peer through its gossipSyncer. To be used atomically.
+	gossipSyncPeer int32
+
+	// lastErr is the most recent error the peer sent us, and lastErrTime
+	// the time at which we received it. Both are protected by lastErrMtx.
+	lastErr     string
+	lastErrTime time.Time
+	lastErrMtx  sync.Mutex
+
 	connReq *connmgr.ConnReq
 	conn    net.Conn
 
@@ -348,11 +358,24 @@ func (p *peer) initGossipSync() {
 		// peers
 		recvUpdates := !cfg.NoChanUpdates
 
+		// If the remote peer is also able to decode compressed short
+		// channel IDs, then we'll use zlib to encode our queries and
+		// replies to save on bandwidth.
+		encoding := lnwire.EncodingSortedPlain
+		if p.remoteLocalFeatures.HasFeature(
+			lnwire.GossipQueriesZlibOptional,
+		) {
+			encoding = lnwire.EncodingSortedZlib
+		}
+
 		// Register the this peer's for gossip syncer with the gossiper.
 		// This is blocks synchronously to ensure the gossip syncer is
 		// registered with the gossiper before attempting to read
 		// messages from the remote peer.
-		p.server.authGossiper.InitSyncState(p, recvUpdates)
+		p.server.authGossiper.InitSyncState(p, recvUpdates, encoding)
+		if recvUpdates {
+			atomic.StoreInt32(&p.gossipSyncPeer, 1)
+		}
 
 	// If the remote peer has the initial sync feature bit set, then we'll
 	// being the synchronization protocol to exchange authenticated channel
@@ -381,6 +404,19 @@ func (p *peer) QuitSignal() <-chan struct{} {
 func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 	var activePublicChans []wire.OutPoint
 	for _, dbChan := range chans {
+		// Channels restored from a static channel backup don't have
+		// any commitment state, so they can't be used off-chain.
+		// Instead, we'll wait for the remote party's channel sync
+		// message, which we'll use to trigger a force close on their
+		// end.
+		if dbChan.HasChanStatus(channeldb.Restored) {
+			peerLog.Infof("ChannelPoint(%v) was restored from a "+
+				"backup, waiting for channel sync from "+
+				"NodeKey(%x)", dbChan.FundingOutpoint,
+				p.PubKey())
+			continue
+		}
+
 		lnChan, err := lnwallet.NewLightningChannel(
 			p.server.cc.signer, p.server.witnessBeacon, dbChan,
 		)
@@ -452,10 +488,20 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 		if selfPolicy != nil {
 			forwardingPolicy = &htlcswitch.ForwardingPolicy{
 				MinHTLC:       selfPolicy.MinHTLC,
+				MaxHTLC:       selfPolicy.MaxHTLC,
 				BaseFee:       selfPolicy.FeeBaseMSat,
 				FeeRate:       selfPolicy.FeeProportionalMillionths,
 				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
 			}
+
+			inboundFee, err := lnwire.ParseInboundFee(
+				selfPolicy.ExtraOpaqueData,
+			)
+			if err != nil {
+				peerLog.Warnf("Unable to parse inbound fee "+
+					"for channel %v: %v", chanPoint, err)
+			}
+			forwardingPolicy.InboundFee = inboundFee
 		} else {
 			peerLog.Warnf("Unable to find our forwarding policy "+
 				"for channel %v, using default values",
@@ -576,10 +622,24 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		UnsafeReplay:        cfg.UnsafeReplay,
 		MinFeeUpdateTimeout: htlcswitch.DefaultMinLinkFeeUpdateTimeout,
 		MaxFeeUpdateTimeout: htlcswitch.DefaultMaxLinkFeeUpdateTimeout,
+		FeeUpdateBand:       cfg.FeeUpdateBand,
+		FeeUpdateConfTarget: cfg.FeeUpdateConfTarget,
+		QuiescenceSupported: p.remoteLocalFeatures.HasFeature(
+			lnwire.QuiescenceOptional,
+		),
+		DynamicCommitmentsSupported: p.remoteLocalFeatures.HasFeature(
+			lnwire.DynamicCommitmentsOptional,
+		),
+		QuiescenceTimeout: htlcswitch.DefaultQuiescenceTimeout,
+		AcquireStartup:    p.server.htlcSwitch.AcquireLinkStartup,
 	}
 
 	link := htlcswitch.NewChannelLink(linkCfg, lnChan)
 
+	// Make sure we track the uptime of the channel, which may have only
+	// just been opened.
+	p.server.uptimeTracker.AddChannel(*chanPoint, p.pubKeyBytes)
+
 	// Before adding our new link, purge the switch of any pending or live
 	// links going by the same channel id. If one is found, we'll shut it
 	// down to ensure that the mailboxes are only ever under the control of
@@ -589,7 +649,14 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 	// With the channel link created, we'll now notify the htlc switch so
 	// this channel can be used to dispatch local payments and also
 	// passively forward payments.
-	return p.server.htlcSwitch.AddLink(link)
+	if err := p.server.htlcSwitch.AddLink(link); err != nil {
+		return err
+	}
+
+	// Now that the link is live, the channel has become active.
+	p.server.chanNotifier.NotifyActiveChannelEvent(*chanPoint)
+
+	return nil
 }
 
 // WaitForDisconnect waits until the peer has disconnected. A peer may be
@@ -882,8 +949,23 @@ func newChanMsgStream(p *peer, cid lnwire.ChannelID) *msgStream {
 				// resync closed channel. In this case we'll
 				// try to resend our last channel sync message,
 				// such that the peer can recover funds from
-				// the closed channel.
+				// the closed channel. If the channel was
+				// instead restored from a backup, then we'll
+				// use the message to recover our funds.
 				case err != nil && isChanSyncMsg:
+					restored, err := p.handleRestoredChanSync(
+						cid, msg.(*lnwire.ChannelReestablish),
+					)
+					if err != nil {
+						peerLog.Errorf("Unable to handle "+
+							"channel sync for restored "+
+							"link(%v): %v", cid, err)
+						return
+					}
+					if restored {
+						return
+					}
+
 					peerLog.Debugf("Unable to find "+
 						"link(%v) to handle channel "+
 						"sync, attempting to resend "+
@@ -1043,6 +1125,7 @@ out:
 
 		case *lnwire.Error:
 			key := p.addr.IdentityKey
+			p.storeError(string(msg.Data))
 
 			switch {
 			// In the case of an all-zero channel ID we want to
@@ -1100,6 +1183,18 @@ out:
 		case *lnwire.ChannelReestablish:
 			isChanUpdate = true
 			targetChan = msg.ChanID
+		case *lnwire.Stfu:
+			isChanUpdate = true
+			targetChan = msg.ChanID
+		case *lnwire.DynPropose:
+			isChanUpdate = true
+			targetChan = msg.ChanID
+		case *lnwire.DynAck:
+			isChanUpdate = true
+			targetChan = msg.ChanID
+		case *lnwire.DynReject:
+			isChanUpdate = true
+			targetChan = msg.ChanID
 
 		case *lnwire.ChannelUpdate,
 			*lnwire.ChannelAnnouncement,
@@ -1113,6 +1208,28 @@ out:
 
 			discStream.AddMsg(msg)
 
+		case *lnwire.OnionMessage:
+			if p.server.onionMessenger == nil {
+				peerLog.Debugf("Ignoring onion message from "+
+					"peer %v, onion messages disabled", p)
+				break
+			}
+
+			err := p.server.onionMessenger.HandleMessage(
+				p.addr.IdentityKey, msg,
+			)
+			if err != nil {
+				peerLog.Debugf("Unable to handle onion "+
+					"message from peer %v: %v", p, err)
+			}
+
+		// Custom messages are opaque to us, so we'll hand them to the
+		// applications that subscribed to them.
+		case *lnwire.Custom:
+			p.server.peerNotifier.NotifyCustomMessage(
+				p.pubKeyBytes, msg,
+			)
+
 		default:
 			peerLog.Errorf("unknown message %v received from peer "+
 				"%v", uint16(msg.MsgType()), p)
@@ -1247,6 +1364,26 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("next_local_height=%v, remote_tail_height=%v",
 			msg.NextLocalCommitHeight, msg.RemoteCommitTailHeight)
 
+	case *lnwire.Stfu:
+		return fmt.Sprintf("chan_id=%v, initiator=%v", msg.ChanID,
+			msg.Initiator)
+
+	case *lnwire.DynPropose:
+		return fmt.Sprintf("chan_id=%v, max_value_in_flight=%v, "+
+			"htlc_minimum=%v, max_accepted_htlcs=%v", msg.ChanID,
+			msg.MaxValueInFlight, msg.HtlcMinimum,
+			msg.MaxAcceptedHTLCs)
+
+	case *lnwire.DynAck:
+		return fmt.Sprintf("chan_id=%v, max_value_in_flight=%v, "+
+			"htlc_minimum=%v, max_accepted_htlcs=%v", msg.ChanID,
+			msg.MaxValueInFlight, msg.HtlcMinimum,
+			msg.MaxAcceptedHTLCs)
+
+	case *lnwire.DynReject:
+		return fmt.Sprintf("chan_id=%v, reason=%q", msg.ChanID,
+			msg.Reason)
+
 	case *lnwire.ReplyShortChanIDsEnd:
 		return fmt.Sprintf("chain_hash=%v, complete=%v", msg.ChainHash,
 			msg.Complete)
@@ -1270,6 +1407,10 @@ func messageSummary(msg lnwire.Message) string {
 			time.Unix(int64(msg.FirstTimestamp), 0),
 			msg.TimestampRange)
 
+	case *lnwire.Custom:
+		return fmt.Sprintf("type=%d, data_len=%v", uint16(msg.Type),
+			len(msg.Data))
+
 	}
 
 	return ""
@@ -1489,6 +1630,50 @@ func (p *peer) PingTime() int64 {
 	return atomic.LoadInt64(&p.pingTime)
 }
 
+// IsGossipSyncPeer returns true if we're requesting channel updates from the
+// peer to keep our view of the channel graph in sync.
+func (p *peer) IsGossipSyncPeer() bool {
+	return atomic.LoadInt32(&p.gossipSyncPeer) == 1
+}
+
+// storeError records an error received from the peer, replacing the
+// previous one.
+func (p *peer) storeError(errMsg string) {
+	p.lastErrMtx.Lock()
+	defer p.lastErrMtx.Unlock()
+
+	p.lastErr = errMsg
+	p.lastErrTime = time.Now()
+}
+
+// LastError returns the most recent error the peer sent us, along with the
+// time at which we received it. If the peer didn't send us an error, then
+// an empty string is returned.
+func (p *peer) LastError() (string, time.Time) {
+	p.lastErrMtx.Lock()
+	defer p.lastErrMtx.Unlock()
+
+	return p.lastErr, p.lastErrTime
+}
+
+// Features returns the feature bits the peer advertised during the
+// connection handshake, both local and global.
+func (p *peer) Features() []lnwire.FeatureBit {
+	var features []lnwire.FeatureBit
+	if p.remoteLocalFeatures != nil {
+		features = append(
+			features, p.remoteLocalFeatures.Features()...,
+		)
+	}
+	if p.remoteGlobalFeatures != nil {
+		features = append(
+			features, p.remoteGlobalFeatures.Features()...,
+		)
+	}
+
+	return features
+}
+
 // queueMsg queues a new lnwire.Message to be eventually sent out on the
 // wire. It returns an error if we failed to queue the message. An error
 // is sent on errChan if the message fails being sent to the peer, or
@@ -1864,14 +2049,19 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	// out this channel on-chain, so we execute the cooperative channel
 	// closure workflow.
 	case htlcswitch.CloseRegular:
-		// First, we'll fetch a fresh delivery address that we'll use
+		// First, we'll determine the delivery address that we'll use
 		// to send the funds to in the case of a successful
-		// negotiation.
-		deliveryAddr, err := p.genDeliveryScript()
-		if err != nil {
-			peerLog.Errorf(err.Error())
-			req.Err <- err
-			return
+		// negotiation. Unless the caller specified one, we'll fetch a
+		// fresh one from the wallet.
+		deliveryAddr := []byte(req.DeliveryScript)
+		if len(deliveryAddr) == 0 {
+			var err error
+			deliveryAddr, err = p.genDeliveryScript()
+			if err != nil {
+				peerLog.Errorf(err.Error())
+				req.Err <- err
+				return
+			}
 		}
 
 		// Next, we'll create a new channel closer state machine to
@@ -2001,6 +2191,14 @@ func (p *peer) handleLinkFailure(failure linkFailureReport) {
 				"remote peer: %v", err)
 		}
 	}
+
+	// If the failure stems from state that only lasts for the duration
+	// of the connection, we'll disconnect the peer to reset it. The link
+	// is restored once the peer reconnects.
+	if failure.linkErr.Disconnect {
+		p.Disconnect(fmt.Errorf("link(%v) failed: %v",
+			failure.shortChanID, failure.linkErr))
+	}
 }
 
 // finalizeChanClosure performs the final clean up steps once the cooperative
@@ -2131,12 +2329,24 @@ func (p *peer) WipeChannel(chanPoint *wire.OutPoint) error {
 	// longer active.
 	p.server.htlcSwitch.RemoveLink(chanID)
 
+	// We also no longer need to track the uptime of the channel.
+	p.server.uptimeTracker.RemoveChannel(*chanPoint)
+
 	return nil
 }
 
 // handleInitMsg handles the incoming init message which contains global and
 // local features vectors. If feature vectors are incompatible then disconnect.
 func (p *peer) handleInitMsg(msg *lnwire.Init) error {
+	// If the features negotiated with this peer have been overridden,
+	// we'll disregard any disabled features it advertises.
+	if overrides, ok := cfg.peerFeatureOverrides[p.pubKeyBytes]; ok {
+		err := overrides.applyRemote(msg.LocalFeatures)
+		if err != nil {
+			return err
+		}
+	}
+
 	p.remoteLocalFeatures = lnwire.NewFeatureVector(msg.LocalFeatures,
 		lnwire.LocalFeatures)
 	p.remoteGlobalFeatures = lnwire.NewFeatureVector(msg.GlobalFeatures,
@@ -2199,6 +2409,63 @@ func (p *peer) resendChanSyncMsg(cid lnwire.ChannelID) error {
 	return nil
 }
 
+// handleRestoredChanSync checks whether the channel identified by the passed
+// ChannelID was restored from a static channel backup. If so, we'll store the
+// commitment point sent within the remote party's channel sync message, which
+// allows us to sweep our funds once they force close the channel. We'll then
+// reply with our own channel sync message which, as we've lost all channel
+// state, will cause the remote party to do just that. The returned boolean is
+// true if the channel is a restored one.
+func (p *peer) handleRestoredChanSync(cid lnwire.ChannelID,
+	msg *lnwire.ChannelReestablish) (bool, error) {
+
+	dbChans, err := p.server.chanDB.FetchOpenChannels(p.addr.IdentityKey)
+	if err != nil {
+		return false, err
+	}
+
+	var restoredChan *channeldb.OpenChannel
+	for _, dbChan := range dbChans {
+		if !cid.IsChanPoint(&dbChan.FundingOutpoint) {
+			continue
+		}
+		if !dbChan.HasChanStatus(channeldb.Restored) {
+			return false, nil
+		}
+
+		restoredChan = dbChan
+		break
+	}
+	if restoredChan == nil {
+		return false, nil
+	}
+
+	// Without the commitment point of the remote party's current
+	// commitment, we won't be able to derive the key for our output once
+	// they force close.
+	if msg.LocalUnrevokedCommitPoint == nil {
+		return true, fmt.Errorf("peer %v doesn't support data loss "+
+			"protection, unable to recover funds of "+
+			"ChannelPoint(%v)", p, restoredChan.FundingOutpoint)
+	}
+
+	err = restoredChan.MarkDataLoss(msg.LocalUnrevokedCommitPoint)
+	if err != nil {
+		return true, err
+	}
+
+	chanSync, err := lnwallet.ChanSyncMsg(restoredChan)
+	if err != nil {
+		return true, err
+	}
+
+	peerLog.Infof("Sending channel sync for restored ChannelPoint(%v) "+
+		"to peer %v, requesting force close",
+		restoredChan.FundingOutpoint, p)
+
+	return true, p.SendMessage(true, chanSync)
+}
+
 // SendMessage sends a variadic number of message to remote peer. The first
 // argument denotes if the method should block until the message has been sent
 // to the remote peer.
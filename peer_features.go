@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// featureOverrides is the set of optional local features that are forcibly
+// enabled or disabled for a single peer, overriding the features we'd
+// otherwise negotiate with it.
+type featureOverrides struct {
+	// enable is the set of features we'll advertise to the peer, even if
+	// we don't advertise them by default.
+	enable []lnwire.FeatureBit
+
+	// disable is the set of features we'll neither advertise to the peer
+	// nor use with it, even if the peer advertises them.
+	disable []lnwire.FeatureBit
+}
+
+// parsePeerFeatureOverrides parses the per-peer feature overrides specified
+// in the configuration. Each override is of the form <pubkey>:<+|-><feature>,
+// where feature is the name of an optional local feature without its
+// "-optional" suffix, e.g. "gossip-queries". A leading + enables the feature
+// for the peer, while a leading - disables it.
+func parsePeerFeatureOverrides(
+	overrides []string) (map[[33]byte]*featureOverrides, error) {
+
+	peerOverrides := make(map[[33]byte]*featureOverrides)
+	for _, override := range overrides {
+		parts := strings.SplitN(override, ":", 2)
+		if len(parts) != 2 || len(parts[1]) < 2 {
+			return nil, fmt.Errorf("invalid peer feature override "+
+				"%q, expected <pubkey>:<+|-><feature>",
+				override)
+		}
+
+		pubKey, err := hex.DecodeString(parts[0])
+		if err != nil || len(pubKey) != 33 {
+			return nil, fmt.Errorf("invalid public key in peer "+
+				"feature override %q", override)
+		}
+
+		bit, ok := optionalFeatureBit(parts[1][1:])
+		if !ok {
+			return nil, fmt.Errorf("unknown feature in peer "+
+				"feature override %q", override)
+		}
+
+		var key [33]byte
+		copy(key[:], pubKey)
+
+		o, ok := peerOverrides[key]
+		if !ok {
+			o = &featureOverrides{}
+			peerOverrides[key] = o
+		}
+
+		switch parts[1][0] {
+		case '+':
+			o.enable = append(o.enable, bit)
+		case '-':
+			o.disable = append(o.disable, bit)
+		default:
+			return nil, fmt.Errorf("invalid peer feature override "+
+				"%q, feature must be prefixed by + or -",
+				override)
+		}
+	}
+
+	return peerOverrides, nil
+}
+
+// optionalFeatureBit returns the optional bit of the local feature known under
+// the given name, without its "-optional" suffix.
+func optionalFeatureBit(name string) (lnwire.FeatureBit, bool) {
+	for bit, bitName := range lnwire.LocalFeatures {
+		if bit%2 == 1 && bitName == name+"-optional" {
+			return bit, true
+		}
+	}
+
+	return 0, false
+}
+
+// applyLocal applies the overrides to the local features we'll advertise to
+// the peer.
+func (o *featureOverrides) applyLocal(features *lnwire.RawFeatureVector) {
+	for _, bit := range o.enable {
+		features.Set(bit)
+	}
+	for _, bit := range o.disable {
+		features.Unset(bit - 1)
+		features.Unset(bit)
+	}
+}
+
+// applyRemote applies the overrides to the local features advertised by the
+// peer, so that disabled features aren't used with it even if the peer
+// supports them. An error is returned if the peer requires a feature that's
+// been disabled.
+func (o *featureOverrides) applyRemote(
+	features *lnwire.RawFeatureVector) error {
+
+	for _, bit := range o.disable {
+		if features.IsSet(bit - 1) {
+			return fmt.Errorf("peer requires feature %v, which "+
+				"is disabled for it",
+				lnwire.LocalFeatures[bit-1])
+		}
+		features.Unset(bit)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+// +build !rpctest
+
+package main
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestPeerFeatureOverrides asserts that per-peer feature overrides are parsed
+// correctly, and applied to both the features we advertise to the peer and
+// those it advertises to us.
+func TestPeerFeatureOverrides(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "02" + strings.Repeat("ab", 32)
+
+	// Overrides that are malformed or refer to unknown features should be
+	// rejected.
+	invalid := []string{
+		pubKey,
+		pubKey + ":gossip-queries",
+		pubKey + ":+unknown-feature",
+		pubKey + ":+initial-routing-sync",
+		"02ab:+gossip-queries",
+	}
+	for _, override := range invalid {
+		_, err := parsePeerFeatureOverrides([]string{override})
+		if err == nil {
+			t.Fatalf("expected override %q to be rejected",
+				override)
+		}
+	}
+
+	overrides, err := parsePeerFeatureOverrides([]string{
+		pubKey + ":-gossip-queries",
+		pubKey + ":+data-loss-protect",
+	})
+	if err != nil {
+		t.Fatalf("unable to parse overrides: %v", err)
+	}
+
+	var key [33]byte
+	pubKeyBytes, _ := hex.DecodeString(pubKey)
+	copy(key[:], pubKeyBytes)
+
+	o, ok := overrides[key]
+	if !ok || len(overrides) != 1 {
+		t.Fatalf("expected overrides for a single peer")
+	}
+
+	// Disabled features shouldn't be advertised to the peer, while
+	// enabled ones should.
+	local := lnwire.NewRawFeatureVector(lnwire.GossipQueriesOptional)
+	o.applyLocal(local)
+	if local.IsSet(lnwire.GossipQueriesOptional) {
+		t.Fatalf("gossip queries shouldn't be advertised")
+	}
+	if !local.IsSet(lnwire.DataLossProtectOptional) {
+		t.Fatalf("data loss protect should be advertised")
+	}
+
+	// Disabled features advertised by the peer should be disregarded.
+	remote := lnwire.NewRawFeatureVector(
+		lnwire.GossipQueriesOptional, lnwire.DataLossProtectOptional,
+	)
+	if err := o.applyRemote(remote); err != nil {
+		t.Fatalf("unable to apply overrides: %v", err)
+	}
+	if remote.IsSet(lnwire.GossipQueriesOptional) {
+		t.Fatalf("gossip queries should be disregarded")
+	}
+	if !remote.IsSet(lnwire.DataLossProtectOptional) {
+		t.Fatalf("data loss protect shouldn't be disregarded")
+	}
+
+	// A peer that requires a disabled feature can't be served.
+	remote = lnwire.NewRawFeatureVector(lnwire.GossipQueriesRequired)
+	if err := o.applyRemote(remote); err == nil {
+		t.Fatalf("expected peer requiring gossip queries to be " +
+			"rejected")
+	}
+}
@@ -0,0 +1,248 @@
+package peernotifier
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// ErrNotifierShuttingDown is returned when attempting to subscribe to a
+// PeerNotifier that is shutting down.
+var ErrNotifierShuttingDown = errors.New("peer notifier shutting down")
+
+// PeerOnlineEvent represents a new event where a peer comes online.
+type PeerOnlineEvent struct {
+	// PubKey is the peer's compressed public key.
+	PubKey [33]byte
+}
+
+// PeerOfflineEvent represents a new event where a peer goes offline.
+type PeerOfflineEvent struct {
+	// PubKey is the peer's compressed public key.
+	PubKey [33]byte
+}
+
+// CustomMessageEvent represents a new event where a peer sent us a message
+// of the custom range.
+type CustomMessageEvent struct {
+	// PubKey is the peer's compressed public key.
+	PubKey [33]byte
+
+	// Msg is the custom message sent by the peer.
+	Msg *lnwire.Custom
+}
+
+// PeerNotifier dispatches events about peers coming online and going offline
+// to all of its subscribers, so that callers can track the connectivity of
+// the peers they're interested in without polling. Custom messages sent by
+// our peers are dispatched to a separate set of subscribers.
+type PeerNotifier struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	clientMtx     sync.Mutex
+	nextClientID  uint64
+	clients       map[uint64]*Subscription
+	customClients map[uint64]*Subscription
+
+	quit chan struct{}
+}
+
+// New creates a new peer notifier.
+func New() *PeerNotifier {
+	return &PeerNotifier{
+		clients:       make(map[uint64]*Subscription),
+		customClients: make(map[uint64]*Subscription),
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start starts the PeerNotifier.
+func (p *PeerNotifier) Start() error {
+	if !atomic.CompareAndSwapUint32(&p.started, 0, 1) {
+		return nil
+	}
+
+	log.Info("PeerNotifier starting")
+
+	return nil
+}
+
+// Stop signals the notifier for a graceful shutdown, canceling all active
+// subscriptions.
+func (p *PeerNotifier) Stop() error {
+	if !atomic.CompareAndSwapUint32(&p.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Info("PeerNotifier shutting down")
+
+	close(p.quit)
+
+	p.clientMtx.Lock()
+	clients := p.clients
+	customClients := p.customClients
+	p.clients = make(map[uint64]*Subscription)
+	p.customClients = make(map[uint64]*Subscription)
+	p.clientMtx.Unlock()
+
+	for _, client := range clients {
+		client.stop()
+	}
+	for _, client := range customClients {
+		client.stop()
+	}
+
+	return nil
+}
+
+// SubscribePeerEvents returns a subscription to the online and offline events
+// of all our peers, delivered in the order they occur.
+func (p *PeerNotifier) SubscribePeerEvents() (*Subscription, error) {
+	return p.subscribe(false)
+}
+
+// SubscribeCustomMessages returns a subscription to the custom messages sent
+// by all our peers, delivered in the order they're received. Each event of
+// the subscription is a CustomMessageEvent.
+func (p *PeerNotifier) SubscribeCustomMessages() (*Subscription, error) {
+	return p.subscribe(true)
+}
+
+// subscribe registers a new subscription, either to the custom messages sent
+// by our peers or to their online and offline events.
+func (p *PeerNotifier) subscribe(customMsgs bool) (*Subscription, error) {
+	select {
+	case <-p.quit:
+		return nil, ErrNotifierShuttingDown
+	default:
+	}
+
+	client := &Subscription{
+		customMsgs: customMsgs,
+		ntfnQueue:  queue.NewConcurrentQueue(20),
+		notifier:   p,
+		quit:       make(chan struct{}),
+	}
+	client.ntfnQueue.Start()
+
+	p.clientMtx.Lock()
+	client.id = p.nextClientID
+	p.nextClientID++
+	if customMsgs {
+		p.customClients[client.id] = client
+	} else {
+		p.clients[client.id] = client
+	}
+	p.clientMtx.Unlock()
+
+	return client, nil
+}
+
+// NotifyPeerOnline notifies the peer notifier that the peer with the given
+// public key has come online.
+func (p *PeerNotifier) NotifyPeerOnline(pubKey [33]byte) {
+	p.notify(PeerOnlineEvent{PubKey: pubKey})
+}
+
+// NotifyPeerOffline notifies the peer notifier that the peer with the given
+// public key has gone offline.
+func (p *PeerNotifier) NotifyPeerOffline(pubKey [33]byte) {
+	p.notify(PeerOfflineEvent{PubKey: pubKey})
+}
+
+// NotifyCustomMessage notifies the peer notifier that the peer with the given
+// public key sent us a custom message.
+func (p *PeerNotifier) NotifyCustomMessage(pubKey [33]byte,
+	msg *lnwire.Custom) {
+
+	p.clientMtx.Lock()
+	defer p.clientMtx.Unlock()
+
+	p.notifyClients(p.customClients, CustomMessageEvent{
+		PubKey: pubKey,
+		Msg:    msg,
+	})
+}
+
+// notify dispatches the given event to all active subscriptions to peer
+// events.
+func (p *PeerNotifier) notify(event interface{}) {
+	p.clientMtx.Lock()
+	defer p.clientMtx.Unlock()
+
+	p.notifyClients(p.clients, event)
+}
+
+// notifyClients dispatches the given event to the passed subscriptions.
+//
+// NOTE: This MUST be called with the client mutex held.
+func (p *PeerNotifier) notifyClients(clients map[uint64]*Subscription,
+	event interface{}) {
+
+	for _, client := range clients {
+		select {
+		case client.ntfnQueue.ChanIn() <- event:
+		case <-client.quit:
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// Subscription is a subscription to the events of a PeerNotifier. Each event
+// is either a PeerOnlineEvent or a PeerOfflineEvent, or a CustomMessageEvent
+// for subscriptions to custom messages.
+type Subscription struct {
+	cancelled uint32 // To be used atomically.
+
+	id uint64
+
+	// customMsgs is true if this is a subscription to custom messages.
+	customMsgs bool
+
+	ntfnQueue *queue.ConcurrentQueue
+
+	notifier *PeerNotifier
+
+	quit chan struct{}
+}
+
+// Updates returns the channel over which the events of the subscription are
+// delivered. It's never closed, so callers should also select on Quit.
+func (s *Subscription) Updates() <-chan interface{} {
+	return s.ntfnQueue.ChanOut()
+}
+
+// Quit returns a channel that is closed once the subscription is canceled,
+// either by the caller or as the notifier shuts down.
+func (s *Subscription) Quit() <-chan struct{} {
+	return s.quit
+}
+
+// Cancel unregisters the subscription, freeing any previously allocated
+// resources.
+func (s *Subscription) Cancel() {
+	s.notifier.clientMtx.Lock()
+	if s.customMsgs {
+		delete(s.notifier.customClients, s.id)
+	} else {
+		delete(s.notifier.clients, s.id)
+	}
+	s.notifier.clientMtx.Unlock()
+
+	s.stop()
+}
+
+// stop closes the quit channel of the subscription and stops its queue.
+func (s *Subscription) stop() {
+	if !atomic.CompareAndSwapUint32(&s.cancelled, 0, 1) {
+		return
+	}
+
+	close(s.quit)
+	s.ntfnQueue.Stop()
+}
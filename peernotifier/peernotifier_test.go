@@ -0,0 +1,139 @@
+package peernotifier
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestPeerNotifier asserts that online and offline events are delivered in
+// order to all active subscriptions, and that subscriptions are canceled once
+// the notifier is stopped.
+func TestPeerNotifier(t *testing.T) {
+	t.Parallel()
+
+	notifier := New()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	sub1, err := notifier.SubscribePeerEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	sub2, err := notifier.SubscribePeerEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	pubKey := [33]byte{0x02, 0x01}
+
+	notifier.NotifyPeerOnline(pubKey)
+	notifier.NotifyPeerOffline(pubKey)
+
+	expectedEvents := []interface{}{
+		PeerOnlineEvent{PubKey: pubKey},
+		PeerOfflineEvent{PubKey: pubKey},
+	}
+
+	for _, sub := range []*Subscription{sub1, sub2} {
+		for _, expected := range expectedEvents {
+			select {
+			case event := <-sub.Updates():
+				if !reflect.DeepEqual(event, expected) {
+					t.Fatalf("expected event %#v, got %#v",
+						expected, event)
+				}
+
+			case <-time.After(time.Second):
+				t.Fatalf("event %T not received", expected)
+			}
+		}
+	}
+
+	sub1.Cancel()
+
+	if err := notifier.Stop(); err != nil {
+		t.Fatalf("unable to stop notifier: %v", err)
+	}
+	select {
+	case <-sub2.Quit():
+	case <-time.After(time.Second):
+		t.Fatalf("subscription not canceled on shutdown")
+	}
+	_, err = notifier.SubscribePeerEvents()
+	if err != ErrNotifierShuttingDown {
+		t.Fatalf("expected ErrNotifierShuttingDown, got %v", err)
+	}
+}
+
+// TestPeerNotifierCustomMessages asserts that custom messages are only
+// delivered to subscriptions to custom messages, and that peer events are
+// only delivered to subscriptions to peer events.
+func TestPeerNotifierCustomMessages(t *testing.T) {
+	t.Parallel()
+
+	notifier := New()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	peerSub, err := notifier.SubscribePeerEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	customSub, err := notifier.SubscribeCustomMessages()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	pubKey := [33]byte{0x02, 0x01}
+	msg, err := lnwire.NewCustom(lnwire.CustomTypeStart+1, []byte{1})
+	if err != nil {
+		t.Fatalf("unable to create custom message: %v", err)
+	}
+
+	notifier.NotifyCustomMessage(pubKey, msg)
+	notifier.NotifyPeerOnline(pubKey)
+
+	select {
+	case event := <-customSub.Updates():
+		expected := CustomMessageEvent{PubKey: pubKey, Msg: msg}
+		if !reflect.DeepEqual(event, expected) {
+			t.Fatalf("expected event %#v, got %#v", expected,
+				event)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("custom message not received")
+	}
+
+	select {
+	case event := <-peerSub.Updates():
+		expected := PeerOnlineEvent{PubKey: pubKey}
+		if !reflect.DeepEqual(event, expected) {
+			t.Fatalf("expected event %#v, got %#v", expected,
+				event)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("peer event not received")
+	}
+
+	select {
+	case event := <-customSub.Updates():
+		t.Fatalf("unexpected event %#v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	customSub.Cancel()
+	select {
+	case <-customSub.Quit():
+	default:
+		t.Fatalf("subscription not canceled")
+	}
+}
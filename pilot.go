@@ -101,6 +101,21 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) *autopilot.ManagerCfg {
 		atplConstraints,
 	)
 
+	// Nodes must pass our static filter before they're handed to the
+	// heuristic for scoring.
+	var nodeFilter *autopilot.NodeFilter
+	if len(cfg.RequiredFeatures) != 0 || len(cfg.excludedNets) != 0 {
+		nodeFilter = &autopilot.NodeFilter{
+			ExcludedNets: cfg.excludedNets,
+		}
+		for _, bit := range cfg.RequiredFeatures {
+			nodeFilter.RequiredFeatures = append(
+				nodeFilter.RequiredFeatures,
+				lnwire.FeatureBit(bit),
+			)
+		}
+	}
+
 	// With the heuristic itself created, we can now populate the remainder
 	// of the items that the autopilot agent needs to perform its duties.
 	self := svr.identityPriv.PubKey()
@@ -117,6 +132,7 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) *autopilot.ManagerCfg {
 		},
 		Graph:       autopilot.ChannelGraphFromDatabase(svr.chanDB.ChannelGraph()),
 		Constraints: atplConstraints,
+		NodeFilter:  nodeFilter,
 		ConnectToPeer: func(target *btcec.PublicKey, addrs []net.Addr) (bool, error) {
 			// First, we'll check if we're already connected to the
 			// target peer. If we are, we can exit early. Otherwise,
@@ -153,7 +169,9 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) *autopilot.ManagerCfg {
 						"address type %T", addr)
 				}
 
-				err := svr.ConnectToPeer(lnAddr, false)
+				err := svr.ConnectToPeer(
+					lnAddr, false, tor.DefaultConnTimeout,
+				)
 				if err != nil {
 					// If we weren't able to connect to the
 					// peer at this address, then we'll move
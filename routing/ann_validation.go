@@ -5,6 +5,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -144,3 +145,27 @@ func ValidateChannelUpdateAnn(pubKey *btcec.PublicKey,
 
 	return nil
 }
+
+// ValidateChannelUpdateFields checks that the optional fields of the channel
+// update are consistent with the channel they apply to, which has the given
+// capacity.
+func ValidateChannelUpdateFields(capacity btcutil.Amount,
+	a *lnwire.ChannelUpdate) error {
+
+	if a.Flags&lnwire.ChanUpdateOptionMaxHtlc == 0 {
+		return nil
+	}
+
+	maxHTLC := a.HtlcMaximumMsat
+	switch {
+	case maxHTLC == 0 || maxHTLC < a.HtlcMinimumMsat:
+		return errors.Errorf("invalid max htlc of %v, min htlc is %v",
+			maxHTLC, a.HtlcMinimumMsat)
+
+	case maxHTLC > lnwire.NewMSatFromSatoshis(capacity):
+		return errors.Errorf("max htlc of %v exceeds channel "+
+			"capacity of %v", maxHTLC, capacity)
+	}
+
+	return nil
+}
@@ -51,6 +51,10 @@ const (
 	// ErrFeeLimitExceeded is returned when the total fees of a route exceed
 	// the user-specified fee limit.
 	ErrFeeLimitExceeded
+
+	// ErrPaymentCancelled is returned when a payment was cancelled by the
+	// user before an HTLC could be successfully routed.
+	ErrPaymentCancelled
 )
 
 // routerError is a structure that represent the error inside the routing package,
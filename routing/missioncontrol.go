@@ -61,6 +61,8 @@ type missionControl struct {
 
 	queryBandwidth func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi
 
+	queryBandwidthSnapshot func() map[uint64]lnwire.MilliSatoshi
+
 	sync.Mutex
 
 	// TODO(roasbeef): further counters, if vertex continually unavailable,
@@ -73,14 +75,16 @@ type missionControl struct {
 //
 // TODO(roasbeef): persist memory
 func newMissionControl(g *channeldb.ChannelGraph, selfNode *channeldb.LightningNode,
-	qb func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi) *missionControl {
+	qb func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi,
+	qbs func() map[uint64]lnwire.MilliSatoshi) *missionControl {
 
 	return &missionControl{
-		failedEdges:    make(map[edgeLocator]time.Time),
-		failedVertexes: make(map[Vertex]time.Time),
-		selfNode:       selfNode,
-		queryBandwidth: qb,
-		graph:          g,
+		failedEdges:            make(map[edgeLocator]time.Time),
+		failedVertexes:         make(map[Vertex]time.Time),
+		selfNode:               selfNode,
+		queryBandwidth:         qb,
+		queryBandwidthSnapshot: qbs,
+		graph:                  g,
 	}
 }
 
@@ -235,7 +239,7 @@ func (m *missionControl) NewPaymentSession(routeHints [][]HopHint,
 		return nil, err
 	}
 	bandwidthHints, err := generateBandwidthHints(
-		sourceNode, m.queryBandwidth,
+		sourceNode, m.queryBandwidth, m.queryBandwidthSnapshot,
 	)
 	if err != nil {
 		return nil, err
@@ -270,9 +274,12 @@ func (m *missionControl) NewPaymentSessionFromRoutes(routes []*Route) *paymentSe
 // bandwidth hints for the edges we directly have open ourselves. Obtaining
 // these hints allows us to reduce the number of extraneous attempts as we can
 // skip channels that are inactive, or just don't have enough bandwidth to
-// carry the payment.
+// carry the payment. If a snapshot of the bandwidth of all links can be
+// obtained, then it's preferred over querying each edge individually.
 func generateBandwidthHints(sourceNode *channeldb.LightningNode,
-	queryBandwidth func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi) (map[uint64]lnwire.MilliSatoshi, error) {
+	queryBandwidth func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi,
+	queryBandwidthSnapshot func() map[uint64]lnwire.MilliSatoshi) (
+	map[uint64]lnwire.MilliSatoshi, error) {
 
 	// First, we'll collect the set of outbound edges from the target
 	// source node.
@@ -288,10 +295,23 @@ func generateBandwidthHints(sourceNode *channeldb.LightningNode,
 		return nil, err
 	}
 
-	// Now that we have all of our outbound edges, we'll populate the set
-	// of bandwidth hints, querying the lower switch layer for the most up
-	// to date values.
+	// If the lower switch layer can provide the bandwidth of all of our
+	// links at once, then we'll take a single snapshot, such that all of
+	// the hints reflect the same view of our balances. Any channel that
+	// lacks a link is reported with zero bandwidth.
 	bandwidthHints := make(map[uint64]lnwire.MilliSatoshi)
+	if queryBandwidthSnapshot != nil {
+		snapshot := queryBandwidthSnapshot()
+		for _, localChan := range localChans {
+			chanID := localChan.ChannelID
+			bandwidthHints[chanID] = snapshot[chanID]
+		}
+
+		return bandwidthHints, nil
+	}
+
+	// Otherwise, we'll populate the set of bandwidth hints by querying
+	// the lower switch layer for the most up to date value of each edge.
 	for _, localChan := range localChans {
 		bandwidthHints[localChan.ChannelID] = queryBandwidth(localChan)
 	}
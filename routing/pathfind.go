@@ -596,6 +596,14 @@ func findPath(g *graphParams, r *restrictParams,
 			return
 		}
 
+		// If the edge advertises a maximum HTLC and the amountToSend
+		// exceeds it, return.
+		if edge.Flags&lnwire.ChanUpdateOptionMaxHtlc != 0 &&
+			amountToSend > edge.MaxHTLC {
+
+			return
+		}
+
 		// Compute fee that fromNode is charging. It is based on the
 		// amount that needs to be sent to the next node in the route.
 		//
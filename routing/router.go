@@ -30,6 +30,11 @@ const (
 	// CLTV delta for a route if one is unspecified.
 	DefaultFinalCLTVDelta = 9
 
+	// DefaultChannelPruneExpiry is the default duration after which a
+	// channel of which neither end has been updated is considered a
+	// zombie, and pruned from the graph.
+	DefaultChannelPruneExpiry = time.Duration(time.Hour * 24 * 14)
+
 	// defaultPayAttemptTimeout is a duration that we'll use to determine
 	// if we should give up on a payment attempt. This will be used if a
 	// value isn't specified in the LightningNode struct.
@@ -140,6 +145,19 @@ type ChannelPolicy struct {
 	// TimeLockDelta is the required HTLC timelock delta to be used
 	// when forwarding payments.
 	TimeLockDelta uint32
+
+	// InboundFee is the fee charged for HTLCs entering through the
+	// channel, which is a discount if negative. If nil, the inbound fee
+	// of the channel is left unchanged.
+	InboundFee *lnwire.InboundFee
+
+	// MinHTLC is the smallest HTLC that will be forwarded over the
+	// channel. If zero, the minimum HTLC of the channel is left unchanged.
+	MinHTLC lnwire.MilliSatoshi
+
+	// MaxHTLC is the largest HTLC that will be forwarded over the
+	// channel. If zero, the maximum HTLC of the channel is left unchanged.
+	MaxHTLC lnwire.MilliSatoshi
 }
 
 // Config defines the configuration for the ChannelRouter. ALL elements within
@@ -187,6 +205,14 @@ type Config struct {
 	// returned.
 	QueryBandwidth func(edge *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi
 
+	// QueryBandwidthSnapshot is an optional method that allows the router
+	// to query the lower link layer for the available bandwidth of all of
+	// our links at once, keyed by channel ID. If set, it's preferred over
+	// QueryBandwidth when computing the bandwidth hints of our channels,
+	// as it gives a single view of our balances. Channels missing from
+	// the snapshot are assumed to be unavailable.
+	QueryBandwidthSnapshot func() map[uint64]lnwire.MilliSatoshi
+
 	// AssumeChannelValid toggles whether or not the router will check for
 	// spentness of channel outpoints. For neutrino, this saves long rescans
 	// from blocking initial usage of the wallet. This should only be
@@ -329,6 +355,11 @@ type ChannelRouter struct {
 	rejectMtx   sync.RWMutex
 	rejectCache map[uint64]struct{}
 
+	// activePayments maps the payment hash of each payment currently
+	// being sent to a channel that's closed in order to cancel it.
+	activePaymentsMtx sync.Mutex
+	activePayments    map[[32]byte]chan struct{}
+
 	sync.RWMutex
 
 	quit chan struct{}
@@ -360,11 +391,13 @@ func New(cfg Config) (*ChannelRouter, error) {
 		selfNode:          selfNode,
 		routeCache:        make(map[routeTuple][]*Route),
 		rejectCache:       make(map[uint64]struct{}),
+		activePayments:    make(map[[32]byte]chan struct{}),
 		quit:              make(chan struct{}),
 	}
 
 	r.missionControl = newMissionControl(
 		cfg.Graph, selfNode, cfg.QueryBandwidth,
+		cfg.QueryBandwidthSnapshot,
 	)
 
 	return r, nil
@@ -615,7 +648,6 @@ func (r *ChannelRouter) syncGraphWithChain() error {
 // health, lively routing table.
 func (r *ChannelRouter) pruneZombieChans() error {
 	var chansToPrune []wire.OutPoint
-	chanExpiry := r.cfg.ChannelPruneExpiry
 
 	log.Infof("Examining Channel Graph for zombie channels")
 
@@ -636,24 +668,7 @@ func (r *ChannelRouter) pruneZombieChans() error {
 		// If *both* edges haven't been updated for a period of
 		// chanExpiry, then we'll mark the channel itself as eligible
 		// for graph pruning.
-		e1Zombie, e2Zombie := true, true
-		if e1 != nil {
-			e1Zombie = time.Since(e1.LastUpdate) >= chanExpiry
-			if e1Zombie {
-				log.Tracef("Edge #1 of ChannelPoint(%v) "+
-					"last update: %v",
-					info.ChannelPoint, e1.LastUpdate)
-			}
-		}
-		if e2 != nil {
-			e2Zombie = time.Since(e2.LastUpdate) >= chanExpiry
-			if e2Zombie {
-				log.Tracef("Edge #2 of ChannelPoint(%v) "+
-					"last update: %v",
-					info.ChannelPoint, e2.LastUpdate)
-			}
-		}
-		if e1Zombie && e2Zombie {
+		if IsZombieChannel(e1, e2, r.cfg.ChannelPruneExpiry) {
 			log.Debugf("ChannelPoint(%v) is a zombie, collecting "+
 				"to prune", info.ChannelPoint)
 
@@ -696,6 +711,20 @@ func (r *ChannelRouter) pruneZombieChans() error {
 	return nil
 }
 
+// IsZombieChannel returns whether a channel with the given edge policies is
+// considered a zombie, as neither of its ends has been updated within the
+// passed channel prune expiry. Unknown policies are considered stale. Zombie
+// channels are pruned from the graph the next time it's garbage collected,
+// unless they're our own.
+func IsZombieChannel(e1, e2 *channeldb.ChannelEdgePolicy,
+	chanExpiry time.Duration) bool {
+
+	e1Zombie := e1 == nil || time.Since(e1.LastUpdate) >= chanExpiry
+	e2Zombie := e2 == nil || time.Since(e2.LastUpdate) >= chanExpiry
+
+	return e1Zombie && e2Zombie
+}
+
 // networkHandler is the primary goroutine for the ChannelRouter. The roles of
 // this goroutine include answering queries related to the state of the
 // network, pruning the graph on new block notification, applying network
@@ -1436,7 +1465,7 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	// set of bandwidth hints that can help us eliminate certain routes
 	// early on in the path finding process.
 	bandwidthHints, err := generateBandwidthHints(
-		r.selfNode, r.cfg.QueryBandwidth,
+		r.selfNode, r.cfg.QueryBandwidth, r.cfg.QueryBandwidthSnapshot,
 	)
 	if err != nil {
 		return nil, err
@@ -1491,6 +1520,112 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	return validRoutes, nil
 }
 
+// FindCircularRoute attempts to find a route that leaves our node through the
+// channel with ID outChanID, and comes back to it through the channel with ID
+// inChanID. Paying ourselves over such a route shifts amt of our balance from
+// the outgoing channel to the incoming one, which allows us to rebalance our
+// channels. The route is rejected if its fees exceed feeLimit.
+func (r *ChannelRouter) FindCircularRoute(outChanID, inChanID uint64,
+	amt, feeLimit lnwire.MilliSatoshi) (*Route, error) {
+
+	if outChanID == inChanID {
+		return nil, fmt.Errorf("outgoing and incoming channels must " +
+			"differ")
+	}
+
+	selfVertex := Vertex(r.selfNode.PubKeyBytes)
+
+	// We'll start by fetching our own policy for the outgoing channel,
+	// which leads to the first hop of the route, and the remote policy of
+	// the incoming channel, which leads back to us.
+	outPolicy, err := r.fetchChannelPolicy(outChanID, func(
+		p *channeldb.ChannelEdgePolicy) bool {
+
+		return p.Node.PubKeyBytes != selfVertex
+	})
+	if err != nil {
+		return nil, err
+	}
+	inPolicy, err := r.fetchChannelPolicy(inChanID, func(
+		p *channeldb.ChannelEdgePolicy) bool {
+
+		return p.Node.PubKeyBytes == selfVertex
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The last hop before reaching us back is the remote node of the
+	// incoming channel, which is the node whose policy we just fetched.
+	edgeInfo, _, _, err := r.cfg.Graph.FetchChannelEdgesByID(inChanID)
+	if err != nil {
+		return nil, err
+	}
+	lastHop := edgeInfo.NodeKey1Bytes
+	if lastHop == selfVertex {
+		lastHop = edgeInfo.NodeKey2Bytes
+	}
+	lastHopKey, err := btcec.ParsePubKey(lastHop[:], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// Unless the first hop is also the last one, we'll then search for a
+	// path between the two, making sure not to go through our own node.
+	pathEdges := []*channeldb.ChannelEdgePolicy{outPolicy}
+	if outPolicy.Node.PubKeyBytes != lastHop {
+		path, err := findPath(
+			&graphParams{
+				graph: r.cfg.Graph,
+			},
+			&restrictParams{
+				ignoredNodes: map[Vertex]struct{}{
+					selfVertex: {},
+				},
+				ignoredEdges: make(map[edgeLocator]struct{}),
+				feeLimit:     feeLimit,
+			},
+			outPolicy.Node, lastHopKey, amt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		pathEdges = append(pathEdges, path...)
+	}
+	pathEdges = append(pathEdges, inPolicy)
+
+	return newRoute(
+		amt, feeLimit, selfVertex, pathEdges, uint32(currentHeight),
+		DefaultFinalCLTVDelta,
+	)
+}
+
+// fetchChannelPolicy returns the policy of the channel with the given ID that
+// satisfies the passed predicate.
+func (r *ChannelRouter) fetchChannelPolicy(chanID uint64,
+	match func(*channeldb.ChannelEdgePolicy) bool) (
+	*channeldb.ChannelEdgePolicy, error) {
+
+	_, policy1, policy2, err := r.cfg.Graph.FetchChannelEdgesByID(chanID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range []*channeldb.ChannelEdgePolicy{policy1, policy2} {
+		if policy != nil && match(policy) {
+			return policy, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no matching policy found for channel %v",
+		chanID)
+}
+
 // generateSphinxPacket generates then encodes a sphinx packet which encodes
 // the onion route specified by the passed layer 3 route. The blob returned
 // from this function can immediately be included within an HTLC add packet to
@@ -1703,13 +1838,21 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 
 	timeoutChan := time.After(payAttemptTimeout)
 
+	// We'll register the payment, so that it can be cancelled by the user
+	// while we're still attempting to route it.
+	cancelChan, err := r.registerPayment(payment.PaymentHash)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	defer r.unregisterPayment(payment.PaymentHash)
+
 	// We'll continue until either our payment succeeds, or we encounter a
 	// critical error during path finding.
 	for {
 		// Before we attempt this next payment, we'll check to see if
-		// either we've gone past the payment attempt timeout, or the
-		// router is exiting. In either case, we'll stop this payment
-		// attempt short.
+		// either we've gone past the payment attempt timeout, the
+		// payment was cancelled, or the router is exiting. In either
+		// case, we'll stop this payment attempt short.
 		select {
 		case <-timeoutChan:
 			errStr := fmt.Sprintf("payment attempt not completed "+
@@ -1719,6 +1862,11 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 				ErrPaymentAttemptTimeout, errStr,
 			)
 
+		case <-cancelChan:
+			return preImage, nil, newErr(
+				ErrPaymentCancelled, "payment cancelled",
+			)
+
 		case <-r.quit:
 			return preImage, nil, fmt.Errorf("router shutting down")
 
@@ -2008,6 +2156,60 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 	}
 }
 
+// registerPayment records the payment with the given hash as being sent,
+// returning a channel that's closed once the payment is cancelled.
+func (r *ChannelRouter) registerPayment(paymentHash [32]byte) (
+	chan struct{}, error) {
+
+	r.activePaymentsMtx.Lock()
+	defer r.activePaymentsMtx.Unlock()
+
+	if _, ok := r.activePayments[paymentHash]; ok {
+		return nil, htlcswitch.ErrPaymentInFlight
+	}
+
+	cancelChan := make(chan struct{})
+	r.activePayments[paymentHash] = cancelChan
+
+	return cancelChan, nil
+}
+
+// unregisterPayment removes the payment with the given hash from the set of
+// payments being sent.
+func (r *ChannelRouter) unregisterPayment(paymentHash [32]byte) {
+	r.activePaymentsMtx.Lock()
+	delete(r.activePayments, paymentHash)
+	r.activePaymentsMtx.Unlock()
+}
+
+// CancelPayment cancels the payment with the given hash that's currently
+// being sent. No further routes will be attempted for the payment, although
+// an HTLC that's already in flight will still be waited upon, as it can only
+// be failed by the remote party. Once it's resolved, the pending SendPayment
+// or SendToRoute call returns, with the preimage if the HTLC happened to
+// succeed, and an error otherwise.
+func (r *ChannelRouter) CancelPayment(paymentHash [32]byte) error {
+	r.activePaymentsMtx.Lock()
+	defer r.activePaymentsMtx.Unlock()
+
+	cancelChan, ok := r.activePayments[paymentHash]
+	if !ok {
+		return fmt.Errorf("no payment with hash %x is being sent",
+			paymentHash[:])
+	}
+
+	// The payment will be unregistered once it returns, until then we'll
+	// make sure not to close its channel twice.
+	select {
+	case <-cancelChan:
+	default:
+		log.Infof("Cancelling payment %x", paymentHash[:])
+		close(cancelChan)
+	}
+
+	return nil
+}
+
 // getFailedEdge tries to locate the failing channel given a route and the
 // pubkey of the node that sent the error. It will assume that the error is
 // associated with the outgoing channel of the error node.
@@ -2069,6 +2271,7 @@ func (r *ChannelRouter) applyChannelUpdate(msg *lnwire.ChannelUpdate,
 		Flags:                     msg.Flags,
 		TimeLockDelta:             msg.TimeLockDelta,
 		MinHTLC:                   msg.HtlcMinimumMsat,
+		MaxHTLC:                   msg.HtlcMaximumMsat,
 		FeeBaseMSat:               lnwire.MilliSatoshi(msg.BaseFee),
 		FeeProportionalMillionths: lnwire.MilliSatoshi(msg.FeeRate),
 	})
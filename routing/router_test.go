@@ -354,6 +354,86 @@ func TestSendPaymentRouteFailureFallback(t *testing.T) {
 	}
 }
 
+// TestSendPaymentCancel asserts that a cancelled payment isn't retried over
+// other routes once its outstanding HTLC fails.
+func TestSendPaymentCancel(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(startingBlockHeight, basicGraphFilePath)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	var payHash [32]byte
+	payHash[0] = 1
+	payment := LightningPayment{
+		Target:      ctx.aliases["luoji"],
+		Amount:      lnwire.NewMSatFromSatoshis(1000),
+		FeeLimit:    noFeeLimit,
+		PaymentHash: payHash,
+	}
+
+	sourceNode := ctx.router.selfNode
+	sourcePub, err := sourceNode.PubKey()
+	if err != nil {
+		t.Fatalf("unable to fetch source pubkey: %v", err)
+	}
+
+	// We'll hold on to the first HTLC until the payment has been
+	// cancelled, and then fail it with an error that would otherwise lead
+	// the router to try another route.
+	attempts := make(chan struct{}, 10)
+	release := make(chan struct{})
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+
+		attempts <- struct{}{}
+		<-release
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource:    sourcePub,
+			FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+		}
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		_, _, err := ctx.router.SendPayment(&payment)
+		errChan <- err
+	}()
+
+	select {
+	case <-attempts:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("payment wasn't attempted")
+	}
+
+	if err := ctx.router.CancelPayment(payHash); err != nil {
+		t.Fatalf("unable to cancel payment: %v", err)
+	}
+	close(release)
+
+	select {
+	case err := <-errChan:
+		if !IsError(err, ErrPaymentCancelled) {
+			t.Fatalf("expected ErrPaymentCancelled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("payment wasn't cancelled")
+	}
+
+	if len(attempts) != 0 {
+		t.Fatalf("expected no further attempts, got %v", len(attempts))
+	}
+
+	// Now that the payment returned, it can no longer be cancelled.
+	if err := ctx.router.CancelPayment(payHash); err == nil {
+		t.Fatalf("expected cancelling unknown payment to fail")
+	}
+}
+
 // TestChannelUpdateValidation tests that a failed payment with an associated
 // channel update will only be applied to the graph when the update contains a
 // valid signature.
@@ -2138,6 +2218,39 @@ func TestIsStaleEdgePolicy(t *testing.T) {
 	}
 }
 
+// TestIsZombieChannel tests that a channel is only considered a zombie once
+// neither of its ends has been updated within the channel prune expiry.
+func TestIsZombieChannel(t *testing.T) {
+	t.Parallel()
+
+	fresh := &channeldb.ChannelEdgePolicy{LastUpdate: time.Now()}
+	stale := &channeldb.ChannelEdgePolicy{
+		LastUpdate: time.Now().Add(-2 * time.Hour),
+	}
+
+	testCases := []struct {
+		e1, e2 *channeldb.ChannelEdgePolicy
+		zombie bool
+	}{
+		{e1: fresh, e2: fresh, zombie: false},
+		{e1: fresh, e2: stale, zombie: false},
+		{e1: nil, e2: fresh, zombie: false},
+		{e1: stale, e2: stale, zombie: true},
+		{e1: stale, e2: nil, zombie: true},
+		{e1: nil, e2: nil, zombie: true},
+	}
+
+	for i, testCase := range testCases {
+		zombie := IsZombieChannel(
+			testCase.e1, testCase.e2, time.Hour,
+		)
+		if zombie != testCase.zombie {
+			t.Fatalf("test #%d: expected zombie %v, got %v", i,
+				testCase.zombie, zombie)
+		}
+	}
+}
+
 // TestEmptyRoutesGenerateSphinxPacket tests that the generateSphinxPacket
 // function is able to gracefully handle being passed a nil set of hops for the
 // route by the caller.
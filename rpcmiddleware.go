@@ -0,0 +1,501 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+const (
+	// middlewareInterceptTimeout is the maximum amount of time we wait
+	// for an RPC middleware to give feedback on an intercepted message
+	// before the RPC call is aborted.
+	middlewareInterceptTimeout = 2 * time.Second
+
+	// registerMiddlewareURI is the full URI of the RPC that is used to
+	// register a middleware. Calls to it are never intercepted.
+	registerMiddlewareURI = "/lnrpc.Lightning/RegisterRPCMiddleware"
+)
+
+var (
+	// errMiddlewareShutdown is returned when a middleware stream is
+	// closed while an intercepted message is waiting for feedback.
+	errMiddlewareShutdown = errors.New("rpc middleware shutting down")
+)
+
+// rpcMiddlewareHandler is the server side of a single registered RPC
+// middleware. It forwards intercepted messages to the middleware over its
+// stream and hands the feedback back to the waiting interceptors.
+type rpcMiddlewareHandler struct {
+	nextMsgID uint64 // To be used atomically.
+
+	middlewareName   string
+	customCaveatName string
+
+	stream  lnrpc.Lightning_RegisterRPCMiddlewareServer
+	sendMtx sync.Mutex
+
+	// pending maps the ID of each intercept message that was sent to the
+	// middleware to the channel the feedback should be delivered on.
+	pending    map[uint64]chan *lnrpc.InterceptFeedback
+	pendingMtx sync.Mutex
+
+	quit chan struct{}
+}
+
+// newRPCMiddlewareHandler creates a new handler for a middleware that
+// registered for the given custom caveat name.
+func newRPCMiddlewareHandler(name, customCaveatName string,
+	stream lnrpc.Lightning_RegisterRPCMiddlewareServer) *rpcMiddlewareHandler {
+
+	return &rpcMiddlewareHandler{
+		middlewareName:   name,
+		customCaveatName: customCaveatName,
+		stream:           stream,
+		pending:          make(map[uint64]chan *lnrpc.InterceptFeedback),
+		quit:             make(chan struct{}),
+	}
+}
+
+// send sends a single message to the middleware. The stream doesn't allow
+// concurrent sends, so all messages must be sent through this method.
+func (h *rpcMiddlewareHandler) send(req *lnrpc.RPCMiddlewareRequest) error {
+	h.sendMtx.Lock()
+	defer h.sendMtx.Unlock()
+
+	return h.stream.Send(req)
+}
+
+// run reads the feedback sent by the middleware and dispatches it to the
+// interceptors waiting for it. It blocks until either the stream is closed or
+// the passed quit channel is closed.
+func (h *rpcMiddlewareHandler) run(serverQuit chan struct{}) error {
+	defer close(h.quit)
+
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := h.stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			feedback := resp.GetFeedback()
+			if feedback == nil {
+				errChan <- fmt.Errorf("middleware %v sent "+
+					"message without feedback",
+					h.middlewareName)
+				return
+			}
+
+			h.pendingMtx.Lock()
+			feedbackChan, ok := h.pending[resp.RefMsgId]
+			delete(h.pending, resp.RefMsgId)
+			h.pendingMtx.Unlock()
+
+			if !ok {
+				rpcsLog.Warnf("Middleware %v sent feedback "+
+					"for unknown message %v",
+					h.middlewareName, resp.RefMsgId)
+				continue
+			}
+
+			// The channel is buffered, so this never blocks.
+			feedbackChan <- feedback
+		}
+	}()
+
+	select {
+	case err := <-errChan:
+		return err
+
+	case <-serverQuit:
+		return nil
+	}
+}
+
+// intercept sends the intercept message to the middleware and waits for its
+// feedback.
+func (h *rpcMiddlewareHandler) intercept(
+	req *lnrpc.RPCMiddlewareRequest) (*lnrpc.InterceptFeedback, error) {
+
+	req.MsgId = atomic.AddUint64(&h.nextMsgID, 1)
+
+	feedbackChan := make(chan *lnrpc.InterceptFeedback, 1)
+	h.pendingMtx.Lock()
+	h.pending[req.MsgId] = feedbackChan
+	h.pendingMtx.Unlock()
+
+	defer func() {
+		h.pendingMtx.Lock()
+		delete(h.pending, req.MsgId)
+		h.pendingMtx.Unlock()
+	}()
+
+	if err := h.send(req); err != nil {
+		return nil, err
+	}
+
+	select {
+	case feedback := <-feedbackChan:
+		return feedback, nil
+
+	case <-time.After(middlewareInterceptTimeout):
+		return nil, fmt.Errorf("timeout waiting for feedback from "+
+			"middleware %v", h.middlewareName)
+
+	case <-h.quit:
+		return nil, errMiddlewareShutdown
+	}
+}
+
+// middlewareTarget is a registered middleware that an RPC call must be sent
+// to, along with the condition of the custom caveat it registered for.
+type middlewareTarget struct {
+	handler   *rpcMiddlewareHandler
+	condition string
+}
+
+// rpcMiddlewareRegistry keeps track of all registered RPC middlewares and
+// provides the gRPC interceptors that send every request and response of an
+// RPC call made with a macaroon that carries a middleware's custom caveat to
+// that middleware.
+type rpcMiddlewareRegistry struct {
+	nextRequestID uint64 // To be used atomically.
+
+	sync.RWMutex
+	handlers map[string]*rpcMiddlewareHandler
+}
+
+// A compile-time constraint to ensure rpcMiddlewareRegistry implements
+// macaroons.CustomCaveatAcceptor.
+var _ macaroons.CustomCaveatAcceptor = (*rpcMiddlewareRegistry)(nil)
+
+// newRPCMiddlewareRegistry creates a new registry without any middlewares.
+func newRPCMiddlewareRegistry() *rpcMiddlewareRegistry {
+	return &rpcMiddlewareRegistry{
+		handlers: make(map[string]*rpcMiddlewareHandler),
+	}
+}
+
+// register adds a new middleware to the registry. Both the name of the
+// middleware and the custom caveat name must be unique.
+func (m *rpcMiddlewareRegistry) register(h *rpcMiddlewareHandler) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.handlers[h.middlewareName]; ok {
+		return fmt.Errorf("middleware with name %v already registered",
+			h.middlewareName)
+	}
+	for _, handler := range m.handlers {
+		if handler.customCaveatName == h.customCaveatName {
+			return fmt.Errorf("middleware %v already registered "+
+				"for custom caveat %v", handler.middlewareName,
+				h.customCaveatName)
+		}
+	}
+
+	m.handlers[h.middlewareName] = h
+
+	return nil
+}
+
+// remove removes the middleware with the given name from the registry.
+func (m *rpcMiddlewareRegistry) remove(name string) {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.handlers, name)
+}
+
+// CustomCaveatSupported returns nil if a middleware is registered for the
+// given custom caveat name.
+//
+// NOTE: Part of the macaroons.CustomCaveatAcceptor interface.
+func (m *rpcMiddlewareRegistry) CustomCaveatSupported(name string) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, handler := range m.handlers {
+		if handler.customCaveatName == name {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot accept macaroon with custom caveat %v, no "+
+		"middleware registered to handle it", name)
+}
+
+// targetsForCall returns all middlewares the RPC call with the given context
+// must be sent to, along with the raw macaroon of the call.
+func (m *rpcMiddlewareRegistry) targetsForCall(ctx context.Context,
+	fullMethod string) ([]middlewareTarget, []byte, error) {
+
+	// The middlewares themselves are never intercepted.
+	if fullMethod == registerMiddlewareURI {
+		return nil, nil, nil
+	}
+
+	m.RLock()
+	handlers := make([]*rpcMiddlewareHandler, 0, len(m.handlers))
+	for _, handler := range m.handlers {
+		handlers = append(handlers, handler)
+	}
+	m.RUnlock()
+
+	if len(handlers) == 0 {
+		return nil, nil, nil
+	}
+
+	// The macaroon has already been validated at this point, so we only
+	// need to extract it to find out which custom caveats it carries.
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["macaroon"]) != 1 {
+		return nil, nil, nil
+	}
+	rawMac, err := hex.DecodeString(md["macaroon"][0])
+	if err != nil {
+		return nil, nil, err
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(rawMac); err != nil {
+		return nil, nil, err
+	}
+
+	var targets []middlewareTarget
+	for _, handler := range handlers {
+		condition, ok := macaroons.GetCustomCaveatCondition(
+			mac, handler.customCaveatName,
+		)
+		if !ok {
+			continue
+		}
+
+		targets = append(targets, middlewareTarget{
+			handler:   handler,
+			condition: condition,
+		})
+	}
+
+	return targets, rawMac, nil
+}
+
+// interceptMessage sends the given request or response message to all target
+// middlewares in turn. If a middleware rejects the message, its error is
+// returned. If a middleware replaces the message, the passed message is
+// overwritten in place, so the next middleware sees the replacement.
+func interceptMessage(targets []middlewareTarget, requestID uint64,
+	rawMac []byte, fullMethod string, isStream, isRequest bool,
+	msg interface{}) error {
+
+	protoMsg, ok := msg.(proto.Message)
+	if !ok {
+		return fmt.Errorf("unable to intercept message of type %T",
+			msg)
+	}
+
+	for _, target := range targets {
+		serialized, err := proto.Marshal(protoMsg)
+		if err != nil {
+			return err
+		}
+
+		rpcMsg := &lnrpc.RPCMessage{
+			MethodFullUri: fullMethod,
+			StreamRpc:     isStream,
+			TypeName:      proto.MessageName(protoMsg),
+			Serialized:    serialized,
+		}
+		req := &lnrpc.RPCMiddlewareRequest{
+			RequestId:             requestID,
+			RawMacaroon:           rawMac,
+			CustomCaveatCondition: target.condition,
+		}
+		if isRequest {
+			req.Request = rpcMsg
+		} else {
+			req.Response = rpcMsg
+		}
+
+		feedback, err := target.handler.intercept(req)
+		if err != nil {
+			return err
+		}
+
+		if feedback.Error != "" {
+			return fmt.Errorf("rejected by middleware %v: %v",
+				target.handler.middlewareName, feedback.Error)
+		}
+
+		if !feedback.ReplaceMessage {
+			continue
+		}
+
+		// Unmarshal resets the message before decoding, so the
+		// content of the message is fully replaced.
+		err = proto.Unmarshal(feedback.ReplacementSerialized, protoMsg)
+		if err != nil {
+			return fmt.Errorf("unable to parse replacement message "+
+				"of middleware %v: %v",
+				target.handler.middlewareName, err)
+		}
+	}
+
+	return nil
+}
+
+// UnaryServerInterceptor is a gRPC interceptor that sends the request and the
+// response of a unary RPC call to the middlewares responsible for the custom
+// caveats of the call's macaroon.
+func (m *rpcMiddlewareRegistry) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		targets, rawMac, err := m.targetsForCall(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		if len(targets) == 0 {
+			return handler(ctx, req)
+		}
+
+		requestID := atomic.AddUint64(&m.nextRequestID, 1)
+		err = interceptMessage(
+			targets, requestID, rawMac, info.FullMethod, false,
+			true, req,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		err = interceptMessage(
+			targets, requestID, rawMac, info.FullMethod, false,
+			false, resp,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor is a gRPC interceptor that sends every message
+// received and sent over a streaming RPC to the middlewares responsible for
+// the custom caveats of the call's macaroon.
+func (m *rpcMiddlewareRegistry) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		targets, rawMac, err := m.targetsForCall(
+			ss.Context(), info.FullMethod,
+		)
+		if err != nil {
+			return err
+		}
+		if len(targets) == 0 {
+			return handler(srv, ss)
+		}
+
+		return handler(srv, &middlewareServerStream{
+			ServerStream: ss,
+			targets:      targets,
+			requestID:    atomic.AddUint64(&m.nextRequestID, 1),
+			rawMac:       rawMac,
+			fullMethod:   info.FullMethod,
+		})
+	}
+}
+
+// middlewareServerStream wraps a gRPC server stream and intercepts all
+// messages that are received from and sent to the client.
+type middlewareServerStream struct {
+	grpc.ServerStream
+
+	targets    []middlewareTarget
+	requestID  uint64
+	rawMac     []byte
+	fullMethod string
+}
+
+// RecvMsg receives a message from the client and sends it to the middlewares
+// before handing it to the RPC handler.
+//
+// NOTE: Part of the grpc.ServerStream interface.
+func (s *middlewareServerStream) RecvMsg(msg interface{}) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+
+	return interceptMessage(
+		s.targets, s.requestID, s.rawMac, s.fullMethod, true, true, msg,
+	)
+}
+
+// SendMsg sends a message of the RPC handler to the middlewares before
+// sending it to the client.
+//
+// NOTE: Part of the grpc.ServerStream interface.
+func (s *middlewareServerStream) SendMsg(msg interface{}) error {
+	err := interceptMessage(
+		s.targets, s.requestID, s.rawMac, s.fullMethod, true, false,
+		msg,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.ServerStream.SendMsg(msg)
+}
+
+// chainUnaryServerInterceptors combines two unary interceptors into one, the
+// first one being the outermost.
+func chainUnaryServerInterceptors(first,
+	second grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		return first(ctx, req, info, func(ctx context.Context,
+			req interface{}) (interface{}, error) {
+
+			return second(ctx, req, info, handler)
+		})
+	}
+}
+
+// chainStreamServerInterceptors combines two stream interceptors into one, the
+// first one being the outermost.
+func chainStreamServerInterceptors(first,
+	second grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		return first(srv, ss, info, func(srv interface{},
+			ss grpc.ServerStream) error {
+
+			return second(srv, ss, info, handler)
+		})
+	}
+}
@@ -0,0 +1,405 @@
+// +build !rpctest
+
+package main
+
+import (
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+const (
+	testMiddlewareName = "test-middleware"
+	testCaveatName     = "test-caveat"
+	testCaveatCond     = "test-condition"
+	testMethodURI      = "/lnrpc.Lightning/GetInfo"
+)
+
+// mockMiddlewareStream is a mock of the server side of the stream that a
+// middleware registered with. The messages sent to the middleware are
+// delivered on the sent channel, while its responses are read from recv. Once
+// quit is closed, the middleware is considered disconnected.
+type mockMiddlewareStream struct {
+	grpc.ServerStream
+
+	sent chan *lnrpc.RPCMiddlewareRequest
+	recv chan *lnrpc.RPCMiddlewareResponse
+	quit chan struct{}
+}
+
+func (m *mockMiddlewareStream) Send(req *lnrpc.RPCMiddlewareRequest) error {
+	select {
+	case m.sent <- req:
+		return nil
+	case <-m.quit:
+		return io.EOF
+	}
+}
+
+func (m *mockMiddlewareStream) Recv() (*lnrpc.RPCMiddlewareResponse, error) {
+	select {
+	case resp := <-m.recv:
+		return resp, nil
+	case <-m.quit:
+		return nil, io.EOF
+	}
+}
+
+// interceptFunc returns the feedback of the mock middleware for an
+// intercepted message.
+type interceptFunc func(*lnrpc.RPCMiddlewareRequest) *lnrpc.InterceptFeedback
+
+// interceptNext waits for the next message sent to the middleware and
+// replies with the feedback returned by the passed closure.
+func (m *mockMiddlewareStream) interceptNext(t *testing.T,
+	feedback interceptFunc) *lnrpc.RPCMiddlewareRequest {
+
+	t.Helper()
+
+	var req *lnrpc.RPCMiddlewareRequest
+	select {
+	case req = <-m.sent:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("middleware didn't receive intercept message")
+	}
+
+	m.recv <- &lnrpc.RPCMiddlewareResponse{
+		RefMsgId: req.MsgId,
+		Feedback: feedback(req),
+	}
+
+	return req
+}
+
+// accept is an interception closure that accepts the message unchanged.
+func accept(*lnrpc.RPCMiddlewareRequest) *lnrpc.InterceptFeedback {
+	return &lnrpc.InterceptFeedback{}
+}
+
+// middlewareHarness houses a registry with a single registered middleware,
+// whose stream is mocked.
+type middlewareHarness struct {
+	registry *rpcMiddlewareRegistry
+	stream   *mockMiddlewareStream
+
+	// runErr receives the error the middleware's handler exits with.
+	runErr chan error
+
+	serverQuit chan struct{}
+}
+
+// newMiddlewareHarness registers a middleware for testCaveatName with a new
+// registry and starts its handler.
+func newMiddlewareHarness(t *testing.T) *middlewareHarness {
+	stream := &mockMiddlewareStream{
+		sent: make(chan *lnrpc.RPCMiddlewareRequest),
+		recv: make(chan *lnrpc.RPCMiddlewareResponse),
+		quit: make(chan struct{}),
+	}
+
+	registry := newRPCMiddlewareRegistry()
+	handler := newRPCMiddlewareHandler(
+		testMiddlewareName, testCaveatName, stream,
+	)
+	if err := registry.register(handler); err != nil {
+		t.Fatalf("unable to register middleware: %v", err)
+	}
+
+	h := &middlewareHarness{
+		registry:   registry,
+		stream:     stream,
+		runErr:     make(chan error, 1),
+		serverQuit: make(chan struct{}),
+	}
+	go func() {
+		h.runErr <- handler.run(h.serverQuit)
+	}()
+
+	return h
+}
+
+// stop shuts down the middleware's handler, as the RPC server would on
+// shutdown.
+func (h *middlewareHarness) stop() {
+	close(h.serverQuit)
+}
+
+// unaryResult is the outcome of an intercepted unary RPC call.
+type unaryResult struct {
+	resp interface{}
+	err  error
+}
+
+// callUnary makes a GetInfo call through the registry's unary interceptor in
+// a new goroutine, using a macaroon that carries the passed caveats. The
+// handler of the call replies with the passed alias, and the number of times
+// it was invoked is counted by calls.
+func (h *middlewareHarness) callUnary(t *testing.T,
+	caveats []func(*macaroon.Macaroon) error, alias string,
+	calls *int) <-chan unaryResult {
+
+	mac, err := macaroon.New(
+		[]byte("root-key"), []byte("id"), "lnd", macaroon.LatestVersion,
+	)
+	if err != nil {
+		t.Fatalf("unable to create macaroon: %v", err)
+	}
+	for _, caveat := range caveats {
+		if err := caveat(mac); err != nil {
+			t.Fatalf("unable to add caveat: %v", err)
+		}
+	}
+	rawMac, err := mac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unable to serialize macaroon: %v", err)
+	}
+
+	ctx := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs("macaroon", hex.EncodeToString(rawMac)),
+	)
+	info := &grpc.UnaryServerInfo{FullMethod: testMethodURI}
+	handler := func(ctx context.Context,
+		req interface{}) (interface{}, error) {
+
+		*calls++
+		return &lnrpc.GetInfoResponse{Alias: alias}, nil
+	}
+
+	resultChan := make(chan unaryResult, 1)
+	go func() {
+		interceptor := h.registry.UnaryServerInterceptor()
+		resp, err := interceptor(
+			ctx, &lnrpc.GetInfoRequest{}, info, handler,
+		)
+		resultChan <- unaryResult{resp: resp, err: err}
+	}()
+
+	return resultChan
+}
+
+// waitResult waits for the result of an intercepted call.
+func waitResult(t *testing.T, resultChan <-chan unaryResult) unaryResult {
+	t.Helper()
+
+	select {
+	case result := <-resultChan:
+		return result
+	case <-time.After(5 * time.Second):
+		t.Fatalf("intercepted call didn't return")
+	}
+
+	return unaryResult{}
+}
+
+// TestRPCMiddlewareAccept asserts that both the request and the response of
+// a call made with the middleware's custom caveat are sent to the
+// middleware, and that calls without it aren't intercepted.
+func TestRPCMiddlewareAccept(t *testing.T) {
+	t.Parallel()
+
+	h := newMiddlewareHarness(t)
+	defer h.stop()
+
+	caveats := []func(*macaroon.Macaroon) error{
+		macaroons.CustomConstraint(testCaveatName, testCaveatCond),
+	}
+
+	var calls int
+	resultChan := h.callUnary(t, caveats, "alias", &calls)
+
+	req := h.stream.interceptNext(t, accept)
+	if req.Request == nil || req.Response != nil {
+		t.Fatalf("expected intercepted request, got %v", req)
+	}
+	if req.CustomCaveatCondition != testCaveatCond {
+		t.Fatalf("expected condition %v, got %v", testCaveatCond,
+			req.CustomCaveatCondition)
+	}
+	if req.Request.MethodFullUri != testMethodURI {
+		t.Fatalf("expected method %v, got %v", testMethodURI,
+			req.Request.MethodFullUri)
+	}
+	if req.Request.TypeName != "lnrpc.GetInfoRequest" {
+		t.Fatalf("unexpected request type %v", req.Request.TypeName)
+	}
+
+	resp := h.stream.interceptNext(t, accept)
+	if resp.Response == nil || resp.Request != nil {
+		t.Fatalf("expected intercepted response, got %v", resp)
+	}
+	if resp.RequestId != req.RequestId {
+		t.Fatalf("expected request ID %v for response, got %v",
+			req.RequestId, resp.RequestId)
+	}
+	if resp.MsgId == req.MsgId {
+		t.Fatalf("request and response share message ID %v",
+			req.MsgId)
+	}
+
+	result := waitResult(t, resultChan)
+	if result.err != nil {
+		t.Fatalf("unexpected error: %v", result.err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected handler to be called once, got %v", calls)
+	}
+	alias := result.resp.(*lnrpc.GetInfoResponse).Alias
+	if alias != "alias" {
+		t.Fatalf("expected unchanged response, got alias %v", alias)
+	}
+
+	// A call made with a macaroon that doesn't carry the custom caveat
+	// should be passed straight to the handler.
+	result = waitResult(t, h.callUnary(t, nil, "alias", &calls))
+	if result.err != nil {
+		t.Fatalf("unexpected error: %v", result.err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected handler to be called twice, got %v", calls)
+	}
+	select {
+	case req := <-h.stream.sent:
+		t.Fatalf("unexpected intercept message %v", req)
+	default:
+	}
+}
+
+// TestRPCMiddlewareReject asserts that a request rejected by the middleware
+// never reaches the handler, and that the middleware's error is returned to
+// the client instead.
+func TestRPCMiddlewareReject(t *testing.T) {
+	t.Parallel()
+
+	h := newMiddlewareHarness(t)
+	defer h.stop()
+
+	caveats := []func(*macaroon.Macaroon) error{
+		macaroons.CustomConstraint(testCaveatName, testCaveatCond),
+	}
+
+	var calls int
+	resultChan := h.callUnary(t, caveats, "alias", &calls)
+
+	h.stream.interceptNext(t, func(
+		*lnrpc.RPCMiddlewareRequest) *lnrpc.InterceptFeedback {
+
+		return &lnrpc.InterceptFeedback{Error: "not allowed"}
+	})
+
+	result := waitResult(t, resultChan)
+	if result.err == nil ||
+		!strings.Contains(result.err.Error(), "not allowed") {
+
+		t.Fatalf("expected rejection, got %v", result.err)
+	}
+	if calls != 0 {
+		t.Fatalf("handler of rejected request was called")
+	}
+}
+
+// TestRPCMiddlewareRewrite asserts that the middleware can replace the
+// response of a call.
+func TestRPCMiddlewareRewrite(t *testing.T) {
+	t.Parallel()
+
+	h := newMiddlewareHarness(t)
+	defer h.stop()
+
+	caveats := []func(*macaroon.Macaroon) error{
+		macaroons.CustomConstraint(testCaveatName, testCaveatCond),
+	}
+
+	var calls int
+	resultChan := h.callUnary(t, caveats, "alias", &calls)
+
+	h.stream.interceptNext(t, accept)
+	h.stream.interceptNext(t, func(
+		req *lnrpc.RPCMiddlewareRequest) *lnrpc.InterceptFeedback {
+
+		resp := &lnrpc.GetInfoResponse{}
+		err := proto.Unmarshal(req.Response.Serialized, resp)
+		if err != nil {
+			t.Errorf("unable to parse response: %v", err)
+		}
+		resp.Alias = "rewritten " + resp.Alias
+
+		replacement, err := proto.Marshal(resp)
+		if err != nil {
+			t.Errorf("unable to serialize replacement: %v", err)
+		}
+
+		return &lnrpc.InterceptFeedback{
+			ReplaceMessage:        true,
+			ReplacementSerialized: replacement,
+		}
+	})
+
+	result := waitResult(t, resultChan)
+	if result.err != nil {
+		t.Fatalf("unexpected error: %v", result.err)
+	}
+	alias := result.resp.(*lnrpc.GetInfoResponse).Alias
+	if alias != "rewritten alias" {
+		t.Fatalf("expected rewritten response, got alias %v", alias)
+	}
+}
+
+// TestRPCMiddlewareDisconnect asserts that a call waiting for feedback is
+// aborted once the middleware disconnects, rather than waiting for the
+// intercept timeout.
+func TestRPCMiddlewareDisconnect(t *testing.T) {
+	t.Parallel()
+
+	h := newMiddlewareHarness(t)
+	defer h.stop()
+
+	caveats := []func(*macaroon.Macaroon) error{
+		macaroons.CustomConstraint(testCaveatName, testCaveatCond),
+	}
+
+	var calls int
+	resultChan := h.callUnary(t, caveats, "alias", &calls)
+
+	// Wait for the request to reach the middleware, then disconnect it
+	// without giving any feedback.
+	select {
+	case <-h.stream.sent:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("middleware didn't receive intercept message")
+	}
+	close(h.stream.quit)
+
+	select {
+	case err := <-h.runErr:
+		if err != io.EOF {
+			t.Fatalf("expected handler to exit with %v, got %v",
+				io.EOF, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("handler didn't exit")
+	}
+
+	select {
+	case result := <-resultChan:
+		if result.err != errMiddlewareShutdown {
+			t.Fatalf("expected %v, got %v", errMiddlewareShutdown,
+				result.err)
+		}
+	case <-time.After(middlewareInterceptTimeout / 2):
+		t.Fatalf("call wasn't aborted on disconnect")
+	}
+	if calls != 0 {
+		t.Fatalf("handler of aborted request was called")
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -29,15 +31,21 @@ import (
 	proxy "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/psbt"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/offers"
+	"github.com/lightningnetwork/lnd/peernotifier"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
+	"github.com/lightningnetwork/lnd/tor"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"github.com/tv42/zbase32"
 	"golang.org/x/net/context"
@@ -97,6 +105,10 @@ var (
 			Entity: "invoices",
 			Action: "read",
 		},
+		{
+			Entity: "macaroon",
+			Action: "read",
+		},
 	}
 
 	// writePermissions is a slice of all entities that allow write
@@ -134,6 +146,14 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		},
+		{
+			Entity: "macaroon",
+			Action: "generate",
+		},
+		{
+			Entity: "macaroon",
+			Action: "write",
+		},
 	}
 
 	// invoicePermissions is a slice of all the entities that allows a user
@@ -158,6 +178,27 @@ var (
 		},
 	}
 
+	// validActions is a list of all actions that are allowed to be used
+	// when baking a new macaroon.
+	validActions = []string{"read", "write", "generate"}
+
+	// validEntities is a list of all entities that are allowed to be used
+	// when baking a new macaroon.
+	validEntities = []string{
+		"onchain", "offchain", "address", "message", "peers", "info",
+		"invoices", "signer", "macaroon",
+	}
+
+	// errMacaroonDisabled is returned when macaroon related calls are
+	// made while lnd was started with the --no-macaroons flag.
+	errMacaroonDisabled = fmt.Errorf("macaroon authentication disabled, " +
+		"remove --no-macaroons flag to enable")
+
+	// errOffersDisabled is returned when offer related calls are made
+	// while lnd wasn't started with the --offers flag.
+	errOffersDisabled = fmt.Errorf("offers disabled, start lnd with " +
+		"--offers to enable")
+
 	// permissions maps RPC calls to the permissions they require.
 	permissions = map[string][]bakery.Op{
 		"/lnrpc.Lightning/SendCoins": {{
@@ -172,10 +213,21 @@ var (
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/EstimateFee": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/NewAddress": {{
 			Entity: "address",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/ListAddresses": {{
+			Entity: "address",
+			Action: "read",
+		}, {
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SignMessage": {{
 			Entity: "message",
 			Action: "write",
@@ -206,6 +258,20 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/BatchOpenChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/FundingStateStep": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/CloseChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -217,14 +283,42 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/FreezeChannel": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/UpdateChannelConstraints": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/GetInfo": {{
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/UpdateNodeAnnouncement": {{
+			Entity: "info",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/GetRecoveryInfo": {{
+			Entity: "info",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ListPeers": {{
 			Entity: "peers",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SubscribePeerEvents": {{
+			Entity: "peers",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SendCustomMessage": {{
+			Entity: "peers",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeCustomMessages": {{
+			Entity: "peers",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/WalletBalance": {{
 			Entity: "onchain",
 			Action: "read",
@@ -241,10 +335,49 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SubscribeChannelEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ExportChannelAudit": {{
+			Entity: "offchain",
+			Action: "read",
+		}, {
+			Entity: "message",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ExportChannelBackup": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ExportAllChannelBackups": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/VerifyChanBackup": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/RestoreChannelBackups": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeChannelBackups": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ClosedChannels": {{
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/LookupHtlcResolution": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ArchiveClosedChannels": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -261,10 +394,22 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/CancelPayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/AddInvoice": {{
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/CreateOffer": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/PayOffer": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/LookupInvoice": {{
 			Entity: "invoices",
 			Action: "read",
@@ -317,6 +462,10 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/DeletePayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/DeleteAllPayments": {{
 			Entity: "offchain",
 			Action: "write",
@@ -341,6 +490,38 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ListSweepableOutputs": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SweepOutputs": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/BakeMacaroon": {{
+			Entity: "macaroon",
+			Action: "generate",
+		}},
+		"/lnrpc.Lightning/ListMacaroonIDs": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/DeleteMacaroonID": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListPermissions": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/CheckMacaroonPermissions": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/RegisterRPCMiddleware": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
 	}
 )
 
@@ -379,6 +560,19 @@ type rpcServer struct {
 	// connect to the main gRPC server to proxy all incoming requests.
 	tlsCfg *tls.Config
 
+	// macService is the macaroon service that we need to mint new
+	// macaroons. It is nil if lnd was started with --no-macaroons.
+	macService *macaroons.Service
+
+	// middleware is the registry of all RPC middlewares that are
+	// currently registered to intercept RPC calls.
+	middleware *rpcMiddlewareRegistry
+
+	// stateService is the State service that reports the lifecycle state
+	// of the daemon. It is served alongside the main RPC server without
+	// macaroon authentication.
+	stateService *stateServer
+
 	quit chan struct{}
 }
 
@@ -392,6 +586,7 @@ var _ lnrpc.LightningServer = (*rpcServer)(nil)
 // base level options passed to the grPC server. This typically includes things
 // like requiring TLS, etc.
 func newRPCServer(s *server, macService *macaroons.Service,
+	middleware *rpcMiddlewareRegistry, stateService *stateServer,
 	subServerCgs *subRPCServerConfigs, serverOpts []grpc.ServerOption,
 	restServerOpts []grpc.DialOption, atpl *autopilot.Manager,
 	tlsCfg *tls.Config) (*rpcServer, error) {
@@ -446,14 +641,23 @@ func newRPCServer(s *server, macService *macaroons.Service,
 
 	// If macaroons aren't disabled (a non-nil service), then we'll set up
 	// our set of interceptors which will allow us handle the macaroon
-	// authentication in a single location .
+	// authentication in a single location . Once a call is authenticated,
+	// it's handed to any RPC middleware responsible for the custom caveats
+	// of its macaroon. Calls to the State service don't require any
+	// authentication.
 	if macService != nil {
-		unaryInterceptor := grpc.UnaryInterceptor(
-			macService.UnaryServerInterceptor(permissions),
-		)
-		streamInterceptor := grpc.StreamInterceptor(
-			macService.StreamServerInterceptor(permissions),
-		)
+		unaryInterceptor := grpc.UnaryInterceptor(stateUnaryInterceptor(
+			chainUnaryServerInterceptors(
+				macService.UnaryServerInterceptor(permissions),
+				middleware.UnaryServerInterceptor(),
+			),
+		))
+		streamInterceptor := grpc.StreamInterceptor(stateStreamInterceptor(
+			chainStreamServerInterceptors(
+				macService.StreamServerInterceptor(permissions),
+				middleware.StreamServerInterceptor(),
+			),
+		))
 
 		serverOpts = append(serverOpts,
 			unaryInterceptor, streamInterceptor,
@@ -469,9 +673,13 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		tlsCfg:         tlsCfg,
 		grpcServer:     grpcServer,
 		server:         s,
+		macService:     macService,
+		middleware:     middleware,
+		stateService:   stateService,
 		quit:           make(chan struct{}, 1),
 	}
 	lnrpc.RegisterLightningServer(grpcServer, rootRPCServer)
+	lnrpc.RegisterStateServer(grpcServer, stateService)
 
 	// Now the main RPC server has been registered, we'll iterate through
 	// all the sub-RPC servers and register them to ensure that requests
@@ -540,6 +748,23 @@ func (r *rpcServer) Start() error {
 	if err != nil {
 		return err
 	}
+
+	// The State service is only served if the daemon is fully running,
+	// and not in observer mode.
+	if r.stateService != nil {
+		err = lnrpc.RegisterStateHandlerFromEndpoint(
+			context.Background(), mux, cfg.RPCListeners[0].String(),
+			r.restServerOpts,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Wrap the REST proxy so that its streaming endpoints can also be
+	// consumed over WebSocket, e.g. by browsers visiting the allowed
+	// origins.
+	wsProxy := lnrpc.NewWebSocketProxy(mux, cfg.WSAllowedOrigins)
 	for _, restEndpoint := range cfg.RESTListeners {
 		lis, err := lncfg.TLSListenOnAddress(restEndpoint, r.tlsCfg)
 		if err != nil {
@@ -556,7 +781,7 @@ func (r *rpcServer) Start() error {
 
 		go func() {
 			rpcsLog.Infof("gRPC proxy started at %s", lis.Addr())
-			http.Serve(lis, mux)
+			http.Serve(lis, wsProxy)
 		}()
 	}
 
@@ -619,18 +844,40 @@ func addrPairsToOutputs(addrPairs map[string]int64) ([]*wire.TxOut, error) {
 	return outputs, nil
 }
 
+// parseDeliveryAddress decodes the passed address, ensuring it belongs to the
+// active network, and returns the script that pays to it.
+func parseDeliveryAddress(addrStr string) (lnwire.DeliveryAddress, error) {
+	addr, err := btcutil.DecodeAddress(addrStr, activeNetParams.Params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid delivery address: %v", err)
+	}
+	if !addr.IsForNet(activeNetParams.Params) {
+		return nil, fmt.Errorf("delivery address %v is not valid for "+
+			"this network", addrStr)
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return pkScript, nil
+}
+
 // sendCoinsOnChain makes an on-chain transaction in or to send coins to one or
 // more addresses specified in the passed payment map. The payment map maps an
 // address to a specified output value to be sent to that address.
+// Only coins with at least minConfs confirmations are spent.
 func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
-	feeRate lnwallet.SatPerKWeight) (*chainhash.Hash, error) {
+	feeRate lnwallet.SatPerKWeight, minConfs int32) (*chainhash.Hash,
+	error) {
 
 	outputs, err := addrPairsToOutputs(paymentMap)
 	if err != nil {
 		return nil, err
 	}
 
-	tx, err := r.server.cc.wallet.SendOutputs(outputs, feeRate)
+	tx, err := r.server.cc.wallet.SendOutputs(outputs, feeRate, minConfs)
 	if err != nil {
 		return nil, err
 	}
@@ -639,6 +886,30 @@ func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
 	return &txHash, err
 }
 
+// parseFeeRateParams returns the manual fee rate in sat/vbyte requested by the
+// passed fee related parameters, taking the deprecated sat/byte fee rate into
+// account. The deprecated fee rate may not be combined with its replacement,
+// and a fee rate can't be combined with a confirmation target.
+func parseFeeRateParams(targetConf int32, satPerByte int64,
+	satPerVbyte uint64) (int64, error) {
+
+	if satPerByte != 0 && satPerVbyte != 0 {
+		return 0, fmt.Errorf("either sat_per_byte or sat_per_vbyte " +
+			"should be set, but not both")
+	}
+
+	feeRate := int64(satPerVbyte)
+	if satPerByte != 0 {
+		feeRate = satPerByte
+	}
+	if targetConf != 0 && feeRate != 0 {
+		return 0, fmt.Errorf("either target_conf or sat_per_vbyte " +
+			"should be set, but not both")
+	}
+
+	return feeRate, nil
+}
+
 // determineFeePerKw will determine the fee in sat/kw that should be paid given
 // an estimator, a confirmation target, and a manual value for sat/byte. A value
 // is chosen based on the two free parameters as one, or both of them can be
@@ -800,26 +1071,88 @@ func (r *rpcServer) ListUnspent(ctx context.Context,
 func (r *rpcServer) SendCoins(ctx context.Context,
 	in *lnrpc.SendCoinsRequest) (*lnrpc.SendCoinsResponse, error) {
 
+	satPerVbyte, err := parseFeeRateParams(
+		in.TargetConf, in.SatPerByte, in.SatPerVbyte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for this transaction.
 	feePerKw, err := determineFeePerKw(
-		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+		r.server.cc.feeEstimator, in.TargetConf, satPerVbyte,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcsLog.Infof("[sendcoins] addr=%v, amt=%v, sat/kw=%v", in.Addr,
-		btcutil.Amount(in.Amount), int64(feePerKw))
+	// We'll validate the label up front, as we don't want to fail after
+	// the transaction has already been broadcast.
+	if in.Label != "" {
+		if err := channeldb.ValidateTxLabel(in.Label); err != nil {
+			return nil, err
+		}
+	}
 
-	paymentMap := map[string]int64{in.Addr: in.Amount}
-	txid, err := r.sendCoinsOnChain(paymentMap, feePerKw)
-	if err != nil {
-		return nil, err
+	// Unless the caller wants to spend unconfirmed coins, we'll only
+	// spend confirmed ones.
+	var minConfs int32 = 1
+	if in.SpendUnconfirmed {
+		minConfs = 0
+	}
+
+	rpcsLog.Infof("[sendcoins] addr=%v, amt=%v, sat/kw=%v, send_all=%v",
+		in.Addr, btcutil.Amount(in.Amount), int64(feePerKw), in.SendAll)
+
+	var txid *chainhash.Hash
+	if in.SendAll {
+		// If the caller wants to sweep the wallet, then the amount is
+		// determined by our coins, so it must not be set.
+		if in.Amount != 0 {
+			return nil, fmt.Errorf("amount set while send_all is " +
+				"active")
+		}
+
+		addr, err := btcutil.DecodeAddress(
+			in.Addr, activeNetParams.Params,
+		)
+		if err != nil {
+			return nil, err
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		sweepTx, err := r.server.cc.wallet.SendAll(
+			pkScript, feePerKw, minConfs,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sweepTxid := sweepTx.TxHash()
+		txid = &sweepTxid
+	} else {
+		paymentMap := map[string]int64{in.Addr: in.Amount}
+		txid, err = r.sendCoinsOnChain(paymentMap, feePerKw, minConfs)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	rpcsLog.Infof("[sendcoins] spend generated txid: %v", txid.String())
 
+	// Now that the transaction is broadcast, failing to persist its label
+	// shouldn't fail the request.
+	if in.Label != "" {
+		if err := r.server.chanDB.PutTxLabel(txid, in.Label); err != nil {
+			rpcsLog.Errorf("[sendcoins] unable to label tx %v: %v",
+				txid, err)
+		}
+	}
+
 	return &lnrpc.SendCoinsResponse{Txid: txid.String()}, nil
 }
 
@@ -840,7 +1173,7 @@ func (r *rpcServer) SendMany(ctx context.Context,
 	rpcsLog.Infof("[sendmany] outputs=%v, sat/kw=%v",
 		spew.Sdump(in.AddrToAmount), int64(feePerKw))
 
-	txid, err := r.sendCoinsOnChain(in.AddrToAmount, feePerKw)
+	txid, err := r.sendCoinsOnChain(in.AddrToAmount, feePerKw, 1)
 	if err != nil {
 		return nil, err
 	}
@@ -850,6 +1183,46 @@ func (r *rpcServer) SendMany(ctx context.Context,
 	return &lnrpc.SendManyResponse{Txid: txid.String()}, nil
 }
 
+// EstimateFee returns the fee and effective fee rate of a transaction paying
+// to the specified outputs, as the wallet would create it for the passed
+// confirmation target.
+func (r *rpcServer) EstimateFee(ctx context.Context,
+	in *lnrpc.EstimateFeeRequest) (*lnrpc.EstimateFeeResponse, error) {
+
+	feePerKw, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := addrPairsToOutputs(in.AddrToAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, weight, err := r.server.cc.wallet.EstimateFee(
+		outputs, feePerKw, 1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The effective fee rate is based on the virtual size of the
+	// transaction, which is a quarter of its weight, rounded up.
+	vsize := (weight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+	satPerVbyte := uint64(int64(fee) / vsize)
+
+	rpcsLog.Debugf("[estimatefee] fee=%v, weight=%v, sat/vbyte=%v", fee,
+		weight, satPerVbyte)
+
+	return &lnrpc.EstimateFeeResponse{
+		FeeSat:      int64(fee),
+		SatPerVbyte: satPerVbyte,
+	}, nil
+}
+
 // NewAddress creates a new address under control of the local wallet.
 func (r *rpcServer) NewAddress(ctx context.Context,
 	in *lnrpc.NewAddressRequest) (*lnrpc.NewAddressResponse, error) {
@@ -873,6 +1246,44 @@ func (r *rpcServer) NewAddress(ctx context.Context,
 	return &lnrpc.NewAddressResponse{Address: addr.String()}, nil
 }
 
+// ListAddresses returns all addresses derived by the wallet so far, along with
+// their derivation paths, types and balances.
+func (r *rpcServer) ListAddresses(ctx context.Context,
+	in *lnrpc.ListAddressesRequest) (*lnrpc.ListAddressesResponse, error) {
+
+	addrs, err := r.server.cc.wallet.ListAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ListAddressesResponse{
+		Addresses: make([]*lnrpc.WalletAddress, 0, len(addrs)),
+	}
+	for _, addr := range addrs {
+		var addrType lnrpc.AddressType
+		switch addr.AddressType {
+		case lnwallet.WitnessPubKey:
+			addrType = lnrpc.AddressType_WITNESS_PUBKEY_HASH
+
+		case lnwallet.NestedWitnessPubKey:
+			addrType = lnrpc.AddressType_NESTED_PUBKEY_HASH
+
+		default:
+			return nil, fmt.Errorf("invalid address type")
+		}
+
+		resp.Addresses = append(resp.Addresses, &lnrpc.WalletAddress{
+			Address:        addr.Address.EncodeAddress(),
+			AddressType:    addrType,
+			IsInternal:     addr.Internal,
+			DerivationPath: addr.DerivationPath,
+			Balance:        int64(addr.Balance),
+		})
+	}
+
+	return resp, nil
+}
+
 var (
 	// signedMsgPrefix is a special prefix that we'll prepend to any
 	// messages we sign/verify. We do this to ensure that we don't
@@ -882,6 +1293,16 @@ var (
 	signedMsgPrefix = []byte("Lightning Signed Message:")
 )
 
+// prefixSignedMsg returns a copy of the given message prepended with
+// signedMsgPrefix. A new slice is always allocated, as appending to the
+// prefix directly could write into its spare capacity, which is shared by
+// all concurrent callers.
+func prefixSignedMsg(msg []byte) []byte {
+	prefixedMsg := make([]byte, 0, len(signedMsgPrefix)+len(msg))
+	prefixedMsg = append(prefixedMsg, signedMsgPrefix...)
+	return append(prefixedMsg, msg...)
+}
+
 // SignMessage signs a message with the resident node's private key. The
 // returned signature string is zbase32 encoded and pubkey recoverable, meaning
 // that only the message digest and signature are needed for verification.
@@ -892,8 +1313,8 @@ func (r *rpcServer) SignMessage(ctx context.Context,
 		return nil, fmt.Errorf("need a message to sign")
 	}
 
-	in.Msg = append(signedMsgPrefix, in.Msg...)
-	sigBytes, err := r.server.nodeSigner.SignCompact(in.Msg)
+	msg := prefixSignedMsg(in.Msg)
+	sigBytes, err := r.server.nodeSigner.SignCompact(msg)
 	if err != nil {
 		return nil, err
 	}
@@ -920,8 +1341,7 @@ func (r *rpcServer) VerifyMessage(ctx context.Context,
 	}
 
 	// The signature is over the double-sha256 hash of the message.
-	in.Msg = append(signedMsgPrefix, in.Msg...)
-	digest := chainhash.DoubleHashB(in.Msg)
+	digest := chainhash.DoubleHashB(prefixSignedMsg(in.Msg))
 
 	// RecoverCompact both recovers the pubkey and validates the signature.
 	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), sig, digest)
@@ -989,7 +1409,15 @@ func (r *rpcServer) ConnectPeer(ctx context.Context,
 		ChainNet:    activeNetParams.Net,
 	}
 
-	if err := r.server.ConnectToPeer(peerAddr, in.Perm); err != nil {
+	// If a timeout was specified, then we'll use it for the connection
+	// attempt, otherwise we'll fall back to the default one.
+	timeout := tor.DefaultConnTimeout
+	if in.Timeout != 0 {
+		timeout = time.Duration(in.Timeout) * time.Second
+	}
+
+	err = r.server.ConnectToPeer(peerAddr, in.Perm, timeout)
+	if err != nil {
 		rpcsLog.Errorf("(connectpeer): error connecting to peer: %v", err)
 		return nil, err
 	}
@@ -1052,9 +1480,16 @@ func (r *rpcServer) DisconnectPeer(ctx context.Context,
 // the OpenChannelRequest that each output used to fund the channel's funding
 // transaction should satisfy.
 func extractOpenChannelMinConfs(in *lnrpc.OpenChannelRequest) (int32, error) {
+	return extractMinConfs(in.MinConfs, in.SpendUnconfirmed)
+}
+
+// extractMinConfs determines the minimum number of confirmations that the
+// outputs spent by a funding transaction should satisfy from the MinConfs and
+// SpendUnconfirmed parameters of a request.
+func extractMinConfs(minConfs int32, spendUnconfirmed bool) (int32, error) {
 	switch {
 	// Ensure that the MinConfs parameter is non-negative.
-	case in.MinConfs < 0:
+	case minConfs < 0:
 		return 0, errors.New("minimum number of confirmations must " +
 			"be a non-negative number")
 
@@ -1063,24 +1498,24 @@ func extractOpenChannelMinConfs(in *lnrpc.OpenChannelRequest) (int32, error) {
 	// provide sane defaults to the OpenChannel RPC, as otherwise, if the
 	// MinConfs field isn't explicitly set by the caller, we'll use
 	// unconfirmed outputs without the caller being aware.
-	case in.MinConfs == 0 && !in.SpendUnconfirmed:
+	case minConfs == 0 && !spendUnconfirmed:
 		return 1, nil
 
 	// In the event that the caller set MinConfs > 0 and SpendUnconfirmed to
 	// true, we'll return an error to indicate the conflict.
-	case in.MinConfs > 0 && in.SpendUnconfirmed:
+	case minConfs > 0 && spendUnconfirmed:
 		return 0, errors.New("SpendUnconfirmed set to true with " +
 			"MinConfs > 0")
 
 	// The funding transaction of the new channel to be created can be
 	// funded with unconfirmed outputs.
-	case in.SpendUnconfirmed:
+	case spendUnconfirmed:
 		return 0, nil
 
 	// If none of the above cases matched, we'll return the value set
 	// explicitly by the caller.
 	default:
-		return in.MinConfs, nil
+		return minConfs, nil
 	}
 }
 
@@ -1176,6 +1611,16 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	rpcsLog.Debugf("[openchannel]: using fee of %v sat/kw for funding tx",
 		int64(feeRate))
 
+	// If a PSBT shim was provided, the funding transaction will be
+	// assembled by an external wallet rather than by ours.
+	var shim *psbtShim
+	if in.PsbtShim != nil {
+		shim, err = parsePsbtShim(in.PsbtShim)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
@@ -1189,6 +1634,7 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		psbtShim:        shim,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1270,6 +1716,13 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 			"wallet is fully synced")
 	}
 
+	// Funding a channel through a PSBT requires the caller to act on the
+	// updates of the funding flow, which this call doesn't return.
+	if in.PsbtShim != nil {
+		return nil, errors.New("PSBT shims are only supported by the " +
+			"streaming OpenChannel call")
+	}
+
 	// Decode the provided target node's public key, parsing it into a pub
 	// key object. For all sync call, byte slices are expected to be
 	// encoded as hex strings.
@@ -1366,20 +1819,316 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	}
 }
 
-// getChanPointFundingTxid returns the given channel point's funding txid in
-// raw bytes.
-func getChanPointFundingTxid(chanPoint *lnrpc.ChannelPoint) ([]byte, error) {
-	var txid []byte
+// BatchOpenChannel attempts to open multiple singly funded channels with a
+// single funding transaction. The call blocks until the funding transaction
+// has been broadcast, or until the batch has failed, in which case none of the
+// channels is opened.
+func (r *rpcServer) BatchOpenChannel(ctx context.Context,
+	in *lnrpc.BatchOpenChannelRequest) (*lnrpc.BatchOpenChannelResponse,
+	error) {
 
-	// A channel point's funding txid can be get/set as a byte slice or a
-	// string. In the case it is a string, decode it.
-	switch chanPoint.GetFundingTxid().(type) {
-	case *lnrpc.ChannelPoint_FundingTxidBytes:
-		txid = chanPoint.GetFundingTxidBytes()
-	case *lnrpc.ChannelPoint_FundingTxidStr:
-		s := chanPoint.GetFundingTxidStr()
-		h, err := chainhash.NewHashFromStr(s)
-		if err != nil {
+	rpcsLog.Tracef("[batchopenchannel] request to open %v channels",
+		len(in.Channels))
+
+	// We don't allow new channels to be open while the server is still
+	// syncing, as otherwise we may not be able to obtain the relevant
+	// notifications.
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	// Creation of channels before the wallet syncs up is currently
+	// disallowed.
+	isSynced, _, err := r.server.cc.wallet.IsSynced()
+	if err != nil {
+		return nil, err
+	}
+	if !isSynced {
+		return nil, errors.New("channels cannot be created before the " +
+			"wallet is fully synced")
+	}
+
+	if len(in.Channels) == 0 {
+		return nil, errors.New("at least one channel must be specified")
+	}
+
+	// The number of confirmations and the fee rate apply to the shared
+	// funding transaction, so they're the same for all channels.
+	minConfs, err := extractMinConfs(in.MinConfs, in.SpendUnconfirmed)
+	if err != nil {
+		return nil, err
+	}
+	feeRate, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Tracef("[batchopenchannel] target sat/kw for funding tx: %v",
+		int64(feeRate))
+
+	// We'll validate all channels of the batch up front, so that the
+	// batch doesn't need to be aborted half way through for an error we
+	// could have caught early.
+	batch := newFundingBatch(len(in.Channels), feeRate, minConfs)
+	reqs := make([]*openChanReq, 0, len(in.Channels))
+	for i, channel := range in.Channels {
+		nodePubKey, err := btcec.ParsePubKey(
+			channel.NodePubkey, btcec.S256(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node pubkey of channel "+
+				"%d: %v", i, err)
+		}
+
+		// The funding flow of every channel needs to be started right
+		// away, so all peers need to be connected.
+		if _, err := r.server.FindPeer(nodePubKey); err != nil {
+			return nil, fmt.Errorf("peer %x of channel %d is not "+
+				"online", channel.NodePubkey, i)
+		}
+
+		localFundingAmt := btcutil.Amount(channel.LocalFundingAmount)
+		remoteInitialBalance := btcutil.Amount(channel.PushSat)
+
+		// Ensure that the initial balance of the remote party (if
+		// pushing satoshis) does not exceed the amount the local party
+		// has requested for funding.
+		if remoteInitialBalance >= localFundingAmt {
+			return nil, fmt.Errorf("amount pushed to remote peer "+
+				"for initial state of channel %d must be below "+
+				"the local funding amount", i)
+		}
+
+		// Ensure that the user doesn't exceed the current soft-limit
+		// for channel size, nor creates a channel that is too small.
+		if localFundingAmt > maxFundingAmount {
+			return nil, fmt.Errorf("funding amount of channel %d is "+
+				"too large, the max channel size is: %v", i,
+				maxFundingAmount)
+		}
+		if localFundingAmt < minChanFundingSize {
+			return nil, fmt.Errorf("channel %d is too small, the "+
+				"minimum channel size is: %v SAT", i,
+				int64(minChanFundingSize))
+		}
+
+		pushAmt := lnwire.NewMSatFromSatoshis(remoteInitialBalance)
+		reqs = append(reqs, &openChanReq{
+			targetPubkey:    nodePubKey,
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: localFundingAmt,
+			pushAmt:         pushAmt,
+			minHtlc:         lnwire.MilliSatoshi(channel.MinHtlcMsat),
+			fundingFeePerKw: feeRate,
+			private:         channel.Private,
+			remoteCsvDelay:  uint16(channel.RemoteCsvDelay),
+			minConfs:        minConfs,
+			batch:           batch,
+		})
+	}
+
+	updateChans := make([]chan *lnrpc.OpenStatusUpdate, len(reqs))
+	errChans := make([]chan error, len(reqs))
+	for i, req := range reqs {
+		updateChans[i], errChans[i] = r.server.OpenChannel(req)
+	}
+
+	// The first update of every channel is sent once the shared funding
+	// transaction has been broadcast. If any of the funding flows fails,
+	// all others are failed as well, so we can return on the first error.
+	resp := &lnrpc.BatchOpenChannelResponse{}
+	for i := range reqs {
+		select {
+		case err := <-errChans[i]:
+			rpcsLog.Errorf("unable to open batched channel to "+
+				"NodeKey(%x): %v", in.Channels[i].NodePubkey, err)
+			return nil, err
+
+		case fundingUpdate := <-updateChans[i]:
+			rpcsLog.Tracef("[batchopenchannel] sending update: %v",
+				fundingUpdate)
+
+			openUpdate := fundingUpdate.Update.(*lnrpc.OpenStatusUpdate_ChanPending)
+			resp.PendingChannels = append(
+				resp.PendingChannels, openUpdate.ChanPending,
+			)
+
+		case <-r.quit:
+			return nil, nil
+		}
+	}
+
+	return resp, nil
+}
+
+// parsePsbtShim validates the PSBT shim of an OpenChannelRequest.
+func parsePsbtShim(rpcShim *lnrpc.PsbtShim) (*psbtShim, error) {
+	pendingChanID, err := parsePendingChanID(rpcShim.PendingChanId)
+	if err != nil {
+		return nil, err
+	}
+
+	shim := &psbtShim{
+		pendingChanID: pendingChanID,
+		noPublish:     rpcShim.NoPublish,
+	}
+
+	if len(rpcShim.BasePsbt) != 0 {
+		shim.basePsbt, err = psbt.Parse(
+			bytes.NewReader(rpcShim.BasePsbt),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base PSBT: %v", err)
+		}
+	}
+
+	return shim, nil
+}
+
+// parsePendingChanID parses a pending channel ID chosen by the caller, which
+// must be 32 bytes long and not all zeroes.
+func parsePendingChanID(rawID []byte) ([32]byte, error) {
+	var pendingChanID [32]byte
+	if len(rawID) != len(pendingChanID) {
+		return pendingChanID, fmt.Errorf("pending channel ID must be "+
+			"%d bytes, got %d", len(pendingChanID), len(rawID))
+	}
+
+	copy(pendingChanID[:], rawID)
+	if pendingChanID == [32]byte{} {
+		return pendingChanID, errors.New("pending channel ID must " +
+			"not be all zeroes")
+	}
+
+	return pendingChanID, nil
+}
+
+// FundingStateStep continues the funding flow of a channel opened with a PSBT
+// shim. The funded PSBT is first verified to pay to the funding output of the
+// channel, then the signed funding transaction is handed over, which is only
+// broadcast once the remote party has signed our commitment transaction.
+func (r *rpcServer) FundingStateStep(ctx context.Context,
+	in *lnrpc.FundingTransitionMsg) (*lnrpc.FundingStateStepResp, error) {
+
+	switch step := in.Trigger.(type) {
+	case *lnrpc.FundingTransitionMsg_ShimCancel:
+		pendingChanID, err := parsePendingChanID(
+			step.ShimCancel.PendingChanId,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcsLog.Debugf("[fundingstatestep] cancelling PSBT funding "+
+			"of pending channel %x", pendingChanID[:])
+
+		err = r.server.fundingMgr.CancelPsbtFunding(pendingChanID)
+		if err != nil {
+			return nil, err
+		}
+
+	case *lnrpc.FundingTransitionMsg_PsbtVerify:
+		pendingChanID, err := parsePendingChanID(
+			step.PsbtVerify.PendingChanId,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		packet, err := psbt.Parse(
+			bytes.NewReader(step.PsbtVerify.FundedPsbt),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid funded PSBT: %v", err)
+		}
+
+		rpcsLog.Debugf("[fundingstatestep] verifying PSBT with txid "+
+			"%v for pending channel %x", packet.UnsignedTx.TxHash(),
+			pendingChanID[:])
+
+		err = r.server.fundingMgr.VerifyPsbtFunding(
+			pendingChanID, packet,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+	case *lnrpc.FundingTransitionMsg_PsbtFinalize:
+		pendingChanID, err := parsePendingChanID(
+			step.PsbtFinalize.PendingChanId,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// The signed funding transaction is either extracted from
+		// the finalized PSBT, or provided as is.
+		var signedTx *wire.MsgTx
+		switch {
+		case len(step.PsbtFinalize.SignedPsbt) != 0:
+			packet, err := psbt.Parse(
+				bytes.NewReader(step.PsbtFinalize.SignedPsbt),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid signed PSBT: "+
+					"%v", err)
+			}
+
+			signedTx, err = psbt.Extract(packet)
+			if err != nil {
+				return nil, err
+			}
+
+		case len(step.PsbtFinalize.FinalRawTx) != 0:
+			signedTx = &wire.MsgTx{}
+			err := signedTx.Deserialize(
+				bytes.NewReader(step.PsbtFinalize.FinalRawTx),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid final raw tx: "+
+					"%v", err)
+			}
+
+		default:
+			return nil, errors.New("either signed_psbt or " +
+				"final_raw_tx must be set")
+		}
+
+		rpcsLog.Debugf("[fundingstatestep] finalizing funding tx %v "+
+			"of pending channel %x", signedTx.TxHash(),
+			pendingChanID[:])
+
+		err = r.server.fundingMgr.FinalizePsbtFunding(
+			pendingChanID, signedTx,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, errors.New("no funding state transition specified")
+	}
+
+	return &lnrpc.FundingStateStepResp{}, nil
+}
+
+// getChanPointFundingTxid returns the given channel point's funding txid in
+// raw bytes.
+func getChanPointFundingTxid(chanPoint *lnrpc.ChannelPoint) ([]byte, error) {
+	var txid []byte
+
+	// A channel point's funding txid can be get/set as a byte slice or a
+	// string. In the case it is a string, decode it.
+	switch chanPoint.GetFundingTxid().(type) {
+	case *lnrpc.ChannelPoint_FundingTxidBytes:
+		txid = chanPoint.GetFundingTxidBytes()
+	case *lnrpc.ChannelPoint_FundingTxidStr:
+		s := chanPoint.GetFundingTxidStr()
+		h, err := chainhash.NewHashFromStr(s)
+		if err != nil {
 			return nil, err
 		}
 
@@ -1418,6 +2167,24 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 	rpcsLog.Tracef("[closechannel] request for ChannelPoint(%v), force=%v",
 		chanPoint, force)
 
+	satPerVbyte, err := parseFeeRateParams(
+		in.TargetConf, in.SatPerByte, in.SatPerVbyte,
+	)
+	if err != nil {
+		return err
+	}
+
+	// A force closure is unilateral, so neither a fee rate nor a delivery
+	// address can be negotiated with the remote peer.
+	if force && (in.TargetConf != 0 || satPerVbyte != 0) {
+		return fmt.Errorf("fee related parameters can't be set when " +
+			"force closing a channel")
+	}
+	if force && in.DeliveryAddress != "" {
+		return fmt.Errorf("a delivery address can't be set when force " +
+			"closing a channel")
+	}
+
 	var (
 		updateChan chan *lnrpc.CloseStatusUpdate
 		errChan    chan error
@@ -1512,12 +2279,24 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// an appropriate fee rate for the cooperative closure
 		// transaction.
 		feeRate, err := determineFeePerKw(
-			r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+			r.server.cc.feeEstimator, in.TargetConf, satPerVbyte,
 		)
 		if err != nil {
 			return err
 		}
 
+		// If a delivery address was specified, then we'll pay our
+		// funds to its script instead of a fresh wallet address.
+		var deliveryScript lnwire.DeliveryAddress
+		if in.DeliveryAddress != "" {
+			deliveryScript, err = parseDeliveryAddress(
+				in.DeliveryAddress,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
 		rpcsLog.Debugf("Target sat/kw for closing transaction: %v",
 			int64(feeRate))
 
@@ -1535,6 +2314,7 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// broadcast details.
 		updateChan, errChan = r.server.htlcSwitch.CloseLink(
 			chanPoint, htlcswitch.CloseRegular, feeRate,
+			deliveryScript,
 		)
 	}
 out:
@@ -1636,6 +2416,159 @@ func (r *rpcServer) AbandonChannel(ctx context.Context,
 	return &lnrpc.AbandonChannelResponse{}, nil
 }
 
+// FreezeChannel puts a channel into drain mode, in which it neither accepts nor
+// offers any new HTLCs, while those already in flight are allowed to resolve.
+// The number of HTLCs still in flight is returned, so the caller can poll for
+// the channel to be drained.
+func (r *rpcServer) FreezeChannel(ctx context.Context,
+	in *lnrpc.FreezeChannelRequest) (*lnrpc.FreezeChannelResponse, error) {
+
+	if in.ChannelPoint == nil {
+		return nil, fmt.Errorf("channel point must be specified")
+	}
+	txidHash, err := getChanPointFundingTxid(in.GetChannelPoint())
+	if err != nil {
+		return nil, err
+	}
+	txid, err := chainhash.NewHash(txidHash)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	dbChan, err := r.fetchOpenDbChannel(*chanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	rpcsLog.Infof("[freezechannel] chan_point=%v, thaw=%v", chanPoint,
+		in.Thaw)
+
+	if in.Thaw {
+		r.server.htlcSwitch.ThawLink(chanID)
+	} else {
+		r.server.htlcSwitch.FreezeLink(chanID)
+	}
+
+	// We'll prefer the link's view of the pending HTLCs, falling back to
+	// the commitment on disk if the link is offline.
+	numPending := len(dbChan.LocalCommitment.Htlcs)
+	pendingHtlcs, err := r.server.htlcSwitch.PendingHTLCs(chanID)
+	if err == nil {
+		numPending = len(pendingHtlcs)
+	}
+
+	return &lnrpc.FreezeChannelResponse{
+		NumPendingHtlcs: uint32(numPending),
+	}, nil
+}
+
+// UpdateChannelConstraints renegotiates the flow constraints which bound the
+// HTLCs that the remote party may offer over a live channel. The channel is
+// quiesced for the duration of the update, which requires the remote peer to
+// support both the quiescence and dynamic commitments protocols.
+func (r *rpcServer) UpdateChannelConstraints(ctx context.Context,
+	in *lnrpc.UpdateChannelConstraintsRequest) (
+	*lnrpc.UpdateChannelConstraintsResponse, error) {
+
+	if in.ChannelPoint == nil {
+		return nil, fmt.Errorf("channel point must be specified")
+	}
+	txidHash, err := getChanPointFundingTxid(in.GetChannelPoint())
+	if err != nil {
+		return nil, err
+	}
+	txid, err := chainhash.NewHash(txidHash)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	dbChan, err := r.fetchOpenDbChannel(*chanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	// The constraints we impose on the remote party are those of its
+	// config. Any value left unspecified is kept as is.
+	current := dbChan.RemoteChanCfg.ChannelConstraints
+	constraints := lnwallet.FlowConstraints{
+		MaxPendingAmount: current.MaxPendingAmount,
+		MinHTLC:          current.MinHTLC,
+		MaxAcceptedHtlcs: current.MaxAcceptedHtlcs,
+	}
+	if in.MaxPendingAmtMsat != 0 {
+		constraints.MaxPendingAmount = lnwire.MilliSatoshi(
+			in.MaxPendingAmtMsat,
+		)
+	}
+	if in.MinHtlcMsat != 0 {
+		constraints.MinHTLC = lnwire.MilliSatoshi(in.MinHtlcMsat)
+	}
+	if in.MaxAcceptedHtlcs != 0 {
+		if in.MaxAcceptedHtlcs > math.MaxUint16 {
+			return nil, fmt.Errorf("max accepted htlcs too large: "+
+				"%v", in.MaxAcceptedHtlcs)
+		}
+		constraints.MaxAcceptedHtlcs = uint16(in.MaxAcceptedHtlcs)
+	}
+
+	rpcsLog.Infof("[updatechanconstraints] chan_point=%v, "+
+		"max_pending_amt=%v, min_htlc=%v, max_accepted_htlcs=%v",
+		chanPoint, constraints.MaxPendingAmount, constraints.MinHTLC,
+		constraints.MaxAcceptedHtlcs)
+
+	// waitLink waits for the outcome of a request made to the link. If
+	// the request doesn't complete, we'll resume the link ourselves so
+	// that it isn't left quiescent.
+	waitLink := func(resp <-chan error) error {
+		var err error
+		select {
+		case err = <-resp:
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-r.quit:
+			err = fmt.Errorf("server shutting down")
+		}
+		if err != nil {
+			r.server.htlcSwitch.ResumeLink(chanID)
+		}
+
+		return err
+	}
+
+	// Before proposing the new constraints, we'll need to wait for all
+	// updates in flight to be irrevocably committed.
+	quiesced, err := r.server.htlcSwitch.QuiesceLink(chanID)
+	if err != nil {
+		return nil, err
+	}
+	if err := waitLink(quiesced); err != nil {
+		return nil, fmt.Errorf("unable to quiesce channel: %v", err)
+	}
+
+	// Once the remote party has responded to our proposal, the link
+	// resumes on its own.
+	updated, err := r.server.htlcSwitch.ProposeLinkConstraints(
+		chanID, constraints,
+	)
+	if err != nil {
+		r.server.htlcSwitch.ResumeLink(chanID)
+		return nil, err
+	}
+	if err := waitLink(updated); err != nil {
+		return nil, fmt.Errorf("unable to update constraints: %v", err)
+	}
+
+	return &lnrpc.UpdateChannelConstraintsResponse{
+		MaxPendingAmtMsat: uint64(constraints.MaxPendingAmount),
+		MinHtlcMsat:       uint64(constraints.MinHTLC),
+		MaxAcceptedHtlcs:  uint32(constraints.MaxAcceptedHtlcs),
+	}, nil
+}
+
 // fetchOpenDbChannel attempts to locate a channel identified by its channel
 // point from the database's set of all currently opened channels.
 func (r *rpcServer) fetchOpenDbChannel(chanPoint wire.OutPoint) (
@@ -1676,6 +2609,14 @@ func (r *rpcServer) fetchActiveChannel(chanPoint wire.OutPoint) (
 		return nil, err
 	}
 
+	// Channels restored from a static backup don't carry any commitment
+	// state, so we can't build a state machine on top of them.
+	if dbChan.HasChanStatus(channeldb.Restored) {
+		return nil, fmt.Errorf("channel %v was restored from a "+
+			"backup and can only be closed by the remote party",
+			chanPoint)
+	}
+
 	// If the channel is successfully fetched from the database,
 	// we create a fully populated channel state machine which
 	// uses the db channel as backing storage.
@@ -1741,6 +2682,16 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 		uris[i] = fmt.Sprintf("%s@%s", encodedIDPub, addr.String())
 	}
 
+	// Report both the global features of our node announcement and the
+	// local features we advertise to our peers upon connecting.
+	var features []uint32
+	for _, feature := range r.server.globalFeatures.Features() {
+		features = append(features, uint32(feature))
+	}
+	for _, feature := range newLocalFeatures().Features() {
+		features = append(features, uint32(feature))
+	}
+
 	// TODO(roasbeef): add synced height n stuff
 	return &lnrpc.GetInfoResponse{
 		IdentityPubkey:      encodedIDPub,
@@ -1751,26 +2702,177 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 		BlockHeight:         uint32(bestHeight),
 		BlockHash:           bestHash.String(),
 		SyncedToChain:       isSynced,
+		SyncedToGraph:       r.server.authGossiper.IsGraphSynced(),
 		Testnet:             isTestnet(&activeNetParams),
 		Chains:              activeChains,
+		Network:             normalizeNetwork(activeNetParams.Name),
 		Uris:                uris,
 		Alias:               nodeAnn.Alias.String(),
 		BestHeaderTimestamp: int64(bestHeaderTimestamp),
 		Version:             build.Version(),
+		Features:            features,
 	}, nil
 }
 
-// ListPeers returns a verbose listing of all currently active peers.
-func (r *rpcServer) ListPeers(ctx context.Context,
-	in *lnrpc.ListPeersRequest) (*lnrpc.ListPeersResponse, error) {
-
-	rpcsLog.Tracef("[listpeers] request")
+// GetRecoveryInfo returns whether the wallet was started in recovery mode,
+// whether the recovery is finished, and the progress it made so far.
+func (r *rpcServer) GetRecoveryInfo(ctx context.Context,
+	in *lnrpc.GetRecoveryInfoRequest) (*lnrpc.GetRecoveryInfoResponse,
+	error) {
 
-	serverPeers := r.server.Peers()
-	resp := &lnrpc.ListPeersResponse{
-		Peers: make([]*lnrpc.Peer, 0, len(serverPeers)),
+	isRecoveryMode, progress, err := r.server.cc.wallet.GetRecoveryInfo()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get wallet recovery info: "+
+			"%v", err)
 	}
 
+	rpcsLog.Debugf("[getrecoveryinfo] is recovery mode=%v, progress=%v",
+		isRecoveryMode, progress)
+
+	return &lnrpc.GetRecoveryInfoResponse{
+		RecoveryMode:     isRecoveryMode,
+		RecoveryFinished: isRecoveryMode && progress == 1,
+		Progress:         progress * 100,
+	}, nil
+}
+
+// UpdateNodeAnnouncement changes the alias, color, advertised addresses and
+// feature bits of our node announcement. The updated announcement is re-signed
+// and broadcast to the network, so no restart is needed for the changes to
+// take effect.
+func (r *rpcServer) UpdateNodeAnnouncement(ctx context.Context,
+	in *lnrpc.UpdateNodeAnnouncementRequest) (
+	*lnrpc.UpdateNodeAnnouncementResponse, error) {
+
+	var updates []func(*lnwire.NodeAnnouncement)
+
+	if in.Alias != "" {
+		alias, err := lnwire.NewNodeAlias(in.Alias)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, lnwire.UpdateNodeAnnAlias(alias))
+	}
+
+	if in.Color != "" {
+		color, err := parseHexColor(in.Color)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, lnwire.UpdateNodeAnnColor(color))
+	}
+
+	if len(in.AddAddresses) > 0 || len(in.RemoveAddresses) > 0 {
+		addAddrs, err := lncfg.NormalizeAddresses(
+			in.AddAddresses, strconv.Itoa(defaultPeerPort),
+			cfg.net.ResolveTCPAddr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse address: %v",
+				err)
+		}
+		removeAddrs, err := lncfg.NormalizeAddresses(
+			in.RemoveAddresses, strconv.Itoa(defaultPeerPort),
+			cfg.net.ResolveTCPAddr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse address: %v",
+				err)
+		}
+
+		removed := make(map[string]struct{}, len(removeAddrs))
+		for _, addr := range removeAddrs {
+			removed[addr.String()] = struct{}{}
+		}
+
+		updates = append(updates, func(nodeAnn *lnwire.NodeAnnouncement) {
+			addrs := make([]net.Addr, 0, len(nodeAnn.Addresses))
+			for _, addr := range nodeAnn.Addresses {
+				if _, ok := removed[addr.String()]; ok {
+					continue
+				}
+				addrs = append(addrs, addr)
+			}
+			nodeAnn.Addresses = append(addrs, addAddrs...)
+		})
+	}
+
+	if len(in.SetFeatures) > 0 || len(in.UnsetFeatures) > 0 {
+		toFeatureBits := func(bits []uint32) ([]lnwire.FeatureBit, error) {
+			featureBits := make([]lnwire.FeatureBit, 0, len(bits))
+			for _, bit := range bits {
+				if bit > math.MaxUint16 {
+					return nil, fmt.Errorf("feature bit %v "+
+						"is too large", bit)
+				}
+				featureBits = append(
+					featureBits, lnwire.FeatureBit(bit),
+				)
+			}
+			return featureBits, nil
+		}
+
+		set, err := toFeatureBits(in.SetFeatures)
+		if err != nil {
+			return nil, err
+		}
+		unset, err := toFeatureBits(in.UnsetFeatures)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(
+			updates, lnwire.UpdateNodeAnnFeatures(set, unset),
+		)
+	}
+
+	if len(updates) == 0 {
+		return nil, fmt.Errorf("no node announcement updates " +
+			"requested")
+	}
+
+	rpcsLog.Infof("[updatenodeannouncement] alias=%v, color=%v, "+
+		"add_addresses=%v, remove_addresses=%v, set_features=%v, "+
+		"unset_features=%v", in.Alias, in.Color, in.AddAddresses,
+		in.RemoveAddresses, in.SetFeatures, in.UnsetFeatures)
+
+	nodeAnn, err := r.server.updateNodeAnnouncement(updates...)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]string, 0, len(nodeAnn.Addresses))
+	for _, addr := range nodeAnn.Addresses {
+		addrs = append(addrs, addr.String())
+	}
+
+	var features []uint32
+	for _, bit := range nodeAnn.Features.Features() {
+		features = append(features, uint32(bit))
+	}
+
+	return &lnrpc.UpdateNodeAnnouncementResponse{
+		Alias: nodeAnn.Alias.String(),
+		Color: fmt.Sprintf("#%02x%02x%02x", nodeAnn.RGBColor.R,
+			nodeAnn.RGBColor.G, nodeAnn.RGBColor.B),
+		Addresses: addrs,
+		Features:  features,
+	}, nil
+}
+
+// ListPeers returns a verbose listing of all currently active peers.
+func (r *rpcServer) ListPeers(ctx context.Context,
+	in *lnrpc.ListPeersRequest) (*lnrpc.ListPeersResponse, error) {
+
+	rpcsLog.Tracef("[listpeers] request")
+
+	serverPeers := r.server.Peers()
+	resp := &lnrpc.ListPeersResponse{
+		Peers: make([]*lnrpc.Peer, 0, len(serverPeers)),
+	}
+
+	rateLimits := r.server.htlcSwitch.HtlcRateLimitStats()
+	holdTimes := r.server.htlcSwitch.PeerHoldTimes()
+
 	for _, serverPeer := range serverPeers {
 		var (
 			satSent int64
@@ -1788,16 +2890,47 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 			satRecv += int64(c.TotalMSatReceived.ToSatoshis())
 		}
 
+		var features []uint32
+		for _, feature := range serverPeer.Features() {
+			features = append(features, uint32(feature))
+		}
+
 		nodePub := serverPeer.addr.IdentityKey.SerializeCompressed()
 		peer := &lnrpc.Peer{
-			PubKey:    hex.EncodeToString(nodePub),
-			Address:   serverPeer.conn.RemoteAddr().String(),
-			Inbound:   serverPeer.inbound,
-			BytesRecv: atomic.LoadUint64(&serverPeer.bytesReceived),
-			BytesSent: atomic.LoadUint64(&serverPeer.bytesSent),
-			SatSent:   satSent,
-			SatRecv:   satRecv,
-			PingTime:  serverPeer.PingTime(),
+			PubKey:         hex.EncodeToString(nodePub),
+			Address:        serverPeer.conn.RemoteAddr().String(),
+			Inbound:        serverPeer.inbound,
+			BytesRecv:      atomic.LoadUint64(&serverPeer.bytesReceived),
+			BytesSent:      atomic.LoadUint64(&serverPeer.bytesSent),
+			SatSent:        satSent,
+			SatRecv:        satRecv,
+			PingTime:       serverPeer.PingTime(),
+			GossipSyncPeer: serverPeer.IsGossipSyncPeer(),
+			Features:       features,
+		}
+
+		lastErr, lastErrTime := serverPeer.LastError()
+		if lastErr != "" {
+			peer.LastError = lastErr
+			peer.LastErrorTimestamp = lastErrTime.Unix()
+		}
+
+		var pubKey [33]byte
+		copy(pubKey[:], nodePub)
+		if stats, ok := rateLimits[pubKey]; ok {
+			peer.HtlcRateLimit = &lnrpc.HtlcRateLimitStats{
+				Accepted: stats.Accepted,
+				Rejected: stats.Rejected,
+			}
+		}
+		if stats, ok := holdTimes[pubKey]; ok {
+			peer.HoldTimes = marshalHoldTimeStats(stats)
+		}
+
+		flaps := r.server.uptimeTracker.Flaps(pubKey)
+		peer.FlapCount = int32(flaps.Count)
+		if !flaps.LastFlap.IsZero() {
+			peer.LastFlapNs = flaps.LastFlap.UnixNano()
 		}
 
 		resp.Peers = append(resp.Peers, peer)
@@ -1808,6 +2941,153 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 	return resp, nil
 }
 
+// SubscribePeerEvents returns a uni-directional stream (server -> client)
+// for notifying the client of peers coming online and going offline.
+func (r *rpcServer) SubscribePeerEvents(req *lnrpc.PeerEventSubscription,
+	eventStream lnrpc.Lightning_SubscribePeerEventsServer) error {
+
+	peerEventSub, err := r.server.peerNotifier.SubscribePeerEvents()
+	if err != nil {
+		return err
+	}
+	defer peerEventSub.Cancel()
+
+	for {
+		select {
+		// A new update has been sent by the peer notifier, we'll
+		// marshal it into the form expected by the gRPC client, then
+		// send it off to the client.
+		case e := <-peerEventSub.Updates():
+			var event *lnrpc.PeerEvent
+
+			switch peerEvent := e.(type) {
+			case peernotifier.PeerOnlineEvent:
+				event = &lnrpc.PeerEvent{
+					PubKey: hex.EncodeToString(
+						peerEvent.PubKey[:],
+					),
+					Type: lnrpc.PeerEvent_PEER_ONLINE,
+				}
+
+			case peernotifier.PeerOfflineEvent:
+				event = &lnrpc.PeerEvent{
+					PubKey: hex.EncodeToString(
+						peerEvent.PubKey[:],
+					),
+					Type: lnrpc.PeerEvent_PEER_OFFLINE,
+				}
+
+			default:
+				return fmt.Errorf("unexpected peer event: %v",
+					peerEvent)
+			}
+
+			if err := eventStream.Send(event); err != nil {
+				return err
+			}
+
+		// The subscription was canceled as the peer notifier is
+		// shutting down.
+		case <-peerEventSub.Quit():
+			return errors.New("peer notifier shutting down")
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// SendCustomMessage sends a custom message to the given connected peer.
+func (r *rpcServer) SendCustomMessage(ctx context.Context,
+	req *lnrpc.SendCustomMessageRequest) (*lnrpc.SendCustomMessageResponse,
+	error) {
+
+	peerKey, err := btcec.ParsePubKey(req.Peer, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse peer pubkey: %v", err)
+	}
+
+	// Peers are required to disconnect upon receiving an unknown even
+	// message, so we'll only allow odd custom messages to be sent, which
+	// peers unaware of them will simply ignore.
+	if req.Type > math.MaxUint16 {
+		return nil, fmt.Errorf("message type %v is too large", req.Type)
+	}
+	if req.Type%2 == 0 {
+		return nil, fmt.Errorf("message type %v must be odd", req.Type)
+	}
+	msg, err := lnwire.NewCustom(lnwire.MessageType(req.Type), req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	serverPeer, err := r.server.FindPeer(peerKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := serverPeer.SendMessage(true, msg); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SendCustomMessageResponse{}, nil
+}
+
+// SubscribeCustomMessages returns a uni-directional stream (server -> client)
+// of the custom messages sent to us by our peers.
+func (r *rpcServer) SubscribeCustomMessages(
+	req *lnrpc.SubscribeCustomMessagesRequest,
+	stream lnrpc.Lightning_SubscribeCustomMessagesServer) error {
+
+	msgSub, err := r.server.peerNotifier.SubscribeCustomMessages()
+	if err != nil {
+		return err
+	}
+	defer msgSub.Cancel()
+
+	for {
+		select {
+		case e := <-msgSub.Updates():
+			event, ok := e.(peernotifier.CustomMessageEvent)
+			if !ok {
+				return fmt.Errorf("unexpected custom message "+
+					"event: %v", e)
+			}
+
+			err := stream.Send(&lnrpc.CustomMessage{
+				Peer: event.PubKey[:],
+				Type: uint32(event.Msg.Type),
+				Data: event.Msg.Data,
+			})
+			if err != nil {
+				return err
+			}
+
+		// The subscription was canceled as the peer notifier is
+		// shutting down.
+		case <-msgSub.Quit():
+			return errors.New("peer notifier shutting down")
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshalHoldTimeStats converts the hold time statistics tracked by the switch
+// into their RPC representation.
+func marshalHoldTimeStats(stats htlcswitch.HoldTimeStats) *lnrpc.HoldTimeStats {
+	return &lnrpc.HoldTimeStats{
+		NumForwards: stats.NumForwards,
+		P50Ms:       int64(stats.P50 / time.Millisecond),
+		P90Ms:       int64(stats.P90 / time.Millisecond),
+		P99Ms:       int64(stats.P99 / time.Millisecond),
+	}
+}
+
 // WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
 // confirmed unspent outputs and all unconfirmed unspent outputs under control
 // by the wallet. This method can be modified by having the request specify
@@ -1831,12 +3111,43 @@ func (r *rpcServer) WalletBalance(ctx context.Context,
 	// Get unconfirmed balance, from txs with 0 confirmations.
 	unconfirmedBal := totalBal - confirmedBal
 
-	rpcsLog.Debugf("[walletbalance] Total balance=%v", totalBal)
+	// Get the balance of the outputs that are locked, which aren't part
+	// of any of the balances above.
+	lockedBal, err := r.server.cc.wallet.LockedBalance()
+	if err != nil {
+		return nil, err
+	}
+
+	// Finally, we'll break down the balances above by the account the
+	// outputs belong to.
+	utxos, err := r.server.cc.wallet.ListUnspentWitness(0, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	accountBals := make(map[string]*lnrpc.WalletAccountBalance)
+	for _, utxo := range utxos {
+		accountBal, ok := accountBals[utxo.Account]
+		if !ok {
+			accountBal = &lnrpc.WalletAccountBalance{}
+			accountBals[utxo.Account] = accountBal
+		}
+
+		if utxo.Confirmations > 0 {
+			accountBal.ConfirmedBalance += int64(utxo.Value)
+		} else {
+			accountBal.UnconfirmedBalance += int64(utxo.Value)
+		}
+	}
+
+	rpcsLog.Debugf("[walletbalance] Total balance=%v, locked balance=%v",
+		totalBal, lockedBal)
 
 	return &lnrpc.WalletBalanceResponse{
 		TotalBalance:       int64(totalBal),
 		ConfirmedBalance:   int64(confirmedBal),
 		UnconfirmedBalance: int64(unconfirmedBal),
+		AccountBalance:     accountBals,
+		LockedBalance:      int64(lockedBal),
 	}, nil
 }
 
@@ -1850,9 +3161,24 @@ func (r *rpcServer) ChannelBalance(ctx context.Context,
 		return nil, err
 	}
 
-	var balance btcutil.Amount
+	var (
+		localBalance, remoteBalance                   lnwire.MilliSatoshi
+		unsettledLocalBalance, unsettledRemoteBalance lnwire.MilliSatoshi
+	)
 	for _, channel := range openChannels {
-		balance += channel.LocalCommitment.LocalBalance.ToSatoshis()
+		commitment := channel.LocalCommitment
+		localBalance += commitment.LocalBalance
+		remoteBalance += commitment.RemoteBalance
+
+		// The in-flight HTLCs are part of neither balance until
+		// they're settled, so we'll report them separately.
+		for _, htlc := range commitment.Htlcs {
+			if htlc.Incoming {
+				unsettledLocalBalance += htlc.Amt
+			} else {
+				unsettledRemoteBalance += htlc.Amt
+			}
+		}
 	}
 
 	pendingChannels, err := r.server.chanDB.FetchPendingChannels()
@@ -1860,17 +3186,41 @@ func (r *rpcServer) ChannelBalance(ctx context.Context,
 		return nil, err
 	}
 
-	var pendingOpenBalance btcutil.Amount
+	var pendingOpenLocalBalance, pendingOpenRemoteBalance lnwire.MilliSatoshi
 	for _, channel := range pendingChannels {
-		pendingOpenBalance += channel.LocalCommitment.LocalBalance.ToSatoshis()
+		commitment := channel.LocalCommitment
+		pendingOpenLocalBalance += commitment.LocalBalance
+		pendingOpenRemoteBalance += commitment.RemoteBalance
 	}
 
+	rpcsLog.Debugf("[channelbalance] local_balance=%v, "+
+		"remote_balance=%v, unsettled_local_balance=%v, "+
+		"unsettled_remote_balance=%v, pending_open_local_balance=%v, "+
+		"pending_open_remote_balance=%v", localBalance, remoteBalance,
+		unsettledLocalBalance, unsettledRemoteBalance,
+		pendingOpenLocalBalance, pendingOpenRemoteBalance)
+
 	return &lnrpc.ChannelBalanceResponse{
-		Balance:            int64(balance),
-		PendingOpenBalance: int64(pendingOpenBalance),
+		Balance:                  int64(localBalance.ToSatoshis()),
+		PendingOpenBalance:       int64(pendingOpenLocalBalance.ToSatoshis()),
+		LocalBalance:             marshalAmount(localBalance),
+		RemoteBalance:            marshalAmount(remoteBalance),
+		UnsettledLocalBalance:    marshalAmount(unsettledLocalBalance),
+		UnsettledRemoteBalance:   marshalAmount(unsettledRemoteBalance),
+		PendingOpenLocalBalance:  marshalAmount(pendingOpenLocalBalance),
+		PendingOpenRemoteBalance: marshalAmount(pendingOpenRemoteBalance),
 	}, nil
 }
 
+// marshalAmount converts an amount in milli-satoshis into its RPC
+// representation, denominated in both satoshis and milli-satoshis.
+func marshalAmount(amt lnwire.MilliSatoshi) *lnrpc.Amount {
+	return &lnrpc.Amount{
+		Sat:  uint64(amt.ToSatoshis()),
+		Msat: uint64(amt),
+	}
+}
+
 // PendingChannels returns a list of all the channels that are currently
 // considered "pending". A channel is pending if it has finished the funding
 // workflow and is waiting for confirmations for the funding txn, or is in the
@@ -1915,9 +3265,11 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				LocalBalance:  int64(localCommitment.LocalBalance.ToSatoshis()),
 				RemoteBalance: int64(localCommitment.RemoteBalance.ToSatoshis()),
 			},
-			CommitWeight: commitWeight,
-			CommitFee:    int64(localCommitment.CommitFee),
-			FeePerKw:     int64(localCommitment.FeePerKw),
+			CommitWeight:           commitWeight,
+			CommitFee:              int64(localCommitment.CommitFee),
+			FeePerKw:               int64(localCommitment.FeePerKw),
+			FundingBroadcastHeight: pendingChan.FundingBroadcastHeight,
+			NumConfsRequired:       uint32(pendingChan.NumConfsRequired),
 			// TODO(roasbeef): need to track confirmation height
 		}
 	}
@@ -2053,6 +3405,7 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			ChannelPoint:  chanPoint.String(),
 			Capacity:      int64(waitingClose.Capacity),
 			LocalBalance:  int64(waitingClose.LocalCommitment.LocalBalance.ToSatoshis()),
+			RemoteBalance: int64(waitingClose.LocalCommitment.RemoteBalance.ToSatoshis()),
 		}
 
 		// A close tx has been broadcasted, all our balance will be in
@@ -2101,62 +3454,133 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 			continue
 		}
 
-		nodePub := dbChannel.RemotePub
-		nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
-
-		var closeType lnrpc.ChannelCloseSummary_ClosureType
 		switch dbChannel.CloseType {
 		case channeldb.CooperativeClose:
 			if filterResults && !in.Cooperative {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE
 		case channeldb.LocalForceClose:
 			if filterResults && !in.LocalForce {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE
 		case channeldb.RemoteForceClose:
 			if filterResults && !in.RemoteForce {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE
 		case channeldb.BreachClose:
 			if filterResults && !in.Breach {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_BREACH_CLOSE
 		case channeldb.FundingCanceled:
 			if filterResults && !in.FundingCanceled {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_FUNDING_CANCELED
 		case channeldb.Abandoned:
 			if filterResults && !in.Abandoned {
 				continue
 			}
-			closeType = lnrpc.ChannelCloseSummary_ABANDONED
-		}
-
-		channel := &lnrpc.ChannelCloseSummary{
-			Capacity:          int64(dbChannel.Capacity),
-			RemotePubkey:      nodeID,
-			CloseHeight:       dbChannel.CloseHeight,
-			CloseType:         closeType,
-			ChannelPoint:      dbChannel.ChanPoint.String(),
-			ChanId:            dbChannel.ShortChanID.ToUint64(),
-			SettledBalance:    int64(dbChannel.SettledBalance),
-			TimeLockedBalance: int64(dbChannel.TimeLockedBalance),
-			ChainHash:         dbChannel.ChainHash.String(),
-			ClosingTxHash:     dbChannel.ClosingTXID.String(),
 		}
 
+		channel := createRPCClosedChannel(dbChannel)
 		resp.Channels = append(resp.Channels, channel)
 	}
 
 	return resp, nil
 }
 
+// createRPCClosedChannel creates an *lnrpc.ChannelCloseSummary from a
+// *channeldb.ChannelCloseSummary.
+func createRPCClosedChannel(
+	dbChannel *channeldb.ChannelCloseSummary) *lnrpc.ChannelCloseSummary {
+
+	nodePub := dbChannel.RemotePub
+	nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
+
+	var closeType lnrpc.ChannelCloseSummary_ClosureType
+	switch dbChannel.CloseType {
+	case channeldb.CooperativeClose:
+		closeType = lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE
+	case channeldb.LocalForceClose:
+		closeType = lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE
+	case channeldb.RemoteForceClose:
+		closeType = lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE
+	case channeldb.BreachClose:
+		closeType = lnrpc.ChannelCloseSummary_BREACH_CLOSE
+	case channeldb.FundingCanceled:
+		closeType = lnrpc.ChannelCloseSummary_FUNDING_CANCELED
+	case channeldb.Abandoned:
+		closeType = lnrpc.ChannelCloseSummary_ABANDONED
+	}
+
+	return &lnrpc.ChannelCloseSummary{
+		Capacity:          int64(dbChannel.Capacity),
+		RemotePubkey:      nodeID,
+		CloseHeight:       dbChannel.CloseHeight,
+		CloseType:         closeType,
+		ChannelPoint:      dbChannel.ChanPoint.String(),
+		ChanId:            dbChannel.ShortChanID.ToUint64(),
+		SettledBalance:    int64(dbChannel.SettledBalance),
+		TimeLockedBalance: int64(dbChannel.TimeLockedBalance),
+		ChainHash:         dbChannel.ChainHash.String(),
+		ClosingTxHash:     dbChannel.ClosingTXID.String(),
+	}
+}
+
+// ArchiveClosedChannels compacts the records of all channels that have been
+// fully closed and resolved, and deletes the records of those that were closed
+// before the requested retention window.
+func (r *rpcServer) ArchiveClosedChannels(ctx context.Context,
+	in *lnrpc.ArchiveClosedChannelsRequest) (
+	*lnrpc.ArchiveClosedChannelsResponse, error) {
+
+	_, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[archiveclosedchannels] retention=%v",
+		in.RetentionBlocks)
+
+	numArchived, numDeleted, err := r.server.chanDB.ArchiveClosedChannels(
+		uint32(bestHeight), in.RetentionBlocks,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ArchiveClosedChannelsResponse{
+		NumArchived: uint32(numArchived),
+		NumDeleted:  uint32(numDeleted),
+	}, nil
+}
+
+// LookupHtlcResolution returns how an HTLC that went to chain was finally
+// resolved, as recorded by the contract resolvers once the HTLC output was
+// fully resolved.
+func (r *rpcServer) LookupHtlcResolution(ctx context.Context,
+	in *lnrpc.LookupHtlcResolutionRequest) (
+	*lnrpc.LookupHtlcResolutionResponse, error) {
+
+	res, err := r.server.chanDB.LookupHtlcResolution(
+		lnwire.NewShortChanIDFromInt(in.ChanId), in.HtlcIndex,
+		in.Incoming,
+	)
+	switch {
+	case err == channeldb.ErrHtlcResolutionNotFound:
+		return nil, fmt.Errorf("no resolution found for htlc %v of "+
+			"channel %v", in.HtlcIndex, in.ChanId)
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &lnrpc.LookupHtlcResolutionResponse{
+		Settled:   res.Outcome == channeldb.HtlcOutcomeSettled,
+		Outpoint:  res.Outpoint.String(),
+		AmountSat: int64(res.AmountRecovered),
+	}, nil
+}
+
 // ListChannels returns a description of all the open channels that this node
 // is a participant in.
 func (r *rpcServer) ListChannels(ctx context.Context,
@@ -2172,9 +3596,19 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 			"`private_only` can be set, but not both")
 	}
 
-	resp := &lnrpc.ListChannelsResponse{}
+	// If a peer was specified, we'll make sure it's a valid public key
+	// before filtering the channels with it.
+	var peerKey *btcec.PublicKey
+	if len(in.Peer) > 0 {
+		var err error
+		peerKey, err = btcec.ParsePubKey(in.Peer, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse peer pubkey: %v",
+				err)
+		}
+	}
 
-	graph := r.server.chanDB.ChannelGraph()
+	resp := &lnrpc.ListChannelsResponse{}
 
 	dbChannels, err := r.server.chanDB.FetchAllOpenChannels()
 	if err != nil {
@@ -2184,102 +3618,44 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 	rpcsLog.Infof("[listchannels] fetched %v channels from DB",
 		len(dbChannels))
 
-	for _, dbChannel := range dbChannels {
-		nodePub := dbChannel.IdentityPub
-		nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
-		chanPoint := dbChannel.FundingOutpoint
-
-		// With the channel point known, retrieve the network channel
-		// ID from the database.
-		var chanID uint64
-		chanID, _ = graph.ChannelID(&chanPoint)
+	holdTimes := r.server.htlcSwitch.ChannelHoldTimes()
+	graph := r.server.chanDB.ChannelGraph()
 
-		var peerOnline bool
-		if _, err := r.server.FindPeer(nodePub); err == nil {
-			peerOnline = true
+	for _, dbChannel := range dbChannels {
+		if peerKey != nil && !dbChannel.IdentityPub.IsEqual(peerKey) {
+			continue
 		}
 
-		channelID := lnwire.NewChanIDFromOutPoint(&chanPoint)
-		var linkActive bool
-		if link, err := r.server.htlcSwitch.GetLink(channelID); err == nil {
-			// A channel is only considered active if it is known
-			// by the switch *and* able to forward
-			// incoming/outgoing payments.
-			linkActive = link.EligibleToForward()
+		channel, err := r.createRPCOpenChannel(dbChannel, holdTimes)
+		if err != nil {
+			return nil, err
 		}
 
-		// Next, we'll determine whether we should add this channel to
-		// our list depending on the type of channels requested to us.
-		isActive := peerOnline && linkActive
-		isPublic := dbChannel.ChannelFlags&lnwire.FFAnnounceChannel != 0
-
 		// We'll only skip returning this channel if we were requested
 		// for a specific kind and this channel doesn't satisfy it.
 		switch {
-		case in.ActiveOnly && !isActive:
+		case in.ActiveOnly && !channel.Active:
 			continue
-		case in.InactiveOnly && isActive:
+		case in.InactiveOnly && channel.Active:
 			continue
-		case in.PublicOnly && !isPublic:
+		case in.PublicOnly && channel.Private:
 			continue
-		case in.PrivateOnly && isPublic:
+		case in.PrivateOnly && !channel.Private:
 			continue
 		}
 
-		// As this is required for display purposes, we'll calculate
-		// the weight of the commitment transaction. We also add on the
-		// estimated weight of the witness to calculate the weight of
-		// the transaction if it were to be immediately unilaterally
-		// broadcast.
-		localCommit := dbChannel.LocalCommitment
-		utx := btcutil.NewTx(localCommit.CommitTx)
-		commitBaseWeight := blockchain.GetTransactionWeight(utx)
-		commitWeight := commitBaseWeight + lnwallet.WitnessCommitmentTxWeight
+		// If requested, we'll also look up the alias of the peer, so
+		// callers don't need to query the graph for each channel. A
+		// peer we haven't received a node announcement from yet has no
+		// alias, which isn't an error.
+		if in.PeerAliasLookup {
+			alias, err := graph.LookupAlias(dbChannel.IdentityPub)
+			if err != nil && err != channeldb.ErrNodeAliasNotFound &&
+				err != channeldb.ErrGraphNodesNotFound {
 
-		localBalance := localCommit.LocalBalance
-		remoteBalance := localCommit.RemoteBalance
-
-		// As an artifact of our usage of mSAT internally, either party
-		// may end up in a state where they're holding a fractional
-		// amount of satoshis which can't be expressed within the
-		// actual commitment output. Since we round down when going
-		// from mSAT -> SAT, we may at any point be adding an
-		// additional SAT to miners fees. As a result, we display a
-		// commitment fee that accounts for this externally.
-		var sumOutputs btcutil.Amount
-		for _, txOut := range localCommit.CommitTx.TxOut {
-			sumOutputs += btcutil.Amount(txOut.Value)
-		}
-		externalCommitFee := dbChannel.Capacity - sumOutputs
-
-		channel := &lnrpc.Channel{
-			Active:                isActive,
-			Private:               !isPublic,
-			RemotePubkey:          nodeID,
-			ChannelPoint:          chanPoint.String(),
-			ChanId:                chanID,
-			Capacity:              int64(dbChannel.Capacity),
-			LocalBalance:          int64(localBalance.ToSatoshis()),
-			RemoteBalance:         int64(remoteBalance.ToSatoshis()),
-			CommitFee:             int64(externalCommitFee),
-			CommitWeight:          commitWeight,
-			FeePerKw:              int64(localCommit.FeePerKw),
-			TotalSatoshisSent:     int64(dbChannel.TotalMSatSent.ToSatoshis()),
-			TotalSatoshisReceived: int64(dbChannel.TotalMSatReceived.ToSatoshis()),
-			NumUpdates:            localCommit.CommitHeight,
-			PendingHtlcs:          make([]*lnrpc.HTLC, len(localCommit.Htlcs)),
-			CsvDelay:              uint32(dbChannel.LocalChanCfg.CsvDelay),
-		}
-
-		for i, htlc := range localCommit.Htlcs {
-			var rHash [32]byte
-			copy(rHash[:], htlc.RHash[:])
-			channel.PendingHtlcs[i] = &lnrpc.HTLC{
-				Incoming:         htlc.Incoming,
-				Amount:           int64(htlc.Amt.ToSatoshis()),
-				HashLock:         rHash[:],
-				ExpirationHeight: htlc.RefundTimeout,
+				return nil, err
 			}
+			channel.PeerAlias = alias
 		}
 
 		resp.Channels = append(resp.Channels, channel)
@@ -2288,27 +3664,377 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 	return resp, nil
 }
 
-// savePayment saves a successfully completed payment to the database for
-// historical record keeping.
-func (r *rpcServer) savePayment(route *routing.Route,
-	amount lnwire.MilliSatoshi, preImage []byte) error {
-
-	paymentPath := make([][33]byte, len(route.Hops))
-	for i, hop := range route.Hops {
-		hopPub := hop.PubKeyBytes
-		copy(paymentPath[i][:], hopPub[:])
+// createRPCOpenChannel creates an *lnrpc.Channel from the
+// *channeldb.OpenChannel, reporting its current state within the switch. The
+// hold time statistics of all channels, as returned by the switch, are
+// passed so that they're only collected once when listing several channels.
+func (r *rpcServer) createRPCOpenChannel(dbChannel *channeldb.OpenChannel,
+	holdTimes map[lnwire.ShortChannelID]htlcswitch.HoldTimeStats) (
+	*lnrpc.Channel, error) {
+
+	nodePub := dbChannel.IdentityPub
+	nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
+	chanPoint := dbChannel.FundingOutpoint
+
+	// With the channel point known, retrieve the network channel
+	// ID from the database.
+	var chanID uint64
+	chanID, _ = r.server.chanDB.ChannelGraph().ChannelID(&chanPoint)
+
+	var peerOnline bool
+	if _, err := r.server.FindPeer(nodePub); err == nil {
+		peerOnline = true
+	}
+
+	channelID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+	var linkActive bool
+	if link, err := r.server.htlcSwitch.GetLink(channelID); err == nil {
+		// A channel is only considered active if it is known
+		// by the switch *and* able to forward
+		// incoming/outgoing payments.
+		linkActive = link.EligibleToForward()
+	}
+
+	// The channel is only reported as active if both the peer is online
+	// and its link is eligible to forward.
+	isActive := peerOnline && linkActive
+	isPublic := dbChannel.ChannelFlags&lnwire.FFAnnounceChannel != 0
+
+	// As this is required for display purposes, we'll calculate
+	// the weight of the commitment transaction. We also add on the
+	// estimated weight of the witness to calculate the weight of
+	// the transaction if it were to be immediately unilaterally
+	// broadcast.
+	localCommit := dbChannel.LocalCommitment
+	utx := btcutil.NewTx(localCommit.CommitTx)
+	commitBaseWeight := blockchain.GetTransactionWeight(utx)
+	commitWeight := commitBaseWeight + lnwallet.WitnessCommitmentTxWeight
+
+	localBalance := localCommit.LocalBalance
+	remoteBalance := localCommit.RemoteBalance
+
+	// As an artifact of our usage of mSAT internally, either party
+	// may end up in a state where they're holding a fractional
+	// amount of satoshis which can't be expressed within the
+	// actual commitment output. Since we round down when going
+	// from mSAT -> SAT, we may at any point be adding an
+	// additional SAT to miners fees. As a result, we display a
+	// commitment fee that accounts for this externally.
+	var sumOutputs btcutil.Amount
+	for _, txOut := range localCommit.CommitTx.TxOut {
+		sumOutputs += btcutil.Amount(txOut.Value)
+	}
+	externalCommitFee := dbChannel.Capacity - sumOutputs
+
+	// We'll also report for how long the peer of the channel has
+	// been online over its lifetime.
+	uptime, err := r.server.uptimeTracker.Uptime(chanPoint)
+	if err != nil {
+		return nil, err
 	}
 
-	payment := &channeldb.OutgoingPayment{
-		Invoice: channeldb.Invoice{
-			Terms: channeldb.ContractTerm{
-				Value: amount,
-			},
-			CreationDate: time.Now(),
-		},
-		Path:           paymentPath,
-		Fee:            route.TotalFees,
-		TimeLockLength: route.TotalTimeLock,
+	channel := &lnrpc.Channel{
+		Active:                isActive,
+		Private:               !isPublic,
+		RemotePubkey:          nodeID,
+		ChannelPoint:          chanPoint.String(),
+		ChanId:                chanID,
+		Capacity:              int64(dbChannel.Capacity),
+		LocalBalance:          int64(localBalance.ToSatoshis()),
+		RemoteBalance:         int64(remoteBalance.ToSatoshis()),
+		CommitFee:             int64(externalCommitFee),
+		CommitWeight:          commitWeight,
+		FeePerKw:              int64(localCommit.FeePerKw),
+		TotalSatoshisSent:     int64(dbChannel.TotalMSatSent.ToSatoshis()),
+		TotalSatoshisReceived: int64(dbChannel.TotalMSatReceived.ToSatoshis()),
+		NumUpdates:            localCommit.CommitHeight,
+		CsvDelay:              uint32(dbChannel.LocalChanCfg.CsvDelay),
+		Lifetime:              int64(uptime.Lifetime.Seconds()),
+		Uptime:                int64(uptime.Uptime.Seconds()),
+	}
+
+	if stats, ok := holdTimes[dbChannel.ShortChanID()]; ok {
+		channel.HoldTimes = marshalHoldTimeStats(stats)
+	}
+
+	// We'll prefer the link's view of the pending HTLCs, as it
+	// reflects the latest state of the channel. If there's no
+	// link, then we'll fall back to the commitment on disk, and
+	// consult the switch's circuit map to determine whether each
+	// HTLC is being forwarded through us, or is one of our own
+	// payments or invoices.
+	pendingHtlcs, err := r.server.htlcSwitch.PendingHTLCs(channelID)
+	if err != nil {
+		shortChanID := dbChannel.ShortChanID()
+		pendingHtlcs = make(
+			[]htlcswitch.HTLCSnapshot, 0,
+			len(localCommit.Htlcs),
+		)
+		for _, htlc := range localCommit.Htlcs {
+			fwd := r.server.htlcSwitch.IsForwardedHTLC(
+				shortChanID, htlc.HtlcIndex,
+				htlc.Incoming,
+			)
+			snapshot := htlcswitch.HTLCSnapshot{
+				HtlcIndex:   htlc.HtlcIndex,
+				Incoming:    htlc.Incoming,
+				Amount:      htlc.Amt,
+				PaymentHash: htlc.RHash,
+				Expiry:      htlc.RefundTimeout,
+				Forwarded:   fwd,
+			}
+			pendingHtlcs = append(pendingHtlcs, snapshot)
+		}
+	}
+
+	channel.PendingHtlcs = make([]*lnrpc.HTLC, len(pendingHtlcs))
+	for i, htlc := range pendingHtlcs {
+		rHash := htlc.PaymentHash
+		channel.PendingHtlcs[i] = &lnrpc.HTLC{
+			Incoming:         htlc.Incoming,
+			Amount:           int64(htlc.Amount.ToSatoshis()),
+			AmountMsat:       uint64(htlc.Amount),
+			HashLock:         rHash[:],
+			ExpirationHeight: htlc.Expiry,
+			Forwarding:       htlc.Forwarded,
+			HtlcIndex:        htlc.HtlcIndex,
+		}
+	}
+
+	return channel, nil
+}
+
+// SubscribeChannelEvents returns a uni-directional stream (server -> client)
+// for notifying the client of newly active, inactive or closed channels.
+func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
+	updateStream lnrpc.Lightning_SubscribeChannelEventsServer) error {
+
+	channelEventSub, err := r.server.chanNotifier.SubscribeChannelEvents()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer channelEventSub.Cancel()
+
+	for {
+		select {
+		// A new update has been sent by the channel notifier, we'll
+		// marshal it into the form expected by the gRPC client, then
+		// send it off to the client.
+		case e := <-channelEventSub.Updates():
+			update, err := r.marshallChannelEvent(e)
+			if err != nil {
+				return err
+			}
+
+			if err := updateStream.Send(update); err != nil {
+				return err
+			}
+
+		// The subscription was canceled as the channel notifier is
+		// shutting down.
+		case <-channelEventSub.Quit():
+			return errors.New("channel notifier shutting down")
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallChannelEvent converts an event sent by the channel notifier into
+// the form expected by the gRPC service.
+func (r *rpcServer) marshallChannelEvent(
+	e interface{}) (*lnrpc.ChannelEventUpdate, error) {
+
+	switch event := e.(type) {
+	case channelnotifier.PendingOpenChannelEvent:
+		return &lnrpc.ChannelEventUpdate{
+			Type: lnrpc.ChannelEventUpdate_PENDING_OPEN_CHANNEL,
+			PendingOpenChannel: &lnrpc.PendingUpdate{
+				Txid:        event.ChannelPoint.Hash[:],
+				OutputIndex: event.ChannelPoint.Index,
+			},
+		}, nil
+
+	case channelnotifier.OpenChannelEvent:
+		holdTimes := r.server.htlcSwitch.ChannelHoldTimes()
+		channel, err := r.createRPCOpenChannel(event.Channel, holdTimes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &lnrpc.ChannelEventUpdate{
+			Type:        lnrpc.ChannelEventUpdate_OPEN_CHANNEL,
+			OpenChannel: channel,
+		}, nil
+
+	case channelnotifier.ClosedChannelEvent:
+		return &lnrpc.ChannelEventUpdate{
+			Type: lnrpc.ChannelEventUpdate_CLOSED_CHANNEL,
+			ClosedChannel: createRPCClosedChannel(
+				event.CloseSummary,
+			),
+		}, nil
+
+	case channelnotifier.ActiveChannelEvent:
+		return &lnrpc.ChannelEventUpdate{
+			Type:          lnrpc.ChannelEventUpdate_ACTIVE_CHANNEL,
+			ActiveChannel: marshallChannelPoint(event.ChannelPoint),
+		}, nil
+
+	case channelnotifier.InactiveChannelEvent:
+		return &lnrpc.ChannelEventUpdate{
+			Type: lnrpc.ChannelEventUpdate_INACTIVE_CHANNEL,
+			InactiveChannel: marshallChannelPoint(
+				event.ChannelPoint,
+			),
+		}, nil
+
+	case channelnotifier.FullyResolvedChannelEvent:
+		return &lnrpc.ChannelEventUpdate{
+			Type: lnrpc.ChannelEventUpdate_FULLY_RESOLVED_CHANNEL,
+			FullyResolvedChannel: marshallChannelPoint(
+				event.ChannelPoint,
+			),
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected channel event update: %v",
+			event)
+	}
+}
+
+// marshallChannelPoint converts a wire.OutPoint into the form expected by the
+// gRPC service.
+func marshallChannelPoint(op *wire.OutPoint) *lnrpc.ChannelPoint {
+	return &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: op.Hash[:],
+		},
+		OutputIndex: op.Index,
+	}
+}
+
+// ExportChannelAudit returns a snapshot of the balances and commitments of all
+// open channels, signed by the identity key of the node. The signature is
+// made over the returned serialized snapshot, in the same way as SignMessage
+// does.
+func (r *rpcServer) ExportChannelAudit(ctx context.Context,
+	in *lnrpc.ExportChannelAuditRequest) (*lnrpc.ExportChannelAuditResponse,
+	error) {
+
+	dbChannels, err := r.server.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	bestHash, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	audit := newChannelAudit(
+		r.server.identityPriv.PubKey(), dbChannels, bestHash,
+		bestHeight, time.Now(),
+	)
+	snapshot, sig, err := audit.sign(r.server.nodeSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[exportchanaudit] signed snapshot of %v channels at "+
+		"height %v", len(audit.Channels), audit.BlockHeight)
+
+	resp := &lnrpc.ExportChannelAuditResponse{
+		IdentityPubkey:    audit.IdentityPubkey,
+		BlockHeight:       audit.BlockHeight,
+		BlockHash:         audit.BlockHash,
+		Timestamp:         audit.Timestamp,
+		TotalLocalBalance: audit.TotalLocalBalance,
+		Channels: make(
+			[]*lnrpc.ChannelAuditEntry, 0, len(audit.Channels),
+		),
+		Snapshot:  snapshot,
+		Signature: sig,
+	}
+	for _, entry := range audit.Channels {
+		resp.Channels = append(resp.Channels, &lnrpc.ChannelAuditEntry{
+			ChannelPoint:    entry.ChannelPoint,
+			ChanId:          entry.ChanID,
+			RemotePubkey:    entry.RemotePubkey,
+			Capacity:        entry.Capacity,
+			LocalBalance:    entry.LocalBalance,
+			RemoteBalance:   entry.RemoteBalance,
+			CommitHeight:    entry.CommitHeight,
+			CommitTxid:      entry.CommitTxid,
+			CommitFee:       entry.CommitFee,
+			NumPendingHtlcs: entry.NumPendingHTLCs,
+			PendingHtlcAmt:  entry.PendingHTLCAmt,
+			Pending:         entry.Pending,
+		})
+	}
+
+	return resp, nil
+}
+
+// initPayment records a payment that is about to be dispatched to the
+// database, so that it's kept track of even if it never succeeds.
+func (r *rpcServer) initPayment(payIntent *rpcPaymentIntent,
+	creationDate time.Time) error {
+
+	// If a route was specified, then the amount to be sent is the one
+	// carried by the first route the payment will be attempted over.
+	amount := payIntent.msat
+	if len(payIntent.routes) > 0 {
+		route := payIntent.routes[0]
+		amount = route.TotalAmount - route.TotalFees
+	}
+
+	payment := &channeldb.OutgoingPayment{
+		Invoice: channeldb.Invoice{
+			Terms: channeldb.ContractTerm{
+				Value: amount,
+			},
+			CreationDate: creationDate,
+			Metadata:     payIntent.metadata,
+		},
+		PaymentHash: payIntent.rHash,
+	}
+
+	return r.server.chanDB.InitPayment(payment)
+}
+
+// savePayment saves a successfully completed payment to the database for
+// historical record keeping, replacing the record created as it was
+// dispatched.
+func (r *rpcServer) savePayment(route *routing.Route,
+	amount lnwire.MilliSatoshi, paymentHash [32]byte, preImage,
+	metadata []byte, creationDate time.Time) error {
+
+	paymentPath := make([][33]byte, len(route.Hops))
+	for i, hop := range route.Hops {
+		hopPub := hop.PubKeyBytes
+		copy(paymentPath[i][:], hopPub[:])
+	}
+
+	payment := &channeldb.OutgoingPayment{
+		Invoice: channeldb.Invoice{
+			Terms: channeldb.ContractTerm{
+				Value: amount,
+			},
+			CreationDate: creationDate,
+			SettleDate:   time.Now(),
+			Metadata:     metadata,
+		},
+		PaymentHash:    paymentHash,
+		Path:           paymentPath,
+		Fee:            route.TotalFees,
+		TimeLockLength: route.TotalTimeLock,
 	}
 	copy(payment.PaymentPreimage[:], preImage)
 
@@ -2424,6 +4150,7 @@ func (r *rpcServer) SendToRoute(stream lnrpc.Lightning_SendToRouteServer) error
 			return &rpcPaymentRequest{
 				SendRequest: &lnrpc.SendRequest{
 					PaymentHash: req.PaymentHash,
+					Metadata:    req.Metadata,
 				},
 				routes: routes,
 			}, nil
@@ -2449,6 +4176,7 @@ type rpcPaymentIntent struct {
 	rHash      [32]byte
 	cltvDelta  uint16
 	routeHints [][]routing.HopHint
+	metadata   []byte
 
 	routes []*routing.Route
 }
@@ -2461,6 +4189,15 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 	var err error
 	payIntent := rpcPaymentIntent{}
 
+	// Any metadata is stored along with the payment once it succeeds, so
+	// we'll ensure it fits before dispatching the payment at all.
+	if len(rpcPayReq.Metadata) > channeldb.MaxMetadataSize {
+		return payIntent, fmt.Errorf("metadata of length %v exceeds "+
+			"max length of %v", len(rpcPayReq.Metadata),
+			channeldb.MaxMetadataSize)
+	}
+	payIntent.metadata = rpcPayReq.Metadata
+
 	// If a route was specified, then we can use that directly.
 	if len(rpcPayReq.routes) != 0 {
 		// If the user is using the REST interface, then they'll be
@@ -2618,6 +4355,14 @@ type paymentIntentResponse struct {
 func (r *rpcServer) dispatchPaymentIntent(
 	payIntent *rpcPaymentIntent) (*paymentIntentResponse, error) {
 
+	// Before dispatching the payment, we'll record it so that it's
+	// reported by ListPayments even if it never succeeds. Its status is
+	// then tracked by the control tower as it goes in flight.
+	creationDate := time.Now()
+	if err := r.initPayment(payIntent, creationDate); err != nil {
+		return nil, err
+	}
+
 	// Construct a payment request to send to the channel router. If the
 	// payment is successful, the route chosen will be returned. Otherwise,
 	// we'll get a non-nil error.
@@ -2676,7 +4421,10 @@ func (r *rpcServer) dispatchPaymentIntent(
 
 	// Save the completed payment to the database for record keeping
 	// purposes.
-	err := r.savePayment(route, amt, preImage[:])
+	err := r.savePayment(
+		route, amt, payIntent.rHash, preImage[:], payIntent.metadata,
+		creationDate,
+	)
 	if err != nil {
 		// We weren't able to save the payment, so we return the save
 		// err, but a nil routing err.
@@ -2725,6 +4473,16 @@ func (r *rpcServer) sendPayment(stream *paymentStream) error {
 		close(reqQuit)
 	}()
 
+	// sendErr delivers a terminal error to the main loop below. Payments
+	// still in flight once the stream has been torn down must not block
+	// on the error channel, as no one is left to read from it.
+	sendErr := func(err error) {
+		select {
+		case errChan <- err:
+		case <-reqQuit:
+		}
+	}
+
 	// TODO(joostjager): Callers expect result to come in in the same order
 	// as the request were sent, but this is far from guarantueed in the
 	// code below.
@@ -2813,7 +4571,7 @@ func (r *rpcServer) sendPayment(stream *paymentStream) error {
 				// payment, then we'll return the error to the
 				// user, and terminate.
 				case saveErr != nil:
-					errChan <- saveErr
+					sendErr(saveErr)
 					return
 
 				// If we receive payment error than, instead of
@@ -2825,7 +4583,7 @@ func (r *rpcServer) sendPayment(stream *paymentStream) error {
 						PaymentHash:  payIntent.rHash[:],
 					})
 					if err != nil {
-						errChan <- err
+						sendErr(err)
 					}
 					return
 				}
@@ -2837,7 +4595,7 @@ func (r *rpcServer) sendPayment(stream *paymentStream) error {
 					PaymentRoute:    marshalledRouted,
 				})
 				if err != nil {
-					errChan <- err
+					sendErr(err)
 					return
 				}
 			}()
@@ -2882,11 +4640,50 @@ func (r *rpcServer) SendToRouteSync(ctx context.Context,
 	return r.sendPaymentSync(ctx, &rpcPaymentRequest{
 		SendRequest: &lnrpc.SendRequest{
 			PaymentHashString: req.PaymentHashString,
+			Metadata:          req.Metadata,
 		},
 		routes: routes,
 	})
 }
 
+// CancelPayment cancels a payment that's currently being sent. No further
+// routes will be attempted for the payment, and the pending SendPayment call
+// returns once its outstanding HTLC, if any, has been resolved.
+func (r *rpcServer) CancelPayment(ctx context.Context,
+	req *lnrpc.CancelPaymentRequest) (*lnrpc.CancelPaymentResponse, error) {
+
+	var (
+		payHash [32]byte
+		rHash   []byte
+		err     error
+	)
+
+	// If the payment hash was provided as a hex string, then decode that
+	// and use that directly. Otherwise, we use the raw bytes provided.
+	if req.PaymentHashStr != "" {
+		rHash, err = hex.DecodeString(req.PaymentHashStr)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		rHash = req.PaymentHash
+	}
+
+	if len(rHash) != 32 {
+		return nil, fmt.Errorf("payment hash must be exactly "+
+			"32 bytes, is instead %v", len(rHash))
+	}
+	copy(payHash[:], rHash)
+
+	rpcsLog.Infof("[cancelpayment] payment_hash=%x", payHash[:])
+
+	if err := r.server.chanRouter.CancelPayment(payHash); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.CancelPaymentResponse{}, nil
+}
+
 // sendPaymentSync is the synchronous variant of sendPayment. It will block and
 // wait until the payment has been fully completed.
 func (r *rpcServer) sendPaymentSync(ctx context.Context,
@@ -2967,8 +4764,8 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 			"(maxsize=%v)", len(invoice.Receipt), channeldb.MaxReceiptSize)
 	}
 	if len(invoice.DescriptionHash) > 0 && len(invoice.DescriptionHash) != 32 {
-		return nil, fmt.Errorf("description hash is %v bytes, must be %v",
-			len(invoice.DescriptionHash), channeldb.MaxPaymentRequestSize)
+		return nil, fmt.Errorf("description hash is %v bytes, must "+
+			"be 32", len(invoice.DescriptionHash))
 	}
 
 	// The value of the invoice must not be negative.
@@ -3067,6 +4864,24 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		options = append(options, zpay32.CLTVExpiry(uint64(defaultDelta)))
 	}
 
+	// We'll restrict the number of individual route hints to 20 to avoid
+	// creating overly large invoices.
+	const maxRouteHints = 20
+
+	// Any route hints given by the caller, e.g. for channels of another
+	// node it's aware of, are included as is.
+	routeHints, err := unmarshallRouteHints(invoice.RouteHints)
+	if err != nil {
+		return nil, err
+	}
+	if len(routeHints) > maxRouteHints {
+		return nil, fmt.Errorf("too many route hints: %v, max "+
+			"allowed is %v", len(routeHints), maxRouteHints)
+	}
+	for _, routeHint := range routeHints {
+		options = append(options, zpay32.RouteHint(routeHint))
+	}
+
 	// If we were requested to include routing hints in the invoice, then
 	// we'll fetch all of our available private channels and create routing
 	// hints for them.
@@ -3078,11 +4893,9 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 
 		graph := r.server.chanDB.ChannelGraph()
 
-		numHints := 0
+		numHints := len(routeHints)
 		for _, channel := range openChannels {
-			// We'll restrict the number of individual route hints
-			// to 20 to avoid creating overly large invoices.
-			if numHints > 20 {
+			if numHints >= maxRouteHints {
 				break
 			}
 
@@ -3218,6 +5031,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		Terms: channeldb.ContractTerm{
 			Value: amtMSat,
 		},
+		Metadata: invoice.Metadata,
 	}
 	copy(newInvoice.Terms.PaymentPreimage[:], paymentPreimage[:])
 
@@ -3240,6 +5054,102 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 	}, nil
 }
 
+// CreateOffer creates a new BOLT-12 offer for our node. Invoice requests for
+// the offer are answered over onion messages with a fresh invoice.
+func (r *rpcServer) CreateOffer(ctx context.Context,
+	req *lnrpc.CreateOfferRequest) (*lnrpc.CreateOfferResponse, error) {
+
+	if r.server.offerMgr == nil {
+		return nil, errOffersDisabled
+	}
+
+	if req.AmtMsat < 0 {
+		return nil, fmt.Errorf("amount cannot be negative")
+	}
+	if req.Expiry < 0 {
+		return nil, fmt.Errorf("expiry cannot be negative")
+	}
+
+	offer, err := r.server.offerMgr.CreateOffer(
+		lnwire.MilliSatoshi(req.AmtMsat), req.Description, req.Issuer,
+		req.QuantityMax, time.Duration(req.Expiry)*time.Second,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	offerStr, err := offer.Encode()
+	if err != nil {
+		return nil, err
+	}
+	offerID, err := offer.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[createoffer] offer_id=%x", offerID[:])
+
+	return &lnrpc.CreateOfferResponse{
+		Offer:   offerStr,
+		OfferId: offerID[:],
+	}, nil
+}
+
+// PayOffer requests an invoice for the passed BOLT-12 offer from its issuer
+// over onion messages, and pays it.
+func (r *rpcServer) PayOffer(ctx context.Context,
+	req *lnrpc.PayOfferRequest) (*lnrpc.SendResponse, error) {
+
+	if r.server.offerMgr == nil {
+		return nil, errOffersDisabled
+	}
+
+	// We don't allow payments to be sent while the daemon itself is still
+	// syncing as we may be trying to sent a payment over a "stale"
+	// channel.
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	if req.AmtMsat < 0 {
+		return nil, fmt.Errorf("amount cannot be negative")
+	}
+
+	offer, err := offers.DecodeOffer(req.Offer)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := r.server.offerMgr.FetchInvoice(
+		offer, lnwire.MilliSatoshi(req.AmtMsat), req.Quantity,
+		req.PayerNote,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[payoffer] payment_hash=%x, amt=%v",
+		invoice.PaymentHash[:], invoice.Amount)
+
+	feeLimit := calculateFeeLimit(req.FeeLimit, invoice.Amount)
+	preimage, route, err := r.server.offerMgr.PayInvoice(
+		invoice, feeLimit,
+	)
+	if err != nil {
+		return &lnrpc.SendResponse{
+			PaymentError: err.Error(),
+			PaymentHash:  invoice.PaymentHash[:],
+		}, nil
+	}
+
+	return &lnrpc.SendResponse{
+		PaymentHash:     invoice.PaymentHash[:],
+		PaymentPreimage: preimage[:],
+		PaymentRoute:    r.marshallRoute(route),
+	}, nil
+}
+
 // createRPCInvoice creates an *lnrpc.Invoice from the *channeldb.Invoice.
 func createRPCInvoice(invoice *channeldb.Invoice) (*lnrpc.Invoice, error) {
 	paymentRequest := string(invoice.PaymentRequest)
@@ -3298,6 +5208,7 @@ func createRPCInvoice(invoice *channeldb.Invoice) (*lnrpc.Invoice, error) {
 		AmtPaidSat:      int64(satAmtPaid),
 		AmtPaidMsat:     int64(invoice.AmtPaid),
 		AmtPaid:         int64(invoice.AmtPaid),
+		Metadata:        invoice.Metadata,
 	}, nil
 }
 
@@ -3331,6 +5242,54 @@ func createRPCRouteHints(routeHints [][]routing.HopHint) []*lnrpc.RouteHint {
 	return res
 }
 
+// unmarshallRouteHints converts the route hints of an RPC invoice into the
+// hop hints of a payment request.
+func unmarshallRouteHints(rpcHints []*lnrpc.RouteHint) ([][]routing.HopHint,
+	error) {
+
+	routeHints := make([][]routing.HopHint, 0, len(rpcHints))
+	for _, rpcHint := range rpcHints {
+		if len(rpcHint.HopHints) == 0 {
+			return nil, fmt.Errorf("route hint has no hops")
+		}
+
+		routeHint := make([]routing.HopHint, 0, len(rpcHint.HopHints))
+		for _, hop := range rpcHint.HopHints {
+			pubKeyBytes, err := hex.DecodeString(hop.NodeId)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode hop "+
+					"hint node id %v: %v", hop.NodeId, err)
+			}
+			nodeID, err := btcec.ParsePubKey(
+				pubKeyBytes, btcec.S256(),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid hop hint node "+
+					"id %v: %v", hop.NodeId, err)
+			}
+
+			if hop.CltvExpiryDelta > math.MaxUint16 {
+				return nil, fmt.Errorf("hop hint CLTV delta "+
+					"of %v is too large, max accepted is: "+
+					"%v", hop.CltvExpiryDelta,
+					math.MaxUint16)
+			}
+
+			routeHint = append(routeHint, routing.HopHint{
+				NodeID:                    nodeID,
+				ChannelID:                 hop.ChanId,
+				FeeBaseMSat:               hop.FeeBaseMsat,
+				FeeProportionalMillionths: hop.FeeProportionalMillionths,
+				CLTVExpiryDelta:           uint16(hop.CltvExpiryDelta),
+			})
+		}
+
+		routeHints = append(routeHints, routeHint)
+	}
+
+	return routeHints, nil
+}
+
 // LookupInvoice attempts to look up an invoice according to its payment hash.
 // The passed payment hash *must* be exactly 32 bytes, if not an error is
 // returned.
@@ -3475,25 +5434,13 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 	for {
 		select {
 		case tx := <-txClient.ConfirmedTransactions():
-			detail := &lnrpc.Transaction{
-				TxHash:           tx.Hash.String(),
-				Amount:           int64(tx.Value),
-				NumConfirmations: tx.NumConfirmations,
-				BlockHash:        tx.BlockHash.String(),
-				TimeStamp:        tx.Timestamp,
-				TotalFees:        tx.TotalFees,
-			}
+			detail := createRPCTransaction(tx)
 			if err := updateStream.Send(detail); err != nil {
 				return err
 			}
 
 		case tx := <-txClient.UnconfirmedTransactions():
-			detail := &lnrpc.Transaction{
-				TxHash:    tx.Hash.String(),
-				Amount:    int64(tx.Value),
-				TimeStamp: tx.Timestamp,
-				TotalFees: tx.TotalFees,
-			}
+			detail := createRPCTransaction(tx)
 			if err := updateStream.Send(detail); err != nil {
 				return err
 			}
@@ -3504,6 +5451,33 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 	}
 }
 
+// createRPCTransaction converts a transaction detail of the wallet to its
+// RPC counterpart.
+func createRPCTransaction(tx *lnwallet.TransactionDetail) *lnrpc.Transaction {
+	destAddresses := make([]string, 0, len(tx.DestAddresses))
+	for _, destAddress := range tx.DestAddresses {
+		addr := destAddress.EncodeAddress()
+		destAddresses = append(destAddresses, addr)
+	}
+
+	// We also get unconfirmed transactions, so BlockHash can be nil.
+	blockHash := ""
+	if tx.BlockHash != nil {
+		blockHash = tx.BlockHash.String()
+	}
+
+	return &lnrpc.Transaction{
+		TxHash:           tx.Hash.String(),
+		Amount:           int64(tx.Value),
+		NumConfirmations: tx.NumConfirmations,
+		BlockHash:        blockHash,
+		BlockHeight:      tx.BlockHeight,
+		TimeStamp:        tx.Timestamp,
+		TotalFees:        tx.TotalFees,
+		DestAddresses:    destAddresses,
+	}
+}
+
 // GetTransactions returns a list of describing all the known transactions
 // relevant to the wallet.
 func (r *rpcServer) GetTransactions(ctx context.Context,
@@ -3515,32 +5489,17 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 		return nil, err
 	}
 
+	labels, err := r.server.chanDB.FetchTxLabels()
+	if err != nil {
+		return nil, err
+	}
+
 	txDetails := &lnrpc.TransactionDetails{
 		Transactions: make([]*lnrpc.Transaction, len(transactions)),
 	}
 	for i, tx := range transactions {
-		var destAddresses []string
-		for _, destAddress := range tx.DestAddresses {
-			destAddresses = append(destAddresses, destAddress.EncodeAddress())
-		}
-
-		// We also get unconfirmed transactions, so BlockHash can be
-		// nil.
-		blockHash := ""
-		if tx.BlockHash != nil {
-			blockHash = tx.BlockHash.String()
-		}
-
-		txDetails.Transactions[i] = &lnrpc.Transaction{
-			TxHash:           tx.Hash.String(),
-			Amount:           int64(tx.Value),
-			NumConfirmations: tx.NumConfirmations,
-			BlockHash:        blockHash,
-			BlockHeight:      tx.BlockHeight,
-			TimeStamp:        tx.Timestamp,
-			TotalFees:        tx.TotalFees,
-			DestAddresses:    destAddresses,
-		}
+		txDetails.Transactions[i] = createRPCTransaction(tx)
+		txDetails.Transactions[i].Label = labels[tx.Hash]
 	}
 
 	return txDetails, nil
@@ -3567,23 +5526,11 @@ func (r *rpcServer) DescribeGraph(ctx context.Context,
 	// within the graph), collating their current state into the RPC
 	// response.
 	err := graph.ForEachNode(nil, func(_ *bbolt.Tx, node *channeldb.LightningNode) error {
-		nodeAddrs := make([]*lnrpc.NodeAddress, 0)
-		for _, addr := range node.Addresses {
-			nodeAddr := &lnrpc.NodeAddress{
-				Network: addr.Network(),
-				Addr:    addr.String(),
-			}
-			nodeAddrs = append(nodeAddrs, nodeAddr)
+		rpcNode, err := marshalNode(node)
+		if err != nil {
+			return err
 		}
-
-		nodeColor := fmt.Sprintf("#%02x%02x%02x", node.Color.R, node.Color.G, node.Color.B)
-		resp.Nodes = append(resp.Nodes, &lnrpc.LightningNode{
-			LastUpdate: uint32(node.LastUpdate.Unix()),
-			PubKey:     hex.EncodeToString(node.PubKeyBytes[:]),
-			Addresses:  nodeAddrs,
-			Alias:      node.Alias,
-			Color:      nodeColor,
-		})
+		resp.Nodes = append(resp.Nodes, rpcNode)
 
 		return nil
 	})
@@ -3617,9 +5564,42 @@ func (r *rpcServer) DescribeGraph(ctx context.Context,
 	return resp, nil
 }
 
-func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
-	c1, c2 *channeldb.ChannelEdgePolicy) *lnrpc.ChannelEdge {
-
+// marshalNode converts a node of the channel graph into its RPC
+// representation.
+func marshalNode(node *channeldb.LightningNode) (*lnrpc.LightningNode, error) {
+	nodeAddrs := make([]*lnrpc.NodeAddress, 0)
+	for _, addr := range node.Addresses {
+		nodeAddr := &lnrpc.NodeAddress{
+			Network: addr.Network(),
+			Addr:    addr.String(),
+		}
+		nodeAddrs = append(nodeAddrs, nodeAddr)
+	}
+
+	// The features are only known once the node announcement has been
+	// received.
+	var globalFeatures bytes.Buffer
+	if node.Features != nil {
+		if err := node.Features.Encode(&globalFeatures); err != nil {
+			return nil, err
+		}
+	}
+
+	nodeColor := fmt.Sprintf("#%02x%02x%02x", node.Color.R, node.Color.G,
+		node.Color.B)
+	return &lnrpc.LightningNode{
+		LastUpdate:     uint32(node.LastUpdate.Unix()),
+		PubKey:         hex.EncodeToString(node.PubKeyBytes[:]),
+		Addresses:      nodeAddrs,
+		Alias:          node.Alias,
+		Color:          nodeColor,
+		GlobalFeatures: globalFeatures.Bytes(),
+	}, nil
+}
+
+func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
+	c1, c2 *channeldb.ChannelEdgePolicy) *lnrpc.ChannelEdge {
+
 	var (
 		lastUpdate int64
 	)
@@ -3648,6 +5628,7 @@ func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
 			FeeBaseMsat:      int64(c1.FeeBaseMSat),
 			FeeRateMilliMsat: int64(c1.FeeProportionalMillionths),
 			Disabled:         c1.Flags&lnwire.ChanUpdateDisabled != 0,
+			MaxHtlcMsat:      uint64(c1.MaxHTLC),
 		}
 	}
 
@@ -3658,6 +5639,7 @@ func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
 			FeeBaseMsat:      int64(c2.FeeBaseMSat),
 			FeeRateMilliMsat: int64(c2.FeeProportionalMillionths),
 			Disabled:         c2.Flags&lnwire.ChanUpdateDisabled != 0,
+			MaxHtlcMsat:      uint64(c2.MaxHTLC),
 		}
 	}
 
@@ -3717,38 +5699,42 @@ func (r *rpcServer) GetNodeInfo(ctx context.Context,
 	var (
 		numChannels   uint32
 		totalCapacity btcutil.Amount
+		channels      []*lnrpc.ChannelEdge
 	)
 	if err := node.ForEachChannel(nil, func(_ *bbolt.Tx, edge *channeldb.ChannelEdgeInfo,
-		_, _ *channeldb.ChannelEdgePolicy) error {
+		outPolicy, inPolicy *channeldb.ChannelEdgePolicy) error {
 
 		numChannels++
 		totalCapacity += edge.Capacity
+
+		if !in.IncludeChannels {
+			return nil
+		}
+
+		// The outgoing policy is the one of the node itself, so we'll
+		// order both policies by the end of the channel they belong
+		// to.
+		c1, c2 := outPolicy, inPolicy
+		if node.PubKeyBytes != edge.NodeKey1Bytes {
+			c1, c2 = inPolicy, outPolicy
+		}
+		channels = append(channels, marshalDbEdge(edge, c1, c2))
+
 		return nil
 	}); err != nil {
 		return nil, err
 	}
 
-	nodeAddrs := make([]*lnrpc.NodeAddress, 0)
-	for _, addr := range node.Addresses {
-		nodeAddr := &lnrpc.NodeAddress{
-			Network: addr.Network(),
-			Addr:    addr.String(),
-		}
-		nodeAddrs = append(nodeAddrs, nodeAddr)
+	rpcNode, err := marshalNode(node)
+	if err != nil {
+		return nil, err
 	}
-	// TODO(roasbeef): list channels as well?
 
-	nodeColor := fmt.Sprintf("#%02x%02x%02x", node.Color.R, node.Color.G, node.Color.B)
 	return &lnrpc.NodeInfo{
-		Node: &lnrpc.LightningNode{
-			LastUpdate: uint32(node.LastUpdate.Unix()),
-			PubKey:     in.PubKey,
-			Addresses:  nodeAddrs,
-			Alias:      node.Alias,
-			Color:      nodeColor,
-		},
+		Node:          rpcNode,
 		NumChannels:   numChannels,
 		TotalCapacity: int64(totalCapacity),
+		Channels:      channels,
 	}, nil
 }
 
@@ -3922,6 +5908,19 @@ func unmarshallKnownPubkeyHop(hop *lnrpc.Hop) (*routing.Hop, error) {
 		return nil, fmt.Errorf("cannot decode pubkey %s", hop.PubKey)
 	}
 
+	// The hop is used as given to craft the onion packet, so we'll make
+	// sure it refers to a valid node key up front rather than failing
+	// deep within the switch.
+	if len(pubKey) != btcec.PubKeyBytesLenCompressed {
+		return nil, fmt.Errorf("pubkey %s must be %d bytes, is "+
+			"instead %d", hop.PubKey, btcec.PubKeyBytesLenCompressed,
+			len(pubKey))
+	}
+	if _, err := btcec.ParsePubKey(pubKey, btcec.S256()); err != nil {
+		return nil, fmt.Errorf("invalid pubkey %s: %v", hop.PubKey,
+			err)
+	}
+
 	var pubKeyBytes [33]byte
 	copy(pubKeyBytes[:], pubKey)
 
@@ -4001,8 +6000,16 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 		totalNetworkCapacity btcutil.Amount
 		minChannelSize       btcutil.Amount = math.MaxInt64
 		maxChannelSize       btcutil.Amount
+		numZombieChans       uint64
 	)
 
+	// Our own channels are never pruned as zombies, so we'll need our
+	// public key to exclude them from the zombie count.
+	selfNode, err := graph.SourceNode()
+	if err != nil {
+		return nil, err
+	}
+
 	// We'll use this map to de-duplicate channels during our traversal.
 	// This is needed since channels are directional, so there will be two
 	// edges for each channel within the graph.
@@ -4023,7 +6030,8 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 		// re-use it within this inner view.
 		var outDegree uint32
 		if err := node.ForEachChannel(tx, func(_ *bbolt.Tx,
-			edge *channeldb.ChannelEdgeInfo, _, _ *channeldb.ChannelEdgePolicy) error {
+			edge *channeldb.ChannelEdgeInfo,
+			e1, e2 *channeldb.ChannelEdgePolicy) error {
 
 			// Bump up the out degree for this node for each
 			// channel encountered.
@@ -4053,6 +6061,21 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 
 			numChannels++
 
+			// Channels that will be pruned by the router as
+			// zombies are a sign of a graph that's out of sync.
+			selfKey := selfNode.PubKeyBytes
+			isOwnChan := edge.NodeKey1Bytes == selfKey ||
+				edge.NodeKey2Bytes == selfKey
+			// The graph is read directly rather than through the
+			// router, as the latter isn't running in observer
+			// mode.
+			isZombie := routing.IsZombieChannel(
+				e1, e2, routing.DefaultChannelPruneExpiry,
+			)
+			if !isOwnChan && isZombie {
+				numZombieChans++
+			}
+
 			seenChans[edge.ChannelID] = struct{}{}
 			return nil
 		}); err != nil {
@@ -4090,6 +6113,7 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 
 		MinChannelSize: int64(minChannelSize),
 		MaxChannelSize: int64(maxChannelSize),
+		NumZombieChans: numZombieChans,
 	}
 
 	// Similarly, if we don't have any channels, then we'll also set the
@@ -4099,6 +6123,12 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 		netInfo.AvgChannelSize = 0
 	}
 
+	// Likewise, if we don't know of any nodes, then we'll set the average
+	// out degree to zero.
+	if numNodes == 0 {
+		netInfo.AvgOutDegree = 0
+	}
+
 	return netInfo, nil
 }
 
@@ -4206,6 +6236,7 @@ func marshallTopologyChange(topChange *routing.TopologyChange) *lnrpc.GraphTopol
 				FeeBaseMsat:      int64(channelUpdate.BaseFee),
 				FeeRateMilliMsat: int64(channelUpdate.FeeRate),
 				Disabled:         channelUpdate.Disabled,
+				MaxHtlcMsat:      uint64(channelUpdate.MaxHTLC),
 			},
 			AdvertisingNode: encodeKey(channelUpdate.AdvertisingNode),
 			ConnectingNode:  encodeKey(channelUpdate.ConnectingNode),
@@ -4234,50 +6265,149 @@ func marshallTopologyChange(topChange *routing.TopologyChange) *lnrpc.GraphTopol
 	}
 }
 
-// ListPayments returns a list of all outgoing payments.
+// ListPayments returns a list of outgoing payments. By default, all the
+// payments that succeeded are returned, while the request can also paginate
+// through them, and include the payments that are in flight or that failed.
 func (r *rpcServer) ListPayments(ctx context.Context,
-	_ *lnrpc.ListPaymentsRequest) (*lnrpc.ListPaymentsResponse, error) {
+	req *lnrpc.ListPaymentsRequest) (*lnrpc.ListPaymentsResponse, error) {
 
 	rpcsLog.Debugf("[ListPayments]")
 
-	payments, err := r.server.chanDB.FetchAllPayments()
-	if err != nil && err != channeldb.ErrNoPaymentsCreated {
+	// If the maximum number of payments wasn't specified, then we'll
+	// return all the payments matching the query.
+	maxPayments := req.MaxPayments
+	if maxPayments == 0 {
+		maxPayments = math.MaxUint64
+	}
+
+	paymentsQuery := channeldb.PaymentsQuery{
+		IndexOffset:       req.IndexOffset,
+		MaxPayments:       maxPayments,
+		IncludeIncomplete: req.IncludeIncomplete,
+		Reversed:          req.Reversed,
+	}
+	paymentsSlice, err := r.server.chanDB.QueryPayments(paymentsQuery)
+	if err != nil {
 		return nil, err
 	}
 
 	paymentsResp := &lnrpc.ListPaymentsResponse{
-		Payments: make([]*lnrpc.Payment, len(payments)),
+		FirstIndexOffset: paymentsSlice.FirstIndexOffset,
+		LastIndexOffset:  paymentsSlice.LastIndexOffset,
 	}
-	for i, payment := range payments {
-		path := make([]string, len(payment.Path))
-		for i, hop := range payment.Path {
-			path[i] = hex.EncodeToString(hop[:])
-		}
+	for _, payment := range paymentsSlice.Payments {
+		paymentsResp.Payments = append(
+			paymentsResp.Payments, marshallPayment(payment),
+		)
+	}
+
+	return paymentsResp, nil
+}
+
+// marshallPayment converts a payment stored within the database into the form
+// expected by the gRPC service.
+func marshallPayment(payment *channeldb.OutgoingPayment) *lnrpc.Payment {
+	path := make([]string, len(payment.Path))
+	for i, hop := range payment.Path {
+		path[i] = hex.EncodeToString(hop[:])
+	}
+
+	msatValue := int64(payment.Terms.Value)
+	satValue := int64(payment.Terms.Value.ToSatoshis())
+
+	var status lnrpc.Payment_PaymentStatus
+	switch payment.Status {
+	case channeldb.StatusInFlight:
+		status = lnrpc.Payment_IN_FLIGHT
+	case channeldb.StatusCompleted:
+		status = lnrpc.Payment_SUCCEEDED
+	case channeldb.StatusGrounded:
+		status = lnrpc.Payment_FAILED
+	default:
+		status = lnrpc.Payment_UNKNOWN
+	}
+
+	// The preimage is only known once the payment succeeded.
+	var preimage string
+	if payment.PaymentPreimage != [32]byte{} {
+		preimage = hex.EncodeToString(payment.PaymentPreimage[:])
+	}
+
+	var settleDate int64
+	if !payment.SettleDate.IsZero() {
+		settleDate = payment.SettleDate.Unix()
+	}
+
+	return &lnrpc.Payment{
+		PaymentHash:     hex.EncodeToString(payment.PaymentHash[:]),
+		Value:           satValue,
+		ValueMsat:       msatValue,
+		ValueSat:        satValue,
+		CreationDate:    payment.CreationDate.Unix(),
+		Path:            path,
+		Fee:             int64(payment.Fee.ToSatoshis()),
+		FeeMsat:         int64(payment.Fee),
+		PaymentPreimage: preimage,
+		Metadata:        payment.Metadata,
+		Status:          status,
+		PaymentIndex:    payment.SequenceNum,
+		SettleDate:      settleDate,
+	}
+}
+
+// DeletePayment deletes the outgoing payment with the given payment hash
+// from the DB. Payments that are still in flight can't be deleted.
+func (r *rpcServer) DeletePayment(ctx context.Context,
+	req *lnrpc.DeletePaymentRequest) (*lnrpc.DeletePaymentResponse, error) {
 
-		msatValue := int64(payment.Terms.Value)
-		satValue := int64(payment.Terms.Value.ToSatoshis())
+	var (
+		payHash [32]byte
+		rHash   []byte
+		err     error
+	)
 
-		paymentHash := sha256.Sum256(payment.PaymentPreimage[:])
-		paymentsResp.Payments[i] = &lnrpc.Payment{
-			PaymentHash:     hex.EncodeToString(paymentHash[:]),
-			Value:           satValue,
-			ValueMsat:       msatValue,
-			ValueSat:        satValue,
-			CreationDate:    payment.CreationDate.Unix(),
-			Path:            path,
-			Fee:             int64(payment.Fee.ToSatoshis()),
-			PaymentPreimage: hex.EncodeToString(payment.PaymentPreimage[:]),
+	// If the payment hash was provided as a hex string, then decode that
+	// and use that directly. Otherwise, we use the raw bytes provided.
+	if req.PaymentHashStr != "" {
+		rHash, err = hex.DecodeString(req.PaymentHashStr)
+		if err != nil {
+			return nil, err
 		}
+	} else {
+		rHash = req.PaymentHash
 	}
 
-	return paymentsResp, nil
+	if len(rHash) != 32 {
+		return nil, fmt.Errorf("payment hash must be exactly "+
+			"32 bytes, is instead %v", len(rHash))
+	}
+	copy(payHash[:], rHash)
+
+	rpcsLog.Debugf("[deletepayment] payment_hash=%x", payHash[:])
+
+	if err := r.server.chanDB.DeletePayment(payHash); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DeletePaymentResponse{}, nil
 }
 
-// DeleteAllPayments deletes all outgoing payments from DB.
+// DeleteAllPayments deletes all outgoing payments from DB, or only the ones
+// that failed if requested.
 func (r *rpcServer) DeleteAllPayments(ctx context.Context,
-	_ *lnrpc.DeleteAllPaymentsRequest) (*lnrpc.DeleteAllPaymentsResponse, error) {
+	req *lnrpc.DeleteAllPaymentsRequest) (*lnrpc.DeleteAllPaymentsResponse, error) {
+
+	rpcsLog.Debugf("[DeleteAllPayments] failed_payments_only=%v",
+		req.FailedPaymentsOnly)
+
+	if req.FailedPaymentsOnly {
+		err := r.server.chanDB.DeleteFailedPayments()
+		if err != nil {
+			return nil, err
+		}
 
-	rpcsLog.Debugf("[DeleteAllPayments]")
+		return &lnrpc.DeleteAllPaymentsResponse{}, nil
+	}
 
 	if err := r.server.chanDB.DeleteAllPayments(); err != nil {
 		return nil, err
@@ -4352,8 +6482,17 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 	routeHints := createRPCRouteHints(payReq.RouteHints)
 
 	amt := int64(0)
+	amtMsat := int64(0)
 	if payReq.MilliSat != nil {
 		amt = int64(payReq.MilliSat.ToSatoshis())
+		amtMsat = int64(*payReq.MilliSat)
+	}
+
+	var features []uint32
+	if payReq.Features != nil {
+		for _, feature := range payReq.Features.Features() {
+			features = append(features, uint32(feature))
+		}
 	}
 
 	dest := payReq.Destination.SerializeCompressed()
@@ -4368,6 +6507,8 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 		Expiry:          expiry,
 		CltvExpiry:      int64(payReq.MinFinalCLTVExpiry()),
 		RouteHints:      routeHints,
+		NumMsat:         amtMsat,
+		Features:        features,
 	}, nil
 }
 
@@ -4392,34 +6533,9 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		return nil, err
 	}
 
-	var feeReports []*lnrpc.ChannelFeeReport
-	err = selfNode.ForEachChannel(nil, func(_ *bbolt.Tx, chanInfo *channeldb.ChannelEdgeInfo,
-		edgePolicy, _ *channeldb.ChannelEdgePolicy) error {
-
-		// Self node should always have policies for its channels.
-		if edgePolicy == nil {
-			return fmt.Errorf("no policy for outgoing channel %v ",
-				chanInfo.ChannelID)
-		}
-
-		// We'll compute the effective fee rate by converting from a
-		// fixed point fee rate to a floating point fee rate. The fee
-		// rate field in the database the amount of mSAT charged per
-		// 1mil mSAT sent, so will divide by this to get the proper fee
-		// rate.
-		feeRateFixedPoint := edgePolicy.FeeProportionalMillionths
-		feeRate := float64(feeRateFixedPoint) / float64(feeBase)
-
-		// TODO(roasbeef): also add stats for revenue for each channel
-		feeReports = append(feeReports, &lnrpc.ChannelFeeReport{
-			ChanPoint:   chanInfo.ChannelPoint.String(),
-			BaseFeeMsat: int64(edgePolicy.FeeBaseMSat),
-			FeePerMil:   int64(feeRateFixedPoint),
-			FeeRate:     feeRate,
-		})
-
-		return nil
-	})
+	// We'll also report why forwards over each channel failed, so that
+	// operators can tell which channels need attention.
+	fwdFailures, err := r.server.chanDB.FetchForwardFailures()
 	if err != nil {
 		return nil, err
 	}
@@ -4427,17 +6543,22 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 	fwdEventLog := r.server.chanDB.ForwardingLog()
 
 	// computeFeeSum is a helper function that computes the total fees for
-	// a particular time slice described by a forwarding event query.
-	computeFeeSum := func(query channeldb.ForwardingEventQuery) (lnwire.MilliSatoshi, error) {
+	// a particular time slice described by a forwarding event query. The
+	// fees are also tallied up for each outgoing channel, as that's the
+	// channel whose policy they were charged for.
+	computeFeeSum := func(query channeldb.ForwardingEventQuery) (
+		lnwire.MilliSatoshi,
+		map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error) {
 
 		var totalFees lnwire.MilliSatoshi
+		chanFees := make(map[lnwire.ShortChannelID]lnwire.MilliSatoshi)
 
 		// We'll continue to fetch the next query and accumulate the
 		// fees until the next query returns no events.
 		for {
 			timeSlice, err := fwdEventLog.Query(query)
 			if err != nil {
-				return 0, nil
+				return 0, nil, err
 			}
 
 			// If the timeslice is empty, then we'll return as
@@ -4451,6 +6572,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 			for _, event := range timeSlice.ForwardingEvents {
 				fee := event.AmtIn - event.AmtOut
 				totalFees += fee
+				chanFees[event.OutgoingChanID] += fee
 			}
 
 			// We'll now take the last offset index returned as
@@ -4461,7 +6583,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 			query.IndexOffset = timeSlice.LastIndexOffset
 		}
 
-		return totalFees, nil
+		return totalFees, chanFees, nil
 	}
 
 	now := time.Now()
@@ -4469,7 +6591,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 	// Before we perform the queries below, we'll instruct the switch to
 	// flush any pending events to disk. This ensure we get a complete
 	// snapshot at this particular time.
-	if r.server.htlcSwitch.FlushForwardingEvents(); err != nil {
+	if err := r.server.htlcSwitch.FlushForwardingEvents(); err != nil {
 		return nil, fmt.Errorf("unable to flush forwarding "+
 			"events: %v", err)
 	}
@@ -4482,7 +6604,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		EndTime:      now,
 		NumMaxEvents: 1000,
 	}
-	dayFees, err := computeFeeSum(dayQuery)
+	dayFees, dayChanFees, err := computeFeeSum(dayQuery)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve day fees: %v", err)
 	}
@@ -4492,9 +6614,9 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		EndTime:      now,
 		NumMaxEvents: 1000,
 	}
-	weekFees, err := computeFeeSum(weekQuery)
+	weekFees, weekChanFees, err := computeFeeSum(weekQuery)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve day fees: %v", err)
+		return nil, fmt.Errorf("unable to retrieve week fees: %v", err)
 	}
 
 	monthQuery := channeldb.ForwardingEventQuery{
@@ -4502,9 +6624,51 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		EndTime:      now,
 		NumMaxEvents: 1000,
 	}
-	monthFees, err := computeFeeSum(monthQuery)
+	monthFees, monthChanFees, err := computeFeeSum(monthQuery)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve day fees: %v", err)
+		return nil, fmt.Errorf("unable to retrieve month fees: %v",
+			err)
+	}
+
+	var feeReports []*lnrpc.ChannelFeeReport
+	err = selfNode.ForEachChannel(nil, func(_ *bbolt.Tx, chanInfo *channeldb.ChannelEdgeInfo,
+		edgePolicy, _ *channeldb.ChannelEdgePolicy) error {
+
+		// Self node should always have policies for its channels.
+		if edgePolicy == nil {
+			return fmt.Errorf("no policy for outgoing channel %v ",
+				chanInfo.ChannelID)
+		}
+
+		// We'll compute the effective fee rate by converting from a
+		// fixed point fee rate to a floating point fee rate. The fee
+		// rate field in the database the amount of mSAT charged per
+		// 1mil mSAT sent, so will divide by this to get the proper fee
+		// rate.
+		feeRateFixedPoint := edgePolicy.FeeProportionalMillionths
+		feeRate := float64(feeRateFixedPoint) / float64(feeBase)
+
+		chanID := lnwire.NewShortChanIDFromInt(chanInfo.ChannelID)
+		failures := fwdFailures[chanID]
+
+		feeReports = append(feeReports, &lnrpc.ChannelFeeReport{
+			ChanPoint:                   chanInfo.ChannelPoint.String(),
+			BaseFeeMsat:                 int64(edgePolicy.FeeBaseMSat),
+			FeePerMil:                   int64(feeRateFixedPoint),
+			FeeRate:                     feeRate,
+			PolicyFailures:              failures.Policy,
+			DownstreamFailures:          failures.Downstream,
+			InsufficientBalanceFailures: failures.InsufficientBalance,
+			LinkOfflineFailures:         failures.LinkOffline,
+			DayFeeSumMsat:               uint64(dayChanFees[chanID]),
+			WeekFeeSumMsat:              uint64(weekChanFees[chanID]),
+			MonthFeeSumMsat:             uint64(monthChanFees[chanID]),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &lnrpc.FeeReportResponse{
@@ -4580,11 +6744,24 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 	chanPolicy := routing.ChannelPolicy{
 		FeeSchema:     feeSchema,
 		TimeLockDelta: req.TimeLockDelta,
+		MinHTLC:       lnwire.MilliSatoshi(req.MinHtlcMsat),
+		MaxHTLC:       lnwire.MilliSatoshi(req.MaxHtlcMsat),
+	}
+
+	// If an inbound fee was specified, then it'll be advertised for, and
+	// applied to, the target channels as well.
+	if req.InboundFee != nil {
+		chanPolicy.InboundFee = &lnwire.InboundFee{
+			BaseFee: req.InboundFee.BaseFeeMsat,
+			FeeRate: req.InboundFee.FeeRatePpm,
+		}
 	}
 
 	rpcsLog.Debugf("[updatechanpolicy] updating channel policy base_fee=%v, "+
-		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, targets=%v",
+		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, "+
+		"inbound_fee=%v, min_htlc=%v, max_htlc=%v, targets=%v",
 		req.BaseFeeMsat, req.FeeRate, feeRateFixed, req.TimeLockDelta,
+		chanPolicy.InboundFee, chanPolicy.MinHTLC, chanPolicy.MaxHTLC,
 		spew.Sdump(targetChans))
 
 	// With the scope resolved, we'll now send this to the
@@ -4606,6 +6783,9 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		BaseFee:       baseFeeMsat,
 		FeeRate:       lnwire.MilliSatoshi(feeRateFixed),
 		TimeLockDelta: req.TimeLockDelta,
+		InboundFee:    chanPolicy.InboundFee,
+		MinHTLC:       chanPolicy.MinHTLC,
+		MaxHTLC:       chanPolicy.MaxHTLC,
 	}
 	err = r.server.htlcSwitch.UpdateForwardingPolicies(p, targetChans...)
 	if err != nil {
@@ -4636,10 +6816,14 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 
 	// Before we perform the queries below, we'll instruct the switch to
 	// flush any pending events to disk. This ensure we get a complete
-	// snapshot at this particular time.
-	if err := r.server.htlcSwitch.FlushForwardingEvents(); err != nil {
-		return nil, fmt.Errorf("unable to flush forwarding "+
-			"events: %v", err)
+	// snapshot at this particular time. There's no switch in observer
+	// mode, in which case the database is all there is.
+	if r.server.htlcSwitch != nil {
+		err := r.server.htlcSwitch.FlushForwardingEvents()
+		if err != nil {
+			return nil, fmt.Errorf("unable to flush forwarding "+
+				"events: %v", err)
+		}
 	}
 
 	var (
@@ -4706,3 +6890,689 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 
 	return resp, nil
 }
+
+// ListSweepableOutputs returns the time-locked outputs of force closed
+// channels that are being swept back into the wallet by the utxo nursery,
+// along with the fee required to sweep each of them.
+func (r *rpcServer) ListSweepableOutputs(ctx context.Context,
+	in *lnrpc.ListSweepableOutputsRequest) (
+	*lnrpc.ListSweepableOutputsResponse, error) {
+
+	feePerKw, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[listsweepable] sat/kw=%v", int64(feePerKw))
+
+	_, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := r.server.utxoNursery.SweepableOutputs(feePerKw)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ListSweepableOutputsResponse{
+		Outputs: make([]*lnrpc.SweepableOutput, 0, len(outputs)),
+	}
+	for _, output := range outputs {
+		blocksTil := int32(output.maturityHeight) - bestHeight
+
+		rpcOutput := &lnrpc.SweepableOutput{
+			Outpoint:          output.outPoint.String(),
+			ChannelPoint:      output.chanPoint.String(),
+			AmountSat:         int64(output.amount),
+			WitnessType:       output.witnessType.String(),
+			MaturityHeight:    output.maturityHeight,
+			BlocksTilMaturity: blocksTil,
+			EstimatedFeeSat:   int64(output.estimatedFee),
+		}
+		if output.sweepTxid != nil {
+			rpcOutput.SweepTxid = output.sweepTxid.String()
+		}
+
+		resp.Outputs = append(resp.Outputs, rpcOutput)
+	}
+
+	return resp, nil
+}
+
+// SweepOutputs immediately sweeps the selected outputs back into the wallet
+// at the requested fee rate, replacing the sweep transaction the utxo nursery
+// broadcast for them.
+func (r *rpcServer) SweepOutputs(ctx context.Context,
+	in *lnrpc.SweepOutputsRequest) (*lnrpc.SweepOutputsResponse, error) {
+
+	feePerKw, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	outPoints := make([]wire.OutPoint, 0, len(in.Outpoints))
+	for _, outPointStr := range in.Outpoints {
+		outPoint, err := parseOutPoint(outPointStr)
+		if err != nil {
+			return nil, err
+		}
+
+		outPoints = append(outPoints, *outPoint)
+	}
+
+	rpcsLog.Infof("[sweepoutputs] outpoints=%v, sat/kw=%v", outPoints,
+		int64(feePerKw))
+
+	sweepTx, err := r.server.utxoNursery.SweepOutputs(outPoints, feePerKw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SweepOutputsResponse{
+		SweepTxid: sweepTx.TxHash().String(),
+	}, nil
+}
+
+// parseOutPoint parses an outpoint in the format txid:index.
+func parseOutPoint(s string) (*wire.OutPoint, error) {
+	split := strings.Split(s, ":")
+	if len(split) != 2 {
+		return nil, fmt.Errorf("expected outpoint in format "+
+			"txid:index, got %v", s)
+	}
+
+	txid, err := chainhash.NewHashFromStr(split[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid txid %v: %v", split[0], err)
+	}
+
+	index, err := strconv.ParseUint(split[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output index %v: %v",
+			split[1], err)
+	}
+
+	return wire.NewOutPoint(txid, uint32(index)), nil
+}
+
+// ExportChannelBackup attempts to return an encrypted static channel backup
+// for the target channel identified by its channel point. The backup is
+// encrypted with a key generated from the aezeed seed of the user.
+func (r *rpcServer) ExportChannelBackup(ctx context.Context,
+	in *lnrpc.ExportChannelBackupRequest) (*lnrpc.ChannelBackup, error) {
+
+	// First, we'll convert the lnrpc channel point into a wire.OutPoint
+	// that we can manipulate.
+	if in.GetChanPoint() == nil {
+		return nil, fmt.Errorf("must specify channel point")
+	}
+	txidHash, err := getChanPointFundingTxid(in.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	txid, err := chainhash.NewHash(txidHash)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.OutPoint{
+		Hash:  *txid,
+		Index: in.ChanPoint.OutputIndex,
+	}
+
+	// Next, we'll attempt to fetch a channel backup for this channel from
+	// the database. If this channel has been closed, or the outpoint is
+	// unknown, then we'll return an error.
+	unpackedBackup, err := chanbackup.FetchBackupForChan(
+		chanPoint, r.server.chanDB, r.server.chanDB,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// At this point, we have an unpacked backup (plaintext) so we'll now
+	// attempt to serialize and encrypt it in order to create a packed
+	// backup.
+	var b bytes.Buffer
+	err = unpackedBackup.PackToWriter(&b, r.server.cc.keyRing)
+	if err != nil {
+		return nil, fmt.Errorf("unable to pack channel backup: %v",
+			err)
+	}
+
+	return &lnrpc.ChannelBackup{
+		ChanPoint:  in.ChanPoint,
+		ChanBackup: b.Bytes(),
+	}, nil
+}
+
+// createBackupSnapshot converts the passed set of unpacked backups into a
+// snapshot, which contains the individually packed single backups, as well as
+// a single packed multi backup covering all of them.
+func (r *rpcServer) createBackupSnapshot(
+	backups []chanbackup.Single) (*lnrpc.ChanBackupSnapshot, error) {
+
+	keyRing := r.server.cc.keyRing
+
+	// First, we'll attempt to construct a set of packed single backups
+	// from the unpacked backups.
+	packedSingles, err := chanbackup.PackStaticChanBackups(
+		backups, keyRing,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to pack channel backups: %v",
+			err)
+	}
+
+	// With the singles packed, we'll assemble the RPC representation of
+	// them, along with the set of channels covered by the multi backup.
+	singleBackups := &lnrpc.ChannelBackups{}
+	multiBackup := &lnrpc.MultiChanBackup{}
+	for _, backup := range backups {
+		chanPoint := backup.FundingOutpoint
+		rpcChanPoint := marshallChannelPoint(&chanPoint)
+
+		singleBackups.ChanBackups = append(
+			singleBackups.ChanBackups, &lnrpc.ChannelBackup{
+				ChanPoint:  rpcChanPoint,
+				ChanBackup: packedSingles[chanPoint],
+			},
+		)
+		multiBackup.ChanPoints = append(
+			multiBackup.ChanPoints, rpcChanPoint,
+		)
+	}
+
+	// Finally, we'll pack all the backups into a single multi backup.
+	var b bytes.Buffer
+	unpackedMulti := chanbackup.Multi{
+		StaticBackups: backups,
+	}
+	if err := unpackedMulti.PackToWriter(&b, keyRing); err != nil {
+		return nil, fmt.Errorf("unable to pack multi backup: %v", err)
+	}
+	multiBackup.MultiChanBackup = b.Bytes()
+
+	return &lnrpc.ChanBackupSnapshot{
+		SingleChanBackups: singleBackups,
+		MultiChanBackup:   multiBackup,
+	}, nil
+}
+
+// fetchBackupSnapshot assembles a fresh backup snapshot covering all the
+// channels currently known to the database.
+func (r *rpcServer) fetchBackupSnapshot() (*lnrpc.ChanBackupSnapshot, error) {
+	backups, err := chanbackup.FetchStaticChanBackups(
+		r.server.chanDB, r.server.chanDB,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch all static chan "+
+			"backups: %v", err)
+	}
+
+	return r.createBackupSnapshot(backups)
+}
+
+// ExportAllChannelBackups returns static channel backups for all existing
+// channels known to lnd. A set of regular singular static channel backups for
+// each channel are returned. Additionally, a multi-channel backup is returned
+// as well, which contains a single encrypted blob containing the backups of
+// each channel.
+func (r *rpcServer) ExportAllChannelBackups(ctx context.Context,
+	in *lnrpc.ChanBackupExportRequest) (*lnrpc.ChanBackupSnapshot, error) {
+
+	return r.fetchBackupSnapshot()
+}
+
+// VerifyChanBackup allows a caller to verify the integrity of a channel backup
+// snapshot. This method will accept either a packed Single or a packed Multi.
+// Specifying both will result in an error.
+func (r *rpcServer) VerifyChanBackup(ctx context.Context,
+	in *lnrpc.ChanBackupSnapshot) (*lnrpc.VerifyChanBackupResponse, error) {
+
+	switch {
+	// If neither a Single or Multi has been specified, then we have
+	// nothing to verify.
+	case in.GetSingleChanBackups() == nil && in.GetMultiChanBackup() == nil:
+		return nil, errors.New("either a Single or Multi channel " +
+			"backup must be specified")
+
+	// Either a Single or a Multi must be specified, but not both.
+	case in.GetSingleChanBackups() != nil && in.GetMultiChanBackup() != nil:
+		return nil, errors.New("either a Single or Multi channel " +
+			"backup must be specified, but not both")
+
+	// If a Single is specified then we'll only accept one of them to
+	// allow the caller to map the valid/invalid state for each individual
+	// Single.
+	case in.GetSingleChanBackups() != nil:
+		chanBackupsProtos := in.GetSingleChanBackups().ChanBackups
+		if len(chanBackupsProtos) != 1 {
+			return nil, errors.New("only one Single is accepted " +
+				"at a time")
+		}
+
+		// First, we'll convert the raw byte slice into a type we can
+		// work with a bit better.
+		chanBackup := chanbackup.PackedSingles(
+			[][]byte{chanBackupsProtos[0].ChanBackup},
+		)
+
+		// With our PackedSingles created, we'll attempt to unpack the
+		// backup. If this fails, then we know the backup is invalid
+		// for some reason, or wasn't created with our current seed.
+		_, err := chanBackup.Unpack(r.server.cc.keyRing)
+		if err != nil {
+			return nil, fmt.Errorf("invalid single channel "+
+				"backup: %v", err)
+		}
+
+	case in.GetMultiChanBackup() != nil:
+		// We'll convert the raw byte slice into a PackedMulti that we
+		// can easily work with.
+		packedMultiBackup := in.GetMultiChanBackup().MultiChanBackup
+		packedMulti := chanbackup.PackedMulti(packedMultiBackup)
+
+		// We'll now attempt to unpack the Multi. If this fails, then
+		// we know it's invalid.
+		_, err := packedMulti.Unpack(r.server.cc.keyRing)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multi channel backup: "+
+				"%v", err)
+		}
+	}
+
+	return &lnrpc.VerifyChanBackupResponse{}, nil
+}
+
+// RestoreChannelBackups accepts a set of singular channel backups, or a single
+// encrypted multi-chan backup and attempts to recover any funds remaining
+// within the channel. If we're able to unpack the backup, then the new channel
+// will be shown under listchannels, as well as pending channels.
+func (r *rpcServer) RestoreChannelBackups(ctx context.Context,
+	in *lnrpc.RestoreChanBackupRequest) (*lnrpc.RestoreBackupResponse, error) {
+
+	// First, we'll make our implementation of the
+	// chanbackup.ChannelRestorer interface which we'll use to properly
+	// restore either a set of chanbackup.Single or chanbackup.Multi
+	// backups.
+	chanRestorer := &chanDBRestorer{
+		db:         r.server.chanDB,
+		secretKeys: r.server.cc.wallet.Cfg.SecretKeyRing,
+		chainArb:   r.server.chainArb,
+	}
+
+	// We'll accept either a list of Single backups, or a single Multi
+	// backup which contains several single backups.
+	switch {
+	case in.GetChanBackups() != nil:
+		chanBackupsProtos := in.GetChanBackups()
+
+		// Now that we know what type of backup we're working with,
+		// we'll parse them all out into a more suitable format.
+		packedBackups := make([][]byte, 0, len(chanBackupsProtos.ChanBackups))
+		for _, chanBackup := range chanBackupsProtos.ChanBackups {
+			packedBackups = append(
+				packedBackups, chanBackup.ChanBackup,
+			)
+		}
+
+		// With our backups obtained, we'll now restore them which will
+		// write the new backups to disk, and then attempt to connect
+		// out to any peers that we know of which were our prior
+		// channel peers.
+		err := chanbackup.UnpackAndRecoverSingles(
+			chanbackup.PackedSingles(packedBackups),
+			r.server.cc.keyRing, chanRestorer, r.server,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unpack single "+
+				"backups: %v", err)
+		}
+
+	case in.GetMultiChanBackup() != nil:
+		packedMultiBackup := in.GetMultiChanBackup()
+
+		// With our backups obtained, we'll now restore them which will
+		// write the new backups to disk, and then attempt to connect
+		// out to any peers that we know of which were our prior
+		// channel peers.
+		packedMulti := chanbackup.PackedMulti(packedMultiBackup)
+		err := chanbackup.UnpackAndRecoverMulti(
+			packedMulti, r.server.cc.keyRing, chanRestorer,
+			r.server,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unpack chan "+
+				"backup: %v", err)
+		}
+
+	default:
+		return nil, fmt.Errorf("either chan_backups or " +
+			"multi_chan_backup must be specified")
+	}
+
+	return &lnrpc.RestoreBackupResponse{}, nil
+}
+
+// SubscribeChannelBackups returns a uni-directional stream (server -> client)
+// over which a new backup snapshot is sent each time a channel is funded,
+// opened or closed. Each snapshot covers all channels known at that point.
+func (r *rpcServer) SubscribeChannelBackups(
+	req *lnrpc.ChannelBackupSubscription,
+	updateStream lnrpc.Lightning_SubscribeChannelBackupsServer) error {
+
+	channelEventSub, err := r.server.chanNotifier.SubscribeChannelEvents()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer channelEventSub.Cancel()
+
+	for {
+		select {
+		// A new update has been sent by the channel notifier. If it
+		// changes the set of channels we need to back up, then we'll
+		// send a fresh snapshot to the client.
+		case e := <-channelEventSub.Updates():
+			switch e.(type) {
+			case channelnotifier.PendingOpenChannelEvent:
+			case channelnotifier.OpenChannelEvent:
+			case channelnotifier.ClosedChannelEvent:
+			default:
+				continue
+			}
+
+			snapshot, err := r.fetchBackupSnapshot()
+			if err != nil {
+				return err
+			}
+
+			if err := updateStream.Send(snapshot); err != nil {
+				return err
+			}
+
+		// The subscription was canceled as the channel notifier is
+		// shutting down.
+		case <-channelEventSub.Quit():
+			return errors.New("channel notifier shutting down")
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// BakeMacaroon allows the creation of a new macaroon with custom read and
+// write permissions. No first-party caveats are added since this can be done
+// offline.
+func (r *rpcServer) BakeMacaroon(ctx context.Context,
+	req *lnrpc.BakeMacaroonRequest) (*lnrpc.BakeMacaroonResponse, error) {
+
+	rpcsLog.Debugf("[bakemacaroon]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon
+	// service is not initialized. Therefore we can't bake new macaroons.
+	if r.macService == nil {
+		return nil, errMacaroonDisabled
+	}
+
+	helpMsg := fmt.Sprintf("supported actions are %v, supported entities "+
+		"are %v", validActions, validEntities)
+
+	// Don't allow empty permission list as it doesn't make sense to have
+	// a macaroon that is not allowed to access any RPC.
+	if len(req.Permissions) == 0 {
+		return nil, fmt.Errorf("permission list cannot be empty. "+
+			"specify at least one action/entity pair. %s", helpMsg)
+	}
+
+	// Validate and map permission struct used by gRPC to the one used by
+	// the bakery.
+	requestedPermissions := make([]bakery.Op, len(req.Permissions))
+	for idx, op := range req.Permissions {
+		if !stringInSlice(op.Action, validActions) {
+			return nil, fmt.Errorf("invalid permission action. %s",
+				helpMsg)
+		}
+		if !stringInSlice(op.Entity, validEntities) {
+			return nil, fmt.Errorf("invalid permission entity. %s",
+				helpMsg)
+		}
+
+		requestedPermissions[idx] = bakery.Op{
+			Entity: op.Entity,
+			Action: op.Action,
+		}
+	}
+
+	// Convert root key id from uint64 to bytes. Because the
+	// DefaultRootKeyID is a digit 0 expressed in a byte slice of a string
+	// "0", we will keep the IDs in the same format - all must be numeric,
+	// and must be a byte slice of string value of the digit.
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+
+	// Bake new macaroon with the given permissions and send it binary
+	// serialized and hex encoded to the client.
+	newMac, err := r.macService.NewMacaroon(
+		ctx, rootKeyID, requestedPermissions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	newMacBytes, err := newMac.M().MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.BakeMacaroonResponse{
+		Macaroon: hex.EncodeToString(newMacBytes),
+	}, nil
+}
+
+// ListMacaroonIDs returns a list of macaroon root key IDs in use.
+func (r *rpcServer) ListMacaroonIDs(ctx context.Context,
+	req *lnrpc.ListMacaroonIDsRequest) (
+	*lnrpc.ListMacaroonIDsResponse, error) {
+
+	rpcsLog.Debugf("[listmacaroonids]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon
+	// service is not initialized. Therefore we can't show any IDs.
+	if r.macService == nil {
+		return nil, errMacaroonDisabled
+	}
+
+	rootKeyIDByteSlice, err := r.macService.ListMacaroonIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rootKeyIDs []uint64
+	for _, value := range rootKeyIDByteSlice {
+		// Convert bytes into uint64.
+		id, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		rootKeyIDs = append(rootKeyIDs, id)
+	}
+
+	return &lnrpc.ListMacaroonIDsResponse{RootKeyIds: rootKeyIDs}, nil
+}
+
+// DeleteMacaroonID removes a specific macaroon ID. All macaroons that were
+// baked with the root key of that ID are invalidated.
+func (r *rpcServer) DeleteMacaroonID(ctx context.Context,
+	req *lnrpc.DeleteMacaroonIDRequest) (
+	*lnrpc.DeleteMacaroonIDResponse, error) {
+
+	rpcsLog.Debugf("[deletemacaroonid]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon
+	// service is not initialized. Therefore we can't delete any IDs.
+	if r.macService == nil {
+		return nil, errMacaroonDisabled
+	}
+
+	// The root key IDs are stored in the same string format as the one
+	// used when baking the macaroon.
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+	deletedIDBytes, err := r.macService.DeleteMacaroonID(ctx, rootKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DeleteMacaroonIDResponse{
+		// If the root key ID doesn't exist, it won't be deleted. We
+		// will return a response with deleted = false, otherwise true.
+		Deleted: deletedIDBytes != nil,
+	}, nil
+}
+
+// ListPermissions lists all RPC method URIs and the macaroon permissions they
+// require, including the ones of the sub-servers.
+func (r *rpcServer) ListPermissions(ctx context.Context,
+	req *lnrpc.ListPermissionsRequest) (*lnrpc.ListPermissionsResponse,
+	error) {
+
+	rpcsLog.Debugf("[listpermissions]")
+
+	methodPerms := make(map[string]*lnrpc.MacaroonPermissionList)
+	for method, ops := range permissions {
+		rpcPerms := make([]*lnrpc.MacaroonPermission, len(ops))
+		for idx, op := range ops {
+			rpcPerms[idx] = &lnrpc.MacaroonPermission{
+				Entity: op.Entity,
+				Action: op.Action,
+			}
+		}
+
+		methodPerms[method] = &lnrpc.MacaroonPermissionList{
+			Permissions: rpcPerms,
+		}
+	}
+
+	return &lnrpc.ListPermissionsResponse{
+		MethodPermissions: methodPerms,
+	}, nil
+}
+
+// CheckMacaroonPermissions checks whether the given macaroon is valid and
+// grants all of the given permissions.
+func (r *rpcServer) CheckMacaroonPermissions(ctx context.Context,
+	req *lnrpc.CheckMacPermRequest) (*lnrpc.CheckMacPermResponse, error) {
+
+	rpcsLog.Debugf("[checkmacaroonpermissions]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon
+	// service is not initialized. Therefore we can't check any macaroon.
+	if r.macService == nil {
+		return nil, errMacaroonDisabled
+	}
+
+	if len(req.Permissions) == 0 {
+		return nil, fmt.Errorf("permission list cannot be empty")
+	}
+
+	requiredPermissions := make([]bakery.Op, len(req.Permissions))
+	for idx, op := range req.Permissions {
+		requiredPermissions[idx] = bakery.Op{
+			Entity: op.Entity,
+			Action: op.Action,
+		}
+	}
+
+	err := r.macService.CheckMacAuth(
+		ctx, req.Macaroon, requiredPermissions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.CheckMacPermResponse{Valid: true}, nil
+}
+
+// stringInSlice returns true if a string is contained in the given slice.
+func stringInSlice(a string, slice []string) bool {
+	for _, b := range slice {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterRPCMiddleware adds a new gRPC middleware to the interceptor chain.
+// The first message sent by the middleware must be its registration. From
+// then on, every request and response of an RPC call made with a macaroon
+// that carries the middleware's custom caveat is sent to it, until the stream
+// is closed.
+func (r *rpcServer) RegisterRPCMiddleware(
+	stream lnrpc.Lightning_RegisterRPCMiddlewareServer) error {
+
+	// Middlewares are selected based on the custom caveats of the
+	// macaroon used for a call, so they can't be used if the macaroon
+	// service isn't running.
+	if r.macService == nil || r.middleware == nil {
+		return errMacaroonDisabled
+	}
+
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	registration := msg.GetRegister()
+	switch {
+	case registration == nil:
+		return fmt.Errorf("first message sent by the middleware must " +
+			"be a registration message")
+
+	case registration.MiddlewareName == "":
+		return fmt.Errorf("middleware name cannot be empty")
+
+	case registration.CustomMacaroonCaveatName == "":
+		return fmt.Errorf("custom macaroon caveat name cannot be empty")
+
+	case strings.Contains(registration.CustomMacaroonCaveatName, " "):
+		return fmt.Errorf("custom macaroon caveat name cannot " +
+			"contain spaces")
+	}
+
+	handler := newRPCMiddlewareHandler(
+		registration.MiddlewareName,
+		registration.CustomMacaroonCaveatName, stream,
+	)
+
+	// Let the middleware know its registration was accepted before any
+	// intercepted messages can be sent to it.
+	err = handler.send(&lnrpc.RPCMiddlewareRequest{RegComplete: true})
+	if err != nil {
+		return err
+	}
+
+	if err := r.middleware.register(handler); err != nil {
+		return err
+	}
+	defer r.middleware.remove(registration.MiddlewareName)
+
+	rpcsLog.Infof("RPC middleware %v registered for custom caveat %v",
+		registration.MiddlewareName,
+		registration.CustomMacaroonCaveatName)
+
+	err = handler.run(r.quit)
+
+	rpcsLog.Infof("RPC middleware %v removed", registration.MiddlewareName)
+
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"image/color"
@@ -28,19 +29,28 @@ import (
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/brontide"
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/chanfitness"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/feepolicy"
 	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/liquidity"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/nat"
+	"github.com/lightningnetwork/lnd/offers"
+	"github.com/lightningnetwork/lnd/onionmsg"
+	"github.com/lightningnetwork/lnd/peernotifier"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/zpay32"
 )
 
 const (
@@ -57,6 +67,10 @@ const (
 	// durations exceeding this value will be eligible to have their
 	// backoffs reduced.
 	defaultStableConnDuration = 10 * time.Minute
+
+	// maxOnionMessageSearch is the maximum number of nodes we'll visit
+	// when searching the channel graph for an onion message path.
+	maxOnionMessageSearch = 10000
 )
 
 var (
@@ -149,8 +163,41 @@ type server struct {
 
 	breachArbiter *breachArbiter
 
+	// chanNotifier dispatches events about the lifecycle of our channels
+	// to its subscribers.
+	chanNotifier *channelnotifier.ChannelNotifier
+
+	// peerNotifier dispatches events about peers coming online and going
+	// offline to its subscribers.
+	peerNotifier *peernotifier.PeerNotifier
+
+	// chanSubSwapper keeps the multi-channel backup file on disk up to
+	// date as channels are opened and closed.
+	chanSubSwapper *chanbackup.SubSwapper
+
 	chanRouter *routing.ChannelRouter
 
+	// onionMessenger forwards onion messages for our peers, and carries
+	// the onion messages of local subsystems. It's only set if onion
+	// messages are enabled.
+	onionMessenger *onionmsg.Messenger
+
+	// offerMgr answers invoice requests for our BOLT-12 offers and pays
+	// remote ones. It's only set if offers are enabled.
+	offerMgr *offers.Manager
+
+	// uptimeTracker tracks for how long the peers of each of our channels
+	// have been online.
+	uptimeTracker *chanfitness.UptimeTracker
+
+	// feeMgr adjusts the fee rates of our channels according to their
+	// liquidity, if enabled.
+	feeMgr *feepolicy.Manager
+
+	// liquidityMgr keeps the balances of our channels within their
+	// targets by rebalancing them, if enabled.
+	liquidityMgr *liquidity.Manager
+
 	authGossiper *discovery.AuthenticatedGossiper
 
 	utxoNursery *utxoNursery
@@ -221,7 +268,9 @@ func parseAddr(address string) (net.Addr, error) {
 func noiseDial(idPriv *btcec.PrivateKey) func(net.Addr) (net.Conn, error) {
 	return func(a net.Addr) (net.Conn, error) {
 		lnAddr := a.(*lnwire.NetAddress)
-		return brontide.Dial(idPriv, lnAddr, cfg.net.Dial)
+		return brontide.Dial(
+			idPriv, lnAddr, tor.DefaultConnTimeout, cfg.net.Dial,
+		)
 	}
 }
 
@@ -246,6 +295,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	}
 
 	globalFeatures := lnwire.NewRawFeatureVector()
+	if cfg.OnionMessages {
+		globalFeatures.Set(lnwire.OnionMessagesOptional)
+	}
 
 	var serializedPubKey [33]byte
 	copy(serializedPubKey[:], privKey.PubKey().SerializeCompressed())
@@ -311,6 +363,13 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
+	// If enabled, the switch will hold HTLCs destined to our private
+	// channel peers while they're offline, rather than failing them.
+	var asyncPaymentPeer func(lnwire.ShortChannelID) ([33]byte, bool)
+	if cfg.AsyncPayments {
+		asyncPaymentPeer = s.asyncPaymentPeer
+	}
+
 	s.htlcSwitch, err = htlcswitch.New(htlcswitch.Config{
 		DB:      chanDB,
 		SelfKey: s.identityPriv.PubKey(),
@@ -345,6 +404,22 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			htlcswitch.DefaultFwdEventInterval),
 		LogEventTicker: ticker.New(
 			htlcswitch.DefaultLogInterval),
+		RejectHTLC:               cfg.RejectHTLC,
+		HtlcAddRate:              cfg.HtlcAddRate,
+		HtlcAddBurst:             cfg.HtlcAddBurst,
+		EndorsedSlotReserve:      cfg.EndorsedSlotReserve,
+		EndorsedLiquidityReserve: cfg.EndorsedLiquidityReserve,
+		AsyncPaymentPeer:         asyncPaymentPeer,
+		AsyncHoldCltvMargin:      cfg.AsyncHoldCltvMargin,
+		AsyncHoldMaxHtlcs:        cfg.AsyncHoldMaxHtlcs,
+		ProbeFailureThreshold:    cfg.ProbeFailureThreshold,
+		ProbeDecayHalfLife:       cfg.ProbeDecayHalfLife,
+		MaxHeldInvoiceHTLCs:      cfg.MaxHeldInvoiceHtlcs,
+		PreimageCache:            s.witnessBeacon,
+		MaxLinkStartups:          cfg.MaxLinkStartups,
+		LinkStartupJitter:        cfg.LinkStartupJitter,
+		AsyncReleaseTicker: ticker.New(
+			htlcswitch.DefaultAsyncReleaseInterval),
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
@@ -523,7 +598,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 				firstHop, htlcAdd, errorDecryptor,
 			)
 		},
-		ChannelPruneExpiry: time.Duration(time.Hour * 24 * 14),
+		ChannelPruneExpiry: routing.DefaultChannelPruneExpiry,
 		GraphPruneInterval: time.Duration(time.Hour),
 		QueryBandwidth: func(edge *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
 			// If we aren't on either side of this edge, then we'll
@@ -554,12 +629,84 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			// for the available bandwidth for the link.
 			return link.Bandwidth()
 		},
+		QueryBandwidthSnapshot: func() map[uint64]lnwire.MilliSatoshi {
+			snapshot := s.htlcSwitch.BandwidthSnapshot()
+
+			bandwidths := make(
+				map[uint64]lnwire.MilliSatoshi, len(snapshot),
+			)
+			for chanID, bandwidth := range snapshot {
+				bandwidths[chanID.ToUint64()] = bandwidth
+			}
+
+			return bandwidths
+		},
 		AssumeChannelValid: cfg.Routing.UseAssumeChannelValid(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("can't create router: %v", err)
 	}
 
+	s.uptimeTracker = chanfitness.NewUptimeTracker(chanfitness.Config{
+		AddUptime:   chanDB.AddChannelUptime,
+		FetchUptime: chanDB.FetchChannelUptime,
+		FlushTicker: ticker.New(chanfitness.DefaultFlushInterval),
+	})
+
+	if cfg.OnionMessages {
+		s.onionMessenger = onionmsg.NewMessenger(&onionmsg.Config{
+			NodeKey: privKey,
+			SendToPeer: func(target *btcec.PublicKey,
+				msg lnwire.Message) error {
+
+				// We queue the message without waiting for it
+				// to be written, as forwarding is done from
+				// within the sending peer's read handler.
+				p, err := s.FindPeer(target)
+				if err != nil {
+					return err
+				}
+				p.queueMsg(msg, nil)
+
+				return nil
+			},
+			FindPath: s.findOnionMessagePath,
+			IsPeer: func(node *btcec.PublicKey) bool {
+				_, err := s.FindPeer(node)
+				return err == nil
+			},
+			PeerRate:  cfg.OnionMessageRate,
+			PeerBurst: cfg.OnionMessageBurst,
+		})
+	}
+
+	if cfg.Offers {
+		s.offerMgr = offers.NewManager(&offers.Config{
+			NodeID:         privKey.PubKey(),
+			SignNodeDigest: offers.KeySigner(privKey),
+			ChainHash:      *activeNetParams.GenesisHash,
+			AddInvoice:     s.invoices.AddInvoice,
+			SendPayment:    s.chanRouter.SendPayment,
+			Transport:      s.onionMessenger,
+			InvoiceRate:    cfg.OfferInvoiceRate,
+			InvoiceBurst:   cfg.OfferInvoiceBurst,
+		})
+
+		offerMsgTypes := []uint64{
+			offers.MsgInvoiceRequest,
+			offers.MsgInvoice,
+			offers.MsgInvoiceError,
+		}
+		for _, msgType := range offerMsgTypes {
+			err := s.onionMessenger.RegisterHandler(
+				msgType, s.handleOfferMessage,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	chanSeries := discovery.NewChanSeries(
 		s.chanDB.ChannelGraph(),
 	)
@@ -587,6 +734,34 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
+	if cfg.AutoFee.Active {
+		s.feeMgr = feepolicy.NewManager(feepolicy.Config{
+			FetchChannels:   s.fetchFeePolicyChannels,
+			UpdatePolicy:    s.updateChanPolicy,
+			MinFeeRate:      cfg.AutoFee.MinFeeRate,
+			MaxFeeRate:      cfg.AutoFee.MaxFeeRate,
+			UpdateThreshold: cfg.AutoFee.UpdateThreshold,
+			IdleDiscount:    cfg.AutoFee.IdleDiscount,
+			UpdateTicker:    ticker.New(cfg.AutoFee.Interval),
+		})
+	}
+
+	if cfg.Liquidity.Active {
+		s.liquidityMgr = liquidity.NewManager(liquidity.Config{
+			FetchChannels: s.fetchLiquidityChannels,
+			Rebalance:     s.rebalance,
+			MinLocalRatio: cfg.Liquidity.MinLocalRatio,
+			MaxLocalRatio: cfg.Liquidity.MaxLocalRatio,
+			MaxFeeRate:    cfg.Liquidity.MaxFeeRate,
+			FeeBudget: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(cfg.Liquidity.FeeBudget),
+			),
+			BudgetPeriod: cfg.Liquidity.BudgetPeriod,
+			DryRun:       cfg.Liquidity.DryRun,
+			UpdateTicker: ticker.New(cfg.Liquidity.Interval),
+		})
+	}
+
 	utxnStore, err := newNurseryStore(activeNetParams.GenesisHash, chanDB)
 	if err != nil {
 		srvrLog.Errorf("unable to create nursery store: %v", err)
@@ -618,9 +793,19 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		closureType htlcswitch.ChannelCloseType) {
 		// TODO(conner): Properly respect the update and error channels
 		// returned by CloseLink.
-		s.htlcSwitch.CloseLink(chanPoint, closureType, 0)
+		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, nil)
 	}
 
+	s.chanNotifier = channelnotifier.New(chanDB)
+	s.peerNotifier = peernotifier.New()
+
+	// We'll also set up the sub-swapper that will keep the on-disk
+	// multi-channel backup in sync with our current set of channels.
+	backupFile := chanbackup.NewMultiFile(cfg.BackupFilePath)
+	s.chanSubSwapper = chanbackup.NewSubSwapper(
+		chanDB, chanDB, s.chanNotifier, cc.keyRing, backupFile,
+	)
+
 	// We will use the following channel to reliably hand off contract
 	// breach events from the ChannelArbitrator to the breachArbiter,
 	contractBreaches := make(chan *ContractBreachEvent, 1)
@@ -702,7 +887,12 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		DisableChannel: func(op wire.OutPoint) error {
 			return s.announceChanStatus(op, true)
 		},
-		Sweeper: sweeper,
+		Sweeper:             sweeper,
+		NotifyClosedChannel: s.chanNotifier.NotifyClosedChannelEvent,
+		NotifyFullyResolvedChannel: func(op wire.OutPoint) {
+			s.chanNotifier.NotifyFullyResolvedChannelEvent(op)
+		},
+		PutHtlcResolution: chanDB.PutHtlcResolution,
 	}, chanDB)
 
 	s.breachArbiter = newBreachArbiter(&BreachConfig{
@@ -717,6 +907,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ContractBreaches:   contractBreaches,
 		Signer:             cc.wallet.Cfg.Signer,
 		Store:              newRetributionStore(chanDB),
+		NotifyFullyResolvedChannel: func(op wire.OutPoint) {
+			s.chanNotifier.NotifyFullyResolvedChannelEvent(op)
+		},
 	})
 
 	// Select the configuration and furnding parameters for Bitcoin or
@@ -862,6 +1055,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
 			return s.htlcSwitch.UpdateShortChanID(cid)
 		},
+		NotifyPendingOpenChannelEvent: s.chanNotifier.NotifyPendingOpenChannelEvent,
+		NotifyOpenChannelEvent:        s.chanNotifier.NotifyOpenChannelEvent,
 		RequiredRemoteChanReserve: func(chanAmt,
 			dustLimit btcutil.Amount) btcutil.Amount {
 
@@ -950,6 +1145,15 @@ func (s *server) Start() error {
 	if err := s.cc.chainNotifier.Start(); err != nil {
 		return err
 	}
+	if err := s.chanNotifier.Start(); err != nil {
+		return err
+	}
+	if err := s.peerNotifier.Start(); err != nil {
+		return err
+	}
+	if err := s.chanSubSwapper.Start(); err != nil {
+		return err
+	}
 	if err := s.sphinx.Start(); err != nil {
 		return err
 	}
@@ -979,6 +1183,33 @@ func (s *server) Start() error {
 	if err := s.invoices.Start(); err != nil {
 		return err
 	}
+	if err := s.uptimeTracker.Start(); err != nil {
+		return err
+	}
+	if s.feeMgr != nil {
+		if err := s.feeMgr.Start(); err != nil {
+			return err
+		}
+	}
+	if s.liquidityMgr != nil {
+		if err := s.liquidityMgr.Start(); err != nil {
+			return err
+		}
+	}
+
+	// We'll track the uptime of all of our open channels from the start,
+	// such that the time their peers spend offline is accounted for even
+	// if they never reconnect.
+	openChannels, err := s.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return err
+	}
+	for _, channel := range openChannels {
+		var peer [33]byte
+		copy(peer[:], channel.IdentityPub.SerializeCompressed())
+
+		s.uptimeTracker.AddChannel(channel.FundingOutpoint, peer)
+	}
 
 	// With all the relevant sub-systems started, we'll now attempt to
 	// establish persistent connections to our direct channel collaborators
@@ -988,6 +1219,27 @@ func (s *server) Start() error {
 	if err := s.chanDB.PruneLinkNodes(); err != nil {
 		return err
 	}
+
+	// If requested, we'll also compact the records of the channels that
+	// have been fully closed and resolved, and delete those that were
+	// closed before the retention window.
+	if cfg.ArchiveClosedChans {
+		_, bestHeight, err := s.cc.chainIO.GetBestBlock()
+		if err != nil {
+			return err
+		}
+
+		numArchived, numDeleted, err := s.chanDB.ArchiveClosedChannels(
+			uint32(bestHeight), cfg.ClosedChanRetention,
+		)
+		if err != nil {
+			return err
+		}
+
+		srvrLog.Infof("Archived %v and deleted %v closed channels",
+			numArchived, numDeleted)
+	}
+
 	if err := s.establishPersistentConnections(); err != nil {
 		return err
 	}
@@ -1048,6 +1300,18 @@ func (s *server) Stop() error {
 	s.cc.feeEstimator.Stop()
 	s.invoices.Stop()
 	s.fundingMgr.Stop()
+	if s.offerMgr != nil {
+		s.offerMgr.Stop()
+	}
+	if s.onionMessenger != nil {
+		s.onionMessenger.Stop()
+	}
+	if s.feeMgr != nil {
+		s.feeMgr.Stop()
+	}
+	if s.liquidityMgr != nil {
+		s.liquidityMgr.Stop()
+	}
 
 	// Disconnect from each active peers to ensure that
 	// peerTerminationWatchers signal completion to each peer.
@@ -1055,6 +1319,13 @@ func (s *server) Stop() error {
 		s.DisconnectPeer(peer.addr.IdentityKey)
 	}
 
+	// With all peers disconnected, persist the uptime accumulated for our
+	// channels.
+	s.uptimeTracker.Stop()
+	s.chanSubSwapper.Stop()
+	s.chanNotifier.Stop()
+	s.peerNotifier.Stop()
+
 	// Wait for all lingering goroutines to quit.
 	s.wg.Wait()
 
@@ -1387,7 +1658,10 @@ func (s *server) peerBootstrapper(numTargetPeers uint32,
 					// TODO(roasbeef): can do AS, subnet,
 					// country diversity, etc
 					errChan := make(chan error, 1)
-					s.connectToPeer(a, errChan)
+					s.connectToPeer(
+						a, errChan,
+						tor.DefaultConnTimeout,
+					)
 					select {
 					case err := <-errChan:
 						if err == nil {
@@ -1453,7 +1727,9 @@ func (s *server) initialPeerBootstrap(ignore map[autopilot.NodeID]struct{},
 				defer wg.Done()
 
 				errChan := make(chan error, 1)
-				go s.connectToPeer(addr, errChan)
+				go s.connectToPeer(
+					addr, errChan, tor.DefaultConnTimeout,
+				)
 
 				// We'll only allow this connection attempt to
 				// take up to 3 seconds. This allows us to move
@@ -1534,16 +1810,22 @@ func (s *server) initTorController() error {
 
 	// Finally, we'll update the on-disk version of our announcement so it
 	// will eventually propagate to nodes in the network.
+	return s.setSelfNode(&newNodeAnn)
+}
+
+// setSelfNode updates the on-disk version of our node, such that it reflects
+// the passed node announcement.
+func (s *server) setSelfNode(nodeAnn *lnwire.NodeAnnouncement) error {
 	selfNode := &channeldb.LightningNode{
 		HaveNodeAnnouncement: true,
-		LastUpdate:           time.Unix(int64(newNodeAnn.Timestamp), 0),
-		Addresses:            newNodeAnn.Addresses,
-		Alias:                newNodeAnn.Alias.String(),
+		LastUpdate:           time.Unix(int64(nodeAnn.Timestamp), 0),
+		Addresses:            nodeAnn.Addresses,
+		Alias:                nodeAnn.Alias.String(),
 		Features: lnwire.NewFeatureVector(
-			newNodeAnn.Features, lnwire.GlobalFeatures,
+			nodeAnn.Features, lnwire.GlobalFeatures,
 		),
-		Color:        newNodeAnn.RGBColor,
-		AuthSigBytes: newNodeAnn.Signature.ToSignatureBytes(),
+		Color:        nodeAnn.RGBColor,
+		AuthSigBytes: nodeAnn.Signature.ToSignatureBytes(),
 	}
 	copy(selfNode.PubKeyBytes[:], s.identityPriv.PubKey().SerializeCompressed())
 	if err := s.chanDB.ChannelGraph().SetSourceNode(selfNode); err != nil {
@@ -1553,6 +1835,31 @@ func (s *server) initTorController() error {
 	return nil
 }
 
+// updateNodeAnnouncement applies the passed updates to our node announcement,
+// re-signs it, persists it and broadcasts it to all our peers, such that the
+// changes propagate through the network without a restart.
+func (s *server) updateNodeAnnouncement(
+	updates ...func(*lnwire.NodeAnnouncement)) (*lnwire.NodeAnnouncement,
+	error) {
+
+	newNodeAnn, err := s.genNodeAnnouncement(true, updates...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate new node "+
+			"announcement: %v", err)
+	}
+
+	if err := s.setSelfNode(&newNodeAnn); err != nil {
+		return nil, err
+	}
+
+	if err := s.BroadcastMessage(nil, &newNodeAnn); err != nil {
+		return nil, fmt.Errorf("unable to broadcast new node "+
+			"announcement: %v", err)
+	}
+
+	return &newNodeAnn, nil
+}
+
 // genNodeAnnouncement generates and returns the current fully signed node
 // announcement. If refresh is true, then the time stamp of the announcement
 // will be updated in order to ensure it propagates through the network.
@@ -1941,6 +2248,141 @@ func (s *server) sendPeerMessages(
 	return errChans
 }
 
+// findOnionMessagePath returns the shortest sequence of nodes within the
+// channel graph through which an onion message can reach the target,
+// starting with one of our direct peers.
+func (s *server) findOnionMessagePath(
+	target *btcec.PublicKey) ([]*btcec.PublicKey, error) {
+
+	graph := s.chanDB.ChannelGraph()
+
+	var source, targetVertex routing.Vertex
+	copy(source[:], s.identityPriv.PubKey().SerializeCompressed())
+	copy(targetVertex[:], target.SerializeCompressed())
+
+	// We'll perform a breadth first search from our own node, only
+	// stepping through peers that we're currently connected to for the
+	// first hop.
+	prev := map[routing.Vertex]routing.Vertex{source: source}
+	queue := []routing.Vertex{source}
+	for len(queue) > 0 && len(prev) < maxOnionMessageSearch {
+		vertex := queue[0]
+		queue = queue[1:]
+
+		if vertex == targetVertex {
+			var path []*btcec.PublicKey
+			for vertex != source {
+				pub, err := btcec.ParsePubKey(
+					vertex[:], btcec.S256(),
+				)
+				if err != nil {
+					return nil, err
+				}
+				path = append([]*btcec.PublicKey{pub}, path...)
+				vertex = prev[vertex]
+			}
+
+			if len(path) > onionmsg.MaxPathLength {
+				return nil, onionmsg.ErrNoPath
+			}
+			return path, nil
+		}
+
+		pub, err := btcec.ParsePubKey(vertex[:], btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+		node, err := graph.FetchLightningNode(pub)
+		if err != nil {
+			continue
+		}
+
+		err = node.ForEachChannel(nil, func(_ *bbolt.Tx,
+			info *channeldb.ChannelEdgeInfo, _,
+			_ *channeldb.ChannelEdgePolicy) error {
+
+			next := routing.Vertex(info.NodeKey1Bytes)
+			if next == vertex {
+				next = routing.Vertex(info.NodeKey2Bytes)
+			}
+			if _, ok := prev[next]; ok {
+				return nil
+			}
+
+			if vertex == source {
+				nextPub, err := btcec.ParsePubKey(
+					next[:], btcec.S256(),
+				)
+				if err != nil {
+					return nil
+				}
+				if _, err := s.FindPeer(nextPub); err != nil {
+					return nil
+				}
+			}
+
+			prev[next] = vertex
+			queue = append(queue, next)
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, onionmsg.ErrNoPath
+}
+
+// asyncPaymentPeer returns the public key of the peer on the other end of
+// the given channel, if HTLCs destined to it may be held while it's offline.
+// We only do so for private channels, as those are the channels of mostly
+// offline recipients, such as mobile wallets, that rely on us to receive
+// payments.
+func (s *server) asyncPaymentPeer(
+	chanID lnwire.ShortChannelID) ([33]byte, bool) {
+
+	graph := s.chanDB.ChannelGraph()
+	info, _, _, err := graph.FetchChannelEdgesByID(chanID.ToUint64())
+	if err != nil || info.AuthProof != nil {
+		return [33]byte{}, false
+	}
+
+	var self [33]byte
+	copy(self[:], s.identityPriv.PubKey().SerializeCompressed())
+
+	switch self {
+	case info.NodeKey1Bytes:
+		return info.NodeKey2Bytes, true
+	case info.NodeKey2Bytes:
+		return info.NodeKey1Bytes, true
+	default:
+		return [33]byte{}, false
+	}
+}
+
+// handleOfferMessage hands an offer related onion message to the offer
+// manager, which replies over the reply path included by the sender.
+func (s *server) handleOfferMessage(msg *onionmsg.ReceivedMessage) {
+	reply := func(msgType uint64, payload []byte) error {
+		if msg.ReplyPath == nil {
+			return fmt.Errorf("onion message has no reply path")
+		}
+
+		return s.onionMessenger.SendReply(
+			msg.ReplyPath, msgType, payload,
+		)
+	}
+
+	err := s.offerMgr.HandleMessage(
+		reply, msg.PathID, msg.Type, msg.Payload,
+	)
+	if err != nil {
+		srvrLog.Debugf("Unable to handle offer message of type %d: "+
+			"%v", msg.Type, err)
+	}
+}
+
 // FindPeer will return the peer that corresponds to the passed in public key.
 // This function is used by the funding manager, allowing it to update the
 // daemon's local representation of the remote peer.
@@ -2281,6 +2723,29 @@ func (s *server) cancelConnReqs(pubStr string, skip *uint64) {
 	delete(s.persistentConnReqs, pubStr)
 }
 
+// newLocalFeatures returns the local feature vector we advertise to our
+// peers upon connecting, before any per-peer overrides are applied.
+func newLocalFeatures() *lnwire.RawFeatureVector {
+	localFeatures := lnwire.NewRawFeatureVector()
+
+	// We'll signal that we understand the data loss protection feature,
+	// and also that we support the new gossip query features.
+	localFeatures.Set(lnwire.DataLossProtectOptional)
+	localFeatures.Set(lnwire.GossipQueriesOptional)
+
+	// We're also able to quiesce channels upon request of our peers, and
+	// to renegotiate their flow constraints once quiescent.
+	localFeatures.Set(lnwire.QuiescenceOptional)
+	localFeatures.Set(lnwire.DynamicCommitmentsOptional)
+
+	// Finally, we're able to compress the short channel IDs exchanged
+	// during gossip syncing, which substantially reduces the bandwidth of
+	// the initial graph sync.
+	localFeatures.Set(lnwire.GossipQueriesZlibOptional)
+
+	return localFeatures
+}
+
 // peerConnected is a function that handles initialization a newly connected
 // peer by adding it to the server's global list of all active peers, and
 // starting all the goroutines the peer needs to function properly. The inbound
@@ -2303,12 +2768,15 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 
 	// With the brontide connection established, we'll now craft the local
 	// feature vector to advertise to the remote node.
-	localFeatures := lnwire.NewRawFeatureVector()
+	localFeatures := newLocalFeatures()
 
-	// We'll signal that we understand the data loss protection feature,
-	// and also that we support the new gossip query features.
-	localFeatures.Set(lnwire.DataLossProtectOptional)
-	localFeatures.Set(lnwire.GossipQueriesOptional)
+	// If the features negotiated with this peer have been overridden,
+	// we'll apply the overrides to the features we advertise to it.
+	var pubKeyBytes [33]byte
+	copy(pubKeyBytes[:], pubKey.SerializeCompressed())
+	if overrides, ok := cfg.peerFeatureOverrides[pubKeyBytes]; ok {
+		overrides.applyLocal(localFeatures)
+	}
 
 	// Now that we've established a connection, create a peer, and it to
 	// the set of currently active peers.
@@ -2401,6 +2869,10 @@ func (s *server) peerInitializer(p *peer) {
 	// was successful, and to begin watching the peer's wait group.
 	close(ready)
 
+	// Now that the peer is online, its channels start accruing uptime.
+	s.uptimeTracker.PeerOnline(p.PubKey())
+	s.peerNotifier.NotifyPeerOnline(p.PubKey())
+
 	pubStr := string(p.addr.IdentityKey.SerializeCompressed())
 
 	s.mu.Lock()
@@ -2453,6 +2925,16 @@ func (s *server) peerTerminationWatcher(p *peer, ready chan struct{}) {
 	// so we don't need to maintain sync state for it any longer.
 	s.authGossiper.PruneSyncState(pubKey)
 
+	// Likewise, the onion messenger no longer needs to rate limit the
+	// messages of this peer.
+	if s.onionMessenger != nil {
+		s.onionMessenger.PeerDisconnected(pubKey)
+	}
+
+	// The channels of this peer no longer accrue uptime.
+	s.uptimeTracker.PeerOffline(p.PubKey())
+	s.peerNotifier.NotifyPeerOffline(p.PubKey())
+
 	// Tell the switch to remove all links associated with this peer.
 	// Passing nil as the target link indicates that all links associated
 	// with this interface should be closed.
@@ -2468,6 +2950,15 @@ func (s *server) peerTerminationWatcher(p *peer, ready chan struct{}) {
 		p.server.htlcSwitch.RemoveLink(link.ChanID())
 	}
 
+	// With their links removed, the channels of this peer are no longer
+	// active.
+	p.activeChanMtx.RLock()
+	for _, activeChan := range p.activeChannels {
+		chanPoint := *activeChan.ChannelPoint()
+		s.chanNotifier.NotifyInactiveChannelEvent(chanPoint)
+	}
+	p.activeChanMtx.RUnlock()
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -2626,6 +3117,15 @@ type openChanReq struct {
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
+	// batch is the funding batch this channel is part of, if any. All
+	// channels of a batch are funded by a single shared transaction.
+	batch *fundingBatch
+
+	// psbtShim, if set, indicates that the funding transaction of the
+	// channel is assembled and signed by an external wallet through a
+	// PSBT, rather than by our own wallet.
+	psbtShim *psbtShim
+
 	// TODO(roasbeef): add ability to specify channel constraints as well
 
 	updates chan *lnrpc.OpenStatusUpdate
@@ -2637,7 +3137,8 @@ type openChanReq struct {
 // connection is established, or the initial handshake process fails.
 //
 // NOTE: This function is safe for concurrent access.
-func (s *server) ConnectToPeer(addr *lnwire.NetAddress, perm bool) error {
+func (s *server) ConnectToPeer(addr *lnwire.NetAddress, perm bool,
+	timeout time.Duration) error {
 
 	targetPub := string(addr.IdentityKey.SerializeCompressed())
 
@@ -2693,7 +3194,7 @@ func (s *server) ConnectToPeer(addr *lnwire.NetAddress, perm bool) error {
 	// the crypto negotiation breaks down, then return an error to the
 	// caller.
 	errChan := make(chan error, 1)
-	s.connectToPeer(addr, errChan)
+	s.connectToPeer(addr, errChan, timeout)
 
 	select {
 	case err := <-errChan:
@@ -2704,10 +3205,15 @@ func (s *server) ConnectToPeer(addr *lnwire.NetAddress, perm bool) error {
 }
 
 // connectToPeer establishes a connection to a remote peer. errChan is used to
-// notify the caller if the connection attempt has failed. Otherwise, it will be
-// closed.
-func (s *server) connectToPeer(addr *lnwire.NetAddress, errChan chan<- error) {
-	conn, err := brontide.Dial(s.identityPriv, addr, cfg.net.Dial)
+// notify the caller if the connection attempt has failed, including if the
+// connection couldn't be established within the passed timeout. Otherwise, it
+// will be closed.
+func (s *server) connectToPeer(addr *lnwire.NetAddress, errChan chan<- error,
+	timeout time.Duration) {
+
+	conn, err := brontide.Dial(
+		s.identityPriv, addr, timeout, cfg.net.Dial,
+	)
 	if err != nil {
 		srvrLog.Errorf("Unable to connect to %v: %v", addr, err)
 		select {
@@ -3033,6 +3539,7 @@ func createChannelUpdate(info *channeldb.ChannelEdgeInfo,
 		Flags:           policy.Flags,
 		TimeLockDelta:   policy.TimeLockDelta,
 		HtlcMinimumMsat: policy.MinHTLC,
+		HtlcMaximumMsat: policy.MaxHTLC,
 		BaseFee:         uint32(policy.FeeBaseMSat),
 		FeeRate:         uint32(policy.FeeProportionalMillionths),
 		ExtraOpaqueData: policy.ExtraOpaqueData,
@@ -3180,3 +3687,186 @@ func (s *server) watchChannelStatus() {
 		}
 	}
 }
+
+// fetchFeePolicyChannels returns a snapshot of our open channels for the fee
+// policy manager, along with the forwarding policies currently advertised for
+// them.
+func (s *server) fetchFeePolicyChannels() ([]*feepolicy.Channel, error) {
+	openChannels, err := s.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	dbChannels := make(map[wire.OutPoint]*channeldb.OpenChannel)
+	for _, channel := range openChannels {
+		if channel.IsPending {
+			continue
+		}
+		dbChannels[channel.FundingOutpoint] = channel
+	}
+
+	var channels []*feepolicy.Channel
+	err = s.chanRouter.ForAllOutgoingChannels(func(
+		info *channeldb.ChannelEdgeInfo,
+		edge *channeldb.ChannelEdgePolicy) error {
+
+		dbChannel, ok := dbChannels[info.ChannelPoint]
+		if !ok {
+			return nil
+		}
+
+		localCommit := dbChannel.LocalCommitment
+		channels = append(channels, &feepolicy.Channel{
+			ChanPoint:    info.ChannelPoint,
+			Capacity:     info.Capacity,
+			LocalBalance: localCommit.LocalBalance,
+			TotalForwarded: dbChannel.TotalMSatSent +
+				dbChannel.TotalMSatReceived,
+			Policy: routing.ChannelPolicy{
+				FeeSchema: routing.FeeSchema{
+					BaseFee: edge.FeeBaseMSat,
+					FeeRate: uint32(
+						edge.FeeProportionalMillionths,
+					),
+				},
+				TimeLockDelta: uint32(edge.TimeLockDelta),
+			},
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// updateChanPolicy broadcasts a new forwarding policy for the given channel,
+// and applies it to the channel's link.
+func (s *server) updateChanPolicy(chanPoint wire.OutPoint,
+	policy routing.ChannelPolicy) error {
+
+	err := s.authGossiper.PropagateChanPolicyUpdate(policy, chanPoint)
+	if err != nil {
+		return err
+	}
+
+	err = s.htlcSwitch.UpdateForwardingPolicies(
+		htlcswitch.ForwardingPolicy{
+			BaseFee:       policy.BaseFee,
+			FeeRate:       lnwire.MilliSatoshi(policy.FeeRate),
+			TimeLockDelta: policy.TimeLockDelta,
+			InboundFee:    policy.InboundFee,
+			MinHTLC:       policy.MinHTLC,
+			MaxHTLC:       policy.MaxHTLC,
+		}, chanPoint,
+	)
+	if err != nil {
+		// The link may simply be offline, in which case it'll pick up
+		// the new policy once it's restarted.
+		srvrLog.Warnf("Unable to update link fees: %v", err)
+	}
+
+	return nil
+}
+
+// fetchLiquidityChannels returns a snapshot of our channels that are able to
+// forward, for the liquidity manager to evaluate.
+func (s *server) fetchLiquidityChannels() ([]*liquidity.Channel, error) {
+	openChannels, err := s.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []*liquidity.Channel
+	for _, channel := range openChannels {
+		if channel.IsPending {
+			continue
+		}
+
+		// Channels whose link isn't active can't be rebalanced.
+		chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+		link, err := s.htlcSwitch.GetLink(chanID)
+		if err != nil || !link.EligibleToForward() {
+			continue
+		}
+
+		channels = append(channels, &liquidity.Channel{
+			ChanPoint:    channel.FundingOutpoint,
+			ChanID:       channel.ShortChannelID,
+			Capacity:     channel.Capacity,
+			LocalBalance: channel.LocalCommitment.LocalBalance,
+		})
+	}
+
+	return channels, nil
+}
+
+// rebalance moves liquidity between two of our channels by paying ourselves
+// over a circular route, returning the fee that was paid.
+func (s *server) rebalance(
+	rebalance *liquidity.Rebalance) (lnwire.MilliSatoshi, error) {
+
+	route, err := s.chanRouter.FindCircularRoute(
+		rebalance.From.ChanID.ToUint64(),
+		rebalance.To.ChanID.ToUint64(), rebalance.Amount,
+		rebalance.MaxFee,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// We'll pay ourselves through a fresh invoice, whose preimage is only
+	// known to us.
+	var preimage [32]byte
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return 0, err
+	}
+	payHash := sha256.Sum256(preimage[:])
+
+	const memo = "rebalance"
+	creationDate := time.Now()
+	payReq, err := zpay32.NewInvoice(
+		activeNetParams.Params, payHash, creationDate,
+		zpay32.Amount(rebalance.Amount), zpay32.Description(memo),
+	)
+	if err != nil {
+		return 0, err
+	}
+	payReqString, err := payReq.Encode(zpay32.MessageSigner{
+		SignCompact: s.nodeSigner.SignDigestCompact,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	invoice := &channeldb.Invoice{
+		CreationDate:   creationDate,
+		Memo:           []byte(memo),
+		PaymentRequest: []byte(payReqString),
+		Terms: channeldb.ContractTerm{
+			Value: rebalance.Amount,
+		},
+	}
+	copy(invoice.Terms.PaymentPreimage[:], preimage[:])
+
+	if _, err := s.invoices.AddInvoice(invoice); err != nil {
+		return 0, err
+	}
+
+	payment := &routing.LightningPayment{
+		Target:      s.identityPriv.PubKey(),
+		Amount:      rebalance.Amount,
+		FeeLimit:    rebalance.MaxFee,
+		PaymentHash: payHash,
+	}
+	_, route, err = s.chanRouter.SendToRoute(
+		[]*routing.Route{route}, payment,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return route.TotalFees, nil
+}
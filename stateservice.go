@@ -0,0 +1,177 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/signal"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// stateMethods is the set of RPC methods of the State service. They're served
+// without any macaroon authentication, as they need to be available before
+// the wallet, and with it the macaroons, even exist.
+var stateMethods = map[string]struct{}{
+	"/lnrpc.State/SubscribeState": {},
+	"/lnrpc.State/GetState":       {},
+}
+
+// stateServer implements the State gRPC service. It keeps track of the
+// lifecycle state of the daemon and notifies all subscribers of every state
+// transition. The same instance is served by the wallet unlocker and the main
+// RPC server, so the state can be queried during the whole lifetime of lnd.
+type stateServer struct {
+	sync.RWMutex
+
+	state lnrpc.WalletState
+
+	nextSubscriberID uint64
+	subscribers      map[uint64]chan lnrpc.WalletState
+}
+
+// A compile time check to ensure that stateServer fully implements the
+// StateServer gRPC service.
+var _ lnrpc.StateServer = (*stateServer)(nil)
+
+// newStateServer creates a new state server starting out in the given state.
+func newStateServer(initialState lnrpc.WalletState) *stateServer {
+	return &stateServer{
+		state:       initialState,
+		subscribers: make(map[uint64]chan lnrpc.WalletState),
+	}
+}
+
+// SetState updates the current state and notifies all subscribers of the
+// transition.
+func (s *stateServer) SetState(state lnrpc.WalletState) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.state == state {
+		return
+	}
+
+	rpcsLog.Debugf("Changing wallet state from %v to %v", s.state, state)
+
+	s.state = state
+	for _, updates := range s.subscribers {
+		// The update channels are buffered to hold every possible
+		// state, so as the state only ever advances, this never
+		// blocks.
+		select {
+		case updates <- state:
+		default:
+		}
+	}
+}
+
+// subscribe registers a new subscriber and returns its ID, the channel it'll
+// receive all future state updates on, and the current state.
+func (s *stateServer) subscribe() (uint64, <-chan lnrpc.WalletState,
+	lnrpc.WalletState) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	id := s.nextSubscriberID
+	s.nextSubscriberID++
+
+	updates := make(chan lnrpc.WalletState, len(lnrpc.WalletState_name))
+	s.subscribers[id] = updates
+
+	return id, updates, s.state
+}
+
+// unsubscribe removes the subscriber with the given ID.
+func (s *stateServer) unsubscribe(id uint64) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.subscribers, id)
+}
+
+// SubscribeState subscribes to the state of the wallet. The current wallet
+// state will always be delivered immediately.
+//
+// NOTE: Part of the lnrpc.StateServer interface.
+func (s *stateServer) SubscribeState(_ *lnrpc.SubscribeStateRequest,
+	stream lnrpc.State_SubscribeStateServer) error {
+
+	id, updates, state := s.subscribe()
+	defer s.unsubscribe(id)
+
+	err := stream.Send(&lnrpc.SubscribeStateResponse{State: state})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case state := <-updates:
+			err := stream.Send(&lnrpc.SubscribeStateResponse{
+				State: state,
+			})
+			if err != nil {
+				return err
+			}
+
+		// The client closed the stream, so we'll exit.
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		// The daemon is shutting down. Returning nil will close the
+		// client's read end of the stream.
+		case <-signal.ShutdownChannel():
+			return nil
+		}
+	}
+}
+
+// GetState returns the current wallet state without streaming further
+// changes.
+//
+// NOTE: Part of the lnrpc.StateServer interface.
+func (s *stateServer) GetState(_ context.Context,
+	_ *lnrpc.GetStateRequest) (*lnrpc.GetStateResponse, error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return &lnrpc.GetStateResponse{State: s.state}, nil
+}
+
+// stateUnaryInterceptor hands all unary calls except those to the State
+// service to the passed interceptor. Calls to the State service skip it, so
+// they don't require a macaroon.
+func stateUnaryInterceptor(
+	next grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if _, ok := stateMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		return next(ctx, req, info, handler)
+	}
+}
+
+// stateStreamInterceptor hands all streaming calls except those to the State
+// service to the passed interceptor. Calls to the State service skip it, so
+// they don't require a macaroon.
+func stateStreamInterceptor(
+	next grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if _, ok := stateMethods[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+
+		return next(srv, ss, info, handler)
+	}
+}
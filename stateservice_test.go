@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"golang.org/x/net/context"
+)
+
+// TestStateServerTransitions ensures that the state server reports the
+// current state and notifies subscribers of every transition.
+func TestStateServerTransitions(t *testing.T) {
+	t.Parallel()
+
+	s := newStateServer(lnrpc.WalletState_NON_EXISTING)
+
+	id, updates, state := s.subscribe()
+	defer s.unsubscribe(id)
+
+	if state != lnrpc.WalletState_NON_EXISTING {
+		t.Fatalf("expected initial state %v, got %v",
+			lnrpc.WalletState_NON_EXISTING, state)
+	}
+
+	transitions := []lnrpc.WalletState{
+		lnrpc.WalletState_LOCKED,
+		lnrpc.WalletState_UNLOCKED,
+		lnrpc.WalletState_RPC_ACTIVE,
+		lnrpc.WalletState_SERVER_ACTIVE,
+	}
+	for _, transition := range transitions {
+		s.SetState(transition)
+
+		// Setting the same state twice shouldn't result in a second
+		// notification.
+		s.SetState(transition)
+	}
+
+	for _, transition := range transitions {
+		select {
+		case state := <-updates:
+			if state != transition {
+				t.Fatalf("expected state %v, got %v",
+					transition, state)
+			}
+
+		case <-time.After(time.Second):
+			t.Fatalf("no notification for state %v", transition)
+		}
+	}
+
+	select {
+	case state := <-updates:
+		t.Fatalf("unexpected notification for state %v", state)
+	default:
+	}
+
+	resp, err := s.GetState(context.Background(), &lnrpc.GetStateRequest{})
+	if err != nil {
+		t.Fatalf("unable to get state: %v", err)
+	}
+	if resp.State != lnrpc.WalletState_SERVER_ACTIVE {
+		t.Fatalf("expected state %v, got %v",
+			lnrpc.WalletState_SERVER_ACTIVE, resp.State)
+	}
+}
@@ -56,14 +56,25 @@ func New(cfg *UtxoSweeperConfig) *UtxoSweeper {
 func (s *UtxoSweeper) CreateSweepTx(inputs []Input, confTarget uint32,
 	currentBlockHeight uint32) (*wire.MsgTx, error) {
 
-	// Generate the receiving script to which the funds will be swept.
-	pkScript, err := s.cfg.GenSweepScript()
+	// Using the txn weight estimate, compute the required txn fee.
+	feePerKw, err := s.cfg.Estimator.EstimateFeePerKW(confTarget)
 	if err != nil {
 		return nil, err
 	}
 
-	// Using the txn weight estimate, compute the required txn fee.
-	feePerKw, err := s.cfg.Estimator.EstimateFeePerKW(confTarget)
+	return s.CreateSweepTxWithFeeRate(inputs, feePerKw, currentBlockHeight)
+}
+
+// CreateSweepTxWithFeeRate is identical to CreateSweepTx, but pays the given
+// fee rate instead of consulting the fee estimator. This allows callers to
+// sweep outputs at a rate of their choosing, e.g. to replace a prior sweep
+// that is taking too long to confirm.
+func (s *UtxoSweeper) CreateSweepTxWithFeeRate(inputs []Input,
+	feePerKw lnwallet.SatPerKWeight,
+	currentBlockHeight uint32) (*wire.MsgTx, error) {
+
+	// Generate the receiving script to which the funds will be swept.
+	pkScript, err := s.cfg.GenSweepScript()
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +154,17 @@ func (s *UtxoSweeper) CreateSweepTx(inputs []Input, confTarget uint32,
 	return sweepTx, nil
 }
 
+// EstimateFee returns the fee a transaction sweeping the given inputs would
+// pay at the given fee rate. Inputs of an unknown witness type are ignored, as
+// they wouldn't be included in the sweep.
+func (s *UtxoSweeper) EstimateFee(inputs []Input,
+	feePerKw lnwallet.SatPerKWeight) btcutil.Amount {
+
+	_, txWeight, _, _ := s.getWeightEstimate(inputs)
+
+	return feePerKw.FeeForWeight(txWeight)
+}
+
 // getWeightEstimate returns a weight estimate for the given inputs.
 // Additionally, it returns counts for the number of csv and cltv inputs.
 func (s *UtxoSweeper) getWeightEstimate(inputs []Input) ([]Input, int64, int, int) {
@@ -329,8 +329,10 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 
 	chainArb := contractcourt.NewChainArbitrator(
 		contractcourt.ChainArbitratorConfig{
-			Notifier: notifier,
-			ChainIO:  chainIO,
+			Notifier:                   notifier,
+			ChainIO:                    chainIO,
+			NotifyClosedChannel:        func(wire.OutPoint) {},
+			NotifyFullyResolvedChannel: func(wire.OutPoint) {},
 		}, dbAlice,
 	)
 	chainArb.WatchNewChannel(aliceChannelState)
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"time"
+)
+
+const (
+	// Make certificate valid for 14 months.
+	autogenCertValidity = 14 /*months*/ * 30 /*days*/ * 24 * time.Hour
+
+	// autogenCertRenewal is how long before its expiry an auto-generated
+	// certificate is regenerated upon startup.
+	autogenCertRenewal = 30 /*days*/ * 24 * time.Hour
+
+	// autogenCertOrg is the organization of the certificates generated by
+	// lnd. Only those certificates are ever regenerated, so that
+	// certificates provided by the user are left untouched.
+	autogenCertOrg = "lnd autogenerated cert"
+)
+
+var (
+	// End of ASN.1 time.
+	endOfTime = time.Date(2049, 12, 31, 23, 59, 59, 0, time.UTC)
+
+	// Max serial number.
+	serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
+)
+
+// certSubjectAltNames returns the IP addresses and DNS names that an
+// auto-generated certificate is valid for: those of the host, along with the
+// extra ones passed.
+func certSubjectAltNames(tlsExtraIPs, tlsExtraDomains []string) ([]net.IP,
+	[]string, error) {
+
+	// Collect the host's IP addresses, including loopback, in a slice.
+	ipAddresses := []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")}
+
+	// addIP appends an IP address only if it isn't already in the slice.
+	addIP := func(ipAddr net.IP) {
+		for _, ip := range ipAddresses {
+			if ip.Equal(ipAddr) {
+				return
+			}
+		}
+		ipAddresses = append(ipAddresses, ipAddr)
+	}
+
+	// Add all the interface IPs that aren't already in the slice.
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, a := range addrs {
+		ipAddr, _, err := net.ParseCIDR(a.String())
+		if err == nil {
+			addIP(ipAddr)
+		}
+	}
+
+	// Add extra IPs to the slice.
+	for _, ip := range tlsExtraIPs {
+		ipAddr := net.ParseIP(ip)
+		if ipAddr != nil {
+			addIP(ipAddr)
+		}
+	}
+
+	// Collect the host's names into a slice.
+	host, err := os.Hostname()
+	if err != nil {
+		return nil, nil, err
+	}
+	dnsNames := []string{host}
+	if host != "localhost" {
+		dnsNames = append(dnsNames, "localhost")
+	}
+	dnsNames = append(dnsNames, tlsExtraDomains...)
+
+	// Also add fake hostnames for unix sockets, otherwise hostname
+	// verification will fail in the client.
+	dnsNames = append(dnsNames, "unix", "unixpacket")
+
+	return ipAddresses, dnsNames, nil
+}
+
+// genCertPair generates a key/cert pair to the paths provided. The
+// auto-generated certificates should *not* be used in production for public
+// access as they're self-signed and don't necessarily contain all of the
+// desired hostnames for the service. For production/public use, consider a
+// real PKI.
+//
+// This function is adapted from https://github.com/btcsuite/btcd and
+// https://github.com/btcsuite/btcutil
+func genCertPair(certFile, keyFile string, tlsExtraIPs,
+	tlsExtraDomains []string) error {
+
+	rpcsLog.Infof("Generating TLS certificates...")
+
+	now := time.Now()
+	validUntil := now.Add(autogenCertValidity)
+
+	// Check that the certificate validity isn't past the ASN.1 end of time.
+	if validUntil.After(endOfTime) {
+		validUntil = endOfTime
+	}
+
+	// Generate a serial number that's below the serialNumberLimit.
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return fmt.Errorf("failed to generate serial number: %s", err)
+	}
+
+	ipAddresses, dnsNames, err := certSubjectAltNames(
+		tlsExtraIPs, tlsExtraDomains,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Generate a private key for the certificate.
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	// Construct the certificate template.
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: []string{autogenCertOrg},
+			CommonName:   dnsNames[0],
+		},
+		NotBefore: now.Add(-time.Hour * 24),
+		NotAfter:  validUntil,
+
+		KeyUsage: x509.KeyUsageKeyEncipherment |
+			x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		IsCA:                  true, // so can sign self.
+		BasicConstraintsValid: true,
+
+		DNSNames:    dnsNames,
+		IPAddresses: ipAddresses,
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, &template,
+		&template, &priv.PublicKey, priv)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate: %v", err)
+	}
+
+	certBuf := &bytes.Buffer{}
+	err = pem.Encode(certBuf, &pem.Block{Type: "CERTIFICATE",
+		Bytes: derBytes})
+	if err != nil {
+		return fmt.Errorf("failed to encode certificate: %v", err)
+	}
+
+	keybytes, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		return fmt.Errorf("unable to encode privkey: %v", err)
+	}
+	keyBuf := &bytes.Buffer{}
+	err = pem.Encode(keyBuf, &pem.Block{Type: "EC PRIVATE KEY",
+		Bytes: keybytes})
+	if err != nil {
+		return fmt.Errorf("failed to encode private key: %v", err)
+	}
+
+	// Write cert and key files.
+	if err = ioutil.WriteFile(certFile, certBuf.Bytes(), 0644); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(keyFile, keyBuf.Bytes(), 0600); err != nil {
+		os.Remove(certFile)
+		return err
+	}
+
+	rpcsLog.Infof("Done generating TLS certificates")
+	return nil
+}
+
+// certRegenReason returns the reason why the passed certificate should be
+// regenerated, or an empty string if it's still fit for use. Only
+// certificates generated by lnd are ever regenerated, either because they're
+// about to expire, or because they're missing any of the extra IPs and
+// domains configured by the user. If autoRefresh is true, they're also
+// regenerated if the IP addresses or DNS names of the host have changed,
+// which is opt-in as those may change frequently.
+func certRegenReason(cert *x509.Certificate, now time.Time,
+	ipAddresses []net.IP, dnsNames []string, tlsExtraIPs,
+	tlsExtraDomains []string, autoRefresh bool) string {
+
+	isAutogen := false
+	for _, org := range cert.Subject.Organization {
+		if org == autogenCertOrg {
+			isAutogen = true
+			break
+		}
+	}
+	if !isAutogen {
+		return ""
+	}
+
+	if now.Add(autogenCertRenewal).After(cert.NotAfter) {
+		return fmt.Sprintf("certificate expires at %v", cert.NotAfter)
+	}
+
+	hasIP := func(ipAddr net.IP) bool {
+		for _, ip := range cert.IPAddresses {
+			if ip.Equal(ipAddr) {
+				return true
+			}
+		}
+		return false
+	}
+	hasDNSName := func(name string) bool {
+		for _, dnsName := range cert.DNSNames {
+			if dnsName == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, ip := range tlsExtraIPs {
+		ipAddr := net.ParseIP(ip)
+		if ipAddr != nil && !hasIP(ipAddr) {
+			return fmt.Sprintf("certificate is missing extra IP %v",
+				ip)
+		}
+	}
+	for _, domain := range tlsExtraDomains {
+		if !hasDNSName(domain) {
+			return fmt.Sprintf("certificate is missing extra "+
+				"domain %v", domain)
+		}
+	}
+
+	if !autoRefresh {
+		return ""
+	}
+
+	// As the certificate was generated from lists built the same way,
+	// they match if they have the same length and all of our names are
+	// within the certificate.
+	if len(ipAddresses) != len(cert.IPAddresses) ||
+		len(dnsNames) != len(cert.DNSNames) {
+
+		return "host IP addresses or DNS names changed"
+	}
+	for _, ipAddr := range ipAddresses {
+		if !hasIP(ipAddr) {
+			return "host IP addresses changed"
+		}
+	}
+	for _, dnsName := range dnsNames {
+		if !hasDNSName(dnsName) {
+			return "host DNS names changed"
+		}
+	}
+
+	return ""
+}
+
+// loadCertPair loads the TLS key/cert pair from the paths provided. The pair
+// is generated if it doesn't exist yet, and regenerated if it was
+// auto-generated and is no longer fit for use, as determined by
+// certRegenReason.
+func loadCertPair(certFile, keyFile string, tlsExtraIPs,
+	tlsExtraDomains []string, autoRefresh bool) (tls.Certificate, error) {
+
+	if !fileExists(certFile) && !fileExists(keyFile) {
+		err := genCertPair(
+			certFile, keyFile, tlsExtraIPs, tlsExtraDomains,
+		)
+		if err != nil {
+			return tls.Certificate{}, err
+		}
+	}
+
+	certPair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	cert, err := x509.ParseCertificate(certPair.Certificate[0])
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	ipAddresses, dnsNames, err := certSubjectAltNames(
+		tlsExtraIPs, tlsExtraDomains,
+	)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	reason := certRegenReason(
+		cert, time.Now(), ipAddresses, dnsNames, tlsExtraIPs,
+		tlsExtraDomains, autoRefresh,
+	)
+	if reason == "" {
+		return certPair, nil
+	}
+
+	// Clients pinning the previous certificate will need to be handed the
+	// new one, so we make sure to log why it was replaced.
+	rpcsLog.Infof("Regenerating TLS certificates: %v", reason)
+
+	err = genCertPair(certFile, keyFile, tlsExtraIPs, tlsExtraDomains)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.LoadX509KeyPair(certFile, keyFile)
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestCert creates a self-signed certificate for the given organization,
+// valid until notAfter for the given IPs and DNS names.
+func newTestCert(t *testing.T, org string, notAfter time.Time,
+	ipAddresses []net.IP, dnsNames []string) *x509.Certificate {
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			Organization: []string{org},
+		},
+		NotBefore:   notAfter.Add(-autogenCertValidity),
+		NotAfter:    notAfter,
+		DNSNames:    dnsNames,
+		IPAddresses: ipAddresses,
+	}
+	derBytes, err := x509.CreateCertificate(
+		rand.Reader, &template, &template, &priv.PublicKey, priv,
+	)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(derBytes)
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %v", err)
+	}
+
+	return cert
+}
+
+// TestCertRegenReason asserts that only auto-generated certificates are
+// regenerated, and only when they're about to expire, or no longer match the
+// configured IPs and domains.
+func TestCertRegenReason(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	validUntil := now.Add(autogenCertValidity)
+
+	ipAddresses := []net.IP{
+		net.ParseIP("127.0.0.1"), net.ParseIP("::1"),
+		net.ParseIP("10.0.0.1"),
+	}
+	dnsNames := []string{"host", "localhost", "unix", "unixpacket"}
+
+	tests := []struct {
+		name            string
+		org             string
+		notAfter        time.Time
+		certIPs         []net.IP
+		certDNSNames    []string
+		tlsExtraIPs     []string
+		tlsExtraDomains []string
+		autoRefresh     bool
+		regen           bool
+	}{
+		{
+			name:         "up to date",
+			org:          autogenCertOrg,
+			notAfter:     validUntil,
+			certIPs:      ipAddresses,
+			certDNSNames: dnsNames,
+			autoRefresh:  true,
+			regen:        false,
+		},
+		{
+			name:         "about to expire",
+			org:          autogenCertOrg,
+			notAfter:     now.Add(autogenCertRenewal / 2),
+			certIPs:      ipAddresses,
+			certDNSNames: dnsNames,
+			regen:        true,
+		},
+		{
+			name:         "user certificate about to expire",
+			org:          "acme",
+			notAfter:     now.Add(autogenCertRenewal / 2),
+			certIPs:      ipAddresses,
+			certDNSNames: dnsNames,
+			regen:        false,
+		},
+		{
+			name:         "missing extra ip",
+			org:          autogenCertOrg,
+			notAfter:     validUntil,
+			certIPs:      ipAddresses,
+			certDNSNames: dnsNames,
+			tlsExtraIPs:  []string{"1.2.3.4"},
+			regen:        true,
+		},
+		{
+			name:            "missing extra domain",
+			org:             autogenCertOrg,
+			notAfter:        validUntil,
+			certIPs:         ipAddresses,
+			certDNSNames:    dnsNames,
+			tlsExtraDomains: []string{"example.com"},
+			regen:           true,
+		},
+		{
+			name:            "user certificate missing extra domain",
+			org:             "acme",
+			notAfter:        validUntil,
+			certIPs:         ipAddresses,
+			certDNSNames:    dnsNames,
+			tlsExtraDomains: []string{"example.com"},
+			regen:           false,
+		},
+		{
+			name:         "host ip changed without auto refresh",
+			org:          autogenCertOrg,
+			notAfter:     validUntil,
+			certIPs:      ipAddresses[:2],
+			certDNSNames: dnsNames,
+			regen:        false,
+		},
+		{
+			name:         "host ip changed with auto refresh",
+			org:          autogenCertOrg,
+			notAfter:     validUntil,
+			certIPs:      ipAddresses[:2],
+			certDNSNames: dnsNames,
+			autoRefresh:  true,
+			regen:        true,
+		},
+		{
+			name:     "hostname changed with auto refresh",
+			org:      autogenCertOrg,
+			notAfter: validUntil,
+			certIPs:  ipAddresses,
+			certDNSNames: []string{
+				"oldhost", "localhost", "unix", "unixpacket",
+			},
+			autoRefresh: true,
+			regen:       true,
+		},
+	}
+
+	for _, test := range tests {
+		cert := newTestCert(
+			t, test.org, test.notAfter, test.certIPs,
+			test.certDNSNames,
+		)
+
+		reason := certRegenReason(
+			cert, now, ipAddresses, dnsNames, test.tlsExtraIPs,
+			test.tlsExtraDomains, test.autoRefresh,
+		)
+		if (reason != "") != test.regen {
+			t.Fatalf("%s: expected regen=%v, got reason %q",
+				test.name, test.regen, reason)
+		}
+	}
+}
+
+// TestLoadCertPairRegen asserts that an auto-generated certificate is
+// regenerated on load once an extra domain is configured.
+func TestLoadCertPairRegen(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "tlscert")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	certFile := filepath.Join(tempDir, "tls.cert")
+	keyFile := filepath.Join(tempDir, "tls.key")
+
+	// The first load should generate the certificate.
+	certPair, err := loadCertPair(certFile, keyFile, nil, nil, false)
+	if err != nil {
+		t.Fatalf("unable to load cert pair: %v", err)
+	}
+
+	// Loading it again with the same config should leave it untouched.
+	sameCertPair, err := loadCertPair(certFile, keyFile, nil, nil, false)
+	if err != nil {
+		t.Fatalf("unable to load cert pair: %v", err)
+	}
+	if !bytes.Equal(sameCertPair.Certificate[0], certPair.Certificate[0]) {
+		t.Fatalf("certificate regenerated without any change")
+	}
+
+	// Once an extra domain is configured, the certificate should be
+	// regenerated to include it.
+	newCertPair, err := loadCertPair(
+		certFile, keyFile, nil, []string{"example.com"}, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to load cert pair: %v", err)
+	}
+	cert, err := x509.ParseCertificate(newCertPair.Certificate[0])
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %v", err)
+	}
+	if err := cert.VerifyHostname("example.com"); err != nil {
+		t.Fatalf("regenerated certificate doesn't include extra "+
+			"domain: %v", err)
+	}
+}
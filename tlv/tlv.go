@@ -0,0 +1,215 @@
+package tlv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	// ErrNonCanonicalBigSize is returned when a BigSize integer is not
+	// encoded using the minimal number of bytes.
+	ErrNonCanonicalBigSize = errors.New("non-canonical BigSize encoding")
+
+	// ErrRecordsOutOfOrder is returned when the records of a TLV stream
+	// are not sorted by strictly increasing type.
+	ErrRecordsOutOfOrder = errors.New("tlv records not in strictly " +
+		"increasing order")
+)
+
+// Record is a single type-length-value entry within a TLV stream.
+type Record struct {
+	// Type is the type of the record. Following the "it's OK to be odd"
+	// rule, readers must understand all even types they encounter.
+	Type uint64
+
+	// Value is the raw value of the record.
+	Value []byte
+}
+
+// WriteBigSize writes the passed integer to w using the BigSize variable
+// length encoding defined in BOLT-01.
+func WriteBigSize(w io.Writer, val uint64) error {
+	var b [9]byte
+	switch {
+	case val < 0xfd:
+		b[0] = uint8(val)
+		_, err := w.Write(b[:1])
+		return err
+
+	case val <= 0xffff:
+		b[0] = 0xfd
+		binary.BigEndian.PutUint16(b[1:3], uint16(val))
+		_, err := w.Write(b[:3])
+		return err
+
+	case val <= 0xffffffff:
+		b[0] = 0xfe
+		binary.BigEndian.PutUint32(b[1:5], uint32(val))
+		_, err := w.Write(b[:5])
+		return err
+
+	default:
+		b[0] = 0xff
+		binary.BigEndian.PutUint64(b[1:9], val)
+		_, err := w.Write(b[:9])
+		return err
+	}
+}
+
+// ReadBigSize reads a BigSize encoded integer from r, rejecting any
+// non-minimal encodings.
+func ReadBigSize(r io.Reader) (uint64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(r, b[:1]); err != nil {
+		return 0, err
+	}
+
+	var val, min uint64
+	switch b[0] {
+	case 0xfd:
+		if _, err := io.ReadFull(r, b[:2]); err != nil {
+			return 0, io.ErrUnexpectedEOF
+		}
+		val, min = uint64(binary.BigEndian.Uint16(b[:2])), 0xfd
+
+	case 0xfe:
+		if _, err := io.ReadFull(r, b[:4]); err != nil {
+			return 0, io.ErrUnexpectedEOF
+		}
+		val, min = uint64(binary.BigEndian.Uint32(b[:4])), 0x10000
+
+	case 0xff:
+		if _, err := io.ReadFull(r, b[:8]); err != nil {
+			return 0, io.ErrUnexpectedEOF
+		}
+		val, min = binary.BigEndian.Uint64(b[:8]), 0x100000000
+
+	default:
+		return uint64(b[0]), nil
+	}
+
+	if val < min {
+		return 0, ErrNonCanonicalBigSize
+	}
+
+	return val, nil
+}
+
+// EncodeTu64 returns the truncated big-endian encoding of val, stripping all
+// leading zero bytes as required for tu64 fields.
+func EncodeTu64(val uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], val)
+
+	i := 0
+	for i < len(b) && b[i] == 0 {
+		i++
+	}
+
+	return b[i:]
+}
+
+// DecodeTu64 parses a truncated big-endian integer, rejecting encodings that
+// are too long or that carry leading zero bytes.
+func DecodeTu64(b []byte) (uint64, error) {
+	if len(b) > 8 {
+		return 0, fmt.Errorf("tu64 too long: %d bytes", len(b))
+	}
+	if len(b) > 0 && b[0] == 0 {
+		return 0, fmt.Errorf("tu64 not minimally encoded")
+	}
+
+	var val uint64
+	for _, c := range b {
+		val = val<<8 | uint64(c)
+	}
+
+	return val, nil
+}
+
+// WriteStream serializes the set of records to w as a TLV stream. The
+// records MUST already be sorted by type.
+func WriteStream(w io.Writer, records []Record) error {
+	for i, rec := range records {
+		if i > 0 && rec.Type <= records[i-1].Type {
+			return ErrRecordsOutOfOrder
+		}
+
+		if err := WriteBigSize(w, rec.Type); err != nil {
+			return err
+		}
+		if err := WriteBigSize(w, uint64(len(rec.Value))); err != nil {
+			return err
+		}
+		if _, err := w.Write(rec.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EncodeStream serializes the set of records, which MUST already be sorted by
+// type, as a TLV stream.
+func EncodeStream(records []Record) ([]byte, error) {
+	var b bytes.Buffer
+	if err := WriteStream(&b, records); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeStream parses a TLV stream into its component records, ensuring the
+// types are strictly increasing.
+func DecodeStream(stream []byte) ([]Record, error) {
+	r := bytes.NewReader(stream)
+
+	var records []Record
+	for r.Len() > 0 {
+		typ, err := ReadBigSize(r)
+		if err != nil {
+			return nil, err
+		}
+		if len(records) > 0 && typ <= records[len(records)-1].Type {
+			return nil, ErrRecordsOutOfOrder
+		}
+
+		length, err := ReadBigSize(r)
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(r.Len()) {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		value := make([]byte, length)
+		if _, err := io.ReadFull(r, value); err != nil {
+			return nil, err
+		}
+
+		records = append(records, Record{Type: typ, Value: value})
+	}
+
+	return records, nil
+}
+
+// CheckUnknownEven returns an error if any record within the set is of an
+// even type that isn't contained in the set of known types. Following the
+// "it's OK to be odd" rule, unknown odd records are ignored.
+func CheckUnknownEven(records []Record, known map[uint64]struct{}) error {
+	for _, rec := range records {
+		if rec.Type%2 != 0 {
+			continue
+		}
+		if _, ok := known[rec.Type]; !ok {
+			return fmt.Errorf("unknown required tlv type %d",
+				rec.Type)
+		}
+	}
+
+	return nil
+}
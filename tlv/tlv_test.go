@@ -0,0 +1,57 @@
+package tlv
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// TestStreamEncodeDecode asserts that a TLV stream survives a round trip,
+// and that streams with records out of order are rejected.
+func TestStreamEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	records := []Record{
+		{Type: 1, Value: []byte{}},
+		{Type: 2, Value: EncodeTu64(1000)},
+		{Type: 0xfd, Value: bytes.Repeat([]byte{0x42}, 300)},
+	}
+
+	stream, err := EncodeStream(records)
+	if err != nil {
+		t.Fatalf("unable to encode stream: %v", err)
+	}
+	decoded, err := DecodeStream(stream)
+	if err != nil {
+		t.Fatalf("unable to decode stream: %v", err)
+	}
+	if !reflect.DeepEqual(records, decoded) {
+		t.Fatalf("records mismatch: expected %v, got %v", records,
+			decoded)
+	}
+
+	amt, err := DecodeTu64(decoded[1].Value)
+	if err != nil {
+		t.Fatalf("unable to decode tu64: %v", err)
+	}
+	if amt != 1000 {
+		t.Fatalf("expected 1000, got %v", amt)
+	}
+
+	_, err = EncodeStream([]Record{records[1], records[0]})
+	if err != ErrRecordsOutOfOrder {
+		t.Fatalf("expected ErrRecordsOutOfOrder, got %v", err)
+	}
+	_, err = DecodeStream([]byte{0x02, 0x00, 0x01, 0x00})
+	if err != ErrRecordsOutOfOrder {
+		t.Fatalf("expected ErrRecordsOutOfOrder, got %v", err)
+	}
+
+	known := map[uint64]struct{}{2: {}}
+	if err := CheckUnknownEven(records, known); err != nil {
+		t.Fatalf("unknown odd record rejected: %v", err)
+	}
+	if err := CheckUnknownEven(records, nil); err == nil {
+		t.Fatalf("unknown even record accepted")
+	}
+}
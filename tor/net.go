@@ -3,8 +3,13 @@ package tor
 import (
 	"errors"
 	"net"
+	"time"
 )
 
+// DefaultConnTimeout is the maximum amount of time a dial will wait for a
+// connection to be established, unless a different timeout is requested.
+const DefaultConnTimeout = 120 * time.Second
+
 // TODO: this interface and its implementations should ideally be moved
 // elsewhere as they are not Tor-specific.
 
@@ -12,8 +17,9 @@ import (
 // allows us to abstract the implementations of these functions over different
 // networks, e.g. clearnet, Tor net, etc.
 type Net interface {
-	// Dial connects to the address on the named network.
-	Dial(network, address string) (net.Conn, error)
+	// Dial connects to the address on the named network, giving up if
+	// the connection isn't established within the passed timeout.
+	Dial(network, address string, timeout time.Duration) (net.Conn, error)
 
 	// LookupHost performs DNS resolution on a given host and returns its
 	// addresses.
@@ -31,9 +37,11 @@ type Net interface {
 // for regular network connections.
 type ClearNet struct{}
 
-// Dial on the regular network uses net.Dial
-func (r *ClearNet) Dial(network, address string) (net.Conn, error) {
-	return net.Dial(network, address)
+// Dial on the regular network uses net.DialTimeout
+func (r *ClearNet) Dial(network, address string,
+	timeout time.Duration) (net.Conn, error) {
+
+	return net.DialTimeout(network, address, timeout)
 }
 
 // LookupHost for regular network uses the net.LookupHost function
@@ -71,13 +79,15 @@ type ProxyNet struct {
 
 // Dial uses the Tor Dial function in order to establish connections through
 // Tor. Since Tor only supports TCP connections, only TCP networks are allowed.
-func (p *ProxyNet) Dial(network, address string) (net.Conn, error) {
+func (p *ProxyNet) Dial(network, address string,
+	timeout time.Duration) (net.Conn, error) {
+
 	switch network {
 	case "tcp", "tcp4", "tcp6":
 	default:
 		return nil, errors.New("cannot dial non-tcp network via Tor")
 	}
-	return Dial(address, p.SOCKS, p.StreamIsolation)
+	return Dial(address, p.SOCKS, p.StreamIsolation, timeout)
 }
 
 // LookupHost uses the Tor LookupHost function in order to resolve hosts over
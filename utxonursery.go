@@ -5,11 +5,13 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
 	"sync"
 	"sync/atomic"
 
 	"github.com/lightningnetwork/lnd/sweep"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -232,6 +234,12 @@ type utxoNursery struct {
 	mu         sync.Mutex
 	bestHeight uint32
 
+	// sweepConfCancels holds, for each kindergarten class whose sweep txn
+	// confirmation we're waiting for, a channel that is closed once that
+	// txn is replaced, so that we stop waiting for it.
+	sweepConfCancels map[uint32]chan struct{}
+	sweepConfMtx     sync.Mutex
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -240,8 +248,9 @@ type utxoNursery struct {
 // ChainNotifier and LightningWallet instance.
 func newUtxoNursery(cfg *NurseryConfig) *utxoNursery {
 	return &utxoNursery{
-		cfg:  cfg,
-		quit: make(chan struct{}),
+		cfg:              cfg,
+		sweepConfCancels: make(map[uint32]chan struct{}),
+		quit:             make(chan struct{}),
 	}
 }
 
@@ -627,6 +636,178 @@ func (u *utxoNursery) NurseryReport(
 	return report, nil
 }
 
+// sweepableOutput describes a kindergarten output, which the nursery will
+// sweep back into the wallet once it has matured, or has already attempted to
+// sweep.
+type sweepableOutput struct {
+	outPoint  wire.OutPoint
+	chanPoint wire.OutPoint
+
+	amount      btcutil.Amount
+	witnessType lnwallet.WitnessType
+
+	// maturityHeight is the height of the kindergarten class the output
+	// belongs to, at which the nursery sweeps it.
+	maturityHeight uint32
+
+	// estimatedFee is the fee required to sweep the output on its own at
+	// the fee rate requested by the caller.
+	estimatedFee btcutil.Amount
+
+	// sweepTxid is the txid of the sweep txn the nursery broadcast for
+	// the output's class, or nil if the class hasn't been swept yet.
+	sweepTxid *chainhash.Hash
+}
+
+// SweepableOutputs returns all kindergarten outputs tracked by the nursery,
+// along with the fee required to sweep each of them at the given fee rate.
+func (u *utxoNursery) SweepableOutputs(
+	feePerKw lnwallet.SatPerKWeight) ([]sweepableOutput, error) {
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	heights, err := u.cfg.Store.HeightsBelowOrEqual(math.MaxUint32)
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs []sweepableOutput
+	for _, height := range heights {
+		finalTx, kgtnOutputs, _, err := u.cfg.Store.FetchClass(height)
+		if err != nil {
+			return nil, err
+		}
+
+		var sweepTxid *chainhash.Hash
+		if finalTx != nil {
+			txid := finalTx.TxHash()
+			sweepTxid = &txid
+		}
+
+		for i := range kgtnOutputs {
+			kid := &kgtnOutputs[i]
+			fee := u.cfg.Sweeper.EstimateFee(
+				[]sweep.Input{kid}, feePerKw,
+			)
+
+			outputs = append(outputs, sweepableOutput{
+				outPoint:       *kid.OutPoint(),
+				chanPoint:      *kid.OriginChanPoint(),
+				amount:         kid.Amount(),
+				witnessType:    kid.WitnessType(),
+				maturityHeight: height,
+				estimatedFee:   fee,
+				sweepTxid:      sweepTxid,
+			})
+		}
+	}
+
+	return outputs, nil
+}
+
+// SweepOutputs immediately sweeps the given kindergarten outputs back into the
+// wallet at the given fee rate, replacing the sweep txn the nursery broadcast
+// for them. Only outputs whose class has already been swept can be selected,
+// and all outputs of a class must be selected together, as the replacement
+// conflicts with the original sweep txn of the class.
+func (u *utxoNursery) SweepOutputs(outPoints []wire.OutPoint,
+	feePerKw lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if len(outPoints) == 0 {
+		return nil, fmt.Errorf("no outputs to sweep")
+	}
+
+	selected := make(map[wire.OutPoint]struct{}, len(outPoints))
+	for _, outPoint := range outPoints {
+		selected[outPoint] = struct{}{}
+	}
+
+	heights, err := u.cfg.Store.HeightsBelowOrEqual(u.bestHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		inputs  []sweep.Input
+		classes = make(map[uint32][]kidOutput)
+	)
+	for _, height := range heights {
+		finalTx, kgtnOutputs, _, err := u.cfg.Store.FetchClass(height)
+		if err != nil {
+			return nil, err
+		}
+
+		var numSelected int
+		for i := range kgtnOutputs {
+			_, ok := selected[*kgtnOutputs[i].OutPoint()]
+			if ok {
+				numSelected++
+			}
+		}
+		if numSelected == 0 {
+			continue
+		}
+
+		if finalTx == nil {
+			return nil, fmt.Errorf("kindergarten class at "+
+				"height=%d hasn't been swept yet", height)
+		}
+		if numSelected != len(kgtnOutputs) {
+			return nil, fmt.Errorf("all %d outputs of the "+
+				"kindergarten class at height=%d must be "+
+				"swept together", len(kgtnOutputs), height)
+		}
+
+		for i := range kgtnOutputs {
+			delete(selected, *kgtnOutputs[i].OutPoint())
+			inputs = append(inputs, &kgtnOutputs[i])
+		}
+		classes[height] = kgtnOutputs
+	}
+
+	for _, outPoint := range outPoints {
+		if _, ok := selected[outPoint]; ok {
+			return nil, fmt.Errorf("output %v isn't a mature "+
+				"output tracked by the nursery", outPoint)
+		}
+	}
+
+	sweepTx, err := u.cfg.Sweeper.CreateSweepTxWithFeeRate(
+		inputs, feePerKw, u.bestHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	utxnLog.Infof("Manually sweeping %v kindergarten outputs with sweep "+
+		"tx (txid=%v) at %v sat/kw", len(inputs), sweepTx.TxHash(),
+		int64(feePerKw))
+
+	// Only once the replacement has been accepted do we persist it, so
+	// that we don't lose track of the original sweep txn otherwise.
+	if err := u.cfg.PublishTransaction(sweepTx); err != nil {
+		return nil, err
+	}
+
+	for height, kgtnOutputs := range classes {
+		err := u.cfg.Store.ReplaceFinalizedKinder(height, sweepTx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = u.registerSweepConf(sweepTx, kgtnOutputs, height)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sweepTx, nil
+}
+
 // reloadPreschool re-initializes the chain notifier with all of the outputs
 // that had been saved to the "preschool" database bucket prior to shutdown.
 func (u *utxoNursery) reloadPreschool() error {
@@ -990,8 +1171,18 @@ func (u *utxoNursery) registerSweepConf(finalTx *wire.MsgTx,
 	utxnLog.Infof("Registering sweep tx %v for confs at height=%d",
 		finalTxID, heightHint)
 
+	// If we were already waiting for a sweep txn of this class, then it
+	// has been replaced, and will never confirm.
+	u.sweepConfMtx.Lock()
+	if cancel, ok := u.sweepConfCancels[heightHint]; ok {
+		close(cancel)
+	}
+	cancel := make(chan struct{})
+	u.sweepConfCancels[heightHint] = cancel
+	u.sweepConfMtx.Unlock()
+
 	u.wg.Add(1)
-	go u.waitForSweepConf(heightHint, kgtnOutputs, confChan)
+	go u.waitForSweepConf(heightHint, kgtnOutputs, confChan, cancel)
 
 	return nil
 }
@@ -1002,7 +1193,8 @@ func (u *utxoNursery) registerSweepConf(finalTx *wire.MsgTx,
 // to mark any mature channels as fully closed in channeldb.
 // NOTE(conner): this method MUST be called as a go routine.
 func (u *utxoNursery) waitForSweepConf(classHeight uint32,
-	kgtnOutputs []kidOutput, confChan *chainntnfs.ConfirmationEvent) {
+	kgtnOutputs []kidOutput, confChan *chainntnfs.ConfirmationEvent,
+	cancel chan struct{}) {
 
 	defer u.wg.Done()
 
@@ -1015,10 +1207,19 @@ func (u *utxoNursery) waitForSweepConf(classHeight uint32,
 			return
 		}
 
+	case <-cancel:
+		return
+
 	case <-u.quit:
 		return
 	}
 
+	u.sweepConfMtx.Lock()
+	if u.sweepConfCancels[classHeight] == cancel {
+		delete(u.sweepConfCancels, classHeight)
+	}
+	u.sweepConfMtx.Unlock()
+
 	u.mu.Lock()
 	defer u.mu.Unlock()
 
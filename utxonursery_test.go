@@ -866,6 +866,104 @@ func testNurseryCommitSuccessOnLocal(t *testing.T,
 	ctx.finish()
 }
 
+// TestNurseryManualSweep asserts that a kindergarten output is reported as
+// sweepable, and that its sweep txn can be replaced by one paying a fee rate
+// chosen by the caller, which then graduates the output once confirmed.
+func TestNurseryManualSweep(t *testing.T) {
+	ctx := createNurseryTestContext(t, func(func()) bool {
+		return false
+	})
+
+	commitRes := createCommitmentRes()
+	err := ctx.nursery.IncubateOutputs(
+		testChanPoint,
+		commitRes, nil, nil, 0,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ctx.notifier.confirmTx(&commitRes.SelfOutPoint.Hash, 124)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-ctx.store.preschoolToKinderChan:
+	case <-time.After(defaultTestTimeout):
+		t.Fatalf("output not promoted to KNDR")
+	}
+
+	// Before the output matures, it can't be swept manually.
+	const feePerKw = lnwallet.SatPerKWeight(1000)
+	_, err = ctx.nursery.SweepOutputs(
+		[]wire.OutPoint{commitRes.SelfOutPoint}, feePerKw,
+	)
+	if err == nil {
+		t.Fatal("expected immature output to be rejected")
+	}
+
+	ctx.notifier.notifyEpoch(126)
+	sweepTx := ctx.receiveTx()
+
+	outputs, err := ctx.nursery.SweepableOutputs(feePerKw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outputs) != 1 {
+		t.Fatalf("expected 1 sweepable output, got %d", len(outputs))
+	}
+	output := outputs[0]
+	if output.outPoint != commitRes.SelfOutPoint {
+		t.Fatalf("unexpected outpoint %v", output.outPoint)
+	}
+	if output.amount != 10000 || output.maturityHeight != 126 {
+		t.Fatalf("unexpected amount %v or maturity height %v",
+			output.amount, output.maturityHeight)
+	}
+	if output.estimatedFee == 0 {
+		t.Fatal("expected non-zero fee estimate")
+	}
+	sweepTxHash := sweepTx.TxHash()
+	if output.sweepTxid == nil || *output.sweepTxid != sweepTxHash {
+		t.Fatalf("expected sweep txid %v, got %v", sweepTxHash,
+			output.sweepTxid)
+	}
+
+	// Replace the sweep txn with one paying a higher fee rate.
+	replacement, err := ctx.nursery.SweepOutputs(
+		[]wire.OutPoint{commitRes.SelfOutPoint}, feePerKw,
+	)
+	if err != nil {
+		t.Fatalf("unable to sweep output: %v", err)
+	}
+	if published := ctx.receiveTx(); published.TxHash() !=
+		replacement.TxHash() {
+
+		t.Fatalf("replacement sweep tx not published")
+	}
+	if replacement.TxOut[0].Value >= sweepTx.TxOut[0].Value {
+		t.Fatalf("replacement doesn't pay a higher fee")
+	}
+
+	// Confirming the replacement graduates the output.
+	replacementHash := replacement.TxHash()
+	err = ctx.notifier.confirmTx(&replacementHash, 129)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-ctx.store.graduateKinderChan:
+	case <-time.After(defaultTestTimeout):
+		t.Fatalf("output not graduated")
+	}
+
+	assertNurseryReportUnavailable(t, ctx.nursery)
+
+	ctx.finish()
+}
+
 func testSweepHtlc(t *testing.T, ctx *nurseryTestContext) {
 	testSweep(t, ctx, func() {
 		// Verify stage in nursery report. HTLCs should now both still
@@ -973,6 +1071,12 @@ func (i *nurseryStoreInterceptor) FinalizeKinder(height uint32,
 	return i.ns.FinalizeKinder(height, tx)
 }
 
+func (i *nurseryStoreInterceptor) ReplaceFinalizedKinder(height uint32,
+	tx *wire.MsgTx) error {
+
+	return i.ns.ReplaceFinalizedKinder(height, tx)
+}
+
 func (i *nurseryStoreInterceptor) LastFinalizedHeight() (uint32, error) {
 	return i.ns.LastFinalizedHeight()
 }
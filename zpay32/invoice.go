@@ -68,6 +68,9 @@ const (
 
 	// fieldTypeC contains an optional requested final CLTV delta.
 	fieldTypeC = 24
+
+	// fieldType9 contains the feature bits of the invoice.
+	fieldType9 = 5
 )
 
 // MessageSigner is passed to the Encode method to provide a signature
@@ -147,6 +150,12 @@ type Invoice struct {
 	//
 	// NOTE: This is optional.
 	RouteHints [][]routing.HopHint
+
+	// Features is the set of features the recipient supports for the
+	// payment of this invoice.
+	//
+	// NOTE: This is optional.
+	Features *lnwire.RawFeatureVector
 }
 
 // Amount is a functional option that allows callers of NewInvoice to set the
@@ -220,6 +229,14 @@ func RouteHint(routeHint []routing.HopHint) func(*Invoice) {
 	}
 }
 
+// Features is a functional option that allows callers of NewInvoice to set
+// the feature bits that the recipient supports for the payment.
+func Features(features *lnwire.RawFeatureVector) func(*Invoice) {
+	return func(i *Invoice) {
+		i.Features = features
+	}
+}
+
 // NewInvoice creates a new Invoice object. The last parameter is a set of
 // variadic arguments for setting optional fields of the invoice.
 //
@@ -664,6 +681,14 @@ func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) er
 			}
 
 			invoice.RouteHints = append(invoice.RouteHints, routeHint)
+		case fieldType9:
+			if invoice.Features != nil {
+				// We skip the field if we have already seen a
+				// supported one.
+				continue
+			}
+
+			invoice.Features = parseFeatures(base32Data)
 		default:
 			// Ignore unknown type.
 		}
@@ -841,6 +866,23 @@ func parseFallbackAddr(data []byte, net *chaincfg.Params) (btcutil.Address, erro
 	return addr, nil
 }
 
+// parseFeatures converts the data (encoded in base32) into a feature vector.
+// The feature bits are encoded big endian, so the least significant bit of the
+// last group is feature bit 0.
+func parseFeatures(data []byte) *lnwire.RawFeatureVector {
+	features := lnwire.NewRawFeatureVector()
+	for i := 0; i < len(data); i++ {
+		group := data[len(data)-i-1]
+		for bit := uint(0); bit < 5; bit++ {
+			if (group>>bit)&1 == 1 {
+				features.Set(lnwire.FeatureBit(i*5 + int(bit)))
+			}
+		}
+	}
+
+	return features
+}
+
 // parseRouteHint converts the data (encoded in base32) into an array containing
 // one or more routing hop hints that represent a single route hint.
 func parseRouteHint(data []byte) ([]routing.HopHint, error) {
@@ -935,6 +977,27 @@ func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
 		}
 	}
 
+	if invoice.Features != nil {
+		features := invoice.Features.Features()
+
+		// The feature bits are written using as few groups as
+		// possible, so the highest bit determines their number.
+		var featuresBase32 []byte
+		if len(features) > 0 {
+			maxBit := int(features[len(features)-1])
+			featuresBase32 = make([]byte, maxBit/5+1)
+		}
+		for _, feature := range features {
+			groupIndex := len(featuresBase32) - int(feature)/5 - 1
+			featuresBase32[groupIndex] |= 1 << (uint(feature) % 5)
+		}
+
+		err := writeTaggedField(bufferBase32, fieldType9, featuresBase32)
+		if err != nil {
+			return err
+		}
+	}
+
 	if invoice.expiry != nil {
 		seconds := invoice.expiry.Seconds()
 		expiry := uint64ToBase32(uint64(seconds))
@@ -1,6 +1,7 @@
 package zpay32
 
 import (
+	"bytes"
 	"encoding/binary"
 	"math"
 	"reflect"
@@ -778,3 +779,57 @@ func TestParseRouteHint(t *testing.T) {
 		}
 	}
 }
+
+// TestParseFeatures checks that the feature bits are properly parsed, and that
+// they're written back using as few groups as possible.
+func TestParseFeatures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		data   []byte
+		result []lnwire.FeatureBit
+	}{
+		{
+			data:   []byte{},
+			result: []lnwire.FeatureBit{},
+		},
+		{
+			data:   []byte{0x1, 0x0},
+			result: []lnwire.FeatureBit{5},
+		},
+		{
+			data:   []byte{0x10, 0x2},
+			result: []lnwire.FeatureBit{1, 9},
+		},
+		{
+			data:   []byte{0x1, 0x0, 0x0, 0x1f},
+			result: []lnwire.FeatureBit{0, 1, 2, 3, 4, 15},
+		},
+	}
+
+	for i, test := range tests {
+		features := parseFeatures(test.data)
+		if !reflect.DeepEqual(features.Features(), test.result) {
+			t.Fatalf("test %d failed decoding features: "+
+				"expected %v, got %v",
+				i, test.result, features.Features())
+		}
+
+		// Writing the features back should result in the same data,
+		// prefixed by the field type and length.
+		var buf bytes.Buffer
+		err := writeTaggedFields(&buf, &Invoice{Features: features})
+		if err != nil {
+			t.Fatalf("test %d failed encoding features: %v", i, err)
+		}
+
+		expected := append(
+			[]byte{fieldType9, 0x0, byte(len(test.data))},
+			test.data...,
+		)
+		if !bytes.Equal(buf.Bytes(), expected) {
+			t.Fatalf("test %d failed encoding features: "+
+				"expected %x, got %x", i, expected, buf.Bytes())
+		}
+	}
+}